@@ -575,10 +575,16 @@ fn get_cpu_stats(cg: &cgroups::Cgroup) -> SingularPtrField<ThrottlingData> {
     let stat = cpu_controller.cpu().stat;
     let h = lines_to_map(&stat);
 
+    // cgroup v2 accounts the throttled time in microseconds
+    let throttled_time = match h.get("throttled_usec") {
+        Some(usec) => *usec * 1000,
+        None => *h.get("throttled_time").unwrap_or(&0),
+    };
+
     SingularPtrField::some(ThrottlingData {
         periods: *h.get("nr_periods").unwrap_or(&0),
         throttled_periods: *h.get("nr_throttled").unwrap_or(&0),
-        throttled_time: *h.get("throttled_time").unwrap_or(&0),
+        throttled_time,
         unknown_fields: UnknownFields::default(),
         cached_size: CachedSize::default(),
     })
@@ -608,24 +614,14 @@ fn get_cpuacct_stats(cg: &cgroups::Cgroup) -> SingularPtrField<CpuUsage> {
         });
     }
 
-    if cg.v2() {
-        return SingularPtrField::some(CpuUsage {
-            total_usage: 0,
-            percpu_usage: vec![],
-            usage_in_kernelmode: 0,
-            usage_in_usermode: 0,
-            unknown_fields: UnknownFields::default(),
-            cached_size: CachedSize::default(),
-        });
-    }
-
-    // try to get from cpu controller
+    // try to get from cpu controller, accounting the usage in microseconds
+    // on cgroup v2
     let cpu_controller: &CpuController = get_controller_or_return_singular_none!(cg);
     let stat = cpu_controller.cpu().stat;
     let h = lines_to_map(&stat);
-    let usage_in_usermode = *h.get("user_usec").unwrap_or(&0);
-    let usage_in_kernelmode = *h.get("system_usec").unwrap_or(&0);
-    let total_usage = *h.get("usage_usec").unwrap_or(&0);
+    let usage_in_usermode = *h.get("user_usec").unwrap_or(&0) * 1000;
+    let usage_in_kernelmode = *h.get("system_usec").unwrap_or(&0) * 1000;
+    let total_usage = *h.get("usage_usec").unwrap_or(&0) * 1000;
     let percpu_usage = vec![];
 
     SingularPtrField::some(CpuUsage {
@@ -806,21 +802,24 @@ fn get_blkio_stats_v2(cg: &cgroups::Cgroup) -> SingularPtrField<BlkioStats> {
     let blkio = blkio_controller.blkio();
 
     let mut resp = BlkioStats::new();
-    let mut blkio_stats = RepeatedField::new();
+    let mut service_bytes = RepeatedField::new();
+    let mut serviced = RepeatedField::new();
 
+    // split the bytes and the operations like the cgroup v1 statistics
     let stat = blkio.io_stat;
     for s in stat {
-        blkio_stats.push(build_blkio_stats_entry(s.major, s.minor, "read", s.rbytes));
-        blkio_stats.push(build_blkio_stats_entry(s.major, s.minor, "write", s.wbytes));
-        blkio_stats.push(build_blkio_stats_entry(s.major, s.minor, "rios", s.rios));
-        blkio_stats.push(build_blkio_stats_entry(s.major, s.minor, "wios", s.wios));
-        blkio_stats.push(build_blkio_stats_entry(
-            s.major, s.minor, "dbytes", s.dbytes,
+        service_bytes.push(build_blkio_stats_entry(s.major, s.minor, "read", s.rbytes));
+        service_bytes.push(build_blkio_stats_entry(s.major, s.minor, "write", s.wbytes));
+        service_bytes.push(build_blkio_stats_entry(
+            s.major, s.minor, "discard", s.dbytes,
         ));
-        blkio_stats.push(build_blkio_stats_entry(s.major, s.minor, "dios", s.dios));
+        serviced.push(build_blkio_stats_entry(s.major, s.minor, "read", s.rios));
+        serviced.push(build_blkio_stats_entry(s.major, s.minor, "write", s.wios));
+        serviced.push(build_blkio_stats_entry(s.major, s.minor, "discard", s.dios));
     }
 
-    resp.io_service_bytes_recursive = blkio_stats;
+    resp.io_service_bytes_recursive = service_bytes;
+    resp.io_serviced_recursive = serviced;
 
     SingularPtrField::some(resp)
 }
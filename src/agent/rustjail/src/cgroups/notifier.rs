@@ -88,6 +88,9 @@ async fn register_memory_event_v2(
     let (sender, receiver) = channel(100);
     let containere_id = containere_id.to_string();
 
+    // the OOM kills before the registration are not reported
+    let mut oom_kills = get_value_from_cgroup(&event_control_path, "oom_kill").unwrap_or(0);
+
     tokio::spawn(async move {
         let mut buffer = [0; 32];
         let mut stream = inotify
@@ -104,12 +107,14 @@ async fn register_memory_event_v2(
             info!(sl!(), "event.wd: {:?}", event.wd);
 
             if event.wd == ev_wd {
-                let oom = get_value_from_cgroup(&event_control_path, "oom_kill");
-                if oom.unwrap_or(0) > 0 {
+                // report every OOM kill, the container may survive the
+                // kill of one of its processes
+                let oom = get_value_from_cgroup(&event_control_path, "oom_kill").unwrap_or(0);
+                if oom > oom_kills {
+                    oom_kills = oom;
                     let _ = sender.send(containere_id.clone()).await.map_err(|e| {
                         error!(sl!(), "send containere_id failed, error: {:?}", e);
                     });
-                    return;
                 }
             } else if event.wd == cg_wd {
                 let pids = get_value_from_cgroup(&cgroup_event_control_path, "populated");
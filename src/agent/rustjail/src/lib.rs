@@ -138,7 +138,7 @@ fn mount_grpc_to_oci(m: &grpc::Mount) -> oci::Mount {
 
 use protocols::oci::Hook as grpcHook;
 
-fn hook_grpc_to_oci(h: &[grpcHook]) -> Vec<oci::Hook> {
+pub fn hook_grpc_to_oci(h: &[grpcHook]) -> Vec<oci::Hook> {
     let mut r = Vec::new();
     for e in h.iter() {
         r.push(oci::Hook {
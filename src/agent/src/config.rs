@@ -60,6 +60,15 @@ pub struct EndpointsConfig {
 pub struct AgentEndpoints {
     pub allowed: HashSet<String>,
     pub all_allowed: bool,
+    // The policy document the allowed endpoints were set from, if any.
+    pub policy: Option<String>,
+}
+
+// A policy of the agent API, in the format of the configuration file.
+#[derive(Debug, Deserialize)]
+#[serde(deny_unknown_fields)]
+pub struct PolicyConfig {
+    pub endpoints: EndpointsConfig,
 }
 
 #[derive(Debug)]
@@ -310,6 +319,38 @@ impl AgentConfig {
     pub fn is_allowed_endpoint(&self, ep: &str) -> bool {
         self.endpoints.all_allowed || self.endpoints.allowed.contains(ep)
     }
+
+    // set_policy replaces the allowed endpoints with the ones of a policy
+    // document.
+    pub fn set_policy(&mut self, policy: &str) -> Result<()> {
+        let config: PolicyConfig = toml::from_str(policy).context("invalid policy")?;
+
+        self.endpoints = AgentEndpoints {
+            allowed: config.endpoints.allowed.into_iter().collect(),
+            all_allowed: false,
+            policy: Some(policy.to_string()),
+        };
+
+        Ok(())
+    }
+
+    // policy returns the active policy document, empty when all the endpoints
+    // are allowed. The endpoints of the configuration file are returned in
+    // the policy format.
+    pub fn policy(&self) -> String {
+        if self.endpoints.all_allowed {
+            return String::new();
+        }
+
+        if let Some(policy) = &self.endpoints.policy {
+            return policy.clone();
+        }
+
+        let mut allowed: Vec<&String> = self.endpoints.allowed.iter().collect();
+        allowed.sort();
+
+        format!("[endpoints]\nallowed = {:?}\n", allowed)
+    }
 }
 
 #[instrument]
@@ -1398,4 +1439,39 @@ Caused by:
         // Verify that the default values are valid
         assert_eq!(config.hotplug_timeout, DEFAULT_HOTPLUG_TIMEOUT);
     }
+
+    #[test]
+    fn test_set_policy() {
+        let mut config = AgentConfig::default();
+        config.endpoints.all_allowed = true;
+        assert_eq!(config.policy(), "");
+
+        let policy = r#"
+               [endpoints]
+               allowed = ["SetPolicyRequest", "GetPolicyRequest"]
+              "#;
+        config.set_policy(policy).unwrap();
+
+        assert!(!config.endpoints.all_allowed);
+        assert!(config.is_allowed_endpoint("GetPolicyRequest"));
+        assert!(!config.is_allowed_endpoint("CreateContainerRequest"));
+        assert_eq!(config.policy(), policy);
+
+        // the policy is left untouched on errors
+        assert!(config.set_policy("[endpoints]").is_err());
+        assert!(config.set_policy("dev_mode = true").is_err());
+        assert_eq!(config.policy(), policy);
+
+        let config = AgentConfig::from_str(
+            r#"
+               [endpoints]
+               allowed = ["StartContainerRequest", "CreateContainerRequest"]
+              "#,
+        )
+        .unwrap();
+        assert_eq!(
+            config.policy(),
+            "[endpoints]\nallowed = [\"CreateContainerRequest\", \"StartContainerRequest\"]\n"
+        );
+    }
 }
@@ -43,9 +43,13 @@ pub const DRIVER_BLK_CCW_TYPE: &str = "blk-ccw";
 pub const DRIVER_MMIO_BLK_TYPE: &str = "mmioblk";
 pub const DRIVER_SCSI_TYPE: &str = "scsi";
 pub const DRIVER_NVDIMM_TYPE: &str = "nvdimm";
+pub const DRIVER_VIRTIO_PMEM_TYPE: &str = "virtio-pmem";
 pub const DRIVER_EPHEMERAL_TYPE: &str = "ephemeral";
 pub const DRIVER_LOCAL_TYPE: &str = "local";
 pub const DRIVER_WATCHABLE_BIND_TYPE: &str = "watchable-bind";
+pub const DRIVER_WATCHABLE_SYNC_TYPE: &str = "watchable-sync";
+pub const DRIVER_LAYER_CACHE_TYPE: &str = "layer-cache";
+pub const DRIVER_NETFS_TYPE: &str = "netfs";
 // VFIO device to be bound to a guest kernel driver
 pub const DRIVER_VFIO_GK_TYPE: &str = "vfio-gk";
 // VFIO device to be bound to vfio-pci and made available inside the
@@ -180,24 +184,27 @@ pub fn pcipath_to_sysfs(root_bus_sysfs: &str, pcipath: &pci::Path) -> Result<Str
     Ok(relpath)
 }
 
-// FIXME: This matcher is only correct if the guest has at most one
-// SCSI host.
+// The runtime may spread the disks across several SCSI controllers, i.e.
+// SCSI hosts, but their SCSI addresses are unique in the guest, so the
+// host number is not matched.
 #[derive(Debug)]
 struct ScsiBlockMatcher {
-    search: String,
+    rex: Regex,
 }
 
 impl ScsiBlockMatcher {
     fn new(scsi_addr: &str) -> ScsiBlockMatcher {
-        let search = format!(r"/0:0:{}/block/", scsi_addr);
+        let re = format!(r"/\d+:0:{}/block/", regex::escape(scsi_addr));
 
-        ScsiBlockMatcher { search }
+        ScsiBlockMatcher {
+            rex: Regex::new(&re).expect("BUG: failed to compile ScsiBlockMatcher regex"),
+        }
     }
 }
 
 impl UeventMatcher for ScsiBlockMatcher {
     fn is_match(&self, uev: &Uevent) -> bool {
-        uev.subsystem == "block" && uev.devpath.contains(&self.search) && !uev.devname.is_empty()
+        uev.subsystem == "block" && self.rex.is_match(&uev.devpath) && !uev.devname.is_empty()
     }
 }
 
@@ -248,6 +255,47 @@ pub async fn get_virtio_blk_pci_device_name(
     Ok(format!("{}/{}", SYSTEM_DEV_PATH, &uev.devname))
 }
 
+// The pmem block device of a virtio-pmem device sits below the nvdimm bus
+// the virtio device exposes, e.g.
+// <pci>/virtio3/ndbus0/region0/namespace0.0/block/pmem0
+#[derive(Debug)]
+struct VirtioPmemPciMatcher {
+    rex: Regex,
+}
+
+impl VirtioPmemPciMatcher {
+    fn new(relpath: &str) -> VirtioPmemPciMatcher {
+        let root_bus = create_pci_root_bus_path();
+        let re = format!(
+            r"^{}{}/virtio[0-9]+/ndbus[0-9]+/.*/block/",
+            root_bus, relpath
+        );
+
+        VirtioPmemPciMatcher {
+            rex: Regex::new(&re).expect("BUG: failed to compile VirtioPmemPciMatcher regex"),
+        }
+    }
+}
+
+impl UeventMatcher for VirtioPmemPciMatcher {
+    fn is_match(&self, uev: &Uevent) -> bool {
+        uev.subsystem == "block" && self.rex.is_match(&uev.devpath) && !uev.devname.is_empty()
+    }
+}
+
+#[instrument]
+pub async fn get_virtio_pmem_pci_device_name(
+    sandbox: &Arc<Mutex<Sandbox>>,
+    pcipath: &pci::Path,
+) -> Result<String> {
+    let root_bus_sysfs = format!("{}{}", SYSFS_DIR, create_pci_root_bus_path());
+    let sysfs_rel_path = pcipath_to_sysfs(&root_bus_sysfs, pcipath)?;
+    let matcher = VirtioPmemPciMatcher::new(&sysfs_rel_path);
+
+    let uev = wait_for_uevent(sandbox, matcher).await?;
+    Ok(format!("{}/{}", SYSTEM_DEV_PATH, &uev.devname))
+}
+
 #[cfg(target_arch = "s390x")]
 #[derive(Debug)]
 struct VirtioBlkCCWMatcher {
@@ -412,8 +460,9 @@ fn scan_scsi_bus(scsi_addr: &str) -> Result<()> {
         ));
     }
 
-    // Scan scsi host passing in the channel, SCSI id and LUN.
-    // Channel is always 0 because we have only one SCSI controller.
+    // Scan scsi hosts passing in the channel, SCSI id and LUN.
+    // Channel is always 0 because each SCSI controller has a single
+    // channel, and the device is only found on the host it is plugged to.
     let scan_data = format!("0 {} {}", tokens[0], tokens[1]);
 
     for entry in fs::read_dir(SYSFS_SCSI_HOST_PATH)? {
@@ -569,31 +618,102 @@ fn update_spec_devices(spec: &mut Spec, mut updates: HashMap<&str, DevUpdate>) -
         ));
     }
 
-    if let Some(resources) = linux.resources.as_mut() {
-        for r in &mut resources.devices {
-            if let (Some(host_major), Some(host_minor)) = (r.major, r.minor) {
-                if let Some(update) = res_updates.get(&(r.r#type.as_str(), host_major, host_minor))
-                {
-                    info!(
-                        sl!(),
-                        "update_spec_devices() updating resource";
-                        "type" => &r.r#type,
-                        "host_major" => host_major,
-                        "host_minor" => host_minor,
-                        "guest_major" => update.guest_major,
-                        "guest_minor" => update.guest_minor,
-                    );
-
-                    r.major = Some(update.guest_major);
-                    r.minor = Some(update.guest_minor);
-                }
+    let resources = linux.resources.get_or_insert_with(LinuxResources::default);
+
+    // The devices the device cgroup rules only match with wildcards, e.g.
+    // raw block volumes, are given the access the rules give them on the
+    // host, with their numbers in the VM. The rules naming them are updated
+    // below.
+    let allowed: Vec<LinuxDeviceCgroup> = res_updates
+        .iter()
+        .filter(|((dev_type, host_major, host_minor), _)| {
+            !resources.devices.iter().any(|r| {
+                r.r#type == *dev_type
+                    && r.major == Some(*host_major)
+                    && r.minor == Some(*host_minor)
+            })
+        })
+        .filter_map(|((dev_type, host_major, host_minor), update)| {
+            let access =
+                device_cgroup_access(&resources.devices, dev_type, *host_major, *host_minor);
+            if access.is_empty() {
+                return None;
+            }
+
+            Some(LinuxDeviceCgroup {
+                allow: true,
+                r#type: dev_type.to_string(),
+                major: Some(update.guest_major),
+                minor: Some(update.guest_minor),
+                access,
+            })
+        })
+        .collect();
+
+    for r in &mut resources.devices {
+        if let (Some(host_major), Some(host_minor)) = (r.major, r.minor) {
+            if let Some(update) = res_updates.get(&(r.r#type.as_str(), host_major, host_minor)) {
+                info!(
+                    sl!(),
+                    "update_spec_devices() updating resource";
+                    "type" => &r.r#type,
+                    "host_major" => host_major,
+                    "host_minor" => host_minor,
+                    "guest_major" => update.guest_major,
+                    "guest_minor" => update.guest_minor,
+                );
+
+                r.major = Some(update.guest_major);
+                r.minor = Some(update.guest_minor);
             }
         }
     }
 
+    resources.devices.extend(allowed);
+
     Ok(())
 }
 
+// device_cgroup_access returns the access the device cgroup rules give to a
+// device, as the kernel does: the last rule matching the device decides of
+// each of its permissions.
+fn device_cgroup_access(
+    rules: &[LinuxDeviceCgroup],
+    dev_type: &str,
+    major: i64,
+    minor: i64,
+) -> String {
+    let mut allowed = [false; 3];
+
+    for r in rules {
+        if !r.r#type.is_empty() && r.r#type != "a" && r.r#type != dev_type {
+            continue;
+        }
+        if r.major.map_or(false, |m| m != major) || r.minor.map_or(false, |m| m != minor) {
+            continue;
+        }
+
+        // An empty access means all of them
+        let access = if r.access.is_empty() {
+            "rwm"
+        } else {
+            r.access.as_str()
+        };
+        for (i, perm) in "rwm".chars().enumerate() {
+            if access.contains(perm) {
+                allowed[i] = r.allow;
+            }
+        }
+    }
+
+    "rwm"
+        .chars()
+        .zip(allowed.iter())
+        .filter(|(_, allowed)| **allowed)
+        .map(|(perm, _)| perm)
+        .collect()
+}
+
 // update_env_pci alters PCI addresses in a set of environment
 // variables to be correct for the VM instead of the host.  It is
 // given a map of (host address => guest address)
@@ -958,6 +1078,51 @@ mod tests {
         );
         assert!(res.is_ok());
 
+        // no rule allows the device
+        let specresources = spec.linux.as_ref().unwrap().resources.as_ref().unwrap();
+        assert!(specresources.devices.is_empty());
+
+        // the device is given the access a wildcard rule gives it
+        spec.linux.as_mut().unwrap().devices = vec![oci::LinuxDevice {
+            path: container_path.to_string(),
+            major,
+            minor,
+            ..oci::LinuxDevice::default()
+        }];
+        spec.linux.as_mut().unwrap().resources = Some(oci::LinuxResources {
+            devices: vec![
+                oci::LinuxDeviceCgroup {
+                    allow: false,
+                    access: "rwm".to_string(),
+                    ..oci::LinuxDeviceCgroup::default()
+                },
+                oci::LinuxDeviceCgroup {
+                    allow: true,
+                    major: Some(major),
+                    access: "rw".to_string(),
+                    ..oci::LinuxDeviceCgroup::default()
+                },
+            ],
+            ..oci::LinuxResources::default()
+        });
+
+        let res = update_spec_devices(
+            &mut spec,
+            HashMap::from_iter(vec![(
+                container_path,
+                DevNumUpdate::from_vm_path(vm_path).unwrap().into(),
+            )]),
+        );
+        assert!(res.is_ok());
+
+        let guest = DevNumUpdate::from_vm_path(vm_path).unwrap();
+        let specresources = spec.linux.as_ref().unwrap().resources.as_ref().unwrap();
+        assert_eq!(3, specresources.devices.len());
+        assert!(specresources.devices[2].allow);
+        assert_eq!(Some(guest.guest_major), specresources.devices[2].major);
+        assert_eq!(Some(guest.guest_minor), specresources.devices[2].minor);
+        assert_eq!("rw", specresources.devices[2].access);
+
         // update both devices and cgroup lists
         spec.linux.as_mut().unwrap().devices = vec![oci::LinuxDevice {
             path: container_path.to_string(),
@@ -1375,6 +1540,38 @@ mod tests {
         assert!(!matcher_a.is_match(&uev_b));
     }
 
+    #[tokio::test]
+    async fn test_virtio_pmem_matcher() {
+        let root_bus = create_pci_root_bus_path();
+        let devname = "pmem1";
+
+        let mut uev_a = crate::uevent::Uevent::default();
+        let relpath_a = "/0000:00:02.0/0000:01:01.0";
+        uev_a.action = crate::linux_abi::U_EVENT_ACTION_ADD.to_string();
+        uev_a.subsystem = "block".to_string();
+        uev_a.devname = devname.to_string();
+        uev_a.devpath = format!(
+            "{}{}/virtio5/ndbus1/region1/namespace1.0/block/{}",
+            root_bus, relpath_a, devname
+        );
+        let matcher_a = VirtioPmemPciMatcher::new(relpath_a);
+
+        // A virtio-blk device at the same PCI path
+        let mut uev_b = uev_a.clone();
+        uev_b.devpath = format!("{}{}/virtio5/block/vda", root_bus, relpath_a);
+
+        let mut uev_c = uev_a.clone();
+        let relpath_c = "/0000:00:02.0/0000:01:02.0";
+        uev_c.devpath = format!(
+            "{}{}/virtio6/ndbus2/region2/namespace2.0/block/pmem2",
+            root_bus, relpath_c
+        );
+
+        assert!(matcher_a.is_match(&uev_a));
+        assert!(!matcher_a.is_match(&uev_b));
+        assert!(!matcher_a.is_match(&uev_c));
+    }
+
     #[cfg(target_arch = "s390x")]
     #[tokio::test]
     async fn test_virtio_blk_ccw_matcher() {
@@ -1459,10 +1656,22 @@ mod tests {
         );
         let matcher_b = ScsiBlockMatcher::new(addr_b);
 
+        // Disk plugged to a second SCSI controller
+        let mut uev_c = uev_a.clone();
+        let addr_c = "0:1";
+        uev_c.devpath = format!(
+            "{}/0000:00:01.0/virtio1/host1/target1:0:0/1:0:{}/block/sdc",
+            root_bus, addr_c
+        );
+        let matcher_c = ScsiBlockMatcher::new(addr_c);
+
         assert!(matcher_a.is_match(&uev_a));
         assert!(matcher_b.is_match(&uev_b));
+        assert!(matcher_c.is_match(&uev_c));
         assert!(!matcher_b.is_match(&uev_a));
         assert!(!matcher_a.is_match(&uev_b));
+        assert!(!matcher_a.is_match(&uev_c));
+        assert!(!matcher_c.is_match(&uev_a));
     }
 
     #[tokio::test]
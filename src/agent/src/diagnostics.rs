@@ -0,0 +1,428 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+use anyhow::{anyhow, Result};
+use nix::errno::Errno;
+use protobuf::RepeatedField;
+use protocols::agent::{Diagnostics, DiagnosticsFile};
+use std::collections::VecDeque;
+use std::fs::{self, File, OpenOptions};
+use std::io::{Read, Seek, SeekFrom, Write};
+use std::os::unix::fs::OpenOptionsExt;
+use std::path::{Path, PathBuf};
+use std::sync::Mutex;
+use tracing::instrument;
+
+// Default maximum size of the data of the diagnostics, well under the
+// maximum size of the ttrpc messages.
+pub const DEFAULT_MAX_SIZE: u64 = 2 * 1024 * 1024;
+
+// Maximum size of the kernel log, its oldest records are dropped.
+const KMSG_MAX_SIZE: usize = 256 * 1024;
+
+// Maximum size of the recent logs of the agent kept for the diagnostics.
+const AGENT_LOG_MAX_SIZE: usize = 256 * 1024;
+
+const CORES_DIR: &str = "cores";
+
+const KMSG_PATH: &str = "/dev/kmsg";
+const CORE_PATTERN_PATH: &str = "/proc/sys/kernel/core_pattern";
+
+// Snapshots of the guest
+const PROC_FILES: &[&str] = &[
+    "meminfo",
+    "vmstat",
+    "loadavg",
+    "stat",
+    "interrupts",
+    "buddyinfo",
+    "slabinfo",
+    "cmdline",
+    "mounts",
+];
+
+// Snapshots of the processes of a container
+const PROCESS_FILES: &[&str] = &["cmdline", "status", "limits", "stack", "wchan"];
+
+lazy_static! {
+    // The most recent logs of the agent, with the records dropped.
+    static ref AGENT_LOG: Mutex<(VecDeque<u8>, bool)> = Mutex::new((VecDeque::new(), false));
+}
+
+// LogRecorder writes the logs of the agent, keeping the most recent ones
+// for the diagnostics.
+pub struct LogRecorder<W: Write> {
+    writer: W,
+}
+
+impl<W: Write> LogRecorder<W> {
+    pub fn new(writer: W) -> Self {
+        LogRecorder { writer }
+    }
+}
+
+impl<W: Write> Write for LogRecorder<W> {
+    fn write(&mut self, buf: &[u8]) -> std::io::Result<usize> {
+        let n = self.writer.write(buf)?;
+
+        if let Ok(mut log) = AGENT_LOG.lock() {
+            record_log(&mut log, &buf[..n], AGENT_LOG_MAX_SIZE);
+        }
+
+        Ok(n)
+    }
+
+    fn flush(&mut self) -> std::io::Result<()> {
+        self.writer.flush()
+    }
+}
+
+// record_log appends data to the log, dropping its oldest records beyond
+// max_size.
+fn record_log(log: &mut (VecDeque<u8>, bool), data: &[u8], max_size: usize) {
+    let (buf, dropped) = log;
+    buf.extend(data);
+
+    if buf.len() <= max_size {
+        return;
+    }
+
+    buf.drain(..buf.len() - max_size);
+    // Start at a record boundary
+    let start = buf
+        .iter()
+        .position(|&c| c == b'\n')
+        .map_or(buf.len(), |i| i + 1);
+    buf.drain(..start);
+    *dropped = true;
+}
+
+// Collector gathers the diagnostics files, in the order of their priority,
+// until their data reaches the maximum size.
+#[derive(Debug)]
+pub struct Collector {
+    files: Vec<DiagnosticsFile>,
+    remaining: u64,
+}
+
+impl Collector {
+    pub fn new(max_size: u64) -> Self {
+        Collector {
+            files: Vec::new(),
+            remaining: max_size,
+        }
+    }
+
+    fn add(&mut self, name: &str, mut data: Vec<u8>, truncated: bool) -> &mut DiagnosticsFile {
+        let mut file = DiagnosticsFile::new();
+        file.set_name(name.to_string());
+        file.set_truncated(truncated);
+
+        if data.len() as u64 > self.remaining {
+            data.truncate(self.remaining as usize);
+            file.set_truncated(true);
+        }
+        self.remaining -= data.len() as u64;
+
+        file.set_data(data);
+        self.files.push(file);
+        self.files.last_mut().unwrap()
+    }
+
+    // add_file adds the content of a file, the files which cannot be read,
+    // e.g. because the guest kernel lacks an option, are skipped.
+    fn add_file(&mut self, name: &str, path: &Path) {
+        let mut data = Vec::new();
+        let read = File::open(path).and_then(|f| {
+            f.take(self.remaining.saturating_add(1))
+                .read_to_end(&mut data)
+        });
+
+        if read.is_ok() {
+            self.add(name, data, false);
+        }
+    }
+
+    // collect_guest adds the /proc snapshots and the kernel log of the guest.
+    #[instrument]
+    pub fn collect_guest(&mut self) {
+        for f in PROC_FILES {
+            self.add_file(&format!("proc/{}", f), &Path::new("/proc").join(f));
+        }
+
+        if let Ok((data, truncated)) = read_kmsg(KMSG_PATH, KMSG_MAX_SIZE) {
+            self.add("kmsg", data, truncated);
+        }
+    }
+
+    // collect_agent_log adds the most recent logs of the agent.
+    #[instrument]
+    pub fn collect_agent_log(&mut self) {
+        let (data, dropped) = match AGENT_LOG.lock() {
+            Ok(log) => (log.0.iter().copied().collect(), log.1),
+            Err(_) => return,
+        };
+
+        self.add("agent.log", data, dropped);
+    }
+
+    // collect_processes adds the /proc snapshots of processes.
+    #[instrument]
+    pub fn collect_processes(&mut self, pids: &[i32]) {
+        for pid in pids {
+            for f in PROCESS_FILES {
+                self.add_file(
+                    &format!("processes/{}/{}", pid, f),
+                    &Path::new("/proc").join(pid.to_string()).join(f),
+                );
+            }
+        }
+    }
+
+    // collect_core_dumps adds the core dumps written in the root filesystem
+    // of a container, the most recent first. Their size is set for the ones
+    // truncated to be read by parts with read_core_dump.
+    #[instrument]
+    pub fn collect_core_dumps(&mut self, rootfs: &str) {
+        let dir = match container_core_dumps_dir(rootfs) {
+            Some(d) => d,
+            None => return,
+        };
+
+        let entries = match fs::read_dir(&dir) {
+            Ok(e) => e,
+            Err(_) => return,
+        };
+
+        let mut cores: Vec<_> = entries
+            .filter_map(|e| e.ok())
+            .filter_map(|e| {
+                // Do not follow the links of the container
+                let metadata = fs::symlink_metadata(e.path()).ok()?;
+                if !metadata.file_type().is_file() {
+                    return None;
+                }
+                Some((metadata.modified().ok()?, e))
+            })
+            .collect();
+        cores.sort_by(|a, b| b.0.cmp(&a.0));
+
+        for (_, e) in cores {
+            let name = e.file_name().to_string_lossy().to_string();
+            if let Ok((data, size)) = read_core_dump_part(&dir, &name, 0, self.remaining) {
+                let file = self.add(&format!("{}/{}", CORES_DIR, name), data, false);
+                let truncated = (file.get_data().len() as u64) < size;
+                file.set_truncated(truncated);
+                file.set_size(size);
+            }
+        }
+    }
+
+    pub fn into_diagnostics(self) -> Diagnostics {
+        let mut diagnostics = Diagnostics::new();
+        diagnostics.set_files(RepeatedField::from_vec(self.files));
+        diagnostics
+    }
+}
+
+// read_core_dump returns the data of a core dump of a container from offset,
+// up to max_size bytes, as diagnostics holding this file only.
+#[instrument]
+pub fn read_core_dump(rootfs: &str, name: &str, offset: u64, max_size: u64) -> Result<Diagnostics> {
+    let dir = container_core_dumps_dir(rootfs)
+        .ok_or_else(|| anyhow!("the core dumps of the container cannot be found"))?;
+
+    let (data, size) = read_core_dump_part(&dir, name, offset, max_size)?;
+
+    let mut file = DiagnosticsFile::new();
+    file.set_name(format!("{}/{}", CORES_DIR, name));
+    file.set_truncated(offset + (data.len() as u64) < size);
+    file.set_size(size);
+    file.set_data(data);
+
+    let mut diagnostics = Diagnostics::new();
+    diagnostics.set_files(RepeatedField::from_vec(vec![file]));
+    Ok(diagnostics)
+}
+
+// read_core_dump_part reads a core dump file of the directory from offset,
+// up to max_size bytes, and returns its size. The links and other files of
+// the container are not followed.
+fn read_core_dump_part(
+    dir: &Path,
+    name: &str,
+    offset: u64,
+    max_size: u64,
+) -> Result<(Vec<u8>, u64)> {
+    if name.is_empty() || name == "." || name == ".." || name.contains('/') {
+        return Err(anyhow!("invalid core dump name {:?}", name));
+    }
+
+    let mut f = OpenOptions::new()
+        .read(true)
+        .custom_flags(libc::O_NOFOLLOW | libc::O_NONBLOCK)
+        .open(dir.join(name))?;
+
+    let metadata = f.metadata()?;
+    if !metadata.file_type().is_file() {
+        return Err(anyhow!("core dump {:?} is not a regular file", name));
+    }
+
+    f.seek(SeekFrom::Start(offset))?;
+
+    let mut data = Vec::new();
+    f.take(max_size).read_to_end(&mut data)?;
+
+    Ok((data, metadata.len()))
+}
+
+// container_core_dumps_dir returns the directory of the core dumps of the
+// container of the root filesystem, following the core pattern of the guest.
+fn container_core_dumps_dir(rootfs: &str) -> Option<PathBuf> {
+    let pattern = fs::read_to_string(CORE_PATTERN_PATH).ok()?;
+    core_dumps_dir(pattern.trim(), rootfs)
+}
+
+// core_dumps_dir returns the directory of the core dumps of a container,
+// the kernel writing them relative to the root of the dumped processes.
+// Core dumps piped to a program, or written relative to the working
+// directory of the processes, cannot be found.
+fn core_dumps_dir(pattern: &str, rootfs: &str) -> Option<PathBuf> {
+    if !pattern.starts_with('/') {
+        return None;
+    }
+
+    let dir = Path::new(pattern).parent()?;
+    if dir.to_string_lossy().contains('%') {
+        return None;
+    }
+
+    Some(Path::new(rootfs).join(dir.strip_prefix("/").ok()?))
+}
+
+// read_kmsg returns the most recent records of the kernel log, up to
+// max_size bytes, and if older records were dropped.
+fn read_kmsg(path: &str, max_size: usize) -> std::io::Result<(Vec<u8>, bool)> {
+    let mut kmsg = OpenOptions::new()
+        .read(true)
+        .custom_flags(libc::O_NONBLOCK)
+        .open(path)?;
+
+    let mut data = Vec::new();
+    // Each read returns a record, of at most 8KB
+    let mut buf = vec![0u8; 8192];
+
+    loop {
+        match kmsg.read(&mut buf) {
+            Ok(0) => break,
+            Ok(n) => data.extend_from_slice(&buf[..n]),
+            Err(e) if e.raw_os_error() == Some(Errno::EAGAIN as i32) => break,
+            // The oldest records were overwritten while reading
+            Err(e) if e.raw_os_error() == Some(Errno::EPIPE as i32) => continue,
+            Err(e) => return Err(e),
+        }
+    }
+
+    if data.len() <= max_size {
+        return Ok((data, false));
+    }
+
+    let start = data.len() - max_size;
+    // Start at a record boundary
+    let start = match data[start..].iter().position(|&c| c == b'\n') {
+        Some(i) => start + i + 1,
+        None => data.len(),
+    };
+
+    Ok((data.split_off(start), true))
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::io::Write;
+    use tempfile::tempdir;
+
+    #[test]
+    fn test_collector_max_size() {
+        let dir = tempdir().unwrap();
+        let path = dir.path().join("file");
+        File::create(&path)
+            .unwrap()
+            .write_all(b"0123456789")
+            .unwrap();
+
+        let mut collector = Collector::new(15);
+        collector.add_file("first", &path);
+        collector.add_file("second", &path);
+        collector.add_file("third", &path);
+        collector.add_file("missing", &dir.path().join("missing"));
+
+        let files = collector.into_diagnostics().take_files().into_vec();
+        assert_eq!(files.len(), 3);
+        assert_eq!(files[0].get_data(), b"0123456789");
+        assert!(!files[0].get_truncated());
+        assert_eq!(files[1].get_data(), b"01234");
+        assert!(files[1].get_truncated());
+        assert!(files[2].get_data().is_empty());
+        assert!(files[2].get_truncated());
+    }
+
+    #[test]
+    fn test_read_core_dump_part() {
+        let dir = tempdir().unwrap();
+        File::create(dir.path().join("core.1"))
+            .unwrap()
+            .write_all(b"0123456789")
+            .unwrap();
+        std::os::unix::fs::symlink("/etc/passwd", dir.path().join("link")).unwrap();
+
+        let (data, size) = read_core_dump_part(dir.path(), "core.1", 0, 4).unwrap();
+        assert_eq!(data, b"0123");
+        assert_eq!(size, 10);
+
+        let (data, size) = read_core_dump_part(dir.path(), "core.1", 8, 4).unwrap();
+        assert_eq!(data, b"89");
+        assert_eq!(size, 10);
+
+        for name in &["", ".", "..", "../core.1", "link", "missing"] {
+            assert!(
+                read_core_dump_part(dir.path(), name, 0, 4).is_err(),
+                "{:?}",
+                name
+            );
+        }
+    }
+
+    #[test]
+    fn test_record_log() {
+        let mut log = (VecDeque::new(), false);
+
+        record_log(&mut log, b"first\nsecond\n", 16);
+        assert_eq!(
+            log.0.iter().copied().collect::<Vec<u8>>(),
+            b"first\nsecond\n"
+        );
+        assert!(!log.1);
+
+        record_log(&mut log, b"third\n", 16);
+        assert_eq!(
+            log.0.iter().copied().collect::<Vec<u8>>(),
+            b"second\nthird\n"
+        );
+        assert!(log.1);
+    }
+
+    #[test]
+    fn test_core_dumps_dir() {
+        assert_eq!(
+            core_dumps_dir("/var/crash/core.%e.%p", "/run/rootfs"),
+            Some(PathBuf::from("/run/rootfs/var/crash"))
+        );
+        assert_eq!(core_dumps_dir("core", "/run/rootfs"), None);
+        assert_eq!(core_dumps_dir("|/usr/bin/collect %p", "/run/rootfs"), None);
+        assert_eq!(core_dumps_dir("/cores/%h/core", "/run/rootfs"), None);
+    }
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+use anyhow::Result;
+use nix::errno::Errno;
+use protocols::agent::KernelLogRecord;
+use std::fs::File;
+use std::io::{ErrorKind, Read};
+use std::thread;
+use tokio::sync::mpsc::{channel, Receiver};
+
+// Convenience macro to obtain the scope logger
+macro_rules! sl {
+    () => {
+        slog_scope::logger().new(o!("subsystem" => "kernel_log"))
+    };
+}
+
+pub const KMSG_PATH: &str = "/dev/kmsg";
+
+// Number of records queued until they are read, the reader then waits and
+// the oldest records may be overwritten in the kernel log buffer.
+const KERNEL_LOG_QUEUE_SIZE: usize = 1024;
+
+// start_reader starts a thread reading the records of the kernel log, from
+// the oldest one held by the kernel log buffer.
+pub fn start_reader(path: &str) -> Result<Receiver<KernelLogRecord>> {
+    let mut kmsg = File::open(path)?;
+    let (tx, rx) = channel(KERNEL_LOG_QUEUE_SIZE);
+
+    thread::spawn(move || {
+        // Each read returns a record, of at most 8KB
+        let mut buf = vec![0u8; 8192];
+
+        loop {
+            let n = match kmsg.read(&mut buf) {
+                Ok(0) => break,
+                Ok(n) => n,
+                // The oldest records were overwritten before being read
+                Err(e) if e.raw_os_error() == Some(Errno::EPIPE as i32) => continue,
+                Err(e) if e.kind() == ErrorKind::Interrupted => continue,
+                Err(e) => {
+                    warn!(sl!(), "failed to read the kernel log: {:?}", e);
+                    break;
+                }
+            };
+
+            if let Some(record) = parse_record(&String::from_utf8_lossy(&buf[..n])) {
+                if tx.blocking_send(record).is_err() {
+                    break;
+                }
+            }
+        }
+    });
+
+    Ok(rx)
+}
+
+// parse_record parses a record of /dev/kmsg, made of a
+// "<priority>,<sequence>,<timestamp>,<flags>[,...];<message>" line, followed
+// by the dictionary of the record, which is ignored.
+fn parse_record(data: &str) -> Option<KernelLogRecord> {
+    let line = data.lines().next()?;
+    let (prefix, message) = line.split_once(';')?;
+
+    let mut fields = prefix.split(',');
+    let priority: u32 = fields.next()?.parse().ok()?;
+    let sequence: u64 = fields.next()?.parse().ok()?;
+    let timestamp_usec: u64 = fields.next()?.parse().ok()?;
+
+    let mut record = KernelLogRecord::new();
+    record.set_level(priority & 7);
+    record.set_facility(priority >> 3);
+    record.set_sequence(sequence);
+    record.set_timestamp_usec(timestamp_usec);
+    record.set_message(unescape(message));
+
+    Some(record)
+}
+
+// unescape replaces the "\xNN" escape sequences of the non printable
+// characters of the messages.
+fn unescape(message: &str) -> String {
+    let mut bytes = Vec::with_capacity(message.len());
+    let mut rest = message.as_bytes();
+
+    while let Some((&c, tail)) = rest.split_first() {
+        if c == b'\\' && tail.len() >= 3 && tail[0] == b'x' {
+            if let Some(b) = std::str::from_utf8(&tail[1..3])
+                .ok()
+                .and_then(|h| u8::from_str_radix(h, 16).ok())
+            {
+                bytes.push(b);
+                rest = &tail[3..];
+                continue;
+            }
+        }
+
+        bytes.push(c);
+        rest = tail;
+    }
+
+    String::from_utf8_lossy(&bytes).into_owned()
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn test_parse_record() {
+        let record =
+            parse_record("6,339,5140900,-;NET: Registered protocol family 10\n SUBSYSTEM=net\n")
+                .unwrap();
+        assert_eq!(record.get_level(), 6);
+        assert_eq!(record.get_facility(), 0);
+        assert_eq!(record.get_sequence(), 339);
+        assert_eq!(record.get_timestamp_usec(), 5140900);
+        assert_eq!(record.get_message(), "NET: Registered protocol family 10");
+
+        // A message of a user space process, with a tab
+        let record = parse_record("14,1000,2000,c;systemd:\\x09started\n").unwrap();
+        assert_eq!(record.get_level(), 6);
+        assert_eq!(record.get_facility(), 1);
+        assert_eq!(record.get_message(), "systemd:\tstarted");
+
+        assert!(parse_record("").is_none());
+        assert!(parse_record("no record").is_none());
+        assert!(parse_record("a,b,c,-;message").is_none());
+    }
+}
@@ -0,0 +1,512 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+use std::collections::BTreeMap;
+use std::ffi::CString;
+use std::fs;
+use std::io;
+use std::os::unix::ffi::OsStrExt;
+use std::os::unix::fs::{symlink, FileTypeExt, MetadataExt, PermissionsExt};
+use std::os::unix::io::AsRawFd;
+use std::path::{Path, PathBuf};
+
+use anyhow::{anyhow, Context, Result};
+use nix::sys::stat::{mknod, Mode, SFlag};
+use nix::unistd::{fchownat, FchownatFlags, Gid, Uid};
+use sha2::{Digest, Sha256};
+use slog::Logger;
+use tokio::sync::Mutex;
+
+// The layer cache is a block device shared by the sandboxes of a node,
+// holding copies of the image layers of their containers, so that the
+// sandboxes started later don't read them from the host again.
+pub const LAYER_CACHE_MOUNT_POINT: &str = "/run/kata-containers/sandbox/layer-cache";
+
+const LAYERS_DIR: &str = "layers";
+const TMP_SUFFIX: &str = ".kata-tmp";
+const CACHE_OPTION: &str = "cache";
+const LAYER_OPTION: &str = "layer";
+// The layers are keyed by the SHA-256 of their content, in hex
+const LAYER_KEY_LEN: usize = 64;
+
+// Overlay whiteouts are device nodes, kept as such, but the opaque
+// directories are only marked by this extended attribute.
+const OVERLAY_OPAQUE_XATTR: &str = "trusted.overlay.opaque";
+
+lazy_static! {
+    // Serializes the mount of the cache and the copies of the layers.
+    pub static ref LAYER_CACHE_LOCK: Mutex<()> = Mutex::new(());
+}
+
+#[derive(Debug, Default, PartialEq)]
+pub struct LayerCacheOptions {
+    pub writable: bool,
+    // keys and shared paths of the layers, from the top one
+    pub layers: Vec<(String, String)>,
+    // options of the overlay mount, apart from its lower directories
+    pub overlay: Vec<String>,
+}
+
+pub fn parse_options(options: &[String]) -> Result<LayerCacheOptions> {
+    let mut opts = LayerCacheOptions::default();
+
+    for option in options {
+        match option.split_once('=') {
+            Some((CACHE_OPTION, mode)) => opts.writable = mode == "rw",
+            Some((LAYER_OPTION, layer)) => {
+                let (key, path) = layer
+                    .split_once(':')
+                    .ok_or_else(|| anyhow!("invalid layer option {}", option))?;
+                if key.len() != LAYER_KEY_LEN || !key.chars().all(|c| c.is_ascii_hexdigit()) {
+                    return Err(anyhow!("invalid layer key {}", key));
+                }
+                opts.layers.push((key.to_string(), path.to_string()));
+            }
+            _ => opts.overlay.push(option.to_string()),
+        }
+    }
+
+    if opts.layers.is_empty() {
+        return Err(anyhow!("no layers given for the layer cache storage"));
+    }
+
+    Ok(opts)
+}
+
+fn overlay_opaque(path: &Path) -> Option<Vec<u8>> {
+    let path = CString::new(path.as_os_str().as_bytes()).ok()?;
+    let name = CString::new(OVERLAY_OPAQUE_XATTR).ok()?;
+    let mut value = [0u8; 8];
+
+    let size = unsafe {
+        libc::lgetxattr(
+            path.as_ptr(),
+            name.as_ptr(),
+            value.as_mut_ptr() as *mut libc::c_void,
+            value.len(),
+        )
+    };
+    if size < 0 {
+        return None;
+    }
+
+    Some(value[..size as usize].to_vec())
+}
+
+fn set_overlay_opaque(path: &Path, value: &[u8]) -> Result<()> {
+    let c_path = CString::new(path.as_os_str().as_bytes())?;
+    let name = CString::new(OVERLAY_OPAQUE_XATTR)?;
+
+    let ret = unsafe {
+        libc::lsetxattr(
+            c_path.as_ptr(),
+            name.as_ptr(),
+            value.as_ptr() as *const libc::c_void,
+            value.len(),
+            0,
+        )
+    };
+    if ret < 0 {
+        return Err(anyhow!(
+            "set {} on {:?}: {}",
+            OVERLAY_OPAQUE_XATTR,
+            path,
+            std::io::Error::last_os_error()
+        ));
+    }
+
+    Ok(())
+}
+
+fn hex(bytes: &[u8]) -> String {
+    bytes.iter().map(|b| format!("{:02x}", b)).collect()
+}
+
+fn file_digest(path: &Path) -> Result<String> {
+    let mut file = fs::File::open(path).context(format!("open {:?}", path))?;
+    let mut hasher = Sha256::new();
+    io::copy(&mut file, &mut hasher).context(format!("read {:?}", path))?;
+    Ok(hex(&hasher.finalize()))
+}
+
+// layer_digest returns the digest of the content of a layer, computed as
+// the runtime does for the key of the layer: the SHA-256 of the entries of
+// the layer, sorted by path, each described by a line
+// "path\0mode\0uid\0gid\0rdev\0size\0link\0opaque\0sha256\n".
+fn layer_digest(root: &Path) -> Result<String> {
+    fn walk(root: &Path, rel: &Path, paths: &mut BTreeMap<Vec<u8>, PathBuf>) -> Result<()> {
+        let path = root.join(rel);
+        let meta = fs::symlink_metadata(&path).context(format!("stat {:?}", path))?;
+        if meta.file_type().is_socket() {
+            return Ok(());
+        }
+
+        paths.insert(rel.as_os_str().as_bytes().to_vec(), rel.to_path_buf());
+
+        if meta.is_dir() {
+            for entry in fs::read_dir(&path)? {
+                walk(root, &rel.join(entry?.file_name()), paths)?;
+            }
+        }
+
+        Ok(())
+    }
+
+    let mut paths = BTreeMap::new();
+    walk(root, Path::new(""), &mut paths)?;
+
+    let mut hasher = Sha256::new();
+    for (rel, path) in paths {
+        let path = root.join(path);
+        let meta = fs::symlink_metadata(&path).context(format!("stat {:?}", path))?;
+        let file_type = meta.file_type();
+
+        let mut rdev = 0;
+        let mut size = 0;
+        let mut link = Vec::new();
+        let mut opaque = String::new();
+        let mut content = String::new();
+        if file_type.is_char_device() || file_type.is_block_device() {
+            rdev = meta.rdev();
+        } else if file_type.is_symlink() {
+            link = fs::read_link(&path)?.as_os_str().as_bytes().to_vec();
+        } else if file_type.is_dir() {
+            if let Some(value) = overlay_opaque(&path) {
+                opaque = hex(&value);
+            }
+        } else if file_type.is_file() {
+            size = meta.len();
+            content = file_digest(&path)?;
+        }
+
+        hasher.update(&rel);
+        hasher.update(format!(
+            "\0{}\0{}\0{}\0{}\0{}\0",
+            meta.mode(),
+            meta.uid(),
+            meta.gid(),
+            rdev,
+            size
+        ));
+        hasher.update(&link);
+        hasher.update(format!("\0{}\0{}\n", opaque, content));
+    }
+
+    Ok(hex(&hasher.finalize()))
+}
+
+// copy_entry copies a file system tree, keeping the ownership, permissions
+// and overlay whiteouts of its entries.
+fn copy_entry(src: &Path, dst: &Path) -> Result<()> {
+    let meta = fs::symlink_metadata(src).context(format!("stat {:?}", src))?;
+    let file_type = meta.file_type();
+
+    if file_type.is_dir() {
+        fs::create_dir(dst).context(format!("create {:?}", dst))?;
+        for entry in fs::read_dir(src)? {
+            let entry = entry?;
+            copy_entry(&entry.path(), &dst.join(entry.file_name()))?;
+        }
+    } else if file_type.is_file() {
+        fs::copy(src, dst).context(format!("copy {:?}", src))?;
+    } else if file_type.is_symlink() {
+        symlink(fs::read_link(src)?, dst).context(format!("create {:?}", dst))?;
+    } else if file_type.is_char_device() || file_type.is_block_device() || file_type.is_fifo() {
+        mknod(
+            dst,
+            SFlag::from_bits_truncate(meta.mode() & libc::S_IFMT),
+            Mode::from_bits_truncate(meta.mode()),
+            meta.rdev(),
+        )
+        .context(format!("create {:?}", dst))?;
+    } else {
+        // Sockets are not part of image layers
+        return Ok(());
+    }
+
+    fchownat(
+        None,
+        dst,
+        Some(Uid::from_raw(meta.uid())),
+        Some(Gid::from_raw(meta.gid())),
+        FchownatFlags::NoFollowSymlink,
+    )
+    .context(format!("chown {:?}", dst))?;
+
+    if !file_type.is_symlink() {
+        // Set once owned, chown clearing the setuid and setgid bits
+        fs::set_permissions(dst, fs::Permissions::from_mode(meta.mode() & 0o7777))?;
+    }
+    if file_type.is_dir() {
+        if let Some(value) = overlay_opaque(src) {
+            set_overlay_opaque(dst, &value)?;
+        }
+    }
+
+    Ok(())
+}
+
+fn sync_fs(path: &Path) -> Result<()> {
+    let dir = fs::File::open(path)?;
+    if unsafe { libc::syncfs(dir.as_raw_fd()) } < 0 {
+        return Err(anyhow!(
+            "sync {:?}: {}",
+            path,
+            std::io::Error::last_os_error()
+        ));
+    }
+    Ok(())
+}
+
+// cache_layer copies a layer to the cache. The copy is only moved to its
+// final place once its digest is verified to be the key of the layer and
+// it is written to the device, so that the layers found in the cache are
+// always complete.
+fn cache_layer(cache_dir: &Path, key: &str, src: &Path) -> Result<PathBuf> {
+    let layers = cache_dir.join(LAYERS_DIR);
+    let dst = layers.join(key);
+    let tmp = layers.join(format!("{}{}", key, TMP_SUFFIX));
+
+    fs::create_dir_all(&layers)?;
+    // Left over by a sandbox which stopped while copying the layer
+    if tmp.exists() {
+        fs::remove_dir_all(&tmp)?;
+    }
+
+    let res = copy_entry(src, &tmp).and_then(|_| {
+        let digest = layer_digest(&tmp)?;
+        if digest != key {
+            return Err(anyhow!(
+                "copy of layer {:?} has digest {}, not {}",
+                src,
+                digest,
+                key
+            ));
+        }
+        sync_fs(&tmp)?;
+        fs::rename(&tmp, &dst)?;
+        sync_fs(&layers)
+    });
+    if let Err(e) = res {
+        let _ = fs::remove_dir_all(&tmp);
+        return Err(e);
+    }
+
+    Ok(dst)
+}
+
+// lower_dirs returns the lower directories of the overlay of the layers,
+// from the top one. The layers held by the cache are taken from it, once
+// their digest is verified, as the cache is written by other sandboxes.
+// The other ones are copied to the cache when it is writable, and read
+// from the shared directory otherwise.
+pub fn lower_dirs(logger: &Logger, cache_dir: &Path, opts: &LayerCacheOptions) -> Vec<String> {
+    let mut hits = 0;
+    let mut lowers = Vec::new();
+
+    for (key, path) in &opts.layers {
+        let cached = cache_dir.join(LAYERS_DIR).join(key);
+        if cached.is_dir() {
+            match layer_digest(&cached) {
+                Ok(digest) if &digest == key => {
+                    hits += 1;
+                    lowers.push(cached.display().to_string());
+                    continue;
+                }
+                res => {
+                    warn!(logger, "cached layer does not match its digest";
+                        "layer" => key, "digest" => format!("{:?}", res));
+                    if opts.writable {
+                        if let Err(e) = fs::remove_dir_all(&cached) {
+                            warn!(logger, "failed to remove cached layer";
+                                "layer" => key, "error" => format!("{:?}", e));
+                        }
+                    }
+                }
+            }
+        }
+
+        if opts.writable {
+            match cache_layer(cache_dir, key, Path::new(path)) {
+                Ok(cached) => {
+                    lowers.push(cached.display().to_string());
+                    continue;
+                }
+                Err(e) => {
+                    warn!(logger, "failed to cache layer";
+                        "layer" => path, "error" => format!("{:?}", e));
+                }
+            }
+        }
+
+        lowers.push(path.to_string());
+    }
+
+    info!(logger, "layer cache lookup";
+        "layers" => opts.layers.len(), "hits" => hits, "writable" => opts.writable);
+
+    lowers
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use crate::skip_if_not_root;
+    use tempfile::tempdir;
+
+    const KEY_0: &str = "0000000000000000000000000000000000000000000000000000000000000000";
+    const KEY_1: &str = "1111111111111111111111111111111111111111111111111111111111111111";
+
+    // The layer of the runtime layer digest test, of the same digest
+    fn create_test_layer(layer: &Path) {
+        fs::create_dir_all(layer.join("d")).unwrap();
+        fs::write(layer.join("a"), "kata").unwrap();
+        fs::set_permissions(layer.join("a"), fs::Permissions::from_mode(0o644)).unwrap();
+        symlink("../a", layer.join("d/l")).unwrap();
+        fs::set_permissions(layer, fs::Permissions::from_mode(0o755)).unwrap();
+        fs::set_permissions(layer.join("d"), fs::Permissions::from_mode(0o755)).unwrap();
+    }
+
+    const TEST_LAYER_DIGEST: &str =
+        "4505fcc75992a207eee73b9647285f48013fab9e08e278cb2c3aec3cefcdc5db";
+
+    #[test]
+    fn test_parse_options() {
+        let options: Vec<String> = vec![
+            "cache=rw",
+            "layer=0000000000000000000000000000000000000000000000000000000000000000:/run/kata-containers/shared/containers/c/layers/0",
+            "layer=1111111111111111111111111111111111111111111111111111111111111111:/run/kata-containers/shared/containers/c/layers/1",
+            "upperdir=/run/kata-containers/shared/containers/c/snapshotdir/fs",
+            "index=off",
+        ]
+        .iter()
+        .map(|s| s.to_string())
+        .collect();
+
+        let opts = parse_options(&options).unwrap();
+        assert!(opts.writable);
+        assert_eq!(
+            opts.layers,
+            vec![
+                (
+                    KEY_0.to_string(),
+                    "/run/kata-containers/shared/containers/c/layers/0".to_string()
+                ),
+                (
+                    KEY_1.to_string(),
+                    "/run/kata-containers/shared/containers/c/layers/1".to_string()
+                ),
+            ]
+        );
+        assert_eq!(
+            opts.overlay,
+            vec![
+                "upperdir=/run/kata-containers/shared/containers/c/snapshotdir/fs".to_string(),
+                "index=off".to_string(),
+            ]
+        );
+
+        let opts = parse_options(&["cache=ro".to_string(), format!("layer={}:/l", KEY_0)]).unwrap();
+        assert!(!opts.writable);
+
+        assert!(parse_options(&["cache=rw".to_string()]).is_err());
+        assert!(parse_options(&["layer=/l".to_string()]).is_err());
+        assert!(parse_options(&["layer=../x:/l".to_string()]).is_err());
+        assert!(parse_options(&["layer=ab01:/l".to_string()]).is_err());
+    }
+
+    #[test]
+    fn test_layer_digest() {
+        skip_if_not_root!();
+
+        let dir = tempdir().unwrap();
+        let layer = dir.path().join("layer");
+        create_test_layer(&layer);
+
+        assert_eq!(layer_digest(&layer).unwrap(), TEST_LAYER_DIGEST);
+
+        // The content of the files is part of the digest
+        fs::write(layer.join("a"), "KATA").unwrap();
+        assert_ne!(layer_digest(&layer).unwrap(), TEST_LAYER_DIGEST);
+
+        assert!(layer_digest(&layer.join("missing")).is_err());
+    }
+
+    #[test]
+    fn test_lower_dirs() {
+        skip_if_not_root!();
+
+        let logger = slog::Logger::root(slog::Discard, o!());
+        let dir = tempdir().unwrap();
+        let cache_dir = dir.path().join("cache");
+        fs::create_dir(&cache_dir).unwrap();
+
+        let layer = dir.path().join("layer");
+        create_test_layer(&layer);
+
+        let mut opts = LayerCacheOptions {
+            writable: false,
+            layers: vec![(TEST_LAYER_DIGEST.to_string(), layer.display().to_string())],
+            overlay: vec![],
+        };
+
+        // Read-only cache missing the layer
+        assert_eq!(
+            lower_dirs(&logger, &cache_dir, &opts),
+            vec![layer.display().to_string()]
+        );
+        assert!(!cache_dir.join(LAYERS_DIR).exists());
+
+        // Writable cache, the layer is copied
+        opts.writable = true;
+        let cached = cache_dir.join(LAYERS_DIR).join(TEST_LAYER_DIGEST);
+        assert_eq!(
+            lower_dirs(&logger, &cache_dir, &opts),
+            vec![cached.display().to_string()]
+        );
+        assert_eq!(fs::read(cached.join("a")).unwrap(), b"kata");
+        assert_eq!(
+            fs::read_link(cached.join("d/l")).unwrap(),
+            Path::new("../a")
+        );
+        assert!(!cache_dir
+            .join(LAYERS_DIR)
+            .join(format!("{}{}", TEST_LAYER_DIGEST, TMP_SUFFIX))
+            .exists());
+
+        // Cached layer, even if the shared one is gone
+        opts.writable = false;
+        let shared = dir.path().join("shared");
+        fs::rename(&layer, &shared).unwrap();
+        assert_eq!(
+            lower_dirs(&logger, &cache_dir, &opts),
+            vec![cached.display().to_string()]
+        );
+        fs::rename(&shared, &layer).unwrap();
+
+        // Tampered cached layer, read from the shared directory
+        fs::write(cached.join("a"), "evil").unwrap();
+        assert_eq!(
+            lower_dirs(&logger, &cache_dir, &opts),
+            vec![layer.display().to_string()]
+        );
+
+        // and copied again to a writable cache
+        opts.writable = true;
+        assert_eq!(
+            lower_dirs(&logger, &cache_dir, &opts),
+            vec![cached.display().to_string()]
+        );
+        assert_eq!(fs::read(cached.join("a")).unwrap(), b"kata");
+
+        // A layer not matching its key is not cached
+        opts.layers = vec![(KEY_0.to_string(), layer.display().to_string())];
+        assert_eq!(
+            lower_dirs(&logger, &cache_dir, &opts),
+            vec![layer.display().to_string()]
+        );
+        assert!(!cache_dir.join(LAYERS_DIR).join(KEY_0).exists());
+    }
+}
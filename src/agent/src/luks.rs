@@ -0,0 +1,244 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+use std::collections::hash_map::DefaultHasher;
+use std::fs::File;
+use std::hash::{Hash, Hasher};
+use std::io::{Read, Seek, SeekFrom, Write};
+use std::path::Path;
+use std::process::{Command, Output, Stdio};
+
+use anyhow::{anyhow, Context, Result};
+use slog::Logger;
+
+pub const ENCRYPTION_TYPE_LUKS: &str = "luks";
+
+const DEV_MAPPER_DIR: &str = "/dev/mapper";
+const LUKS_MAPPER_PREFIX: &str = "kata-luks-";
+
+// Size of the areas at the start and at the end of a device which must be
+// zeroed for the device to be formatted, covering the LUKS headers and the
+// signatures of the filesystems, partition tables and RAID superblocks.
+const BLANK_CHECK_SIZE: u64 = 1 << 20;
+
+// mapper_name returns the name of the device mapper device decrypting the
+// storage mounted on mount_point, so that it can be found again when the
+// storage is removed.
+fn mapper_name(mount_point: &str) -> String {
+    let mut hasher = DefaultHasher::new();
+    mount_point.hash(&mut hasher);
+    format!("{}{:016x}", LUKS_MAPPER_PREFIX, hasher.finish())
+}
+
+// cryptsetup runs cryptsetup with the given arguments, writing the key, if
+// any, to its standard input.
+fn cryptsetup(args: &[&str], key: Option<&[u8]>) -> Result<Output> {
+    let mut child = Command::new("cryptsetup")
+        .args(args)
+        .stdin(Stdio::piped())
+        .stdout(Stdio::piped())
+        .stderr(Stdio::piped())
+        .spawn()
+        .context("run cryptsetup")?;
+
+    let mut stdin = child
+        .stdin
+        .take()
+        .ok_or_else(|| anyhow!("failed to open cryptsetup stdin"))?;
+    if let Some(key) = key {
+        stdin.write_all(key).context("write key to cryptsetup")?;
+    }
+    drop(stdin);
+
+    child.wait_with_output().context("wait for cryptsetup")
+}
+
+// is_blank tells whether a device holds no data, its first and last
+// BLANK_CHECK_SIZE bytes being zeroes.
+fn is_blank(device: &str) -> Result<bool> {
+    let mut file = File::open(device).context(format!("open {}", device))?;
+    let size = file.seek(SeekFrom::End(0))?;
+    let mut buf = vec![0u8; BLANK_CHECK_SIZE as usize];
+
+    for offset in &[0, size.saturating_sub(BLANK_CHECK_SIZE)] {
+        let len = std::cmp::min(BLANK_CHECK_SIZE, size - offset) as usize;
+        file.seek(SeekFrom::Start(*offset))?;
+        file.read_exact(&mut buf[..len])
+            .context(format!("read {}", device))?;
+        if buf[..len].iter().any(|b| *b != 0) {
+            return Ok(false);
+        }
+    }
+
+    Ok(true)
+}
+
+fn check_output(output: Output, op: &str, device: &str) -> Result<()> {
+    if !output.status.success() {
+        return Err(anyhow!(
+            "cryptsetup {} {} failed: {}",
+            op,
+            device,
+            String::from_utf8_lossy(&output.stderr).trim()
+        ));
+    }
+    Ok(())
+}
+
+// open_luks_device opens the LUKS encrypted device for the storage mounted
+// on mount_point, and returns the path of the decrypted device. A blank
+// device is formatted with LUKS first, and a fs_type filesystem is created
+// on it. Devices holding other data are never formatted, so that a wrong
+// key or a damaged header doesn't destroy their content.
+pub fn open_luks_device(
+    logger: &Logger,
+    device: &str,
+    fs_type: &str,
+    mount_point: &str,
+    read_only: bool,
+    key: &[u8],
+) -> Result<String> {
+    let mut formatted = false;
+
+    if !cryptsetup(&["isLuks", device], None)?.status.success() {
+        if read_only {
+            return Err(anyhow!("read-only device {} is not LUKS encrypted", device));
+        }
+        if !is_blank(device)? {
+            return Err(anyhow!(
+                "device {} is neither LUKS encrypted nor blank, refusing to format it",
+                device
+            ));
+        }
+
+        info!(logger, "formatting device with LUKS"; "device" => device);
+        check_output(
+            cryptsetup(
+                &[
+                    "luksFormat",
+                    "--type",
+                    "luks2",
+                    "--batch-mode",
+                    "--key-file",
+                    "-",
+                    device,
+                ],
+                Some(key),
+            )?,
+            "luksFormat",
+            device,
+        )?;
+        formatted = true;
+    }
+
+    let name = mapper_name(mount_point);
+    let mut args = vec!["open", "--type", "luks", "--key-file", "-"];
+    if read_only {
+        args.push("--readonly");
+    }
+    args.push(device);
+    args.push(&name);
+    check_output(cryptsetup(&args, Some(key))?, "open", device)?;
+
+    let decrypted = format!("{}/{}", DEV_MAPPER_DIR, name);
+
+    if formatted {
+        let mkfs = format!("mkfs.{}", fs_type);
+        let res = Command::new(&mkfs)
+            .arg(&decrypted)
+            .output()
+            .context(format!("run {}", mkfs))
+            .and_then(|output| {
+                if output.status.success() {
+                    Ok(())
+                } else {
+                    Err(anyhow!(
+                        "{} {} failed: {}",
+                        mkfs,
+                        decrypted,
+                        String::from_utf8_lossy(&output.stderr).trim()
+                    ))
+                }
+            });
+        if let Err(e) = res {
+            let _ = close_luks_device(mount_point);
+            return Err(e);
+        }
+    }
+
+    Ok(decrypted)
+}
+
+// close_luks_device closes the decrypted device of the storage mounted on
+// mount_point, if any.
+pub fn close_luks_device(mount_point: &str) -> Result<()> {
+    let name = mapper_name(mount_point);
+    if !Path::new(DEV_MAPPER_DIR).join(&name).exists() {
+        return Ok(());
+    }
+
+    check_output(cryptsetup(&["close", &name], None)?, "close", &name)
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn test_mapper_name() {
+        let name = mapper_name("/run/kata-containers/sandbox/storage/data");
+
+        assert!(name.starts_with(LUKS_MAPPER_PREFIX));
+        assert_eq!(
+            name,
+            mapper_name("/run/kata-containers/sandbox/storage/data")
+        );
+        assert_ne!(
+            name,
+            mapper_name("/run/kata-containers/sandbox/storage/logs")
+        );
+        // Device mapper names are limited to 127 characters
+        assert!(name.len() < 128);
+    }
+
+    #[test]
+    fn test_is_blank() {
+        let dir = tempfile::tempdir().unwrap();
+        let device = dir.path().join("device");
+        let device = device.to_str().unwrap();
+
+        let file = File::create(device).unwrap();
+        file.set_len(4 * BLANK_CHECK_SIZE).unwrap();
+        assert!(is_blank(device).unwrap());
+
+        // Data past the checked areas is not looked for
+        let mut file = std::fs::OpenOptions::new()
+            .write(true)
+            .open(device)
+            .unwrap();
+        file.seek(SeekFrom::Start(2 * BLANK_CHECK_SIZE)).unwrap();
+        file.write_all(b"data").unwrap();
+        assert!(is_blank(device).unwrap());
+
+        // A signature at the end of the device
+        file.seek(SeekFrom::End(-4)).unwrap();
+        file.write_all(b"data").unwrap();
+        assert!(!is_blank(device).unwrap());
+
+        // Devices smaller than the checked areas
+        let file = File::create(device).unwrap();
+        file.set_len(512).unwrap();
+        assert!(is_blank(device).unwrap());
+        std::fs::write(device, b"\0\0ext4").unwrap();
+        assert!(!is_blank(device).unwrap());
+
+        assert!(is_blank(&format!("{}.missing", device)).is_err());
+    }
+
+    #[test]
+    fn test_close_luks_device_not_opened() {
+        assert!(close_luks_device("/run/kata-containers/sandbox/storage/none").is_ok());
+    }
+}
@@ -40,18 +40,24 @@ mod ccw;
 mod config;
 mod console;
 mod device;
+mod diagnostics;
+mod kernel_log;
+mod layer_cache;
 mod linux_abi;
+mod luks;
 mod metrics;
 mod mount;
 mod namespace;
 mod netlink;
 mod network;
 mod pci;
+mod process_io;
 pub mod random;
 mod sandbox;
 mod signal;
 #[cfg(test)]
 mod test_utils;
+mod time_source;
 mod uevent;
 mod util;
 mod version;
@@ -77,7 +83,7 @@ use tokio::{
 mod rpc;
 mod tracer;
 
-const NAME: &str = "kata-agent";
+pub const NAME: &str = "kata-agent";
 
 lazy_static! {
     static ref AGENT_CONFIG: Arc<RwLock<AgentConfig>> = Arc::new(RwLock::new(
@@ -195,11 +201,12 @@ async fn real_main() -> std::result::Result<(), Box<dyn std::error::Error>> {
 
     tasks.push(log_handle);
 
-    let writer = unsafe { File::from_raw_fd(wfd) };
+    // The most recent logs are kept for the diagnostics
+    let writer = diagnostics::LogRecorder::new(unsafe { File::from_raw_fd(wfd) });
 
     // Recreate a logger with the log level get from "/proc/cmdline".
-    let (logger, logger_async_guard) =
-        logging::create_logger(NAME, "agent", config.log_level, writer);
+    let (logger, logger_async_guard, log_level) =
+        logging::create_logger_with_level_handle(NAME, "agent", config.log_level, writer);
 
     announce(&logger, &config);
 
@@ -229,7 +236,15 @@ async fn real_main() -> std::result::Result<(), Box<dyn std::error::Error>> {
     let span_guard = root_span.enter();
 
     // Start the sandbox and wait for its ttRPC server to end
-    start_sandbox(&logger, &config, init_mode, &mut tasks, shutdown_rx.clone()).await?;
+    start_sandbox(
+        &logger,
+        &config,
+        init_mode,
+        &mut tasks,
+        shutdown_rx.clone(),
+        log_level,
+    )
+    .await?;
 
     // Install a NOP logger for the remainder of the shutdown sequence
     // to ensure any log calls made by local crates using the scope logger
@@ -254,9 +269,8 @@ async fn real_main() -> std::result::Result<(), Box<dyn std::error::Error>> {
     drop(span_guard);
     drop(root_span);
 
-    if config.tracing {
-        tracer::end_tracing();
-    }
+    // tracing may also have been started by the runtime
+    tracer::end_tracing();
 
     eprintln!("{} shutdown complete", NAME);
 
@@ -309,6 +323,7 @@ async fn start_sandbox(
     init_mode: bool,
     tasks: &mut Vec<JoinHandle<Result<()>>>,
     shutdown: Receiver<bool>,
+    log_level: logging::LogLevelHandle,
 ) -> Result<()> {
     let debug_console_vport = config.debug_console_vport as u32;
 
@@ -346,7 +361,7 @@ async fn start_sandbox(
     sandbox.lock().await.sender = Some(tx);
 
     // vsock:///dev/vsock, port
-    let mut server = rpc::start(sandbox.clone(), config.server_addr.as_str())?;
+    let mut server = rpc::start(sandbox.clone(), config.server_addr.as_str(), log_level)?;
     server.start().await?;
 
     rx.await?;
@@ -5,15 +5,32 @@
 
 extern crate procfs;
 
-use prometheus::{Encoder, Gauge, GaugeVec, IntCounter, TextEncoder};
+use prometheus::{Encoder, Gauge, GaugeVec, HistogramVec, IntCounter, TextEncoder};
 
 use anyhow::Result;
 use slog::warn;
+use std::collections::HashSet;
+use std::path::PathBuf;
+use std::sync::Mutex;
+use std::time::{Duration, Instant};
 use tracing::instrument;
 
 const NAMESPACE_KATA_AGENT: &str = "kata_agent";
 const NAMESPACE_KATA_GUEST: &str = "kata_guest";
 
+// The shared filesystems served by a virtiofsd daemon on the host.
+const SHARED_FS_TYPE: &str = "virtiofs";
+
+// A probe of a stalled shared filesystem must not block the scrape.
+const SHARED_FS_PROBE_TIMEOUT: Duration = Duration::from_secs(1);
+
+lazy_static! {
+    // The mount points of the shared filesystems being probed. A probe
+    // blocked on a stalled filesystem is not started again until it returns,
+    // so that the blocked threads are bounded by the filesystems.
+    static ref SHARED_FS_PROBES: Mutex<HashSet<PathBuf>> = Mutex::new(HashSet::new());
+}
+
 // Convenience macro to obtain the scope logger
 macro_rules! sl {
     () => {
@@ -68,10 +85,13 @@ lazy_static! {
 
     static ref     GUEST_MEMINFO: GaugeVec =
     prometheus::register_gauge_vec!(format!("{}_{}",NAMESPACE_KATA_GUEST,"meminfo") , "Statistics about memory usage in the system.", &["item"]).unwrap();
+
+    static ref     GUEST_SHARED_FS_LATENCY: HistogramVec =
+    prometheus::register_histogram_vec!(format!("{}_{}",NAMESPACE_KATA_GUEST,"shared_fs_latency_seconds") , "Latency of the requests probing the shared filesystems.", &["tag"], prometheus::exponential_buckets(0.0001, 2.0, 14).unwrap()).unwrap();
 }
 
 #[instrument]
-pub fn get_metrics(_: &protocols::agent::GetMetricsRequest) -> Result<String> {
+pub async fn get_metrics(_: &protocols::agent::GetMetricsRequest) -> Result<String> {
     AGENT_SCRAPE_COUNT.inc();
 
     // update agent process metrics
@@ -80,6 +100,9 @@ pub fn get_metrics(_: &protocols::agent::GetMetricsRequest) -> Result<String> {
     // update guest os metrics
     update_guest_metrics();
 
+    // probe the latency of the shared filesystems
+    update_shared_fs_metrics().await;
+
     // gather all metrics and return as a String
     let metric_families = prometheus::gather();
 
@@ -226,6 +249,75 @@ fn update_guest_metrics() {
     }
 }
 
+// update_shared_fs_metrics times a statfs request on each shared filesystem,
+// served by its daemon on the host, so that a virtiofsd falling behind shows
+// in the metrics. The filesystems are probed concurrently, for the scrape to
+// last SHARED_FS_PROBE_TIMEOUT at most.
+#[instrument]
+async fn update_shared_fs_metrics() {
+    let mounts = match procfs::process::Process::myself().and_then(|p| p.mountinfo()) {
+        Err(err) => {
+            info!(sl!(), "failed to get guest mountinfo: {:?}", err);
+            return;
+        }
+        Ok(mounts) => mounts,
+    };
+
+    // skip the bind mounts of the containers, probing each share once
+    let probes = mounts
+        .into_iter()
+        .filter(|m| m.fs_type == SHARED_FS_TYPE && m.root == "/")
+        .map(|m| async move {
+            let tag = m.mount_source.unwrap_or_default();
+            let latency = probe_shared_fs(m.mount_point).await;
+            (tag, latency)
+        });
+
+    for (tag, latency) in futures::future::join_all(probes).await {
+        let latency = match latency {
+            Some(Err(err)) => {
+                info!(
+                    sl!(),
+                    "failed to probe shared filesystem {}: {:?}", tag, err
+                );
+                continue;
+            }
+            Some(Ok(latency)) => latency,
+            None => {
+                warn!(sl!(), "shared filesystem {} did not answer in time", tag);
+                SHARED_FS_PROBE_TIMEOUT
+            }
+        };
+
+        GUEST_SHARED_FS_LATENCY
+            .with_label_values(&[tag.as_str()])
+            .observe(latency.as_secs_f64());
+    }
+}
+
+// probe_shared_fs returns the latency of a statfs request on the shared
+// filesystem mounted on mount_point, or None when it did not answer within
+// SHARED_FS_PROBE_TIMEOUT, or did not answer the previous probe yet.
+async fn probe_shared_fs(mount_point: PathBuf) -> Option<nix::Result<Duration>> {
+    if !SHARED_FS_PROBES.lock().unwrap().insert(mount_point.clone()) {
+        return None;
+    }
+
+    let start = Instant::now();
+    let probe = tokio::task::spawn_blocking(move || {
+        let res = nix::sys::statfs::statfs(&mount_point);
+        SHARED_FS_PROBES.lock().unwrap().remove(&mount_point);
+        res
+    });
+
+    match tokio::time::timeout(SHARED_FS_PROBE_TIMEOUT, probe).await {
+        Ok(Ok(Ok(_))) => Some(Ok(start.elapsed())),
+        Ok(Ok(Err(err))) => Some(Err(err)),
+        // timed out, the probe keeps waiting for the filesystem
+        _ => None,
+    }
+}
+
 #[instrument]
 fn set_gauge_vec_meminfo(gv: &prometheus::GaugeVec, meminfo: &procfs::Meminfo) {
     gv.with_label_values(&["mem_total"])
@@ -10,8 +10,10 @@ use std::io::{BufRead, BufReader, Write};
 use std::iter;
 use std::os::unix::fs::{MetadataExt, PermissionsExt};
 use std::path::Path;
+use std::process::Command;
 use std::str::FromStr;
 use std::sync::Arc;
+use std::time::Duration;
 
 use tokio::sync::Mutex;
 
@@ -21,12 +23,16 @@ use nix::unistd::{Gid, Uid};
 use regex::Regex;
 
 use crate::device::{
-    get_scsi_device_name, get_virtio_blk_pci_device_name, online_device, wait_for_pmem_device,
-    DRIVER_9P_TYPE, DRIVER_BLK_CCW_TYPE, DRIVER_BLK_TYPE, DRIVER_EPHEMERAL_TYPE, DRIVER_LOCAL_TYPE,
-    DRIVER_MMIO_BLK_TYPE, DRIVER_NVDIMM_TYPE, DRIVER_OVERLAYFS_TYPE, DRIVER_SCSI_TYPE,
-    DRIVER_VIRTIOFS_TYPE, DRIVER_WATCHABLE_BIND_TYPE, FS_TYPE_HUGETLB,
+    get_scsi_device_name, get_virtio_blk_pci_device_name, get_virtio_pmem_pci_device_name,
+    online_device, wait_for_pmem_device, DRIVER_9P_TYPE, DRIVER_BLK_CCW_TYPE, DRIVER_BLK_TYPE,
+    DRIVER_EPHEMERAL_TYPE, DRIVER_LAYER_CACHE_TYPE, DRIVER_LOCAL_TYPE, DRIVER_MMIO_BLK_TYPE,
+    DRIVER_NETFS_TYPE, DRIVER_NVDIMM_TYPE, DRIVER_OVERLAYFS_TYPE, DRIVER_SCSI_TYPE,
+    DRIVER_VIRTIOFS_TYPE, DRIVER_VIRTIO_PMEM_TYPE, DRIVER_WATCHABLE_BIND_TYPE,
+    DRIVER_WATCHABLE_SYNC_TYPE, FS_TYPE_HUGETLB,
 };
+use crate::layer_cache::{self, LAYER_CACHE_LOCK, LAYER_CACHE_MOUNT_POINT};
 use crate::linux_abi::*;
+use crate::luks::{close_luks_device, open_luks_device, ENCRYPTION_TYPE_LUKS};
 use crate::pci;
 use crate::protocols::agent::Storage;
 use crate::protocols::types::FSGroupChangePolicy;
@@ -49,6 +55,24 @@ const RO_MASK: u32 = 0o440;
 const EXEC_MASK: u32 = 0o110;
 const MODE_SETGID: u32 = 0o2000;
 
+// Time given to the guest kernel to report the new size of a resized device
+const BLOCK_RESIZE_RETRIES: u32 = 50;
+const BLOCK_RESIZE_RETRY_INTERVAL: Duration = Duration::from_millis(100);
+
+const REDACTED: &str = "<redacted>";
+
+// Mount options holding the credentials of SMB shares, as accepted by
+// mount.cifs
+const CREDENTIAL_OPTIONS: &[&str] = &[
+    "user",
+    "username",
+    "pass",
+    "password",
+    "password2",
+    "dom",
+    "domain",
+];
+
 #[rustfmt::skip]
 lazy_static! {
     pub static ref FLAGS: HashMap<&'static str, (bool, MsFlags)> = {
@@ -141,10 +165,14 @@ pub const STORAGE_HANDLER_LIST: &[&str] = &[
     DRIVER_LOCAL_TYPE,
     DRIVER_SCSI_TYPE,
     DRIVER_NVDIMM_TYPE,
+    DRIVER_VIRTIO_PMEM_TYPE,
     DRIVER_WATCHABLE_BIND_TYPE,
+    DRIVER_WATCHABLE_SYNC_TYPE,
+    DRIVER_LAYER_CACHE_TYPE,
+    DRIVER_NETFS_TYPE,
 ];
 
-#[instrument]
+#[instrument(skip(options), fields(options = %redact_options(options)))]
 pub fn baremount(
     source: &Path,
     destination: &Path,
@@ -173,7 +201,7 @@ pub fn baremount(
         source,
         destination,
         fs_type,
-        options
+        redact_options(options)
     );
 
     nix::mount::mount(
@@ -193,7 +221,7 @@ pub fn baremount(
     })
 }
 
-#[instrument]
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 async fn ephemeral_storage_handler(
     logger: &Logger,
     storage: &Storage,
@@ -239,7 +267,7 @@ async fn ephemeral_storage_handler(
     Ok("".to_string())
 }
 
-#[instrument]
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 async fn overlayfs_storage_handler(
     logger: &Logger,
     storage: &Storage,
@@ -248,7 +276,48 @@ async fn overlayfs_storage_handler(
     common_storage_handler(logger, storage)
 }
 
-#[instrument]
+// layer_cache_storage_handler mounts a container rootfs as an overlay of its
+// image layers, taken from the layer cache when it holds them. The cache is
+// mounted when first used by the sandbox.
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
+async fn layer_cache_storage_handler(
+    logger: &Logger,
+    storage: &Storage,
+    sandbox: Arc<Mutex<Sandbox>>,
+) -> Result<String> {
+    let opts = layer_cache::parse_options(&storage.options)?;
+
+    let _guard = LAYER_CACHE_LOCK.lock().await;
+
+    if !is_mounted(LAYER_CACHE_MOUNT_POINT)? {
+        let pcipath = pci::Path::from_str(&storage.source)?;
+        let mut cache = Storage::new();
+        cache.source = get_virtio_blk_pci_device_name(&sandbox, &pcipath).await?;
+        cache.mount_point = LAYER_CACHE_MOUNT_POINT.to_string();
+        cache.fstype = "ext4".to_string();
+        cache.options = if opts.writable {
+            vec!["rw".to_string()]
+        } else {
+            // The journal can't be replayed from a read-only device
+            vec!["ro".to_string(), "noload".to_string()]
+        }
+        .into();
+        mount_storage(logger, &cache).context("mount layer cache")?;
+    }
+
+    let lowers = layer_cache::lower_dirs(logger, Path::new(LAYER_CACHE_MOUNT_POINT), &opts);
+
+    let mut options = opts.overlay;
+    options.push(format!("lowerdir={}", lowers.join(":")));
+
+    let mut overlay = storage.clone();
+    overlay.source = "overlay".to_string();
+    overlay.options = options.into();
+
+    common_storage_handler(logger, &overlay)
+}
+
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 async fn local_storage_handler(
     _logger: &Logger,
     storage: &Storage,
@@ -288,7 +357,7 @@ async fn local_storage_handler(
     Ok("".to_string())
 }
 
-#[instrument]
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 async fn virtio9p_storage_handler(
     logger: &Logger,
     storage: &Storage,
@@ -297,7 +366,7 @@ async fn virtio9p_storage_handler(
     common_storage_handler(logger, storage)
 }
 
-#[instrument]
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 async fn handle_hugetlbfs_storage(logger: &Logger, storage: &Storage) -> Result<String> {
     info!(logger, "handle hugetlbfs storage");
     // Allocate hugepages before mount
@@ -402,7 +471,7 @@ fn get_pagesize_and_size_from_option(options: &[String]) -> Result<(u64, u64)> {
 }
 
 // virtiommio_blk_storage_handler handles the storage for mmio blk driver.
-#[instrument]
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 async fn virtiommio_blk_storage_handler(
     logger: &Logger,
     storage: &Storage,
@@ -413,7 +482,7 @@ async fn virtiommio_blk_storage_handler(
 }
 
 // virtiofs_storage_handler handles the storage for virtio-fs.
-#[instrument]
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 async fn virtiofs_storage_handler(
     logger: &Logger,
     storage: &Storage,
@@ -422,8 +491,19 @@ async fn virtiofs_storage_handler(
     common_storage_handler(logger, storage)
 }
 
+// netfs_storage_handler handles the storage for NFS and SMB volumes, mounted
+// over the pod network. The runtime provides the address of their server.
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
+async fn netfs_storage_handler(
+    logger: &Logger,
+    storage: &Storage,
+    _sandbox: Arc<Mutex<Sandbox>>,
+) -> Result<String> {
+    common_storage_handler(logger, storage)
+}
+
 // virtio_blk_storage_handler handles the storage for blk driver.
-#[instrument]
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 async fn virtio_blk_storage_handler(
     logger: &Logger,
     storage: &Storage,
@@ -451,7 +531,7 @@ async fn virtio_blk_storage_handler(
 
 // virtio_blk_ccw_storage_handler handles storage for the blk-ccw driver (s390x)
 #[cfg(target_arch = "s390x")]
-#[instrument]
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 async fn virtio_blk_ccw_storage_handler(
     logger: &Logger,
     storage: &Storage,
@@ -475,7 +555,7 @@ async fn virtio_blk_ccw_storage_handler(
 }
 
 // virtio_scsi_storage_handler handles the  storage for scsi driver.
-#[instrument]
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 async fn virtio_scsi_storage_handler(
     logger: &Logger,
     storage: &Storage,
@@ -490,18 +570,90 @@ async fn virtio_scsi_storage_handler(
     common_storage_handler(logger, &storage)
 }
 
-#[instrument]
+fn redact_option(option: &str) -> String {
+    match option.split_once('=') {
+        Some((name, _)) if CREDENTIAL_OPTIONS.contains(&name) => format!("{}={}", name, REDACTED),
+        _ => option.to_string(),
+    }
+}
+
+// redact_options returns comma separated mount options without the values
+// of the credentials ones.
+pub fn redact_options(options: &str) -> String {
+    options
+        .split(',')
+        .map(redact_option)
+        .collect::<Vec<String>>()
+        .join(",")
+}
+
+// redact_storage returns a copy of a storage without its secrets, the key
+// of an encrypted device or the credentials of a network volume, for the
+// storage to be logged and traced.
+pub fn redact_storage(storage: &Storage) -> Storage {
+    let mut storage = storage.clone();
+    if storage.has_encryption() {
+        storage.mut_encryption().key = REDACTED.as_bytes().to_vec();
+    }
+    storage.options = protobuf::RepeatedField::from_vec(
+        storage.options.iter().map(|o| redact_option(o)).collect(),
+    );
+    storage
+}
+
+pub fn redact_storages(storages: &[Storage]) -> Vec<Storage> {
+    storages.iter().map(redact_storage).collect()
+}
+
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 fn common_storage_handler(logger: &Logger, storage: &Storage) -> Result<String> {
     // Mount the storage device.
     let mount_point = storage.mount_point.to_string();
 
+    if storage.has_encryption() {
+        return encrypted_storage_handler(logger, storage);
+    }
+
     mount_storage(logger, storage)?;
     set_ownership(logger, storage)?;
     Ok(mount_point)
 }
 
+// encrypted_storage_handler mounts an encrypted block device storage, once
+// decrypted with the key the runtime provided.
+fn encrypted_storage_handler(logger: &Logger, storage: &Storage) -> Result<String> {
+    let encryption = storage.get_encryption();
+    if encryption.field_type != ENCRYPTION_TYPE_LUKS {
+        return Err(anyhow!(
+            "unsupported encryption {} for storage {}",
+            encryption.field_type,
+            storage.mount_point
+        ));
+    }
+
+    let mut storage = storage.clone();
+    storage.source = open_luks_device(
+        logger,
+        &storage.source,
+        &storage.fstype,
+        &storage.mount_point,
+        storage.options.iter().any(|o| o == "ro"),
+        &encryption.key,
+    )?;
+
+    let res = mount_storage(logger, &storage).and_then(|_| set_ownership(logger, &storage));
+    if let Err(e) = res {
+        if let Err(err) = close_luks_device(&storage.mount_point) {
+            warn!(logger, "failed to close encrypted device"; "error" => format!("{:?}", err));
+        }
+        return Err(e);
+    }
+
+    Ok(storage.mount_point.to_string())
+}
+
 // nvdimm_storage_handler handles the storage for NVDIMM driver.
-#[instrument]
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 async fn nvdimm_storage_handler(
     logger: &Logger,
     storage: &Storage,
@@ -515,6 +667,22 @@ async fn nvdimm_storage_handler(
     common_storage_handler(logger, &storage)
 }
 
+// virtio_pmem_storage_handler handles the storage for virtio-pmem devices,
+// given by their PCI path.
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
+async fn virtio_pmem_storage_handler(
+    logger: &Logger,
+    storage: &Storage,
+    sandbox: Arc<Mutex<Sandbox>>,
+) -> Result<String> {
+    let mut storage = storage.clone();
+
+    let pcipath = pci::Path::from_str(&storage.source)?;
+    storage.source = get_virtio_pmem_pci_device_name(&sandbox, &pcipath).await?;
+
+    common_storage_handler(logger, &storage)
+}
+
 async fn bind_watcher_storage_handler(
     logger: &Logger,
     storage: &Storage,
@@ -533,8 +701,26 @@ async fn bind_watcher_storage_handler(
     }
 }
 
+async fn sync_watcher_storage_handler(
+    logger: &Logger,
+    storage: &Storage,
+    sandbox: Arc<Mutex<Sandbox>>,
+    cid: Option<String>,
+) -> Result<()> {
+    let mut locked = sandbox.lock().await;
+
+    if let Some(cid) = cid {
+        locked
+            .bind_watcher
+            .add_synced_container(cid, storage, logger)
+            .await
+    } else {
+        Ok(())
+    }
+}
+
 // mount_storage performs the mount described by the storage structure.
-#[instrument]
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 fn mount_storage(logger: &Logger, storage: &Storage) -> Result<()> {
     let logger = logger.new(o!("subsystem" => "mount"));
 
@@ -568,7 +754,7 @@ fn mount_storage(logger: &Logger, storage: &Storage) -> Result<()> {
     "mount-source" => source.display(),
     "mount-destination" => mount_path.display(),
     "mount-fstype"  => storage.fstype.as_str(),
-    "mount-options" => options.as_str(),
+    "mount-options" => redact_options(&options),
     );
 
     baremount(
@@ -581,7 +767,7 @@ fn mount_storage(logger: &Logger, storage: &Storage) -> Result<()> {
     )
 }
 
-#[instrument]
+#[instrument(skip(storage), fields(storage = ?redact_storage(storage)))]
 pub fn set_ownership(logger: &Logger, storage: &Storage) -> Result<()> {
     let logger = logger.new(o!("subsystem" => "mount", "fn" => "set_ownership"));
 
@@ -718,7 +904,7 @@ fn parse_mount_flags_and_options(options_vec: Vec<&str>) -> (MsFlags, String) {
 // associated operations such as waiting for the device to show up, and mount
 // it to a specific location, according to the type of handler chosen, and for
 // each storage.
-#[instrument]
+#[instrument(skip(storages), fields(storages = ?redact_storages(&storages)))]
 pub async fn add_storages(
     logger: Logger,
     storages: Vec<Storage>,
@@ -737,6 +923,9 @@ pub async fn add_storages(
             let mut sb = sandbox.lock().await;
             let new_storage = sb.set_sandbox_storage(&storage.mount_point);
             if !new_storage {
+                // The storage is already mounted, the container still
+                // holds a reference to it, released on its removal.
+                mount_list.push(storage.mount_point.clone());
                 continue;
             }
         }
@@ -764,12 +953,25 @@ pub async fn add_storages(
                 virtio_scsi_storage_handler(&logger, &storage, sandbox.clone()).await
             }
             DRIVER_NVDIMM_TYPE => nvdimm_storage_handler(&logger, &storage, sandbox.clone()).await,
+            DRIVER_VIRTIO_PMEM_TYPE => {
+                virtio_pmem_storage_handler(&logger, &storage, sandbox.clone()).await
+            }
             DRIVER_WATCHABLE_BIND_TYPE => {
                 bind_watcher_storage_handler(&logger, &storage, sandbox.clone(), cid.clone())
                     .await?;
                 // Don't register watch mounts, they're handled separately by the watcher.
                 Ok(String::new())
             }
+            DRIVER_LAYER_CACHE_TYPE => {
+                layer_cache_storage_handler(&logger, &storage, sandbox.clone()).await
+            }
+            DRIVER_NETFS_TYPE => netfs_storage_handler(&logger, &storage, sandbox.clone()).await,
+            DRIVER_WATCHABLE_SYNC_TYPE => {
+                sync_watcher_storage_handler(&logger, &storage, sandbox.clone(), cid.clone())
+                    .await?;
+                // The content of synced watch mounts is written by the runtime.
+                Ok(String::new())
+            }
             _ => {
                 return Err(anyhow!(
                     "Failed to find the storage handler {}",
@@ -864,6 +1066,129 @@ pub fn get_mount_fs_type_from_file(mount_file: &str, mount_point: &str) -> Resul
     ))
 }
 
+// get_mount_device_from_file returns the device mounted on the passed mount point
+// and any error encountered.
+#[instrument]
+pub fn get_mount_device_from_file(mount_file: &str, mount_point: &str) -> Result<String> {
+    if mount_point.is_empty() {
+        return Err(anyhow!("Invalid mount point {}", mount_point));
+    }
+
+    let file = File::open(mount_file)?;
+    let reader = BufReader::new(file);
+
+    let re = Regex::new(
+        format!(
+            "device (.+) mounted on {} with fstype",
+            regex::escape(mount_point)
+        )
+        .as_str(),
+    )?;
+
+    for line in reader.lines() {
+        let line = line?;
+        if let Some(caps) = re.captures(line.as_str()) {
+            return Ok(caps[1].to_string());
+        }
+    }
+
+    Err(anyhow!(
+        "failed to find device for mount point {}",
+        mount_point
+    ))
+}
+
+// grow_fs_command returns the command, and its arguments, growing a mounted
+// filesystem to the size of its device.
+fn grow_fs_command(
+    fs_type: &str,
+    device: &str,
+    mount_point: &str,
+) -> Result<(&'static str, Vec<String>)> {
+    match fs_type {
+        "ext2" | "ext3" | "ext4" => Ok(("resize2fs", vec![device.to_string()])),
+        "xfs" => Ok(("xfs_growfs", vec![mount_point.to_string()])),
+        "btrfs" => Ok((
+            "btrfs",
+            vec![
+                "filesystem".to_string(),
+                "resize".to_string(),
+                "max".to_string(),
+                mount_point.to_string(),
+            ],
+        )),
+        _ => Err(anyhow!("growing {} filesystems is not supported", fs_type)),
+    }
+}
+
+// wait_for_block_device_size waits for the guest kernel to report the new
+// size of a block device, in bytes, after the host resized it.
+async fn wait_for_block_device_size(dev_name: &str, size: u64) -> Result<()> {
+    let size_path = format!("{}/class/block/{}/size", SYSFS_DIR, dev_name);
+
+    for _ in 0..BLOCK_RESIZE_RETRIES {
+        // The size is given in 512-byte sectors
+        let sectors: u64 = fs::read_to_string(&size_path)?.trim().parse()?;
+        if sectors * 512 >= size {
+            return Ok(());
+        }
+        tokio::time::sleep(BLOCK_RESIZE_RETRY_INTERVAL).await;
+    }
+
+    Err(anyhow!(
+        "timeout waiting for device {} to be resized to {} bytes",
+        dev_name,
+        size
+    ))
+}
+
+// grow_mounted_fs grows the filesystem mounted on mount_point once its block
+// device has been resized to at least size bytes.
+#[instrument]
+pub async fn grow_mounted_fs(logger: &Logger, mount_point: &str, size: u64) -> Result<()> {
+    let fs_type = get_mount_fs_type(mount_point)?;
+    let device = get_mount_device_from_file(PROC_MOUNTSTATS, mount_point)?;
+    let dev_name = Path::new(&device)
+        .file_name()
+        .and_then(|n| n.to_str())
+        .ok_or_else(|| anyhow!("invalid device {} for mount point {}", device, mount_point))?
+        .to_string();
+
+    // The size of SCSI disks is only updated on a rescan
+    if dev_name.starts_with("sd") {
+        fs::write(
+            format!("{}/class/block/{}/device/rescan", SYSFS_DIR, dev_name),
+            "1",
+        )
+        .context(format!("rescan SCSI device {}", dev_name))?;
+    }
+
+    wait_for_block_device_size(&dev_name, size).await?;
+
+    let (cmd, args) = grow_fs_command(&fs_type, &device, mount_point)?;
+    info!(logger, "growing filesystem";
+        "mount-point" => mount_point,
+        "device" => &device,
+        "fstype" => &fs_type,
+        "size" => size,
+    );
+
+    let output = Command::new(cmd)
+        .args(&args)
+        .output()
+        .context(format!("run {}", cmd))?;
+    if !output.status.success() {
+        return Err(anyhow!(
+            "{} {:?} failed: {}",
+            cmd,
+            args,
+            String::from_utf8_lossy(&output.stderr).trim()
+        ));
+    }
+
+    Ok(())
+}
+
 #[instrument]
 pub fn get_cgroup_mounts(
     logger: &Logger,
@@ -1388,6 +1713,49 @@ mod tests {
         }
     }
 
+    #[test]
+    fn test_get_mount_device_from_file() {
+        let dir = tempdir().expect("failed to create tmpdir");
+        let file_path = dir.path().join("mount_stats");
+        let filename = file_path.to_str().expect("failed to create filename");
+
+        let mut file = File::create(filename).expect("failed to create file");
+        file.write_all(
+            b"device /dev/vda mounted on / with fstype ext4\n\
+              device /dev/sda mounted on /run/kata-containers/sandbox/storage/vol+1 with fstype xfs\n",
+        )
+        .expect("failed to write file contents");
+
+        assert_eq!(
+            get_mount_device_from_file(filename, "/").unwrap(),
+            "/dev/vda"
+        );
+        assert_eq!(
+            get_mount_device_from_file(filename, "/run/kata-containers/sandbox/storage/vol+1")
+                .unwrap(),
+            "/dev/sda"
+        );
+        assert!(get_mount_device_from_file(filename, "/tmp").is_err());
+        assert!(get_mount_device_from_file(filename, "").is_err());
+    }
+
+    #[test]
+    fn test_grow_fs_command() {
+        assert_eq!(
+            grow_fs_command("ext4", "/dev/vda", "/mnt").unwrap(),
+            ("resize2fs", vec!["/dev/vda".to_string()])
+        );
+        assert_eq!(
+            grow_fs_command("xfs", "/dev/vda", "/mnt").unwrap(),
+            ("xfs_growfs", vec!["/mnt".to_string()])
+        );
+        assert_eq!(
+            grow_fs_command("btrfs", "/dev/vda", "/mnt").unwrap().0,
+            "btrfs"
+        );
+        assert!(grow_fs_command("vfat", "/dev/vda", "/mnt").is_err());
+    }
+
     #[test]
     fn test_get_cgroup_v2_mounts() {
         let _ = tempdir().expect("failed to create tmpdir");
@@ -1582,6 +1950,45 @@ mod tests {
         assert!(testfile.is_file());
     }
 
+    #[test]
+    fn test_redact_storage() {
+        let mut storage = Storage {
+            driver: "blk".to_string(),
+            source: "/dev/vdb".to_string(),
+            mount_point: "/run/kata-containers/sandbox/storage/data".to_string(),
+            ..Default::default()
+        };
+        assert_eq!(redact_storage(&storage), storage);
+
+        storage.mut_encryption().field_type = ENCRYPTION_TYPE_LUKS.to_string();
+        storage.mut_encryption().key = b"secret".to_vec();
+
+        let redacted = redact_storage(&storage);
+        assert_eq!(redacted.get_encryption().key, REDACTED.as_bytes());
+        assert_eq!(redacted.get_encryption().field_type, ENCRYPTION_TYPE_LUKS);
+        assert_eq!(redacted.mount_point, storage.mount_point);
+        assert!(!format!("{:?}", redact_storages(&[storage])).contains("secret"));
+
+        let storage = Storage {
+            driver: DRIVER_NETFS_TYPE.to_string(),
+            fstype: "cifs".to_string(),
+            options: RepeatedField::from_vec(vec![
+                "vers=3.0".to_string(),
+                "username=kata".to_string(),
+                "password=s3cr=t".to_string(),
+            ]),
+            ..Default::default()
+        };
+        assert_eq!(
+            redact_storage(&storage).options.to_vec(),
+            vec!["vers=3.0", "username=<redacted>", "password=<redacted>"]
+        );
+        assert_eq!(
+            redact_options("vers=3.0,password=s3cr=t,ro"),
+            "vers=3.0,password=<redacted>,ro"
+        );
+    }
+
     #[test]
     fn test_mount_storage() {
         #[derive(Debug)]
@@ -4,13 +4,25 @@
 //
 
 use anyhow::{anyhow, Result};
+use nix::errno::Errno;
 use nix::mount::{self, MsFlags};
+use protocols::agent::NetworkStats;
 use slog::Logger;
 use std::fs;
+use std::os::unix::io::RawFd;
+use std::path::Path;
 
 const KATA_GUEST_SANDBOX_DNS_FILE: &str = "/run/kata-containers/sandbox/resolv.conf";
 const GUEST_DNS_FILE: &str = "/etc/resolv.conf";
 
+const SYSFS_NET_PATH: &str = "/sys/class/net";
+const VIRTIO_NET_DRIVER: &str = "virtio_net";
+
+// See <linux/sockios.h> and <linux/ethtool.h>
+const SIOCETHTOOL: libc::c_ulong = 0x8946;
+const ETHTOOL_GCHANNELS: u32 = 0x3c;
+const ETHTOOL_SCHANNELS: u32 = 0x3d;
+
 // Network describes a sandbox network, includings its dns
 // related information.
 #[derive(Debug, Default)]
@@ -37,6 +49,136 @@ pub fn setup_guest_dns(logger: Logger, dns_list: Vec<String>) -> Result<()> {
     )
 }
 
+// get_network_stats returns the counters of the guest network interfaces,
+// skipping the loopback one.
+pub fn get_network_stats() -> Result<Vec<NetworkStats>> {
+    let devs = procfs::net::dev_status().map_err(|e| anyhow!(e))?;
+
+    let mut stats: Vec<NetworkStats> = devs
+        .into_iter()
+        .map(|(_, status)| status)
+        .filter(|status| status.name != "lo")
+        .map(|status| NetworkStats {
+            name: status.name,
+            rx_bytes: status.recv_bytes,
+            rx_packets: status.recv_packets,
+            rx_errors: status.recv_errs,
+            rx_dropped: status.recv_drop,
+            tx_bytes: status.sent_bytes,
+            tx_packets: status.sent_packets,
+            tx_errors: status.sent_errs,
+            tx_dropped: status.sent_drop,
+            ..Default::default()
+        })
+        .collect();
+    stats.sort_by(|a, b| a.name.cmp(&b.name));
+
+    Ok(stats)
+}
+
+#[repr(C)]
+#[derive(Debug, Default)]
+struct EthtoolChannels {
+    cmd: u32,
+    max_rx: u32,
+    max_tx: u32,
+    max_other: u32,
+    max_combined: u32,
+    rx_count: u32,
+    tx_count: u32,
+    other_count: u32,
+    combined_count: u32,
+}
+
+#[repr(C)]
+struct IfReqData {
+    ifr_name: [libc::c_char; libc::IFNAMSIZ],
+    ifr_data: *mut libc::c_void,
+    _pad: [u8; 16],
+}
+
+// update_net_queues enables as many queue pairs as there are online CPUs on
+// the virtio-net interfaces. The runtime provisions the queues for the
+// maximum number of vCPUs, but the guest driver only enables the ones
+// matching the CPUs online when the device is probed, so that throughput
+// does not scale with hot added vCPUs otherwise.
+pub fn update_net_queues(logger: &Logger) -> Result<()> {
+    let cpus = unsafe { libc::sysconf(libc::_SC_NPROCESSORS_ONLN) };
+    if cpus <= 0 {
+        return Err(anyhow!("failed to get the number of online CPUs"));
+    }
+
+    for entry in fs::read_dir(SYSFS_NET_PATH)? {
+        let entry = entry?;
+        let name = entry.file_name().to_string_lossy().to_string();
+
+        if !is_virtio_net(&entry.path()) {
+            continue;
+        }
+
+        if let Err(e) = set_combined_channels(&name, cpus as u32) {
+            warn!(logger, "failed to update queues of {}: {:?}", name, e);
+        }
+    }
+
+    Ok(())
+}
+
+fn is_virtio_net(iface_path: &Path) -> bool {
+    fs::read_link(iface_path.join("device/driver"))
+        .map(|driver| {
+            driver
+                .file_name()
+                .map(|n| n == VIRTIO_NET_DRIVER)
+                .unwrap_or(false)
+        })
+        .unwrap_or(false)
+}
+
+fn set_combined_channels(iface: &str, count: u32) -> Result<()> {
+    let fd = unsafe { libc::socket(libc::AF_INET, libc::SOCK_DGRAM | libc::SOCK_CLOEXEC, 0) };
+    Errno::result(fd)?;
+
+    let res = do_set_combined_channels(fd, iface, count);
+    unsafe { libc::close(fd) };
+
+    res
+}
+
+fn do_set_combined_channels(fd: RawFd, iface: &str, count: u32) -> Result<()> {
+    if iface.len() >= libc::IFNAMSIZ {
+        return Err(anyhow!("invalid interface name {}", iface));
+    }
+
+    let mut channels = EthtoolChannels {
+        cmd: ETHTOOL_GCHANNELS,
+        ..Default::default()
+    };
+
+    let mut ifr = IfReqData {
+        ifr_name: [0; libc::IFNAMSIZ],
+        ifr_data: &mut channels as *mut EthtoolChannels as *mut libc::c_void,
+        _pad: [0; 16],
+    };
+    for (i, b) in iface.bytes().enumerate() {
+        ifr.ifr_name[i] = b as libc::c_char;
+    }
+
+    Errno::result(unsafe { libc::ioctl(fd, SIOCETHTOOL as _, &mut ifr) })?;
+
+    let wanted = count.min(channels.max_combined);
+    if wanted == 0 || wanted == channels.combined_count {
+        return Ok(());
+    }
+
+    channels.cmd = ETHTOOL_SCHANNELS;
+    channels.combined_count = wanted;
+
+    Errno::result(unsafe { libc::ioctl(fd, SIOCETHTOOL as _, &mut ifr) })?;
+
+    Ok(())
+}
+
 fn do_setup_guest_dns(logger: Logger, dns_list: Vec<String>, src: &str, dst: &str) -> Result<()> {
     let logger = logger.new(o!( "subsystem" => "network"));
 
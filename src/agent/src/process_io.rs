@@ -0,0 +1,243 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+//! Streaming of the stdio of the processes over dedicated vsock connections,
+//! rather than through the ReadStdout, ReadStderr and WriteStdin requests
+//! multiplexed over the connection of the agent API.
+
+use std::os::unix::io::RawFd;
+use std::sync::Arc;
+use std::time::Duration;
+
+use anyhow::{anyhow, Result};
+use nix::sys::socket::{self, AddressFamily, SockAddr, SockFlag, SockType};
+use nix::unistd;
+use protocols::agent::ProcessIOPorts;
+use rustjail::{pipestream::PipeStream, process::StreamType};
+use tokio::io::{AsyncRead, AsyncReadExt, AsyncWrite, AsyncWriteExt, ReadHalf, WriteHalf};
+use tokio::sync::{Mutex, Notify};
+use tokio_vsock::VsockStream;
+
+use crate::sandbox::Sandbox;
+use crate::util;
+
+// How long the runtime is waited for to connect to a stream
+const ACCEPT_TIMEOUT: Duration = Duration::from_secs(30);
+
+// Size of I/O read buffer
+const BUF_SIZE: usize = 8192;
+
+type Reader = Arc<Mutex<ReadHalf<PipeStream>>>;
+type Writer = Arc<Mutex<WriteHalf<PipeStream>>>;
+
+macro_rules! sl {
+    () => {
+        slog_scope::logger().new(o!("subsystem" => "process_io"))
+    };
+}
+
+/// Listens on vsock ports to stream the stdio of a process, accepting a single
+/// connection each, and returns the ports. The stdin and stdout are streamed
+/// over a single connection, in both directions, when bidirectional is set.
+pub async fn open_process_io(
+    sandbox: Arc<Mutex<Sandbox>>,
+    cid: &str,
+    eid: &str,
+    bidirectional: bool,
+) -> Result<ProcessIOPorts> {
+    let (stdin, stdout, stderr, term_exit_notifier) = {
+        let mut s = sandbox.lock().await;
+        let p = s.find_container_process(cid, eid)?;
+
+        if p.term_master.is_some() {
+            (
+                p.get_writer(StreamType::TermMaster),
+                p.get_reader(StreamType::TermMaster),
+                None,
+                Some(p.term_exit_notifier.clone()),
+            )
+        } else {
+            (
+                p.parent_stdin
+                    .and_then(|_| p.get_writer(StreamType::ParentStdin)),
+                p.parent_stdout
+                    .and_then(|_| p.get_reader(StreamType::ParentStdout)),
+                p.parent_stderr
+                    .and_then(|_| p.get_reader(StreamType::ParentStderr)),
+                None,
+            )
+        }
+    };
+
+    let mut ports = ProcessIOPorts::new();
+
+    let (stdin, stdout) = match (stdin, stdout) {
+        (Some(writer), Some(reader)) if bidirectional => {
+            let (fd, port) = listen()?;
+            ports.set_stdin_port(port);
+            ports.set_stdout_port(port);
+
+            let sandbox = sandbox.clone();
+            let (cid, eid) = (cid.to_string(), eid.to_string());
+            let term_exit_notifier = term_exit_notifier.clone();
+            tokio::spawn(async move {
+                if let Ok(conn) = accept(fd, &cid, &eid).await {
+                    let (conn_reader, conn_writer) = tokio::io::split(conn);
+
+                    // the stdin of the process is closed once the runtime
+                    // shut down the write side of the connection
+                    let input = async {
+                        copy_input(conn_reader, writer).await;
+                        close_stdin(&sandbox, &cid, &eid).await;
+                    };
+                    let output = copy_output(reader, conn_writer, term_exit_notifier);
+
+                    tokio::join!(input, output);
+                }
+            });
+
+            (None, None)
+        }
+        streams => streams,
+    };
+
+    if let Some(writer) = stdin {
+        let (fd, port) = listen()?;
+        ports.set_stdin_port(port);
+
+        let sandbox = sandbox.clone();
+        let (cid, eid) = (cid.to_string(), eid.to_string());
+        tokio::spawn(async move {
+            if let Ok(conn) = accept(fd, &cid, &eid).await {
+                copy_input(conn, writer).await;
+
+                // the stdin of the process is closed with its connection
+                close_stdin(&sandbox, &cid, &eid).await;
+            }
+        });
+    }
+
+    for (reader, stderr) in [(stdout, false), (stderr, true)] {
+        let reader = match reader {
+            Some(reader) => reader,
+            None => continue,
+        };
+
+        let (fd, port) = listen()?;
+        if stderr {
+            ports.set_stderr_port(port);
+        } else {
+            ports.set_stdout_port(port);
+        }
+
+        let (cid, eid) = (cid.to_string(), eid.to_string());
+        let term_exit_notifier = term_exit_notifier.clone();
+        tokio::spawn(async move {
+            if let Ok(conn) = accept(fd, &cid, &eid).await {
+                copy_output(reader, conn, term_exit_notifier).await;
+            }
+        });
+    }
+
+    Ok(ports)
+}
+
+// Listens on a vsock port picked by the kernel.
+fn listen() -> Result<(RawFd, u32)> {
+    let fd = socket::socket(
+        AddressFamily::Vsock,
+        SockType::Stream,
+        SockFlag::SOCK_CLOEXEC,
+        None,
+    )?;
+
+    let addr = SockAddr::new_vsock(libc::VMADDR_CID_ANY, libc::VMADDR_PORT_ANY);
+    let port = socket::bind(fd, &addr)
+        .and_then(|_| socket::listen(fd, 1))
+        .and_then(|_| socket::getsockname(fd))
+        .map_err(anyhow::Error::from)
+        .and_then(|addr| match addr {
+            SockAddr::Vsock(addr) => Ok(addr.port()),
+            _ => Err(anyhow!("unexpected address {}", addr)),
+        });
+
+    match port {
+        Ok(port) => Ok((fd, port)),
+        Err(e) => {
+            let _ = unistd::close(fd);
+            Err(e)
+        }
+    }
+}
+
+async fn accept(fd: RawFd, cid: &str, eid: &str) -> Result<VsockStream> {
+    // the listener is closed once the connection is accepted or timed out
+    let result = tokio::time::timeout(ACCEPT_TIMEOUT, util::get_vsock_stream(fd))
+        .await
+        .map_err(|_| anyhow!("timed out waiting for the connection"))
+        .and_then(|r| r);
+
+    if let Err(e) = &result {
+        warn!(sl!(), "process stream not connected";
+            "container" => cid,
+            "exec" => eid,
+            "error" => format!("{:?}", e));
+    }
+
+    result
+}
+
+async fn close_stdin(sandbox: &Arc<Mutex<Sandbox>>, cid: &str, eid: &str) {
+    let mut s = sandbox.lock().await;
+    if let Ok(p) = s.find_container_process(cid, eid) {
+        p.close_stdin();
+    }
+}
+
+async fn copy_input(mut conn: impl AsyncRead + Unpin, writer: Writer) {
+    let mut buf = [0u8; BUF_SIZE];
+
+    loop {
+        let len = match conn.read(&mut buf).await {
+            Ok(0) | Err(_) => break,
+            Ok(len) => len,
+        };
+
+        if writer.lock().await.write_all(&buf[..len]).await.is_err() {
+            break;
+        }
+    }
+}
+
+async fn copy_output(
+    reader: Reader,
+    mut conn: impl AsyncWrite + Unpin,
+    term_exit_notifier: Option<Arc<Notify>>,
+) {
+    let mut buf = [0u8; BUF_SIZE];
+    let mut reader = reader.lock().await;
+
+    loop {
+        let result = match &term_exit_notifier {
+            Some(notifier) => tokio::select! {
+                _ = notifier.notified() => break,
+                result = reader.read(&mut buf) => result,
+            },
+            None => reader.read(&mut buf).await,
+        };
+
+        // the terminal fails with EIO once the process exited
+        let len = match result {
+            Ok(0) | Err(_) => break,
+            Ok(len) => len,
+        };
+
+        if conn.write_all(&buf[..len]).await.is_err() {
+            break;
+        }
+    }
+
+    let _ = conn.shutdown().await;
+}
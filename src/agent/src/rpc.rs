@@ -8,6 +8,7 @@ use rustjail::{pipestream::PipeStream, process::StreamType};
 use tokio::io::{AsyncReadExt, AsyncWriteExt, ReadHalf};
 use tokio::sync::Mutex;
 
+use std::collections::HashMap;
 use std::ffi::CString;
 use std::io;
 use std::path::Path;
@@ -20,11 +21,12 @@ use ttrpc::{
 
 use anyhow::{anyhow, Context, Result};
 use cgroups::freezer::FreezerState;
-use oci::{LinuxNamespace, Root, Spec};
+use oci::{ContainerState, LinuxNamespace, Root, Spec, State as OCIState};
 use protobuf::{Message, RepeatedField, SingularPtrField};
 use protocols::agent::{
-    AddSwapRequest, AgentDetails, CopyFileRequest, GuestDetailsResponse, Interfaces, Metrics,
-    OOMEvent, ReadStreamResponse, Routes, StatsContainerResponse, VolumeStatsRequest,
+    AddSwapRequest, AgentDetails, CopyFileRequest, Diagnostics, GetIPTablesResponse,
+    GuestDetailsResponse, Interfaces, KernelLog, Metrics, OOMEvent, Policy, ProcessIOPorts,
+    ReadStreamResponse, Routes, SetIPTablesResponse, StatsContainerResponse, VolumeStatsRequest,
     WaitProcessResponse, WriteStreamResponse,
 };
 use protocols::csi::{VolumeCondition, VolumeStatsResponse, VolumeUsage, VolumeUsage_Unit};
@@ -34,7 +36,7 @@ use protocols::health::{
 };
 use protocols::types::Interface;
 use rustjail::cgroups::notifier;
-use rustjail::container::{BaseContainer, Container, LinuxContainer};
+use rustjail::container::{execute_hook, BaseContainer, Container, LinuxContainer};
 use rustjail::process::Process;
 use rustjail::specconv::CreateOpts;
 
@@ -48,19 +50,26 @@ use rustjail::process::ProcessOperations;
 use crate::device::{
     add_devices, get_virtio_blk_pci_device_name, update_device_cgroup, update_env_pci,
 };
+use crate::diagnostics;
+use crate::kernel_log;
 use crate::linux_abi::*;
 use crate::metrics::get_metrics;
-use crate::mount::{add_storages, baremount, STORAGE_HANDLER_LIST};
+use crate::mount::{
+    add_storages, baremount, grow_mounted_fs, redact_storages, STORAGE_HANDLER_LIST,
+};
 use crate::namespace::{NSTYPEIPC, NSTYPEPID, NSTYPEUTS};
-use crate::network::setup_guest_dns;
+use crate::network::{get_network_stats, setup_guest_dns};
 use crate::pci;
+use crate::process_io;
 use crate::random;
-use crate::sandbox::Sandbox;
+use crate::sandbox::{wait_for_memory_blocks, Sandbox};
+use crate::time_source;
 use crate::version::{AGENT_VERSION, API_VERSION};
 use crate::AGENT_CONFIG;
 
 use crate::trace_rpc_call;
-use crate::tracer::extract_carrier_from_ttrpc;
+use crate::tracer::{self, extract_carrier_from_ttrpc};
+use crate::NAME;
 use opentelemetry::global;
 use tracing::span;
 use tracing_opentelemetry::OpenTelemetrySpanExt;
@@ -82,6 +91,14 @@ use std::path::PathBuf;
 const CONTAINER_BASE: &str = "/run/kata-containers";
 const MODPROBE_PATH: &str = "/sbin/modprobe";
 
+const IPTABLES_SAVE: &str = "/sbin/iptables-save";
+const IPTABLES_RESTORE: &str = "/sbin/iptables-restore";
+const IP6TABLES_SAVE: &str = "/sbin/ip6tables-save";
+const IP6TABLES_RESTORE: &str = "/sbin/ip6tables-restore";
+
+// Maximum number of records of the kernel log returned by a request
+const MAX_KERNEL_LOG_RECORDS: usize = 256;
+
 const ERR_CANNOT_GET_WRITER: &str = "Cannot get writer";
 const ERR_INVALID_BLOCK_SIZE: &str = "Invalid block size";
 const ERR_NO_LINUX_FIELD: &str = "Spec does not contain linux field";
@@ -119,6 +136,7 @@ macro_rules! is_allowed {
 #[derive(Clone, Debug)]
 pub struct AgentService {
     sandbox: Arc<Mutex<Sandbox>>,
+    log_level: logging::LogLevelHandle,
 }
 
 // A container ID must match this regex:
@@ -146,7 +164,7 @@ fn verify_cid(id: &str) -> Result<()> {
 }
 
 impl AgentService {
-    #[instrument]
+    #[instrument(skip(req), fields(container_id = %req.container_id))]
     async fn do_create_container(
         &self,
         req: protocols::agent::CreateContainerRequest,
@@ -172,7 +190,8 @@ impl AgentService {
         info!(sl!(), "receive createcontainer, spec: {:?}", &oci);
         info!(
             sl!(),
-            "receive createcontainer, storages: {:?}", &req.storages
+            "receive createcontainer, storages: {:?}",
+            redact_storages(&req.storages)
         );
 
         // Some devices need some extra processing (the ones invoked with
@@ -296,7 +315,9 @@ impl AgentService {
                 }
             }
 
-            for m in cmounts.iter() {
+            // The storages are removed in the reverse order of their
+            // mounts, as a storage may be mounted on top of the previous ones.
+            for m in cmounts.iter().rev() {
                 sandbox.unset_and_remove_sandbox_storage(m)?;
             }
 
@@ -644,7 +665,10 @@ impl protocols::agent_ttrpc::AgentService for AgentService {
         ctx: &TtrpcContext,
         req: protocols::agent::CreateContainerRequest,
     ) -> ttrpc::Result<Empty> {
-        trace_rpc_call!(ctx, "create_container", req);
+        // The storages are traced without their secrets
+        let mut traced = req.clone();
+        traced.set_storages(redact_storages(&req.storages).into());
+        trace_rpc_call!(ctx, "create_container", traced);
         is_allowed!(req);
         match self.do_create_container(req).await {
             Err(e) => Err(ttrpc_error!(ttrpc::Code::INTERNAL, e)),
@@ -771,8 +795,18 @@ impl protocols::agent_ttrpc::AgentService for AgentService {
             )
         })?;
 
-        ctr.stats()
-            .map_err(|e| ttrpc_error!(ttrpc::Code::INTERNAL, e))
+        let mut resp = ctr
+            .stats()
+            .map_err(|e| ttrpc_error!(ttrpc::Code::INTERNAL, e))?;
+
+        // Containers share the network namespace of the sandbox, so report
+        // the guest network interfaces for each of them.
+        match get_network_stats() {
+            Ok(stats) => resp.set_network_stats(RepeatedField::from_vec(stats)),
+            Err(e) => warn!(sl!(), "failed to get guest network stats: {:?}", e),
+        }
+
+        Ok(resp)
     }
 
     async fn pause_container(
@@ -883,6 +917,24 @@ impl protocols::agent_ttrpc::AgentService for AgentService {
         Ok(Empty::new())
     }
 
+    async fn open_process_io(
+        &self,
+        ctx: &TtrpcContext,
+        req: protocols::agent::OpenProcessIORequest,
+    ) -> ttrpc::Result<ProcessIOPorts> {
+        trace_rpc_call!(ctx, "open_process_io", req);
+        is_allowed!(req);
+
+        process_io::open_process_io(
+            self.sandbox.clone(),
+            &req.container_id,
+            &req.exec_id,
+            req.bidirectional,
+        )
+        .await
+        .map_err(|e| ttrpc_error!(ttrpc::Code::INTERNAL, e))
+    }
+
     async fn tty_win_resize(
         &self,
         ctx: &TtrpcContext,
@@ -1050,7 +1102,9 @@ impl protocols::agent_ttrpc::AgentService for AgentService {
         ctx: &TtrpcContext,
         req: protocols::agent::CreateSandboxRequest,
     ) -> ttrpc::Result<Empty> {
-        trace_rpc_call!(ctx, "create_sandbox", req);
+        let mut traced = req.clone();
+        traced.set_storages(redact_storages(&req.storages).into());
+        trace_rpc_call!(ctx, "create_sandbox", traced);
         is_allowed!(req);
 
         {
@@ -1180,6 +1234,109 @@ impl protocols::agent_ttrpc::AgentService for AgentService {
         Ok(Empty::new())
     }
 
+    async fn start_tracing(
+        &self,
+        ctx: &TtrpcContext,
+        req: protocols::agent::StartTracingRequest,
+    ) -> ttrpc::Result<Empty> {
+        trace_rpc_call!(ctx, "start_tracing", req);
+        is_allowed!(req);
+        info!(sl!(), "start_tracing");
+
+        tracer::start_tracing(NAME, &sl!()).map_err(|e| {
+            ttrpc_error!(
+                ttrpc::Code::FAILED_PRECONDITION,
+                format!("failed to start tracing: {:?}", e),
+            )
+        })?;
+
+        Ok(Empty::new())
+    }
+
+    async fn stop_tracing(
+        &self,
+        ctx: &TtrpcContext,
+        req: protocols::agent::StopTracingRequest,
+    ) -> ttrpc::Result<Empty> {
+        trace_rpc_call!(ctx, "stop_tracing", req);
+        is_allowed!(req);
+        info!(sl!(), "stop_tracing");
+
+        tracer::end_tracing();
+
+        Ok(Empty::new())
+    }
+
+    async fn get_ip_tables(
+        &self,
+        ctx: &TtrpcContext,
+        req: protocols::agent::GetIPTablesRequest,
+    ) -> ttrpc::Result<GetIPTablesResponse> {
+        trace_rpc_call!(ctx, "get_ip_tables", req);
+        is_allowed!(req);
+
+        let cmd = if req.is_ipv6 {
+            IP6TABLES_SAVE
+        } else {
+            IPTABLES_SAVE
+        };
+
+        let output = tokio::process::Command::new(cmd)
+            .output()
+            .await
+            .map_err(|e| {
+                ttrpc_error!(
+                    ttrpc::Code::INTERNAL,
+                    format!("failed to run {}: {:?}", cmd, e),
+                )
+            })?;
+
+        if !output.status.success() {
+            return Err(ttrpc_error!(
+                ttrpc::Code::INTERNAL,
+                format!(
+                    "{} failed: {}",
+                    cmd,
+                    String::from_utf8_lossy(&output.stderr)
+                ),
+            ));
+        }
+
+        Ok(GetIPTablesResponse {
+            data: output.stdout,
+            ..Default::default()
+        })
+    }
+
+    async fn set_ip_tables(
+        &self,
+        ctx: &TtrpcContext,
+        req: protocols::agent::SetIPTablesRequest,
+    ) -> ttrpc::Result<SetIPTablesResponse> {
+        trace_rpc_call!(ctx, "set_ip_tables", req);
+        is_allowed!(req);
+
+        let cmd = if req.is_ipv6 {
+            IP6TABLES_RESTORE
+        } else {
+            IPTABLES_RESTORE
+        };
+
+        info!(sl!(), "set_ip_tables"; "command" => cmd);
+
+        let output = restore_ip_tables(cmd, &req.data).await.map_err(|e| {
+            ttrpc_error!(
+                ttrpc::Code::INTERNAL,
+                format!("failed to set the rules with {}: {:?}", cmd, e),
+            )
+        })?;
+
+        Ok(SetIPTablesResponse {
+            data: output,
+            ..Default::default()
+        })
+    }
+
     async fn online_cpu_mem(
         &self,
         ctx: &TtrpcContext,
@@ -1194,6 +1351,17 @@ impl protocols::agent_ttrpc::AgentService for AgentService {
             .online_cpu_memory(&req)
             .map_err(|e| ttrpc_error!(ttrpc::Code::INTERNAL, e))?;
 
+        let memory_blocks = sandbox.memory_blocks_rx.clone();
+        drop(sandbox);
+
+        if req.nb_mem_blocks > 0 {
+            let hotplug_timeout = AGENT_CONFIG.read().await.hotplug_timeout;
+
+            wait_for_memory_blocks(memory_blocks, req.nb_mem_blocks, hotplug_timeout)
+                .await
+                .map_err(|e| ttrpc_error!(ttrpc::Code::DEADLINE_EXCEEDED, e))?;
+        }
+
         Ok(Empty::new())
     }
 
@@ -1294,7 +1462,7 @@ impl protocols::agent_ttrpc::AgentService for AgentService {
         trace_rpc_call!(ctx, "get_metrics", req);
         is_allowed!(req);
 
-        match get_metrics(&req) {
+        match get_metrics(&req).await {
             Err(e) => Err(ttrpc_error!(ttrpc::Code::INTERNAL, e)),
             Ok(s) => {
                 let mut metrics = Metrics::new();
@@ -1370,6 +1538,280 @@ impl protocols::agent_ttrpc::AgentService for AgentService {
         Ok(resp)
     }
 
+    async fn resize_volume(
+        &self,
+        ctx: &TtrpcContext,
+        req: protocols::agent::ResizeVolumeRequest,
+    ) -> ttrpc::Result<Empty> {
+        trace_rpc_call!(ctx, "resize_volume", req);
+        is_allowed!(req);
+
+        grow_mounted_fs(&sl!(), &req.volume_guest_path, req.size)
+            .await
+            .map_err(|e| ttrpc_error!(ttrpc::Code::INTERNAL, e))?;
+
+        Ok(Empty::new())
+    }
+
+    async fn sync_watchable_mount(
+        &self,
+        ctx: &TtrpcContext,
+        req: protocols::agent::SyncWatchableMountRequest,
+    ) -> ttrpc::Result<Empty> {
+        // Only the mount is traced, the entries holding the content of the
+        // files, such as the ones of the secrets.
+        let traced = protocols::agent::SyncWatchableMountRequest {
+            mount_point: req.mount_point.clone(),
+            ..Default::default()
+        };
+        trace_rpc_call!(ctx, "sync_watchable_mount", traced);
+        is_allowed!(req);
+
+        let mut sandbox = self.sandbox.lock().await;
+        sandbox
+            .bind_watcher
+            .sync(&req, &sl!())
+            .await
+            .map_err(|e| ttrpc_error!(ttrpc::Code::INTERNAL, e))?;
+
+        Ok(Empty::new())
+    }
+
+    async fn set_policy(
+        &self,
+        ctx: &TtrpcContext,
+        req: protocols::agent::SetPolicyRequest,
+    ) -> ttrpc::Result<Empty> {
+        trace_rpc_call!(ctx, "set_policy", req);
+        is_allowed!(req);
+
+        AGENT_CONFIG
+            .write()
+            .await
+            .set_policy(&req.policy)
+            .map_err(|e| ttrpc_error!(ttrpc::Code::INVALID_ARGUMENT, e))?;
+
+        info!(sl!(), "agent policy set");
+
+        Ok(Empty::new())
+    }
+
+    async fn get_policy(
+        &self,
+        ctx: &TtrpcContext,
+        req: protocols::agent::GetPolicyRequest,
+    ) -> ttrpc::Result<Policy> {
+        trace_rpc_call!(ctx, "get_policy", req);
+        is_allowed!(req);
+
+        let mut resp = Policy::new();
+        resp.policy = AGENT_CONFIG.read().await.policy();
+
+        Ok(resp)
+    }
+
+    async fn execute_hooks(
+        &self,
+        ctx: &TtrpcContext,
+        req: protocols::agent::ExecuteHooksRequest,
+    ) -> ttrpc::Result<Empty> {
+        trace_rpc_call!(ctx, "execute_hooks", req);
+        is_allowed!(req);
+
+        let state = {
+            let s = Arc::clone(&self.sandbox);
+            let mut sandbox = s.lock().await;
+
+            match sandbox.get_container(&req.container_id) {
+                Some(ctr) => ctr
+                    .oci_state()
+                    .map_err(|e| ttrpc_error!(ttrpc::Code::INTERNAL, e))?,
+                // the hooks run after the removal of the container
+                None => OCIState {
+                    version: String::new(),
+                    id: req.container_id.clone(),
+                    status: ContainerState::Stopped,
+                    pid: 0,
+                    bundle: String::new(),
+                    annotations: HashMap::new(),
+                },
+            }
+        };
+
+        for hook in rustjail::hook_grpc_to_oci(&req.hooks).iter() {
+            execute_hook(&sl!(), hook, &state).await.map_err(|e| {
+                ttrpc_error!(
+                    ttrpc::Code::INTERNAL,
+                    format!("hook {} failed: {:?}", hook.path, e)
+                )
+            })?;
+        }
+
+        Ok(Empty::new())
+    }
+
+    async fn get_diagnostics(
+        &self,
+        ctx: &TtrpcContext,
+        req: protocols::agent::GetDiagnosticsRequest,
+    ) -> ttrpc::Result<Diagnostics> {
+        trace_rpc_call!(ctx, "get_diagnostics", req);
+        is_allowed!(req);
+
+        let max_size = if req.max_size > 0 {
+            req.max_size
+        } else {
+            diagnostics::DEFAULT_MAX_SIZE
+        };
+        let mut collector = diagnostics::Collector::new(max_size);
+
+        if !req.core_dump.is_empty() && req.container_id.is_empty() {
+            return Err(ttrpc_error!(
+                ttrpc::Code::INVALID_ARGUMENT,
+                "the core dumps are read from a container"
+            ));
+        }
+
+        if !req.container_id.is_empty() {
+            let (pids, rootfs) = {
+                let s = Arc::clone(&self.sandbox);
+                let mut sandbox = s.lock().await;
+
+                let ctr = sandbox.get_container(&req.container_id).ok_or_else(|| {
+                    ttrpc_error!(ttrpc::Code::INVALID_ARGUMENT, "invalid container id")
+                })?;
+
+                let rootfs = ctr
+                    .config
+                    .spec
+                    .as_ref()
+                    .and_then(|spec| spec.root.as_ref())
+                    .map(|root| root.path.clone());
+
+                (ctr.processes().unwrap_or_default(), rootfs)
+            };
+
+            if !req.core_dump.is_empty() {
+                let rootfs = rootfs.ok_or_else(|| {
+                    ttrpc_error!(
+                        ttrpc::Code::NOT_FOUND,
+                        "the container has no root filesystem"
+                    )
+                })?;
+
+                return diagnostics::read_core_dump(&rootfs, &req.core_dump, req.offset, max_size)
+                    .map_err(|e| ttrpc_error!(ttrpc::Code::INVALID_ARGUMENT, e));
+            }
+
+            collector.collect_processes(&pids);
+            collector.collect_guest();
+            collector.collect_agent_log();
+
+            // The core dumps come last, for the other files to fit in the
+            // maximum size, the truncated ones being completed by parts.
+            if let Some(rootfs) = rootfs {
+                collector.collect_core_dumps(&rootfs);
+            }
+        } else {
+            collector.collect_guest();
+            collector.collect_agent_log();
+        }
+
+        Ok(collector.into_diagnostics())
+    }
+
+    async fn set_log_level(
+        &self,
+        ctx: &TtrpcContext,
+        req: protocols::agent::SetLogLevelRequest,
+    ) -> ttrpc::Result<Empty> {
+        trace_rpc_call!(ctx, "set_log_level", req);
+        is_allowed!(req);
+
+        let level = logging::level_name_to_slog_level(&req.level)
+            .map_err(|e| ttrpc_error!(ttrpc::Code::INVALID_ARGUMENT, e))?;
+
+        info!(sl!(), "changing the log level";
+            "from" => logging::slog_level_to_level_name(self.log_level.level()).unwrap_or_default(),
+            "to" => &req.level);
+
+        self.log_level.set_level(level);
+        AGENT_CONFIG.write().await.log_level = level;
+
+        Ok(Empty::new())
+    }
+
+    async fn read_kernel_log(
+        &self,
+        _ctx: &TtrpcContext,
+        req: protocols::agent::ReadKernelLogRequest,
+    ) -> ttrpc::Result<KernelLog> {
+        is_allowed!(req);
+
+        let kernel_log_rx = {
+            let mut sandbox = self.sandbox.lock().await;
+
+            if sandbox.kernel_log_rx.is_none() {
+                let rx = kernel_log::start_reader(kernel_log::KMSG_PATH)
+                    .map_err(|e| ttrpc_error!(ttrpc::Code::INTERNAL, e))?;
+                sandbox.kernel_log_rx = Some(Arc::new(Mutex::new(rx)));
+            }
+
+            sandbox.kernel_log_rx.clone().unwrap()
+        };
+
+        let mut kernel_log_rx = kernel_log_rx.lock().await;
+        let mut resp = KernelLog::new();
+
+        // Wait for a record, and return the ones already queued with it
+        match kernel_log_rx.recv().await {
+            Some(record) => resp.records.push(record),
+            None => {
+                return Err(ttrpc_error!(
+                    ttrpc::Code::INTERNAL,
+                    "the kernel log reader stopped"
+                ))
+            }
+        }
+
+        while resp.records.len() < MAX_KERNEL_LOG_RECORDS {
+            match kernel_log_rx.try_recv() {
+                Ok(record) => resp.records.push(record),
+                Err(_) => break,
+            }
+        }
+
+        Ok(resp)
+    }
+
+    async fn set_guest_time_source(
+        &self,
+        ctx: &TtrpcContext,
+        req: protocols::agent::SetGuestTimeSourceRequest,
+    ) -> ttrpc::Result<Empty> {
+        trace_rpc_call!(ctx, "set_guest_time_source", req);
+        is_allowed!(req);
+
+        if req.source == time_source::SOURCE_PTP_KVM {
+            let mut module = protocols::agent::KernelModule::new();
+            module.set_name(time_source::PTP_KVM_MODULE.to_string());
+
+            // The module may be built in the kernel
+            if let Err(e) = load_kernel_module(&module) {
+                warn!(sl!(), "failed to load the ptp_kvm module: {:?}", e);
+            }
+        }
+
+        time_source::set_time_source(
+            &req.source,
+            req.get_ntp_servers(),
+            time_source::SYSFS_PTP_PATH,
+        )
+        .map_err(|e| ttrpc_error!(ttrpc::Code::INTERNAL, e))?;
+
+        Ok(Empty::new())
+    }
+
     async fn add_swap(
         &self,
         ctx: &TtrpcContext,
@@ -1528,9 +1970,15 @@ async fn read_stream(reader: Arc<Mutex<ReadHalf<PipeStream>>>, l: usize) -> Resu
     Ok(content)
 }
 
-pub fn start(s: Arc<Mutex<Sandbox>>, server_address: &str) -> Result<TtrpcServer> {
-    let agent_service = Box::new(AgentService { sandbox: s })
-        as Box<dyn protocols::agent_ttrpc::AgentService + Send + Sync>;
+pub fn start(
+    s: Arc<Mutex<Sandbox>>,
+    server_address: &str,
+    log_level: logging::LogLevelHandle,
+) -> Result<TtrpcServer> {
+    let agent_service = Box::new(AgentService {
+        sandbox: s,
+        log_level,
+    }) as Box<dyn protocols::agent_ttrpc::AgentService + Send + Sync>;
 
     let agent_worker = Arc::new(agent_service);
 
@@ -1871,6 +2319,36 @@ fn load_kernel_module(module: &protocols::agent::KernelModule) -> Result<()> {
     }
 }
 
+// restore_ip_tables feeds the rules to the restore command cmd, and returns
+// its output.
+async fn restore_ip_tables(cmd: &str, rules: &[u8]) -> Result<Vec<u8>> {
+    let mut child = tokio::process::Command::new(cmd)
+        .arg("--wait")
+        .stdin(Stdio::piped())
+        .stdout(Stdio::piped())
+        .stderr(Stdio::piped())
+        .spawn()?;
+
+    // drop stdin once written so that the command sees the end of the rules
+    child
+        .stdin
+        .take()
+        .ok_or_else(|| anyhow!("no stdin for {}", cmd))?
+        .write_all(rules)
+        .await?;
+
+    let output = child.wait_with_output().await?;
+    if !output.status.success() {
+        return Err(anyhow!(
+            "{}: {}",
+            output.status,
+            String::from_utf8_lossy(&output.stderr)
+        ));
+    }
+
+    Ok(output.stdout)
+}
+
 #[cfg(test)]
 mod tests {
     use super::*;
@@ -1978,6 +2456,7 @@ mod tests {
 
         let agent_service = Box::new(AgentService {
             sandbox: Arc::new(Mutex::new(sandbox)),
+            log_level: Default::default(),
         });
 
         let req = protocols::agent::UpdateInterfaceRequest::default();
@@ -1995,6 +2474,7 @@ mod tests {
 
         let agent_service = Box::new(AgentService {
             sandbox: Arc::new(Mutex::new(sandbox)),
+            log_level: Default::default(),
         });
 
         let req = protocols::agent::UpdateRoutesRequest::default();
@@ -2012,6 +2492,7 @@ mod tests {
 
         let agent_service = Box::new(AgentService {
             sandbox: Arc::new(Mutex::new(sandbox)),
+            log_level: Default::default(),
         });
 
         let req = protocols::agent::AddARPNeighborsRequest::default();
@@ -2144,6 +2625,7 @@ mod tests {
 
             let agent_service = Box::new(AgentService {
                 sandbox: Arc::new(Mutex::new(sandbox)),
+                log_level: Default::default(),
             });
 
             let result = agent_service
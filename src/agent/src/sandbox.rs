@@ -4,17 +4,18 @@
 //
 
 use crate::linux_abi::*;
+use crate::luks::close_luks_device;
 use crate::mount::{get_mount_fs_type, remove_mounts, TYPE_ROOTFS};
 use crate::namespace::Namespace;
 use crate::netlink::Handle;
-use crate::network::Network;
+use crate::network::{update_net_queues, Network};
 use crate::pci;
 use crate::uevent::{Uevent, UeventMatcher};
 use crate::watcher::BindWatcher;
 use anyhow::{anyhow, Context, Result};
 use libc::pid_t;
 use oci::{Hook, Hooks};
-use protocols::agent::OnlineCPUMemRequest;
+use protocols::agent::{KernelLogRecord, OnlineCPUMemRequest};
 use regex::Regex;
 use rustjail::cgroups as rustjail_cgroups;
 use rustjail::container::BaseContainer;
@@ -29,7 +30,7 @@ use std::sync::Arc;
 use std::{thread, time};
 use tokio::sync::mpsc::{channel, Receiver, Sender};
 use tokio::sync::oneshot;
-use tokio::sync::Mutex;
+use tokio::sync::{watch, Mutex};
 use tracing::instrument;
 
 pub const ERR_INVALID_CONTAINER_ID: &str = "Invalid container id";
@@ -60,6 +61,11 @@ pub struct Sandbox {
     pub event_tx: Option<Sender<String>>,
     pub bind_watcher: BindWatcher,
     pub pcimap: HashMap<pci::Address, pci::Address>,
+    // Number of the hot added memory blocks seen online
+    pub memory_blocks_tx: watch::Sender<u32>,
+    pub memory_blocks_rx: watch::Receiver<u32>,
+    // Records of the kernel log, read once requested
+    pub kernel_log_rx: Option<Arc<Mutex<Receiver<KernelLogRecord>>>>,
 }
 
 impl Sandbox {
@@ -69,6 +75,7 @@ impl Sandbox {
         let logger = logger.new(o!("subsystem" => "sandbox"));
         let (tx, rx) = channel::<String>(100);
         let event_rx = Arc::new(Mutex::new(rx));
+        let (memory_blocks_tx, memory_blocks_rx) = watch::channel(0);
 
         Ok(Sandbox {
             logger: logger.clone(),
@@ -93,9 +100,27 @@ impl Sandbox {
             event_tx: Some(tx),
             bind_watcher: BindWatcher::new(),
             pcimap: HashMap::new(),
+            memory_blocks_tx,
+            memory_blocks_rx,
+            kernel_log_rx: None,
         })
     }
 
+    // update_memory_blocks records a hot added memory block going online
+    // (added is true) or being removed, and wakes up the callers waiting
+    // for the memory blocks to be online.
+    #[instrument]
+    pub fn update_memory_blocks(&mut self, added: bool) {
+        let count = *self.memory_blocks_rx.borrow();
+        let count = if added {
+            count + 1
+        } else {
+            count.saturating_sub(1)
+        };
+
+        let _ = self.memory_blocks_tx.send(count);
+    }
+
     // set_sandbox_storage sets the sandbox level reference
     // counter for the sandbox storage.
     // This method also returns a boolean to let
@@ -151,6 +176,8 @@ impl Sandbox {
     pub fn remove_sandbox_storage(&self, path: &str) -> Result<()> {
         let mounts = vec![path.to_string()];
         remove_mounts(&mounts)?;
+        // Close the decrypted device of an encrypted storage
+        close_luks_device(path)?;
         // "remove_dir" will fail if the mount point is backed by a read-only filesystem.
         // This is the case with the device mapper snapshotter, where we mount the block device directly
         // at the underlying sandbox path which was provided from the base RO kataShared path from the host.
@@ -264,6 +291,11 @@ impl Sandbox {
         if req.nb_cpus > 0 {
             // online cpus
             online_cpus(&self.logger, req.nb_cpus as i32)?;
+
+            // scale the network queues with the new vCPUs
+            if let Err(e) = update_net_queues(&self.logger) {
+                warn!(self.logger, "failed to update network queues: {:?}", e);
+            }
         }
 
         if !req.cpu_only {
@@ -468,6 +500,34 @@ fn online_memory(logger: &Logger) -> Result<()> {
     Ok(())
 }
 
+// wait_for_memory_blocks waits for num hot added memory blocks to be online,
+// as reported by the uevents of the memory blocks.
+#[instrument]
+pub async fn wait_for_memory_blocks(
+    mut memory_blocks: watch::Receiver<u32>,
+    num: u32,
+    timeout: time::Duration,
+) -> Result<()> {
+    let wait = async {
+        while *memory_blocks.borrow() < num {
+            memory_blocks.changed().await?;
+        }
+        Ok::<(), anyhow::Error>(())
+    };
+
+    let result = tokio::time::timeout(timeout, wait).await;
+
+    match result {
+        Ok(r) => r,
+        Err(_) => Err(anyhow!(
+            "Timeout after {:?} waiting for {} hot added memory blocks to be online, {} are",
+            timeout,
+            num,
+            *memory_blocks.borrow()
+        )),
+    }
+}
+
 #[cfg(test)]
 mod tests {
     use super::*;
@@ -1103,4 +1163,34 @@ mod tests {
             }
         }
     }
+    #[tokio::test]
+    #[serial]
+    async fn test_wait_for_memory_blocks() {
+        let logger = slog::Logger::root(slog::Discard, o!());
+        let mut s = Sandbox::new(&logger).unwrap();
+        let timeout = time::Duration::from_millis(100);
+
+        // Nothing to wait for
+        let r = wait_for_memory_blocks(s.memory_blocks_rx.clone(), 0, timeout).await;
+        assert!(r.is_ok());
+
+        let r = wait_for_memory_blocks(s.memory_blocks_rx.clone(), 2, timeout).await;
+        assert!(r.is_err());
+
+        let rx = s.memory_blocks_rx.clone();
+        let waiter = tokio::spawn(async move {
+            wait_for_memory_blocks(rx, 2, time::Duration::from_secs(5)).await
+        });
+
+        s.update_memory_blocks(true);
+        s.update_memory_blocks(true);
+        assert!(waiter.await.unwrap().is_ok());
+
+        s.update_memory_blocks(false);
+        assert_eq!(*s.memory_blocks_rx.borrow(), 1);
+
+        s.update_memory_blocks(false);
+        s.update_memory_blocks(false);
+        assert_eq!(*s.memory_blocks_rx.borrow(), 0);
+    }
 }
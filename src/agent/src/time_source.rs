@@ -0,0 +1,144 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+use anyhow::{anyhow, Context, Result};
+use std::fs;
+use std::path::Path;
+use std::process::Command;
+use tracing::instrument;
+
+pub const SOURCE_PTP_KVM: &str = "ptp_kvm";
+pub const SOURCE_NTP: &str = "ntp";
+
+pub const PTP_KVM_MODULE: &str = "ptp_kvm";
+pub const SYSFS_PTP_PATH: &str = "/sys/class/ptp";
+
+const PTP_KVM_CLOCK_NAME: &str = "KVM virtual PTP";
+const CHRONY_CONF_PATH: &str = "/run/kata-containers/chrony.conf";
+const CHRONYD_PATHS: &[&str] = &["/usr/sbin/chronyd", "/sbin/chronyd", "/usr/bin/chronyd"];
+
+// find_ptp_kvm_device returns the device of the KVM PTP clock.
+fn find_ptp_kvm_device(sysfs_ptp: &str) -> Result<String> {
+    for entry in fs::read_dir(sysfs_ptp).context("no PTP clock")? {
+        let entry = entry?;
+
+        let name = match fs::read_to_string(entry.path().join("clock_name")) {
+            Ok(name) => name,
+            Err(_) => continue,
+        };
+
+        if name.trim() == PTP_KVM_CLOCK_NAME {
+            return Ok(format!("/dev/{}", entry.file_name().to_string_lossy()));
+        }
+    }
+
+    Err(anyhow!("no KVM PTP clock"))
+}
+
+// chrony_config returns the chronyd configuration syncing the clock with
+// the PTP clock device, or with the NTP servers.
+fn chrony_config(ptp_device: Option<&str>, ntp_servers: &[String]) -> String {
+    let mut config = String::new();
+
+    if let Some(device) = ptp_device {
+        config.push_str(&format!(
+            "refclock PHC {} poll 2 dpoll -2 offset 0\n",
+            device
+        ));
+    }
+
+    for server in ntp_servers {
+        config.push_str(&format!("server {} iburst\n", server));
+    }
+
+    // Step the clock whenever it is late, e.g. when the VM was paused.
+    config.push_str("makestep 1 -1\n");
+
+    config
+}
+
+// set_time_source starts chronyd to keep the guest clock in sync with the
+// time source.
+#[instrument]
+pub fn set_time_source(source: &str, ntp_servers: &[String], sysfs_ptp: &str) -> Result<()> {
+    let config = match source {
+        SOURCE_PTP_KVM => chrony_config(Some(&find_ptp_kvm_device(sysfs_ptp)?), &[]),
+        SOURCE_NTP => {
+            if ntp_servers.is_empty() {
+                return Err(anyhow!("no NTP server"));
+            }
+            chrony_config(None, ntp_servers)
+        }
+        _ => return Err(anyhow!("unknown time source {:?}", source)),
+    };
+
+    let chronyd = CHRONYD_PATHS
+        .iter()
+        .find(|p| Path::new(p).exists())
+        .ok_or_else(|| anyhow!("chronyd not found"))?;
+
+    if let Some(dir) = Path::new(CHRONY_CONF_PATH).parent() {
+        fs::create_dir_all(dir)?;
+    }
+    fs::write(CHRONY_CONF_PATH, config)?;
+
+    // chronyd daemonizes once started
+    let output = Command::new(chronyd)
+        .args(&["-f", CHRONY_CONF_PATH])
+        .output()?;
+
+    if !output.status.success() {
+        return Err(anyhow!(
+            "chronyd failed: {}",
+            String::from_utf8_lossy(&output.stderr)
+        ));
+    }
+
+    Ok(())
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use tempfile::tempdir;
+
+    #[test]
+    fn test_find_ptp_kvm_device() {
+        let dir = tempdir().unwrap();
+        let sysfs_ptp = dir.path().to_str().unwrap();
+
+        assert!(find_ptp_kvm_device(sysfs_ptp).is_err());
+
+        fs::create_dir(dir.path().join("ptp0")).unwrap();
+        fs::write(dir.path().join("ptp0/clock_name"), "other\n").unwrap();
+        fs::create_dir(dir.path().join("ptp1")).unwrap();
+        fs::write(
+            dir.path().join("ptp1/clock_name"),
+            format!("{}\n", PTP_KVM_CLOCK_NAME),
+        )
+        .unwrap();
+
+        assert_eq!(find_ptp_kvm_device(sysfs_ptp).unwrap(), "/dev/ptp1");
+    }
+
+    #[test]
+    fn test_chrony_config() {
+        assert_eq!(
+            chrony_config(Some("/dev/ptp0"), &[]),
+            "refclock PHC /dev/ptp0 poll 2 dpoll -2 offset 0\nmakestep 1 -1\n"
+        );
+
+        assert_eq!(
+            chrony_config(None, &["a".to_string(), "b".to_string()]),
+            "server a iburst\nserver b iburst\nmakestep 1 -1\n"
+        );
+    }
+
+    #[test]
+    fn test_set_time_source_invalid() {
+        assert!(set_time_source("gps", &[], SYSFS_PTP_PATH).is_err());
+        assert!(set_time_source(SOURCE_NTP, &[], SYSFS_PTP_PATH).is_err());
+    }
+}
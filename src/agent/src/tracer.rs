@@ -3,21 +3,34 @@
 // SPDX-License-Identifier: Apache-2.0
 //
 
-use anyhow::Result;
+use anyhow::{anyhow, Result};
 use opentelemetry::sdk::propagation::TraceContextPropagator;
+use opentelemetry::sdk::trace::Tracer;
 use opentelemetry::{global, sdk::trace::Config, trace::TracerProvider};
 use slog::{info, o, Logger};
 use std::collections::HashMap;
+use std::sync::atomic::{AtomicBool, Ordering};
+use std::sync::Mutex;
 use tracing_opentelemetry::OpenTelemetryLayer;
 use tracing_subscriber::layer::SubscriberExt;
-use tracing_subscriber::Registry;
+use tracing_subscriber::{reload, Registry};
 use ttrpc::r#async::TtrpcContext;
 
-pub fn setup_tracing(name: &'static str, logger: &Logger) -> Result<()> {
-    let logger = logger.new(o!("subsystem" => "vsock-tracer"));
+type TracingLayer = OpenTelemetryLayer<Registry, Tracer>;
+
+// TRACING is set while the spans are exported.
+static TRACING: AtomicBool = AtomicBool::new(false);
+
+lazy_static! {
+    // The global tracing subscriber can only be installed once: its layer
+    // exporting the spans is replaced when the tracing is started again.
+    static ref TRACING_LAYER: Mutex<Option<reload::Handle<TracingLayer, Registry>>> =
+        Mutex::new(None);
+}
 
+fn new_tracing_layer(name: &'static str, logger: &Logger) -> TracingLayer {
     let exporter = vsock_exporter::Exporter::builder()
-        .with_logger(&logger)
+        .with_logger(logger)
         .init();
 
     let config = Config::default();
@@ -35,13 +48,32 @@ pub fn setup_tracing(name: &'static str, logger: &Logger) -> Result<()> {
 
     let _global_provider = global::set_tracer_provider(provider);
 
-    let layer = OpenTelemetryLayer::new(tracer);
+    OpenTelemetryLayer::new(tracer)
+}
+
+pub fn setup_tracing(name: &'static str, logger: &Logger) -> Result<()> {
+    let logger = logger.new(o!("subsystem" => "vsock-tracer"));
+
+    let mut handle = TRACING_LAYER.lock().unwrap();
+
+    let layer = new_tracing_layer(name, &logger);
 
-    let subscriber = Registry::default().with(layer);
+    match handle.as_ref() {
+        Some(handle) => handle
+            .reload(layer)
+            .map_err(|e| anyhow!("failed to replace the tracing layer: {}", e))?,
+        None => {
+            let (layer, reload_handle) = reload::Layer::new(layer);
 
-    tracing::subscriber::set_global_default(subscriber)?;
+            let subscriber = Registry::default().with(layer);
+
+            tracing::subscriber::set_global_default(subscriber)?;
+            *handle = Some(reload_handle);
+        }
+    }
 
     global::set_text_map_propagator(TraceContextPropagator::new());
+    TRACING.store(true, Ordering::SeqCst);
 
     info!(logger, "tracing setup");
 
@@ -49,7 +81,19 @@ pub fn setup_tracing(name: &'static str, logger: &Logger) -> Result<()> {
 }
 
 pub fn end_tracing() {
-    global::shutdown_tracer_provider();
+    if TRACING.swap(false, Ordering::SeqCst) {
+        global::shutdown_tracer_provider();
+    }
+}
+
+// start_tracing starts the tracing of a running agent, when requested by the
+// runtime, again if it was stopped.
+pub fn start_tracing(name: &'static str, logger: &Logger) -> Result<()> {
+    if TRACING.load(Ordering::SeqCst) {
+        return Ok(());
+    }
+
+    setup_tracing(name, logger)
 }
 
 pub fn extract_carrier_from_ttrpc(ttrpc_context: &TtrpcContext) -> HashMap<String, String> {
@@ -77,6 +77,12 @@ impl Uevent {
                     "error" => format!("{}", e),
                 )
             });
+
+            // The block may have been onlined by the guest kernel, or by
+            // an OnlineCPUMem request, before the event is handled.
+            if is_online(&online_path) {
+                sandbox.lock().await.update_memory_blocks(true);
+            }
             return;
         }
 
@@ -99,6 +105,13 @@ impl Uevent {
     #[instrument]
     async fn process_remove(&self, logger: &Logger, sandbox: &Arc<Mutex<Sandbox>>) {
         let mut sb = sandbox.lock().await;
+
+        let online_path = format!("{}/{}/online", SYSFS_DIR, &self.devpath);
+        if online_path.starts_with(SYSFS_MEMORY_ONLINE_PATH) {
+            sb.update_memory_blocks(false);
+            return;
+        }
+
         sb.uevent_map.remove(&self.devpath);
     }
 
@@ -113,6 +126,12 @@ impl Uevent {
     }
 }
 
+fn is_online(online_path: &str) -> bool {
+    std::fs::read_to_string(online_path)
+        .map(|v| v.trim() == "1")
+        .unwrap_or(false)
+}
+
 #[instrument]
 pub async fn wait_for_uevent(
     sandbox: &Arc<Mutex<Sandbox>>,
@@ -6,15 +6,16 @@
 #![allow(unknown_lints)]
 
 use std::collections::HashMap;
-use std::os::unix::fs::MetadataExt;
-use std::path::{Path, PathBuf};
+use std::fs::OpenOptions;
+use std::os::unix::fs::{FileExt, MetadataExt, PermissionsExt};
+use std::path::{Component, Path, PathBuf};
 use std::sync::Arc;
 use std::time::SystemTime;
 
-use anyhow::{ensure, Context, Result};
+use anyhow::{anyhow, ensure, Context, Result};
 use async_recursion::async_recursion;
 use nix::mount::{umount, MsFlags};
-use nix::unistd::{Gid, Uid};
+use nix::unistd::{fchownat, FchownatFlags, Gid, Uid};
 use slog::{debug, error, info, warn, Logger};
 use thiserror::Error;
 use tokio::fs;
@@ -37,6 +38,10 @@ const WATCH_INTERVAL_SECS: u64 = 2;
 /// Destination path for tmpfs
 const WATCH_MOUNT_POINT_PATH: &str = "/run/kata-containers/shared/containers/watchable/";
 
+/// Suffix of the temporary files synced entries are written to, before being
+/// renamed to their path.
+const SYNC_TMP_SUFFIX: &str = ".kata-sync";
+
 /// Represents a single watched storage entry which may have multiple files to watch.
 #[derive(Default, Debug, Clone)]
 struct Storage {
@@ -319,6 +324,110 @@ impl Storage {
     }
 }
 
+// sync_target_path returns the path of an entry of a synced watchable mount,
+// given by its path relative to the mount point.
+fn sync_target_path(mount_point: &Path, path: &str) -> Result<PathBuf> {
+    let path = Path::new(path);
+    ensure!(
+        path.components().all(|c| matches!(c, Component::Normal(_))),
+        "invalid watchable entry path {}",
+        path.display()
+    );
+    Ok(mount_point.join(path))
+}
+
+// sync_tmp_path returns the path of the temporary file a synced entry is
+// written to before being renamed to its path.
+fn sync_tmp_path(target: &Path) -> Result<PathBuf> {
+    let name = target
+        .file_name()
+        .ok_or_else(|| anyhow!("invalid watchable entry path {}", target.display()))?;
+    let mut tmp_name = std::ffi::OsString::from(".");
+    tmp_name.push(name);
+    tmp_name.push(SYNC_TMP_SUFFIX);
+    Ok(target.with_file_name(tmp_name))
+}
+
+// replace_sync_entry atomically replaces an entry with the temporary file
+// it was written to, so that the containers watching it get notified of the
+// update only once it is complete.
+fn replace_sync_entry(tmp: &Path, target: &Path) -> Result<()> {
+    if let Ok(metadata) = target.symlink_metadata() {
+        if metadata.is_dir() {
+            std::fs::remove_dir_all(target)?;
+        }
+    }
+    std::fs::rename(tmp, target)?;
+    Ok(())
+}
+
+// apply_sync_entry creates or updates an entry of a synced watchable mount.
+// Regular files are received by chunks, and updated once complete.
+fn apply_sync_entry(mount_point: &Path, entry: &protos::WatchableEntry) -> Result<()> {
+    let target = sync_target_path(mount_point, &entry.path)?;
+    let permissions = std::fs::Permissions::from_mode(entry.mode & 0o7777);
+    let uid = Some(Uid::from_raw(entry.uid));
+    let gid = Some(Gid::from_raw(entry.gid));
+
+    match entry.mode & libc::S_IFMT {
+        libc::S_IFDIR => {
+            if let Ok(metadata) = target.symlink_metadata() {
+                if !metadata.is_dir() {
+                    std::fs::remove_file(&target)?;
+                }
+            }
+            std::fs::create_dir_all(&target)?;
+            std::fs::set_permissions(&target, permissions)?;
+            nix::unistd::chown(&target, uid, gid)?;
+        }
+        libc::S_IFLNK => {
+            let tmp = sync_tmp_path(&target)?;
+            let _ = std::fs::remove_file(&tmp);
+            std::os::unix::fs::symlink(&entry.link_target, &tmp)?;
+            fchownat(None, &tmp, uid, gid, FchownatFlags::NoFollowSymlink)?;
+            replace_sync_entry(&tmp, &target)?;
+        }
+        libc::S_IFREG => {
+            let tmp = sync_tmp_path(&target)?;
+            let file = OpenOptions::new()
+                .write(true)
+                .create(true)
+                .truncate(entry.offset == 0)
+                .open(&tmp)?;
+            file.write_all_at(entry.data.as_slice(), entry.offset)?;
+
+            if entry.offset + entry.data.len() as u64 != entry.size {
+                return Ok(());
+            }
+
+            file.set_permissions(permissions)?;
+            nix::unistd::chown(&tmp, uid, gid)?;
+            replace_sync_entry(&tmp, &target)?;
+        }
+        file_type => {
+            return Err(anyhow!(
+                "unsupported type {:o} of watchable entry {}",
+                file_type,
+                entry.path
+            ))
+        }
+    }
+
+    Ok(())
+}
+
+// remove_sync_entry removes an entry of a synced watchable mount.
+fn remove_sync_entry(mount_point: &Path, path: &str) -> Result<()> {
+    let target = sync_target_path(mount_point, path)?;
+    match target.symlink_metadata() {
+        Ok(metadata) if metadata.is_dir() => std::fs::remove_dir_all(&target)?,
+        Ok(_) => std::fs::remove_file(&target)?,
+        // Already removed along with its directory
+        Err(_) => {}
+    }
+    Ok(())
+}
+
 #[derive(Default, Debug)]
 struct SandboxStorages(Vec<Storage>);
 
@@ -425,11 +534,18 @@ impl SandboxStorages {
 /// More context on this:
 /// - https://github.com/kata-containers/runtime/issues/1505
 /// - https://github.com/kata-containers/kata-containers/issues/1879
+///
+/// The watcher also holds the synced watchable mounts, whose changes are detected by the runtime
+/// on the host and sent to the agent, rather than polled from the shared filesystem. Synced mounts
+/// are not limited in size or number of files.
 #[derive(Debug, Default)]
 pub struct BindWatcher {
     /// Container ID -> Vec of watched entries
     sandbox_storages: Arc<Mutex<HashMap<String, SandboxStorages>>>,
     watch_thread: Option<task::JoinHandle<()>>,
+    /// Container ID -> Vec of synced mount points
+    synced_storages: HashMap<String, Vec<PathBuf>>,
+    mounted: bool,
 }
 
 impl Drop for BindWatcher {
@@ -451,7 +567,7 @@ impl BindWatcher {
     ) -> Result<()> {
         if self.watch_thread.is_none() {
             // Virtio-fs shared path is RO by default, so we back the target-mounts by tmpfs.
-            self.mount(logger).await?;
+            self.mount_once(logger).await?;
 
             // Spawn background thread to monitor changes
             self.watch_thread = Some(Self::spawn_watcher(
@@ -473,8 +589,64 @@ impl BindWatcher {
         Ok(())
     }
 
-    pub async fn remove_container(&self, id: &str) {
+    pub async fn remove_container(&mut self, id: &str) {
         self.sandbox_storages.lock().await.remove(id);
+
+        for mount_point in self.synced_storages.remove(id).unwrap_or_default() {
+            if mount_point.is_dir() {
+                let _ = std::fs::remove_dir_all(&mount_point);
+            } else {
+                let _ = std::fs::remove_file(&mount_point);
+            }
+        }
+    }
+
+    /// Registers the synced watchable mount of a container, which is removed along with
+    /// the container. Its content is synced by the runtime, before and after the
+    /// container creation.
+    pub async fn add_synced_container(
+        &mut self,
+        id: String,
+        storage: &protos::Storage,
+        logger: &Logger,
+    ) -> Result<()> {
+        self.mount_once(logger).await?;
+
+        self.synced_storages
+            .entry(id)
+            .or_insert_with(Vec::new)
+            .push(PathBuf::from(&storage.mount_point));
+
+        Ok(())
+    }
+
+    /// Applies the changes of a watchable mount synced by the runtime to its mount point.
+    pub async fn sync(
+        &mut self,
+        req: &protos::SyncWatchableMountRequest,
+        logger: &Logger,
+    ) -> Result<()> {
+        let mount_point = Path::new(&req.mount_point);
+        ensure!(
+            mount_point.parent() == Some(Path::new(WATCH_MOUNT_POINT_PATH))
+                && mount_point.file_name().is_some(),
+            "invalid watchable mount point {}",
+            mount_point.display()
+        );
+
+        self.mount_once(logger).await?;
+
+        for entry in req.entries.iter() {
+            apply_sync_entry(mount_point, entry)
+                .with_context(|| format!("sync watchable entry {:?}", entry.path))?;
+        }
+
+        for path in req.removed.iter() {
+            remove_sync_entry(mount_point, path)
+                .with_context(|| format!("remove watchable entry {:?}", path))?;
+        }
+
+        Ok(())
     }
 
     fn spawn_watcher(
@@ -499,6 +671,14 @@ impl BindWatcher {
         })
     }
 
+    async fn mount_once(&mut self, logger: &Logger) -> Result<()> {
+        if !self.mounted {
+            self.mount(logger).await?;
+            self.mounted = true;
+        }
+        Ok(())
+    }
+
     async fn mount(&self, logger: &Logger) -> Result<()> {
         fs::create_dir_all(WATCH_MOUNT_POINT_PATH).await?;
 
@@ -521,6 +701,7 @@ impl BindWatcher {
         }
 
         let _ = umount(WATCH_MOUNT_POINT_PATH);
+        self.mounted = false;
     }
 }
 
@@ -1267,6 +1448,105 @@ mod tests {
 
     use serial_test::serial;
 
+    fn sync_entry(path: &str, mode: u32) -> protos::WatchableEntry {
+        protos::WatchableEntry {
+            path: path.to_string(),
+            mode,
+            uid: nix::unistd::getuid().as_raw(),
+            gid: nix::unistd::getgid().as_raw(),
+            ..Default::default()
+        }
+    }
+
+    #[test]
+    fn test_apply_sync_entries() {
+        let dir = tempfile::tempdir().unwrap();
+        let mount_point = dir.path().join("config");
+
+        apply_sync_entry(&mount_point, &sync_entry("", libc::S_IFDIR | 0o755)).unwrap();
+        apply_sync_entry(&mount_point, &sync_entry("..v1", libc::S_IFDIR | 0o755)).unwrap();
+        assert!(mount_point.join("..v1").is_dir());
+
+        // A file is updated once all its chunks are received
+        let mut chunk = sync_entry("..v1/key", libc::S_IFREG | 0o640);
+        chunk.size = 6;
+        chunk.data = b"val".to_vec();
+        apply_sync_entry(&mount_point, &chunk).unwrap();
+        assert!(!mount_point.join("..v1/key").exists());
+
+        chunk.offset = 3;
+        chunk.data = b"ue1".to_vec();
+        apply_sync_entry(&mount_point, &chunk).unwrap();
+        assert_eq!(
+            fs::read_to_string(mount_point.join("..v1/key")).unwrap(),
+            "value1"
+        );
+        assert_eq!(
+            fs::metadata(mount_point.join("..v1/key"))
+                .unwrap()
+                .permissions()
+                .mode()
+                & 0o7777,
+            0o640
+        );
+        assert!(!mount_point.join("..v1/.key.kata-sync").exists());
+
+        let mut link = sync_entry("..data", libc::S_IFLNK | 0o777);
+        link.link_target = "..v1".to_string();
+        apply_sync_entry(&mount_point, &link).unwrap();
+        let mut link = sync_entry("key", libc::S_IFLNK | 0o777);
+        link.link_target = "..data/key".to_string();
+        apply_sync_entry(&mount_point, &link).unwrap();
+        assert_eq!(
+            fs::read_to_string(mount_point.join("key")).unwrap(),
+            "value1"
+        );
+
+        // Atomic update
+        apply_sync_entry(&mount_point, &sync_entry("..v2", libc::S_IFDIR | 0o755)).unwrap();
+        let mut file = sync_entry("..v2/key", libc::S_IFREG | 0o644);
+        file.size = 6;
+        file.data = b"value2".to_vec();
+        apply_sync_entry(&mount_point, &file).unwrap();
+        let mut link = sync_entry("..data", libc::S_IFLNK | 0o777);
+        link.link_target = "..v2".to_string();
+        apply_sync_entry(&mount_point, &link).unwrap();
+        remove_sync_entry(&mount_point, "..v1/key").unwrap();
+        remove_sync_entry(&mount_point, "..v1").unwrap();
+
+        assert_eq!(
+            fs::read_to_string(mount_point.join("key")).unwrap(),
+            "value2"
+        );
+        assert!(!mount_point.join("..v1").exists());
+
+        // Removing a missing entry is not an error
+        remove_sync_entry(&mount_point, "..v0").unwrap();
+
+        // Entries can't escape the mount point
+        assert!(apply_sync_entry(&mount_point, &sync_entry("../x", libc::S_IFDIR)).is_err());
+        assert!(remove_sync_entry(&mount_point, "/etc").is_err());
+        assert!(apply_sync_entry(&mount_point, &sync_entry("fifo", libc::S_IFIFO)).is_err());
+    }
+
+    #[tokio::test]
+    async fn test_sync_invalid_mount_point() {
+        let logger = slog::Logger::root(slog::Discard, o!());
+        let mut watcher = BindWatcher::default();
+
+        for mount_point in &[
+            "/etc/passwd",
+            "/run/kata-containers/shared/containers/watchable/../x",
+            "/run/kata-containers/shared/containers/watchable",
+        ] {
+            let req = protos::SyncWatchableMountRequest {
+                mount_point: mount_point.to_string(),
+                ..Default::default()
+            };
+            assert!(watcher.sync(&req, &logger).await.is_err());
+        }
+    }
+
     #[tokio::test]
     #[serial]
     async fn create_tmpfs() {
@@ -9,7 +9,7 @@ use std::io;
 use std::io::Write;
 use std::process;
 use std::result;
-use std::sync::Mutex;
+use std::sync::{Arc, Mutex};
 
 const LOG_LEVELS: &[(&str, slog::Level)] = &[
     ("trace", slog::Level::Trace),
@@ -29,6 +29,22 @@ pub fn create_logger<W>(
     level: slog::Level,
     writer: W,
 ) -> (slog::Logger, slog_async::AsyncGuard)
+where
+    W: Write + Send + Sync + 'static,
+{
+    let (logger, guard, _) = create_logger_with_level_handle(name, source, level, writer);
+
+    (logger, guard)
+}
+
+// Like create_logger, but also returns a handle to change the log level of
+// the logger at runtime.
+pub fn create_logger_with_level_handle<W>(
+    name: &str,
+    source: &str,
+    level: slog::Level,
+    writer: W,
+) -> (slog::Logger, slog_async::AsyncGuard, LogLevelHandle)
 where
     W: Write + Send + Sync + 'static,
 {
@@ -41,7 +57,8 @@ where
     let unique_drain = UniqueDrain::new(json_drain).fuse();
 
     // Allow runtime filtering of records by log level
-    let filter_drain = RuntimeLevelFilter::new(unique_drain, level).fuse();
+    let level_handle = LogLevelHandle::new(level);
+    let filter_drain = RuntimeLevelFilter::new(unique_drain, level_handle.clone()).fuse();
 
     // Ensure the logger is thread-safe
     let (async_drain, guard) = slog_async::Async::new(filter_drain)
@@ -58,7 +75,7 @@ where
             "source" => source.to_string()),
     );
 
-    (logger, guard)
+    (logger, guard, level_handle)
 }
 
 pub fn get_log_levels() -> Vec<&'static str> {
@@ -177,19 +194,44 @@ where
     }
 }
 
+// A LogLevelHandle changes the log level of a logger at runtime.
+#[derive(Clone, Debug)]
+pub struct LogLevelHandle {
+    level: Arc<Mutex<slog::Level>>,
+}
+
+impl LogLevelHandle {
+    pub fn new(level: slog::Level) -> Self {
+        LogLevelHandle {
+            level: Arc::new(Mutex::new(level)),
+        }
+    }
+
+    pub fn level(&self) -> slog::Level {
+        *self.level.lock().unwrap()
+    }
+
+    pub fn set_level(&self, level: slog::Level) {
+        *self.level.lock().unwrap() = level;
+    }
+}
+
+impl Default for LogLevelHandle {
+    fn default() -> Self {
+        LogLevelHandle::new(slog::Level::Info)
+    }
+}
+
 // A RuntimeLevelFilter will discard all log records whose log level is less than the level
 // specified in the struct.
 struct RuntimeLevelFilter<D> {
     drain: D,
-    level: Mutex<slog::Level>,
+    level: LogLevelHandle,
 }
 
 impl<D> RuntimeLevelFilter<D> {
-    fn new(drain: D, level: slog::Level) -> Self {
-        RuntimeLevelFilter {
-            drain,
-            level: Mutex::new(level),
-        }
+    fn new(drain: D, level: LogLevelHandle) -> Self {
+        RuntimeLevelFilter { drain, level }
     }
 }
 
@@ -205,9 +247,9 @@ where
         record: &slog::Record,
         values: &slog::OwnedKVList,
     ) -> result::Result<Self::Ok, Self::Err> {
-        let log_level = self.level.lock().unwrap();
+        let log_level = self.level.level();
 
-        if record.level().is_at_least(*log_level) {
+        if record.level().is_at_least(log_level) {
             self.drain.log(record, values)?;
         }
 
@@ -599,4 +641,32 @@ mod tests {
             assert_eq!(field_subsystem, &json!(DEFAULT_SUBSYSTEM), "{}", msg);
         }
     }
+
+    #[test]
+    fn test_log_level_handle() {
+        let writer = NamedTempFile::new().expect("failed to create tempfile");
+        let mut writer_ref = writer.reopen().expect("failed to clone tempfile");
+
+        let (logger, guard, level_handle) =
+            create_logger_with_level_handle("name", "source", slog::Level::Warning, writer);
+        assert_eq!(level_handle.level(), slog::Level::Warning);
+
+        info!(&logger, "filtered");
+
+        level_handle.set_level(slog::Level::Info);
+        assert_eq!(level_handle.level(), slog::Level::Info);
+
+        info!(&logger, "logged");
+
+        drop(guard);
+        drop(logger);
+
+        let mut contents = String::new();
+        writer_ref
+            .read_to_string(&mut contents)
+            .expect("failed to read tempfile contents");
+
+        assert!(!contents.contains("filtered"));
+        assert!(contents.contains("logged"));
+    }
 }
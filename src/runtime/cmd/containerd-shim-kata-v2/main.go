@@ -19,6 +19,9 @@ import (
 func shimConfig(config *shimapi.Config) {
 	config.NoReaper = true
 	config.NoSubreaper = true
+	// A shim adopting a sandbox inherits the log fifo of the shim it takes
+	// over from, containerd not reading it meanwhile.
+	config.NoSetupLogger = os.Getenv(shim.HandoverStateEnv) != ""
 }
 
 func main() {
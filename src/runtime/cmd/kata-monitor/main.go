@@ -6,11 +6,14 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	goruntime "runtime"
+	"strings"
 	"text/template"
 	"time"
 
@@ -23,6 +26,10 @@ const defaultListenAddress = "127.0.0.1:8090"
 var monitorListenAddr = flag.String("listen-address", defaultListenAddress, "The address to listen on for HTTP requests.")
 var runtimeEndpoint = flag.String("runtime-endpoint", "/run/containerd/containerd.sock", "Endpoint of CRI container runtime service.")
 var logLevel = flag.String("log-level", "info", "Log level of logrus(trace/debug/info/warn/error/fatal/panic).")
+var pushURL = flag.String("push-url", "", "URL of the collector the metrics are pushed to, e.g. the one of a Prometheus Pushgateway job or of an OTLP/HTTP collector.")
+var pushFormat = flag.String("push-format", kataMonitor.PushFormatText, "Format of the pushed metrics: text for the Prometheus text format, otlp for the OTLP/HTTP protocol.")
+var pushInterval = flag.Duration("push-interval", 30*time.Second, "Interval of the pushes of the metrics, when push-url is set.")
+var podLabels = flag.String("pod-labels", "", "Comma separated list of the pod labels added to the sandbox metrics, as cri_label_<name> labels.")
 
 // These values are overridden via ldflags
 var (
@@ -99,27 +106,40 @@ func main() {
 		"listen-address":   *monitorListenAddr,
 		"runtime-endpoint": *runtimeEndpoint,
 		"log-level":        *logLevel,
+		"pod-labels":       *podLabels,
+		"push-url":         redactURL(*pushURL),
+		"push-format":      *pushFormat,
+		"push-interval":    *pushInterval,
 	}
 
 	logrus.WithFields(announceFields).Info("announce")
 
 	// create new kataMonitor
-	km, err := kataMonitor.NewKataMonitor(*runtimeEndpoint)
+	km, err := kataMonitor.NewKataMonitor(*runtimeEndpoint, splitPodLabels(*podLabels))
 	if err != nil {
 		panic(err)
 	}
 
+	// push the metrics for the nodes which cannot be scraped
+	if *pushURL != "" {
+		pusher, err := kataMonitor.NewMetricsPusher(km, *pushURL, *pushFormat, *pushInterval)
+		if err != nil {
+			panic(err)
+		}
+		go pusher.Run(context.Background())
+	}
+
 	// setup handlers, currently only metrics are supported
 	m := http.NewServeMux()
 	endpoints = []endpoint{
 		{
 			path:    "/metrics",
-			desc:    "Get metrics from sandboxes.",
+			desc:    "Get metrics from sandboxes, filtered by the namespace, pod and labels query parameters.",
 			handler: km.ProcessMetricsRequest,
 		},
 		{
 			path:    "/sandboxes",
-			desc:    "List all Kata Containers sandboxes.",
+			desc:    "List all Kata Containers sandboxes, filtered by the namespace, pod and labels query parameters.",
 			handler: km.ListSandboxes,
 		},
 		{
@@ -132,6 +152,11 @@ func main() {
 			desc:    "Golang pprof `/debug/vars` endpoint for kata runtime shim process.",
 			handler: km.ExpvarHandler,
 		},
+		{
+			path:    "/debug/state",
+			desc:    "JSON dump of the sandbox state and of the task API calls in progress of the kata runtime shim process.",
+			handler: km.DebugStateHandler,
+		},
 		{
 			path:    "/debug/pprof/",
 			desc:    "Golang pprof `/debug/pprof/` endpoint for kata runtime shim process.",
@@ -174,6 +199,26 @@ func main() {
 	logrus.Fatal(svr.ListenAndServe())
 }
 
+// splitPodLabels returns the keys of the comma separated list of pod labels.
+func splitPodLabels(labels string) []string {
+	var keys []string
+	for _, key := range strings.Split(labels, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// redactURL hides the password of the URL, if any.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.Redacted()
+}
+
 func indexPage(w http.ResponseWriter, r *http.Request) {
 	htmlResponse := kataMonitor.IfReturnHTMLResponse(w, r)
 	if htmlResponse {
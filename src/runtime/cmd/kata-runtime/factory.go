@@ -7,6 +7,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"os"
@@ -20,6 +21,7 @@ import (
 	pb "github.com/kata-containers/kata-containers/src/runtime/protocols/cache"
 	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
 	vf "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/factory"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/factory/template"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"golang.org/x/sys/unix"
@@ -31,6 +33,7 @@ var factorySubCmds = []cli.Command{
 	initFactoryCommand,
 	destroyFactoryCommand,
 	statusFactoryCommand,
+	templateFactoryCommand,
 }
 
 var factoryCLICommand = cli.Command{
@@ -325,3 +328,282 @@ var statusFactoryCommand = cli.Command{
 		return nil
 	},
 }
+
+var templateFactoryCommand = cli.Command{
+	Name:  "template",
+	Usage: "manage the VM templates of the VM factory",
+	Subcommands: []cli.Command{
+		listTemplateCommand,
+		inspectTemplateCommand,
+		destroyTemplateCommand,
+		rebuildTemplateCommand,
+	},
+	Action: func(context *cli.Context) {
+		cli.ShowSubcommandHelp(context)
+	},
+}
+
+// templateInfo describes the VM template stored in a template path.
+type templateInfo struct {
+	Path       string
+	MemoryFile string
+	StateFile  string
+	MemorySize int64
+	StateSize  int64
+	// Ready is true when both the memory and the device state files exist,
+	// i.e. when VMs can be created from the template.
+	Ready bool
+	// Mounted is true when the template path is a tmpfs mount.
+	Mounted bool
+}
+
+// cachedVM describes a VM held by the VM cache server.
+type cachedVM struct {
+	Pid    int64
+	CPU    uint32
+	Memory uint32
+}
+
+// templateDetails is the output of "kata-runtime factory template inspect".
+type templateDetails struct {
+	Template        templateInfo
+	HypervisorType  string
+	NumVCPUs        uint32
+	MemorySize      uint32
+	VMCacheEndpoint string     `json:",omitempty"`
+	VMCachePid      int64      `json:",omitempty"`
+	VMCache         []cachedVM `json:",omitempty"`
+}
+
+func getTemplateInfo(templatePath string) templateInfo {
+	info := templateInfo{
+		Path:       templatePath,
+		MemoryFile: filepath.Join(templatePath, "memory"),
+		StateFile:  filepath.Join(templatePath, "state"),
+	}
+
+	memory, err := os.Stat(info.MemoryFile)
+	if err == nil {
+		info.MemorySize = memory.Size()
+	}
+	state, err2 := os.Stat(info.StateFile)
+	if err2 == nil {
+		info.StateSize = state.Size()
+	}
+	info.Ready = err == nil && err2 == nil
+
+	var st unix.Statfs_t
+	if unix.Statfs(templatePath, &st) == nil && st.Type == unix.TMPFS_MAGIC {
+		// the template tmpfs is mounted on the template path itself,
+		// so make sure the parent directory is not the tmpfs.
+		var parent unix.Statfs_t
+		if unix.Statfs(filepath.Dir(templatePath), &parent) != nil || parent.Fsid != st.Fsid {
+			info.Mounted = true
+		}
+	}
+
+	return info
+}
+
+func templateFactoryConfig(runtimeConfig oci.RuntimeConfig) vf.Config {
+	return vf.Config{
+		Template:     true,
+		TemplatePath: runtimeConfig.FactoryConfig.TemplatePath,
+		VMConfig: vc.VMConfig{
+			HypervisorType:   runtimeConfig.HypervisorType,
+			HypervisorConfig: runtimeConfig.HypervisorConfig,
+			AgentConfig:      runtimeConfig.AgentConfig,
+		},
+	}
+}
+
+// getTemplateRuntimeConfig returns the runtime configuration of the
+// template commands, which require VM templating to be enabled.
+func getTemplateRuntimeConfig(c *cli.Context) (oci.RuntimeConfig, error) {
+	runtimeConfig, ok := c.App.Metadata["runtimeConfig"].(oci.RuntimeConfig)
+	if !ok {
+		return oci.RuntimeConfig{}, errors.New("invalid runtime config")
+	}
+
+	if !runtimeConfig.FactoryConfig.Template {
+		return oci.RuntimeConfig{}, errors.New("vm templating is not enabled")
+	}
+
+	return runtimeConfig, nil
+}
+
+// getVMCacheStatus returns the status of the VM cache server, or nil when
+// the VM cache is not enabled or the server is not running.
+func getVMCacheStatus(ctx context.Context, runtimeConfig oci.RuntimeConfig) *pb.GrpcStatus {
+	if runtimeConfig.FactoryConfig.VMCacheNumber == 0 {
+		return nil
+	}
+
+	endpoint := runtimeConfig.FactoryConfig.VMCacheEndpoint
+	if _, err := os.Stat(endpoint); err != nil {
+		return nil
+	}
+
+	conn, err := grpc.Dial(fmt.Sprintf("unix://%s", endpoint), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		kataLog.WithError(err).WithField("endpoint", endpoint).Warn("failed to connect VM cache server")
+		return nil
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	status, err := pb.NewCacheServiceClient(conn).Status(ctx, &types.Empty{})
+	if err != nil {
+		kataLog.WithError(err).WithField("endpoint", endpoint).Warn("failed to call gRPC Status")
+		return nil
+	}
+
+	return status
+}
+
+// destroyTemplate closes the template through the factory API, and falls
+// back to unmounting and removing the template path when the template
+// cannot be loaded, e.g. because its creation was interrupted.
+func destroyTemplate(ctx context.Context, runtimeConfig oci.RuntimeConfig) error {
+	if status := getVMCacheStatus(ctx, runtimeConfig); status != nil {
+		return fmt.Errorf("the template is in use by the VM cache server (pid %d), use \"kata-runtime factory destroy\" instead", status.Pid)
+	}
+
+	factoryConfig := templateFactoryConfig(runtimeConfig)
+	kataLog.WithField("factory", factoryConfig).Info("load vm factory")
+	f, err := vf.NewFactory(ctx, factoryConfig, true)
+	if err == nil {
+		f.CloseFactory(ctx)
+		return nil
+	}
+	kataLog.WithError(err).Info("load vm factory failed, removing template path")
+
+	return template.Destroy(factoryConfig.TemplatePath)
+}
+
+var listTemplateCommand = cli.Command{
+	Name:  "list",
+	Usage: "list the VM templates",
+	Action: func(c *cli.Context) error {
+		ctx, err := cliContextToContext(c)
+		if err != nil {
+			return err
+		}
+
+		runtimeConfig, err := getTemplateRuntimeConfig(c)
+		if err != nil {
+			return err
+		}
+
+		info := getTemplateInfo(runtimeConfig.FactoryConfig.TemplatePath)
+		state := "absent"
+		if info.Ready {
+			state = "ready"
+		} else if info.Mounted || info.MemorySize > 0 {
+			state = "incomplete"
+		}
+		fmt.Fprintf(defaultOutputFile, "%s\t%s\t%d\n", info.Path, state, info.MemorySize+info.StateSize)
+
+		if status := getVMCacheStatus(ctx, runtimeConfig); status != nil {
+			for _, vs := range status.Vmstatus {
+				fmt.Fprintf(defaultOutputFile, "%s\tcached VM pid = %d Cpu = %d Memory = %dMiB\n", info.Path, vs.Pid, vs.Cpu, vs.Memory)
+			}
+		}
+
+		return nil
+	},
+}
+
+var inspectTemplateCommand = cli.Command{
+	Name:  "inspect",
+	Usage: "display the details of the VM template in JSON format",
+	Action: func(c *cli.Context) error {
+		ctx, err := cliContextToContext(c)
+		if err != nil {
+			return err
+		}
+
+		runtimeConfig, err := getTemplateRuntimeConfig(c)
+		if err != nil {
+			return err
+		}
+
+		details := templateDetails{
+			Template:       getTemplateInfo(runtimeConfig.FactoryConfig.TemplatePath),
+			HypervisorType: string(runtimeConfig.HypervisorType),
+			NumVCPUs:       runtimeConfig.HypervisorConfig.NumVCPUs,
+			MemorySize:     runtimeConfig.HypervisorConfig.MemorySize,
+		}
+
+		if status := getVMCacheStatus(ctx, runtimeConfig); status != nil {
+			details.VMCacheEndpoint = runtimeConfig.FactoryConfig.VMCacheEndpoint
+			details.VMCachePid = status.Pid
+			for _, vs := range status.Vmstatus {
+				details.VMCache = append(details.VMCache, cachedVM{
+					Pid:    vs.Pid,
+					CPU:    vs.Cpu,
+					Memory: vs.Memory,
+				})
+			}
+		}
+
+		encoder := json.NewEncoder(defaultOutputFile)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(details)
+	},
+}
+
+var destroyTemplateCommand = cli.Command{
+	Name:  "destroy",
+	Usage: "destroy the VM template and unmount its tmpfs",
+	Action: func(c *cli.Context) error {
+		ctx, err := cliContextToContext(c)
+		if err != nil {
+			return err
+		}
+
+		runtimeConfig, err := getTemplateRuntimeConfig(c)
+		if err != nil {
+			return err
+		}
+
+		if err := destroyTemplate(ctx, runtimeConfig); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(defaultOutputFile, "vm template destroyed")
+		return nil
+	},
+}
+
+var rebuildTemplateCommand = cli.Command{
+	Name:  "rebuild",
+	Usage: "destroy the VM template and create a new one from the current configuration",
+	Action: func(c *cli.Context) error {
+		ctx, err := cliContextToContext(c)
+		if err != nil {
+			return err
+		}
+
+		runtimeConfig, err := getTemplateRuntimeConfig(c)
+		if err != nil {
+			return err
+		}
+
+		if err := destroyTemplate(ctx, runtimeConfig); err != nil {
+			return err
+		}
+
+		factoryConfig := templateFactoryConfig(runtimeConfig)
+		kataLog.WithField("factory", factoryConfig).Info("create vm factory")
+		if _, err := vf.NewFactory(ctx, factoryConfig, false); err != nil {
+			kataLog.WithError(err).Error("create vm factory failed")
+			return err
+		}
+
+		fmt.Fprintln(defaultOutputFile, "vm template rebuilt")
+		return nil
+	},
+}
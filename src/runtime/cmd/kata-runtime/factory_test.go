@@ -8,6 +8,8 @@ package main
 import (
 	"context"
 	"flag"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -148,3 +150,71 @@ func TestFactoryCLIFunctionStatus(t *testing.T) {
 	err = fn(ctx)
 	assert.Nil(err)
 }
+
+func TestFactoryCLIFunctionTemplate(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpdir := t.TempDir()
+
+	runtimeConfig, err := newTestRuntimeConfig(tmpdir, testConsole, true)
+	assert.NoError(err)
+
+	set := flag.NewFlagSet("", 0)
+
+	set.String("console-socket", "", "")
+
+	ctx := createCLIContext(set)
+	ctx.App.Name = "foo"
+
+	commands := []cli.Command{
+		listTemplateCommand,
+		inspectTemplateCommand,
+		destroyTemplateCommand,
+		rebuildTemplateCommand,
+	}
+
+	// No template
+	ctx.App.Metadata["runtimeConfig"] = runtimeConfig
+	for _, cmd := range commands {
+		fn, ok := cmd.Action.(func(context *cli.Context) error)
+		assert.True(ok)
+		assert.Error(fn(ctx), cmd.Name)
+	}
+
+	// With template
+	runtimeConfig.FactoryConfig.Template = true
+	runtimeConfig.FactoryConfig.TemplatePath = filepath.Join(tmpdir, "template")
+	runtimeConfig.HypervisorType = vc.MockHypervisor
+	ctx.App.Metadata["runtimeConfig"] = runtimeConfig
+	for _, cmd := range commands[:3] {
+		fn, ok := cmd.Action.(func(context *cli.Context) error)
+		assert.True(ok)
+		assert.NoError(fn(ctx), cmd.Name)
+	}
+}
+
+func TestGetTemplateInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	templatePath := filepath.Join(t.TempDir(), "template")
+
+	info := getTemplateInfo(templatePath)
+	assert.Equal(filepath.Join(templatePath, "memory"), info.MemoryFile)
+	assert.Equal(filepath.Join(templatePath, "state"), info.StateFile)
+	assert.False(info.Ready)
+	assert.False(info.Mounted)
+
+	assert.NoError(os.MkdirAll(templatePath, 0700))
+	assert.NoError(os.WriteFile(info.MemoryFile, []byte("memory"), 0600))
+
+	info = getTemplateInfo(templatePath)
+	assert.False(info.Ready)
+	assert.Equal(int64(6), info.MemorySize)
+
+	assert.NoError(os.WriteFile(info.StateFile, []byte("state"), 0600))
+
+	info = getTemplateInfo(templatePath)
+	assert.True(info.Ready)
+	assert.Equal(int64(5), info.StateSize)
+	assert.False(info.Mounted)
+}
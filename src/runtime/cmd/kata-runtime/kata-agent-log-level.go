@@ -0,0 +1,43 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+
+	containerdshim "github.com/kata-containers/kata-containers/src/runtime/pkg/containerd-shim-v2"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/utils/shimclient"
+	"github.com/urfave/cli"
+)
+
+var kataAgentLogLevelCLICommand = cli.Command{
+	Name:      "agent-log-level",
+	Usage:     "change the log level of the agent of a running sandbox",
+	UsageText: "agent-log-level <sandbox id> <trace|debug|info|warn|error|critical>",
+	Action: func(context *cli.Context) error {
+		sandboxID := context.Args().Get(0)
+
+		if err := katautils.VerifyContainerID(sandboxID); err != nil {
+			return err
+		}
+
+		level := context.Args().Get(1)
+		if level == "" {
+			return errors.New("missing log level")
+		}
+
+		encoded, err := json.Marshal(containerdshim.AgentLogLevelRequest{
+			Level: level,
+		})
+		if err != nil {
+			return err
+		}
+
+		return shimclient.DoPost(sandboxID, defaultTimeout, containerdshim.AgentLogLevelUrl, encoded)
+	},
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/oci"
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/compatoci"
+	"github.com/urfave/cli"
+)
+
+const defaultBenchBootCount = 10
+
+// The phases of the boot of a sandbox, in order
+const (
+	bootPhaseHypervisorStart = "hypervisor-start"
+	bootPhaseAgentReady      = "agent-ready"
+	bootPhaseContainerStart  = "container-start"
+	bootPhaseTotal           = "total"
+)
+
+var bootPhases = []string{bootPhaseHypervisorStart, bootPhaseAgentReady, bootPhaseContainerStart, bootPhaseTotal}
+
+// bootSample holds the timestamps of the boot of a sandbox.
+type bootSample struct {
+	start             time.Time
+	hypervisorStarted time.Time
+	agentConnected    time.Time
+	containerStarted  time.Time
+}
+
+// bootPhaseStats holds the percentiles of the duration of a boot phase, in
+// milliseconds.
+type bootPhaseStats struct {
+	Phase string  `json:"phase"`
+	P50   float64 `json:"p50_ms"`
+	P95   float64 `json:"p95_ms"`
+	P99   float64 `json:"p99_ms"`
+}
+
+var kataBenchCLICommand = cli.Command{
+	Name:  "bench",
+	Usage: "benchmark sandboxes with the current configuration",
+	Subcommands: []cli.Command{
+		{
+			Name:  "boot",
+			Usage: "create, start and destroy sandboxes, reporting the percentiles of the duration of each boot phase",
+			Description: `The container of the OCI bundle is run in each sandbox, and should exit
+   or be stopped quickly, e.g. "sleep 1". The phases are:
+
+   hypervisor-start  from the creation of the sandbox to the boot of the VM
+   agent-ready       from the boot of the VM to the start of the sandbox by the agent
+   container-start   from the start of the sandbox to the start of the container
+   total             from the creation of the sandbox to the start of the container`,
+			Flags: []cli.Flag{
+				cli.UintFlag{
+					Name:  "count, n",
+					Value: defaultBenchBootCount,
+					Usage: "number of sandboxes created",
+				},
+				cli.StringFlag{
+					Name:  "bundle, b",
+					Value: ".",
+					Usage: "path to the root of the OCI bundle of the container",
+				},
+				cli.BoolFlag{
+					Name:  "json",
+					Usage: "display the results in JSON format",
+				},
+			},
+			Action: func(context *cli.Context) error {
+				runtimeConfig, ok := context.App.Metadata["runtimeConfig"].(oci.RuntimeConfig)
+				if !ok {
+					return errors.New("bench: cannot determine runtime config")
+				}
+
+				count := context.Uint("count")
+				if count == 0 {
+					return errors.New("bench: the count must be positive")
+				}
+
+				bundle, err := filepath.Abs(context.String("bundle"))
+				if err != nil {
+					return err
+				}
+
+				ctx, err := cliContextToContext(context)
+				if err != nil {
+					return err
+				}
+
+				katautils.HandleFactory(ctx, vci, &runtimeConfig)
+
+				var samples []bootSample
+				for i := uint(0); i < count; i++ {
+					sample, err := benchBoot(ctx, runtimeConfig, bundle, fmt.Sprintf("kata-bench-%d-%d", os.Getpid(), i))
+					if err != nil {
+						return fmt.Errorf("sandbox %d: %v", i, err)
+					}
+					samples = append(samples, sample)
+				}
+
+				return writeBootPhaseStats(defaultOutputFile, bootPhaseStatsFromSamples(samples), context.Bool("json"))
+			},
+		},
+	},
+}
+
+// benchBoot creates and starts a sandbox running the container of the bundle,
+// then destroys it.
+func benchBoot(ctx context.Context, runtimeConfig oci.RuntimeConfig, bundle, id string) (sample bootSample, err error) {
+	// the spec is changed by the creation of the sandbox
+	spec, err := compatoci.ParseConfigJSON(bundle)
+	if err != nil {
+		return sample, err
+	}
+
+	containerType, err := oci.ContainerType(spec)
+	if err != nil {
+		return sample, err
+	}
+	if containerType != vc.SingleContainer && containerType != vc.PodSandbox {
+		return sample, fmt.Errorf("invalid container type %q, the bundle must create a sandbox", containerType)
+	}
+
+	if spec.Root == nil {
+		return sample, errors.New("the bundle has no root filesystem")
+	}
+	if !filepath.IsAbs(spec.Root.Path) {
+		spec.Root.Path = filepath.Join(bundle, spec.Root.Path)
+	}
+
+	runtimeConfig.SandboxCPUs, runtimeConfig.SandboxMemMB = oci.CalculateContainerSizing(&spec)
+
+	var lock sync.Mutex
+	ctx = vc.WithLifecycleEventHandler(ctx, func(event vc.LifecycleEvent) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		switch event.Type {
+		case vc.HypervisorStartedEvent:
+			sample.hypervisorStarted = event.Timestamp
+		case vc.AgentConnectedEvent:
+			sample.agentConnected = event.Timestamp
+		}
+	})
+
+	sample.start = time.Now()
+
+	rootFs := vc.RootFs{Target: spec.Root.Path, Mounted: true}
+	sandbox, _, err := katautils.CreateSandbox(ctx, vci, spec, runtimeConfig, rootFs, id, bundle, "", true, false)
+	if err != nil {
+		return sample, err
+	}
+
+	defer func() {
+		if e := sandbox.Stop(ctx, true); e != nil && err == nil {
+			err = e
+		}
+		if e := sandbox.Delete(ctx); e != nil && err == nil {
+			err = e
+		}
+	}()
+
+	if err = sandbox.Start(ctx); err != nil {
+		return sample, err
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	sample.containerStarted = time.Now()
+
+	return sample, nil
+}
+
+// phaseDuration returns the duration of the boot phase of the sample.
+func (s bootSample) phaseDuration(phase string) time.Duration {
+	switch phase {
+	case bootPhaseHypervisorStart:
+		return s.hypervisorStarted.Sub(s.start)
+	case bootPhaseAgentReady:
+		return s.agentConnected.Sub(s.hypervisorStarted)
+	case bootPhaseContainerStart:
+		return s.containerStarted.Sub(s.agentConnected)
+	case bootPhaseTotal:
+		return s.containerStarted.Sub(s.start)
+	}
+	return 0
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func bootPhaseStatsFromSamples(samples []bootSample) []bootPhaseStats {
+	var stats []bootPhaseStats
+
+	for _, phase := range bootPhases {
+		var durations []time.Duration
+		for _, s := range samples {
+			durations = append(durations, s.phaseDuration(phase))
+		}
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+		stats = append(stats, bootPhaseStats{
+			Phase: phase,
+			P50:   durationMs(percentile(durations, 50)),
+			P95:   durationMs(percentile(durations, 95)),
+			P99:   durationMs(percentile(durations, 99)),
+		})
+	}
+
+	return stats
+}
+
+func writeBootPhaseStats(out io.Writer, stats []bootPhaseStats, asJSON bool) error {
+	if asJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(stats)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "PHASE\tP50\tP95\tP99")
+	for _, s := range stats {
+		fmt.Fprintf(w, "%s\t%.1fms\t%.1fms\t%.1fms\n", s.Phase, s.P50, s.P95, s.P99)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercentile(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(time.Duration(0), percentile(nil, 50))
+
+	var durations []time.Duration
+	for i := 1; i <= 100; i++ {
+		durations = append(durations, time.Duration(i)*time.Millisecond)
+	}
+
+	assert.Equal(50*time.Millisecond, percentile(durations, 50))
+	assert.Equal(95*time.Millisecond, percentile(durations, 95))
+	assert.Equal(99*time.Millisecond, percentile(durations, 99))
+	assert.Equal(1*time.Millisecond, percentile(durations, 0))
+
+	assert.Equal(3*time.Millisecond, percentile(durations[:3], 99))
+	assert.Equal(2*time.Millisecond, percentile(durations[:3], 50))
+}
+
+func TestBootPhaseStats(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Now()
+	var samples []bootSample
+	for _, ms := range []time.Duration{30, 10, 20} {
+		samples = append(samples, bootSample{
+			start:             start,
+			hypervisorStarted: start.Add(ms * time.Millisecond),
+			agentConnected:    start.Add(2 * ms * time.Millisecond),
+			containerStarted:  start.Add(4 * ms * time.Millisecond),
+		})
+	}
+
+	stats := bootPhaseStatsFromSamples(samples)
+	assert.Equal([]bootPhaseStats{
+		{Phase: bootPhaseHypervisorStart, P50: 20, P95: 30, P99: 30},
+		{Phase: bootPhaseAgentReady, P50: 20, P95: 30, P99: 30},
+		{Phase: bootPhaseContainerStart, P50: 40, P95: 60, P99: 60},
+		{Phase: bootPhaseTotal, P50: 80, P95: 120, P99: 120},
+	}, stats)
+
+	var out bytes.Buffer
+	assert.NoError(writeBootPhaseStats(&out, stats[:1], false))
+	assert.Equal("PHASE             P50     P95     P99\nhypervisor-start  20.0ms  30.0ms  30.0ms\n", out.String())
+
+	out.Reset()
+	assert.NoError(writeBootPhaseStats(&out, stats, true))
+	var decoded []bootPhaseStats
+	assert.NoError(json.Unmarshal(out.Bytes(), &decoded))
+	assert.Equal(stats, decoded)
+}
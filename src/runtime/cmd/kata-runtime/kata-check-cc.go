@@ -0,0 +1,277 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"unsafe"
+
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/oci"
+	"github.com/urfave/cli"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	ccTechSEV    = "sev"
+	ccTechSEVES  = "sev-es"
+	ccTechSEVSNP = "sev-snp"
+	ccTechTDX    = "tdx"
+	ccTechSGX    = "sgx"
+
+	// SEV_ISSUE_CMD ioctl and SEV_PLATFORM_STATUS command of <linux/psp-sev.h>
+	sevIoctlIssueCmd      = 0xc0105300
+	sevCmdPlatformStatus  = 1
+	sevPlatformStatusSize = 12
+)
+
+// variables rather than consts to allow tests to modify them
+var (
+	devDir        = "/dev"
+	ccFirmwareDir = "/sys/firmware"
+	aesmSocket    = "/var/run/aesmd/aesm.socket"
+)
+
+// ccDevice is a device node required by a confidential computing technology.
+type ccDevice struct {
+	Path    string
+	Present bool
+}
+
+// ccTechnology describes the host support of a confidential computing
+// technology.
+type ccTechnology struct {
+	Name string
+	// Supported is true when the CPU supports the technology.
+	Supported bool
+	// Enabled is true when the host kernel has enabled the technology,
+	// i.e. when confidential guests can be started.
+	Enabled         bool
+	FirmwareVersion string `json:",omitempty"`
+	Devices         []ccDevice
+	// AttestationReady is true when the host provides what the guests
+	// need to get attestation evidence.
+	AttestationReady bool
+	// Missing lists the prerequisites that are not fulfilled.
+	Missing []string `json:",omitempty"`
+}
+
+// ccCheckResult is the output of "kata-runtime check cc".
+type ccCheckResult struct {
+	// Capable is true when at least one technology is enabled.
+	Capable           bool
+	ConfidentialGuest bool
+	Firmware          string `json:",omitempty"`
+	FirmwarePresent   bool
+	Technologies      []ccTechnology
+}
+
+var kataCheckCCCommand = cli.Command{
+	Name:  "cc",
+	Usage: "tests if the host can run confidential guests and displays the result in JSON format",
+	Action: func(context *cli.Context) error {
+		runtimeConfig, ok := context.App.Metadata["runtimeConfig"].(oci.RuntimeConfig)
+		if !ok {
+			return errors.New("check: cannot determine runtime config")
+		}
+
+		result, err := checkConfidentialComputing(runtimeConfig)
+		if err != nil {
+			return err
+		}
+
+		encoder := json.NewEncoder(defaultOutputFile)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	},
+}
+
+func checkConfidentialComputing(runtimeConfig oci.RuntimeConfig) (ccCheckResult, error) {
+	cpuinfo, err := getCPUInfo(procCPUInfo)
+	if err != nil {
+		return ccCheckResult{}, err
+	}
+	flags := getCPUFlags(cpuinfo)
+
+	result := ccCheckResult{
+		ConfidentialGuest: runtimeConfig.HypervisorConfig.ConfidentialGuest,
+		Firmware:          runtimeConfig.HypervisorConfig.FirmwarePath,
+		Technologies: []ccTechnology{
+			checkSEV(flags),
+			checkSEVES(flags),
+			checkSEVSNP(flags),
+			checkTDX(flags),
+			checkSGX(flags),
+		},
+	}
+
+	if result.Firmware != "" {
+		_, err := os.Stat(result.Firmware)
+		result.FirmwarePresent = err == nil
+	}
+
+	for _, tech := range result.Technologies {
+		// SGX protects enclaves, not whole guests
+		if tech.Enabled && tech.Name != ccTechSGX {
+			result.Capable = true
+		}
+	}
+
+	return result, nil
+}
+
+// kernelModuleParamEnabled returns true when the boolean kernel module
+// parameter is set, i.e. "1" or "Y" for linux >= 5.12.
+func kernelModuleParamEnabled(module, param string) bool {
+	c, err := os.ReadFile(filepath.Join(sysModuleDir, module, moduleParamDir, param))
+	return err == nil && len(c) > 0 && (c[0] == '1' || c[0] == 'Y')
+}
+
+func checkDevices(tech *ccTechnology, names ...string) {
+	for _, name := range names {
+		dev := ccDevice{Path: filepath.Join(devDir, name)}
+		if _, err := os.Stat(dev.Path); err == nil {
+			dev.Present = true
+		} else {
+			tech.Missing = append(tech.Missing, fmt.Sprintf("device %s", dev.Path))
+		}
+		tech.Devices = append(tech.Devices, dev)
+	}
+}
+
+func checkCPUFlag(tech *ccTechnology, flags, flag string) bool {
+	if findAnchoredString(flags, flag) {
+		return true
+	}
+	tech.Missing = append(tech.Missing, fmt.Sprintf("CPU flag %s", flag))
+	return false
+}
+
+func checkModuleParam(tech *ccTechnology, module, param string) bool {
+	if kernelModuleParamEnabled(module, param) {
+		return true
+	}
+	tech.Missing = append(tech.Missing, fmt.Sprintf("kernel module parameter %s.%s", module, param))
+	return false
+}
+
+// checkSEVFamily checks the SEV technology whose CPU flag and kvm_amd
+// parameter are named after param.
+func checkSEVFamily(name, flags, param string) ccTechnology {
+	tech := ccTechnology{Name: name}
+	tech.Supported = checkCPUFlag(&tech, flags, param)
+	tech.Enabled = checkModuleParam(&tech, "kvm_amd", param) && tech.Supported
+
+	// /dev/sev is used to get the platform certificates the guest owner
+	// needs to verify the launch measurement.
+	checkDevices(&tech, "sev")
+	tech.AttestationReady = tech.Enabled && tech.Devices[0].Present
+
+	if tech.Devices[0].Present {
+		version, err := sevFirmwareVersion(tech.Devices[0].Path)
+		if err != nil {
+			tech.Missing = append(tech.Missing, fmt.Sprintf("SEV platform status: %v", err))
+		}
+		tech.FirmwareVersion = version
+	}
+
+	return tech
+}
+
+func checkSEV(flags string) ccTechnology {
+	return checkSEVFamily(ccTechSEV, flags, "sev")
+}
+
+func checkSEVES(flags string) ccTechnology {
+	return checkSEVFamily(ccTechSEVES, flags, "sev_es")
+}
+
+func checkSEVSNP(flags string) ccTechnology {
+	return checkSEVFamily(ccTechSEVSNP, flags, "sev_snp")
+}
+
+func checkTDX(flags string) ccTechnology {
+	tech := ccTechnology{Name: ccTechTDX}
+
+	// the firmware directory is exported once the TDX module is loaded
+	firmware := false
+	for _, dir := range []string{"tdx", "tdx_seam"} {
+		if d, err := os.Stat(filepath.Join(ccFirmwareDir, dir)); err == nil && d.IsDir() {
+			firmware = true
+		}
+	}
+
+	tech.Supported = findAnchoredString(flags, "tdx") || findAnchoredString(flags, "tdx_host_platform") || firmware
+	if !tech.Supported {
+		tech.Missing = append(tech.Missing, "CPU flag tdx")
+	}
+	if !firmware {
+		tech.Missing = append(tech.Missing, fmt.Sprintf("TDX module in %s", ccFirmwareDir))
+	}
+	tech.Enabled = checkModuleParam(&tech, "kvm_intel", "tdx") && tech.Supported && firmware
+
+	// TD quotes are generated by the quoting enclave, which relies on the
+	// SGX flexible launch control and provisioning device.
+	sgxLC := checkCPUFlag(&tech, flags, "sgx_lc")
+	checkDevices(&tech, "sgx_provision")
+	tech.AttestationReady = tech.Enabled && sgxLC && tech.Devices[0].Present
+
+	return tech
+}
+
+func checkSGX(flags string) ccTechnology {
+	tech := ccTechnology{Name: ccTechSGX}
+	tech.Supported = checkCPUFlag(&tech, flags, "sgx")
+
+	checkDevices(&tech, "sgx_enclave", "sgx_provision")
+	tech.Enabled = tech.Supported && tech.Devices[0].Present
+
+	aesm := true
+	if _, err := os.Stat(aesmSocket); err != nil {
+		aesm = false
+		tech.Missing = append(tech.Missing, fmt.Sprintf("AESM service socket %s", aesmSocket))
+	}
+	tech.AttestationReady = tech.Enabled && tech.Devices[1].Present && aesm
+
+	return tech
+}
+
+// sevIssueCmd mirrors the packed struct sev_issue_cmd of <linux/psp-sev.h>,
+// with its 64-bit data address split as it is not naturally aligned.
+type sevIssueCmd struct {
+	cmd    uint32
+	dataLo uint32
+	dataHi uint32
+	error  uint32
+}
+
+// sevFirmwareVersion returns the "api_major.api_minor.build" version of
+// the SEV firmware from the platform status.
+func sevFirmwareVersion(device string) (string, error) {
+	f, err := os.OpenFile(device, os.O_RDWR, 0)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	// packed struct sev_user_data_status
+	status := new([sevPlatformStatusSize]byte)
+	addr := uint64(uintptr(unsafe.Pointer(status)))
+	cmd := sevIssueCmd{
+		cmd:    sevCmdPlatformStatus,
+		dataLo: uint32(addr),
+		dataHi: uint32(addr >> 32),
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, f.Fd(), sevIoctlIssueCmd, uintptr(unsafe.Pointer(&cmd))); errno != 0 {
+		return "", fmt.Errorf("%v (firmware error %d)", errno, cmd.error)
+	}
+
+	return fmt.Sprintf("%d.%d.%d", status[0], status[1], status[7]), nil
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/oci"
+)
+
+func setupCCCheck(t *testing.T, flags string) string {
+	assert := assert.New(t)
+
+	tmpdir := t.TempDir()
+
+	savedProcCPUInfo := procCPUInfo
+	savedSysModuleDir := sysModuleDir
+	savedDevDir := devDir
+	savedCCFirmwareDir := ccFirmwareDir
+	savedAesmSocket := aesmSocket
+	t.Cleanup(func() {
+		procCPUInfo = savedProcCPUInfo
+		sysModuleDir = savedSysModuleDir
+		devDir = savedDevDir
+		ccFirmwareDir = savedCCFirmwareDir
+		aesmSocket = savedAesmSocket
+	})
+
+	procCPUInfo = filepath.Join(tmpdir, "cpuinfo")
+	sysModuleDir = filepath.Join(tmpdir, "module")
+	devDir = filepath.Join(tmpdir, "dev")
+	ccFirmwareDir = filepath.Join(tmpdir, "firmware")
+	aesmSocket = filepath.Join(tmpdir, "aesm.socket")
+
+	assert.NoError(makeCPUInfoFile(procCPUInfo, "", flags))
+	assert.NoError(os.MkdirAll(devDir, testDirMode))
+	assert.NoError(os.MkdirAll(ccFirmwareDir, testDirMode))
+
+	return tmpdir
+}
+
+func findCCTechnology(result ccCheckResult, name string) ccTechnology {
+	for _, tech := range result.Technologies {
+		if tech.Name == name {
+			return tech
+		}
+	}
+	return ccTechnology{}
+}
+
+func TestCheckConfidentialComputingNone(t *testing.T) {
+	assert := assert.New(t)
+
+	setupCCCheck(t, "lm vmx sse4_1")
+
+	result, err := checkConfidentialComputing(oci.RuntimeConfig{})
+	assert.NoError(err)
+	assert.False(result.Capable)
+	assert.Len(result.Technologies, 5)
+	for _, tech := range result.Technologies {
+		assert.False(tech.Supported, tech.Name)
+		assert.False(tech.Enabled, tech.Name)
+		assert.False(tech.AttestationReady, tech.Name)
+		assert.NotEmpty(tech.Missing, tech.Name)
+	}
+}
+
+func TestCheckConfidentialComputingSEV(t *testing.T) {
+	assert := assert.New(t)
+
+	setupCCCheck(t, "lm svm sev sev_es")
+
+	param := filepath.Join(sysModuleDir, "kvm_amd", moduleParamDir)
+	assert.NoError(os.MkdirAll(param, testDirMode))
+	assert.NoError(createFile(filepath.Join(param, "sev"), "Y"))
+	assert.NoError(createFile(filepath.Join(param, "sev_es"), "N"))
+
+	result, err := checkConfidentialComputing(oci.RuntimeConfig{})
+	assert.NoError(err)
+	assert.True(result.Capable)
+
+	sev := findCCTechnology(result, ccTechSEV)
+	assert.True(sev.Supported)
+	assert.True(sev.Enabled)
+	// no /dev/sev
+	assert.False(sev.AttestationReady)
+	assert.Contains(sev.Missing, "device "+filepath.Join(devDir, "sev"))
+
+	sevES := findCCTechnology(result, ccTechSEVES)
+	assert.True(sevES.Supported)
+	assert.False(sevES.Enabled)
+
+	snp := findCCTechnology(result, ccTechSEVSNP)
+	assert.False(snp.Supported)
+}
+
+func TestCheckConfidentialComputingTDX(t *testing.T) {
+	assert := assert.New(t)
+
+	setupCCCheck(t, "lm vmx tdx sgx sgx_lc")
+
+	param := filepath.Join(sysModuleDir, "kvm_intel", moduleParamDir)
+	assert.NoError(os.MkdirAll(param, testDirMode))
+	assert.NoError(createFile(filepath.Join(param, "tdx"), "1"))
+	assert.NoError(os.MkdirAll(filepath.Join(ccFirmwareDir, "tdx"), testDirMode))
+	assert.NoError(createFile(filepath.Join(devDir, "sgx_enclave"), ""))
+	assert.NoError(createFile(filepath.Join(devDir, "sgx_provision"), ""))
+
+	result, err := checkConfidentialComputing(oci.RuntimeConfig{})
+	assert.NoError(err)
+	assert.True(result.Capable)
+
+	tdx := findCCTechnology(result, ccTechTDX)
+	assert.True(tdx.Supported)
+	assert.True(tdx.Enabled)
+	assert.True(tdx.AttestationReady)
+	assert.Empty(tdx.Missing)
+
+	sgx := findCCTechnology(result, ccTechSGX)
+	assert.True(sgx.Enabled)
+	// no AESM service
+	assert.False(sgx.AttestationReady)
+
+	assert.NoError(createFile(aesmSocket, ""))
+	result, err = checkConfidentialComputing(oci.RuntimeConfig{})
+	assert.NoError(err)
+	assert.True(findCCTechnology(result, ccTechSGX).AttestationReady)
+}
+
+func TestCheckCCCLIFunction(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpdir := setupCCCheck(t, "lm vmx sse4_1")
+
+	runtimeConfig, err := newTestRuntimeConfig(tmpdir, testConsole, true)
+	assert.NoError(err)
+
+	firmware := filepath.Join(tmpdir, "OVMF.fd")
+	assert.NoError(createFile(firmware, ""))
+	runtimeConfig.HypervisorConfig.FirmwarePath = firmware
+	runtimeConfig.HypervisorConfig.ConfidentialGuest = true
+
+	savedOutputFile := defaultOutputFile
+	defer func() {
+		defaultOutputFile = savedOutputFile
+	}()
+	output := filepath.Join(tmpdir, "output")
+	defaultOutputFile, err = os.Create(output)
+	assert.NoError(err)
+	defer defaultOutputFile.Close()
+
+	ctx := createCLIContext(nil)
+	ctx.App.Name = "foo"
+
+	fn, ok := kataCheckCCCommand.Action.(func(context *cli.Context) error)
+	assert.True(ok)
+
+	// no runtime config in the Metadata
+	assert.Error(fn(ctx))
+
+	ctx.App.Metadata["runtimeConfig"] = runtimeConfig
+	assert.NoError(fn(ctx))
+
+	data, err := os.ReadFile(output)
+	assert.NoError(err)
+
+	var result ccCheckResult
+	assert.NoError(json.Unmarshal(data, &result))
+	assert.False(result.Capable)
+	assert.True(result.ConfidentialGuest)
+	assert.True(result.FirmwarePresent)
+	assert.Len(result.Technologies, 5)
+}
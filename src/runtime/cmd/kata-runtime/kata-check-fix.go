@@ -0,0 +1,259 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/oci"
+	"golang.org/x/sys/unix"
+)
+
+// variables rather than consts to allow tests to modify them
+var (
+	sysMiscDir  = "/sys/class/misc"
+	procSysDir  = "/proc/sys"
+	procMeminfo = "/proc/meminfo"
+
+	lookupGroup = user.LookupGroup
+)
+
+// hostDevices are the device nodes of the kernel modules used by the
+// hypervisors, with their permissions and, as udev sets them, their group.
+// They can be missing when /dev is not managed by udev, e.g. in a container.
+var hostDevices = []struct {
+	name  string
+	mode  uint32
+	group string
+}{
+	{"kvm", 0660, "kvm"},
+	{"vhost-net", 0600, ""},
+	{"vhost-vsock", 0600, ""},
+}
+
+// hostFix is a change of the host configuration fixing a failed check.
+type hostFix struct {
+	desc  string
+	apply func() error
+}
+
+// getHostFixes returns the fixes of the host configuration required by the
+// runtime configuration, in the order they have to be applied.
+func getHostFixes(runtimeConfig oci.RuntimeConfig, modules map[string]kernelModule) ([]hostFix, error) {
+	var fixes []hostFix
+
+	// sort the modules to get a stable plan
+	var names []string
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if katautils.FileExists(filepath.Join(sysModuleDir, name)) {
+			continue
+		}
+		module := name
+		fixes = append(fixes, hostFix{
+			desc: fmt.Sprintf("load kernel module %s (%s)", module, modules[module].desc),
+			apply: func() error {
+				if output, err := exec.Command(modProbeCmd, module).CombinedOutput(); err != nil {
+					return fmt.Errorf("%v: %s", err, strings.TrimSpace(string(output)))
+				}
+				return nil
+			},
+		})
+	}
+
+	for _, d := range hostDevices {
+		path := filepath.Join(devDir, d.name)
+		if katautils.FileExists(path) {
+			continue
+		}
+		name, mode, group := d.name, d.mode, d.group
+		fixes = append(fixes, hostFix{
+			desc: fmt.Sprintf("create device node %s", path),
+			apply: func() error {
+				return createMiscDevice(name, path, mode, group)
+			},
+		})
+	}
+
+	if runtimeConfig.HypervisorConfig.HugePages {
+		fix, err := getHugePagesFix(runtimeConfig.HypervisorConfig.MemorySize)
+		if err != nil {
+			return nil, err
+		}
+		if fix != nil {
+			fixes = append(fixes, *fix)
+		}
+	}
+
+	return fixes, nil
+}
+
+// createMiscDevice creates the device node of a misc device, whose numbers
+// are only known once its kernel module is loaded. The node is owned by
+// group, when set, which is granted the access of the owner. The node is
+// owned by the root group when the group does not exist, rather than made
+// accessible to everyone.
+func createMiscDevice(name, path string, mode uint32, group string) error {
+	numbers, err := katautils.GetFileContents(filepath.Join(sysMiscDir, name, "dev"))
+	if err != nil {
+		return fmt.Errorf("device %s not registered, is its kernel module loaded? %v", name, err)
+	}
+
+	var major, minor uint32
+	if _, err := fmt.Sscanf(strings.TrimSpace(numbers), "%d:%d", &major, &minor); err != nil {
+		return fmt.Errorf("invalid device numbers %q of %s: %v", numbers, name, err)
+	}
+
+	gid := -1
+	if group != "" {
+		g, err := lookupGroup(group)
+		if err == nil {
+			if gid, err = strconv.Atoi(g.Gid); err != nil {
+				return fmt.Errorf("invalid gid %q of group %s: %v", g.Gid, group, err)
+			}
+		} else {
+			kataLog.WithError(err).WithField("device", path).Warn("device group not found, granting access to root only")
+			gid = 0
+		}
+	}
+
+	if err := unix.Mknod(path, unix.S_IFCHR|mode, int(unix.Mkdev(major, minor))); err != nil {
+		return err
+	}
+
+	if gid != -1 {
+		if err := os.Chown(path, -1, gid); err != nil {
+			return err
+		}
+	}
+
+	// mknod applies the umask
+	return os.Chmod(path, os.FileMode(mode))
+}
+
+// getHugePagesFix returns the fix reserving enough huge pages for a VM with
+// the default memory size, or nil if enough are reserved.
+func getHugePagesFix(memorySizeMiB uint32) (*hostFix, error) {
+	pageSizeKiB, err := getHugePageSize()
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(procSysDir, "vm", "nr_hugepages")
+	value, err := katautils.GetFileContents(path)
+	if err != nil {
+		return nil, err
+	}
+	current, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	required := (uint64(memorySizeMiB)*1024 + pageSizeKiB - 1) / pageSizeKiB
+	if current >= required {
+		return nil, nil
+	}
+
+	return &hostFix{
+		desc: fmt.Sprintf("set sysctl vm.nr_hugepages to %d (from %d) to back the %d MiB of memory of a VM", required, current, memorySizeMiB),
+		apply: func() error {
+			return os.WriteFile(path, []byte(strconv.FormatUint(required, 10)), 0644)
+		},
+	}, nil
+}
+
+// getHugePageSize returns the default size of the huge pages, in KiB.
+func getHugePageSize() (uint64, error) {
+	meminfo, err := katautils.GetFileContents(procMeminfo)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, line := range strings.Split(meminfo, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "Hugepagesize:" {
+			size, err := strconv.ParseUint(fields[1], 10, 64)
+			if err != nil || size == 0 {
+				return 0, fmt.Errorf("invalid huge page size %q", line)
+			}
+			return size, nil
+		}
+	}
+
+	return 0, fmt.Errorf("huge pages not supported by the host kernel")
+}
+
+// confirmHostFixes asks whether to apply the fixes, and returns true if the
+// answer is yes.
+func confirmHostFixes(in io.Reader, out io.Writer) bool {
+	fmt.Fprint(out, "Apply these changes? [y/N] ")
+
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && answer == "" {
+		return false
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// fixHost applies the fixes of the host configuration required by the
+// runtime configuration, after confirmation unless assumeYes is set.
+func fixHost(runtimeConfig oci.RuntimeConfig, modules map[string]kernelModule, assumeYes bool, in io.Reader, out io.Writer) error {
+	if os.Geteuid() != 0 {
+		return fmt.Errorf("fixing the host requires root privileges")
+	}
+
+	fixes, err := getHostFixes(runtimeConfig, modules)
+	if err != nil {
+		return err
+	}
+
+	if len(fixes) == 0 {
+		fmt.Fprintln(out, "Nothing to fix")
+		return nil
+	}
+
+	fmt.Fprintln(out, "The following changes will be made to the host:")
+	for _, fix := range fixes {
+		fmt.Fprintf(out, "  - %s\n", fix.desc)
+	}
+
+	if !assumeYes && !confirmHostFixes(in, out) {
+		return fmt.Errorf("host changes not confirmed")
+	}
+
+	failed := 0
+	for _, fix := range fixes {
+		if err := fix.apply(); err != nil {
+			kataLog.WithError(err).WithField("fix", fix.desc).Error("failed to fix the host")
+			fmt.Fprintf(out, "FAILED: %s: %v\n", fix.desc, err)
+			failed++
+			continue
+		}
+		fmt.Fprintf(out, "Done: %s\n", fix.desc)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d host changes failed", failed, len(fixes))
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+
+	ktu "github.com/kata-containers/kata-containers/src/runtime/pkg/katatestutils"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/oci"
+)
+
+func setupHostFixes(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpdir := t.TempDir()
+
+	savedSysModuleDir := sysModuleDir
+	savedDevDir := devDir
+	savedSysMiscDir := sysMiscDir
+	savedProcSysDir := procSysDir
+	savedProcMeminfo := procMeminfo
+	savedModProbeCmd := modProbeCmd
+	t.Cleanup(func() {
+		sysModuleDir = savedSysModuleDir
+		devDir = savedDevDir
+		sysMiscDir = savedSysMiscDir
+		procSysDir = savedProcSysDir
+		procMeminfo = savedProcMeminfo
+		modProbeCmd = savedModProbeCmd
+	})
+
+	sysModuleDir = filepath.Join(tmpdir, "module")
+	devDir = filepath.Join(tmpdir, "dev")
+	sysMiscDir = filepath.Join(tmpdir, "misc")
+	procSysDir = filepath.Join(tmpdir, "sys")
+	procMeminfo = filepath.Join(tmpdir, "meminfo")
+	modProbeCmd = "true"
+
+	assert.NoError(os.MkdirAll(filepath.Join(sysModuleDir, "kvm"), testDirMode))
+	assert.NoError(os.MkdirAll(devDir, testDirMode))
+	assert.NoError(createFile(filepath.Join(devDir, "kvm"), ""))
+	assert.NoError(createFile(filepath.Join(devDir, "vhost-net"), ""))
+	assert.NoError(os.MkdirAll(filepath.Join(procSysDir, "vm"), testDirMode))
+	assert.NoError(createFile(filepath.Join(procSysDir, "vm", "nr_hugepages"), "0\n"))
+	assert.NoError(createFile(procMeminfo, "MemTotal:       16306216 kB\nHugepagesize:       2048 kB\n"))
+}
+
+func hostFixDescs(fixes []hostFix) []string {
+	var descs []string
+	for _, fix := range fixes {
+		descs = append(descs, fix.desc)
+	}
+	return descs
+}
+
+func TestGetHostFixes(t *testing.T) {
+	assert := assert.New(t)
+
+	setupHostFixes(t)
+
+	modules := map[string]kernelModule{
+		"kvm":         {desc: "KVM"},
+		"vhost_vsock": {desc: "vsock"},
+		"vhost_net":   {desc: "vhost net"},
+	}
+
+	fixes, err := getHostFixes(oci.RuntimeConfig{}, modules)
+	assert.NoError(err)
+	assert.Equal([]string{
+		"load kernel module vhost_net (vhost net)",
+		"load kernel module vhost_vsock (vsock)",
+		"create device node " + filepath.Join(devDir, "vhost-vsock"),
+	}, hostFixDescs(fixes))
+
+	// modprobe is faked
+	assert.NoError(fixes[0].apply())
+
+	// vhost_vsock is not loaded
+	assert.Error(fixes[2].apply())
+}
+
+func TestGetHostFixesHugePages(t *testing.T) {
+	assert := assert.New(t)
+
+	setupHostFixes(t)
+	assert.NoError(createFile(filepath.Join(devDir, "vhost-vsock"), ""))
+
+	config := oci.RuntimeConfig{}
+	config.HypervisorConfig.HugePages = true
+	config.HypervisorConfig.MemorySize = 2049
+
+	fixes, err := getHostFixes(config, nil)
+	assert.NoError(err)
+	assert.Len(fixes, 1)
+	assert.True(strings.HasPrefix(fixes[0].desc, "set sysctl vm.nr_hugepages to 1025 "))
+
+	assert.NoError(fixes[0].apply())
+	value, err := os.ReadFile(filepath.Join(procSysDir, "vm", "nr_hugepages"))
+	assert.NoError(err)
+	assert.Equal("1025", string(value))
+
+	// enough huge pages
+	fixes, err = getHostFixes(config, nil)
+	assert.NoError(err)
+	assert.Empty(fixes)
+
+	// no huge pages support
+	assert.NoError(createFile(procMeminfo, "MemTotal:       16306216 kB\n"))
+	_, err = getHostFixes(config, nil)
+	assert.Error(err)
+}
+
+func TestConfirmHostFixes(t *testing.T) {
+	assert := assert.New(t)
+
+	for answer, expected := range map[string]bool{
+		"":      false,
+		"\n":    false,
+		"n\n":   false,
+		"no\n":  false,
+		"y\n":   true,
+		"Yes\n": true,
+		"y":     true,
+	} {
+		var out bytes.Buffer
+		assert.Equal(expected, confirmHostFixes(strings.NewReader(answer), &out), answer)
+		assert.NotEmpty(out.String())
+	}
+}
+
+func TestCreateMiscDevice(t *testing.T) {
+	if tc.NotValid(ktu.NeedRoot()) {
+		t.Skip(testDisabledAsNonRoot)
+	}
+
+	assert := assert.New(t)
+
+	setupHostFixes(t)
+	assert.NoError(os.MkdirAll(filepath.Join(sysMiscDir, "kvm"), testDirMode))
+	assert.NoError(createFile(filepath.Join(sysMiscDir, "kvm", "dev"), "10:232\n"))
+
+	savedLookupGroup := lookupGroup
+	defer func() {
+		lookupGroup = savedLookupGroup
+	}()
+
+	path := filepath.Join(devDir, "kvm")
+
+	for _, d := range []struct {
+		name string
+		gid  int
+		mode os.FileMode
+	}{
+		{"group exists", 1234, 0660},
+		{"no group", 0, 0660},
+	} {
+		gid := d.gid
+		lookupGroup = func(name string) (*user.Group, error) {
+			assert.Equal("kvm", name, d.name)
+			if gid == 0 {
+				return nil, user.UnknownGroupError(name)
+			}
+			return &user.Group{Name: name, Gid: strconv.Itoa(gid)}, nil
+		}
+
+		assert.NoError(os.RemoveAll(path))
+		assert.NoError(createMiscDevice("kvm", path, 0660, "kvm"), d.name)
+
+		var st unix.Stat_t
+		assert.NoError(unix.Stat(path, &st), d.name)
+		assert.Equal(uint32(unix.S_IFCHR), st.Mode&unix.S_IFMT, d.name)
+		assert.Equal(uint64(unix.Mkdev(10, 232)), uint64(st.Rdev), d.name)
+		assert.Equal(uint32(0), st.Uid, d.name)
+		assert.Equal(uint32(d.gid), st.Gid, d.name)
+		assert.Equal(uint32(d.mode), st.Mode&0777, d.name)
+	}
+}
+
+func TestFixHost(t *testing.T) {
+	if tc.NotValid(ktu.NeedRoot()) {
+		t.Skip(testDisabledAsNonRoot)
+	}
+
+	assert := assert.New(t)
+
+	setupHostFixes(t)
+	assert.NoError(createFile(filepath.Join(devDir, "vhost-vsock"), ""))
+
+	modules := map[string]kernelModule{
+		"vhost_net": {desc: "vhost net"},
+	}
+
+	var out bytes.Buffer
+
+	// not confirmed
+	err := fixHost(oci.RuntimeConfig{}, modules, false, strings.NewReader("n\n"), &out)
+	assert.Error(err)
+	assert.Contains(out.String(), "load kernel module vhost_net")
+	assert.NotContains(out.String(), "Done:")
+
+	out.Reset()
+	err = fixHost(oci.RuntimeConfig{}, modules, true, strings.NewReader(""), &out)
+	assert.NoError(err)
+	assert.Contains(out.String(), "Done: load kernel module vhost_net")
+
+	// modprobe fails
+	modProbeCmd = "false"
+	out.Reset()
+	err = fixHost(oci.RuntimeConfig{}, modules, true, strings.NewReader(""), &out)
+	assert.Error(err)
+	assert.Contains(out.String(), "FAILED: load kernel module vhost_net")
+
+	out.Reset()
+	err = fixHost(oci.RuntimeConfig{}, nil, true, strings.NewReader(""), &out)
+	assert.NoError(err)
+	assert.Contains(out.String(), "Nothing to fix")
+}
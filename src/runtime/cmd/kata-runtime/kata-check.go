@@ -314,11 +314,18 @@ var kataCheckCLICommand = cli.Command{
 	Name:    "check",
 	Aliases: []string{"kata-check"},
 	Usage:   "tests if system can run " + katautils.PROJECT,
+	Subcommands: []cli.Command{
+		kataCheckCCCommand,
+	},
 	Flags: []cli.Flag{
 		cli.BoolFlag{
 			Name:  "check-version-only",
 			Usage: "Only compare the current and latest available versions (requires network, non-root only)",
 		},
+		cli.BoolFlag{
+			Name:  "fix",
+			Usage: "load the missing kernel modules, create the missing device nodes and set the required sysctls before checking (requires root)",
+		},
 		cli.BoolFlag{
 			Name:  "include-all-releases",
 			Usage: "Don't filter out pre-release release versions",
@@ -339,6 +346,10 @@ var kataCheckCLICommand = cli.Command{
 			Name:  "verbose, v",
 			Usage: "display the list of checks performed",
 		},
+		cli.BoolFlag{
+			Name:  "yes, y",
+			Usage: "do not ask for confirmation before fixing the host",
+		},
 	},
 	Description: fmt.Sprintf(`tests if system can run %s and version is current.
 
@@ -371,6 +382,14 @@ EXAMPLES:
 - List all available releases (includes pre-release versions):
 
   $ %s check --only-list-releases --include-all-releases
+
+- Fix the host configuration, then check it:
+
+  $ sudo %s check --fix
+
+- Check the confidential computing support of the host (JSON output):
+
+  $ sudo %s check cc
 `,
 		katautils.PROJECT,
 		noNetworkEnvVar,
@@ -380,6 +399,8 @@ EXAMPLES:
 		katautils.NAME,
 		katautils.NAME,
 		katautils.NAME,
+		katautils.NAME,
+		katautils.NAME,
 	),
 
 	Action: func(context *cli.Context) error {
@@ -419,6 +440,13 @@ EXAMPLES:
 			return err
 		}
 
+		if context.Bool("fix") {
+			err = fixHost(runtimeConfig, archRequiredKernelModules, context.Bool("yes"), os.Stdin, defaultOutputFile)
+			if err != nil {
+				return err
+			}
+		}
+
 		details := vmContainerCapableDetails{
 			cpuInfoFile:           procCPUInfo,
 			requiredCPUFlags:      archRequiredCPUFlags,
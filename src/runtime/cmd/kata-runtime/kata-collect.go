@@ -0,0 +1,402 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	containerdshim "github.com/kata-containers/kata-containers/src/runtime/pkg/containerd-shim-v2"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils"
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/persist"
+	persistapi "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/persist/api"
+	vcAnnotations "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/annotations"
+	"github.com/urfave/cli"
+)
+
+const (
+	// persistFile is the state of the sandbox written by the fs persist
+	// driver in its run store.
+	persistFile = "persist.json"
+
+	// maxBundleFileSize is the size above which the files of the stores are
+	// not collected, such as the memory of a VM.
+	maxBundleFileSize = 16 * 1024 * 1024
+
+	// bundleErrorsFile lists what could not be collected.
+	bundleErrorsFile = "collect-errors.txt"
+
+	// shimLogFile holds the shim logs of the sandbox, which include the
+	// output of the hypervisor.
+	shimLogFile = "logs/shim.log"
+
+	// agentPolicyFile holds the policy of the agent API of the sandbox.
+	agentPolicyFile = "agent-policy.rego"
+
+	// journalBundleFile holds the lifecycle journal of the sandbox.
+	journalBundleFile = "journal.json"
+
+	// consoleBundleFile holds the guest console output captured on failure.
+	consoleBundleFile = "logs/console-capture.log"
+)
+
+// The syslog identifiers of the shim logs: the shim logs to the system log
+// with the kata identifier, and to containerd, which logs them in debug.
+var shimLogIdentifiers = []string{katautils.SYSLOGTAG, "containerd"}
+
+// secretPattern matches the values of the keys, variables and options whose
+// names denote a secret, in the logs, JSON and TOML files collected.
+var secretPattern = regexp.MustCompile(`(?i)([\w.-]*(?:password|passwd|secret|token|api[_-]?key|credential|authorization|private[_-]?key)[\w.-]*["']?\s*[:=]\s*["']?(?:(?:bearer|basic)\s+)?)([^"'\s,;&{}\[\]]+)`)
+
+const redacted = "<redacted>"
+
+var kataCollectCLICommand = cli.Command{
+	Name:      "collect",
+	Usage:     "collect the logs, state and configuration of a sandbox to attach to a bug report",
+	ArgsUsage: "<sandbox-id>",
+	Description: `The gzip compressed tar archive holds the shim logs of the sandbox, from the
+   system journal, which include the output of the hypervisor, the files of its
+   run and VM stores: its persisted state and hypervisor log, its lifecycle
+   journal, its guest console captured on failure, the policy of its agent and
+   the configuration of the runtime. The values of the passwords, tokens, keys and other secrets
+   are redacted. The sandbox does not need to be running.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "output",
+			Usage: "the gzip compressed tar archive to write, instead of kata-<sandbox-id>.tar.gz",
+		},
+		cli.StringFlag{
+			Name:  "since",
+			Usage: "only collect the logs since this time, in the journalctl --since format",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		sandboxID := context.Args().First()
+		if err := katautils.VerifyContainerID(sandboxID); err != nil {
+			return err
+		}
+
+		store, err := persist.GetDriver()
+		if err != nil {
+			return err
+		}
+
+		configFile, _ := context.App.Metadata["configFile"].(string)
+		sources := bundleSources{
+			sandboxID:   sandboxID,
+			storePath:   filepath.Join(store.RunStoragePath(), sandboxID),
+			vmStorePath: filepath.Join(store.RunVMStoragePath(), sandboxID),
+			journalPath: containerdshim.JournalPath(sandboxID),
+			consolePath: vc.ConsoleCapturePath(sandboxID),
+			configFile:  configFile,
+			shimLogs: func(sandboxID string) ([]byte, error) {
+				return journalShimLogs(sandboxID, context.String("since"))
+			},
+		}
+
+		output := context.String("output")
+		if output == "" {
+			output = fmt.Sprintf("kata-%s.tar.gz", sandboxID)
+		}
+
+		f, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+
+		if err := collectBundle(f, sources, time.Now()); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(defaultOutputFile, "Collected the sandbox %s in %s\n", sandboxID, output)
+		return nil
+	},
+}
+
+// bundleSources are where the files of the bundle of a sandbox are collected.
+type bundleSources struct {
+	sandboxID string
+
+	// run and VM stores of the sandbox
+	storePath   string
+	vmStorePath string
+
+	// lifecycle journal and captured guest console of the sandbox, kept
+	// out of its stores
+	journalPath string
+	consolePath string
+
+	// configuration file of the runtime
+	configFile string
+
+	// shimLogs returns the shim logs of the sandbox
+	shimLogs func(sandboxID string) ([]byte, error)
+}
+
+// bundleWriter writes the files of a bundle to a tar archive, under the
+// directory of the sandbox, redacting their secrets.
+type bundleWriter struct {
+	tw     *tar.Writer
+	dir    string
+	now    time.Time
+	errors []string
+}
+
+func (b *bundleWriter) add(name string, data []byte) error {
+	data = redactSecrets(data)
+
+	if err := b.tw.WriteHeader(&tar.Header{
+		Name:    filepath.Join(b.dir, name),
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: b.now,
+	}); err != nil {
+		return err
+	}
+
+	_, err := b.tw.Write(data)
+	return err
+}
+
+// failed records an item which could not be collected.
+func (b *bundleWriter) failed(item string, err error) {
+	b.errors = append(b.errors, fmt.Sprintf("%s: %v", item, err))
+}
+
+// addTree adds the regular files of a directory, under dir in the bundle.
+func (b *bundleWriter) addTree(root, dir string) error {
+	return filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if path == root {
+				return err
+			}
+			b.failed(path, err)
+			return nil
+		}
+
+		// Skip the sockets, FIFOs and symlinks, which may point to the
+		// files of the containers
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			b.failed(path, err)
+			return nil
+		}
+		if info.Size() > maxBundleFileSize {
+			b.failed(path, fmt.Errorf("skipped, size %d larger than %d", info.Size(), maxBundleFileSize))
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			b.failed(path, err)
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		return b.add(filepath.Join(dir, rel), data)
+	})
+}
+
+// collectBundle writes to out the bundle of a sandbox, as a gzip compressed
+// tar archive. The items which cannot be collected, as the sandbox may be
+// half gone, are listed in the bundle rather than failing the collection.
+func collectBundle(out io.Writer, sources bundleSources, now time.Time) error {
+	gw := gzip.NewWriter(out)
+	tw := tar.NewWriter(gw)
+
+	b := &bundleWriter{
+		tw:  tw,
+		dir: fmt.Sprintf("kata-%s", sources.sandboxID),
+		now: now,
+	}
+
+	if logs, err := sources.shimLogs(sources.sandboxID); err != nil {
+		b.failed("shim logs", err)
+	} else if err := b.add(shimLogFile, logs); err != nil {
+		return err
+	}
+
+	if err := b.addTree(sources.storePath, "store"); err != nil {
+		b.failed("run store", err)
+	}
+
+	if err := b.addTree(sources.vmStorePath, "vm"); err != nil {
+		b.failed("VM store", err)
+	}
+
+	// The guest console is only captured on failure
+	for _, f := range []struct{ item, path, name string }{
+		{"lifecycle journal", sources.journalPath, journalBundleFile},
+		{"guest console capture", sources.consolePath, consoleBundleFile},
+	} {
+		data, err := os.ReadFile(f.path)
+		if os.IsNotExist(err) && f.name == consoleBundleFile {
+			continue
+		}
+		if err != nil {
+			b.failed(f.item, err)
+			continue
+		}
+		if err := b.add(f.name, data); err != nil {
+			return err
+		}
+	}
+
+	annotations, err := sandboxAnnotations(filepath.Join(sources.storePath, persistFile))
+	if err != nil {
+		b.failed("sandbox annotations", err)
+	}
+
+	if value, ok := annotations[vcAnnotations.AgentPolicy]; ok {
+		if policy, err := base64.StdEncoding.DecodeString(value); err != nil {
+			b.failed("agent policy", err)
+		} else if err := b.add(agentPolicyFile, policy); err != nil {
+			return err
+		}
+	}
+
+	// The sandbox may use another configuration than the runtime
+	configFiles := []string{sources.configFile}
+	if path, ok := annotations[vcAnnotations.SandboxConfigPathKey]; ok && path != sources.configFile {
+		configFiles = append(configFiles, path)
+	}
+	for _, path := range configFiles {
+		if path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			b.failed("configuration", err)
+			continue
+		}
+		if err := b.add(filepath.Join("config", filepath.Base(path)), data); err != nil {
+			return err
+		}
+	}
+
+	if len(b.errors) > 0 {
+		if err := b.add(bundleErrorsFile, []byte(strings.Join(b.errors, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// sandboxAnnotations returns the annotations of the containers of a sandbox,
+// from its persisted state, which include the ones of the sandbox.
+func sandboxAnnotations(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var state persistapi.SandboxState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+
+	annotations := make(map[string]string)
+	for _, c := range state.Config.ContainerConfigs {
+		for k, v := range c.Annotations {
+			annotations[k] = v
+		}
+	}
+	return annotations, nil
+}
+
+// journalShimLogs returns the shim logs of a sandbox from the system journal.
+func journalShimLogs(sandboxID, since string) ([]byte, error) {
+	args := []string{"--quiet", "--no-pager", "--output", "short-iso-precise"}
+	for _, id := range shimLogIdentifiers {
+		args = append(args, "--identifier", id)
+	}
+	if since != "" {
+		args = append(args, "--since", since)
+	}
+
+	cmd := exec.Command("journalctl", args...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	logs, filterErr := filterLogs(stdout, sandboxID)
+
+	if err := cmd.Wait(); err != nil {
+		return nil, fmt.Errorf("journalctl failed: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return logs, filterErr
+}
+
+// filterLogs returns the lines of the logs which mention a sandbox. The logs
+// are read to the end.
+func filterLogs(logs io.Reader, sandboxID string) ([]byte, error) {
+	var out bytes.Buffer
+
+	reader := bufio.NewReader(logs)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if bytes.Contains(line, []byte(sandboxID)) {
+			out.Write(bytes.TrimSuffix(line, []byte("\n")))
+			out.WriteByte('\n')
+		}
+		if err == io.EOF {
+			return out.Bytes(), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// redactSecrets replaces the values of the secrets in data. The boolean and
+// null values are kept, as they are not secrets.
+func redactSecrets(data []byte) []byte {
+	return secretPattern.ReplaceAllFunc(data, func(match []byte) []byte {
+		groups := secretPattern.FindSubmatch(match)
+		switch string(groups[2]) {
+		case "true", "false", "null":
+			return match
+		}
+
+		return append(append([]byte{}, groups[1]...), redacted...)
+	})
+}
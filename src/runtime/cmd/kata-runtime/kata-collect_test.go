@@ -0,0 +1,179 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	persistapi "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/persist/api"
+	vcAnnotations "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/annotations"
+	"github.com/stretchr/testify/assert"
+)
+
+func readBundle(t *testing.T, data []byte) map[string]string {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	assert.NoError(t, err)
+
+	files := make(map[string]string)
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+
+		content, err := io.ReadAll(tr)
+		assert.NoError(t, err)
+		files[header.Name] = string(content)
+	}
+	return files
+}
+
+func TestRedactSecrets(t *testing.T) {
+	assert := assert.New(t)
+
+	for input, expected := range map[string]string{
+		`level=info msg="started"`:                    `level=info msg="started"`,
+		`REGISTRY_PASSWORD=hunter2 FOO=bar`:           `REGISTRY_PASSWORD=<redacted> FOO=bar`,
+		`{"Token":"abc","ID":"sb"}`:                   `{"Token":"<redacted>","ID":"sb"}`,
+		`otlp_headers = ["authorization=Bearer abc"]`: `otlp_headers = ["authorization=Bearer <redacted>"]`,
+		`api_key: 'abc'`:                              `api_key: '<redacted>'`,
+		`url=https://host/?access_token=abc&x=1`:      `url=https://host/?access_token=<redacted>&x=1`,
+		`{"EnableSecrets":true,"Secrets":{"a":"b"}}`:  `{"EnableSecrets":true,"Secrets":{"a":"b"}}`,
+		`private_key = "abc"`:                         `private_key = "<redacted>"`,
+	} {
+		assert.Equal(expected, string(redactSecrets([]byte(input))), input)
+	}
+}
+
+func TestFilterLogs(t *testing.T) {
+	assert := assert.New(t)
+
+	long := strings.Repeat("x", 2*1024*1024)
+	logs := "sandbox=sb1 msg=one\nsandbox=sb2 msg=two\n" + long + " sandbox=sb1\nsandbox=sb1 msg=last"
+
+	out, err := filterLogs(strings.NewReader(logs), "sb1")
+	assert.NoError(err)
+	assert.Equal("sandbox=sb1 msg=one\n"+long+" sandbox=sb1\nsandbox=sb1 msg=last\n", string(out))
+}
+
+func TestCollectBundle(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	storePath := filepath.Join(dir, "sbs", "sb")
+	vmStorePath := filepath.Join(dir, "vm", "sb")
+	assert.NoError(os.MkdirAll(filepath.Join(storePath, "ctr"), 0700))
+	assert.NoError(os.MkdirAll(vmStorePath, 0700))
+
+	configFile := filepath.Join(dir, "configuration.toml")
+	assert.NoError(os.WriteFile(configFile, []byte("[runtime]\notlp_headers = [\"authorization=Bearer abc\"]\n"), 0600))
+	podConfigFile := filepath.Join(dir, "pod.toml")
+	assert.NoError(os.WriteFile(podConfigFile, []byte("[runtime]\n"), 0600))
+
+	policy := "package agent_policy\ndefault CreateContainerRequest := true\n"
+	state := persistapi.SandboxState{
+		Config: persistapi.SandboxConfig{
+			ContainerConfigs: []persistapi.ContainerConfig{
+				{
+					ID: "sb",
+					Annotations: map[string]string{
+						vcAnnotations.AgentPolicy:          base64.StdEncoding.EncodeToString([]byte(policy)),
+						vcAnnotations.SandboxConfigPathKey: podConfigFile,
+					},
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(state)
+	assert.NoError(err)
+	assert.NoError(os.WriteFile(filepath.Join(storePath, persistFile), data, 0600))
+	journalPath := filepath.Join(dir, "journal", "sb.json")
+	assert.NoError(os.MkdirAll(filepath.Dir(journalPath), 0700))
+	assert.NoError(os.WriteFile(journalPath, []byte(`{"type":"sandbox-create"}`+"\n"), 0600))
+	consolePath := filepath.Join(dir, "console", "sb.log")
+	assert.NoError(os.MkdirAll(filepath.Dir(consolePath), 0700))
+	assert.NoError(os.WriteFile(consolePath, []byte("[    0.000000] Linux version\n"), 0600))
+	assert.NoError(os.WriteFile(filepath.Join(storePath, "ctr", persistFile), []byte(`{"Env":["DB_PASSWORD=hunter2"]}`), 0600))
+	assert.NoError(os.WriteFile(filepath.Join(vmStorePath, "qemu.log"), []byte("qemu: terminating on signal 15\n"), 0600))
+	assert.NoError(syscall.Mkfifo(filepath.Join(vmStorePath, "fifo"), 0600))
+	assert.NoError(os.Symlink("/etc/passwd", filepath.Join(vmStorePath, "link")))
+
+	shimLogs := func(sandboxID string) ([]byte, error) {
+		assert.Equal("sb", sandboxID)
+		return []byte("sandbox=sb msg=\"failed\" token=abc\n"), nil
+	}
+
+	var out bytes.Buffer
+	sources := bundleSources{
+		sandboxID:   "sb",
+		storePath:   storePath,
+		vmStorePath: vmStorePath,
+		journalPath: journalPath,
+		consolePath: consolePath,
+		configFile:  configFile,
+		shimLogs:    shimLogs,
+	}
+	assert.NoError(collectBundle(&out, sources, time.Now()))
+
+	files := readBundle(t, out.Bytes())
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	assert.ElementsMatch([]string{
+		"kata-sb/logs/shim.log",
+		"kata-sb/store/persist.json",
+		"kata-sb/journal.json",
+		"kata-sb/logs/console-capture.log",
+		"kata-sb/store/ctr/persist.json",
+		"kata-sb/vm/qemu.log",
+		"kata-sb/agent-policy.rego",
+		"kata-sb/config/configuration.toml",
+		"kata-sb/config/pod.toml",
+	}, names)
+
+	assert.Equal("sandbox=sb msg=\"failed\" token=<redacted>\n", files["kata-sb/logs/shim.log"])
+	assert.Equal(`{"Env":["DB_PASSWORD=<redacted>"]}`, files["kata-sb/store/ctr/persist.json"])
+	assert.Equal(policy, files["kata-sb/agent-policy.rego"])
+	assert.Contains(files["kata-sb/config/configuration.toml"], "Bearer <redacted>")
+
+	// The sandbox is gone and the journal cannot be read: what could not be
+	// collected is listed in the bundle
+	out.Reset()
+	sources.storePath = filepath.Join(dir, "sbs", "missing")
+	sources.vmStorePath = filepath.Join(dir, "vm", "missing")
+	sources.journalPath = filepath.Join(dir, "journal", "missing.json")
+	sources.consolePath = filepath.Join(dir, "console", "missing.log")
+	sources.configFile = ""
+	sources.shimLogs = func(string) ([]byte, error) {
+		return nil, errors.New("journalctl not found")
+	}
+	assert.NoError(collectBundle(&out, sources, time.Now()))
+
+	files = readBundle(t, out.Bytes())
+	assert.Len(files, 1)
+	errs := files["kata-sb/"+bundleErrorsFile]
+	assert.Contains(errs, "shim logs: journalctl not found")
+	assert.Contains(errs, "run store:")
+	assert.Contains(errs, "VM store:")
+	assert.Contains(errs, "lifecycle journal:")
+	assert.NotContains(errs, "guest console capture:")
+	assert.Contains(errs, "sandbox annotations:")
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	containerdshim "github.com/kata-containers/kata-containers/src/runtime/pkg/containerd-shim-v2"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/utils/shimclient"
+	"github.com/urfave/cli"
+)
+
+// diagnosticsTimeout leaves time to the agent to read the core dumps.
+const diagnosticsTimeout = 30 * time.Second
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+var kataDiagnosticsCLICommand = cli.Command{
+	Name:      "diagnostics",
+	Usage:     "collect the diagnostics of the guest of a sandbox, and of one of its containers",
+	UsageText: "diagnostics [--container <container id>] [--output <file>] <sandbox id>",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "container",
+			Usage: "also collect the processes snapshots and core dumps of this container",
+		},
+		cli.StringFlag{
+			Name:  "output",
+			Usage: "the gzip compressed tar archive to write, instead of the standard output",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		sandboxID := context.Args().Get(0)
+
+		if err := katautils.VerifyContainerID(sandboxID); err != nil {
+			return err
+		}
+
+		urlPath := containerdshim.DiagnosticsUrl
+		if containerID := context.String("container"); containerID != "" {
+			if err := katautils.VerifyContainerID(containerID); err != nil {
+				return err
+			}
+			urlPath = fmt.Sprintf("%s?%s=%s", urlPath, containerdshim.DiagnosticsContainerKey, url.QueryEscape(containerID))
+		}
+
+		body, err := shimclient.DoGet(sandboxID, diagnosticsTimeout, urlPath)
+		if err != nil {
+			return err
+		}
+
+		// The shim replies with the error when the collection fails
+		if !bytes.HasPrefix(body, gzipMagic) {
+			return fmt.Errorf("failed to collect the diagnostics: %s", body)
+		}
+
+		if output := context.String("output"); output != "" {
+			return os.WriteFile(output, body, 0600)
+		}
+
+		_, err = os.Stdout.Write(body)
+		return err
+	},
+}
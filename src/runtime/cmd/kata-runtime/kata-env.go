@@ -25,11 +25,12 @@ import (
 	vcUtils "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/utils"
 )
 
-// Semantic version for the output of the command.
+// Semantic version for the output of the command, see
+// docs/design/kata-runtime-env.md for the versioning rules.
 //
 // XXX: Increment for every change to the output format
 // (meaning any change to the EnvInfo type).
-const formatVersion = "1.0.26"
+const formatVersion = "1.1.0"
 
 // MetaInfo stores information on the format of the output itself
 type MetaInfo struct {
@@ -118,8 +119,20 @@ type HypervisorInfo struct {
 
 // AgentInfo stores agent details
 type AgentInfo struct {
-	Debug bool
-	Trace bool
+	KernelModules       []string
+	DialTimeout         uint32
+	HealthCheckInterval uint32
+	Debug               bool
+	Trace               bool
+	EnableDebugConsole  bool
+}
+
+// FactoryInfo stores VM factory details
+type FactoryInfo struct {
+	TemplatePath    string
+	VMCacheEndpoint string
+	VMCacheNumber   uint
+	Template        bool
 }
 
 // DistroInfo stores host operating system distribution details.
@@ -153,6 +166,7 @@ type EnvInfo struct {
 	Runtime    RuntimeInfo
 	Host       HostInfo
 	Agent      AgentInfo
+	Factory    FactoryInfo
 }
 
 func getMetaInfo() MetaInfo {
@@ -277,10 +291,23 @@ func getAgentInfo(config oci.RuntimeConfig) (AgentInfo, error) {
 	agentConfig := config.AgentConfig
 	agent.Debug = agentConfig.Debug
 	agent.Trace = agentConfig.Trace
+	agent.KernelModules = agentConfig.KernelModules
+	agent.DialTimeout = agentConfig.DialTimeout
+	agent.HealthCheckInterval = agentConfig.HealthCheckInterval
+	agent.EnableDebugConsole = agentConfig.EnableDebugConsole
 
 	return agent, nil
 }
 
+func getFactoryInfo(config oci.RuntimeConfig) FactoryInfo {
+	return FactoryInfo{
+		Template:        config.FactoryConfig.Template,
+		TemplatePath:    config.FactoryConfig.TemplatePath,
+		VMCacheNumber:   config.FactoryConfig.VMCacheNumber,
+		VMCacheEndpoint: config.FactoryConfig.VMCacheEndpoint,
+	}
+}
+
 func getHypervisorInfo(config oci.RuntimeConfig) (HypervisorInfo, error) {
 	hypervisorPath := config.HypervisorConfig.HypervisorPath
 
@@ -367,6 +394,7 @@ func getEnvInfo(configFile string, config oci.RuntimeConfig) (env EnvInfo, err e
 		Initrd:     initrd,
 		Agent:      agent,
 		Host:       host,
+		Factory:    getFactoryInfo(config),
 	}
 
 	return env, nil
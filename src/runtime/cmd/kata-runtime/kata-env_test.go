@@ -162,8 +162,12 @@ func getExpectedAgentDetails(config oci.RuntimeConfig) (AgentInfo, error) {
 
 	agentConfig := config.AgentConfig
 	return AgentInfo{
-		Debug: agentConfig.Debug,
-		Trace: agentConfig.Trace,
+		KernelModules:       agentConfig.KernelModules,
+		DialTimeout:         agentConfig.DialTimeout,
+		HealthCheckInterval: agentConfig.HealthCheckInterval,
+		Debug:               agentConfig.Debug,
+		Trace:               agentConfig.Trace,
+		EnableDebugConsole:  agentConfig.EnableDebugConsole,
 	}, nil
 }
 
@@ -344,6 +348,12 @@ func getExpectedSettings(config oci.RuntimeConfig, tmpdir, configFile string) (E
 		Kernel:     kernel,
 		Agent:      agent,
 		Host:       host,
+		Factory: FactoryInfo{
+			Template:        config.FactoryConfig.Template,
+			TemplatePath:    config.FactoryConfig.TemplatePath,
+			VMCacheNumber:   config.FactoryConfig.VMCacheNumber,
+			VMCacheEndpoint: config.FactoryConfig.VMCacheEndpoint,
+		},
 	}
 
 	return env, nil
@@ -573,6 +583,56 @@ func TestEnvGetAgentInfo(t *testing.T) {
 	agent, err = getAgentInfo(config)
 	assert.NoError(t, err)
 	assert.True(t, agent.Trace)
+
+	agentConfig.EnableDebugConsole = true
+	agentConfig.DialTimeout = 42
+	config.AgentConfig = agentConfig
+	agent, err = getAgentInfo(config)
+	assert.NoError(t, err)
+	assert.True(t, agent.EnableDebugConsole)
+	assert.Equal(t, uint32(42), agent.DialTimeout)
+}
+
+func TestEnvGetFactoryInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	config := oci.RuntimeConfig{
+		FactoryConfig: oci.FactoryConfig{
+			Template:        true,
+			TemplatePath:    "/run/vc/vm/template",
+			VMCacheNumber:   2,
+			VMCacheEndpoint: "/var/run/kata-containers/cache.sock",
+		},
+	}
+
+	factory := getFactoryInfo(config)
+	assert.True(factory.Template)
+	assert.Equal("/run/vc/vm/template", factory.TemplatePath)
+	assert.Equal(uint(2), factory.VMCacheNumber)
+	assert.Equal("/var/run/kata-containers/cache.sock", factory.VMCacheEndpoint)
+}
+
+// TestEnvJSONSections checks the top-level sections of the JSON output, which
+// are part of the documented format: changing them requires a new major
+// formatVersion.
+func TestEnvJSONSections(t *testing.T) {
+	assert := assert.New(t)
+
+	data, err := json.Marshal(EnvInfo{Meta: getMetaInfo()})
+	assert.NoError(err)
+
+	var sections map[string]json.RawMessage
+	assert.NoError(json.Unmarshal(data, &sections))
+
+	var names []string
+	for name := range sections {
+		names = append(names, name)
+	}
+	assert.ElementsMatch([]string{"Kernel", "Meta", "Image", "Initrd", "Hypervisor", "Runtime", "Host", "Agent", "Factory"}, names)
+
+	var meta MetaInfo
+	assert.NoError(json.Unmarshal(sections["Meta"], &meta))
+	assert.True(strings.HasPrefix(meta.Version, "1."))
 }
 
 func testEnvShowTOMLSettings(t *testing.T, tmpdir string, tmpfile *os.File) error {
@@ -7,12 +7,14 @@
 package main
 
 import (
+	"bufio"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 
 	"sync"
@@ -36,7 +38,14 @@ const (
 	subCommandName = "exec"
 	// command-line parameters name
 	paramDebugConsolePort                    = "kata-debug-port"
+	paramTimeout                             = "timeout"
 	defaultKernelParamDebugConsoleVPortValue = 1026
+
+	// Markers printed around the output of a non-interactive command. They
+	// are printed as two words joined by the shell so that the echo of the
+	// command line by the console terminal never matches them.
+	commandBeginMarker = "KATA_EXEC_BEGIN"
+	commandEndMarker   = "KATA_EXEC_END"
 )
 
 var (
@@ -49,13 +58,22 @@ var (
 )
 
 var kataExecCLICommand = cli.Command{
-	Name:  subCommandName,
-	Usage: "Enter into guest by debug console",
+	Name:      subCommandName,
+	Usage:     "Enter into guest by debug console",
+	ArgsUsage: "<sandbox-id> [command [args...]]",
+	Description: `Without a command, opens an interactive shell on the debug console of the
+   guest. With a command, runs it non-interactively with its arguments as
+   given, each one being quoted for the shell of the debug console, prints its
+   output (stdout and stderr) and exits with its exit code.`,
 	Flags: []cli.Flag{
 		cli.Uint64Flag{
 			Name:  paramDebugConsolePort,
 			Usage: "Port that debug console is listening on. (Default: 1026)",
 		},
+		cli.DurationFlag{
+			Name:  paramTimeout,
+			Usage: "Maximum time a non-interactive command may run. (Default: no timeout)",
+		},
 	},
 	Action: func(context *cli.Context) error {
 		port := context.Uint64(paramDebugConsolePort)
@@ -76,6 +94,17 @@ var kataExecCLICommand = cli.Command{
 		}
 		defer conn.Close()
 
+		if context.NArg() > 1 {
+			code, err := runDebugConsoleCommand(conn, context.Args().Tail(), defaultOutputFile, context.Duration(paramTimeout))
+			if err != nil {
+				return err
+			}
+			if code != 0 {
+				return cli.NewExitError("", code)
+			}
+			return nil
+		}
+
 		con := console.Current()
 		defer con.Reset()
 
@@ -96,6 +125,74 @@ var kataExecCLICommand = cli.Command{
 	},
 }
 
+// shellQuote quotes arg as a single word for the shell.
+func shellQuote(arg string) string {
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// runDebugConsoleCommand runs the command of argv through the shell of the
+// debug console connected to conn, copies its output to out and returns its
+// exit code.
+func runDebugConsoleCommand(conn net.Conn, argv []string, out io.Writer, timeout time.Duration) (int, error) {
+	if timeout > 0 {
+		if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+			return -1, err
+		}
+	}
+
+	words := make([]string, len(argv))
+	for i, arg := range argv {
+		words[i] = shellQuote(arg)
+	}
+	command := strings.Join(words, " ")
+
+	nonce := strconv.FormatInt(time.Now().UnixNano(), 16)
+	begin := commandBeginMarker + "_" + nonce
+	end := commandEndMarker + "_" + nonce + " "
+
+	// The command does not get any input: the console is a terminal and
+	// anything reading it would hang forever.
+	script := fmt.Sprintf("printf '%%s_%%s\\n' %s %s; ( %s ) </dev/null; printf '\\n%%s_%%s %%d\\n' %s %s $?; exit\n",
+		commandBeginMarker, nonce, command, commandEndMarker, nonce)
+	if _, err := conn.Write([]byte(script)); err != nil {
+		return -1, err
+	}
+
+	reader := bufio.NewReader(conn)
+	started := false
+	// The output is written a line late, to drop the line feed printed
+	// before the end marker.
+	var pending string
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, os.ErrDeadlineExceeded) {
+				return -1, fmt.Errorf("command did not complete in %v", timeout)
+			}
+			return -1, errors.Wrap(err, "failed to read the command output")
+		}
+		// the console terminal translates line feeds
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+
+		if !started {
+			started = line == begin
+			continue
+		}
+
+		if strings.HasPrefix(line, end) {
+			if _, err := io.WriteString(out, strings.TrimSuffix(pending, "\n")); err != nil {
+				return -1, err
+			}
+			return strconv.Atoi(strings.TrimPrefix(line, end))
+		}
+
+		if _, err := io.WriteString(out, pending); err != nil {
+			return -1, err
+		}
+		pending = line + "\n"
+	}
+}
+
 func ioCopy(stream *iostream, con console.Console) {
 	var wg sync.WaitGroup
 
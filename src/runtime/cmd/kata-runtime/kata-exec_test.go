@@ -0,0 +1,75 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDebugConsole behaves like the terminal of the debug console: it
+// echoes the command line, runs it and translates the line feeds.
+func fakeDebugConsole(conn net.Conn) {
+	go func() {
+		defer conn.Close()
+
+		line, err := bufio.NewReader(conn).ReadString('\n')
+		if err != nil {
+			return
+		}
+		conn.Write([]byte("~ # " + strings.ReplaceAll(line, "\n", "\r\n")))
+
+		output, _ := exec.Command("sh", "-c", line).CombinedOutput()
+		conn.Write(bytes.ReplaceAll(output, []byte("\n"), []byte("\r\n")))
+	}()
+}
+
+func TestRunDebugConsoleCommand(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, d := range []struct {
+		argv   []string
+		output string
+		code   int
+	}{
+		{[]string{"echo", "hello"}, "hello\n", 0},
+		{[]string{"printf", "hello"}, "hello", 0},
+		{[]string{"printf", `a\n\nb\n`}, "a\n\nb\n", 0},
+		{[]string{"true"}, "", 0},
+		{[]string{"sh", "-c", "echo oops >&2; exit 3"}, "oops\n", 3},
+		{[]string{"cat"}, "", 0},
+		// the arguments are not split nor expanded by the shell
+		{[]string{"echo", "it's  $HOME; *"}, "it's  $HOME; *\n", 0},
+	} {
+		client, server := net.Pipe()
+		fakeDebugConsole(server)
+
+		var out bytes.Buffer
+		code, err := runDebugConsoleCommand(client, d.argv, &out, 0)
+		assert.NoError(err, d.argv)
+		assert.Equal(d.code, code, d.argv)
+		assert.Equal(d.output, out.String(), d.argv)
+		client.Close()
+	}
+}
+
+func TestRunDebugConsoleCommandTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	client, server := net.Pipe()
+	defer client.Close()
+	fakeDebugConsole(server)
+
+	_, err := runDebugConsoleCommand(client, []string{"sleep", "5"}, &bytes.Buffer{}, 100*time.Millisecond)
+	assert.Error(err)
+}
@@ -0,0 +1,305 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils"
+	"github.com/urfave/cli"
+)
+
+// configSetting is a key of a section of the configuration. The hypervisor
+// and agent sections match the [hypervisor.<name>] and [agent.<name>] ones.
+type configSetting struct {
+	section string
+	key     string
+	value   string
+	// requires is a key of the hypervisor section which must be set for
+	// the setting to apply
+	requires string
+}
+
+// configProfile is a set of settings tuned for a goal, applied to the
+// configuration of the hypervisor fitting the goal best.
+type configProfile struct {
+	desc       string
+	hypervisor string
+	settings   []configSetting
+}
+
+var configProfiles = map[string]configProfile{
+	"low-latency": {
+		desc:       "fast sandbox boot and container start, at the cost of memory",
+		hypervisor: "clh",
+		settings: []configSetting{
+			{section: "hypervisor", key: "enable_mem_prealloc", value: "true"},
+			{section: "hypervisor", key: "enable_iothreads", value: "true"},
+			{section: "hypervisor", key: "virtio_fs_cache", value: `"auto"`},
+			{section: "runtime", key: "static_sandbox_resource_mgmt", value: "true"},
+		},
+	},
+	"high-density": {
+		desc:       "many small sandboxes per node, with small hot-pluggable memory",
+		hypervisor: "qemu",
+		settings: []configSetting{
+			{section: "hypervisor", key: "default_memory", value: "1024"},
+			{section: "hypervisor", key: "enable_mem_prealloc", value: "false"},
+			{section: "hypervisor", key: "enable_virtio_mem", value: "true"},
+			{section: "hypervisor", key: "virtio_fs_cache", value: `"never"`},
+			{section: "hypervisor", key: "virtio_fs_cache_size", value: "0"},
+			{section: "factory", key: "enable_template", value: "true", requires: "initrd"},
+			{section: "runtime", key: "sandbox_cgroup_only", value: "true"},
+		},
+	},
+	"confidential": {
+		desc:       "confidential guests, without host access to the guest",
+		hypervisor: "qemu",
+		settings: []configSetting{
+			{section: "hypervisor", key: "confidential_guest", value: "true"},
+			{section: "hypervisor", key: "disable_image_nvdimm", value: "true"},
+			{section: "hypervisor", key: "enable_virtio_mem", value: "false"},
+			{section: "hypervisor", key: "file_mem_backend", value: `""`},
+			{section: "hypervisor", key: "enable_debug", value: "false"},
+			{section: "agent", key: "enable_debug", value: "false"},
+			{section: "agent", key: "debug_console_enabled", value: "false"},
+			{section: "factory", key: "enable_template", value: "false"},
+			{section: "factory", key: "vm_cache_number", value: "0"},
+			{section: "runtime", key: "static_sandbox_resource_mgmt", value: "true"},
+			{section: "runtime", key: "enable_debug", value: "false"},
+			{section: "runtime", key: "enable_pprof", value: "false"},
+		},
+	},
+	"dev": {
+		desc:       "debugging of Kata Containers, with full logs and debug console",
+		hypervisor: "qemu",
+		settings: []configSetting{
+			{section: "hypervisor", key: "enable_debug", value: "true"},
+			{section: "agent", key: "enable_debug", value: "true"},
+			{section: "agent", key: "debug_console_enabled", value: "true"},
+			{section: "runtime", key: "enable_debug", value: "true"},
+			{section: "runtime", key: "enable_pprof", value: "true"},
+		},
+	},
+}
+
+var sectionHeader = regexp.MustCompile(`^\s*\[([^\[\]]+)\]\s*(#.*)?$`)
+
+func configProfileNames() []string {
+	var names []string
+	for name := range configProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func configProfilesUsage() string {
+	var usage []string
+	for _, name := range configProfileNames() {
+		p := configProfiles[name]
+		usage = append(usage, fmt.Sprintf("   %-13s %s (preferred hypervisor: %s)", name, p.desc, p.hypervisor))
+	}
+	return strings.Join(usage, "\n")
+}
+
+var kataGenConfigCLICommand = cli.Command{
+	Name:  "gen-config",
+	Usage: "generate a configuration tuned for a goal",
+	Description: `Generate a complete configuration file, based on the installed configuration
+   of the hypervisor preferred by the profile, or on --base, with the settings of
+   the profile applied. The settings the hypervisor does not support are skipped.
+
+   Profiles:
+` + configProfilesUsage(),
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "profile",
+			Usage: "profile of the configuration: " + strings.Join(configProfileNames(), ", "),
+		},
+		cli.StringFlag{
+			Name:  "base",
+			Usage: "configuration file the profile is applied to",
+		},
+		cli.StringFlag{
+			Name:  "output, o",
+			Usage: "file the configuration is written to, instead of the standard output",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		name := context.String("profile")
+		profile, ok := configProfiles[name]
+		if !ok {
+			return fmt.Errorf("invalid profile %q, must be one of %s", name, strings.Join(configProfileNames(), ", "))
+		}
+
+		base := context.String("base")
+		if base == "" {
+			configFile, _ := context.App.Metadata["configFile"].(string)
+			base = findProfileBaseConfig(profile, configFile, katautils.GetDefaultConfigFilePaths())
+			if base == "" {
+				return errors.New("gen-config: cannot determine the base configuration, use --base")
+			}
+		}
+
+		content, err := os.ReadFile(base)
+		if err != nil {
+			return err
+		}
+
+		config, skipped, err := applyConfigProfile(string(content), name, profile, base)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range skipped {
+			fmt.Fprintf(os.Stderr, "skipped %s.%s: not supported by %s\n", s.section, s.key, base)
+		}
+
+		var out io.Writer = defaultOutputFile
+		if output := context.String("output"); output != "" {
+			f, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+
+		_, err = io.WriteString(out, config)
+		return err
+	},
+}
+
+// findProfileBaseConfig returns the installed configuration of the
+// hypervisor preferred by the profile, next to the loaded or the default
+// configuration files, or the loaded configuration.
+func findProfileBaseConfig(profile configProfile, configFile string, defaultPaths []string) string {
+	var dirs []string
+	if configFile != "" {
+		dirs = append(dirs, filepath.Dir(configFile))
+	}
+	for _, path := range defaultPaths {
+		dirs = append(dirs, filepath.Dir(path))
+	}
+
+	name := fmt.Sprintf("configuration-%s.toml", profile.hypervisor)
+	for _, dir := range dirs {
+		path := filepath.Join(dir, name)
+		if katautils.FileExists(path) {
+			return path
+		}
+	}
+
+	return configFile
+}
+
+// findConfigSection returns the range of the lines of the section, after its
+// header, or -1 if it is missing.
+func findConfigSection(lines []string, section string) (int, int) {
+	start := -1
+	for i, line := range lines {
+		m := sectionHeader.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		if start >= 0 {
+			return start, i
+		}
+
+		name := strings.TrimSpace(m[1])
+		if name == section || ((section == "hypervisor" || section == "agent") && strings.HasPrefix(name, section+".")) {
+			start = i + 1
+		}
+	}
+
+	if start < 0 {
+		return -1, -1
+	}
+	return start, len(lines)
+}
+
+func configKeyRegexp(key string, commented bool) *regexp.Regexp {
+	prefix := `^\s*`
+	if commented {
+		prefix += `#\s*`
+	}
+	return regexp.MustCompile(prefix + regexp.QuoteMeta(key) + `\s*=`)
+}
+
+// setConfigKey sets the key of the section, replacing its setting or else its
+// commented example. It returns false if the section has neither, i.e. the
+// key is not supported.
+func setConfigKey(lines []string, section, key, value string) bool {
+	start, end := findConfigSection(lines, section)
+	if start < 0 {
+		return false
+	}
+
+	for _, commented := range []bool{false, true} {
+		re := configKeyRegexp(key, commented)
+		for i := start; i < end; i++ {
+			if re.MatchString(lines[i]) {
+				lines[i] = fmt.Sprintf("%s = %s", key, value)
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isConfigKeySet returns true if the key of the section is set.
+func isConfigKeySet(lines []string, section, key string) bool {
+	start, end := findConfigSection(lines, section)
+	if start < 0 {
+		return false
+	}
+
+	re := configKeyRegexp(key, false)
+	for i := start; i < end; i++ {
+		if re.MatchString(lines[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyConfigProfile applies the settings of the profile to the content of
+// the base configuration, keeping its comments. It returns the settings
+// which are skipped as the base configuration does not support them.
+func applyConfigProfile(content, name string, profile configProfile, base string) (string, []configSetting, error) {
+	lines := strings.Split(content, "\n")
+
+	var skipped []configSetting
+	for _, s := range profile.settings {
+		if s.requires != "" && !isConfigKeySet(lines, "hypervisor", s.requires) {
+			skipped = append(skipped, s)
+			continue
+		}
+		if !setConfigKey(lines, s.section, s.key, s.value) {
+			skipped = append(skipped, s)
+		}
+	}
+
+	header := fmt.Sprintf("# Generated by \"kata-runtime gen-config --profile %s\" from %s\n#\n", name, base)
+	config := header + strings.Join(lines, "\n")
+
+	var decoded map[string]interface{}
+	if _, err := toml.Decode(config, &decoded); err != nil {
+		return "", nil, fmt.Errorf("invalid generated configuration: %v", err)
+	}
+
+	return config, skipped, nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testBaseConfig = `[hypervisor.qemu]
+path = "/usr/bin/qemu-system-x86_64"
+image = "/usr/share/kata-containers/kata-containers.img"
+#enable_debug = true
+# virtio_fs_cache_size_max = 0
+virtio_fs_cache = "auto"
+# virtio_fs_cache_size = 1024
+
+[factory]
+#enable_template = true
+
+[agent.kata]
+#enable_debug = true
+
+[runtime]
+# enable_pprof = true
+`
+
+func TestApplyConfigProfile(t *testing.T) {
+	assert := assert.New(t)
+
+	config, skipped, err := applyConfigProfile(testBaseConfig, "high-density", configProfiles["high-density"], "base.toml")
+	assert.NoError(err)
+	assert.Contains(config, `# Generated by "kata-runtime gen-config --profile high-density" from base.toml`)
+	assert.Contains(config, "virtio_fs_cache = \"never\"\nvirtio_fs_cache_size = 0\n")
+	assert.Contains(config, "# virtio_fs_cache_size_max = 0\n")
+	// no initrd for the template
+	assert.Contains(config, "#enable_template = true\n")
+
+	var keys []string
+	for _, s := range skipped {
+		keys = append(keys, s.key)
+	}
+	assert.Equal([]string{"default_memory", "enable_mem_prealloc", "enable_virtio_mem", "enable_template", "sandbox_cgroup_only"}, keys)
+
+	config, skipped, err = applyConfigProfile(testBaseConfig, "dev", configProfiles["dev"], "base.toml")
+	assert.NoError(err)
+	assert.Contains(config, "[hypervisor.qemu]\npath = \"/usr/bin/qemu-system-x86_64\"\nimage = \"/usr/share/kata-containers/kata-containers.img\"\nenable_debug = true\n")
+	assert.Contains(config, "[agent.kata]\nenable_debug = true\n")
+	assert.Contains(config, "[runtime]\nenable_pprof = true\n")
+	assert.Len(skipped, 2)
+
+	_, _, err = applyConfigProfile("[runtime\n", "dev", configProfiles["dev"], "base.toml")
+	assert.Error(err)
+}
+
+func TestFindProfileBaseConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "configuration.toml")
+	defaultDir := t.TempDir()
+	defaultPaths := []string{filepath.Join(defaultDir, "configuration.toml")}
+
+	assert.Equal(configFile, findProfileBaseConfig(configProfiles["low-latency"], configFile, defaultPaths))
+
+	clh := filepath.Join(defaultDir, "configuration-clh.toml")
+	assert.NoError(os.WriteFile(clh, []byte(testBaseConfig), 0644))
+	assert.Equal(clh, findProfileBaseConfig(configProfiles["low-latency"], configFile, defaultPaths))
+
+	clh = filepath.Join(dir, "configuration-clh.toml")
+	assert.NoError(os.WriteFile(clh, []byte(testBaseConfig), 0644))
+	assert.Equal(clh, findProfileBaseConfig(configProfiles["low-latency"], configFile, defaultPaths))
+
+	assert.Empty(findProfileBaseConfig(configProfiles["dev"], "", nil))
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	containerdshim "github.com/kata-containers/kata-containers/src/runtime/pkg/containerd-shim-v2"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/utils/shimclient"
+	"github.com/urfave/cli"
+)
+
+var iptablesV6Flag = cli.BoolFlag{
+	Name:  "v6",
+	Usage: "manage the ip6tables rules instead of the iptables ones",
+}
+
+var kataIPTablesCLICommand = cli.Command{
+	Name:  "iptables",
+	Usage: "get or set the iptables rules of the guest of a running sandbox",
+	Subcommands: []cli.Command{
+		getIPTablesCommand,
+		setIPTablesCommand,
+	},
+}
+
+var getIPTablesCommand = cli.Command{
+	Name:      "get",
+	Usage:     "display the iptables rules of the guest, in iptables-save format",
+	UsageText: "iptables get [--v6] <sandbox id>",
+	Flags:     []cli.Flag{iptablesV6Flag},
+	Action: func(context *cli.Context) error {
+		sandboxID := context.Args().Get(0)
+
+		if err := katautils.VerifyContainerID(sandboxID); err != nil {
+			return err
+		}
+
+		rules, err := shimclient.DoGet(sandboxID, defaultTimeout, iptablesURL(context.Bool("v6")))
+		if err != nil {
+			return err
+		}
+
+		_, err = defaultOutputFile.Write(rules)
+		return err
+	},
+}
+
+var setIPTablesCommand = cli.Command{
+	Name:  "set",
+	Usage: "replace the iptables rules of the guest",
+	UsageText: "iptables set [--v6] <sandbox id> <file>\n\n" +
+		"   The file is in iptables-save format, \"-\" reads the rules from the standard input.",
+	Flags: []cli.Flag{iptablesV6Flag},
+	Action: func(context *cli.Context) error {
+		sandboxID := context.Args().Get(0)
+
+		if err := katautils.VerifyContainerID(sandboxID); err != nil {
+			return err
+		}
+
+		rules, err := readIPTablesRules(context.Args().Get(1), os.Stdin)
+		if err != nil {
+			return err
+		}
+
+		return shimclient.DoPut(sandboxID, defaultTimeout, iptablesURL(context.Bool("v6")), "text/plain", rules)
+	},
+}
+
+func iptablesURL(ipv6 bool) string {
+	if ipv6 {
+		return containerdshim.IP6TablesUrl
+	}
+	return containerdshim.IPTablesUrl
+}
+
+// readIPTablesRules reads the rules to set from file, or from stdin if file
+// is "-".
+func readIPTablesRules(file string, stdin io.Reader) ([]byte, error) {
+	var rules []byte
+	var err error
+
+	switch file {
+	case "":
+		return nil, errors.New("missing iptables rules file")
+	case "-":
+		rules, err = io.ReadAll(stdin)
+	default:
+		rules, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(string(rules)) == "" {
+		return nil, fmt.Errorf("no iptables rules in %s", file)
+	}
+
+	return rules, nil
+}
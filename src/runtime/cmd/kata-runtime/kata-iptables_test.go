@@ -0,0 +1,49 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	containerdshim "github.com/kata-containers/kata-containers/src/runtime/pkg/containerd-shim-v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPTablesURL(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(containerdshim.IPTablesUrl, iptablesURL(false))
+	assert.Equal(containerdshim.IP6TablesUrl, iptablesURL(true))
+}
+
+func TestReadIPTablesRules(t *testing.T) {
+	assert := assert.New(t)
+
+	rules := "*filter\n:INPUT ACCEPT [0:0]\nCOMMIT\n"
+
+	file := filepath.Join(t.TempDir(), "rules")
+	assert.NoError(os.WriteFile(file, []byte(rules), 0600))
+
+	data, err := readIPTablesRules(file, nil)
+	assert.NoError(err)
+	assert.Equal(rules, string(data))
+
+	data, err = readIPTablesRules("-", strings.NewReader(rules))
+	assert.NoError(err)
+	assert.Equal(rules, string(data))
+
+	_, err = readIPTablesRules("", nil)
+	assert.Error(err)
+
+	_, err = readIPTablesRules(filepath.Join(t.TempDir(), "missing"), nil)
+	assert.Error(err)
+
+	_, err = readIPTablesRules("-", strings.NewReader("\n"))
+	assert.Error(err)
+}
@@ -6,17 +6,54 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
 
 	kataMonitor "github.com/kata-containers/kata-containers/src/runtime/pkg/kata-monitor"
 	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
 	"github.com/urfave/cli"
 )
 
+// jsonMetric is a metric of the JSON output. Values that are not finite,
+// which JSON cannot represent, are omitted.
+type jsonMetric struct {
+	Labels    map[string]string  `json:",omitempty"`
+	Value     *float64           `json:",omitempty"`
+	Count     *uint64            `json:",omitempty"`
+	Sum       *float64           `json:",omitempty"`
+	Buckets   map[string]uint64  `json:",omitempty"`
+	Quantiles map[string]float64 `json:",omitempty"`
+}
+
+// jsonMetricFamily is a metric family of the JSON output.
+type jsonMetricFamily struct {
+	Name    string
+	Help    string
+	Type    string
+	Metrics []jsonMetric
+}
+
 var kataMetricsCLICommand = cli.Command{
 	Name:      "metrics",
 	Usage:     "gather metrics associated with infrastructure used to run a sandbox",
-	UsageText: "metrics <sandbox id>",
+	UsageText: "metrics [--json] [--filter <prefix>] <sandbox id>",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "json",
+			Usage: "Format output as JSON instead of the Prometheus text format",
+		},
+		cli.StringFlag{
+			Name:  "filter",
+			Usage: "Only display the metrics whose name starts with the prefix, e.g. kata_hypervisor_",
+		},
+	},
 	Action: func(context *cli.Context) error {
 
 		sandboxID := context.Args().Get(0)
@@ -31,8 +68,110 @@ var kataMetricsCLICommand = cli.Command{
 			return err
 		}
 
-		fmt.Printf("%s\n", metrics)
+		if !context.Bool("json") && context.String("filter") == "" {
+			fmt.Printf("%s\n", metrics)
+			return nil
+		}
 
-		return nil
+		return writeSandboxMetrics(defaultOutputFile, metrics, context.String("filter"), context.Bool("json"))
 	},
 }
+
+// writeSandboxMetrics decodes the metrics in Prometheus text format, and
+// writes the ones starting with prefix in Prometheus text or JSON format.
+func writeSandboxMetrics(w io.Writer, metrics, prefix string, jsonFormat bool) error {
+	parser := expfmt.TextParser{}
+	families, err := parser.TextToMetricFamilies(strings.NewReader(metrics))
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for name := range families {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	if !jsonFormat {
+		for _, name := range names {
+			if _, err := expfmt.MetricFamilyToText(w, families[name]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	output := make([]jsonMetricFamily, 0, len(names))
+	for _, name := range names {
+		output = append(output, toJSONMetricFamily(families[name]))
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
+
+func finite(v float64) *float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil
+	}
+	return &v
+}
+
+func formatBound(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func toJSONMetricFamily(mf *dto.MetricFamily) jsonMetricFamily {
+	family := jsonMetricFamily{
+		Name: mf.GetName(),
+		Help: mf.GetHelp(),
+		Type: strings.ToLower(mf.GetType().String()),
+	}
+
+	for _, m := range mf.Metric {
+		metric := jsonMetric{}
+
+		if len(m.Label) > 0 {
+			metric.Labels = make(map[string]string, len(m.Label))
+			for _, l := range m.Label {
+				metric.Labels[l.GetName()] = l.GetValue()
+			}
+		}
+
+		switch mf.GetType() {
+		case dto.MetricType_COUNTER:
+			metric.Value = finite(m.GetCounter().GetValue())
+		case dto.MetricType_GAUGE:
+			metric.Value = finite(m.GetGauge().GetValue())
+		case dto.MetricType_UNTYPED:
+			metric.Value = finite(m.GetUntyped().GetValue())
+		case dto.MetricType_SUMMARY:
+			s := m.GetSummary()
+			count := s.GetSampleCount()
+			metric.Count = &count
+			metric.Sum = finite(s.GetSampleSum())
+			metric.Quantiles = make(map[string]float64, len(s.Quantile))
+			for _, q := range s.Quantile {
+				if v := finite(q.GetValue()); v != nil {
+					metric.Quantiles[formatBound(q.GetQuantile())] = *v
+				}
+			}
+		case dto.MetricType_HISTOGRAM:
+			h := m.GetHistogram()
+			count := h.GetSampleCount()
+			metric.Count = &count
+			metric.Sum = finite(h.GetSampleSum())
+			metric.Buckets = make(map[string]uint64, len(h.Bucket))
+			for _, b := range h.Bucket {
+				metric.Buckets[formatBound(b.GetUpperBound())] = b.GetCumulativeCount()
+			}
+		}
+
+		family.Metrics = append(family.Metrics, metric)
+	}
+
+	return family
+}
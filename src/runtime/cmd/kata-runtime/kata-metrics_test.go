@@ -0,0 +1,73 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSandboxMetrics = `# HELP kata_hypervisor_fds Open FDs for hypervisor.
+# TYPE kata_hypervisor_fds gauge
+kata_hypervisor_fds 42
+# HELP kata_hypervisor_netdev Net devices statistics.
+# TYPE kata_hypervisor_netdev gauge
+kata_hypervisor_netdev{interface="eth0",item="recv_bytes"} 1024
+kata_hypervisor_netdev{interface="eth0",item="sent_bytes"} NaN
+# HELP kata_shim_rpc_durations_histogram_milliseconds RPC latency distributions.
+# TYPE kata_shim_rpc_durations_histogram_milliseconds histogram
+kata_shim_rpc_durations_histogram_milliseconds_bucket{action="create",le="1"} 0
+kata_shim_rpc_durations_histogram_milliseconds_bucket{action="create",le="+Inf"} 3
+kata_shim_rpc_durations_histogram_milliseconds_sum{action="create"} 1500
+kata_shim_rpc_durations_histogram_milliseconds_count{action="create"} 3
+`
+
+func TestWriteSandboxMetricsFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	var out bytes.Buffer
+	assert.NoError(writeSandboxMetrics(&out, testSandboxMetrics, "kata_hypervisor_", false))
+	assert.Contains(out.String(), "kata_hypervisor_fds 42")
+	assert.Contains(out.String(), "kata_hypervisor_netdev")
+	assert.NotContains(out.String(), "kata_shim_")
+
+	out.Reset()
+	assert.Error(writeSandboxMetrics(&out, "invalid metrics", "", false))
+}
+
+func TestWriteSandboxMetricsJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	var out bytes.Buffer
+	assert.NoError(writeSandboxMetrics(&out, testSandboxMetrics, "", true))
+
+	var families []jsonMetricFamily
+	assert.NoError(json.Unmarshal(out.Bytes(), &families))
+	assert.Len(families, 3)
+
+	fds := families[0]
+	assert.Equal("kata_hypervisor_fds", fds.Name)
+	assert.Equal("gauge", fds.Type)
+	assert.Len(fds.Metrics, 1)
+	assert.Equal(42.0, *fds.Metrics[0].Value)
+
+	netdev := families[1]
+	assert.Len(netdev.Metrics, 2)
+	assert.Equal("eth0", netdev.Metrics[0].Labels["interface"])
+	assert.Equal(1024.0, *netdev.Metrics[0].Value)
+	// NaN cannot be represented in JSON
+	assert.Nil(netdev.Metrics[1].Value)
+
+	rpc := families[2]
+	assert.Equal("histogram", rpc.Type)
+	assert.Equal(uint64(3), *rpc.Metrics[0].Count)
+	assert.Equal(1500.0, *rpc.Metrics[0].Sum)
+	assert.Equal(uint64(3), rpc.Metrics[0].Buckets["+Inf"])
+	assert.Equal(uint64(0), rpc.Metrics[0].Buckets["1"])
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/docker/go-units"
+	containerdshim "github.com/kata-containers/kata-containers/src/runtime/pkg/containerd-shim-v2"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/utils/shimclient"
+	"github.com/urfave/cli"
+)
+
+var kataSandboxCLICommand = cli.Command{
+	Name:  "sandbox",
+	Usage: "manage the running sandboxes",
+	Subcommands: []cli.Command{
+		{
+			Name:  "list",
+			Usage: "list the running sandboxes, with their allocated and used resources",
+			Description: `The sandboxes are found in the run store, and their resources are queried
+   from their shims. RSS is the resident memory of the hypervisor, virtiofsd
+   and shim processes of the sandbox, and UPTIME the one of its hypervisor.`,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "json",
+					Usage: "display the sandboxes in JSON format",
+				},
+			},
+			Action: func(context *cli.Context) error {
+				infos, err := listSandboxes(containerdshim.GetSandboxesStoragePath(), getSandboxInfo)
+				if err != nil {
+					return err
+				}
+
+				return writeSandboxInfos(defaultOutputFile, infos, time.Now(), context.Bool("json"))
+			},
+		},
+		{
+			Name:      "journal",
+			Usage:     "display the lifecycle journal of a sandbox",
+			ArgsUsage: "<sandbox-id>",
+			Description: `The journal records the creation, the boot phases, the device hotplugs, the
+   errors and the shutdown of the sandbox, in /var/lib/kata-containers/journal.
+   It is kept for 7 days after the last event. ELAPSED is the time since the
+   first event.`,
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "json",
+					Usage: "display the events in JSON format",
+				},
+			},
+			Action: func(context *cli.Context) error {
+				sandboxID := context.Args().First()
+				if sandboxID == "" {
+					return errors.New("sandbox journal: missing sandbox ID")
+				}
+
+				events, err := containerdshim.ReadJournal(containerdshim.JournalPath(sandboxID))
+				if os.IsNotExist(err) {
+					return fmt.Errorf("no journal for sandbox %s", sandboxID)
+				}
+				if err != nil {
+					return err
+				}
+
+				return writeJournal(defaultOutputFile, events, context.Bool("json"))
+			},
+		},
+	},
+}
+
+func getSandboxInfo(sandboxID string) (containerdshim.SandboxInfo, error) {
+	var info containerdshim.SandboxInfo
+
+	data, err := shimclient.DoGet(sandboxID, defaultTimeout, containerdshim.SandboxInfoUrl)
+	if err != nil {
+		return info, err
+	}
+
+	err = json.Unmarshal(data, &info)
+	return info, err
+}
+
+// listSandboxes returns the info of the sandboxes of the run store, sorted by
+// ID. The sandboxes whose shim cannot be queried are skipped with a warning,
+// as they can be stopping.
+func listSandboxes(storePath string, getInfo func(string) (containerdshim.SandboxInfo, error)) ([]containerdshim.SandboxInfo, error) {
+	entries, err := os.ReadDir(storePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []containerdshim.SandboxInfo
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		info, err := getInfo(entry.Name())
+		if err != nil {
+			kataLog.WithError(err).WithField("sandbox", entry.Name()).Warn("failed to query the shim of the sandbox")
+			continue
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+
+	return infos, nil
+}
+
+func writeSandboxInfos(out io.Writer, infos []containerdshim.SandboxInfo, now time.Time, asJSON bool) error {
+	if asJSON {
+		if infos == nil {
+			infos = []containerdshim.SandboxInfo{}
+		}
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(infos)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "SANDBOX ID\tPID\tVCPUS\tMEMORY\tRSS\tUPTIME")
+	for _, info := range infos {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\n", info.ID, info.HypervisorPid, info.VCPUs,
+			units.BytesSize(float64(info.MemoryMB)*units.MiB), units.BytesSize(float64(info.RSSBytes)),
+			units.HumanDuration(now.Sub(info.StartTime)))
+	}
+	return w.Flush()
+}
+
+// journalTimeFormat is RFC 3339 with milliseconds, for the times to be aligned
+const journalTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+func writeJournal(out io.Writer, events []containerdshim.VMEvent, asJSON bool) error {
+	if asJSON {
+		if events == nil {
+			events = []containerdshim.VMEvent{}
+		}
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(events)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tELAPSED\tEVENT\tDETAILS")
+	for _, e := range events {
+		var details []string
+		for key, value := range e.Attributes {
+			details = append(details, fmt.Sprintf("%s=%q", key, value))
+		}
+		sort.Strings(details)
+
+		fmt.Fprintf(w, "%s\t+%.3fs\t%s\t%s\n", e.Timestamp.Format(journalTimeFormat),
+			e.Timestamp.Sub(events[0].Timestamp).Seconds(), e.Type, strings.Join(details, " "))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	containerdshim "github.com/kata-containers/kata-containers/src/runtime/pkg/containerd-shim-v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListSandboxes(t *testing.T) {
+	assert := assert.New(t)
+
+	storePath := t.TempDir()
+
+	getInfo := func(id string) (containerdshim.SandboxInfo, error) {
+		if id == "stopping" {
+			return containerdshim.SandboxInfo{}, errors.New("connection refused")
+		}
+		return containerdshim.SandboxInfo{ID: id}, nil
+	}
+
+	infos, err := listSandboxes(filepath.Join(storePath, "missing"), getInfo)
+	assert.NoError(err)
+	assert.Empty(infos)
+
+	for _, id := range []string{"sb2", "stopping", "sb1"} {
+		assert.NoError(os.Mkdir(filepath.Join(storePath, id), 0700))
+	}
+	assert.NoError(os.WriteFile(filepath.Join(storePath, "file"), nil, 0600))
+
+	infos, err = listSandboxes(storePath, getInfo)
+	assert.NoError(err)
+	assert.Equal([]containerdshim.SandboxInfo{{ID: "sb1"}, {ID: "sb2"}}, infos)
+}
+
+func TestWriteSandboxInfos(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now()
+	infos := []containerdshim.SandboxInfo{
+		{
+			ID:            "sb1",
+			HypervisorPid: 1234,
+			VCPUs:         2,
+			MemoryMB:      2048,
+			RSSBytes:      512 << 20,
+			StartTime:     now.Add(-2 * time.Hour),
+		},
+	}
+
+	var out bytes.Buffer
+	assert.NoError(writeSandboxInfos(&out, infos, now, false))
+	assert.Equal("SANDBOX ID  PID   VCPUS  MEMORY  RSS     UPTIME\n"+
+		"sb1         1234  2      2GiB    512MiB  2 hours\n", out.String())
+
+	out.Reset()
+	assert.NoError(writeSandboxInfos(&out, infos, now, true))
+	var decoded []containerdshim.SandboxInfo
+	assert.NoError(json.Unmarshal(out.Bytes(), &decoded))
+	assert.Len(decoded, 1)
+	assert.True(infos[0].StartTime.Equal(decoded[0].StartTime))
+
+	out.Reset()
+	assert.NoError(writeSandboxInfos(&out, nil, now, true))
+	assert.Equal("[]\n", out.String())
+}
+
+func TestWriteJournal(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	events := []containerdshim.VMEvent{
+		{
+			Timestamp:  start,
+			Attributes: map[string]string{"hypervisor": "qemu", "bundle": "/run/bundle"},
+			SandboxID:  "sb1",
+			Type:       "sandbox-create",
+		},
+		{
+			Timestamp: start.Add(1500 * time.Millisecond),
+			SandboxID: "sb1",
+			Type:      "agent-connected",
+		},
+	}
+
+	var out bytes.Buffer
+	assert.NoError(writeJournal(&out, events, false))
+	assert.Contains(out.String(), "2022-03-01T10:00:00.000Z  +0.000s  sandbox-create   bundle=\"/run/bundle\" hypervisor=\"qemu\"")
+	assert.Contains(out.String(), "2022-03-01T10:00:01.500Z  +1.500s  agent-connected")
+
+	out.Reset()
+	assert.NoError(writeJournal(&out, events, true))
+	var decoded []containerdshim.VMEvent
+	assert.NoError(json.Unmarshal(out.Bytes(), &decoded))
+	assert.Equal(events, decoded)
+
+	out.Reset()
+	assert.NoError(writeJournal(&out, nil, true))
+	assert.Equal("[]\n", out.String())
+}
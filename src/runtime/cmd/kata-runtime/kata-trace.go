@@ -0,0 +1,86 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	containerdshim "github.com/kata-containers/kata-containers/src/runtime/pkg/containerd-shim-v2"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/utils/shimclient"
+	"github.com/urfave/cli"
+)
+
+var kataTraceCLICommand = cli.Command{
+	Name:  "trace",
+	Usage: "toggle the tracing of a running sandbox, without recreating it",
+	Description: `The traces of the runtime shim are sent to the Jaeger collector of the
+   runtime configuration, and the ones of the agent to the trace forwarder,
+   which must be running on the host.`,
+	Subcommands: []cli.Command{
+		{
+			Name:      "enable",
+			Usage:     "start the tracing of the shim and of the agent of a sandbox",
+			UsageText: "trace enable <sandbox id>",
+			Action: func(context *cli.Context) error {
+				return setSandboxTracing(context.Args().Get(0), true)
+			},
+		},
+		{
+			Name:      "disable",
+			Usage:     "stop the tracing of the shim and of the agent of a sandbox",
+			UsageText: "trace disable <sandbox id>",
+			Action: func(context *cli.Context) error {
+				return setSandboxTracing(context.Args().Get(0), false)
+			},
+		},
+		{
+			Name:      "status",
+			Usage:     "display whether a sandbox is traced",
+			UsageText: "trace status <sandbox id>",
+			Action: func(context *cli.Context) error {
+				sandboxID := context.Args().Get(0)
+
+				if err := katautils.VerifyContainerID(sandboxID); err != nil {
+					return err
+				}
+
+				data, err := shimclient.DoGet(sandboxID, defaultTimeout, containerdshim.TracingUrl)
+				if err != nil {
+					return err
+				}
+
+				var state containerdshim.TracingState
+				if err := json.Unmarshal(data, &state); err != nil {
+					return err
+				}
+
+				if state.Enabled {
+					fmt.Fprintln(defaultOutputFile, "enabled")
+				} else {
+					fmt.Fprintln(defaultOutputFile, "disabled")
+				}
+				return nil
+			},
+		},
+	},
+}
+
+func setSandboxTracing(sandboxID string, enable bool) error {
+	if err := katautils.VerifyContainerID(sandboxID); err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(containerdshim.TracingState{
+		Enabled: enable,
+	})
+	if err != nil {
+		return err
+	}
+
+	return shimclient.DoPost(sandboxID, defaultTimeout, containerdshim.TracingUrl, encoded)
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"time"
+
+	containerdshim "github.com/kata-containers/kata-containers/src/runtime/pkg/containerd-shim-v2"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/utils/shimclient"
+	"github.com/urfave/cli"
+)
+
+// The new shim has up to a minute to adopt the sandbox
+const upgradeShimTimeout = 90 * time.Second
+
+var kataUpgradeShimCLICommand = cli.Command{
+	Name:  "upgrade-shim",
+	Usage: "hand a running sandbox over to a shim started from the installed shim binary",
+	UsageText: `upgrade-shim <sandbox id>
+
+   The shim of the sandbox is replaced without restarting the VM, so that the
+   sandboxes of a node need not be drained when the runtime is upgraded.
+   containerd must be stopped while the shims are upgraded, and started again
+   once they are: it reconnects to the new shims then.`,
+	Action: func(context *cli.Context) error {
+		sandboxID := context.Args().Get(0)
+
+		if err := katautils.VerifyContainerID(sandboxID); err != nil {
+			return err
+		}
+
+		return shimclient.DoPost(sandboxID, upgradeShimTimeout, containerdshim.UpgradeUrl, nil)
+	},
+}
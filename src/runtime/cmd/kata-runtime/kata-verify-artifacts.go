@@ -0,0 +1,82 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/artifacts"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/oci"
+	"github.com/urfave/cli"
+)
+
+var kataVerifyArtifactsCLICommand = cli.Command{
+	Name:  "verify-artifacts",
+	Usage: "verify the digests and signatures of the configured kernel, initrd, image and firmware",
+	Description: fmt.Sprintf(`The digest of each artifact is read from the file of the same name with the
+   %q suffix, in the sha256sum format. When a public key is configured, the
+   signature of the digest of each artifact is read from the file with the %q
+   suffix, as created by "openssl dgst -sha256 -sign <private key>".`, artifacts.DigestSuffix, artifacts.SignatureSuffix),
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "public-key",
+			Usage: "PEM public key verifying the signatures, instead of the artifacts_public_key of the configuration",
+		},
+		cli.BoolFlag{
+			Name:  "json",
+			Usage: "display the results in JSON format",
+		},
+	},
+	Action: func(context *cli.Context) error {
+		runtimeConfig, ok := context.App.Metadata["runtimeConfig"].(oci.RuntimeConfig)
+		if !ok {
+			return errors.New("verify-artifacts: cannot determine runtime config")
+		}
+
+		publicKey := runtimeConfig.ArtifactsPublicKey
+		if context.IsSet("public-key") {
+			publicKey = context.String("public-key")
+		}
+
+		results, verifyErr := katautils.VerifyGuestArtifacts(runtimeConfig.HypervisorConfig, publicKey)
+		if results == nil && verifyErr != nil {
+			return verifyErr
+		}
+
+		if err := writeArtifactsResults(defaultOutputFile, results, context.Bool("json")); err != nil {
+			return err
+		}
+
+		return verifyErr
+	},
+}
+
+func writeArtifactsResults(out io.Writer, results []artifacts.Result, asJSON bool) error {
+	if asJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(results)
+	}
+
+	for _, r := range results {
+		status := "OK"
+		switch {
+		case r.Error != "":
+			status = "FAILED: " + r.Error
+		case r.SignatureVerified:
+			status = "OK (signature)"
+		case r.DigestVerified:
+			status = "OK (digest)"
+		}
+		fmt.Fprintf(out, "%-8s %s: %s\n", r.Name, r.Path, status)
+	}
+
+	return nil
+}
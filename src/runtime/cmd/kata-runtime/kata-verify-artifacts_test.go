@@ -0,0 +1,38 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/artifacts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteArtifactsResults(t *testing.T) {
+	assert := assert.New(t)
+
+	results := []artifacts.Result{
+		{Artifact: artifacts.Artifact{Name: "kernel", Path: "/kernel"}, DigestVerified: true},
+		{Artifact: artifacts.Artifact{Name: "image", Path: "/image"}, DigestVerified: true, SignatureVerified: true},
+		{Artifact: artifacts.Artifact{Name: "initrd", Path: "/initrd"}, Error: "digest mismatch"},
+	}
+
+	var out bytes.Buffer
+	assert.NoError(writeArtifactsResults(&out, results, false))
+	assert.Equal("kernel   /kernel: OK (digest)\n"+
+		"image    /image: OK (signature)\n"+
+		"initrd   /initrd: FAILED: digest mismatch\n", out.String())
+
+	out.Reset()
+	assert.NoError(writeArtifactsResults(&out, results, true))
+
+	var decoded []artifacts.Result
+	assert.NoError(json.Unmarshal(out.Bytes(), &decoded))
+	assert.Equal(results, decoded)
+}
@@ -7,6 +7,7 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/kata-containers/kata-containers/src/runtime/pkg/utils/shimclient"
 
 	"github.com/urfave/cli"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 var volumeSubCmds = []cli.Command{
@@ -27,7 +29,7 @@ var volumeSubCmds = []cli.Command{
 var (
 	mountInfo  string
 	volumePath string
-	size       uint64
+	size       string
 )
 
 var kataVolumeCommand = cli.Command{
@@ -91,6 +93,10 @@ var statsCommand = cli.Command{
 		},
 	},
 	Action: func(c *cli.Context) error {
+		if volumePath == "" {
+			return cli.NewExitError("missing --volume-path", 1)
+		}
+
 		stats, err := Stats(volumePath)
 		if err != nil {
 			return cli.NewExitError(err.Error(), 1)
@@ -110,20 +116,50 @@ var resizeCommand = cli.Command{
 			Usage:       "the target volume path the volume is published to",
 			Destination: &volumePath,
 		},
-		cli.Uint64Flag{
+		cli.StringFlag{
 			Name:        "size",
-			Usage:       "the new size of the volume",
+			Usage:       "the new size of the volume, in bytes or as a Kubernetes quantity, e.g. 8Gi (GiB) or 8G (GB)",
 			Destination: &size,
 		},
 	},
 	Action: func(c *cli.Context) error {
-		if err := Resize(volumePath, size); err != nil {
+		if volumePath == "" {
+			return cli.NewExitError("missing --volume-path", 1)
+		}
+
+		bytes, err := parseVolumeSize(size)
+		if err != nil {
+			return cli.NewExitError(err.Error(), 1)
+		}
+
+		if err := Resize(volumePath, bytes); err != nil {
 			return cli.NewExitError(err.Error(), 1)
 		}
 		return nil
 	},
 }
 
+// parseVolumeSize parses the size of a volume, as a number of bytes or as a
+// Kubernetes quantity: the binary suffixes are in powers of 1024, e.g. 8Gi is
+// 8 GiB, and the decimal ones in powers of 1000, e.g. 8G is 8 GB.
+func parseVolumeSize(s string) (uint64, error) {
+	if s == "" {
+		return 0, errors.New("missing --size")
+	}
+
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid volume size %q: %v", s, err)
+	}
+
+	bytes, ok := q.AsInt64()
+	if !ok || bytes <= 0 {
+		return 0, fmt.Errorf("invalid volume size %q", s)
+	}
+
+	return uint64(bytes), nil
+}
+
 // Stats retrieves the filesystem stats of the direct volume inside the guest.
 func Stats(volumePath string) ([]byte, error) {
 	sandboxId, err := volume.GetSandboxIdForVolume(volumePath)
@@ -0,0 +1,42 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVolumeSize(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, d := range []struct {
+		size     string
+		expected uint64
+		err      bool
+	}{
+		{"", 0, true},
+		{"0", 0, true},
+		{"-1", 0, true},
+		{"foo", 0, true},
+		{"4096", 4096, false},
+		{"8Gi", 8 << 30, false},
+		{"512Mi", 512 << 20, false},
+		{"8G", 8000000000, false},
+		{"1k", 1000, false},
+		{"1GiB", 0, true},
+		{"100m", 0, true},
+	} {
+		bytes, err := parseVolumeSize(d.size)
+		if d.err {
+			assert.Error(err, d.size)
+			continue
+		}
+		assert.NoError(err, d.size)
+		assert.Equal(d.expected, bytes, d.size)
+	}
+}
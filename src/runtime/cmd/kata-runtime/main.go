@@ -123,6 +123,16 @@ var runtimeCommands = []cli.Command{
 	kataEnvCLICommand,
 	kataExecCLICommand,
 	kataMetricsCLICommand,
+	kataDiagnosticsCLICommand,
+	kataCollectCLICommand,
+	kataAgentLogLevelCLICommand,
+	kataIPTablesCLICommand,
+	kataTraceCLICommand,
+	kataVerifyArtifactsCLICommand,
+	kataGenConfigCLICommand,
+	kataBenchCLICommand,
+	kataSandboxCLICommand,
+	kataUpgradeShimCLICommand,
 	factoryCLICommand,
 	kataVolumeCommand,
 }
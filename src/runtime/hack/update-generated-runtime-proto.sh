@@ -12,3 +12,17 @@ protoc \
 Mgoogle/protobuf/empty.proto=github.com/gogo/protobuf/types,\
 plugins=grpc:protocols/cache \
 	protocols/cache/cache.proto
+
+protoc \
+	-I=. \
+	-I=vendor \
+	-I=$GOPATH/src/github.com/gogo/protobuf/protobuf \
+	--gogottrpc_out=\
+Mgithub.com/containerd/containerd/api/types/metrics.proto=github.com/containerd/containerd/api/types,\
+Mgithub.com/containerd/containerd/api/types/mount.proto=github.com/containerd/containerd/api/types,\
+Mgithub.com/containerd/containerd/api/types/platform.proto=github.com/containerd/containerd/api/types,\
+Mgoogle/protobuf/any.proto=github.com/gogo/protobuf/types,\
+Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types,\
+paths=source_relative,\
+plugins=ttrpc:. \
+	protocols/sandbox/sandbox.proto
@@ -0,0 +1,337 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+// Package artifacts verifies the integrity of the guest artifacts, i.e. the
+// kernel, initrd, image and firmware booted by the hypervisor, from their
+// detached digests and signatures.
+package artifacts
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// DigestSuffix is the suffix of the file holding the SHA-256 digest of
+	// an artifact, in the sha256sum format.
+	DigestSuffix = ".sha256"
+
+	// SignatureSuffix is the suffix of the file holding the signature of
+	// the SHA-256 digest of an artifact, as created by
+	// "openssl dgst -sha256 -sign <private key>".
+	SignatureSuffix = ".sig"
+)
+
+var (
+	ErrDigestMismatch   = errors.New("digest mismatch")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrReplaced         = errors.New("replaced while verified")
+)
+
+// digestCacheDir holds the digests of the artifacts already computed, for
+// the large images not to be read again before each sandbox boot. They are
+// only used for the same file, not modified since.
+var digestCacheDir = "/run/kata-containers/artifacts"
+
+// digestCacheMinAge is the age of the last change of an artifact after which
+// its digest is cached: the timestamps of the files are not precise enough
+// to tell apart the changes made right after the digest is computed.
+const digestCacheMinAge = time.Second
+
+// Artifact is a guest artifact to verify.
+type Artifact struct {
+	// Name describes the artifact, e.g. "kernel".
+	Name string
+	Path string
+}
+
+// Result is the outcome of the verification of an artifact.
+type Result struct {
+	Artifact
+	// Digest is the SHA-256 digest of the artifact, in hexadecimal.
+	Digest            string
+	DigestVerified    bool
+	SignatureVerified bool
+	Error             string `json:",omitempty"`
+}
+
+// LoadPublicKey loads the PEM encoded RSA or ECDSA public key used to verify
+// the signatures of the artifacts.
+func LoadPublicKey(path string) (crypto.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in %s", path)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key %s: %v", path, err)
+	}
+
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T in %s", key, path)
+	}
+}
+
+// fileID identifies a file and its content: the content of the same file
+// with the same size and timestamps is the same. The change time cannot be
+// set back by the users of the file.
+type fileID struct {
+	Dev   uint64
+	Ino   uint64
+	Size  int64
+	Mtime int64
+	Ctime int64
+}
+
+func statFileID(st *unix.Stat_t) fileID {
+	return fileID{
+		Dev:   uint64(st.Dev),
+		Ino:   st.Ino,
+		Size:  st.Size,
+		Mtime: unix.TimespecToNsec(st.Mtim),
+		Ctime: unix.TimespecToNsec(st.Ctim),
+	}
+}
+
+// cachedDigest is an entry of the digest cache.
+type cachedDigest struct {
+	ID     fileID
+	Digest string
+}
+
+func digestCachePath(path string) string {
+	key := sha256.Sum256([]byte(path))
+	return filepath.Join(digestCacheDir, hex.EncodeToString(key[:]))
+}
+
+// readCachedDigest returns the cached digest of the file, if any.
+func readCachedDigest(path string, id fileID) []byte {
+	data, err := os.ReadFile(digestCachePath(path))
+	if err != nil {
+		return nil
+	}
+
+	var entry cachedDigest
+	if json.Unmarshal(data, &entry) != nil || entry.ID != id {
+		return nil
+	}
+
+	digest, err := hex.DecodeString(entry.Digest)
+	if err != nil || len(digest) != sha256.Size {
+		return nil
+	}
+	return digest
+}
+
+// writeCachedDigest caches the digest of the file. The cache is only an
+// optimization, failing to update it is not an error.
+func writeCachedDigest(path string, id fileID, digest []byte) {
+	if time.Since(time.Unix(0, id.Ctime)) < digestCacheMinAge {
+		return
+	}
+
+	data, err := json.Marshal(cachedDigest{ID: id, Digest: hex.EncodeToString(digest)})
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(digestCacheDir, 0700); err != nil {
+		return
+	}
+
+	tmp, err := os.CreateTemp(digestCacheDir, ".tmp-")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil && closeErr == nil {
+		os.Rename(tmp.Name(), digestCachePath(path))
+	}
+}
+
+// fileDigest returns the SHA-256 digest of the open file, from the cache if
+// the file did not change since it was computed, and the identity of the
+// file.
+func fileDigest(path string, f *os.File) ([]byte, fileID, error) {
+	var st unix.Stat_t
+	if err := unix.Fstat(int(f.Fd()), &st); err != nil {
+		return nil, fileID{}, err
+	}
+	id := statFileID(&st)
+
+	if digest := readCachedDigest(path, id); digest != nil {
+		return digest, id, nil
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, id, err
+	}
+	digest := h.Sum(nil)
+
+	writeCachedDigest(path, id, digest)
+
+	return digest, id, nil
+}
+
+// readDigestFile returns the digest of a file in the sha256sum format, i.e.
+// the hexadecimal digest optionally followed by the file name.
+func readDigestFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("empty digest file %s", path)
+	}
+
+	digest, err := hex.DecodeString(fields[0])
+	if err != nil || len(digest) != sha256.Size {
+		return nil, fmt.Errorf("invalid SHA-256 digest in %s", path)
+	}
+
+	return digest, nil
+}
+
+func verifySignature(key crypto.PublicKey, digest, signature []byte) error {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if rsa.VerifyPKCS1v15(k, crypto.SHA256, digest, signature) != nil {
+			return ErrInvalidSignature
+		}
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, digest, signature) {
+			return ErrInvalidSignature
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+	return nil
+}
+
+// Verify verifies the artifact. If key is nil, the digest of the artifact
+// must match its digest file. Otherwise the signature file must be a valid
+// signature of the digest of the artifact, and the digest file is optional.
+func Verify(artifact Artifact, key crypto.PublicKey) Result {
+	result := Result{Artifact: artifact}
+
+	if err := verify(&result, key); err != nil {
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// verify verifies the artifact from the file opened once, and checks that
+// its path still names that file once verified, as the hypervisor opens
+// the path.
+func verify(result *Result, key crypto.PublicKey) error {
+	f, err := os.Open(result.Path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	digest, id, err := fileDigest(result.Path, f)
+	if err != nil {
+		return err
+	}
+	result.Digest = hex.EncodeToString(digest)
+
+	if err := verifyDigest(result, digest, key); err != nil {
+		return err
+	}
+
+	var st unix.Stat_t
+	if err := unix.Stat(result.Path, &st); err != nil {
+		return err
+	}
+	if statFileID(&st) != id {
+		result.DigestVerified = false
+		result.SignatureVerified = false
+		return fmt.Errorf("%s: %w", result.Path, ErrReplaced)
+	}
+
+	return nil
+}
+
+// verifyDigest verifies the digest of the artifact against its digest and
+// signature files.
+func verifyDigest(result *Result, digest []byte, key crypto.PublicKey) error {
+	expected, err := readDigestFile(result.Path + DigestSuffix)
+	switch {
+	case err == nil:
+		if hex.EncodeToString(expected) != result.Digest {
+			return fmt.Errorf("%w: %s is %s, expected %x", ErrDigestMismatch, result.Path, result.Digest, expected)
+		}
+		result.DigestVerified = true
+	case key == nil || !os.IsNotExist(err):
+		return err
+	}
+
+	if key == nil {
+		return nil
+	}
+
+	signature, err := os.ReadFile(result.Path + SignatureSuffix)
+	if err != nil {
+		return err
+	}
+
+	if err := verifySignature(key, digest, signature); err != nil {
+		return fmt.Errorf("%s: %w", result.Path+SignatureSuffix, err)
+	}
+	result.SignatureVerified = true
+
+	return nil
+}
+
+// VerifyAll verifies the artifacts, and returns an error describing all the
+// failures, if any.
+func VerifyAll(artifacts []Artifact, key crypto.PublicKey) ([]Result, error) {
+	var results []Result
+	var failures []string
+
+	for _, artifact := range artifacts {
+		result := Verify(artifact, key)
+		if result.Error != "" {
+			failures = append(failures, fmt.Sprintf("%s: %s", artifact.Name, result.Error))
+		}
+		results = append(results, result)
+	}
+
+	if len(failures) > 0 {
+		return results, fmt.Errorf("artifacts verification failed: %s", strings.Join(failures, "; "))
+	}
+
+	return results, nil
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package artifacts
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "artifacts")
+	if err != nil {
+		panic(err)
+	}
+	digestCacheDir = dir
+
+	ret := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(ret)
+}
+
+func writePublicKey(t *testing.T, key crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "key.pem")
+	assert.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644))
+	return path
+}
+
+func writeArtifact(t *testing.T, content string) (string, []byte) {
+	path := filepath.Join(t.TempDir(), "vmlinux")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+	digest := sha256.Sum256([]byte(content))
+	return path, digest[:]
+}
+
+func TestLoadPublicKey(t *testing.T) {
+	assert := assert.New(t)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(err)
+	key, err := LoadPublicKey(writePublicKey(t, &ecKey.PublicKey))
+	assert.NoError(err)
+	assert.IsType(&ecdsa.PublicKey{}, key)
+
+	_, err = LoadPublicKey(filepath.Join(t.TempDir(), "missing.pem"))
+	assert.Error(err)
+
+	notPEM := filepath.Join(t.TempDir(), "key.pem")
+	assert.NoError(os.WriteFile(notPEM, []byte("not a key"), 0644))
+	_, err = LoadPublicKey(notPEM)
+	assert.Error(err)
+}
+
+func TestVerifyDigest(t *testing.T) {
+	assert := assert.New(t)
+
+	path, digest := writeArtifact(t, "kernel")
+	artifact := Artifact{Name: "kernel", Path: path}
+
+	// no digest file
+	result := Verify(artifact, nil)
+	assert.NotEmpty(result.Error)
+	assert.False(result.DigestVerified)
+
+	assert.NoError(os.WriteFile(path+DigestSuffix, []byte(hex.EncodeToString(digest)+"  vmlinux\n"), 0644))
+	result = Verify(artifact, nil)
+	assert.Empty(result.Error)
+	assert.True(result.DigestVerified)
+	assert.False(result.SignatureVerified)
+	assert.Equal(hex.EncodeToString(digest), result.Digest)
+
+	// the artifact is modified
+	assert.NoError(os.WriteFile(path, []byte("modified kernel"), 0644))
+	result = Verify(artifact, nil)
+	assert.Contains(result.Error, ErrDigestMismatch.Error())
+	assert.False(result.DigestVerified)
+
+	assert.NoError(os.WriteFile(path+DigestSuffix, []byte("1234\n"), 0644))
+	result = Verify(artifact, nil)
+	assert.Contains(result.Error, "invalid SHA-256 digest")
+}
+
+func TestVerifySignature(t *testing.T) {
+	assert := assert.New(t)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(err)
+
+	for _, signer := range []crypto.Signer{rsaKey, ecKey} {
+		path, digest := writeArtifact(t, "image")
+		artifact := Artifact{Name: "image", Path: path}
+
+		key, err := LoadPublicKey(writePublicKey(t, signer.Public()))
+		assert.NoError(err)
+
+		// no signature
+		result := Verify(artifact, key)
+		assert.NotEmpty(result.Error)
+
+		signature, err := signer.Sign(rand.Reader, digest, crypto.SHA256)
+		assert.NoError(err)
+		assert.NoError(os.WriteFile(path+SignatureSuffix, signature, 0644))
+
+		// the digest file is optional
+		result = Verify(artifact, key)
+		assert.Empty(result.Error)
+		assert.True(result.SignatureVerified)
+		assert.False(result.DigestVerified)
+
+		// the artifact is modified
+		assert.NoError(os.WriteFile(path, []byte("modified image"), 0644))
+		result = Verify(artifact, key)
+		assert.Contains(result.Error, ErrInvalidSignature.Error())
+		assert.False(result.SignatureVerified)
+	}
+}
+
+func TestVerifyAll(t *testing.T) {
+	assert := assert.New(t)
+
+	kernel, digest := writeArtifact(t, "kernel")
+	assert.NoError(os.WriteFile(kernel+DigestSuffix, []byte(hex.EncodeToString(digest)), 0644))
+	initrd, _ := writeArtifact(t, "initrd")
+
+	results, err := VerifyAll([]Artifact{
+		{Name: "kernel", Path: kernel},
+		{Name: "initrd", Path: initrd},
+	}, nil)
+	assert.Error(err)
+	assert.Contains(err.Error(), "initrd")
+	assert.NotContains(err.Error(), "kernel")
+	assert.Len(results, 2)
+	assert.True(results[0].DigestVerified)
+	assert.False(results[1].DigestVerified)
+
+	results, err = VerifyAll([]Artifact{{Name: "kernel", Path: kernel}}, nil)
+	assert.NoError(err)
+	assert.Len(results, 1)
+
+	assert.True(errors.Is(verify(&Result{Artifact: Artifact{Path: filepath.Join(t.TempDir(), "missing")}}, nil), os.ErrNotExist))
+}
+
+func TestVerifyDigestCache(t *testing.T) {
+	assert := assert.New(t)
+
+	path, digest := writeArtifact(t, "image")
+	assert.NoError(os.WriteFile(path+DigestSuffix, []byte(hex.EncodeToString(digest)), 0644))
+	artifact := Artifact{Name: "image", Path: path}
+
+	// the digest of a file changed right before is not cached
+	result := Verify(artifact, nil)
+	assert.Empty(result.Error)
+	assert.NoFileExists(digestCachePath(path))
+
+	old := time.Now().Add(-time.Minute)
+	assert.NoError(os.Chtimes(path, old, old))
+	time.Sleep(digestCacheMinAge)
+	result = Verify(artifact, nil)
+	assert.Empty(result.Error)
+	assert.FileExists(digestCachePath(path))
+
+	// the cached digest is used while the file is not changed
+	var entry cachedDigest
+	data, err := os.ReadFile(digestCachePath(path))
+	assert.NoError(err)
+	assert.NoError(json.Unmarshal(data, &entry))
+	assert.Equal(hex.EncodeToString(digest), entry.Digest)
+	entry.Digest = hex.EncodeToString(make([]byte, sha256.Size))
+	data, err = json.Marshal(entry)
+	assert.NoError(err)
+	assert.NoError(os.WriteFile(digestCachePath(path), data, 0600))
+	result = Verify(artifact, nil)
+	assert.Contains(result.Error, ErrDigestMismatch.Error())
+
+	// and not once the file is changed
+	sum := sha256.Sum256([]byte("IMAGE"))
+	assert.NoError(os.WriteFile(path, []byte("IMAGE"), 0644))
+	assert.NoError(os.WriteFile(path+DigestSuffix, []byte(hex.EncodeToString(sum[:])), 0644))
+	assert.NoError(os.Chtimes(path, old, old))
+	result = Verify(artifact, nil)
+	assert.Empty(result.Error)
+	assert.True(result.DigestVerified)
+}
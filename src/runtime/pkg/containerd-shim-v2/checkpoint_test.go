@@ -0,0 +1,82 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"context"
+	"testing"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/namespaces"
+	runcoptions "github.com/containerd/containerd/runtime/v2/runc/options"
+	taskAPI "github.com/containerd/containerd/runtime/v2/task"
+	"github.com/containerd/typeurl"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/vcmock"
+)
+
+func TestCheckpointContainer(t *testing.T) {
+	assert := assert.New(t)
+
+	var checkpointed string
+	sandbox := &vcmock.Sandbox{
+		MockID: testSandboxID,
+		CheckpointFunc: func(dir string) error {
+			checkpointed = dir
+			return nil
+		},
+	}
+
+	s := &service{
+		id:         testSandboxID,
+		sandbox:    sandbox,
+		containers: make(map[string]*container),
+	}
+
+	var err error
+	s.containers[testContainerID], err = newContainer(s, &taskAPI.CreateTaskRequest{ID: testContainerID}, "", nil, true)
+	assert.NoError(err)
+
+	ctx := namespaces.WithNamespace(context.Background(), "UnitTest")
+	dir := t.TempDir()
+
+	// unknown container
+	_, err = s.Checkpoint(ctx, &taskAPI.CheckpointTaskRequest{ID: "unknown", Path: dir})
+	assert.Error(err)
+	assert.Empty(checkpointed)
+
+	opts, err := typeurl.MarshalAny(&runcoptions.CheckpointOptions{Exit: true})
+	assert.NoError(err)
+	_, err = s.Checkpoint(ctx, &taskAPI.CheckpointTaskRequest{ID: testContainerID, Path: dir, Options: opts})
+	assert.True(errdefs.IsNotImplemented(errdefs.FromGRPC(err)))
+	assert.Empty(checkpointed)
+
+	// a checkpoint is in progress
+	s.checkpointing = true
+	_, err = s.Checkpoint(ctx, &taskAPI.CheckpointTaskRequest{ID: testContainerID, Path: dir})
+	assert.True(errdefs.IsUnavailable(errdefs.FromGRPC(err)))
+	assert.Empty(checkpointed)
+	s.checkpointing = false
+
+	_, err = s.Checkpoint(ctx, &taskAPI.CheckpointTaskRequest{ID: testContainerID, Path: dir})
+	assert.NoError(err)
+	assert.Equal(dir, checkpointed)
+	assert.False(s.checkpointing)
+}
+
+func TestCreateFromCheckpoint(t *testing.T) {
+	s := &service{
+		id:         testSandboxID,
+		containers: make(map[string]*container),
+	}
+
+	_, err := create(context.Background(), s, &taskAPI.CreateTaskRequest{
+		ID:         testContainerID,
+		Checkpoint: t.TempDir(),
+	})
+	assert.True(t, errdefs.IsNotImplemented(errdefs.FromGRPC(err)))
+}
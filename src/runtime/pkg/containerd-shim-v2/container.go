@@ -26,17 +26,22 @@ type container struct {
 	exitIOch    chan struct{}
 	stdinPipe   io.WriteCloser
 	stdinCloser chan struct{}
-	exitCh      chan uint32
+	exited      chan struct{}
 	id          string
 	stdin       string
 	stdout      string
 	stderr      string
 	bundle      string
+	upperDir    string
 	cType       vc.ContainerType
 	exit        uint32
 	status      task.Status
 	terminal    bool
 	mounted     bool
+
+	// closed once the crash diagnostics of the container are collected
+	// and the container stopped, nil if none are collected
+	diagnosed chan struct{}
 }
 
 func newContainer(s *service, r *taskAPI.CreateTaskRequest, containerType vc.ContainerType, spec *specs.Spec, mounted bool) (*container, error) {
@@ -54,6 +59,7 @@ func newContainer(s *service, r *taskAPI.CreateTaskRequest, containerType vc.Con
 		spec:        spec,
 		id:          r.ID,
 		bundle:      r.Bundle,
+		upperDir:    overlayUpperDir(r.Rootfs),
 		stdin:       r.Stdin,
 		stdout:      r.Stdout,
 		stderr:      r.Stderr,
@@ -62,9 +68,24 @@ func newContainer(s *service, r *taskAPI.CreateTaskRequest, containerType vc.Con
 		execs:       make(map[string]*exec),
 		status:      task.StatusCreated,
 		exitIOch:    make(chan struct{}),
-		exitCh:      make(chan uint32, 1),
+		exited:      make(chan struct{}),
 		stdinCloser: make(chan struct{}),
 		mounted:     mounted,
 	}
 	return c, nil
 }
+
+// setExit records the exit of the init process of the container, unblocking
+// its waiters. It is called with the service lock held, the first exit
+// recorded being kept.
+func (c *container) setExit(status uint32, exitTime time.Time) {
+	select {
+	case <-c.exited:
+		return
+	default:
+	}
+
+	c.exit = status
+	c.exitTime = exitTime
+	close(c.exited)
+}
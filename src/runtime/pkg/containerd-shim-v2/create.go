@@ -19,6 +19,7 @@ import (
 	"syscall"
 
 	containerd_types "github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/mount"
 	taskAPI "github.com/containerd/containerd/runtime/v2/task"
 	"github.com/containerd/typeurl"
@@ -56,6 +57,14 @@ func create(ctx context.Context, s *service, r *taskAPI.CreateTaskRequest) (*con
 		rootFs.Options = m.Options
 	}
 
+	// Checkpoints are saved for inspection only: the snapshot of a VM holds
+	// the guest state of its sandbox, e.g. its network configuration and
+	// the containers started by the agent, and cannot be restored in the
+	// sandbox of another one.
+	if r.Checkpoint != "" {
+		return nil, errdefs.ToGRPCf(errdefs.ErrNotImplemented, "restoring a container from a checkpoint, kata checkpoints can only be inspected")
+	}
+
 	detach := !r.Terminal
 	ociSpec, bundlePath, err := loadSpec(r)
 
@@ -86,18 +95,13 @@ func create(ctx context.Context, s *service, r *taskAPI.CreateTaskRequest) (*con
 		// create tracer
 		// This is the earliest location we can create the tracer because we must wait
 		// until the runtime config is loaded
-		jaegerConfig := &katatrace.JaegerConfig{
-			JaegerEndpoint: s.config.JaegerEndpoint,
-			JaegerUser:     s.config.JaegerUser,
-			JaegerPassword: s.config.JaegerPassword,
-		}
-		_, err = katatrace.CreateTracer("kata", jaegerConfig)
+		_, err = katatrace.CreateTracer("kata", tracerConfig(s.config))
 		if err != nil {
 			return nil, err
 		}
 
 		// create root span
-		rootSpan, newCtx := katatrace.Trace(s.ctx, shimLog, "root span", shimTracingTags)
+		rootSpan, newCtx := katatrace.Trace(katatrace.RequestContext(ctx, s.ctx), shimLog, "root span", shimTracingTags)
 		s.rootCtx = newCtx
 		defer rootSpan.End()
 
@@ -144,8 +148,13 @@ func create(ctx context.Context, s *service, r *taskAPI.CreateTaskRequest) (*con
 		// ctx will be canceled after this rpc service call, but the sandbox will live
 		// across multiple rpc service calls.
 		//
-		sandbox, _, err := katautils.CreateSandbox(s.ctx, vci, *ociSpec, *s.config, rootFs, r.ID, bundlePath, "", disableOutput, false)
+		s.recordEvent(SandboxCreateEvent, map[string]string{
+			"hypervisor": string(s.config.HypervisorType),
+			"bundle":     bundlePath,
+		})
+		sandbox, _, err := katautils.CreateSandbox(vc.WithLifecycleEventHandler(s.ctx, s.sendVMEvent), vci, *ociSpec, *s.config, rootFs, r.ID, bundlePath, "", disableOutput, false)
 		if err != nil {
+			s.recordEvent(SandboxCreateFailedEvent, map[string]string{"error": err.Error()})
 			return nil, err
 		}
 		s.sandbox = sandbox
@@ -160,7 +169,7 @@ func create(ctx context.Context, s *service, r *taskAPI.CreateTaskRequest) (*con
 		}
 
 	case vc.PodContainer:
-		span, ctx := katatrace.Trace(s.ctx, shimLog, "create", shimTracingTags)
+		span, ctx := katatrace.Trace(katatrace.RequestContext(ctx, s.ctx), shimLog, "create", shimTracingTags)
 		defer span.End()
 
 		if s.sandbox == nil {
@@ -193,6 +202,24 @@ func create(ctx context.Context, s *service, r *taskAPI.CreateTaskRequest) (*con
 	return container, nil
 }
 
+// tracerConfig returns the configuration of the exporter of the traces
+func tracerConfig(config *oci.RuntimeConfig) *katatrace.TracerConfig {
+	return &katatrace.TracerConfig{
+		Exporter: config.TracingExporter,
+		Jaeger: katatrace.JaegerConfig{
+			JaegerEndpoint: config.JaegerEndpoint,
+			JaegerUser:     config.JaegerUser,
+			JaegerPassword: config.JaegerPassword,
+		},
+		OTLP: katatrace.OTLPConfig{
+			Endpoint: config.OTLPEndpoint,
+			Protocol: config.OTLPProtocol,
+			Headers:  config.OTLPHeaders,
+			CACert:   config.OTLPCACert,
+		},
+	}
+}
+
 func loadSpec(r *taskAPI.CreateTaskRequest) (*specs.Spec, string, error) {
 	// Checks the MUST and MUST NOT from OCI runtime specification
 	bundlePath, err := validBundle(r.ID, r.Bundle)
@@ -245,7 +272,7 @@ func loadRuntimeConfig(s *service, r *taskAPI.CreateTaskRequest, anno map[string
 		configPath = os.Getenv("KATA_CONF_FILE")
 	}
 
-	_, runtimeConfig, err := katautils.LoadConfiguration(configPath, false)
+	resolvedConfigPath, runtimeConfig, err := katautils.LoadConfiguration(configPath, false)
 	if err != nil {
 		return nil, err
 	}
@@ -253,6 +280,7 @@ func loadRuntimeConfig(s *service, r *taskAPI.CreateTaskRequest, anno map[string
 	// For the unit test, the config will be predefined
 	if s.config == nil {
 		s.config = &runtimeConfig
+		s.configPath = resolvedConfigPath
 	}
 
 	return &runtimeConfig, nil
@@ -0,0 +1,129 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
+)
+
+const (
+	DebugStateUrl = "/debug/state"
+
+	// debugStateLockTimeout is how long the state dump waits for the task
+	// API calls in progress, after which the sandbox is left out of it.
+	debugStateLockTimeout = 2 * time.Second
+)
+
+// pendingOperation is a task API call in progress.
+type pendingOperation struct {
+	Name        string    `json:"name"`
+	ContainerID string    `json:"container_id"`
+	Start       time.Time `json:"start"`
+}
+
+// pendingOperations tracks the task API calls in progress.
+type pendingOperations struct {
+	sync.Mutex
+	ops  map[uint64]pendingOperation
+	next uint64
+}
+
+// track records the call in progress, until the returned function is called.
+func (p *pendingOperations) track(name, containerID string) func() {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.ops == nil {
+		p.ops = make(map[uint64]pendingOperation)
+	}
+
+	id := p.next
+	p.next++
+	p.ops[id] = pendingOperation{
+		Name:        name,
+		ContainerID: containerID,
+		Start:       time.Now(),
+	}
+
+	return func() {
+		p.Lock()
+		defer p.Unlock()
+		delete(p.ops, id)
+	}
+}
+
+// list returns the calls in progress, the oldest first.
+func (p *pendingOperations) list() []pendingOperation {
+	p.Lock()
+	defer p.Unlock()
+
+	ops := make([]pendingOperation, 0, len(p.ops))
+	for _, op := range p.ops {
+		ops = append(ops, op)
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].Start.Before(ops[j].Start)
+	})
+
+	return ops
+}
+
+// debugState is the state dump of the shim.
+type debugState struct {
+	// Sandbox is nil when the task API calls in progress do not complete
+	Sandbox           *vc.SandboxDebugState `json:"sandbox,omitempty"`
+	PendingOperations []pendingOperation    `json:"pending_operations"`
+}
+
+// lockTimeout locks the mutex unless it does not get it within the timeout,
+// in which case it is unlocked once it gets it.
+func lockTimeout(mu *sync.Mutex, timeout time.Duration) bool {
+	locked := make(chan struct{})
+	go func() {
+		mu.Lock()
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		return true
+	case <-time.After(timeout):
+		go func() {
+			<-locked
+			mu.Unlock()
+		}()
+		return false
+	}
+}
+
+// serveDebugState returns the JSON dump of the sandbox state and of the task
+// API calls in progress.
+func (s *service) serveDebugState(w http.ResponseWriter, r *http.Request) {
+	state := debugState{
+		PendingOperations: s.pendingOps.list(),
+	}
+
+	if lockTimeout(&s.mu, debugStateLockTimeout) {
+		if s.sandbox != nil {
+			sandboxState := s.sandbox.DebugState()
+			state.Sandbox = &sandboxState
+		}
+		s.mu.Unlock()
+	} else {
+		shimMgtLog.Warn("task API calls in progress, the sandbox state is not dumped")
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(state); err != nil {
+		shimMgtLog.WithError(err).Error("failed to encode the debug state")
+	}
+}
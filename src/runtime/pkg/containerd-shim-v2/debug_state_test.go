@@ -0,0 +1,79 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/vcmock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPendingOperations(t *testing.T) {
+	assert := assert.New(t)
+
+	var p pendingOperations
+	assert.Empty(p.list())
+
+	doneStart := p.track("start", "c1")
+	doneWait := p.track("wait", "c2")
+
+	ops := p.list()
+	assert.Len(ops, 2)
+	assert.Equal("start", ops[0].Name)
+	assert.Equal("c1", ops[0].ContainerID)
+	assert.Equal("wait", ops[1].Name)
+
+	doneStart()
+	ops = p.list()
+	assert.Len(ops, 1)
+	assert.Equal("wait", ops[0].Name)
+
+	doneWait()
+	assert.Empty(p.list())
+}
+
+func TestServeDebugState(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &service{
+		id: testSandboxID,
+		sandbox: &vcmock.Sandbox{
+			MockID: testSandboxID,
+		},
+		containers: make(map[string]*container),
+	}
+	defer s.pendingOps.track("start", testContainerID)()
+
+	rr := httptest.NewRecorder()
+	s.serveDebugState(rr, &http.Request{})
+	assert.Equal(http.StatusOK, rr.Code)
+
+	var state debugState
+	assert.NoError(json.Unmarshal(rr.Body.Bytes(), &state))
+	assert.NotNil(state.Sandbox)
+	assert.Len(state.PendingOperations, 1)
+	assert.Equal(testContainerID, state.PendingOperations[0].ContainerID)
+}
+
+func TestLockTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	var s service
+
+	assert.True(lockTimeout(&s.mu, time.Second))
+	// held, the lock is taken once released
+	assert.False(lockTimeout(&s.mu, 10*time.Millisecond))
+	s.mu.Unlock()
+
+	assert.True(lockTimeout(&s.mu, time.Second))
+	s.mu.Unlock()
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// crashDiagnosticsTimeout bounds the collection of the diagnostics of a
+// crashed container, which delays its deletion.
+const crashDiagnosticsTimeout = 2 * time.Minute
+
+// coreDumpSignals are the signals whose default action is to dump core.
+var coreDumpSignals = map[syscall.Signal]bool{
+	syscall.SIGABRT: true,
+	syscall.SIGBUS:  true,
+	syscall.SIGFPE:  true,
+	syscall.SIGILL:  true,
+	syscall.SIGQUIT: true,
+	syscall.SIGSEGV: true,
+	syscall.SIGSYS:  true,
+	syscall.SIGTRAP: true,
+	syscall.SIGXCPU: true,
+	syscall.SIGXFSZ: true,
+}
+
+// dumpedCore returns if an exit code reports a process killed by a signal
+// dumping core, the agent reporting them as 128 plus the signal number.
+func dumpedCore(exitCode int32) bool {
+	return exitCode > 128 && coreDumpSignals[syscall.Signal(exitCode-128)]
+}
+
+// collectCrashDiagnostics writes the diagnostics of a crashed container to
+// the crash diagnostics directory. Failures are only logged, not to change
+// how the container exit is handled.
+func collectCrashDiagnostics(ctx context.Context, s *service, containerID string) {
+	logger := shimLog.WithFields(logrus.Fields{
+		"sandbox":   s.id,
+		"container": containerID,
+	})
+
+	dir := s.config.CrashDiagnosticsDir
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		logger.WithError(err).Error("Could not create the crash diagnostics directory")
+		return
+	}
+
+	// The diagnostics are not written through links
+	info, err := os.Lstat(dir)
+	if err != nil {
+		logger.WithError(err).Error("Could not check the crash diagnostics directory")
+		return
+	}
+	if !info.IsDir() {
+		logger.Errorf("The crash diagnostics directory %s is not a directory", dir)
+		return
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s-%d.tar.gz", s.id, containerID, time.Now().Unix()))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY|syscall.O_NOFOLLOW, 0600)
+	if err != nil {
+		logger.WithError(err).Error("Could not create the crash diagnostics")
+		return
+	}
+	defer f.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, crashDiagnosticsTimeout)
+	defer cancel()
+
+	if err := s.sandbox.CollectDiagnostics(ctx, containerID, f); err != nil {
+		logger.WithError(err).Error("Could not collect the crash diagnostics")
+		os.Remove(path)
+		return
+	}
+
+	logger.WithField("path", path).Info("Wrote the crash diagnostics")
+}
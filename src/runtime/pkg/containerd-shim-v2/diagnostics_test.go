@@ -0,0 +1,62 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/oci"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/vcmock"
+)
+
+func TestDumpedCore(t *testing.T) {
+	assert := assert.New(t)
+
+	// SIGSEGV
+	assert.True(dumpedCore(139))
+	// SIGABRT
+	assert.True(dumpedCore(134))
+	// SIGKILL
+	assert.False(dumpedCore(137))
+	// SIGTERM
+	assert.False(dumpedCore(143))
+	assert.False(dumpedCore(0))
+	assert.False(dumpedCore(1))
+}
+
+func TestCollectCrashDiagnostics(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpdir := t.TempDir()
+	dir := filepath.Join(tmpdir, "diagnostics")
+	s := &service{
+		id:      testSandboxID,
+		sandbox: &vcmock.Sandbox{MockID: testSandboxID},
+		config:  &oci.RuntimeConfig{CrashDiagnosticsDir: dir},
+	}
+
+	collectCrashDiagnostics(context.Background(), s, testContainerID)
+	files, err := filepath.Glob(filepath.Join(dir, testSandboxID+"-"+testContainerID+"-*.tar.gz"))
+	assert.NoError(err)
+	assert.Len(files, 1)
+
+	// The diagnostics are not written through a link
+	target := filepath.Join(tmpdir, "target")
+	assert.NoError(os.Mkdir(target, 0700))
+	link := filepath.Join(tmpdir, "link")
+	assert.NoError(os.Symlink(target, link))
+
+	s.config.CrashDiagnosticsDir = link
+	collectCrashDiagnostics(context.Background(), s, testContainerID)
+	entries, err := os.ReadDir(target)
+	assert.NoError(err)
+	assert.Empty(entries)
+}
@@ -6,16 +6,34 @@
 package containerdshim
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 	"syscall"
 
 	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
 )
 
+// errorInfoDomain is the domain of the reasons of the ErrorInfo details of
+// the classified errors.
+const errorInfoDomain = "katacontainers.io"
+
+// errorCategoryCodes are the grpc codes of the error categories.
+var errorCategoryCodes = map[vc.ErrorCategory]codes.Code{
+	vc.ErrorInvalidArgument:    codes.InvalidArgument,
+	vc.ErrorNotFound:           codes.NotFound,
+	vc.ErrorFailedPrecondition: codes.FailedPrecondition,
+	vc.ErrorResourceExhausted:  codes.ResourceExhausted,
+	vc.ErrorUnavailable:        codes.Unavailable,
+	vc.ErrorTimeout:            codes.DeadlineExceeded,
+	vc.ErrorInternal:           codes.Internal,
+}
+
 // toGRPC maps the virtcontainers error into a grpc error,
 // using the original error message as a description.
 func toGRPC(err error) error {
@@ -28,15 +46,20 @@ func toGRPC(err error) error {
 		return err
 	}
 
-	err = errors.Cause(err)
+	cause := errors.Cause(err)
 	switch {
-	case isInvalidArgument(err):
-		return status.Errorf(codes.InvalidArgument, err.Error())
-	case isNotFound(err):
-		return status.Errorf(codes.NotFound, err.Error())
+	case isInvalidArgument(cause):
+		return status.Errorf(codes.InvalidArgument, cause.Error())
+	case isNotFound(cause):
+		return status.Errorf(codes.NotFound, cause.Error())
+	}
+
+	var classified *vc.Error
+	if errors.As(err, &classified) {
+		return classifiedToGRPC(err, classified)
 	}
 
-	return err
+	return cause
 }
 
 // toGRPCf maps the error to grpc error codes, assembling the formatting string
@@ -45,6 +68,38 @@ func toGRPCf(err error, format string, args ...interface{}) error {
 	return toGRPC(errors.Wrapf(err, format, args...))
 }
 
+// classifiedToGRPC maps a classified error to the grpc code of its category.
+// Its subsystem and category prefix the message, and are detailed in a
+// google.rpc.ErrorInfo along with whether the request is retriable, for the
+// callers to tell the failures apart rather than from a timeout of their own.
+func classifiedToGRPC(err error, classified *vc.Error) error {
+	code, ok := errorCategoryCodes[classified.Category]
+	if !ok {
+		code = codes.Unknown
+	}
+
+	st := status.New(code, fmt.Sprintf("%s %s: %v", classified.Subsystem, classified.Category, err))
+	if detailed, err := st.WithDetails(errorInfo(classified)); err == nil {
+		st = detailed
+	}
+
+	return st.Err()
+}
+
+// errorInfo returns the google.rpc.ErrorInfo detail of a classified error,
+// whose reason is its category.
+func errorInfo(classified *vc.Error) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{
+		Reason: strings.ToUpper(strings.ReplaceAll(string(classified.Category), "-", "_")),
+		Domain: errorInfoDomain,
+		Metadata: map[string]string{
+			"category":  string(classified.Category),
+			"subsystem": classified.Subsystem,
+			"retriable": strconv.FormatBool(classified.Retriable),
+		},
+	}
+}
+
 func isGRPCError(err error) bool {
 	_, ok := status.FromError(err)
 	return ok
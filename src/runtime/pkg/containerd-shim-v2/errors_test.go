@@ -6,12 +6,15 @@
 package containerdshim
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"syscall"
 	"testing"
 
 	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -38,3 +41,71 @@ func TestIsGRPCErrorCode(t *testing.T) {
 	assert.True(isGRPCErrorCode(codes.NotFound, status.New(codes.NotFound, "foobar").Err()))
 	assert.False(isGRPCErrorCode(codes.Unimplemented, errors.New("foobar")))
 }
+
+func TestToGRPCClassified(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, tc := range []struct {
+		err       error
+		code      codes.Code
+		message   string
+		reason    string
+		retriable string
+		subsystem string
+	}{
+		{
+			err:       vc.NewError(vc.ErrorInternal, vc.SubsystemHypervisor, false, errors.New("qemu exited")),
+			code:      codes.Internal,
+			message:   "hypervisor internal: creating sandbox: qemu exited",
+			reason:    "INTERNAL",
+			retriable: "false",
+			subsystem: vc.SubsystemHypervisor,
+		},
+		{
+			err:       vc.NewError(vc.ErrorUnavailable, vc.SubsystemAgent, true, fmt.Errorf("check: %w", context.DeadlineExceeded)),
+			code:      codes.DeadlineExceeded,
+			message:   "agent timeout: creating sandbox: check: context deadline exceeded",
+			reason:    "TIMEOUT",
+			retriable: "true",
+			subsystem: vc.SubsystemAgent,
+		},
+		{
+			err:       vc.NewError(vc.ErrorResourceExhausted, vc.SubsystemResources, false, errors.New("no memory")),
+			code:      codes.ResourceExhausted,
+			message:   "resources resource-exhausted: creating sandbox: no memory",
+			reason:    "RESOURCE_EXHAUSTED",
+			retriable: "false",
+			subsystem: vc.SubsystemResources,
+		},
+	} {
+		err := toGRPCf(tc.err, "creating sandbox")
+
+		st, ok := status.FromError(err)
+		assert.True(ok)
+		assert.Equal(tc.code, st.Code())
+		assert.Equal(tc.message, st.Message())
+
+		details := st.Details()
+		assert.Len(details, 1)
+		info, ok := details[0].(*errdetails.ErrorInfo)
+		assert.True(ok)
+		assert.Equal(tc.reason, info.Reason)
+		assert.Equal(errorInfoDomain, info.Domain)
+		assert.Equal(tc.retriable, info.Metadata["retriable"])
+		assert.Equal(tc.subsystem, info.Metadata["subsystem"])
+
+		// already mapped
+		assert.Equal(err, toGRPC(err))
+	}
+}
+
+func TestToGRPCClassifiedNotFound(t *testing.T) {
+	assert := assert.New(t)
+
+	// the not found errors keep their code
+	err := toGRPC(vc.NewError(vc.ErrorInternal, vc.SubsystemAgent, false, vc.ErrNoSuchContainer))
+
+	st, ok := status.FromError(err)
+	assert.True(ok)
+	assert.Equal(codes.NotFound, st.Code())
+}
@@ -10,7 +10,11 @@ import (
 	"os"
 	"time"
 
-	"github.com/containerd/containerd/events"
+	v1 "github.com/containerd/containerd/api/services/ttrpc/events/v1"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/pkg/dialer"
+	"github.com/containerd/ttrpc"
+	"github.com/containerd/typeurl"
 )
 
 type forwarderType string
@@ -26,6 +30,21 @@ const (
 	// ttrpc address passed from container runtime.
 	// For now containerd will pass the address, and CRI-O will not
 	ttrpcAddressEnv = "TTRPC_ADDRESS"
+
+	// The events not published yet to containerd, while it is restarting,
+	// are kept up to this number, the oldest ones being dropped beyond.
+	maxPendingEvents = 4096
+
+	// An event containerd fails to accept this number of times, while
+	// connected to it, is dropped not to hold back the next ones.
+	maxPublishAttempts = 5
+)
+
+var (
+	// The delays between the attempts to publish an event to containerd,
+	// doubled after each failure up to the max.
+	publishRetryDelay    = 500 * time.Millisecond
+	maxPublishRetryDelay = 10 * time.Second
 )
 
 type eventsForwarder interface {
@@ -47,28 +66,159 @@ func (lf *logForwarder) forwarderType() forwarderType {
 	return forwarderTypeLog
 }
 
+// containerdForwarder publishes the events to containerd over ttrpc. The
+// connection is established again when lost, for instance when containerd
+// restarts, the events not published meanwhile being kept and published in
+// order once reconnected, while the VM keeps running.
 type containerdForwarder struct {
-	s         *service
-	ctx       context.Context
-	publisher events.Publisher
+	s      *service
+	ctx    context.Context
+	client *ttrpc.Client
+	// dial connects to containerd, resolving its address on each connection
+	dial    func(ctx context.Context) (*ttrpc.Client, error)
+	pending []*v1.ForwardRequest
+	// the failed attempts to publish the oldest pending event
+	attempts int
+}
+
+func dialContainerd(ctx context.Context) (*ttrpc.Client, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeOut)
+	defer cancel()
+
+	conn, err := dialer.ContextDialer(ctx, os.Getenv(ttrpcAddressEnv))
+	if err != nil {
+		return nil, err
+	}
+
+	return ttrpc.NewClient(conn), nil
 }
 
 func (cf *containerdForwarder) forward() {
-	for e := range cf.s.events {
+	var (
+		retry        <-chan time.Time
+		delay        time.Duration
+		disconnected bool
+	)
+
+	events := cf.s.events
+	for events != nil || len(cf.pending) > 0 {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			req, err := cf.newRequest(e)
+			if err != nil {
+				shimLog.WithError(err).WithField("topic", getTopic(e)).Error("post event")
+				continue
+			}
+			cf.queue(req)
+			if retry != nil {
+				continue
+			}
+		case <-retry:
+			retry = nil
+		}
+
+		if err := cf.publishPending(); err != nil {
+			if !disconnected {
+				shimLog.WithError(err).Warn("failed to post events to containerd, retrying")
+				disconnected = true
+				delay = publishRetryDelay
+			} else if delay *= 2; delay > maxPublishRetryDelay {
+				delay = maxPublishRetryDelay
+			}
+			retry = time.After(delay)
+			continue
+		}
+
+		if disconnected {
+			shimLog.Info("events posted to containerd again")
+			disconnected = false
+		}
+	}
+
+	if cf.client != nil {
+		cf.client.Close()
+	}
+}
+
+func (cf *containerdForwarder) newRequest(e interface{}) (*v1.ForwardRequest, error) {
+	ns, err := namespaces.NamespaceRequired(cf.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	evt, err := typeurl.MarshalAny(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ForwardRequest{
+		Envelope: &v1.Envelope{
+			Timestamp: time.Now(),
+			Namespace: ns,
+			Topic:     getTopic(e),
+			Event:     evt,
+		},
+	}, nil
+}
+
+func (cf *containerdForwarder) queue(req *v1.ForwardRequest) {
+	if len(cf.pending) >= maxPendingEvents {
+		shimLog.WithField("topic", cf.pending[0].Envelope.Topic).Warn("too many events not posted, dropping the oldest")
+		cf.dropOldest()
+	}
+	cf.pending = append(cf.pending, req)
+}
+
+func (cf *containerdForwarder) dropOldest() {
+	cf.pending[0] = nil
+	cf.pending = cf.pending[1:]
+	cf.attempts = 0
+}
+
+// publishPending publishes the pending events in order, stopping at the first
+// failure, the connection being established again on the next attempt. An
+// event failing to be published maxPublishAttempts times once connected is
+// logged and dropped.
+func (cf *containerdForwarder) publishPending() error {
+	for len(cf.pending) > 0 {
+		if cf.client == nil {
+			client, err := cf.dial(cf.ctx)
+			if err != nil {
+				return err
+			}
+			cf.client = client
+		}
+
 		ctx, cancel := context.WithTimeout(cf.ctx, timeOut)
-		err := cf.publisher.Publish(ctx, getTopic(e), e)
+		_, err := v1.NewEventsClient(cf.client).Forward(ctx, cf.pending[0])
 		cancel()
 		if err != nil {
-			shimLog.WithError(err).Error("post event")
+			cf.client.Close()
+			cf.client = nil
+
+			if cf.attempts++; cf.attempts < maxPublishAttempts {
+				return err
+			}
+			shimLog.WithError(err).WithField("topic", cf.pending[0].Envelope.Topic).Error("failed to post event, dropping it")
+			cf.dropOldest()
+			continue
 		}
+
+		cf.dropOldest()
 	}
+
+	return nil
 }
 
 func (cf *containerdForwarder) forwarderType() forwarderType {
 	return forwarderTypeContainerd
 }
 
-func (s *service) newEventsForwarder(ctx context.Context, publisher events.Publisher) eventsForwarder {
+func (s *service) newEventsForwarder(ctx context.Context) eventsForwarder {
 	var forwarder eventsForwarder
 	ttrpcAddress := os.Getenv(ttrpcAddressEnv)
 	if ttrpcAddress == "" {
@@ -78,9 +228,9 @@ func (s *service) newEventsForwarder(ctx context.Context, publisher events.Publi
 		}
 	} else {
 		forwarder = &containerdForwarder{
-			s:         s,
-			ctx:       ctx,
-			publisher: publisher,
+			s:    s,
+			ctx:  ctx,
+			dial: dialContainerd,
 		}
 	}
 
@@ -7,10 +7,21 @@ package containerdshim
 
 import (
 	"context"
+	"errors"
+	"net"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
-	"github.com/containerd/containerd/events"
+	eventstypes "github.com/containerd/containerd/api/events"
+	v1 "github.com/containerd/containerd/api/services/ttrpc/events/v1"
+	"github.com/containerd/containerd/namespaces"
+	cdruntime "github.com/containerd/containerd/runtime"
+	"github.com/containerd/ttrpc"
+	"github.com/containerd/typeurl"
+	"github.com/gogo/protobuf/types"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/vcmock"
 
 	"github.com/stretchr/testify/assert"
@@ -29,17 +40,158 @@ func TestNewEventsForwarder(t *testing.T) {
 		containers: make(map[string]*container),
 	}
 
-	// newEventsForwarder will not call publisher to publish events
-	// so here we can use a nil pointer to test newEventsForwarder
-	var publisher events.Publisher
-
 	// check log forwarder
-	forwarder := s.newEventsForwarder(context.Background(), publisher)
+	forwarder := s.newEventsForwarder(context.Background())
 	assert.Equal(forwarderTypeLog, forwarder.forwarderType())
 
 	// check containerd forwarder
 	os.Setenv(ttrpcAddressEnv, "/foo/bar.sock")
 	defer os.Setenv(ttrpcAddressEnv, "")
-	forwarder = s.newEventsForwarder(context.Background(), publisher)
+	forwarder = s.newEventsForwarder(context.Background())
 	assert.Equal(forwarderTypeContainerd, forwarder.forwarderType())
 }
+
+type eventsService struct {
+	received chan *v1.ForwardRequest
+	// the container whose events are rejected
+	rejected string
+}
+
+func (es *eventsService) Forward(ctx context.Context, req *v1.ForwardRequest) (*types.Empty, error) {
+	evt, err := typeurl.UnmarshalAny(req.Envelope.Event)
+	if err != nil {
+		return nil, err
+	}
+	if start, ok := evt.(*eventstypes.TaskStart); ok && start.ContainerID == es.rejected {
+		return nil, errors.New("event rejected")
+	}
+
+	es.received <- req
+	return &types.Empty{}, nil
+}
+
+func startEventsService(t *testing.T, address string, es *eventsService) *ttrpc.Server {
+	server, err := ttrpc.NewServer()
+	assert.NoError(t, err)
+	v1.RegisterEventsService(server, es)
+
+	l, err := net.Listen("unix", address)
+	assert.NoError(t, err)
+	go server.Serve(context.Background(), l)
+
+	return server
+}
+
+func TestContainerdForwarderReconnect(t *testing.T) {
+	assert := assert.New(t)
+
+	savedDelay := publishRetryDelay
+	publishRetryDelay = 10 * time.Millisecond
+	defer func() {
+		publishRetryDelay = savedDelay
+	}()
+
+	address := filepath.Join(t.TempDir(), "containerd.sock")
+	os.Setenv(ttrpcAddressEnv, "unix://"+address)
+	defer os.Setenv(ttrpcAddressEnv, "")
+
+	es := &eventsService{received: make(chan *v1.ForwardRequest, 10)}
+	server := startEventsService(t, address, es)
+
+	s := &service{
+		id:     testSandboxID,
+		events: make(chan interface{}, chSize),
+	}
+	ctx := namespaces.WithNamespace(context.Background(), "k8s.io")
+	forwarder := s.newEventsForwarder(ctx)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		forwarder.forward()
+	}()
+
+	receive := func(id string) {
+		select {
+		case req := <-es.received:
+			assert.Equal("k8s.io", req.Envelope.Namespace)
+			assert.Equal(cdruntime.TaskStartEventTopic, req.Envelope.Topic)
+			evt, err := typeurl.UnmarshalAny(req.Envelope.Event)
+			assert.NoError(err)
+			assert.Equal(id, evt.(*eventstypes.TaskStart).ContainerID)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("event of %s not received", id)
+		}
+	}
+
+	s.events <- &eventstypes.TaskStart{ContainerID: "foo"}
+	receive("foo")
+
+	// containerd restarts, the events are published once reconnected
+	server.Close()
+	s.events <- &eventstypes.TaskStart{ContainerID: "bar"}
+	s.events <- &eventstypes.TaskStart{ContainerID: "baz"}
+	time.Sleep(50 * time.Millisecond)
+
+	os.Remove(address)
+	server = startEventsService(t, address, es)
+	defer server.Close()
+
+	receive("bar")
+	receive("baz")
+
+	close(s.events)
+	wg.Wait()
+}
+
+func TestContainerdForwarderDropRejected(t *testing.T) {
+	assert := assert.New(t)
+
+	savedDelay := publishRetryDelay
+	publishRetryDelay = time.Millisecond
+	defer func() {
+		publishRetryDelay = savedDelay
+	}()
+
+	address := filepath.Join(t.TempDir(), "containerd.sock")
+	os.Setenv(ttrpcAddressEnv, "unix://"+address)
+	defer os.Setenv(ttrpcAddressEnv, "")
+
+	es := &eventsService{
+		received: make(chan *v1.ForwardRequest, 10),
+		rejected: "foo",
+	}
+	server := startEventsService(t, address, es)
+	defer server.Close()
+
+	s := &service{
+		id:     testSandboxID,
+		events: make(chan interface{}, chSize),
+	}
+	ctx := namespaces.WithNamespace(context.Background(), "k8s.io")
+	forwarder := s.newEventsForwarder(ctx)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		forwarder.forward()
+	}()
+
+	// the rejected event does not hold back the next one
+	s.events <- &eventstypes.TaskStart{ContainerID: "foo"}
+	s.events <- &eventstypes.TaskStart{ContainerID: "bar"}
+
+	select {
+	case req := <-es.received:
+		evt, err := typeurl.UnmarshalAny(req.Envelope.Event)
+		assert.NoError(err)
+		assert.Equal("bar", evt.(*eventstypes.TaskStart).ContainerID)
+	case <-time.After(10 * time.Second):
+		t.Fatal("event of bar not received")
+	}
+
+	close(s.events)
+	wg.Wait()
+}
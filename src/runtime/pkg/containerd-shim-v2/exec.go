@@ -32,7 +32,7 @@ type exec struct {
 	exitIOch    chan struct{}
 	stdinCloser chan struct{}
 
-	exitCh chan uint32
+	exited chan struct{}
 
 	id string
 
@@ -125,13 +125,27 @@ func newExec(c *container, stdin, stdout, stderr string, terminal bool, jspec *g
 		exitCode:    exitCode255,
 		exitIOch:    make(chan struct{}),
 		stdinCloser: make(chan struct{}),
-		exitCh:      make(chan uint32, 1),
+		exited:      make(chan struct{}),
 		status:      task.StatusCreated,
 	}
 
 	return exec, nil
 }
 
+// setExit records the exit of the exec process, unblocking its waiters. It is
+// called with the service lock held, the first exit recorded being kept.
+func (e *exec) setExit(exitCode int32, exitTime time.Time) {
+	select {
+	case <-e.exited:
+		return
+	default:
+	}
+
+	e.exitCode = exitCode
+	e.exitTime = exitTime
+	close(e.exited)
+}
+
 func (c *container) getExec(id string) (*exec, error) {
 	if c.execs == nil {
 		return nil, errdefs.ToGRPCf(errdefs.ErrNotFound, "exec does not exist %s", id)
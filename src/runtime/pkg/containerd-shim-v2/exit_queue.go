@@ -0,0 +1,123 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"sync"
+	"time"
+)
+
+// execExitTimeout is how long the exit of the init process of a container is
+// held for its execs to exit, when they are not killed with it, for instance
+// when sharing the PID namespace of the sandbox.
+var execExitTimeout = 5 * time.Second
+
+// exitQueue orders the exits of the processes published to containerd. The
+// exits are published in the order they are recorded, except for the exit of
+// the init process of a container, published after the ones of its execs
+// running when it exited.
+type exitQueue struct {
+	pending map[string]*pendingExit
+	running map[string]int
+	notify  chan struct{}
+	exits   []exit
+	mu      sync.Mutex
+}
+
+// pendingExit is the exit of the init process of a container held until its
+// execs exit.
+type pendingExit struct {
+	timer *time.Timer
+	e     exit
+}
+
+func newExitQueue() *exitQueue {
+	return &exitQueue{
+		pending: make(map[string]*pendingExit),
+		running: make(map[string]int),
+		notify:  make(chan struct{}, 1),
+	}
+}
+
+// execStarted records an exec of a container started, whose exit is pushed
+// once it exits.
+func (q *exitQueue) execStarted(containerID string) {
+	// for unit test, the service may have no exit queue
+	if q == nil {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.running[containerID]++
+}
+
+// push records the exit of a process. It does not block.
+func (q *exitQueue) push(e exit) {
+	if q == nil {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if e.execid != "" {
+		q.append(e)
+
+		if q.running[e.id]--; q.running[e.id] > 0 {
+			return
+		}
+		delete(q.running, e.id)
+
+		if p, ok := q.pending[e.id]; ok && p.timer.Stop() {
+			delete(q.pending, e.id)
+			q.append(p.e)
+		}
+		return
+	}
+
+	if q.running[e.id] == 0 {
+		q.append(e)
+		return
+	}
+
+	p := &pendingExit{e: e}
+	p.timer = time.AfterFunc(execExitTimeout, func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+
+		shimLog.WithField("container", e.id).Warn("execs still running, publishing the exit of the container")
+		delete(q.pending, e.id)
+		q.append(e)
+	})
+	q.pending[e.id] = p
+}
+
+func (q *exitQueue) append(e exit) {
+	q.exits = append(q.exits, e)
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// pop returns the next exit to publish, waiting for one if none is.
+func (q *exitQueue) pop() exit {
+	for {
+		q.mu.Lock()
+		if len(q.exits) > 0 {
+			e := q.exits[0]
+			q.exits = q.exits[1:]
+			q.mu.Unlock()
+			return e
+		}
+		q.mu.Unlock()
+
+		<-q.notify
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExitQueueOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	q := newExitQueue()
+
+	q.push(exit{id: "c1"})
+	assert.Equal(exit{id: "c1"}, q.pop())
+
+	q.execStarted("c2")
+	q.execStarted("c2")
+
+	// the exit of the container is held until its execs exit
+	q.push(exit{id: "c2", status: 1})
+	q.push(exit{id: "c2", execid: "e1", status: 2})
+	q.push(exit{id: "c3", status: 3})
+	q.push(exit{id: "c2", execid: "e2", status: 4})
+
+	assert.Equal(exit{id: "c2", execid: "e1", status: 2}, q.pop())
+	assert.Equal(exit{id: "c3", status: 3}, q.pop())
+	assert.Equal(exit{id: "c2", execid: "e2", status: 4}, q.pop())
+	assert.Equal(exit{id: "c2", status: 1}, q.pop())
+
+	assert.Empty(q.pending)
+	assert.Empty(q.running)
+}
+
+func TestExitQueueExecTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	timeout := execExitTimeout
+	execExitTimeout = 10 * time.Millisecond
+	defer func() {
+		execExitTimeout = timeout
+	}()
+
+	q := newExitQueue()
+	q.execStarted("c1")
+
+	// the exec keeps running once the container exited
+	q.push(exit{id: "c1", status: 1})
+	assert.Equal(exit{id: "c1", status: 1}, q.pop())
+
+	q.push(exit{id: "c1", execid: "e1", status: 2})
+	assert.Equal(exit{id: "c1", execid: "e1", status: 2}, q.pop())
+	assert.Empty(q.pending)
+}
+
+func TestExitQueuePopWaits(t *testing.T) {
+	q := newExitQueue()
+
+	popped := make(chan exit)
+	go func() {
+		popped <- q.pop()
+	}()
+
+	q.push(exit{id: "c1"})
+	assert.Equal(t, exit{id: "c1"}, <-popped)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/utils"
+)
+
+const (
+	journalExt = ".json"
+
+	// journalRetention is how long the journals of the sandboxes are kept
+	// after their last event.
+	journalRetention = 7 * 24 * time.Hour
+)
+
+// journalDir is where the lifecycle journals of the sandboxes are kept, out
+// of their stores for the journals to outlive the sandboxes.
+var journalDir = "/var/lib/kata-containers/journal"
+
+// The events of the lifecycle of the sandbox recorded by the shim in the
+// journal only, along with the VM events.
+const (
+	// SandboxCreateEvent is recorded when the shim starts creating the
+	// sandbox.
+	SandboxCreateEvent vc.LifecycleEventType = "sandbox-create"
+
+	// SandboxCreateFailedEvent is recorded when the sandbox cannot be
+	// created, with the error.
+	SandboxCreateFailedEvent vc.LifecycleEventType = "sandbox-create-failed"
+
+	// SandboxStartedEvent is recorded once the sandbox is started.
+	SandboxStartedEvent vc.LifecycleEventType = "sandbox-started"
+
+	// SandboxStartFailedEvent is recorded when the sandbox cannot be
+	// started, with the error.
+	SandboxStartFailedEvent vc.LifecycleEventType = "sandbox-start-failed"
+
+	// SandboxFailedEvent is recorded when the monitor of the sandbox
+	// reports it is malfunctioning, with the error.
+	SandboxFailedEvent vc.LifecycleEventType = "sandbox-failed"
+
+	// SandboxErrorEvent is recorded when the sandbox cannot be stopped or
+	// deleted, with the error.
+	SandboxErrorEvent vc.LifecycleEventType = "sandbox-error"
+
+	// SandboxStopEvent is recorded when the shim starts stopping the
+	// sandbox.
+	SandboxStopEvent vc.LifecycleEventType = "sandbox-stop"
+)
+
+// JournalPath returns the path of the lifecycle journal of the sandbox. The
+// journal is kept for journalRetention after the last event of the sandbox.
+func JournalPath(sandboxID string) string {
+	return filepath.Join(journalDir, sandboxID+journalExt)
+}
+
+// journal is the append-only lifecycle journal of a sandbox: a VMEvent per
+// line, marshaled as JSON. It is opened on the first event, as the shim also
+// runs for commands not handling a sandbox.
+type journal struct {
+	file *os.File
+	path string
+	mu   sync.Mutex
+}
+
+func newJournal(path string) *journal {
+	return &journal{path: path}
+}
+
+func (j *journal) append(e *VMEvent) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.file == nil {
+		dir := filepath.Dir(j.path)
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+		f, err := os.OpenFile(j.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+		if err != nil {
+			return err
+		}
+		j.file = f
+
+		// the journals of the sandboxes gone are removed by the next
+		// sandboxes
+		if err := utils.RemoveExpiredFiles(dir, journalExt, j.path, journalRetention); err != nil {
+			shimLog.WithError(err).Warn("failed to remove the expired sandbox journals")
+		}
+	}
+
+	// a single write per event, for the lines not to be interleaved with
+	// the ones of a shim adopting the sandbox
+	_, err = j.file.Write(line)
+	return err
+}
+
+// journalEvent records the event of the sandbox in its journal.
+func (s *service) journalEvent(e *VMEvent) {
+	if s.journal == nil {
+		return
+	}
+
+	if err := s.journal.append(e); err != nil {
+		shimLog.WithError(err).WithField("event", e.Type).Warn("failed to record the event in the sandbox journal")
+	}
+}
+
+// recordEvent records the event of the sandbox in its journal only.
+func (s *service) recordEvent(eventType vc.LifecycleEventType, attributes map[string]string) {
+	s.journalEvent(&VMEvent{
+		Timestamp:  time.Now(),
+		Attributes: attributes,
+		SandboxID:  s.id,
+		Type:       string(eventType),
+	})
+}
+
+// ReadJournal returns the events of the lifecycle journal, in the order they
+// are recorded. A truncated last line, left by a shim killed while
+// recording it, is ignored.
+func ReadJournal(path string) ([]VMEvent, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var events []VMEvent
+	var invalid error
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		if invalid != nil {
+			return nil, invalid
+		}
+
+		var e VMEvent
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			invalid = fmt.Errorf("invalid journal entry at line %d: %v", n, err)
+			continue
+		}
+		events = append(events, e)
+	}
+
+	return events, scanner.Err()
+}
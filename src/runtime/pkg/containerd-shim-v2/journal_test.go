@@ -0,0 +1,86 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
+)
+
+func TestJournalPath(t *testing.T) {
+	assert.Equal(t, "/var/lib/kata-containers/journal/"+testSandboxID+".json", JournalPath(testSandboxID))
+}
+
+func TestJournal(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), testSandboxID+journalExt)
+	s := &service{
+		id:      testSandboxID,
+		events:  make(chan interface{}, 1),
+		journal: newJournal(path),
+	}
+
+	s.recordEvent(SandboxCreateEvent, map[string]string{"hypervisor": "qemu"})
+	s.sendVMEvent(vc.LifecycleEvent{
+		Timestamp:  time.Now(),
+		Attributes: map[string]string{"pid": "1234"},
+		SandboxID:  testSandboxID,
+		Type:       vc.HypervisorStartedEvent,
+	})
+	<-s.events
+
+	// a shim adopting the sandbox appends to the journal
+	s.journal = newJournal(path)
+	s.recordEvent(SandboxStopEvent, nil)
+
+	events, err := ReadJournal(path)
+	assert.NoError(err)
+	assert.Len(events, 3)
+	assert.Equal(string(SandboxCreateEvent), events[0].Type)
+	assert.Equal("qemu", events[0].Attributes["hypervisor"])
+	assert.Equal(testSandboxID, events[0].SandboxID)
+	assert.Equal(string(vc.HypervisorStartedEvent), events[1].Type)
+	assert.Equal("1234", events[1].Attributes["pid"])
+	assert.Equal(string(SandboxStopEvent), events[2].Type)
+	assert.False(events[2].Timestamp.Before(events[0].Timestamp))
+
+	// without a journal, the events are not recorded
+	s.journal = nil
+	s.recordEvent(SandboxStopEvent, nil)
+	events, err = ReadJournal(path)
+	assert.NoError(err)
+	assert.Len(events, 3)
+}
+
+func TestReadJournal(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), testSandboxID+journalExt)
+
+	_, err := ReadJournal(path)
+	assert.True(os.IsNotExist(err))
+
+	valid := `{"timestamp":"2022-03-01T10:00:00Z","sandbox_id":"sb","type":"sandbox-create"}` + "\n"
+
+	// truncated last line
+	assert.NoError(os.WriteFile(path, []byte(valid+`{"timestamp":"2022-03-01T10:00:01Z","sand`), 0600))
+	events, err := ReadJournal(path)
+	assert.NoError(err)
+	assert.Len(events, 1)
+	assert.Equal("sb", events[0].SandboxID)
+
+	// corrupted journal
+	assert.NoError(os.WriteFile(path, []byte("not json\n"+valid), 0600))
+	_, err = ReadJournal(path)
+	assert.Error(err)
+}
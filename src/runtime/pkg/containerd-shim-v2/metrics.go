@@ -15,14 +15,25 @@ import (
 	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
 )
 
-func marshalMetrics(ctx context.Context, s *service, containerID string) (*google_protobuf.Any, error) {
-	stats, err := s.sandbox.StatsContainer(ctx, containerID)
+func marshalMetrics(ctx context.Context, s *service, c *container) (*google_protobuf.Any, error) {
+	stats, err := s.sandbox.StatsContainer(ctx, c.id)
 	if err != nil {
 		return nil, err
 	}
 
 	metrics := statsToMetrics(&stats)
 
+	// The stats of the sandbox container account for the pod overhead, the
+	// usage of the host processes running the sandbox that the guest stats
+	// of the containers do not account for.
+	if c.cType.IsSandbox() {
+		if overhead, err := s.podOverhead(); err != nil {
+			shimLog.WithError(err).Warn("failed to get the pod overhead")
+		} else {
+			addPodOverhead(metrics, overhead.guestExcluded())
+		}
+	}
+
 	data, err := typeurl.MarshalAny(metrics)
 	if err != nil {
 		return nil, err
@@ -49,6 +60,29 @@ func statsToMetrics(stats *vc.ContainerStats) *cgroupsv1.Metrics {
 	return metrics
 }
 
+// addPodOverhead adds the resource usage of the processes running the sandbox,
+// excluding the one of the guest, to the metrics.
+func addPodOverhead(metrics *cgroupsv1.Metrics, overhead vc.ProcessUsage) {
+	if metrics.CPU == nil {
+		metrics.CPU = &cgroupsv1.CPUStat{}
+	}
+	if metrics.CPU.Usage == nil {
+		metrics.CPU.Usage = &cgroupsv1.CPUUsage{}
+	}
+	metrics.CPU.Usage.Total += overhead.UserNanoseconds + overhead.SystemNanoseconds
+	metrics.CPU.Usage.User += overhead.UserNanoseconds
+	metrics.CPU.Usage.Kernel += overhead.SystemNanoseconds
+
+	if metrics.Memory == nil {
+		metrics.Memory = &cgroupsv1.MemoryStat{}
+	}
+	if metrics.Memory.Usage == nil {
+		metrics.Memory.Usage = &cgroupsv1.MemoryEntry{}
+	}
+	metrics.Memory.Usage.Usage += overhead.RSSBytes
+	metrics.Memory.RSS += overhead.RSSBytes
+}
+
 func setHugetlbStats(vcHugetlb map[string]vc.HugetlbStats) []*cgroupsv1.HugetlbStat {
 	var hugetlbStats []*cgroupsv1.HugetlbStat
 	for _, v := range vcHugetlb {
@@ -123,7 +157,13 @@ func setMemoryStats(vcMemory vc.MemoryStats) *cgroupsv1.MemoryStat {
 		},
 	}
 
-	if vcMemory.UseHierarchy {
+	if _, ok := vcMemory.Stats["anon"]; ok {
+		// cgroup v2 in the guest
+		memoryStats.Cache = vcMemory.Stats["file"]
+		memoryStats.RSS = vcMemory.Stats["anon"]
+		memoryStats.MappedFile = vcMemory.Stats["file_mapped"]
+		memoryStats.TotalInactiveFile = vcMemory.Stats["inactive_file"]
+	} else if vcMemory.UseHierarchy {
 		memoryStats.Cache = vcMemory.Stats["total_cache"]
 		memoryStats.RSS = vcMemory.Stats["total_rss"]
 		memoryStats.MappedFile = vcMemory.Stats["total_mapped_file"]
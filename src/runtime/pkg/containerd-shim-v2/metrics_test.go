@@ -11,8 +11,11 @@ import (
 	"testing"
 
 	"github.com/containerd/cgroups/stats/v1"
+	"github.com/containerd/typeurl"
 	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/vcmock"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -57,3 +60,126 @@ func TestStatNetworkMetric(t *testing.T) {
 	metrics := statsToMetrics(&resp)
 	assert.Equal(expectedNetwork, metrics.Network)
 }
+
+func TestSetMemoryStats(t *testing.T) {
+	assert := assert.New(t)
+
+	// cgroup v1
+	memoryStats := setMemoryStats(vc.MemoryStats{
+		UseHierarchy: true,
+		Stats: map[string]uint64{
+			"total_cache":         10,
+			"total_rss":           20,
+			"total_mapped_file":   30,
+			"total_inactive_file": 40,
+		},
+	})
+	assert.Equal(uint64(10), memoryStats.Cache)
+	assert.Equal(uint64(20), memoryStats.RSS)
+	assert.Equal(uint64(30), memoryStats.MappedFile)
+	assert.Equal(uint64(40), memoryStats.TotalInactiveFile)
+
+	// cgroup v2
+	memoryStats = setMemoryStats(vc.MemoryStats{
+		Stats: map[string]uint64{
+			"file":          10,
+			"anon":          20,
+			"file_mapped":   30,
+			"inactive_file": 40,
+		},
+	})
+	assert.Equal(uint64(10), memoryStats.Cache)
+	assert.Equal(uint64(20), memoryStats.RSS)
+	assert.Equal(uint64(30), memoryStats.MappedFile)
+	assert.Equal(uint64(40), memoryStats.TotalInactiveFile)
+}
+
+func TestMarshalMetricsPodOverhead(t *testing.T) {
+	assert := assert.New(t)
+
+	sandbox := &vcmock.Sandbox{
+		MockID: testSandboxID,
+	}
+	sandbox.OverheadStatsFunc = func() (vc.OverheadStats, error) {
+		return vc.OverheadStats{
+			Hypervisor: vc.ProcessUsage{UserNanoseconds: 1<<40 + 10, SystemNanoseconds: 20, GuestNanoseconds: 1 << 40, RSSBytes: 1 << 40},
+			Virtiofsd:  vc.ProcessUsage{UserNanoseconds: 1, SystemNanoseconds: 2, RSSBytes: 10},
+		}, nil
+	}
+
+	s := &service{
+		id:         testSandboxID,
+		sandbox:    sandbox,
+		containers: make(map[string]*container),
+	}
+
+	overhead, err := s.podOverhead()
+	assert.NoError(err)
+	assert.NotZero(overhead.Shim.RSSBytes)
+	assert.Equal(uint64(1<<40+10)+overhead.Shim.RSSBytes, overhead.total().RSSBytes)
+
+	// the vCPU time and memory of the hypervisor are the guest ones
+	usage := overhead.guestExcluded()
+	assert.Equal(uint64(11)+overhead.Shim.UserNanoseconds, usage.UserNanoseconds)
+	assert.Equal(uint64(22)+overhead.Shim.SystemNanoseconds, usage.SystemNanoseconds)
+	assert.Equal(uint64(10)+overhead.Shim.RSSBytes, usage.RSSBytes)
+
+	// the overhead is accounted to the sandbox container only
+	for _, cType := range []vc.ContainerType{vc.PodSandbox, vc.PodContainer} {
+		c := &container{id: testContainerID, cType: cType}
+
+		data, err := marshalMetrics(context.Background(), s, c)
+		assert.NoError(err)
+
+		v, err := typeurl.UnmarshalAny(data)
+		assert.NoError(err)
+		metrics, ok := v.(*v1.Metrics)
+		assert.True(ok)
+
+		if cType.IsSandbox() {
+			assert.True(metrics.CPU.Usage.User >= 11 && metrics.CPU.Usage.User < 1<<40)
+			assert.True(metrics.CPU.Usage.Kernel >= 22)
+			assert.Equal(metrics.CPU.Usage.User+metrics.CPU.Usage.Kernel, metrics.CPU.Usage.Total)
+			assert.True(metrics.Memory.Usage.Usage > 10 && metrics.Memory.Usage.Usage < 1<<40)
+			assert.Equal(metrics.Memory.Usage.Usage, metrics.Memory.RSS)
+		} else {
+			assert.Nil(metrics.CPU)
+			assert.Nil(metrics.Memory)
+		}
+	}
+}
+
+func TestPodOverheadCPUCollector(t *testing.T) {
+	assert := assert.New(t)
+
+	sandbox := &vcmock.Sandbox{
+		MockID: testSandboxID,
+	}
+	sandbox.OverheadStatsFunc = func() (vc.OverheadStats, error) {
+		return vc.OverheadStats{
+			Hypervisor: vc.ProcessUsage{UserNanoseconds: 3e9, SystemNanoseconds: 1e9},
+		}, nil
+	}
+
+	collector := &podOverheadCPUCollector{s: &service{id: testSandboxID, sandbox: sandbox}}
+	ch := make(chan prometheus.Metric, 6)
+	collector.Collect(ch)
+	close(ch)
+	assert.Len(ch, 6)
+
+	values := make(map[string]float64)
+	for metric := range ch {
+		var m dto.Metric
+		assert.NoError(metric.Write(&m))
+		assert.NotNil(m.GetCounter())
+
+		labels := make(map[string]string)
+		for _, l := range m.GetLabel() {
+			labels[l.GetName()] = l.GetValue()
+		}
+		values[labels["component"]+"/"+labels["mode"]] = m.GetCounter().GetValue()
+	}
+	assert.Equal(float64(3), values["hypervisor/user"])
+	assert.Equal(float64(1), values["hypervisor/system"])
+	assert.Equal(float64(0), values["virtiofsd/user"])
+}
@@ -0,0 +1,326 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	cgroupsv1 "github.com/containerd/cgroups/stats/v1"
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/api/types/task"
+	cri "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
+)
+
+// defaultInterface is the network interface of the pods reported as their
+// default one.
+const defaultInterface = "eth0"
+
+// writableLayerUsageInterval is the interval the usage of the writable layer
+// of a container is refreshed at, walking the layer being costly.
+const writableLayerUsageInterval = time.Minute
+
+// cpuSample is the CPU usage of the pod at a time, the usage in nano cores
+// being the one between two samples.
+type cpuSample struct {
+	time  time.Time
+	usage uint64
+}
+
+// podSandboxStats returns the CRI stats of the pod, combining:
+//   - the host view of the sandbox: the CPU and memory usage of its host
+//     cgroup, the usage of the processes running it aside the VM, and the
+//     traffic of its network interfaces,
+//   - the guest view of its containers, reported by the agent from the
+//     guest cgroups.
+//
+// It is called with the service lock held, and returns the upper directories
+// of the listed containers, for their writable layers to be walked by
+// writableLayersUsage.get once the lock is released.
+func (s *service) podSandboxStats(ctx context.Context, last *cpuSample) (*cri.PodSandboxStats, []string, error) {
+	now := time.Now()
+	timestamp := now.UnixNano()
+
+	sandboxStats, err := s.sandbox.Stats(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	overhead, err := s.podOverhead()
+	if err != nil {
+		shimLog.WithError(err).Warn("failed to get the pod overhead")
+	}
+
+	linux := &cri.LinuxPodSandboxStats{
+		Cpu: &cri.CpuUsage{
+			Timestamp: timestamp,
+		},
+		Memory: &cri.MemoryUsage{
+			Timestamp: timestamp,
+		},
+		Network: networkUsage(timestamp, sandboxStats.NetworkStats),
+		Process: &cri.ProcessUsage{
+			Timestamp:    timestamp,
+			ProcessCount: &cri.UInt64Value{},
+		},
+	}
+
+	// the processes running the sandbox aside the VM, whose memory holds the
+	// one of the guest
+	var workingSet, rss, pageFaults, majorPageFaults uint64
+	workingSet = overhead.Virtiofsd.RSSBytes + overhead.Shim.RSSBytes
+	rss = workingSet
+
+	var upperDirs []string
+
+	for _, c := range s.containers {
+		if c.status != task.StatusRunning && c.status != task.StatusPaused {
+			continue
+		}
+
+		stats, err := s.sandbox.StatsContainer(ctx, c.id)
+		if err != nil {
+			return nil, nil, err
+		}
+		metrics := statsToMetrics(&stats)
+
+		if metrics.Pids != nil {
+			linux.Process.ProcessCount.Value += metrics.Pids.Current
+		}
+		if metrics.Memory != nil {
+			workingSet += memoryWorkingSet(metrics.Memory)
+			rss += metrics.Memory.RSS
+			pageFaults += metrics.Memory.PgFault
+			majorPageFaults += metrics.Memory.PgMajFault
+		}
+
+		// the stats of the sandbox container are the ones of the pod
+		if c.cType.IsSandbox() {
+			continue
+		}
+
+		linux.Containers = append(linux.Containers, &cri.ContainerStats{
+			Attributes: &cri.ContainerAttributes{
+				Id:          c.id,
+				Annotations: c.spec.Annotations,
+			},
+			Cpu:    containerCPUUsage(timestamp, metrics.CPU),
+			Memory: containerMemoryUsage(timestamp, metrics.Memory),
+		})
+		upperDirs = append(upperDirs, c.upperDir)
+	}
+
+	// the host cgroup of the sandbox accounts for the vCPUs and the guest
+	// memory, and for the processes running the sandbox when they are in it
+	cpu := sandboxStats.CgroupStats.CPUStats.CPUUsage.TotalUsage
+	if cpu == 0 {
+		total := overhead.total()
+		cpu = total.UserNanoseconds + total.SystemNanoseconds
+	}
+	usage := sandboxStats.CgroupStats.MemoryStats.Usage.Usage
+	if usage == 0 {
+		usage = overhead.total().RSSBytes
+	}
+
+	linux.Cpu.UsageCoreNanoSeconds = &cri.UInt64Value{Value: cpu}
+	if !last.time.IsZero() && now.After(last.time) && cpu >= last.usage {
+		nanoCores := float64(cpu-last.usage) / now.Sub(last.time).Seconds()
+		linux.Cpu.UsageNanoCores = &cri.UInt64Value{Value: uint64(nanoCores)}
+	}
+	*last = cpuSample{time: now, usage: cpu}
+
+	linux.Memory.UsageBytes = &cri.UInt64Value{Value: usage}
+	linux.Memory.WorkingSetBytes = &cri.UInt64Value{Value: workingSet}
+	linux.Memory.RssBytes = &cri.UInt64Value{Value: rss}
+	linux.Memory.PageFaults = &cri.UInt64Value{Value: pageFaults}
+	linux.Memory.MajorPageFaults = &cri.UInt64Value{Value: majorPageFaults}
+
+	attributes := &cri.PodSandboxAttributes{
+		Id: s.id,
+	}
+	if c, ok := s.containers[s.id]; ok {
+		attributes.Annotations = c.spec.Annotations
+	}
+
+	return &cri.PodSandboxStats{
+		Attributes: attributes,
+		Linux:      linux,
+	}, upperDirs, nil
+}
+
+// writableLayersUsage caches the usage of the writable layers of the
+// containers, refreshed every writableLayerUsageInterval.
+type writableLayersUsage struct {
+	sync.Mutex
+	usage map[string]*cri.FilesystemUsage
+}
+
+// get returns the usage of the writable layers of the upper directories,
+// walking the ones whose cached usage expired, and forgets the usage of the
+// other layers. It is called without the service lock held.
+func (w *writableLayersUsage) get(upperDirs []string) []*cri.FilesystemUsage {
+	w.Lock()
+	defer w.Unlock()
+
+	now := time.Now()
+	usage := make(map[string]*cri.FilesystemUsage, len(upperDirs))
+	layers := make([]*cri.FilesystemUsage, len(upperDirs))
+
+	for i, upperDir := range upperDirs {
+		if upperDir == "" {
+			continue
+		}
+
+		u, ok := w.usage[upperDir]
+		if !ok || now.Sub(time.Unix(0, u.Timestamp)) >= writableLayerUsageInterval {
+			u = writableLayerUsage(now.UnixNano(), upperDir)
+		}
+		if u != nil {
+			usage[upperDir] = u
+		}
+		layers[i] = u
+	}
+	w.usage = usage
+
+	return layers
+}
+
+// memoryWorkingSet returns the memory usage of a guest cgroup not counting its
+// inactive file cache, as the kubelet does.
+func memoryWorkingSet(memory *cgroupsv1.MemoryStat) uint64 {
+	if memory.Usage == nil || memory.Usage.Usage < memory.TotalInactiveFile {
+		return 0
+	}
+
+	return memory.Usage.Usage - memory.TotalInactiveFile
+}
+
+func containerCPUUsage(timestamp int64, cpu *cgroupsv1.CPUStat) *cri.CpuUsage {
+	usage := &cri.CpuUsage{
+		Timestamp: timestamp,
+	}
+	if cpu != nil && cpu.Usage != nil {
+		usage.UsageCoreNanoSeconds = &cri.UInt64Value{Value: cpu.Usage.Total}
+	}
+
+	return usage
+}
+
+func containerMemoryUsage(timestamp int64, memory *cgroupsv1.MemoryStat) *cri.MemoryUsage {
+	usage := &cri.MemoryUsage{
+		Timestamp: timestamp,
+	}
+	if memory == nil {
+		return usage
+	}
+
+	usage.WorkingSetBytes = &cri.UInt64Value{Value: memoryWorkingSet(memory)}
+	usage.RssBytes = &cri.UInt64Value{Value: memory.RSS}
+	usage.PageFaults = &cri.UInt64Value{Value: memory.PgFault}
+	usage.MajorPageFaults = &cri.UInt64Value{Value: memory.PgMajFault}
+	if memory.Usage != nil {
+		usage.UsageBytes = &cri.UInt64Value{Value: memory.Usage.Usage}
+		if memory.Usage.Limit > usage.WorkingSetBytes.Value && memory.Usage.Limit != ^uint64(0) {
+			usage.AvailableBytes = &cri.UInt64Value{Value: memory.Usage.Limit - usage.WorkingSetBytes.Value}
+		}
+	}
+
+	return usage
+}
+
+// networkUsage returns the usage of the network interfaces of the pod, seen
+// from the host side of the sandbox.
+func networkUsage(timestamp int64, stats []*vc.NetworkStats) *cri.NetworkUsage {
+	usage := &cri.NetworkUsage{
+		Timestamp: timestamp,
+	}
+
+	for _, s := range stats {
+		iface := &cri.NetworkInterfaceUsage{
+			Name:     s.Name,
+			RxBytes:  &cri.UInt64Value{Value: s.RxBytes},
+			RxErrors: &cri.UInt64Value{Value: s.RxErrors},
+			TxBytes:  &cri.UInt64Value{Value: s.TxBytes},
+			TxErrors: &cri.UInt64Value{Value: s.TxErrors},
+		}
+
+		if s.Name == defaultInterface {
+			usage.DefaultInterface = iface
+		} else {
+			usage.Interfaces = append(usage.Interfaces, iface)
+		}
+	}
+
+	return usage
+}
+
+// writableLayerUsage returns the usage of the upper directory of the overlay
+// rootfs of a container, nil when its rootfs is not an overlay.
+func writableLayerUsage(timestamp int64, upperDir string) *cri.FilesystemUsage {
+	if upperDir == "" {
+		return nil
+	}
+
+	var bytes, inodes uint64
+	seen := make(map[uint64]bool)
+
+	err := filepath.WalkDir(upperDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		// the hard links are counted once
+		st, ok := info.Sys().(*syscall.Stat_t)
+		if !ok || seen[st.Ino] {
+			return nil
+		}
+		seen[st.Ino] = true
+
+		inodes++
+		bytes += uint64(st.Blocks) * 512
+
+		return nil
+	})
+	if err != nil {
+		shimLog.WithError(err).WithField("upperdir", upperDir).Warn("failed to get the usage of the writable layer")
+		return nil
+	}
+
+	return &cri.FilesystemUsage{
+		Timestamp:  timestamp,
+		FsId:       &cri.FilesystemIdentifier{Mountpoint: upperDir},
+		UsedBytes:  &cri.UInt64Value{Value: bytes},
+		InodesUsed: &cri.UInt64Value{Value: inodes},
+	}
+}
+
+// overlayUpperDir returns the upper directory of an overlay rootfs, the
+// writable layer of the container.
+func overlayUpperDir(rootfs []*types.Mount) string {
+	if len(rootfs) != 1 || rootfs[0].Type != "overlay" {
+		return ""
+	}
+
+	for _, o := range rootfs[0].Options {
+		if strings.HasPrefix(o, "upperdir=") {
+			return strings.TrimPrefix(o, "upperdir=")
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/api/types/task"
+	"github.com/containerd/typeurl"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	cri "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+
+	sandboxAPI "github.com/kata-containers/kata-containers/src/runtime/protocols/sandbox"
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/vcmock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSandboxServiceMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	upperDir := t.TempDir()
+	assert.NoError(os.WriteFile(filepath.Join(upperDir, "foo"), make([]byte, 8192), 0600))
+
+	containerStats := func(pids, usage, inactive uint64) vc.ContainerStats {
+		stats := vc.ContainerStats{CgroupStats: &vc.CgroupStats{}}
+		stats.CgroupStats.PidsStats.Current = pids
+		stats.CgroupStats.CPUStats.CPUUsage.TotalUsage = 100
+		stats.CgroupStats.MemoryStats.Usage.Usage = usage
+		stats.CgroupStats.MemoryStats.Stats = map[string]uint64{
+			"anon":          usage - inactive,
+			"inactive_file": inactive,
+		}
+		return stats
+	}
+
+	cpu := uint64(1e9)
+	sandbox := &vcmock.Sandbox{
+		MockID: testSandboxID,
+		StatsFunc: func() (vc.SandboxStats, error) {
+			var stats vc.SandboxStats
+			stats.CgroupStats.CPUStats.CPUUsage.TotalUsage = cpu
+			stats.CgroupStats.MemoryStats.Usage.Usage = 512 << 20
+			stats.NetworkStats = []*vc.NetworkStats{
+				{Name: "eth0", RxBytes: 10, TxBytes: 20},
+				{Name: "lo", RxBytes: 1, TxBytes: 1},
+			}
+			return stats, nil
+		},
+		StatsContainerFunc: func(contID string) (vc.ContainerStats, error) {
+			if contID == testSandboxID {
+				return containerStats(1, 1<<20, 0), nil
+			}
+			return containerStats(3, 10<<20, 2<<20), nil
+		},
+	}
+
+	s := &service{
+		id:         testSandboxID,
+		sandbox:    sandbox,
+		containers: make(map[string]*container),
+	}
+	s.containers[testSandboxID] = &container{
+		id:     testSandboxID,
+		cType:  vc.PodSandbox,
+		status: task.StatusRunning,
+		spec:   &specs.Spec{},
+	}
+	s.containers[testContainerID] = &container{
+		id:       testContainerID,
+		cType:    vc.PodContainer,
+		status:   task.StatusRunning,
+		spec:     &specs.Spec{},
+		upperDir: upperDir,
+	}
+	ss := &sandboxService{s: s}
+
+	metrics := func() *cri.PodSandboxStats {
+		resp, err := ss.SandboxMetrics(context.Background(), &sandboxAPI.SandboxMetricsRequest{SandboxId: testSandboxID})
+		assert.NoError(err)
+		assert.Equal(testSandboxID, resp.Metrics.ID)
+
+		data, err := typeurl.UnmarshalAny(resp.Metrics.Data)
+		assert.NoError(err)
+		return data.(*cri.PodSandboxStats)
+	}
+
+	stats := metrics()
+	assert.Equal(testSandboxID, stats.Attributes.Id)
+	assert.Equal(cpu, stats.Linux.Cpu.UsageCoreNanoSeconds.Value)
+	assert.Nil(stats.Linux.Cpu.UsageNanoCores)
+	assert.Equal(uint64(512<<20), stats.Linux.Memory.UsageBytes.Value)
+	assert.Equal(uint64(4), stats.Linux.Process.ProcessCount.Value)
+	assert.Equal("eth0", stats.Linux.Network.DefaultInterface.Name)
+	assert.Equal(uint64(10), stats.Linux.Network.DefaultInterface.RxBytes.Value)
+	assert.Len(stats.Linux.Network.Interfaces, 1)
+
+	// the sandbox container is not listed
+	assert.Len(stats.Linux.Containers, 1)
+	c := stats.Linux.Containers[0]
+	assert.Equal(testContainerID, c.Attributes.Id)
+	assert.Equal(uint64(8<<20), c.Memory.WorkingSetBytes.Value)
+	assert.Equal(uint64(100), c.Cpu.UsageCoreNanoSeconds.Value)
+	assert.Equal(uint64(2), c.WritableLayer.InodesUsed.Value)
+	assert.True(c.WritableLayer.UsedBytes.Value >= 8192)
+
+	// the usage in nano cores is the one between two metrics
+	time.Sleep(10 * time.Millisecond)
+	cpu += 1e6
+	assert.NoError(os.WriteFile(filepath.Join(upperDir, "bar"), make([]byte, 8192), 0600))
+	stats = metrics()
+	assert.NotNil(stats.Linux.Cpu.UsageNanoCores)
+	assert.True(stats.Linux.Cpu.UsageNanoCores.Value > 0)
+
+	// the usage of the writable layer is cached
+	assert.Equal(uint64(2), stats.Linux.Containers[0].WritableLayer.InodesUsed.Value)
+}
+
+func TestOverlayUpperDir(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("/upper", overlayUpperDir([]*types.Mount{{
+		Type:    "overlay",
+		Options: []string{"lowerdir=/lower", "upperdir=/upper", "workdir=/work"},
+	}}))
+	assert.Empty(overlayUpperDir([]*types.Mount{{Type: "ext4", Source: "/dev/vdb"}}))
+	assert.Empty(overlayUpperDir(nil))
+}
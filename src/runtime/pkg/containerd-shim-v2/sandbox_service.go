@@ -0,0 +1,311 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"context"
+	"runtime"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/api/types/task"
+	"github.com/containerd/containerd/errdefs"
+	taskAPI "github.com/containerd/containerd/runtime/v2/task"
+	"github.com/containerd/ttrpc"
+	"github.com/containerd/typeurl"
+	ptypes "github.com/gogo/protobuf/types"
+
+	sandboxAPI "github.com/kata-containers/kata-containers/src/runtime/protocols/sandbox"
+)
+
+const (
+	sandboxStateReady    = "SANDBOX_READY"
+	sandboxStateNotReady = "SANDBOX_NOTREADY"
+)
+
+// sandboxService implements the containerd sandbox API, the sandbox being
+// managed through the task API calls of its sandbox container, so that both
+// APIs share the sandbox lifecycle.
+type sandboxService struct {
+	s *service
+
+	createdAt time.Time
+
+	// the CPU usage of the pod at the last metrics
+	lastCPU cpuSample
+
+	writableLayers writableLayersUsage
+}
+
+func (ss *sandboxService) RegisterTTRPC(server *ttrpc.Server) error {
+	sandboxAPI.RegisterSandboxService(server, ss)
+	return nil
+}
+
+// checkSandboxID ensures the request is for the sandbox of the shim.
+func (ss *sandboxService) checkSandboxID(id string) error {
+	if id != ss.s.id {
+		return errdefs.ToGRPCf(errdefs.ErrNotFound, "sandbox %s not found, the shim manages sandbox %s", id, ss.s.id)
+	}
+
+	return nil
+}
+
+// CreateSandbox creates the sandbox from the bundle, as when its sandbox
+// container is created.
+func (ss *sandboxService) CreateSandbox(ctx context.Context, r *sandboxAPI.CreateSandboxRequest) (*sandboxAPI.CreateSandboxResponse, error) {
+	if err := ss.checkSandboxID(r.SandboxId); err != nil {
+		return nil, err
+	}
+
+	if _, err := ss.s.Create(ctx, &taskAPI.CreateTaskRequest{
+		ID:     r.SandboxId,
+		Bundle: r.BundlePath,
+		Rootfs: r.Rootfs,
+	}); err != nil {
+		return nil, err
+	}
+
+	ss.s.mu.Lock()
+	ss.createdAt = time.Now()
+	ss.s.mu.Unlock()
+
+	return &sandboxAPI.CreateSandboxResponse{}, nil
+}
+
+func (ss *sandboxService) StartSandbox(ctx context.Context, r *sandboxAPI.StartSandboxRequest) (*sandboxAPI.StartSandboxResponse, error) {
+	if err := ss.checkSandboxID(r.SandboxId); err != nil {
+		return nil, err
+	}
+
+	resp, err := ss.s.Start(ctx, &taskAPI.StartRequest{ID: r.SandboxId})
+	if err != nil {
+		return nil, err
+	}
+
+	ss.s.mu.Lock()
+	createdAt, err := ptypes.TimestampProto(ss.createdAt)
+	ss.s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &sandboxAPI.StartSandboxResponse{
+		Pid:       resp.Pid,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// Platform returns the platform of the guest, which is the one of the host.
+func (ss *sandboxService) Platform(ctx context.Context, r *sandboxAPI.PlatformRequest) (*sandboxAPI.PlatformResponse, error) {
+	if err := ss.checkSandboxID(r.SandboxId); err != nil {
+		return nil, err
+	}
+
+	return &sandboxAPI.PlatformResponse{
+		Platform: &types.Platform{
+			OS:           "linux",
+			Architecture: runtime.GOARCH,
+		},
+	}, nil
+}
+
+// StopSandbox kills the sandbox container, which stops the sandbox, waits
+// for it to exit and deletes it. Stopping a sandbox already stopped is not
+// an error.
+func (ss *sandboxService) StopSandbox(ctx context.Context, r *sandboxAPI.StopSandboxRequest) (*sandboxAPI.StopSandboxResponse, error) {
+	if err := ss.checkSandboxID(r.SandboxId); err != nil {
+		return nil, err
+	}
+
+	ss.s.mu.Lock()
+	_, err := ss.s.getContainer(r.SandboxId)
+	ss.s.mu.Unlock()
+	if err != nil {
+		// already deleted
+		return &sandboxAPI.StopSandboxResponse{}, nil
+	}
+
+	if _, err := ss.s.Kill(ctx, &taskAPI.KillRequest{
+		ID:     r.SandboxId,
+		Signal: uint32(syscall.SIGKILL),
+		All:    true,
+	}); err != nil {
+		return nil, err
+	}
+
+	if r.TimeoutSecs > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(r.TimeoutSecs)*time.Second)
+		defer cancel()
+	}
+
+	waited := make(chan error, 1)
+	go func() {
+		_, err := ss.s.Wait(ctx, &taskAPI.WaitRequest{ID: r.SandboxId})
+		waited <- err
+	}()
+
+	select {
+	case err := <-waited:
+		if err != nil {
+			return nil, err
+		}
+	case <-ctx.Done():
+		return nil, errdefs.ToGRPCf(ctx.Err(), "sandbox %s did not stop", r.SandboxId)
+	}
+
+	if _, err := ss.s.Delete(ctx, &taskAPI.DeleteRequest{ID: r.SandboxId}); err != nil {
+		return nil, err
+	}
+
+	return &sandboxAPI.StopSandboxResponse{}, nil
+}
+
+func (ss *sandboxService) WaitSandbox(ctx context.Context, r *sandboxAPI.WaitSandboxRequest) (*sandboxAPI.WaitSandboxResponse, error) {
+	if err := ss.checkSandboxID(r.SandboxId); err != nil {
+		return nil, err
+	}
+
+	resp, err := ss.s.Wait(ctx, &taskAPI.WaitRequest{ID: r.SandboxId})
+	if err != nil {
+		return nil, err
+	}
+
+	exitedAt, err := ptypes.TimestampProto(resp.ExitedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sandboxAPI.WaitSandboxResponse{
+		ExitStatus: resp.ExitStatus,
+		ExitedAt:   exitedAt,
+	}, nil
+}
+
+// SandboxStatus returns the sandbox as ready while its sandbox container
+// runs.
+func (ss *sandboxService) SandboxStatus(ctx context.Context, r *sandboxAPI.SandboxStatusRequest) (*sandboxAPI.SandboxStatusResponse, error) {
+	if err := ss.checkSandboxID(r.SandboxId); err != nil {
+		return nil, err
+	}
+
+	ss.s.mu.Lock()
+	defer ss.s.mu.Unlock()
+
+	resp := &sandboxAPI.SandboxStatusResponse{
+		SandboxId: r.SandboxId,
+		Pid:       ss.s.hpid,
+		State:     sandboxStateNotReady,
+	}
+
+	var err error
+	if !ss.createdAt.IsZero() {
+		if resp.CreatedAt, err = ptypes.TimestampProto(ss.createdAt); err != nil {
+			return nil, err
+		}
+	}
+
+	c, err := ss.s.getContainer(r.SandboxId)
+	if err == nil {
+		switch c.status {
+		case task.StatusRunning:
+			resp.State = sandboxStateReady
+		case task.StatusStopped:
+			if resp.ExitedAt, err = ptypes.TimestampProto(c.exitTime); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if r.Verbose {
+		resp.Info = map[string]string{
+			"hypervisor_pid": strconv.FormatUint(uint64(ss.s.hpid), 10),
+			"shim_pid":       strconv.FormatUint(uint64(ss.s.pid), 10),
+		}
+
+		if ss.s.sandbox != nil {
+			if overhead, err := ss.s.podOverhead(); err == nil {
+				usage := overhead.guestExcluded()
+				resp.Info["overhead_cpu_nanoseconds"] = strconv.FormatUint(usage.UserNanoseconds+usage.SystemNanoseconds, 10)
+				resp.Info["overhead_rss_bytes"] = strconv.FormatUint(usage.RSSBytes, 10)
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+// PingSandbox checks the hypervisor process is alive.
+func (ss *sandboxService) PingSandbox(ctx context.Context, r *sandboxAPI.PingRequest) (*sandboxAPI.PingResponse, error) {
+	if err := ss.checkSandboxID(r.SandboxId); err != nil {
+		return nil, err
+	}
+
+	ss.s.mu.Lock()
+	hpid := ss.s.hpid
+	ss.s.mu.Unlock()
+
+	if hpid == 0 {
+		return nil, errdefs.ToGRPCf(errdefs.ErrFailedPrecondition, "sandbox %s is not started", r.SandboxId)
+	}
+
+	if err := syscall.Kill(int(hpid), 0); err != nil {
+		return nil, errdefs.ToGRPCf(errdefs.ErrUnavailable, "hypervisor process %d of sandbox %s: %v", hpid, r.SandboxId, err)
+	}
+
+	return &sandboxAPI.PingResponse{}, nil
+}
+
+// SandboxMetrics returns the CRI stats of the pod.
+func (ss *sandboxService) SandboxMetrics(ctx context.Context, r *sandboxAPI.SandboxMetricsRequest) (*sandboxAPI.SandboxMetricsResponse, error) {
+	if err := ss.checkSandboxID(r.SandboxId); err != nil {
+		return nil, err
+	}
+
+	ss.s.mu.Lock()
+	if ss.s.sandbox == nil {
+		ss.s.mu.Unlock()
+		return nil, errdefs.ToGRPCf(errdefs.ErrFailedPrecondition, "sandbox %s is not created", r.SandboxId)
+	}
+	stats, upperDirs, err := ss.s.podSandboxStats(ctx, &ss.lastCPU)
+	ss.s.mu.Unlock()
+	if err != nil {
+		return nil, errdefs.ToGRPC(err)
+	}
+
+	// the writable layers are walked without the service lock
+	for i, layer := range ss.writableLayers.get(upperDirs) {
+		stats.Linux.Containers[i].WritableLayer = layer
+	}
+
+	data, err := typeurl.MarshalAny(stats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sandboxAPI.SandboxMetricsResponse{
+		Metrics: &types.Metric{
+			Timestamp: time.Now(),
+			ID:        r.SandboxId,
+			Data:      data,
+		},
+	}, nil
+}
+
+func (ss *sandboxService) ShutdownSandbox(ctx context.Context, r *sandboxAPI.ShutdownSandboxRequest) (*sandboxAPI.ShutdownSandboxResponse, error) {
+	if err := ss.checkSandboxID(r.SandboxId); err != nil {
+		return nil, err
+	}
+
+	if _, err := ss.s.Shutdown(ctx, &taskAPI.ShutdownRequest{ID: r.SandboxId}); err != nil {
+		return nil, err
+	}
+
+	return &sandboxAPI.ShutdownSandboxResponse{}, nil
+}
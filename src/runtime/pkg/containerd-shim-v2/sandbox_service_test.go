@@ -0,0 +1,111 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/containerd/containerd/api/types/task"
+	taskAPI "github.com/containerd/containerd/runtime/v2/task"
+
+	sandboxAPI "github.com/kata-containers/kata-containers/src/runtime/protocols/sandbox"
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/vcmock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSandboxServiceCheckSandboxID(t *testing.T) {
+	assert := assert.New(t)
+
+	ss := &sandboxService{s: &service{id: testSandboxID}}
+
+	assert.NoError(ss.checkSandboxID(testSandboxID))
+	assert.Error(ss.checkSandboxID("other"))
+
+	_, err := ss.Platform(context.Background(), &sandboxAPI.PlatformRequest{SandboxId: "other"})
+	assert.Error(err)
+}
+
+func TestSandboxServicePlatform(t *testing.T) {
+	assert := assert.New(t)
+
+	ss := &sandboxService{s: &service{id: testSandboxID}}
+
+	resp, err := ss.Platform(context.Background(), &sandboxAPI.PlatformRequest{SandboxId: testSandboxID})
+	assert.NoError(err)
+	assert.Equal("linux", resp.Platform.OS)
+	assert.Equal(runtime.GOARCH, resp.Platform.Architecture)
+}
+
+func TestSandboxServiceStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &service{
+		id: testSandboxID,
+		sandbox: &vcmock.Sandbox{
+			MockID: testSandboxID,
+		},
+		containers: make(map[string]*container),
+		hpid:       42,
+	}
+	ss := &sandboxService{s: s}
+	req := &sandboxAPI.SandboxStatusRequest{SandboxId: testSandboxID, Verbose: true}
+
+	// not created
+	resp, err := ss.SandboxStatus(context.Background(), req)
+	assert.NoError(err)
+	assert.Equal(sandboxStateNotReady, resp.State)
+	assert.Nil(resp.CreatedAt)
+	assert.Equal("42", resp.Info["hypervisor_pid"])
+
+	c, err := newContainer(s, &taskAPI.CreateTaskRequest{ID: testSandboxID}, vc.PodSandbox, nil, false)
+	assert.NoError(err)
+	s.containers[testSandboxID] = c
+
+	c.status = task.StatusRunning
+	resp, err = ss.SandboxStatus(context.Background(), req)
+	assert.NoError(err)
+	assert.Equal(sandboxStateReady, resp.State)
+	assert.Equal(uint32(42), resp.Pid)
+
+	c.status = task.StatusStopped
+	resp, err = ss.SandboxStatus(context.Background(), req)
+	assert.NoError(err)
+	assert.Equal(sandboxStateNotReady, resp.State)
+	assert.NotNil(resp.ExitedAt)
+}
+
+func TestSandboxServicePing(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &service{id: testSandboxID}
+	ss := &sandboxService{s: s}
+	req := &sandboxAPI.PingRequest{SandboxId: testSandboxID}
+
+	// not started
+	_, err := ss.PingSandbox(context.Background(), req)
+	assert.Error(err)
+
+	s.hpid = uint32(os.Getpid())
+	_, err = ss.PingSandbox(context.Background(), req)
+	assert.NoError(err)
+}
+
+func TestSandboxServiceStopDeleted(t *testing.T) {
+	assert := assert.New(t)
+
+	ss := &sandboxService{s: &service{
+		id:         testSandboxID,
+		containers: make(map[string]*container),
+	}}
+
+	_, err := ss.StopSandbox(context.Background(), &sandboxAPI.StopSandboxRequest{SandboxId: testSandboxID})
+	assert.NoError(err)
+}
@@ -18,7 +18,9 @@ import (
 	"github.com/containerd/containerd/api/types/task"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/plugin"
 	cdruntime "github.com/containerd/containerd/runtime"
+	runcoptions "github.com/containerd/containerd/runtime/v2/runc/options"
 	cdshim "github.com/containerd/containerd/runtime/v2/shim"
 	taskAPI "github.com/containerd/containerd/runtime/v2/task"
 	"github.com/containerd/typeurl"
@@ -43,11 +45,6 @@ var shimTracingTags = map[string]string{
 }
 
 const (
-	// Define the service's channel size, which is used for
-	// reaping the exited processes exit state and forwarding
-	// it to containerd as the containerd event format.
-	bufferSize = 32
-
 	chSize      = 128
 	exitCode255 = 255
 )
@@ -66,8 +63,10 @@ var shimLog = logrus.WithFields(logrus.Fields{
 	"name":   "containerd-shim-v2",
 })
 
-// New returns a new shim service that can be used via GRPC
-func New(ctx context.Context, id string, publisher cdshim.Publisher, shutdown func()) (cdshim.Shim, error) {
+// New returns a new shim service that can be used via GRPC. The publisher of
+// containerd is not used: its connection is not established again when
+// containerd restarts, the events being published by a containerdForwarder.
+func New(ctx context.Context, id string, _ cdshim.Publisher, shutdown func()) (cdshim.Shim, error) {
 	shimLog = shimLog.WithFields(logrus.Fields{
 		"sandbox": id,
 		"pid":     os.Getpid(),
@@ -89,15 +88,34 @@ func New(ctx context.Context, id string, publisher cdshim.Publisher, shutdown fu
 		ctx:        ctx,
 		containers: make(map[string]*container),
 		events:     make(chan interface{}, chSize),
-		ec:         make(chan exit, bufferSize),
+		exits:      newExitQueue(),
 		cancel:     shutdown,
+		journal:    newJournal(JournalPath(id)),
 	}
 
+	// Serve the containerd sandbox API along with the task one
+	plugin.Register(&plugin.Registration{
+		Type: plugin.TTRPCPlugin,
+		ID:   "sandbox",
+		InitFn: func(ic *plugin.InitContext) (interface{}, error) {
+			return &sandboxService{s: s}, nil
+		},
+	})
+
 	go s.processExits()
 
-	forwarder := s.newEventsForwarder(ctx, publisher)
+	forwarder := s.newEventsForwarder(ctx)
 	go forwarder.forward()
 
+	// The shim is started to adopt the sandbox of a shim being upgraded,
+	// logging to its log fifo.
+	if statePath := os.Getenv(HandoverStateEnv); statePath != "" {
+		logrus.SetOutput(os.Stderr)
+		if err := s.completeHandover(statePath); err != nil {
+			return nil, err
+		}
+	}
+
 	return s, nil
 }
 
@@ -120,8 +138,11 @@ type service struct {
 
 	config *oci.RuntimeConfig
 
+	// path of the configuration file the runtime config is loaded from
+	configPath string
+
 	monitor chan error
-	ec      chan exit
+	exits   *exitQueue
 
 	events chan interface{}
 
@@ -139,6 +160,15 @@ type service struct {
 
 	// shim's pid
 	pid uint32
+
+	// task API calls in progress
+	pendingOps pendingOperations
+
+	// lifecycle journal of the sandbox
+	journal *journal
+
+	// a checkpoint of the sandbox is being saved
+	checkpointing bool
 }
 
 func newCommand(ctx context.Context, id, containerdBinary, containerdAddress string) (*sysexec.Cmd, error) {
@@ -268,7 +298,7 @@ func (s *service) sendL(evt interface{}) {
 }
 
 func getTopic(e interface{}) string {
-	switch e.(type) {
+	switch evt := e.(type) {
 	case *eventstypes.TaskCreate:
 		return cdruntime.TaskCreateEventTopic
 	case *eventstypes.TaskStart:
@@ -289,6 +319,8 @@ func getTopic(e interface{}) string {
 		return cdruntime.TaskResumedEventTopic
 	case *eventstypes.TaskCheckpointed:
 		return cdruntime.TaskCheckpointedEventTopic
+	case *VMEvent:
+		return vmEventTopicPrefix + evt.Type
 	default:
 		shimLog.WithField("event-type", e).Warn("no topic for event type")
 	}
@@ -297,7 +329,7 @@ func getTopic(e interface{}) string {
 
 // Cleanup is a binary call that cleans up resources used by the shim
 func (s *service) Cleanup(ctx context.Context) (_ *taskAPI.DeleteResponse, err error) {
-	span, spanCtx := katatrace.Trace(s.rootCtx, shimLog, "Cleanup", shimTracingTags)
+	span, spanCtx := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Cleanup", shimTracingTags)
 	defer span.End()
 
 	//Since the binary cleanup will return the DeleteResponse from stdout to
@@ -357,6 +389,8 @@ func (s *service) Cleanup(ctx context.Context) (_ *taskAPI.DeleteResponse, err e
 func (s *service) Create(ctx context.Context, r *taskAPI.CreateTaskRequest) (_ *taskAPI.CreateTaskResponse, err error) {
 	shimLog.WithField("container", r.ID).Debug("Create() start")
 	defer shimLog.WithField("container", r.ID).Debug("Create() end")
+	defer s.pendingOps.track("create", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
@@ -416,9 +450,11 @@ func (s *service) Create(ctx context.Context, r *taskAPI.CreateTaskRequest) (_ *
 func (s *service) Start(ctx context.Context, r *taskAPI.StartRequest) (_ *taskAPI.StartResponse, err error) {
 	shimLog.WithField("container", r.ID).Debug("Start() start")
 	defer shimLog.WithField("container", r.ID).Debug("Start() end")
-	span, spanCtx := katatrace.Trace(s.rootCtx, shimLog, "Start", shimTracingTags)
+	span, spanCtx := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Start", shimTracingTags)
 	defer span.End()
 
+	defer s.pendingOps.track("start", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
@@ -469,9 +505,11 @@ func (s *service) Start(ctx context.Context, r *taskAPI.StartRequest) (_ *taskAP
 func (s *service) Delete(ctx context.Context, r *taskAPI.DeleteRequest) (_ *taskAPI.DeleteResponse, err error) {
 	shimLog.WithField("container", r.ID).Debug("Delete() start")
 	defer shimLog.WithField("container", r.ID).Debug("Delete() end")
-	span, spanCtx := katatrace.Trace(s.rootCtx, shimLog, "Delete", shimTracingTags)
+	span, spanCtx := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Delete", shimTracingTags)
 	defer span.End()
 
+	defer s.pendingOps.track("delete", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
@@ -487,6 +525,17 @@ func (s *service) Delete(ctx context.Context, r *taskAPI.DeleteRequest) (_ *task
 	}
 
 	if r.ExecID == "" {
+		// the container is stopped once its crash diagnostics are collected
+		if c.diagnosed != nil {
+			s.mu.Unlock()
+			<-c.diagnosed
+			s.mu.Lock()
+
+			if c, err = s.getContainer(r.ID); err != nil {
+				return nil, err
+			}
+		}
+
 		if err = deleteContainer(spanCtx, s, c); err != nil {
 			return nil, err
 		}
@@ -523,9 +572,11 @@ func (s *service) Delete(ctx context.Context, r *taskAPI.DeleteRequest) (_ *task
 func (s *service) Exec(ctx context.Context, r *taskAPI.ExecProcessRequest) (_ *ptypes.Empty, err error) {
 	shimLog.WithField("container", r.ID).Debug("Exec() start")
 	defer shimLog.WithField("container", r.ID).Debug("Exec() end")
-	span, _ := katatrace.Trace(s.rootCtx, shimLog, "Exec", shimTracingTags)
+	span, _ := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Exec", shimTracingTags)
 	defer span.End()
 
+	defer s.pendingOps.track("exec", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		rpcDurationsHistogram.WithLabelValues("exec").Observe(float64(time.Since(start).Nanoseconds() / int64(time.Millisecond)))
@@ -563,9 +614,11 @@ func (s *service) Exec(ctx context.Context, r *taskAPI.ExecProcessRequest) (_ *p
 func (s *service) ResizePty(ctx context.Context, r *taskAPI.ResizePtyRequest) (_ *ptypes.Empty, err error) {
 	shimLog.WithField("container", r.ID).Debug("ResizePty() start")
 	defer shimLog.WithField("container", r.ID).Debug("ResizePty() end")
-	span, spanCtx := katatrace.Trace(s.rootCtx, shimLog, "ResizePty", shimTracingTags)
+	span, spanCtx := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "ResizePty", shimTracingTags)
 	defer span.End()
 
+	defer s.pendingOps.track("resize_pty", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
@@ -604,9 +657,11 @@ func (s *service) ResizePty(ctx context.Context, r *taskAPI.ResizePtyRequest) (_
 func (s *service) State(ctx context.Context, r *taskAPI.StateRequest) (_ *taskAPI.StateResponse, err error) {
 	shimLog.WithField("container", r.ID).Debug("State() start")
 	defer shimLog.WithField("container", r.ID).Debug("State() end")
-	span, _ := katatrace.Trace(s.rootCtx, shimLog, "State", shimTracingTags)
+	span, _ := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "State", shimTracingTags)
 	defer span.End()
 
+	defer s.pendingOps.track("state", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
@@ -660,9 +715,11 @@ func (s *service) State(ctx context.Context, r *taskAPI.StateRequest) (_ *taskAP
 func (s *service) Pause(ctx context.Context, r *taskAPI.PauseRequest) (_ *ptypes.Empty, err error) {
 	shimLog.WithField("container", r.ID).Debug("Pause() start")
 	defer shimLog.WithField("container", r.ID).Debug("Pause() end")
-	span, spanCtx := katatrace.Trace(s.rootCtx, shimLog, "Pause", shimTracingTags)
+	span, spanCtx := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Pause", shimTracingTags)
 	defer span.End()
 
+	defer s.pendingOps.track("pause", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
@@ -701,9 +758,11 @@ func (s *service) Pause(ctx context.Context, r *taskAPI.PauseRequest) (_ *ptypes
 func (s *service) Resume(ctx context.Context, r *taskAPI.ResumeRequest) (_ *ptypes.Empty, err error) {
 	shimLog.WithField("container", r.ID).Debug("Resume() start")
 	defer shimLog.WithField("container", r.ID).Debug("Resume() end")
-	span, spanCtx := katatrace.Trace(s.rootCtx, shimLog, "Resume", shimTracingTags)
+	span, spanCtx := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Resume", shimTracingTags)
 	defer span.End()
 
+	defer s.pendingOps.track("resume", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
@@ -740,9 +799,11 @@ func (s *service) Resume(ctx context.Context, r *taskAPI.ResumeRequest) (_ *ptyp
 func (s *service) Kill(ctx context.Context, r *taskAPI.KillRequest) (_ *ptypes.Empty, err error) {
 	shimLog.WithField("container", r.ID).Debug("Kill() start")
 	defer shimLog.WithField("container", r.ID).Debug("Kill() end")
-	span, spanCtx := katatrace.Trace(s.rootCtx, shimLog, "Kill", shimTracingTags)
+	span, spanCtx := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Kill", shimTracingTags)
 	defer span.End()
 
+	defer s.pendingOps.track("kill", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
@@ -805,11 +866,13 @@ func (s *service) Kill(ctx context.Context, r *taskAPI.KillRequest) (_ *ptypes.E
 func (s *service) Pids(ctx context.Context, r *taskAPI.PidsRequest) (_ *taskAPI.PidsResponse, err error) {
 	shimLog.WithField("container", r.ID).Debug("Pids() start")
 	defer shimLog.WithField("container", r.ID).Debug("Pids() end")
-	span, _ := katatrace.Trace(s.rootCtx, shimLog, "Pids", shimTracingTags)
+	span, _ := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Pids", shimTracingTags)
 	defer span.End()
 
 	var processes []*task.ProcessInfo
 
+	defer s.pendingOps.track("pids", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
@@ -830,9 +893,11 @@ func (s *service) Pids(ctx context.Context, r *taskAPI.PidsRequest) (_ *taskAPI.
 func (s *service) CloseIO(ctx context.Context, r *taskAPI.CloseIORequest) (_ *ptypes.Empty, err error) {
 	shimLog.WithField("container", r.ID).Debug("CloseIO() start")
 	defer shimLog.WithField("container", r.ID).Debug("CloseIO() end")
-	span, _ := katatrace.Trace(s.rootCtx, shimLog, "CloseIO", shimTracingTags)
+	span, _ := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "CloseIO", shimTracingTags)
 	defer span.End()
 
+	defer s.pendingOps.track("close_io", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
@@ -873,25 +938,74 @@ func (s *service) CloseIO(ctx context.Context, r *taskAPI.CloseIORequest) (_ *pt
 func (s *service) Checkpoint(ctx context.Context, r *taskAPI.CheckpointTaskRequest) (_ *ptypes.Empty, err error) {
 	shimLog.WithField("container", r.ID).Debug("Checkpoint() start")
 	defer shimLog.WithField("container", r.ID).Debug("Checkpoint() end")
-	span, _ := katatrace.Trace(s.rootCtx, shimLog, "Checkpoint", shimTracingTags)
+	span, spanCtx := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Checkpoint", shimTracingTags)
 	defer span.End()
 
+	defer s.pendingOps.track("checkpoint", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
 		rpcDurationsHistogram.WithLabelValues("checkpoint").Observe(float64(time.Since(start).Nanoseconds() / int64(time.Millisecond)))
 	}()
 
-	return nil, errdefs.ToGRPCf(errdefs.ErrNotImplemented, "service Checkpoint")
+	if r.Options != nil {
+		v, err := typeurl.UnmarshalAny(r.Options)
+		if err != nil {
+			return nil, err
+		}
+		if opts, ok := v.(*runcoptions.CheckpointOptions); ok && opts.Exit {
+			return nil, errdefs.ToGRPCf(errdefs.ErrNotImplemented, "exiting the task once checkpointed")
+		}
+	}
+
+	s.mu.Lock()
+	if _, err := s.getContainer(r.ID); err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	if s.checkpointing {
+		s.mu.Unlock()
+		return nil, errdefs.ToGRPCf(errdefs.ErrUnavailable, "a checkpoint of the sandbox is in progress")
+	}
+
+	// The snapshot of the VM holds all the containers of the sandbox
+	state, err := s.sandbox.PauseForCheckpoint(spanCtx)
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	s.checkpointing = true
+	s.mu.Unlock()
+
+	// The memory of the VM is saved without the service lock, not to block
+	// the containers management meanwhile
+	err = s.sandbox.SaveCheckpoint(spanCtx, r.Path, state)
+
+	s.mu.Lock()
+	s.checkpointing = false
+	s.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.send(&eventstypes.TaskCheckpointed{
+		ContainerID: r.ID,
+	})
+
+	return empty, nil
 }
 
 // Connect returns shim information such as the shim's pid
 func (s *service) Connect(ctx context.Context, r *taskAPI.ConnectRequest) (_ *taskAPI.ConnectResponse, err error) {
 	shimLog.WithField("container", r.ID).Debug("Connect() start")
 	defer shimLog.WithField("container", r.ID).Debug("Connect() end")
-	span, _ := katatrace.Trace(s.rootCtx, shimLog, "Connect", shimTracingTags)
+	span, _ := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Connect", shimTracingTags)
 	defer span.End()
 
+	defer s.pendingOps.track("connect", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
@@ -911,7 +1025,9 @@ func (s *service) Connect(ctx context.Context, r *taskAPI.ConnectRequest) (_ *ta
 func (s *service) Shutdown(ctx context.Context, r *taskAPI.ShutdownRequest) (_ *ptypes.Empty, err error) {
 	shimLog.WithField("container", r.ID).Debug("Shutdown() start")
 	defer shimLog.WithField("container", r.ID).Debug("Shutdown() end")
-	span, _ := katatrace.Trace(s.rootCtx, shimLog, "Shutdown", shimTracingTags)
+	span, _ := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Shutdown", shimTracingTags)
+
+	defer s.pendingOps.track("shutdown", r.ID)()
 
 	start := time.Now()
 	defer func() {
@@ -956,9 +1072,11 @@ func (s *service) Shutdown(ctx context.Context, r *taskAPI.ShutdownRequest) (_ *
 func (s *service) Stats(ctx context.Context, r *taskAPI.StatsRequest) (_ *taskAPI.StatsResponse, err error) {
 	shimLog.WithField("container", r.ID).Debug("Stats() start")
 	defer shimLog.WithField("container", r.ID).Debug("Stats() end")
-	span, spanCtx := katatrace.Trace(s.rootCtx, shimLog, "Stats", shimTracingTags)
+	span, spanCtx := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Stats", shimTracingTags)
 	defer span.End()
 
+	defer s.pendingOps.track("stats", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
@@ -973,7 +1091,7 @@ func (s *service) Stats(ctx context.Context, r *taskAPI.StatsRequest) (_ *taskAP
 		return nil, err
 	}
 
-	data, err := marshalMetrics(spanCtx, s, c.id)
+	data, err := marshalMetrics(spanCtx, s, c)
 	if err != nil {
 		return nil, err
 	}
@@ -987,9 +1105,11 @@ func (s *service) Stats(ctx context.Context, r *taskAPI.StatsRequest) (_ *taskAP
 func (s *service) Update(ctx context.Context, r *taskAPI.UpdateTaskRequest) (_ *ptypes.Empty, err error) {
 	shimLog.WithField("container", r.ID).Debug("Update() start")
 	defer shimLog.WithField("container", r.ID).Debug("Update() end")
-	span, spanCtx := katatrace.Trace(s.rootCtx, shimLog, "Update", shimTracingTags)
+	span, spanCtx := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Update", shimTracingTags)
 	defer span.End()
 
+	defer s.pendingOps.track("update", r.ID)()
+
 	start := time.Now()
 	defer func() {
 		err = toGRPC(err)
@@ -1021,10 +1141,10 @@ func (s *service) Update(ctx context.Context, r *taskAPI.UpdateTaskRequest) (_ *
 func (s *service) Wait(ctx context.Context, r *taskAPI.WaitRequest) (_ *taskAPI.WaitResponse, err error) {
 	shimLog.WithField("container", r.ID).Debug("Wait() start")
 	defer shimLog.WithField("container", r.ID).Debug("Wait() end")
-	span, _ := katatrace.Trace(s.rootCtx, shimLog, "Wait", shimTracingTags)
+	span, _ := katatrace.Trace(katatrace.RequestContext(ctx, s.rootCtx), shimLog, "Wait", shimTracingTags)
 	defer span.End()
 
-	var ret uint32
+	defer s.pendingOps.track("wait", r.ID)()
 
 	start := time.Now()
 	defer func() {
@@ -1042,32 +1162,34 @@ func (s *service) Wait(ctx context.Context, r *taskAPI.WaitRequest) (_ *taskAPI.
 
 	//wait for container
 	if r.ExecID == "" {
-		ret = <-c.exitCh
+		<-c.exited
 
-		// refill the exitCh with the container process's exit code in case
-		// there were other waits on this process.
-		c.exitCh <- ret
-	} else { //wait for exec
-		execs, err := c.getExec(r.ExecID)
-		if err != nil {
-			return nil, err
-		}
-		ret = <-execs.exitCh
+		return &taskAPI.WaitResponse{
+			ExitStatus: c.exit,
+			ExitedAt:   c.exitTime,
+		}, nil
+	}
 
-		// refill the exitCh with the exec process's exit code in case
-		// there were other waits on this process.
-		execs.exitCh <- ret
+	//wait for exec
+	s.mu.Lock()
+	execs, err := c.getExec(r.ExecID)
+	s.mu.Unlock()
+
+	if err != nil {
+		return nil, err
 	}
 
+	<-execs.exited
+
 	return &taskAPI.WaitResponse{
-		ExitStatus: ret,
-		ExitedAt:   c.exitTime,
+		ExitStatus: uint32(execs.exitCode),
+		ExitedAt:   execs.exitTime,
 	}, nil
 }
 
 func (s *service) processExits() {
-	for e := range s.ec {
-		s.checkProcesses(e)
+	for {
+		s.checkProcesses(s.exits.pop())
 	}
 }
 
@@ -11,6 +11,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	taskAPI "github.com/containerd/containerd/runtime/v2/task"
 	ktu "github.com/kata-containers/kata-containers/src/runtime/pkg/katatestutils"
@@ -28,7 +29,7 @@ func newService(id string) (*service, error) {
 		ctx:        ctx,
 		containers: make(map[string]*container),
 		events:     make(chan interface{}, chSize),
-		ec:         make(chan exit, bufferSize),
+		exits:      newExitQueue(),
 		cancel:     cancel,
 	}
 
@@ -71,3 +72,47 @@ func TestServiceCreate(t *testing.T) {
 		}
 	}
 }
+
+func TestServiceWait(t *testing.T) {
+	assert := assert.New(t)
+
+	s, err := newService(testSandboxID)
+	assert.NoError(err)
+
+	c, err := newContainer(s, &taskAPI.CreateTaskRequest{ID: testContainerID}, "", nil, true)
+	assert.NoError(err)
+	s.containers[c.id] = c
+
+	e := &exec{
+		container: c,
+		exitCode:  exitCode255,
+		exited:    make(chan struct{}),
+	}
+	c.execs["exec"] = e
+
+	containerExit := time.Now()
+	execExit := containerExit.Add(-time.Second)
+
+	c.setExit(1, containerExit)
+	e.setExit(2, execExit)
+
+	// the first exit recorded is kept
+	c.setExit(exitCode255, time.Now())
+	e.setExit(exitCode255, time.Now())
+
+	resp, err := s.Wait(context.Background(), &taskAPI.WaitRequest{ID: testContainerID})
+	assert.NoError(err)
+	assert.Equal(uint32(1), resp.ExitStatus)
+	assert.Equal(containerExit, resp.ExitedAt)
+
+	// waited twice
+	resp, err = s.Wait(context.Background(), &taskAPI.WaitRequest{ID: testContainerID, ExecID: "exec"})
+	assert.NoError(err)
+	resp, err = s.Wait(context.Background(), &taskAPI.WaitRequest{ID: testContainerID, ExecID: "exec"})
+	assert.NoError(err)
+	assert.Equal(uint32(2), resp.ExitStatus)
+	assert.Equal(execExit, resp.ExitedAt)
+
+	_, err = s.Wait(context.Background(), &taskAPI.WaitRequest{ID: testContainerID, ExecID: "unknown"})
+	assert.Error(err)
+}
@@ -6,6 +6,7 @@
 package containerdshim
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"expvar"
@@ -18,10 +19,12 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 
 	cdshim "github.com/containerd/containerd/runtime/v2/shim"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils/katatrace"
 	mutils "github.com/kata-containers/kata-containers/src/runtime/pkg/utils"
 	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
 	vcAnnotations "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/annotations"
@@ -29,6 +32,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	dto "github.com/prometheus/client_model/go"
 	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/procfs"
 )
 
 const (
@@ -36,6 +40,19 @@ const (
 
 	DirectVolumeStatUrl   = "/direct-volume/stats"
 	DirectVolumeResizeUrl = "/direct-volume/resize"
+
+	DiagnosticsContainerKey = "container"
+
+	DiagnosticsUrl = "/diagnostics"
+
+	AgentLogLevelUrl = "/agent-log-level"
+
+	IPTablesUrl  = "/iptables"
+	IP6TablesUrl = "/ip6tables"
+
+	TracingUrl = "/tracing"
+
+	SandboxInfoUrl = "/sandbox-info"
 )
 
 var (
@@ -48,6 +65,31 @@ type ResizeRequest struct {
 	Size       uint64
 }
 
+type AgentLogLevelRequest struct {
+	Level string
+}
+
+// TracingState is returned by a GET of TracingUrl, and changes the tracing
+// state when POSTed.
+type TracingState struct {
+	Enabled bool
+}
+
+// SandboxInfo is returned by a GET of SandboxInfoUrl.
+type SandboxInfo struct {
+	ID            string
+	HypervisorPid int
+	// VCPUs and MemoryMB are currently allocated to the VM, including the
+	// hotplugged ones
+	VCPUs    uint32
+	MemoryMB uint32
+	// RSSBytes is the resident memory of the hypervisor, virtiofsd and
+	// shim processes
+	RSSBytes uint64
+	// StartTime is the start time of the hypervisor
+	StartTime time.Time
+}
+
 // agentURL returns URL for agent
 func (s *service) agentURL(w http.ResponseWriter, r *http.Request) {
 	url, err := s.sandbox.GetAgentURL()
@@ -60,6 +102,34 @@ func (s *service) agentURL(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprint(w, url)
 }
 
+// agentPolicyHash returns the hash of the active policy of the agent API
+func (s *service) agentPolicyHash(w http.ResponseWriter, r *http.Request) {
+	hash, err := s.sandbox.GetAgentPolicyHash(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	fmt.Fprint(w, hash)
+}
+
+// serveDiagnostics returns the gzip compressed tar archive of the
+// diagnostics of the guest, and of the container given in the query
+func (s *service) serveDiagnostics(w http.ResponseWriter, r *http.Request) {
+	containerID := r.URL.Query().Get(DiagnosticsContainerKey)
+
+	var buf bytes.Buffer
+	if err := s.sandbox.CollectDiagnostics(r.Context(), containerID, &buf); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Write(buf.Bytes())
+}
+
 // serveMetrics handle /metrics requests
 func (s *service) serveMetrics(w http.ResponseWriter, r *http.Request) {
 
@@ -69,6 +139,11 @@ func (s *service) serveMetrics(w http.ResponseWriter, r *http.Request) {
 	// update metrics for shim process
 	updateShimMetrics()
 
+	// update the resource usage of the processes running the sandbox
+	if err := s.updatePodOverheadProcessMetrics(); err != nil {
+		shimMgtLog.WithError(err).Warn("failed to update the pod overhead metrics")
+	}
+
 	// metrics gathered by shim
 	mfs, err := prometheus.DefaultGatherer.Gather()
 	if err != nil {
@@ -195,6 +270,191 @@ func (s *service) serveVolumeResize(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(""))
 }
 
+// serveAgentLogLevel changes the log level of the agent
+func (s *service) serveAgentLogLevel(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		shimMgtLog.WithError(err).Error("failed to read request body")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	var levelReq AgentLogLevelRequest
+	err = json.Unmarshal(body, &levelReq)
+	if err != nil {
+		shimMgtLog.WithError(err).Error("failed to unmarshal the http request body")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	err = s.sandbox.SetAgentLogLevel(r.Context(), levelReq.Level)
+	if err != nil {
+		shimMgtLog.WithError(err).WithField("level", levelReq.Level).Error("failed to set the agent log level")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	w.Write([]byte(""))
+}
+
+// serveIPTables gets (GET) or replaces (PUT) the iptables rules of the
+// guest, or its ip6tables ones on IP6TablesUrl, in the iptables-save format.
+func (s *service) serveIPTables(w http.ResponseWriter, r *http.Request) {
+	isIPv6 := r.URL.Path == IP6TablesUrl
+	logger := shimMgtLog.WithField("ipv6", isIPv6)
+
+	switch r.Method {
+	case http.MethodGet:
+		data, err := s.sandbox.GetIPTables(r.Context(), isIPv6)
+		if err != nil {
+			logger.WithError(err).Error("failed to get the iptables")
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(data)
+
+	case http.MethodPut:
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			logger.WithError(err).Error("failed to read request body")
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		if err := s.sandbox.SetIPTables(r.Context(), isIPv6, body); err != nil {
+			logger.WithError(err).Error("failed to set the iptables")
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write([]byte(""))
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		fmt.Fprintf(w, "method %s not allowed", r.Method)
+	}
+}
+
+// serveTracing returns (GET) or toggles (POST) the tracing of the shim and
+// of the agent, so that traces can be captured without recreating the pod.
+func (s *service) serveTracing(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		json.NewEncoder(w).Encode(TracingState{Enabled: katatrace.IsTracing()})
+
+	case http.MethodPost:
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			shimMgtLog.WithError(err).Error("failed to read request body")
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		var state TracingState
+		if err := json.Unmarshal(body, &state); err != nil {
+			shimMgtLog.WithError(err).Error("failed to unmarshal the http request body")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		if err := s.setTracing(r.Context(), state.Enabled); err != nil {
+			shimMgtLog.WithError(err).WithField("enabled", state.Enabled).Error("failed to toggle tracing")
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write([]byte(""))
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		fmt.Fprintf(w, "method %s not allowed", r.Method)
+	}
+}
+
+// serveSandboxInfo returns the resources allocated to and used by the sandbox
+func (s *service) serveSandboxInfo(w http.ResponseWriter, r *http.Request) {
+	info, err := s.sandboxInfo(r.Context())
+	if err != nil {
+		shimMgtLog.WithError(err).Error("failed to get the sandbox info")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	json.NewEncoder(w).Encode(info)
+}
+
+func (s *service) sandboxInfo(ctx context.Context) (SandboxInfo, error) {
+	info := SandboxInfo{
+		ID:            s.sandbox.ID(),
+		HypervisorPid: int(s.hpid),
+	}
+
+	resources, err := s.sandbox.GetAllocatedResources(ctx)
+	if err != nil {
+		return info, err
+	}
+	info.VCPUs = resources.VCPUs
+	info.MemoryMB = resources.MemoryMB
+
+	overhead, err := s.podOverhead()
+	if err != nil {
+		return info, err
+	}
+	info.RSSBytes = overhead.total().RSSBytes
+
+	proc, err := procfs.NewProc(info.HypervisorPid)
+	if err != nil {
+		return info, err
+	}
+	stat, err := proc.Stat()
+	if err != nil {
+		return info, err
+	}
+	startTime, err := stat.StartTime()
+	if err != nil {
+		return info, err
+	}
+	info.StartTime = time.Unix(0, int64(startTime*float64(time.Second)))
+
+	return info, nil
+}
+
+// setTracing turns the tracing of the shim and of the agent on or off. The
+// agent tracing is stopped first and started last, so that its spans can be
+// linked to the ones of the shim. The tracing is left as it was when the
+// agent one cannot be turned on or off.
+func (s *service) setTracing(ctx context.Context, enable bool) error {
+	if !enable {
+		if err := s.sandbox.SetAgentTracing(ctx, false); err != nil {
+			return fmt.Errorf("failed to stop the agent tracing: %v", err)
+		}
+		katatrace.DisableTracing(ctx)
+		shimMgtLog.Info("tracing disabled")
+		return nil
+	}
+
+	wasTracing := katatrace.IsTracing()
+	err := katatrace.EnableTracing("kata", tracerConfig(s.config))
+	if err != nil {
+		return fmt.Errorf("failed to start the shim tracing: %v", err)
+	}
+
+	if err := s.sandbox.SetAgentTracing(ctx, true); err != nil {
+		if !wasTracing {
+			katatrace.DisableTracing(ctx)
+		}
+		return fmt.Errorf("failed to start the agent tracing: %v", err)
+	}
+
+	shimMgtLog.Info("tracing enabled")
+	return nil
+}
+
 func (s *service) startManagementServer(ctx context.Context, ociSpec *specs.Spec) {
 	// metrics socket will under sandbox's bundle path
 	metricsAddress := SocketAddress(s.id)
@@ -217,12 +477,21 @@ func (s *service) startManagementServer(ctx context.Context, ociSpec *specs.Spec
 	m := http.NewServeMux()
 	m.Handle("/metrics", http.HandlerFunc(s.serveMetrics))
 	m.Handle("/agent-url", http.HandlerFunc(s.agentURL))
+	m.Handle("/agent-policy-hash", http.HandlerFunc(s.agentPolicyHash))
 	m.Handle(DirectVolumeStatUrl, http.HandlerFunc(s.serveVolumeStats))
 	m.Handle(DirectVolumeResizeUrl, http.HandlerFunc(s.serveVolumeResize))
+	m.Handle(DiagnosticsUrl, http.HandlerFunc(s.serveDiagnostics))
+	m.Handle(AgentLogLevelUrl, http.HandlerFunc(s.serveAgentLogLevel))
+	m.Handle(IPTablesUrl, http.HandlerFunc(s.serveIPTables))
+	m.Handle(IP6TablesUrl, http.HandlerFunc(s.serveIPTables))
+	m.Handle(TracingUrl, http.HandlerFunc(s.serveTracing))
+	m.Handle(SandboxInfoUrl, http.HandlerFunc(s.serveSandboxInfo))
+	m.Handle(UpgradeUrl, http.HandlerFunc(s.serveUpgrade))
 	s.mountPprofHandle(m, ociSpec)
 
 	// register shim metrics
 	registerMetrics()
+	prometheus.MustRegister(&podOverheadCPUCollector{s: s})
 
 	// register sandbox metrics
 	vc.RegisterMetrics()
@@ -232,7 +501,7 @@ func (s *service) startManagementServer(ctx context.Context, ociSpec *specs.Spec
 	svr.Serve(listener)
 }
 
-// mountPprofHandle provides a debug endpoint
+// mountPprofHandle provides the debug endpoints, for pprof and the state dump
 func (s *service) mountPprofHandle(m *http.ServeMux, ociSpec *specs.Spec) {
 
 	// return if not enabled
@@ -252,6 +521,7 @@ func (s *service) mountPprofHandle(m *http.ServeMux, ociSpec *specs.Spec) {
 	m.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
 	m.Handle("/debug/pprof/symbol", http.HandlerFunc(pprof.Symbol))
 	m.Handle("/debug/pprof/trace", http.HandlerFunc(pprof.Trace))
+	m.Handle(DebugStateUrl, http.HandlerFunc(s.serveDebugState))
 }
 
 // GetSandboxesStoragePath returns the storage path where sandboxes info are stored
@@ -6,12 +6,19 @@
 package containerdshim
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils/katatrace"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/oci"
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/vcmock"
 
 	"github.com/stretchr/testify/assert"
@@ -61,3 +68,162 @@ go_threads 23
 	body = rr.Body.String()
 	assert.Equal(true, len(strings.Split(body, "\n")) > 0)
 }
+
+func TestServeIPTables(t *testing.T) {
+	assert := assert.New(t)
+
+	rules := map[bool][]byte{}
+	sandbox := &vcmock.Sandbox{
+		MockID: testSandboxID,
+		GetIPTablesFunc: func(isIPv6 bool) ([]byte, error) {
+			return rules[isIPv6], nil
+		},
+		SetIPTablesFunc: func(isIPv6 bool, data []byte) error {
+			if len(data) == 0 {
+				return fmt.Errorf("no rules")
+			}
+			rules[isIPv6] = data
+			return nil
+		},
+	}
+
+	s := &service{
+		id:         testSandboxID,
+		sandbox:    sandbox,
+		containers: make(map[string]*container),
+	}
+
+	for _, d := range []struct {
+		url   string
+		rules string
+	}{
+		{IPTablesUrl, "*filter\n-A INPUT -s 10.0.0.1 -j DROP\nCOMMIT\n"},
+		{IP6TablesUrl, "*filter\n-A INPUT -s fd00::1 -j DROP\nCOMMIT\n"},
+	} {
+		rr := httptest.NewRecorder()
+		s.serveIPTables(rr, httptest.NewRequest(http.MethodPut, d.url, strings.NewReader(d.rules)))
+		assert.Equal(http.StatusOK, rr.Code, d.url)
+
+		rr = httptest.NewRecorder()
+		s.serveIPTables(rr, httptest.NewRequest(http.MethodGet, d.url, nil))
+		assert.Equal(http.StatusOK, rr.Code, d.url)
+		assert.Equal(d.rules, rr.Body.String(), d.url)
+	}
+
+	// each family has its own rules
+	assert.Contains(string(rules[false]), "10.0.0.1")
+	assert.Contains(string(rules[true]), "fd00::1")
+
+	rr := httptest.NewRecorder()
+	s.serveIPTables(rr, httptest.NewRequest(http.MethodPut, IPTablesUrl, strings.NewReader("")))
+	assert.Equal(http.StatusInternalServerError, rr.Code)
+
+	rr = httptest.NewRecorder()
+	s.serveIPTables(rr, httptest.NewRequest(http.MethodPost, IPTablesUrl, nil))
+	assert.Equal(http.StatusMethodNotAllowed, rr.Code)
+}
+
+func TestServeTracing(t *testing.T) {
+	assert := assert.New(t)
+
+	agentTracing := false
+	sandbox := &vcmock.Sandbox{
+		MockID: testSandboxID,
+		SetAgentTracingFunc: func(enable bool) error {
+			agentTracing = enable
+			return nil
+		},
+	}
+
+	s := &service{
+		id:         testSandboxID,
+		sandbox:    sandbox,
+		config:     &oci.RuntimeConfig{},
+		containers: make(map[string]*container),
+	}
+
+	defer katatrace.DisableTracing(context.Background())
+
+	getState := func() TracingState {
+		rr := httptest.NewRecorder()
+		s.serveTracing(rr, httptest.NewRequest(http.MethodGet, TracingUrl, nil))
+		assert.Equal(http.StatusOK, rr.Code)
+
+		var state TracingState
+		assert.NoError(json.Unmarshal(rr.Body.Bytes(), &state))
+		return state
+	}
+
+	assert.False(getState().Enabled)
+
+	for _, enable := range []bool{true, true, false, false} {
+		rr := httptest.NewRecorder()
+		body := fmt.Sprintf(`{"Enabled": %v}`, enable)
+		s.serveTracing(rr, httptest.NewRequest(http.MethodPost, TracingUrl, strings.NewReader(body)))
+		assert.Equal(http.StatusOK, rr.Code, body)
+		assert.Equal(enable, getState().Enabled, body)
+		assert.Equal(enable, katatrace.IsTracing(), body)
+		assert.Equal(enable, agentTracing, body)
+	}
+
+	// the shim tracing is left as it was if the agent one fails
+	sandbox.SetAgentTracingFunc = func(enable bool) error {
+		return fmt.Errorf("agent tracing not supported")
+	}
+	rr := httptest.NewRecorder()
+	s.serveTracing(rr, httptest.NewRequest(http.MethodPost, TracingUrl, strings.NewReader(`{"Enabled": true}`)))
+	assert.Equal(http.StatusInternalServerError, rr.Code)
+	assert.False(katatrace.IsTracing())
+	assert.False(getState().Enabled)
+
+	rr = httptest.NewRecorder()
+	s.serveTracing(rr, httptest.NewRequest(http.MethodPost, TracingUrl, strings.NewReader("{")))
+	assert.Equal(http.StatusBadRequest, rr.Code)
+
+	rr = httptest.NewRecorder()
+	s.serveTracing(rr, httptest.NewRequest(http.MethodPut, TracingUrl, nil))
+	assert.Equal(http.StatusMethodNotAllowed, rr.Code)
+}
+
+func TestServeSandboxInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	sandbox := &vcmock.Sandbox{
+		MockID: testSandboxID,
+		GetAllocatedResourcesFunc: func() (vc.AllocatedResources, error) {
+			return vc.AllocatedResources{VCPUs: 2, MemoryMB: 2048}, nil
+		},
+		OverheadStatsFunc: func() (vc.OverheadStats, error) {
+			return vc.OverheadStats{Hypervisor: vc.ProcessUsage{RSSBytes: 1 << 30}}, nil
+		},
+	}
+
+	s := &service{
+		id:         testSandboxID,
+		sandbox:    sandbox,
+		containers: make(map[string]*container),
+		hpid:       uint32(os.Getpid()),
+	}
+
+	rr := httptest.NewRecorder()
+	s.serveSandboxInfo(rr, httptest.NewRequest(http.MethodGet, SandboxInfoUrl, nil))
+	assert.Equal(http.StatusOK, rr.Code)
+
+	var info SandboxInfo
+	assert.NoError(json.Unmarshal(rr.Body.Bytes(), &info))
+	assert.Equal(testSandboxID, info.ID)
+	assert.Equal(os.Getpid(), info.HypervisorPid)
+	assert.Equal(uint32(2), info.VCPUs)
+	assert.Equal(uint32(2048), info.MemoryMB)
+	// the shim is the test process
+	assert.True(info.RSSBytes > 1<<30)
+	assert.True(info.StartTime.Before(time.Now()))
+	assert.False(info.StartTime.IsZero())
+
+	sandbox.GetAllocatedResourcesFunc = func() (vc.AllocatedResources, error) {
+		return vc.AllocatedResources{}, fmt.Errorf("hypervisor not running")
+	}
+	rr = httptest.NewRecorder()
+	s.serveSandboxInfo(rr, httptest.NewRequest(http.MethodGet, SandboxInfoUrl, nil))
+	assert.Equal(http.StatusInternalServerError, rr.Code)
+}
@@ -82,6 +82,30 @@ var (
 		Name:      "pod_overhead_memory_in_bytes",
 		Help:      "Kata Pod overhead for memory resources(bytes).",
 	})
+
+	katashimPodOverheadProcessRSS = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespaceKatashim,
+		Name:      "pod_overhead_process_rss_bytes",
+		Help:      "Resident memory of the host processes running the sandbox.",
+	},
+		[]string{"component"},
+	)
+
+	katashimPodOverheadProcessThreads = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespaceKatashim,
+		Name:      "pod_overhead_process_threads",
+		Help:      "Threads of the host processes running the sandbox.",
+	},
+		[]string{"component"},
+	)
+
+	katashimPodOverheadProcessFDs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespaceKatashim,
+		Name:      "pod_overhead_process_fds",
+		Help:      "Open FDs of the host processes running the sandbox.",
+	},
+		[]string{"component"},
+	)
 )
 
 func registerMetrics() {
@@ -94,6 +118,9 @@ func registerMetrics() {
 	prometheus.MustRegister(katashimOpenFDs)
 	prometheus.MustRegister(katashimPodOverheadCPU)
 	prometheus.MustRegister(katashimPodOverheadMemory)
+	prometheus.MustRegister(katashimPodOverheadProcessRSS)
+	prometheus.MustRegister(katashimPodOverheadProcessThreads)
+	prometheus.MustRegister(katashimPodOverheadProcessFDs)
 }
 
 // updateShimMetrics will update metrics for kata shim process itself
@@ -209,3 +236,118 @@ func (s *service) setPodOverheadMetrics(ctx context.Context) error {
 	katashimPodOverheadCPU.Set(cpu)
 	return nil
 }
+
+// podOverheadStats is the host resource usage of the processes running the
+// sandbox: its hypervisor, virtiofsd daemons and shim.
+type podOverheadStats struct {
+	vc.OverheadStats
+	Shim vc.ProcessUsage
+}
+
+func (o podOverheadStats) total() vc.ProcessUsage {
+	var total vc.ProcessUsage
+	total.Add(o.Hypervisor)
+	total.Add(o.Virtiofsd)
+	total.Add(o.Shim)
+
+	return total
+}
+
+// guestExcluded returns the usage of the processes running the sandbox on top
+// of the one of the guest, which the stats of the containers account for:
+// the hypervisor without its vCPU time and memory, which holds the guest
+// memory, and the virtiofsd daemons and shim.
+func (o podOverheadStats) guestExcluded() vc.ProcessUsage {
+	hypervisor := o.Hypervisor
+	if hypervisor.GuestNanoseconds < hypervisor.UserNanoseconds {
+		hypervisor.UserNanoseconds -= hypervisor.GuestNanoseconds
+	} else {
+		hypervisor.UserNanoseconds = 0
+	}
+	hypervisor.GuestNanoseconds = 0
+	hypervisor.RSSBytes = 0
+
+	var usage vc.ProcessUsage
+	usage.Add(hypervisor)
+	usage.Add(o.Virtiofsd)
+	usage.Add(o.Shim)
+
+	return usage
+}
+
+func (s *service) podOverhead() (podOverheadStats, error) {
+	overhead, err := s.sandbox.OverheadStats()
+	if err != nil {
+		return podOverheadStats{}, err
+	}
+
+	proc, err := procfs.Self()
+	if err != nil {
+		return podOverheadStats{}, err
+	}
+
+	shim, err := vc.ProcessUsageOf(proc)
+	if err != nil {
+		return podOverheadStats{}, err
+	}
+
+	return podOverheadStats{
+		OverheadStats: overhead,
+		Shim:          shim,
+	}, nil
+}
+
+// updatePodOverheadProcessMetrics updates the metrics of the resource usage
+// of the processes running the sandbox.
+func (s *service) updatePodOverheadProcessMetrics() error {
+	overhead, err := s.podOverhead()
+	if err != nil {
+		return err
+	}
+
+	for component, usage := range map[string]vc.ProcessUsage{
+		"hypervisor": overhead.Hypervisor,
+		"virtiofsd":  overhead.Virtiofsd,
+		"shim":       overhead.Shim,
+	} {
+		katashimPodOverheadProcessRSS.WithLabelValues(component).Set(float64(usage.RSSBytes))
+		katashimPodOverheadProcessThreads.WithLabelValues(component).Set(float64(usage.Threads))
+		katashimPodOverheadProcessFDs.WithLabelValues(component).Set(float64(usage.FDs))
+	}
+
+	return nil
+}
+
+var podOverheadProcessCPUDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespaceKatashim, "", "pod_overhead_process_cpu_seconds_total"),
+	"CPU time used by the host processes running the sandbox.",
+	[]string{"component", "mode"},
+	nil,
+)
+
+// podOverheadCPUCollector collects the CPU time used by the processes running
+// the sandbox, read from their stats at each scrape.
+type podOverheadCPUCollector struct {
+	s *service
+}
+
+func (c *podOverheadCPUCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- podOverheadProcessCPUDesc
+}
+
+func (c *podOverheadCPUCollector) Collect(ch chan<- prometheus.Metric) {
+	overhead, err := c.s.podOverhead()
+	if err != nil {
+		shimMgtLog.WithError(err).Warn("failed to get the pod overhead CPU time")
+		return
+	}
+
+	for component, usage := range map[string]vc.ProcessUsage{
+		"hypervisor": overhead.Hypervisor,
+		"virtiofsd":  overhead.Virtiofsd,
+		"shim":       overhead.Shim,
+	} {
+		ch <- prometheus.MustNewConstMetric(podOverheadProcessCPUDesc, prometheus.CounterValue, float64(usage.UserNanoseconds)/1e9, component, "user")
+		ch <- prometheus.MustNewConstMetric(podOverheadProcessCPUDesc, prometheus.CounterValue, float64(usage.SystemNanoseconds)/1e9, component, "system")
+	}
+}
@@ -7,11 +7,14 @@ package containerdshim
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/vcmock"
 
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -113,3 +116,43 @@ func TestStatsSandbox(t *testing.T) {
 	//       = 50000
 	assert.Equal(float64(50000), mem)
 }
+
+func TestUpdatePodOverheadProcessMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	sandbox := &vcmock.Sandbox{
+		MockID: testSandboxID,
+	}
+	sandbox.OverheadStatsFunc = func() (vc.OverheadStats, error) {
+		return vc.OverheadStats{
+			Hypervisor: vc.ProcessUsage{RSSBytes: 100, Threads: 8, FDs: 42},
+			Virtiofsd:  vc.ProcessUsage{RSSBytes: 10, Threads: 4, FDs: 12},
+		}, nil
+	}
+
+	s := &service{
+		id:      testSandboxID,
+		sandbox: sandbox,
+	}
+
+	assert.NoError(s.updatePodOverheadProcessMetrics())
+
+	gaugeValue := func(vec *prometheus.GaugeVec, component string) float64 {
+		var m dto.Metric
+		assert.NoError(vec.WithLabelValues(component).Write(&m))
+		return m.GetGauge().GetValue()
+	}
+
+	assert.Equal(float64(8), gaugeValue(katashimPodOverheadProcessThreads, "hypervisor"))
+	assert.Equal(float64(4), gaugeValue(katashimPodOverheadProcessThreads, "virtiofsd"))
+	assert.NotZero(gaugeValue(katashimPodOverheadProcessThreads, "shim"))
+	assert.Equal(float64(42), gaugeValue(katashimPodOverheadProcessFDs, "hypervisor"))
+	assert.Equal(float64(12), gaugeValue(katashimPodOverheadProcessFDs, "virtiofsd"))
+	assert.NotZero(gaugeValue(katashimPodOverheadProcessFDs, "shim"))
+	assert.Equal(float64(100), gaugeValue(katashimPodOverheadProcessRSS, "hypervisor"))
+
+	sandbox.OverheadStatsFunc = func() (vc.OverheadStats, error) {
+		return vc.OverheadStats{}, errors.New("no hypervisor process")
+	}
+	assert.Error(s.updatePodOverheadProcessMetrics())
+}
@@ -8,6 +8,8 @@ package containerdshim
 import (
 	"context"
 	"fmt"
+	"time"
+
 	"github.com/sirupsen/logrus"
 
 	"github.com/containerd/containerd/api/types/task"
@@ -18,8 +20,8 @@ func startContainer(ctx context.Context, s *service, c *container) (retErr error
 	shimLog.WithField("container", c.id).Debug("start container")
 	defer func() {
 		if retErr != nil {
-			// notify the wait goroutine to continue
-			c.exitCh <- exitCode255
+			// notify the waiters of the container
+			c.setExit(exitCode255, time.Now())
 		}
 	}()
 	// start a container
@@ -36,18 +38,14 @@ func startContainer(ctx context.Context, s *service, c *container) (retErr error
 	if c.cType.IsSandbox() {
 		err := s.sandbox.Start(ctx)
 		if err != nil {
+			s.recordEvent(SandboxStartFailedEvent, map[string]string{"error": err.Error()})
 			return err
 		}
+		s.recordEvent(SandboxStartedEvent, nil)
 		// Start monitor after starting sandbox
-		s.monitor, err = s.sandbox.Monitor(ctx)
-		if err != nil {
+		if err := startSandboxWatchers(ctx, s); err != nil {
 			return err
 		}
-		go watchSandbox(ctx, s)
-
-		// We use s.ctx(`ctx` derived from `s.ctx`) to check for cancellation of the
-		// shim context and the context passed to startContainer for tracing.
-		go watchOOMEvents(ctx, s)
 	} else {
 		_, err := s.sandbox.StartContainer(ctx, c.id)
 		if err != nil {
@@ -67,6 +65,31 @@ func startContainer(ctx context.Context, s *service, c *container) (retErr error
 
 	c.status = task.StatusRunning
 
+	return startContainerIO(ctx, s, c)
+}
+
+// startSandboxWatchers starts the monitor of the running sandbox and the
+// watchers of its events.
+func startSandboxWatchers(ctx context.Context, s *service) (err error) {
+	s.monitor, err = s.sandbox.Monitor(ctx)
+	if err != nil {
+		return err
+	}
+	go watchSandbox(ctx, s)
+
+	// We use s.ctx(`ctx` derived from `s.ctx`) to check for cancellation of the
+	// shim context and the context passed to startContainer for tracing.
+	go watchOOMEvents(ctx, s)
+	go watchVolumes(ctx, s)
+	go watchGuestTime(ctx, s)
+	go watchGuestKernelLog(ctx, s)
+
+	return nil
+}
+
+// startContainerIO copies the IO streams of the running container and waits
+// for it to exit.
+func startContainerIO(ctx context.Context, s *service, c *container) error {
 	stdin, stdout, stderr, err := s.sandbox.IOStream(c.id, c.id)
 	if err != nil {
 		return err
@@ -114,8 +137,8 @@ func startExec(ctx context.Context, s *service, containerID, execID string) (e *
 
 	defer func() {
 		if retErr != nil {
-			// notify the wait goroutine to continue
-			execs.exitCh <- exitCode255
+			// notify the waiters of the exec
+			execs.setExit(exitCode255, time.Now())
 		}
 	}()
 
@@ -152,6 +175,8 @@ func startExec(ctx context.Context, s *service, containerID, execID string) (e *
 		"exec":      execID,
 	}), execs.exitIOch, execs.stdinCloser, tty, stdin, stdout, stderr)
 
+	// the exit of the container is published after the one of the exec
+	s.exits.execStarted(c.id)
 	go wait(ctx, s, c, execID)
 
 	return execs, nil
@@ -0,0 +1,389 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	sysexec "os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/containerd/containerd/api/types/task"
+	cdshim "github.com/containerd/containerd/runtime/v2/shim"
+	taskAPI "github.com/containerd/containerd/runtime/v2/task"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils"
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/compatoci"
+)
+
+const (
+	UpgradeUrl = "/upgrade"
+
+	// HandoverStateEnv is set for a shim started to adopt the sandbox of a
+	// running shim, to the path of the state handed over.
+	HandoverStateEnv = "KATA_SHIM_HANDOVER_STATE"
+
+	handoverStateFile = "shim-handover.json"
+
+	// The shim adopting the sandbox serves the task API on the listener
+	// inherited as fd 3, as a shim started by containerd does, and reports
+	// the adoption on fd 4.
+	handoverReadyFd = 4
+
+	handoverReady = "ready"
+
+	handoverTimeout = 60 * time.Second
+)
+
+// shimHandover is the state a shim hands over, along with the persisted
+// sandbox state, to the shim adopting its sandbox.
+type shimHandover struct {
+	SandboxID     string              `json:"sandbox_id"`
+	ConfigPath    string              `json:"config_path"`
+	Containers    []containerHandover `json:"containers"`
+	HypervisorPID uint32              `json:"hypervisor_pid"`
+}
+
+type containerHandover struct {
+	ExitTime time.Time        `json:"exit_time"`
+	ID       string           `json:"id"`
+	Bundle   string           `json:"bundle"`
+	Stdin    string           `json:"stdin"`
+	Stdout   string           `json:"stdout"`
+	Stderr   string           `json:"stderr"`
+	Type     vc.ContainerType `json:"type"`
+	Status   task.Status      `json:"status"`
+	Exit     uint32           `json:"exit"`
+	Terminal bool             `json:"terminal"`
+	Mounted  bool             `json:"mounted"`
+}
+
+// serveUpgrade hands the sandbox over to a shim started from the shim binary
+// currently installed, and exits once that shim has adopted it. containerd
+// must be stopped meanwhile, as it cleans up the tasks of a shim it loses the
+// connection to: it reconnects to the new shim once started again.
+func (s *service) serveUpgrade(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	// The task API calls are blocked from now on, and served by the new
+	// shim once the sandbox is handed over.
+	s.mu.Lock()
+
+	if err := s.handover(); err != nil {
+		s.mu.Unlock()
+		shimMgtLog.WithError(err).Error("failed to hand the sandbox over")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	shimMgtLog.Info("sandbox handed over, exiting")
+	w.Header().Set("Content-Length", "0")
+	w.WriteHeader(http.StatusOK)
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	// The hypervisor and virtiofs daemons started by this shim are
+	// reparented to init, not to the new shim which is their sibling:
+	// init reaps them, and the new shim watches them through pidfds.
+	os.Exit(0)
+}
+
+// handover starts a shim adopting the sandbox and waits for it to report the
+// adoption.
+func (s *service) handover() error {
+	if s.sandbox == nil {
+		return fmt.Errorf("no sandbox to hand over")
+	}
+
+	state := shimHandover{
+		SandboxID:     s.id,
+		ConfigPath:    s.configPath,
+		HypervisorPID: s.hpid,
+	}
+
+	for _, c := range s.containers {
+		for execID, e := range c.execs {
+			if e.status != task.StatusStopped {
+				return fmt.Errorf("exec process %s of container %s cannot be handed over", execID, c.id)
+			}
+		}
+
+		state.Containers = append(state.Containers, containerHandover{
+			ID:       c.id,
+			Bundle:   c.bundle,
+			Stdin:    c.stdin,
+			Stdout:   c.stdout,
+			Stderr:   c.stderr,
+			Terminal: c.terminal,
+			Type:     c.cType,
+			Status:   c.status,
+			Exit:     c.exit,
+			ExitTime: c.exitTime,
+			Mounted:  c.mounted,
+		})
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	statePath := filepath.Join(cwd, handoverStateFile)
+	if err := os.WriteFile(statePath, data, 0600); err != nil {
+		return err
+	}
+	defer os.Remove(statePath)
+
+	listener, err := taskListener()
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	readyReader, readyWriter, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	defer readyReader.Close()
+
+	// The binary the shim was started from is replaced by the upgrade
+	self, err := os.Executable()
+	if err != nil {
+		readyWriter.Close()
+		return err
+	}
+
+	cmd := sysexec.Command(self, os.Args[1:]...)
+	cmd.Dir = cwd
+	cmd.Env = append(os.Environ(), HandoverStateEnv+"="+statePath)
+	// stderr is the log fifo
+	cmd.Stderr = os.Stderr
+	cmd.ExtraFiles = []*os.File{listener, readyWriter}
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setpgid: true,
+	}
+
+	err = cmd.Start()
+	readyWriter.Close()
+	if err != nil {
+		return err
+	}
+	go cmd.Wait()
+
+	result := make(chan string, 1)
+	go func() {
+		msg, _ := io.ReadAll(readyReader)
+		result <- string(msg)
+	}()
+
+	select {
+	case msg := <-result:
+		if msg != handoverReady {
+			cmd.Process.Kill()
+			return fmt.Errorf("shim %d failed to adopt the sandbox: %s", cmd.Process.Pid, msg)
+		}
+	case <-time.After(handoverTimeout):
+		cmd.Process.Kill()
+		return fmt.Errorf("shim %d did not adopt the sandbox within %v", cmd.Process.Pid, handoverTimeout)
+	}
+
+	shimLog.WithField("shim-pid", cmd.Process.Pid).Info("sandbox adopted")
+
+	return nil
+}
+
+// taskListener returns a duplicate of the socket the task API is served on,
+// the one listening on the address published to containerd.
+func taskListener() (*os.File, error) {
+	address, err := cdshim.ReadAddress("address")
+	if err != nil {
+		return nil, err
+	}
+	path := strings.TrimPrefix(address, "unix://")
+
+	entries, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range entries {
+		fd, err := strconv.Atoi(e.Name())
+		if err != nil {
+			continue
+		}
+
+		sa, err := unix.Getsockname(fd)
+		if err != nil {
+			continue
+		}
+		if addr, ok := sa.(*unix.SockaddrUnix); !ok || addr.Name != path {
+			continue
+		}
+
+		// the connections accepted have the same address
+		if listening, err := unix.GetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_ACCEPTCONN); err != nil || listening != 1 {
+			continue
+		}
+
+		dup, err := unix.Dup(fd)
+		if err != nil {
+			return nil, err
+		}
+
+		return os.NewFile(uintptr(dup), path), nil
+	}
+
+	return nil, fmt.Errorf("no socket listening on the task API address %s", address)
+}
+
+// completeHandover adopts the sandbox handed over by the shim this one is
+// started from, and reports the adoption to it.
+func (s *service) completeHandover(statePath string) error {
+	ready := os.NewFile(handoverReadyFd, "handover-ready")
+	defer ready.Close()
+
+	spec, err := s.adoptSandbox(statePath)
+	if err != nil {
+		ready.WriteString(err.Error())
+		return err
+	}
+
+	if _, err := ready.WriteString(handoverReady); err != nil {
+		return err
+	}
+
+	if err := cdshim.WritePidFile("shim.pid", os.Getpid()); err != nil {
+		shimLog.WithError(err).Warn("failed to write the shim pid file")
+	}
+
+	// The shim handing over keeps serving its management socket until it
+	// exits, the socket being replaced meanwhile.
+	if err := cdshim.RemoveSocket(SocketAddress(s.id)); err != nil {
+		shimLog.WithError(err).Warn("failed to remove the management socket")
+	}
+	if defaultStartManagementServerFunc != nil {
+		defaultStartManagementServerFunc(s, s.ctx, spec)
+	}
+
+	return nil
+}
+
+// adoptSandbox restores the sandbox and its containers from the state handed
+// over, and resumes the IO of the running containers. It returns the spec of
+// the sandbox container.
+func (s *service) adoptSandbox(statePath string) (*specs.Spec, error) {
+	data, err := os.ReadFile(statePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var state shimHandover
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+
+	if state.SandboxID != s.id {
+		return nil, fmt.Errorf("sandbox %s handed over to the shim of sandbox %s", state.SandboxID, s.id)
+	}
+
+	_, runtimeConfig, err := katautils.LoadConfiguration(state.ConfigPath, false)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.config = &runtimeConfig
+	s.configPath = state.ConfigPath
+	s.rootCtx = s.ctx
+
+	s.sandbox, err = vci.AdoptSandbox(vc.WithLifecycleEventHandler(s.ctx, s.sendVMEvent), state.SandboxID)
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := s.sandbox.GetHypervisorPid()
+	if err != nil {
+		return nil, err
+	}
+	if uint32(pid) != state.HypervisorPID {
+		return nil, fmt.Errorf("hypervisor pid %d of the sandbox differs from the one handed over %d", pid, state.HypervisorPID)
+	}
+	s.hpid = uint32(pid)
+
+	var sandboxContainer *container
+	for _, ch := range state.Containers {
+		spec, err := compatoci.ParseConfigJSON(ch.Bundle)
+		if err != nil {
+			return nil, err
+		}
+
+		c, err := newContainer(s, &taskAPI.CreateTaskRequest{
+			ID:       ch.ID,
+			Bundle:   ch.Bundle,
+			Stdin:    ch.Stdin,
+			Stdout:   ch.Stdout,
+			Stderr:   ch.Stderr,
+			Terminal: ch.Terminal,
+		}, ch.Type, &spec, ch.Mounted)
+		if err != nil {
+			return nil, err
+		}
+
+		c.status = ch.Status
+		c.exit = ch.Exit
+		c.exitTime = ch.ExitTime
+		s.containers[c.id] = c
+
+		if c.cType.IsSandbox() {
+			sandboxContainer = c
+		}
+	}
+
+	if sandboxContainer == nil {
+		return nil, fmt.Errorf("sandbox container of sandbox %s not handed over", s.id)
+	}
+
+	if sandboxContainer.status == task.StatusRunning {
+		if err := startSandboxWatchers(s.ctx, s); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, c := range s.containers {
+		switch c.status {
+		case task.StatusRunning:
+			if err := startContainerIO(s.ctx, s, c); err != nil {
+				return nil, err
+			}
+		case task.StatusStopped:
+			close(c.exited)
+		}
+	}
+
+	return sandboxContainer.spec, nil
+}
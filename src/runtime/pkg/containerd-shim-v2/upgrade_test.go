@@ -0,0 +1,156 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/containerd/api/types/task"
+	taskAPI "github.com/containerd/containerd/runtime/v2/task"
+	"github.com/stretchr/testify/assert"
+
+	ktu "github.com/kata-containers/kata-containers/src/runtime/pkg/katatestutils"
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/vcmock"
+)
+
+func TestHandoverNoSandbox(t *testing.T) {
+	s := &service{
+		id:         testSandboxID,
+		containers: make(map[string]*container),
+	}
+
+	assert.Error(t, s.handover())
+}
+
+func TestHandoverRunningExec(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &service{
+		id:         testSandboxID,
+		sandbox:    &vcmock.Sandbox{MockID: testSandboxID},
+		containers: make(map[string]*container),
+	}
+
+	c, err := newContainer(s, &taskAPI.CreateTaskRequest{ID: testSandboxID}, vc.PodSandbox, nil, false)
+	assert.NoError(err)
+	c.status = task.StatusRunning
+	c.execs["exec"] = &exec{status: task.StatusRunning}
+	s.containers[c.id] = c
+
+	err = s.handover()
+	assert.Error(err)
+	assert.Contains(err.Error(), "cannot be handed over")
+}
+
+func writeHandoverState(t *testing.T, state shimHandover) string {
+	data, err := json.Marshal(state)
+	assert.NoError(t, err)
+
+	statePath := filepath.Join(t.TempDir(), handoverStateFile)
+	assert.NoError(t, os.WriteFile(statePath, data, testFileMode))
+
+	return statePath
+}
+
+func TestAdoptSandbox(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpdir, bundlePath, _ := ktu.SetupOCIConfigFile(t)
+
+	configPath, err := createAllRuntimeConfigFiles(tmpdir, "qemu")
+	assert.NoError(err)
+
+	testingImpl.AdoptSandboxFunc = func(ctx context.Context, sandboxID string) (vc.VCSandbox, error) {
+		return &vcmock.Sandbox{MockID: sandboxID}, nil
+	}
+	defer func() {
+		testingImpl.AdoptSandboxFunc = nil
+	}()
+
+	statePath := writeHandoverState(t, shimHandover{
+		SandboxID:  testSandboxID,
+		ConfigPath: configPath,
+		Containers: []containerHandover{
+			{
+				ID:     testSandboxID,
+				Bundle: bundlePath,
+				Type:   vc.PodSandbox,
+				Status: task.StatusCreated,
+			},
+			{
+				ID:     testContainerID,
+				Bundle: bundlePath,
+				Type:   vc.PodContainer,
+				Status: task.StatusStopped,
+				Exit:   3,
+			},
+		},
+	})
+
+	s := &service{
+		id:         testSandboxID,
+		ctx:        context.Background(),
+		containers: make(map[string]*container),
+	}
+
+	spec, err := s.adoptSandbox(statePath)
+	assert.NoError(err)
+	assert.NotNil(spec)
+	assert.NotNil(s.sandbox)
+	assert.Equal(configPath, s.configPath)
+	assert.Len(s.containers, 2)
+
+	c, err := s.getContainer(testSandboxID)
+	assert.NoError(err)
+	assert.Equal(task.StatusCreated, c.status)
+
+	c, err = s.getContainer(testContainerID)
+	assert.NoError(err)
+	assert.Equal(task.StatusStopped, c.status)
+	<-c.exited
+	assert.Equal(uint32(3), c.exit)
+}
+
+func TestAdoptSandboxMismatch(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpdir := t.TempDir()
+	configPath, err := createAllRuntimeConfigFiles(tmpdir, "qemu")
+	assert.NoError(err)
+
+	testingImpl.AdoptSandboxFunc = func(ctx context.Context, sandboxID string) (vc.VCSandbox, error) {
+		return &vcmock.Sandbox{MockID: sandboxID}, nil
+	}
+	defer func() {
+		testingImpl.AdoptSandboxFunc = nil
+	}()
+
+	s := &service{
+		id:         testSandboxID,
+		ctx:        context.Background(),
+		containers: make(map[string]*container),
+	}
+
+	// handed over to the shim of another sandbox
+	_, err = s.adoptSandbox(writeHandoverState(t, shimHandover{
+		SandboxID:  "other",
+		ConfigPath: configPath,
+	}))
+	assert.Error(err)
+
+	// the hypervisor of the sandbox restored is not the one handed over
+	_, err = s.adoptSandbox(writeHandoverState(t, shimHandover{
+		SandboxID:     testSandboxID,
+		ConfigPath:    configPath,
+		HypervisorPID: 1234,
+	}))
+	assert.Error(err)
+}
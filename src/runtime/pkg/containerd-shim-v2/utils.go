@@ -11,7 +11,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"time"
 
 	"github.com/containerd/containerd/mount"
 	cdshim "github.com/containerd/containerd/runtime/v2/shim"
@@ -21,16 +20,6 @@ import (
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/compatoci"
 )
 
-func cReap(s *service, status int, id, execid string, exitat time.Time) {
-	s.ec <- exit{
-		timestamp: exitat,
-		pid:       s.hpid,
-		status:    status,
-		id:        id,
-		execid:    execid,
-	}
-}
-
 func cleanupContainer(ctx context.Context, sandboxID, cid, bundlePath string) error {
 	shimLog.WithField("service", "cleanup").WithField("container", cid).Info("Cleanup container")
 
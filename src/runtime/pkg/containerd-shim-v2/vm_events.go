@@ -0,0 +1,52 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"time"
+
+	"github.com/containerd/typeurl"
+
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
+)
+
+const (
+	// The VM events are published on the topic of their type, for instance
+	// /kata/vm/hypervisor-started.
+	vmEventTopicPrefix = "/kata/vm/"
+
+	// GuestOOMEvent is published when the agent reports an OOM kill in the
+	// guest, along with the TaskOOM event of the container.
+	GuestOOMEvent vc.LifecycleEventType = "guest-oom"
+)
+
+// VMEvent is published on the containerd event bus on the transitions of the
+// lifecycle of the VM of the sandbox. It is marshaled as JSON, with the
+// io.katacontainers.events.VMEvent type URL.
+type VMEvent struct {
+	Timestamp  time.Time         `json:"timestamp"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	SandboxID  string            `json:"sandbox_id"`
+	Type       string            `json:"type"`
+}
+
+func init() {
+	typeurl.Register(&VMEvent{}, "io.katacontainers.events.VMEvent")
+}
+
+// sendVMEvent is the lifecycle event handler of the sandbox. The events are
+// also recorded in the journal of the sandbox.
+func (s *service) sendVMEvent(e vc.LifecycleEvent) {
+	event := &VMEvent{
+		Timestamp:  e.Timestamp,
+		Attributes: e.Attributes,
+		SandboxID:  e.SandboxID,
+		Type:       string(e.Type),
+	}
+
+	s.journalEvent(event)
+	s.send(event)
+}
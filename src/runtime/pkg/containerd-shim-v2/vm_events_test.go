@@ -0,0 +1,47 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package containerdshim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/containerd/typeurl"
+	"github.com/stretchr/testify/assert"
+
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
+)
+
+func TestSendVMEvent(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &service{
+		id:     testSandboxID,
+		events: make(chan interface{}, 1),
+	}
+
+	s.sendVMEvent(vc.LifecycleEvent{
+		Timestamp:  time.Now(),
+		Attributes: map[string]string{"pid": "1234"},
+		SandboxID:  testSandboxID,
+		Type:       vc.HypervisorStartedEvent,
+	})
+
+	e := <-s.events
+	assert.Equal("/kata/vm/hypervisor-started", getTopic(e))
+
+	data, err := typeurl.MarshalAny(e)
+	assert.NoError(err)
+	assert.Equal("io.katacontainers.events.VMEvent", data.TypeUrl)
+
+	v, err := typeurl.UnmarshalAny(data)
+	assert.NoError(err)
+	evt, ok := v.(*VMEvent)
+	assert.True(ok)
+	assert.Equal(testSandboxID, evt.SandboxID)
+	assert.Equal(string(vc.HypervisorStartedEvent), evt.Type)
+	assert.Equal("1234", evt.Attributes["pid"])
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"os"
 	"path"
+	"strconv"
 	"time"
 
 	"github.com/containerd/containerd/api/events"
@@ -17,9 +18,20 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/kata-containers/kata-containers/src/runtime/pkg/oci"
+	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
 )
 
-const defaultCheckInterval = 1 * time.Second
+const (
+	defaultCheckInterval = 1 * time.Second
+
+	// volumeCheckInterval is the interval at which the sizes of the block
+	// devices backing the volumes are checked.
+	volumeCheckInterval = 10 * time.Second
+
+	// guestTimeCheckInterval is the interval at which the host is checked
+	// for having resumed from suspend, which leaves the guest clock late.
+	guestTimeCheckInterval = 5 * time.Second
+)
 
 func wait(ctx context.Context, s *service, c *container, execID string) (int32, error) {
 	var execs *exec
@@ -59,53 +71,78 @@ func wait(ctx context.Context, s *service, c *container, execID string) (int32,
 
 	s.mu.Lock()
 	if execID == "" {
-		// Take care of the use case where it is a sandbox.
-		// Right after the container representing the sandbox has
-		// been deleted, let's make sure we stop and delete the
-		// sandbox.
-
-		if c.cType.IsSandbox() {
-			// cancel watcher
-			if s.monitor != nil {
-				shimLog.WithField("sandbox", s.sandbox.ID()).Info("cancel watcher")
-				s.monitor <- nil
-			}
-			if err = s.sandbox.Stop(ctx, true); err != nil {
-				shimLog.WithField("sandbox", s.sandbox.ID()).Error("failed to stop sandbox")
-			}
+		if s.config != nil && s.config.CrashDiagnosticsDir != "" && dumpedCore(ret) {
+			// The container is stopped once its diagnostics are
+			// collected, for its exit not to wait for them.
+			c.diagnosed = make(chan struct{})
+			go func() {
+				defer close(c.diagnosed)
+				collectCrashDiagnostics(ctx, s, c.id)
 
-			if err = s.sandbox.Delete(ctx); err != nil {
-				shimLog.WithField("sandbox", s.sandbox.ID()).Error("failed to delete sandbox")
-			}
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				stopExitedContainer(ctx, s, c, ret)
+			}()
 		} else {
-			if _, err = s.sandbox.StopContainer(ctx, c.id, false); err != nil {
-				shimLog.WithError(err).WithField("container", c.id).Warn("stop container failed")
-			}
+			stopExitedContainer(ctx, s, c, ret)
 		}
 		c.status = task.StatusStopped
-		c.exit = uint32(ret)
-		c.exitTime = timeStamp
-
-		c.exitCh <- uint32(ret)
+		c.setExit(uint32(ret), timeStamp)
 		shimLog.WithField("container", c.id).Debug("The container status is StatusStopped")
 	} else {
 		execs.status = task.StatusStopped
-		execs.exitCode = ret
-		execs.exitTime = timeStamp
-
-		execs.exitCh <- uint32(ret)
+		execs.setExit(ret, timeStamp)
 		shimLog.WithFields(logrus.Fields{
 			"container": c.id,
 			"exec":      execID,
 		}).Debug("The container exec status is StatusStopped")
 	}
-	s.mu.Unlock()
 
-	go cReap(s, int(ret), c.id, execID, timeStamp)
+	// recorded along with the exit, for the exits to be published in the
+	// order they are recorded
+	s.exits.push(exit{
+		timestamp: timeStamp,
+		pid:       s.hpid,
+		status:    int(ret),
+		id:        c.id,
+		execid:    execID,
+	})
+	s.mu.Unlock()
 
 	return ret, nil
 }
 
+// stopExitedContainer stops a container whose process exited, and the
+// sandbox with its sandbox container. It must be called with s.mu held.
+func stopExitedContainer(ctx context.Context, s *service, c *container, ret int32) {
+	// Take care of the use case where it is a sandbox.
+	// Right after the container representing the sandbox has
+	// been deleted, let's make sure we stop and delete the
+	// sandbox.
+
+	if c.cType.IsSandbox() {
+		// cancel watcher
+		if s.monitor != nil {
+			shimLog.WithField("sandbox", s.sandbox.ID()).Info("cancel watcher")
+			s.monitor <- nil
+		}
+		s.recordEvent(SandboxStopEvent, map[string]string{"exit_status": strconv.Itoa(int(ret))})
+		if err := s.sandbox.Stop(ctx, true); err != nil {
+			shimLog.WithField("sandbox", s.sandbox.ID()).Error("failed to stop sandbox")
+			s.recordEvent(SandboxErrorEvent, map[string]string{"operation": "stop", "error": err.Error()})
+		}
+
+		if err := s.sandbox.Delete(ctx); err != nil {
+			shimLog.WithField("sandbox", s.sandbox.ID()).Error("failed to delete sandbox")
+			s.recordEvent(SandboxErrorEvent, map[string]string{"operation": "delete", "error": err.Error()})
+		}
+	} else {
+		if _, err := s.sandbox.StopContainer(ctx, c.id, false); err != nil {
+			shimLog.WithError(err).WithField("container", c.id).Warn("stop container failed")
+		}
+	}
+}
+
 func watchSandbox(ctx context.Context, s *service) {
 	if s.monitor == nil {
 		return
@@ -121,13 +158,16 @@ func watchSandbox(ctx context.Context, s *service) {
 	defer s.mu.Unlock()
 	// sandbox malfunctioning, cleanup as much as we can
 	shimLog.WithError(err).Warn("sandbox stopped unexpectedly")
+	s.recordEvent(SandboxFailedEvent, map[string]string{"error": err.Error()})
 	err = s.sandbox.Stop(ctx, true)
 	if err != nil {
 		shimLog.WithError(err).Warn("stop sandbox failed")
+		s.recordEvent(SandboxErrorEvent, map[string]string{"operation": "stop", "error": err.Error()})
 	}
 	err = s.sandbox.Delete(ctx)
 	if err != nil {
 		shimLog.WithError(err).Warn("delete sandbox failed")
+		s.recordEvent(SandboxErrorEvent, map[string]string{"operation": "delete", "error": err.Error()})
 	}
 
 	for _, c := range s.containers {
@@ -166,8 +206,12 @@ func watchOOMEvents(ctx context.Context, s *service) {
 				continue
 			}
 
+			s.mu.Lock()
+			c, ok := s.containers[containerID]
+			s.mu.Unlock()
+
 			// write oom file for CRI-O
-			if c, ok := s.containers[containerID]; ok && oci.IsCRIOContainerManager(c.spec) {
+			if ok && oci.IsCRIOContainerManager(c.spec) {
 				oomPath := path.Join(c.bundle, "oom")
 				shimLog.Infof("write oom file to notify CRI-O: %s", oomPath)
 
@@ -183,6 +227,141 @@ func watchOOMEvents(ctx context.Context, s *service) {
 			s.send(&events.TaskOOM{
 				ContainerID: containerID,
 			})
+			s.sendVMEvent(vc.LifecycleEvent{
+				Timestamp:  time.Now(),
+				Attributes: map[string]string{"container": containerID},
+				SandboxID:  s.id,
+				Type:       GuestOOMEvent,
+			})
+		}
+	}
+}
+
+// watchGuestKernelLog writes the records of the guest kernel log to the
+// shim log.
+func watchGuestKernelLog(ctx context.Context, s *service) {
+	if s.sandbox == nil || s.config == nil || !s.config.ForwardGuestKernelLog {
+		return
+	}
+
+	forwarder := vc.NewLogForwarder("guest-kernel", s.config.LogRateLimit, "", 0)
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		default:
+			records, err := s.sandbox.ReadGuestKernelLog(ctx)
+			if err != nil {
+				if err.Error() == "ttrpc: closed" || err.Error() == "Dead agent" {
+					shimLog.WithError(err).Warn("agent has shutdown, return from watching of the guest kernel log")
+					return
+				}
+				shimLog.WithError(err).Warn("failed to read the guest kernel log")
+				time.Sleep(defaultCheckInterval)
+				continue
+			}
+
+			for _, r := range records {
+				if forwarder.Forward(r.Message) {
+					logGuestKernelRecord(r)
+				}
+			}
+		}
+	}
+}
+
+// logGuestKernelRecord writes a record of the guest kernel log at the level
+// matching its syslog level.
+func logGuestKernelRecord(r vc.GuestKernelLogRecord) {
+	logger := shimLog.WithFields(logrus.Fields{
+		"subsystem":       "guest-kernel",
+		"sequence":        r.Sequence,
+		"facility":        r.Facility,
+		"guest-timestamp": r.Timestamp.Seconds(),
+	})
+
+	switch {
+	// emerg, alert, crit and err
+	case r.Level <= 3:
+		logger.Error(r.Message)
+	case r.Level == 4:
+		logger.Warn(r.Message)
+	// notice and info
+	case r.Level <= 6:
+		logger.Info(r.Message)
+	default:
+		logger.Debug(r.Message)
+	}
+}
+
+// watchVolumes periodically propagates the growth of the block devices
+// backing the container volumes to the guest, e.g. after a CSI volume
+// expansion, for the pods to see the new capacity without restarting.
+func watchVolumes(ctx context.Context, s *service) {
+	if s.sandbox == nil {
+		return
+	}
+
+	ticker := time.NewTicker(volumeCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			grown := s.sandbox.GrownVolumes()
+			s.mu.Unlock()
+
+			// the hypervisor and agent requests are not made under the
+			// service lock, not to block the containers management
+			for _, v := range grown {
+				if err := s.sandbox.ResizeVolume(ctx, v); err != nil {
+					shimLog.WithError(err).WithFields(logrus.Fields{
+						"container":   v.ContainerID,
+						"destination": v.Destination,
+					}).Warn("failed to resize grown volume")
+					continue
+				}
+
+				s.mu.Lock()
+				err := s.sandbox.VolumeResized(v)
+				s.mu.Unlock()
+				if err != nil {
+					shimLog.WithError(err).WithField("container", v.ContainerID).Warn("failed to record grown volume size")
+				}
+			}
+		}
+	}
+}
+
+// watchGuestTime keeps the guest clock in sync with the host one, after the
+// host resumes from suspend and at the configured interval.
+func watchGuestTime(ctx context.Context, s *service) {
+	if s.sandbox == nil {
+		return
+	}
+
+	ticker := time.NewTicker(guestTimeCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			due, err := s.sandbox.GuestTimeSyncDue()
+			s.mu.Unlock()
+			if err == nil && due {
+				// the agent request is not made under the service lock
+				err = s.sandbox.SyncGuestTime(ctx)
+			}
+			if err != nil {
+				shimLog.WithError(err).Warn("failed to sync guest time")
+			}
 		}
 	}
 }
@@ -13,6 +13,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
 )
 
 const (
@@ -20,6 +22,49 @@ const (
 
 	FSGroupMetadataKey             = "fsGroup"
 	FSGroupChangePolicyMetadataKey = "fsGroupChangePolicy"
+
+	// EncryptionMetadataKey is the encryption format of a block volume the
+	// guest decrypts, so that its content is only ever seen encrypted on
+	// the host. Only LUKSEncryption is supported.
+	EncryptionMetadataKey = "encryption"
+	// EncryptionKeyFileMetadataKey is the path of the host file holding the
+	// key of an encrypted volume. The key is sent to the agent, the mount
+	// info itself must not contain it.
+	EncryptionKeyFileMetadataKey = "encryptionKeyFile"
+
+	// CredentialsFileMetadataKey is the metadata key of the host file holding
+	// the credentials of a SMB network volume, in the mount.cifs format. The
+	// credentials are sent to the agent, the mount info itself must not
+	// contain them.
+	CredentialsFileMetadataKey = "credentialsFile"
+
+	// LUKSEncryption is a volume encrypted with LUKS, and formatted by the
+	// agent on first use.
+	LUKSEncryption = "luks"
+)
+
+const (
+	// BlockVolumeType is a volume backed by a host block device.
+	BlockVolumeType = "block"
+	// SPDKVolumeType is a volume backed by a SPDK vhost-user-blk target.
+	// The device of the volume is the vhost-user socket of the target, and
+	// the guest memory must be shared (e.g. hugepages) for the target to
+	// access it.
+	SPDKVolumeType = "spdkvol"
+	// NVMeVolumeType is a volume backed by a namespace of a NVMe controller
+	// bound to vfio-pci. The device of the volume is the namespace URI,
+	// nvme://<bdf>/<namespace>, and the hypervisor drives the controller
+	// from userspace, bypassing the host block layer.
+	NVMeVolumeType = "nvme"
+	// PmemVolumeType is a volume backed by a file, typically on a DAX
+	// capable host filesystem, mapped into the guest as a virtio-pmem
+	// device and mounted with DAX, bypassing the guest page cache.
+	PmemVolumeType = "pmem"
+	// NetworkVolumeType is a NFS or SMB volume mounted by the agent over the
+	// pod network, rather than mounted on the host and shared with the guest.
+	// The device of the volume is the NFS export, <server>:<path>, or the SMB
+	// share, //<server>/<share>, and its fstype is nfs, nfs4, cifs or smb3.
+	NetworkVolumeType = "netfs"
 )
 
 // FSGroupChangePolicy holds policies that will be used for applying fsGroup to a volume.
@@ -40,11 +85,25 @@ const (
 
 var kataDirectVolumeRootPath = "/run/kata-containers/shared/direct-volumes"
 
+// networkFsTypes are the filesystems of the network volumes
+var networkFsTypes = []string{"nfs", "nfs4", "cifs", "smb3"}
+
+// IsNetworkFsType returns true if fsType is the filesystem of a network volume.
+func IsNetworkFsType(fsType string) bool {
+	for _, t := range networkFsTypes {
+		if t == fsType {
+			return true
+		}
+	}
+	return false
+}
+
 // MountInfo contains the information needed by Kata to consume a host block device and mount it as a filesystem inside the guest VM.
 type MountInfo struct {
-	// The type of the volume (ie. block)
+	// The type of the volume (ie. block, spdkvol, nvme, pmem or netfs)
 	VolumeType string `json:"volume-type"`
-	// The device backing the volume.
+	// The device backing the volume, i.e. the vhost-user socket for a SPDK volume,
+	// or the namespace URI for a NVMe volume.
 	Device string `json:"device"`
 	// The filesystem type to be mounted on the volume.
 	FsType string `json:"fstype"`
@@ -75,6 +134,38 @@ func Add(volumePath string, mountInfo string) error {
 		return err
 	}
 
+	if deserialized.VolumeType == SPDKVolumeType && deserialized.Device == "" {
+		return fmt.Errorf("no vhost-user socket provided for %s volume", SPDKVolumeType)
+	}
+
+	if encryption, ok := deserialized.Metadata[EncryptionMetadataKey]; ok {
+		if encryption != LUKSEncryption {
+			return fmt.Errorf("unsupported volume encryption %q, only %s is supported", encryption, LUKSEncryption)
+		}
+		if deserialized.Metadata[EncryptionKeyFileMetadataKey] == "" {
+			return fmt.Errorf("no key file provided for the %s encrypted volume", encryption)
+		}
+	}
+
+	if deserialized.VolumeType == PmemVolumeType && deserialized.Device == "" {
+		return fmt.Errorf("no backing file provided for %s volume", PmemVolumeType)
+	}
+
+	if deserialized.VolumeType == NetworkVolumeType {
+		if deserialized.Device == "" {
+			return fmt.Errorf("no export or share provided for %s volume", NetworkVolumeType)
+		}
+		if !IsNetworkFsType(deserialized.FsType) {
+			return fmt.Errorf("unsupported fstype %q for %s volume, expected one of %v", deserialized.FsType, NetworkVolumeType, networkFsTypes)
+		}
+	}
+
+	if deserialized.VolumeType == NVMeVolumeType {
+		if _, _, err := config.ParseNVMeURI(deserialized.Device); err != nil {
+			return fmt.Errorf("invalid namespace provided for %s volume: %v", NVMeVolumeType, err)
+		}
+	}
+
 	return ioutil.WriteFile(filepath.Join(volumeDir, mountInfoFileName), []byte(mountInfo), 0600)
 }
 
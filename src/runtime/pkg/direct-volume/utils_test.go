@@ -56,6 +56,149 @@ func TestAdd(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestAddSPDKVolume(t *testing.T) {
+	kataDirectVolumeRootPath = t.TempDir()
+	var volumePath = "/a/b/c"
+
+	mntInfo := MountInfo{
+		VolumeType: SPDKVolumeType,
+		FsType:     "ext4",
+	}
+	buf, err := json.Marshal(mntInfo)
+	assert.Nil(t, err)
+
+	// The vhost-user socket of the SPDK target is required
+	assert.Error(t, Add(volumePath, string(buf)))
+
+	mntInfo.Device = "/var/run/spdk/vhost/blk0.sock"
+	buf, err = json.Marshal(mntInfo)
+	assert.Nil(t, err)
+	assert.Nil(t, Add(volumePath, string(buf)))
+
+	actual, err := VolumeMountInfo(volumePath)
+	assert.Nil(t, err)
+	assert.Equal(t, &mntInfo, actual)
+}
+
+func TestAddNVMeVolume(t *testing.T) {
+	kataDirectVolumeRootPath = t.TempDir()
+	var volumePath = "/a/b/c"
+
+	mntInfo := MountInfo{
+		VolumeType: NVMeVolumeType,
+		Device:     "/dev/nvme0n1",
+		FsType:     "xfs",
+	}
+	buf, err := json.Marshal(mntInfo)
+	assert.Nil(t, err)
+
+	// The namespace must be given by its URI
+	assert.Error(t, Add(volumePath, string(buf)))
+
+	mntInfo.Device = "nvme://0000:01:00.0/0"
+	buf, err = json.Marshal(mntInfo)
+	assert.Nil(t, err)
+	assert.Error(t, Add(volumePath, string(buf)))
+
+	mntInfo.Device = "nvme://0000:01:00.0/1"
+	buf, err = json.Marshal(mntInfo)
+	assert.Nil(t, err)
+	assert.Nil(t, Add(volumePath, string(buf)))
+
+	actual, err := VolumeMountInfo(volumePath)
+	assert.Nil(t, err)
+	assert.Equal(t, &mntInfo, actual)
+}
+
+func TestAddPmemVolume(t *testing.T) {
+	kataDirectVolumeRootPath = t.TempDir()
+	var volumePath = "/a/b/c"
+
+	mntInfo := MountInfo{
+		VolumeType: PmemVolumeType,
+		FsType:     "ext4",
+	}
+	buf, err := json.Marshal(mntInfo)
+	assert.Nil(t, err)
+
+	// The backing file is required
+	assert.Error(t, Add(volumePath, string(buf)))
+
+	mntInfo.Device = "/mnt/pmem0/volume.img"
+	buf, err = json.Marshal(mntInfo)
+	assert.Nil(t, err)
+	assert.Nil(t, Add(volumePath, string(buf)))
+
+	actual, err := VolumeMountInfo(volumePath)
+	assert.Nil(t, err)
+	assert.Equal(t, &mntInfo, actual)
+}
+
+func TestAddNetworkVolume(t *testing.T) {
+	kataDirectVolumeRootPath = t.TempDir()
+	var volumePath = "/a/b/c"
+
+	mntInfo := MountInfo{
+		VolumeType: NetworkVolumeType,
+		FsType:     "nfs4",
+		Options:    []string{"vers=4.1", "hard"},
+	}
+	buf, err := json.Marshal(mntInfo)
+	assert.Nil(t, err)
+
+	// The export is required
+	assert.Error(t, Add(volumePath, string(buf)))
+
+	mntInfo.Device = "nfs.example.com:/exports/data"
+	mntInfo.FsType = "ext4"
+	buf, err = json.Marshal(mntInfo)
+	assert.Nil(t, err)
+	assert.Error(t, Add(volumePath, string(buf)))
+
+	mntInfo.FsType = "nfs4"
+	buf, err = json.Marshal(mntInfo)
+	assert.Nil(t, err)
+	assert.Nil(t, Add(volumePath, string(buf)))
+
+	actual, err := VolumeMountInfo(volumePath)
+	assert.Nil(t, err)
+	assert.Equal(t, &mntInfo, actual)
+}
+
+func TestAddEncryptedVolume(t *testing.T) {
+	kataDirectVolumeRootPath = t.TempDir()
+	var volumePath = "/a/b/c"
+
+	mntInfo := MountInfo{
+		VolumeType: BlockVolumeType,
+		Device:     "/dev/sda",
+		FsType:     "ext4",
+		Metadata: map[string]string{
+			EncryptionMetadataKey: "dm-crypt",
+		},
+	}
+	buf, err := json.Marshal(mntInfo)
+	assert.Nil(t, err)
+
+	// Unsupported encryption
+	assert.Error(t, Add(volumePath, string(buf)))
+
+	// The key file is required
+	mntInfo.Metadata[EncryptionMetadataKey] = LUKSEncryption
+	buf, err = json.Marshal(mntInfo)
+	assert.Nil(t, err)
+	assert.Error(t, Add(volumePath, string(buf)))
+
+	mntInfo.Metadata[EncryptionKeyFileMetadataKey] = "/etc/keys/volume.key"
+	buf, err = json.Marshal(mntInfo)
+	assert.Nil(t, err)
+	assert.Nil(t, Add(volumePath, string(buf)))
+
+	actual, err := VolumeMountInfo(volumePath)
+	assert.Nil(t, err)
+	assert.Equal(t, &mntInfo, actual)
+}
+
 func TestRecordSandboxId(t *testing.T) {
 	var err error
 	kataDirectVolumeRootPath = t.TempDir()
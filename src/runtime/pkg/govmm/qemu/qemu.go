@@ -707,6 +707,12 @@ const (
 
 	// VHOSTUSER is a vhost-user port (socket)
 	VHOSTUSER NetDeviceType = "vhostuser"
+
+	// VHOSTVDPA is a vhost-vdpa device (character device)
+	VHOSTVDPA NetDeviceType = "vhost-vdpa"
+
+	// STREAM is a stream socket based backend, e.g. passt (socket)
+	STREAM NetDeviceType = "stream"
 )
 
 // QemuNetdevParam converts to the QEMU -netdev parameter notation
@@ -734,6 +740,10 @@ func (n NetDeviceType) QemuNetdevParam(netdev *NetDevice, config *Config) string
 			log.Fatal("vhost-user devices are not supported on IBM Z")
 		}
 		return "vhost-user" // -netdev type=vhost-user (no device)
+	case VHOSTVDPA:
+		return "vhost-vdpa" // -netdev type=vhost-vdpa -device virtio-net-pci
+	case STREAM:
+		return "stream" // -netdev type=stream -device virtio-net-pci
 	default:
 		return ""
 
@@ -767,6 +777,10 @@ func (n NetDeviceType) QemuDeviceParam(netdev *NetDevice, config *Config) Device
 			log.Fatal("vhost-user devices are not supported on IBM Z")
 		}
 		return "" // -netdev type=vhost-user (no device)
+	case VHOSTVDPA:
+		device = "virtio-net" // -netdev type=vhost-vdpa -device virtio-net-pci
+	case STREAM:
+		device = "virtio-net" // -netdev type=stream -device virtio-net-pci
 	default:
 		return ""
 	}
@@ -821,6 +835,16 @@ type NetDevice struct {
 	// MACAddress is the networking device interface MAC address.
 	MACAddress string
 
+	// VhostDev is the path to the vhost-vdpa character device.
+	VhostDev string
+
+	// SocketPath is the path to the UNIX socket of a stream backend.
+	SocketPath string
+
+	// MTU is the MTU advertised to the guest driver.
+	// When 0, the guest driver default is used.
+	MTU int
+
 	// DisableModern prevents qemu from relying on fast MMIO.
 	DisableModern bool
 
@@ -832,6 +856,12 @@ type NetDevice struct {
 
 	// Transport is the virtio transport for this device.
 	Transport VirtioTransport
+
+	// RSS enables receive side scaling on the device.
+	RSS bool
+
+	// HashReport enables reporting of the computed packet hash to the guest.
+	HashReport bool
 }
 
 // VirtioNetTransport is a map of the virtio-net device name that corresponds
@@ -853,6 +883,10 @@ func (netdev NetDevice) Valid() bool {
 		return true
 	case MACVTAP:
 		return true
+	case VHOSTVDPA:
+		return netdev.VhostDev != ""
+	case STREAM:
+		return netdev.SocketPath != ""
 	default:
 		return false
 	}
@@ -895,6 +929,10 @@ func (netdev NetDevice) QemuDeviceParams(config *Config) []string {
 	deviceParams = append(deviceParams, fmt.Sprintf("netdev=%s", netdev.ID))
 	deviceParams = append(deviceParams, fmt.Sprintf("mac=%s", netdev.MACAddress))
 
+	if netdev.MTU > 0 {
+		deviceParams = append(deviceParams, fmt.Sprintf("host_mtu=%d", netdev.MTU))
+	}
+
 	if netdev.Bus != "" {
 		deviceParams = append(deviceParams, fmt.Sprintf("bus=%s", netdev.Bus))
 	}
@@ -914,6 +952,14 @@ func (netdev NetDevice) QemuDeviceParams(config *Config) []string {
 		deviceParams = append(deviceParams, netdev.mqParameter(config))
 	}
 
+	if netdev.RSS {
+		deviceParams = append(deviceParams, "rss=on")
+	}
+
+	if netdev.HashReport {
+		deviceParams = append(deviceParams, "hash=on")
+	}
+
 	if netdev.Transport.isVirtioPCI(config) && netdev.ROMFile != "" {
 		deviceParams = append(deviceParams, fmt.Sprintf("romfile=%s", netdev.ROMFile))
 	}
@@ -940,6 +986,18 @@ func (netdev NetDevice) QemuNetdevParams(config *Config) []string {
 	netdevParams = append(netdevParams, netdevType)
 	netdevParams = append(netdevParams, fmt.Sprintf("id=%s", netdev.ID))
 
+	if netdev.Type == VHOSTVDPA {
+		netdevParams = append(netdevParams, fmt.Sprintf("vhostdev=%s", netdev.VhostDev))
+		return netdevParams
+	}
+
+	if netdev.Type == STREAM {
+		netdevParams = append(netdevParams, "server=off")
+		netdevParams = append(netdevParams, "addr.type=unix")
+		netdevParams = append(netdevParams, fmt.Sprintf("addr.path=%s", netdev.SocketPath))
+		return netdevParams
+	}
+
 	if netdev.VHost {
 		netdevParams = append(netdevParams, "vhost=on")
 		if len(netdev.VhostFDs) > 0 {
@@ -1768,6 +1826,10 @@ type SCSIController struct {
 	// IOThread is the IO thread on which IO will be handled
 	IOThread string
 
+	// VirtQueueSize is the size of the request virtqueues, i.e. the
+	// maximum number of in-flight requests per queue, this is optional
+	VirtQueueSize uint32
+
 	// ROMFile specifies the ROM file being used for this device.
 	ROMFile string
 
@@ -1810,6 +1872,9 @@ func (scsiCon SCSIController) QemuParams(config *Config) []string {
 	if scsiCon.IOThread != "" {
 		deviceParams = append(deviceParams, fmt.Sprintf("iothread=%s", scsiCon.IOThread))
 	}
+	if scsiCon.VirtQueueSize != 0 {
+		deviceParams = append(deviceParams, fmt.Sprintf("virtqueue_size=%d", scsiCon.VirtQueueSize))
+	}
 	if scsiCon.Transport.isVirtioPCI(config) && scsiCon.ROMFile != "" {
 		deviceParams = append(deviceParams, fmt.Sprintf("romfile=%s", scsiCon.ROMFile))
 	}
@@ -2387,6 +2452,24 @@ type Memory struct {
 	Path string
 }
 
+// NUMANode is a guest NUMA node.
+type NUMANode struct {
+	// CPUs is the list of the vCPUs of the node.
+	CPUs []uint32
+
+	// Size is the amount of memory of the node. It should be suffixed
+	// with M or G for sizes in megabytes or gigabytes respectively.
+	Size string
+
+	// HostNodes is the list of the host NUMA nodes the memory of the node
+	// is bound to, e.g. "0" or "0-1". The memory is not bound if empty.
+	HostNodes string
+
+	// Distances are the distances from the node to each node, indexed by
+	// their IDs.
+	Distances []uint32
+}
+
 // Kernel is the guest kernel configuration structure.
 type Kernel struct {
 	// Path is the guest kernel path on the host filesystem.
@@ -2579,6 +2662,10 @@ type Config struct {
 	// SMP is the quest multi processors configuration.
 	SMP SMP
 
+	// NUMANodes are the guest NUMA nodes, sharing the memory and the vCPUs
+	// of the guest. It has a single node when empty.
+	NUMANodes []NUMANode
+
 	// GlobalParam is the -global parameter.
 	GlobalParam string
 
@@ -2818,21 +2905,16 @@ func (config *Config) appendKernel() {
 	}
 }
 
-func (config *Config) appendMemoryKnobs() {
-	if config.Memory.Size == "" {
-		return
-	}
-	var objMemParam, numaMemParam string
-	dimmName := "dimm1"
+// memoryBackendParam returns the parameter of the memory backend dimmName
+// of size bytes.
+func (config *Config) memoryBackendParam(dimmName, size string) string {
+	var objMemParam string
 	if config.Knobs.HugePages {
-		objMemParam = "memory-backend-file,id=" + dimmName + ",size=" + config.Memory.Size + ",mem-path=/dev/hugepages"
-		numaMemParam = "node,memdev=" + dimmName
+		objMemParam = "memory-backend-file,id=" + dimmName + ",size=" + size + ",mem-path=/dev/hugepages"
 	} else if config.Knobs.FileBackedMem && config.Memory.Path != "" {
-		objMemParam = "memory-backend-file,id=" + dimmName + ",size=" + config.Memory.Size + ",mem-path=" + config.Memory.Path
-		numaMemParam = "node,memdev=" + dimmName
+		objMemParam = "memory-backend-file,id=" + dimmName + ",size=" + size + ",mem-path=" + config.Memory.Path
 	} else {
-		objMemParam = "memory-backend-ram,id=" + dimmName + ",size=" + config.Memory.Size
-		numaMemParam = "node,memdev=" + dimmName
+		objMemParam = "memory-backend-ram,id=" + dimmName + ",size=" + size
 	}
 
 	if config.Knobs.MemShared {
@@ -2841,18 +2923,65 @@ func (config *Config) appendMemoryKnobs() {
 	if config.Knobs.MemPrealloc {
 		objMemParam += ",prealloc=on"
 	}
+
+	return objMemParam
+}
+
+func (config *Config) appendMemoryKnobs() {
+	if config.Memory.Size == "" {
+		return
+	}
+
+	if len(config.NUMANodes) > 0 && isDimmSupported(config) {
+		config.appendNUMANodes()
+		return
+	}
+
+	dimmName := "dimm1"
 	config.qemuParams = append(config.qemuParams, "-object")
-	config.qemuParams = append(config.qemuParams, objMemParam)
+	config.qemuParams = append(config.qemuParams, config.memoryBackendParam(dimmName, config.Memory.Size))
 
 	if isDimmSupported(config) {
 		config.qemuParams = append(config.qemuParams, "-numa")
-		config.qemuParams = append(config.qemuParams, numaMemParam)
+		config.qemuParams = append(config.qemuParams, "node,memdev="+dimmName)
 	} else {
 		config.qemuParams = append(config.qemuParams, "-machine")
 		config.qemuParams = append(config.qemuParams, "memory-backend="+dimmName)
 	}
 }
 
+// appendNUMANodes appends the memory backend of each guest NUMA node, bound
+// to its host nodes, the node and its distances to the other nodes.
+func (config *Config) appendNUMANodes() {
+	for i, node := range config.NUMANodes {
+		dimmName := fmt.Sprintf("dimm%d", i+1)
+
+		objMemParam := config.memoryBackendParam(dimmName, node.Size)
+		if node.HostNodes != "" {
+			objMemParam += ",host-nodes=" + node.HostNodes + ",policy=bind"
+		}
+		config.qemuParams = append(config.qemuParams, "-object")
+		config.qemuParams = append(config.qemuParams, objMemParam)
+
+		numaMemParam := fmt.Sprintf("node,nodeid=%d,memdev=%s", i, dimmName)
+		for _, cpu := range node.CPUs {
+			numaMemParam += fmt.Sprintf(",cpus=%d", cpu)
+		}
+		config.qemuParams = append(config.qemuParams, "-numa")
+		config.qemuParams = append(config.qemuParams, numaMemParam)
+	}
+
+	for i, node := range config.NUMANodes {
+		for j, distance := range node.Distances {
+			if i == j || j >= len(config.NUMANodes) {
+				continue
+			}
+			config.qemuParams = append(config.qemuParams, "-numa")
+			config.qemuParams = append(config.qemuParams, fmt.Sprintf("dist,src=%d,dst=%d,val=%d", i, j, distance))
+		}
+	}
+}
+
 func (config *Config) appendKnobs() {
 	if config.Knobs.NoUserConfig {
 		config.qemuParams = append(config.qemuParams, "-no-user-config")
@@ -220,6 +220,87 @@ func TestAppendDeviceNetworkMq(t *testing.T) {
 	testAppend(netdev, deviceNetworkStringMq, t)
 }
 
+func TestAppendDeviceNetworkVhostVdpa(t *testing.T) {
+	netdev := NetDevice{
+		Driver:        VirtioNet,
+		Type:          VHOSTVDPA,
+		ID:            "vdpa0",
+		IFName:        "eth0",
+		VhostDev:      "/dev/vhost-vdpa-0",
+		MACAddress:    "01:02:de:ad:be:ef",
+		DisableModern: true,
+		ROMFile:       romfile,
+	}
+
+	if netdev.Transport.isVirtioPCI(nil) {
+		netdev.Bus = "/pci-bus/pcie.0"
+		netdev.Addr = "255"
+	} else if netdev.Transport.isVirtioCCW(nil) {
+		netdev.DevNo = DevNo
+	}
+
+	testAppend(netdev, deviceNetworkVhostVdpaString, t)
+}
+
+func TestAppendDeviceNetworkStream(t *testing.T) {
+	netdev := NetDevice{
+		Driver:        VirtioNet,
+		Type:          STREAM,
+		ID:            "passt0",
+		IFName:        "eth0",
+		SocketPath:    "/run/passt.sock",
+		MACAddress:    "01:02:de:ad:be:ef",
+		DisableModern: true,
+		ROMFile:       romfile,
+	}
+
+	if netdev.Transport.isVirtioPCI(nil) {
+		netdev.Bus = "/pci-bus/pcie.0"
+		netdev.Addr = "255"
+	} else if netdev.Transport.isVirtioCCW(nil) {
+		netdev.DevNo = DevNo
+	}
+
+	testAppend(netdev, deviceNetworkStreamString, t)
+}
+
+func TestAppendDeviceNetworkRSS(t *testing.T) {
+	foo, _ := ioutil.TempFile(os.TempDir(), "govmm-qemu-test")
+	bar, _ := ioutil.TempFile(os.TempDir(), "govmm-qemu-test")
+
+	defer func() {
+		_ = foo.Close()
+		_ = bar.Close()
+		_ = os.Remove(foo.Name())
+		_ = os.Remove(bar.Name())
+	}()
+
+	netdev := NetDevice{
+		Driver:        VirtioNet,
+		Type:          TAP,
+		ID:            "tap0",
+		IFName:        "ceth0",
+		Script:        "no",
+		DownScript:    "no",
+		FDs:           []*os.File{foo, bar},
+		VHost:         true,
+		MACAddress:    "01:02:de:ad:be:ef",
+		DisableModern: true,
+		ROMFile:       romfile,
+		RSS:           true,
+		HashReport:    true,
+	}
+
+	if netdev.Transport.isVirtioPCI(nil) {
+		netdev.Bus = "/pci-bus/pcie.0"
+		netdev.Addr = "255"
+	} else if netdev.Transport.isVirtioCCW(nil) {
+		netdev.DevNo = DevNo
+	}
+
+	testAppend(netdev, deviceNetworkStringRSS, t)
+}
+
 var deviceLegacySerialString = "-serial chardev:tlserial0"
 
 func TestAppendLegacySerial(t *testing.T) {
@@ -436,6 +517,7 @@ func TestAppendDeviceSCSIController(t *testing.T) {
 	scsiCon.Addr = "00:04.0"
 	scsiCon.DisableModern = true
 	scsiCon.IOThread = "iothread1"
+	scsiCon.VirtQueueSize = 512
 	testAppend(scsiCon, deviceSCSIControllerBusAddrStr, t)
 }
 
@@ -633,6 +715,44 @@ func TestAppendMemoryFileBackedMemPrealloc(t *testing.T) {
 	testConfigAppend(conf, knobs, memString+" "+knobsString, t)
 }
 
+func TestAppendMemoryNUMANodes(t *testing.T) {
+	if !isDimmSupported(nil) {
+		t.Skip("NUMA is not supported")
+	}
+
+	conf := &Config{
+		Memory: Memory{
+			Size: "2G",
+		},
+		NUMANodes: []NUMANode{
+			{
+				CPUs:      []uint32{0, 2},
+				Size:      "1024M",
+				HostNodes: "0",
+				Distances: []uint32{10, 21},
+			},
+			{
+				CPUs:      []uint32{1, 3},
+				Size:      "1024M",
+				HostNodes: "1",
+				Distances: []uint32{21, 10},
+			},
+		},
+	}
+
+	knobs := Knobs{
+		MemPrealloc: true,
+	}
+	knobsString := "-object memory-backend-ram,id=dimm1,size=1024M,prealloc=on,host-nodes=0,policy=bind " +
+		"-numa node,nodeid=0,memdev=dimm1,cpus=0,cpus=2 " +
+		"-object memory-backend-ram,id=dimm2,size=1024M,prealloc=on,host-nodes=1,policy=bind " +
+		"-numa node,nodeid=1,memdev=dimm2,cpus=1,cpus=3 " +
+		"-numa dist,src=0,dst=1,val=21 " +
+		"-numa dist,src=1,dst=0,val=21"
+
+	testConfigAppend(conf, knobs, knobsString, t)
+}
+
 func TestNoRebootKnob(t *testing.T) {
 	conf := &Config{}
 
@@ -817,6 +817,32 @@ func (q *QMP) ExecuteBlockdevAddWithCache(ctx context.Context, device, blockdevI
 	return q.executeCommand(ctx, "blockdev-add", args, nil)
 }
 
+// ExecuteBlockdevAddWithDiscard has the same parameters as ExecuteBlockdevAdd
+// but passes the discard (TRIM/UNMAP) requests of the guest down to the
+// device, so that thin-provisioned backing storage reclaims the freed blocks.
+func (q *QMP) ExecuteBlockdevAddWithDiscard(ctx context.Context, device, blockdevID string, ro bool) error {
+	args, blockdevArgs := q.blockdevAddBaseArgs("host_device", device, blockdevID, ro)
+
+	blockdevArgs["discard"] = "unmap"
+
+	return q.executeCommand(ctx, "blockdev-add", args, nil)
+}
+
+// ExecuteBlockdevAddWithCacheDiscard has the same parameters as
+// ExecuteBlockdevAddWithCache but passes the discard requests of the guest
+// down to the device, like ExecuteBlockdevAddWithDiscard.
+func (q *QMP) ExecuteBlockdevAddWithCacheDiscard(ctx context.Context, device, blockdevID string, direct, noFlush, ro bool) error {
+	args, blockdevArgs := q.blockdevAddBaseArgs("host_device", device, blockdevID, ro)
+
+	blockdevArgs["cache"] = map[string]interface{}{
+		"direct":   direct,
+		"no-flush": noFlush,
+	}
+	blockdevArgs["discard"] = "unmap"
+
+	return q.executeCommand(ctx, "blockdev-add", args, nil)
+}
+
 // ExecuteBlockdevAddWithDriverCache has three one parameter driver
 // than ExecuteBlockdevAddWithCache.
 // Parameter driver can set the driver of block device.
@@ -831,6 +857,25 @@ func (q *QMP) ExecuteBlockdevAddWithDriverCache(ctx context.Context, driver, dev
 	return q.executeCommand(ctx, "blockdev-add", args, nil)
 }
 
+// ExecuteBlockdevAddNVMe sends a blockdev-add to the QEMU instance for a
+// namespace of a NVMe controller, accessed through the QEMU userspace NVMe
+// driver. bdf is the PCI address of the controller, which must be bound to
+// vfio-pci, and namespace is the id of the namespace, starting from 1.
+func (q *QMP) ExecuteBlockdevAddNVMe(ctx context.Context, bdf string, namespace uint32, blockdevID string, ro bool) error {
+	args := map[string]interface{}{
+		"driver":    "raw",
+		"read-only": ro,
+		"node-name": blockdevID,
+		"file": map[string]interface{}{
+			"driver":    "nvme",
+			"device":    bdf,
+			"namespace": namespace,
+		},
+	}
+
+	return q.executeCommand(ctx, "blockdev-add", args, nil)
+}
+
 // ExecuteDeviceAdd adds the guest portion of a device to a QEMU instance
 // using the device_add command.  blockdevID should match the blockdevID passed
 // to a previous call to ExecuteBlockdevAdd.  devID is the id of the device to
@@ -927,6 +972,36 @@ func (q *QMP) ExecuteBlockdevDel(ctx context.Context, blockdevID string) error {
 	return q.executeCommand(ctx, "blockdev-del", args, nil)
 }
 
+// ExecuteBlockResize resizes a block device by sending a block_resize
+// command. blockdevID is the node name of the block device, typically the id
+// passed to ExecuteBlockdevAdd, and size is its new size in bytes.
+func (q *QMP) ExecuteBlockResize(ctx context.Context, blockdevID string, size uint64) error {
+	args := map[string]interface{}{
+		"node-name": blockdevID,
+		"size":      size,
+	}
+	return q.executeCommand(ctx, "block_resize", args, nil)
+}
+
+// ExecuteBlockSetIOThrottle caps the I/Os of a block device by sending a
+// block_set_io_throttle command. devID is the id of the device, as passed to
+// ExecuteDeviceAdd, and group is the throttle group the device joins. iops is
+// the maximum number of I/O operations per second and bps the maximum
+// bandwidth in bytes per second, 0 meaning unlimited.
+func (q *QMP) ExecuteBlockSetIOThrottle(ctx context.Context, devID, group string, iops, bps uint64) error {
+	args := map[string]interface{}{
+		"id":      devID,
+		"group":   group,
+		"iops":    iops,
+		"iops_rd": 0,
+		"iops_wr": 0,
+		"bps":     bps,
+		"bps_rd":  0,
+		"bps_wr":  0,
+	}
+	return q.executeCommand(ctx, "block_set_io_throttle", args, nil)
+}
+
 // ExecuteChardevDel deletes a char device by sending a chardev-remove command.
 // chardevID is the id of the char device to be deleted. Typically, this will
 // match the id passed to ExecuteCharDevUnixSocketAdd. It must be a valid QMP id.
@@ -1004,10 +1079,12 @@ func (q *QMP) ExecuteNetdevDel(ctx context.Context, netdevID string) error {
 // using the device_add command. devID is the id of the device to add.
 // Must be valid QMP identifier. netdevID is the id of nic added by previous netdev_add.
 // queues is the number of queues of a nic.
+// mtu is the MTU advertised to the guest driver, 0 keeps the driver default.
 // disableModern indicates if virtio version 1.0 should be replaced by the
 // former version 0.9, as there is a KVM bug that occurs when using virtio
 // 1.0 in nested environments.
-func (q *QMP) ExecuteNetPCIDeviceAdd(ctx context.Context, netdevID, devID, macAddr, addr, bus, romfile string, queues int, disableModern bool) error {
+// rss enables receive side scaling and hash reporting on the nic.
+func (q *QMP) ExecuteNetPCIDeviceAdd(ctx context.Context, netdevID, devID, macAddr, addr, bus, romfile string, queues, mtu int, disableModern, rss bool) error {
 	args := map[string]interface{}{
 		"id":      devID,
 		"driver":  VirtioNetPCI,
@@ -1029,6 +1106,9 @@ func (q *QMP) ExecuteNetPCIDeviceAdd(ctx context.Context, netdevID, devID, macAd
 	if disableModern {
 		args["disable-modern"] = disableModern
 	}
+	if mtu > 0 {
+		args["host_mtu"] = mtu
+	}
 
 	if queues > 0 {
 		// (2N+2 vectors, N for tx queues, N for rx queues, 1 for config, and one for possible control vq)
@@ -1041,6 +1121,11 @@ func (q *QMP) ExecuteNetPCIDeviceAdd(ctx context.Context, netdevID, devID, macAd
 		args["vectors"] = 2*queues + 2
 	}
 
+	if rss {
+		args["rss"] = "on"
+		args["hash"] = "on"
+	}
+
 	return q.executeCommand(ctx, "device_add", args, nil)
 }
 
@@ -1048,7 +1133,8 @@ func (q *QMP) ExecuteNetPCIDeviceAdd(ctx context.Context, netdevID, devID, macAd
 // using the device_add command. devID is the id of the device to add.
 // Must be valid QMP identifier. netdevID is the id of nic added by previous netdev_add.
 // queues is the number of queues of a nic.
-func (q *QMP) ExecuteNetCCWDeviceAdd(ctx context.Context, netdevID, devID, macAddr, bus string, queues int) error {
+// mtu is the MTU advertised to the guest driver, 0 keeps the driver default.
+func (q *QMP) ExecuteNetCCWDeviceAdd(ctx context.Context, netdevID, devID, macAddr, bus string, queues, mtu int) error {
 	args := map[string]interface{}{
 		"id":     devID,
 		"driver": VirtioNetCCW,
@@ -1057,6 +1143,10 @@ func (q *QMP) ExecuteNetCCWDeviceAdd(ctx context.Context, netdevID, devID, macAd
 		"devno":  bus,
 	}
 
+	if mtu > 0 {
+		args["host_mtu"] = mtu
+	}
+
 	if queues > 0 {
 		args["mq"] = "on"
 	}
@@ -1468,6 +1558,49 @@ func (q *QMP) ExecuteNVDIMMDeviceAdd(ctx context.Context, id, mempath string, si
 	return err
 }
 
+// ExecuteVirtioPmemDeviceAdd hotplugs a virtio-pmem device backed by the
+// file at mempath, of the given size, on the PCI(E) bus at address addr.
+// The guest accesses the file directly through its DAX mappings. readOnly
+// maps the file read-only, which requires QEMU 7.0 or later.
+func (q *QMP) ExecuteVirtioPmemDeviceAdd(ctx context.Context, id, mempath string, size int64, addr, bus string, readOnly bool) error {
+	memdev := "pmemmem" + id
+
+	args := map[string]interface{}{
+		"qom-type": "memory-backend-file",
+		"id":       memdev,
+		"mem-path": mempath,
+		"size":     size,
+		"share":    true,
+	}
+	if readOnly {
+		args["readonly"] = true
+	}
+
+	if err := q.executeCommand(ctx, "object-add", args, nil); err != nil {
+		return err
+	}
+
+	args = map[string]interface{}{
+		"driver": "virtio-pmem-pci",
+		"id":     id,
+		"memdev": memdev,
+		"addr":   addr,
+	}
+	if bus != "" {
+		args["bus"] = bus
+	}
+
+	err := q.executeCommand(ctx, "device_add", args, nil)
+	if err != nil {
+		q.cfg.Logger.Errorf("Unable to hotplug virtio-pmem device: %v", err)
+		if err2 := q.executeCommand(ctx, "object-del", map[string]interface{}{"id": memdev}, nil); err2 != nil {
+			q.cfg.Logger.Warningf("Unable to clean up memory object: %v", err2)
+		}
+	}
+
+	return err
+}
+
 // ExecuteBalloon sets the size of the balloon, hence updates the memory
 // allocated for the VM.
 func (q *QMP) ExecuteBalloon(ctx context.Context, bytes uint64) error {
@@ -433,6 +433,31 @@ func TestQMPBlockdevAddWithCache(t *testing.T) {
 	<-disconnectedCh
 }
 
+// Checks that the blockdev-add command, with discard enabled, is correctly
+// sent.
+func TestQMPBlockdevAddWithDiscard(t *testing.T) {
+	connectedCh := make(chan *QMPVersion)
+	disconnectedCh := make(chan struct{})
+	buf := newQMPTestCommandBuffer(t)
+	buf.AddCommand("blockdev-add", nil, "return", nil)
+	buf.AddCommand("blockdev-add", nil, "return", nil)
+	cfg := QMPConfig{Logger: qmpTestLogger{}}
+	q := startQMPLoop(buf, cfg, connectedCh, disconnectedCh)
+	q.version = checkVersion(t, connectedCh)
+	err := q.ExecuteBlockdevAddWithDiscard(context.Background(), "/dev/rbd0",
+		fmt.Sprintf("drive_%s", volumeUUID), false)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	err = q.ExecuteBlockdevAddWithCacheDiscard(context.Background(), "/dev/rbd0",
+		fmt.Sprintf("drive_%s", volumeUUID), true, false, false)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	q.Shutdown()
+	<-disconnectedCh
+}
+
 // Checks that the netdev_add command is correctly sent.
 //
 // We start a QMPLoop, send the netdev_add command and stop the loop.
@@ -534,7 +559,7 @@ func TestQMPNetPCIDeviceAdd(t *testing.T) {
 	cfg := QMPConfig{Logger: qmpTestLogger{}}
 	q := startQMPLoop(buf, cfg, connectedCh, disconnectedCh)
 	checkVersion(t, connectedCh)
-	err := q.ExecuteNetPCIDeviceAdd(context.Background(), "br0", "virtio-0", "02:42:ac:11:00:02", "0x7", "", "", 8, false)
+	err := q.ExecuteNetPCIDeviceAdd(context.Background(), "br0", "virtio-0", "02:42:ac:11:00:02", "0x7", "", "", 8, 1500, false, false)
 	if err != nil {
 		t.Fatalf("Unexpected error %v", err)
 	}
@@ -550,7 +575,7 @@ func TestQMPNetCCWDeviceAdd(t *testing.T) {
 	cfg := QMPConfig{Logger: qmpTestLogger{}}
 	q := startQMPLoop(buf, cfg, connectedCh, disconnectedCh)
 	checkVersion(t, connectedCh)
-	err := q.ExecuteNetCCWDeviceAdd(context.Background(), "br0", "virtio-0", "02:42:ac:11:00:02", DevNo, 8)
+	err := q.ExecuteNetCCWDeviceAdd(context.Background(), "br0", "virtio-0", "02:42:ac:11:00:02", DevNo, 8, 1500)
 	if err != nil {
 		t.Fatalf("Unexpected error %v", err)
 	}
@@ -631,6 +656,88 @@ func TestQMPBlockdevDel(t *testing.T) {
 	<-disconnectedCh
 }
 
+// Checks that the block_resize command is correctly sent.
+//
+// We start a QMPLoop, send the block_resize command and stop the loop.
+//
+// The block_resize command should be correctly sent and the QMP loop should
+// exit gracefully.
+func TestQMPBlockResize(t *testing.T) {
+	connectedCh := make(chan *QMPVersion)
+	disconnectedCh := make(chan struct{})
+	buf := newQMPTestCommandBuffer(t)
+	buf.AddCommand("block_resize", map[string]interface{}{
+		"node-name": fmt.Sprintf("drive_%s", volumeUUID),
+		"size":      float64(1 << 30),
+	}, "return", nil)
+	cfg := QMPConfig{Logger: qmpTestLogger{}}
+	q := startQMPLoop(buf, cfg, connectedCh, disconnectedCh)
+	q.version = checkVersion(t, connectedCh)
+	err := q.ExecuteBlockResize(context.Background(),
+		fmt.Sprintf("drive_%s", volumeUUID), 1<<30)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	q.Shutdown()
+	<-disconnectedCh
+}
+
+// Checks that the block_set_io_throttle command is correctly sent.
+//
+// We start a QMPLoop, send the block_set_io_throttle command and stop the
+// loop.
+//
+// The block_set_io_throttle command should be correctly sent and the QMP
+// loop should exit gracefully.
+func TestQMPBlockSetIOThrottle(t *testing.T) {
+	connectedCh := make(chan *QMPVersion)
+	disconnectedCh := make(chan struct{})
+	buf := newQMPTestCommandBuffer(t)
+	buf.AddCommand("block_set_io_throttle", nil, "return", nil)
+	cfg := QMPConfig{Logger: qmpTestLogger{}}
+	q := startQMPLoop(buf, cfg, connectedCh, disconnectedCh)
+	q.version = checkVersion(t, connectedCh)
+	err := q.ExecuteBlockSetIOThrottle(context.Background(), fmt.Sprintf("virtio-%s", volumeUUID),
+		fmt.Sprintf("drive_%s", volumeUUID), 1000, 100<<20)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	q.Shutdown()
+	<-disconnectedCh
+}
+
+// Checks that the blockdev-add command is correctly sent for a NVMe namespace.
+//
+// We start a QMPLoop, send the blockdev-add command and stop the loop.
+//
+// The blockdev-add command should use the nvme driver and the QMP loop should
+// exit gracefully.
+func TestQMPBlockdevAddNVMe(t *testing.T) {
+	connectedCh := make(chan *QMPVersion)
+	disconnectedCh := make(chan struct{})
+	buf := newQMPTestCommandBuffer(t)
+	buf.AddCommand("blockdev-add", map[string]interface{}{
+		"driver":    "raw",
+		"read-only": false,
+		"node-name": fmt.Sprintf("drive_%s", volumeUUID),
+		"file": map[string]interface{}{
+			"driver":    "nvme",
+			"device":    "0000:01:00.0",
+			"namespace": float64(1),
+		},
+	}, "return", nil)
+	cfg := QMPConfig{Logger: qmpTestLogger{}}
+	q := startQMPLoop(buf, cfg, connectedCh, disconnectedCh)
+	q.version = checkVersion(t, connectedCh)
+	err := q.ExecuteBlockdevAddNVMe(context.Background(), "0000:01:00.0", 1,
+		fmt.Sprintf("drive_%s", volumeUUID), false)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	q.Shutdown()
+	<-disconnectedCh
+}
+
 // Checks that the chardev-remove command is correctly sent.
 //
 // We start a QMPLoop, send the chardev-remove command and stop the loop.
@@ -1631,6 +1738,23 @@ func TestExecuteNVDIMMDeviceAdd(t *testing.T) {
 	<-disconnectedCh
 }
 
+func TestExecuteVirtioPmemDeviceAdd(t *testing.T) {
+	connectedCh := make(chan *QMPVersion)
+	disconnectedCh := make(chan struct{})
+	buf := newQMPTestCommandBuffer(t)
+	buf.AddCommand("object-add", nil, "return", nil)
+	buf.AddCommand("device_add", nil, "return", nil)
+	cfg := QMPConfig{Logger: qmpTestLogger{}}
+	q := startQMPLoop(buf, cfg, connectedCh, disconnectedCh)
+	checkVersion(t, connectedCh)
+	err := q.ExecuteVirtioPmemDeviceAdd(context.Background(), "pmem0", "/var/lib/db.img", 2<<20, "0x02", "pci-bridge-0", false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	q.Shutdown()
+	<-disconnectedCh
+}
+
 func TestMainLoopEventBeforeGreeting(t *testing.T) {
 	const (
 		seconds      = int64(1352167040730)
@@ -41,10 +41,12 @@ type HypervisorState struct {
 	// HotpluggedCPUs is the list of CPUs that were hot-added
 	HotpluggedVCPUs []CPUDevice
 
-	HotpluggedMemory  int
-	VirtiofsDaemonPid int
-	Pid               int
-	PCIeRootPort      int
+	HotpluggedMemory         int
+	BalloonedMemory          int
+	VirtiofsDaemonPid        int
+	VolumesVirtiofsDaemonPid int
+	Pid                      int
+	PCIeRootPort             int
 
 	HotplugVFIOOnRootBus bool
 }
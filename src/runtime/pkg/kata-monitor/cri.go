@@ -146,6 +146,7 @@ func (km *KataMonitor) syncSandboxes(sandboxList []string) ([]string, error) {
 					uid:       pod.Metadata.Uid,
 					name:      pod.Metadata.Name,
 					namespace: pod.Metadata.Namespace,
+					labels:    pod.Labels,
 				})
 
 				sandboxList = removeFromSandboxList(sandboxList, sandbox)
@@ -55,6 +55,18 @@ var (
 		Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
 	})
 
+	pushCount = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: promNamespaceMonitor,
+		Name:      "push_count",
+		Help:      "Push count to the remote collector.",
+	})
+
+	pushFailedCount = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: promNamespaceMonitor,
+		Name:      "push_failed_count",
+		Help:      "Failed push count to the remote collector.",
+	})
+
 	gzipPool = sync.Pool{
 		New: func() interface{} {
 			return gzip.NewWriter(nil)
@@ -67,6 +79,8 @@ func registerMetrics() {
 	prometheus.MustRegister(scrapeCount)
 	prometheus.MustRegister(scrapeFailedCount)
 	prometheus.MustRegister(scrapeDurationsHistogram)
+	prometheus.MustRegister(pushCount)
+	prometheus.MustRegister(pushFailedCount)
 }
 
 // ProcessMetricsRequest get metrics from shim/hypervisor/vm/agent and return metrics to client.
@@ -91,7 +105,14 @@ func (km *KataMonitor) ProcessMetricsRequest(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// if no sandbox provided, will get all sandbox's metrics.
+	// if no sandbox provided, will get the metrics of all the sandboxes
+	// selected by the filter.
+	filter, err := getSandboxFilterFromReq(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
 
 	// prepare writer for writing response.
 	contentType := expfmt.Negotiate(r.Header)
@@ -116,13 +137,20 @@ func (km *KataMonitor) ProcessMetricsRequest(w http.ResponseWriter, r *http.Requ
 	// create encoder to encode metrics.
 	encoder := expfmt.NewEncoder(writer, contentType)
 
+	if err := km.encodeMetrics(encoder, filter); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+	}
+}
+
+// encodeMetrics encodes the metrics of kata-monitor, and the ones of the
+// sandboxes selected by the filter.
+func (km *KataMonitor) encodeMetrics(encoder expfmt.Encoder, filter sandboxFilter) error {
 	// gather metrics collected for management agent.
 	mfs, err := prometheus.DefaultGatherer.Gather()
 	if err != nil {
 		monitorLog.WithError(err).Error("failed to Gather metrics from prometheus.DefaultGatherer")
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(err.Error()))
-		return
+		return err
 	}
 
 	// encode metric gathered in current process
@@ -131,10 +159,12 @@ func (km *KataMonitor) ProcessMetricsRequest(w http.ResponseWriter, r *http.Requ
 	}
 
 	// aggregate sandboxes metrics and write to response by encoder
-	if err := km.aggregateSandboxMetrics(encoder); err != nil {
+	if err := km.aggregateSandboxMetrics(encoder, filter); err != nil {
 		monitorLog.WithError(err).Errorf("failed aggregateSandboxMetrics")
 		scrapeFailedCount.Inc()
 	}
+
+	return nil
 }
 
 func encodeMetricFamily(mfs []*dto.MetricFamily, encoder expfmt.Encoder) error {
@@ -154,11 +184,12 @@ func encodeMetricFamily(mfs []*dto.MetricFamily, encoder expfmt.Encoder) error {
 }
 
 // aggregateSandboxMetrics will get metrics from one sandbox and do some process
-func (km *KataMonitor) aggregateSandboxMetrics(encoder expfmt.Encoder) error {
-	// get all kata sandboxes from cache
-	sandboxes := km.sandboxCache.getSandboxList()
+func (km *KataMonitor) aggregateSandboxMetrics(encoder expfmt.Encoder, filter sandboxFilter) error {
 	// save running kata pods as a metrics.
-	runningShimCount.Set(float64(len(sandboxes)))
+	runningShimCount.Set(float64(len(km.sandboxCache.getSandboxList())))
+
+	// get the selected kata sandboxes from cache
+	sandboxes := km.getFilteredSandboxList(filter)
 
 	if len(sandboxes) == 0 {
 		return nil
@@ -181,7 +212,7 @@ func (km *KataMonitor) aggregateSandboxMetrics(encoder expfmt.Encoder) error {
 		}
 		wg.Add(1)
 		go func(sandboxID string, sandboxMetadata sandboxCRIMetadata, results chan<- []*dto.MetricFamily) {
-			sandboxMetrics, err := getParsedMetrics(sandboxID, sandboxMetadata)
+			sandboxMetrics, err := getParsedMetrics(sandboxID, sandboxMetadata, km.podLabels)
 			if err != nil {
 				monitorLog.WithError(err).WithField("sandbox_id", sandboxID).Errorf("failed to get metrics for sandbox")
 			}
@@ -238,13 +269,19 @@ func (km *KataMonitor) aggregateSandboxMetrics(encoder expfmt.Encoder) error {
 
 }
 
-func getParsedMetrics(sandboxID string, sandboxMetadata sandboxCRIMetadata) ([]*dto.MetricFamily, error) {
+func getParsedMetrics(sandboxID string, sandboxMetadata sandboxCRIMetadata, podLabels []string) ([]*dto.MetricFamily, error) {
 	body, err := shimclient.DoGet(sandboxID, defaultTimeout, "metrics")
 	if err != nil {
 		return nil, err
 	}
 
-	return parsePrometheusMetrics(sandboxID, sandboxMetadata, body)
+	mfs, err := parsePrometheusMetrics(sandboxID, sandboxMetadata, body)
+	if err != nil {
+		return nil, err
+	}
+
+	addPodLabels(mfs, sandboxMetadata, podLabels)
+	return mfs, nil
 }
 
 // GetSandboxMetrics will get sandbox's metrics from shim
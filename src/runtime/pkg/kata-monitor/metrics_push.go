@@ -0,0 +1,342 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package katamonitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	defaultPushTimeout = 10 * time.Second
+
+	// PushFormatText pushes the metrics in the Prometheus text format, as
+	// accepted by the Prometheus Pushgateway and the VictoriaMetrics import
+	// API.
+	PushFormatText = "text"
+
+	// PushFormatOTLP pushes the metrics with the OTLP/HTTP protocol, in
+	// protobuf, as accepted by the OpenTelemetry collectors.
+	PushFormatOTLP = "otlp"
+
+	contentTypeProtobuf = "application/x-protobuf"
+)
+
+// MetricsPusher pushes periodically the metrics of kata-monitor and of all
+// the sandboxes to a remote collector, for the nodes which cannot be
+// scraped.
+type MetricsPusher struct {
+	km       *KataMonitor
+	client   *http.Client
+	url      string
+	format   string
+	interval time.Duration
+	// start is the start time of the cumulative OTLP metrics
+	start time.Time
+}
+
+// NewMetricsPusher returns a MetricsPusher pushing the metrics to pushURL
+// every interval, in the given format. The user info of the URL is sent as
+// basic authentication.
+func NewMetricsPusher(km *KataMonitor, pushURL, format string, interval time.Duration) (*MetricsPusher, error) {
+	u, err := url.Parse(pushURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid push URL %q: the scheme must be http or https", pushURL)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid push URL %q: no host", pushURL)
+	}
+
+	if format != PushFormatText && format != PushFormatOTLP {
+		return nil, fmt.Errorf("invalid push format %q: must be %s or %s", format, PushFormatText, PushFormatOTLP)
+	}
+
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid push interval %v: must be positive", interval)
+	}
+
+	return &MetricsPusher{
+		km:       km,
+		client:   &http.Client{Timeout: defaultPushTimeout},
+		url:      pushURL,
+		format:   format,
+		interval: interval,
+		start:    time.Now(),
+	}, nil
+}
+
+// Run pushes the metrics until ctx is done.
+func (p *MetricsPusher) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.push(ctx); err != nil {
+			monitorLog.WithError(err).Error("failed to push the metrics")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *MetricsPusher) push(ctx context.Context) error {
+	pushCount.Inc()
+
+	var (
+		data        []byte
+		contentType string
+		err         error
+	)
+	if p.format == PushFormatOTLP {
+		data, err = p.encodeOTLP()
+		contentType = contentTypeProtobuf
+	} else {
+		data, err = p.encodeText()
+		contentType = string(expfmt.FmtText)
+	}
+	if err != nil {
+		pushFailedCount.Inc()
+		return err
+	}
+
+	size := len(data)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(data))
+	if err != nil {
+		pushFailedCount.Inc()
+		return err
+	}
+	req.Header.Set(contentTypeHeader, contentType)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		pushFailedCount.Inc()
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		pushFailedCount.Inc()
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %s from %s: %s", resp.Status, req.URL.Redacted(), bytes.TrimSpace(body))
+	}
+
+	monitorLog.WithField("size", size).Debug("metrics pushed")
+
+	return nil
+}
+
+func (p *MetricsPusher) encodeText() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := expfmt.NewEncoder(&buf, expfmt.FmtText)
+	if err := p.km.encodeMetrics(encoder, sandboxFilter{}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// metricFamilies is an expfmt.Encoder collecting the encoded metric families.
+type metricFamilies []*dto.MetricFamily
+
+func (mfs *metricFamilies) Encode(mf *dto.MetricFamily) error {
+	*mfs = append(*mfs, mf)
+	return nil
+}
+
+// encodeOTLP encodes the metrics in an OTLP export request. The Prometheus
+// counters, histograms and summaries being cumulative since the start of the
+// process exposing them, their start time is the one of the pusher.
+func (p *MetricsPusher) encodeOTLP() ([]byte, error) {
+	var mfs metricFamilies
+	if err := p.km.encodeMetrics(&mfs, sandboxFilter{}); err != nil {
+		return nil, err
+	}
+
+	resource := &resourcepb.Resource{
+		Attributes: []*commonpb.KeyValue{otlpAttribute("service.name", "kata-monitor")},
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		resource.Attributes = append(resource.Attributes, otlpAttribute("host.name", hostname))
+	}
+
+	return proto.Marshal(&collectorpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{{
+			Resource: resource,
+			InstrumentationLibraryMetrics: []*metricspb.InstrumentationLibraryMetrics{{
+				InstrumentationLibrary: &commonpb.InstrumentationLibrary{Name: "kata-monitor"},
+				Metrics:                otlpMetrics(mfs, p.start, time.Now()),
+			}},
+		}},
+	})
+}
+
+func otlpAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+// otlpMetrics converts Prometheus metric families to OTLP metrics.
+func otlpMetrics(mfs []*dto.MetricFamily, start, now time.Time) []*metricspb.Metric {
+	metrics := make([]*metricspb.Metric, 0, len(mfs))
+
+	for _, mf := range mfs {
+		metric := &metricspb.Metric{
+			Name:        mf.GetName(),
+			Description: mf.GetHelp(),
+		}
+
+		switch mf.GetType() {
+		case dto.MetricType_COUNTER:
+			sum := &metricspb.Sum{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				IsMonotonic:            true,
+			}
+			for _, m := range mf.Metric {
+				sum.DataPoints = append(sum.DataPoints, otlpNumberDataPoint(m, m.GetCounter().GetValue(), start, now))
+			}
+			metric.Data = &metricspb.Metric_Sum{Sum: sum}
+		case dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+			gauge := &metricspb.Gauge{}
+			for _, m := range mf.Metric {
+				value := m.GetGauge().GetValue()
+				if m.Untyped != nil {
+					value = m.GetUntyped().GetValue()
+				}
+				gauge.DataPoints = append(gauge.DataPoints, otlpNumberDataPoint(m, value, time.Time{}, now))
+			}
+			metric.Data = &metricspb.Metric_Gauge{Gauge: gauge}
+		case dto.MetricType_HISTOGRAM:
+			histogram := &metricspb.Histogram{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			}
+			for _, m := range mf.Metric {
+				histogram.DataPoints = append(histogram.DataPoints, otlpHistogramDataPoint(m, start, now))
+			}
+			metric.Data = &metricspb.Metric_Histogram{Histogram: histogram}
+		case dto.MetricType_SUMMARY:
+			summary := &metricspb.Summary{}
+			for _, m := range mf.Metric {
+				summary.DataPoints = append(summary.DataPoints, otlpSummaryDataPoint(m, start, now))
+			}
+			metric.Data = &metricspb.Metric_Summary{Summary: summary}
+		default:
+			continue
+		}
+
+		metrics = append(metrics, metric)
+	}
+
+	return metrics
+}
+
+func otlpAttributes(m *dto.Metric) []*commonpb.KeyValue {
+	attributes := make([]*commonpb.KeyValue, 0, len(m.Label))
+	for _, l := range m.Label {
+		attributes = append(attributes, otlpAttribute(l.GetName(), l.GetValue()))
+	}
+	return attributes
+}
+
+// otlpTimes returns the start and the time of a data point, in nanoseconds.
+func otlpTimes(m *dto.Metric, start, now time.Time) (uint64, uint64) {
+	if m.TimestampMs != nil {
+		now = time.Unix(0, m.GetTimestampMs()*int64(time.Millisecond))
+	}
+
+	var startNano uint64
+	if !start.IsZero() {
+		startNano = uint64(start.UnixNano())
+	}
+
+	return startNano, uint64(now.UnixNano())
+}
+
+func otlpNumberDataPoint(m *dto.Metric, value float64, start, now time.Time) *metricspb.NumberDataPoint {
+	startNano, timeNano := otlpTimes(m, start, now)
+
+	return &metricspb.NumberDataPoint{
+		Attributes:        otlpAttributes(m),
+		StartTimeUnixNano: startNano,
+		TimeUnixNano:      timeNano,
+		Value:             &metricspb.NumberDataPoint_AsDouble{AsDouble: value},
+	}
+}
+
+// otlpHistogramDataPoint converts a Prometheus histogram, whose buckets count
+// the observations up to their bound, to an OTLP one, whose buckets count the
+// observations between two bounds, the last one having no upper bound.
+func otlpHistogramDataPoint(m *dto.Metric, start, now time.Time) *metricspb.HistogramDataPoint {
+	h := m.GetHistogram()
+	startNano, timeNano := otlpTimes(m, start, now)
+
+	point := &metricspb.HistogramDataPoint{
+		Attributes:        otlpAttributes(m),
+		StartTimeUnixNano: startNano,
+		TimeUnixNano:      timeNano,
+		Count:             h.GetSampleCount(),
+		Sum:               h.GetSampleSum(),
+	}
+
+	var previous uint64
+	for _, b := range h.Bucket {
+		if math.IsInf(b.GetUpperBound(), 1) {
+			continue
+		}
+		point.ExplicitBounds = append(point.ExplicitBounds, b.GetUpperBound())
+		point.BucketCounts = append(point.BucketCounts, b.GetCumulativeCount()-previous)
+		previous = b.GetCumulativeCount()
+	}
+	point.BucketCounts = append(point.BucketCounts, h.GetSampleCount()-previous)
+
+	return point
+}
+
+func otlpSummaryDataPoint(m *dto.Metric, start, now time.Time) *metricspb.SummaryDataPoint {
+	s := m.GetSummary()
+	startNano, timeNano := otlpTimes(m, start, now)
+
+	point := &metricspb.SummaryDataPoint{
+		Attributes:        otlpAttributes(m),
+		StartTimeUnixNano: startNano,
+		TimeUnixNano:      timeNano,
+		Count:             s.GetSampleCount(),
+		Sum:               s.GetSampleSum(),
+	}
+	for _, q := range s.Quantile {
+		point.QuantileValues = append(point.QuantileValues, &metricspb.SummaryDataPoint_ValueAtQuantile{
+			Quantile: q.GetQuantile(),
+			Value:    q.GetValue(),
+		})
+	}
+
+	return point
+}
@@ -40,20 +40,34 @@ func SetLogger(logger *logrus.Entry) {
 type KataMonitor struct {
 	sandboxCache    *sandboxCache
 	runtimeEndpoint string
+	// podLabels are the keys of the pod labels added to the sandbox metrics
+	podLabels []string
 }
 
-// NewKataMonitor create and return a new KataMonitor instance
-func NewKataMonitor(runtimeEndpoint string) (*KataMonitor, error) {
+// NewKataMonitor create and return a new KataMonitor instance. The pod
+// labels whose keys are listed in podLabels are added to the metrics of
+// the sandboxes.
+func NewKataMonitor(runtimeEndpoint string, podLabels []string) (*KataMonitor, error) {
 	if runtimeEndpoint == "" {
 		return nil, errors.New("runtime endpoint missing")
 	}
 
+	labelKeys := make(map[string]string)
+	for _, key := range podLabels {
+		name := podLabelName(key)
+		if other, found := labelKeys[name]; found {
+			return nil, fmt.Errorf("pod labels %q and %q are both exported as %s", other, key, name)
+		}
+		labelKeys[name] = key
+	}
+
 	if !strings.HasPrefix(runtimeEndpoint, "unix") {
 		runtimeEndpoint = "unix://" + runtimeEndpoint
 	}
 
 	km := &KataMonitor{
 		runtimeEndpoint: runtimeEndpoint,
+		podLabels:       podLabels,
 		sandboxCache: &sandboxCache{
 			Mutex:     &sync.Mutex{},
 			sandboxes: make(map[string]sandboxCRIMetadata),
@@ -194,7 +208,13 @@ func (km *KataMonitor) GetAgentURL(w http.ResponseWriter, r *http.Request) {
 
 // ListSandboxes list all sandboxes running in Kata
 func (km *KataMonitor) ListSandboxes(w http.ResponseWriter, r *http.Request) {
-	sandboxes := km.sandboxCache.getSandboxList()
+	filter, err := getSandboxFilterFromReq(r)
+	if err != nil {
+		commonServeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	sandboxes := km.getFilteredSandboxList(filter)
 	htmlResponse := IfReturnHTMLResponse(w, r)
 	if htmlResponse {
 		listSandboxesHtml(sandboxes, w)
@@ -203,6 +223,25 @@ func (km *KataMonitor) ListSandboxes(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// getFilteredSandboxList returns the sandboxes selected by the filter.
+func (km *KataMonitor) getFilteredSandboxList(filter sandboxFilter) []string {
+	sandboxes := km.sandboxCache.getSandboxList()
+	if filter.isEmpty() {
+		return sandboxes
+	}
+
+	// The sandboxes whose CRI metadata is not synced yet, without uid, are
+	// skipped: they would match the negative label requirements.
+	var selected []string
+	for _, sandboxID := range sandboxes {
+		metadata, ok := km.sandboxCache.getCRIMetadata(sandboxID)
+		if ok && metadata.uid != "" && filter.matches(metadata) {
+			selected = append(selected, sandboxID)
+		}
+	}
+	return selected
+}
+
 func listSandboxesText(sandboxes []string, w http.ResponseWriter) {
 	for _, s := range sandboxes {
 		w.Write([]byte(fmt.Sprintf("%s\n", s)))
@@ -95,6 +95,11 @@ func (km *KataMonitor) ExpvarHandler(w http.ResponseWriter, r *http.Request) {
 	km.proxyRequest(w, r, nil)
 }
 
+// DebugStateHandler handles `/debug/state` requests
+func (km *KataMonitor) DebugStateHandler(w http.ResponseWriter, r *http.Request) {
+	km.proxyRequest(w, r, nil)
+}
+
 // PprofIndex handles other `/debug/pprof/` requests
 func (km *KataMonitor) PprofIndex(w http.ResponseWriter, r *http.Request) {
 	if len(strings.TrimPrefix(r.URL.Path, "/debug/pprof/")) == 0 {
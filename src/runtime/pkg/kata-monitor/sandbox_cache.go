@@ -13,6 +13,7 @@ type sandboxCRIMetadata struct {
 	uid       string
 	name      string
 	namespace string
+	labels    map[string]string
 }
 type sandboxCache struct {
 	*sync.Mutex
@@ -0,0 +1,168 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package katamonitor
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	mutils "github.com/kata-containers/kata-containers/src/runtime/pkg/utils"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	// query string keys of the sandbox filter
+	filterNamespaceKey = "namespace"
+	filterPodKey       = "pod"
+	filterLabelsKey    = "labels"
+
+	// prefix of the metric labels copied from the pod labels
+	podLabelPrefix = "cri_label_"
+)
+
+var invalidLabelNameChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// labelRequirement is a term of a label selector: "key=value",
+// "key!=value" or "key", the latter only requiring the label to be set.
+type labelRequirement struct {
+	key      string
+	value    string
+	exists   bool
+	negative bool
+}
+
+func (r labelRequirement) matches(labels map[string]string) bool {
+	value, found := labels[r.key]
+	if r.exists {
+		return found
+	}
+	if r.negative {
+		return !found || value != r.value
+	}
+	return found && value == r.value
+}
+
+// sandboxFilter selects the sandboxes from their CRI metadata. The
+// namespaces and pod names are alternatives, while all the label
+// requirements have to be met.
+type sandboxFilter struct {
+	namespaces []string
+	pods       []string
+	labels     []labelRequirement
+}
+
+// parseLabelSelector parses a comma separated list of label requirements,
+// e.g. "app=web,tier!=db,canary".
+func parseLabelSelector(selector string) ([]labelRequirement, error) {
+	var requirements []labelRequirement
+
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		var r labelRequirement
+		if i := strings.Index(term, "!="); i >= 0 {
+			r = labelRequirement{key: term[:i], value: term[i+2:], negative: true}
+		} else if i := strings.Index(term, "="); i >= 0 {
+			r = labelRequirement{key: term[:i], value: term[i+1:]}
+		} else {
+			r = labelRequirement{key: term, exists: true}
+		}
+
+		r.key = strings.TrimSpace(r.key)
+		r.value = strings.TrimSpace(r.value)
+		if r.key == "" {
+			return nil, fmt.Errorf("invalid label selector %q: missing label name", term)
+		}
+
+		requirements = append(requirements, r)
+	}
+
+	return requirements, nil
+}
+
+// getSandboxFilterFromReq returns the sandbox filter passed in the query
+// string of the request, e.g. "?namespace=default&labels=app=web".
+func getSandboxFilterFromReq(r *http.Request) (sandboxFilter, error) {
+	query := r.URL.Query()
+
+	filter := sandboxFilter{
+		namespaces: query[filterNamespaceKey],
+		pods:       query[filterPodKey],
+	}
+
+	for _, selector := range query[filterLabelsKey] {
+		requirements, err := parseLabelSelector(selector)
+		if err != nil {
+			return sandboxFilter{}, err
+		}
+		filter.labels = append(filter.labels, requirements...)
+	}
+
+	return filter, nil
+}
+
+func (f sandboxFilter) isEmpty() bool {
+	return len(f.namespaces) == 0 && len(f.pods) == 0 && len(f.labels) == 0
+}
+
+func matchesAny(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// matches returns true if the sandbox with the CRI metadata is selected.
+func (f sandboxFilter) matches(metadata sandboxCRIMetadata) bool {
+	if !matchesAny(f.namespaces, metadata.namespace) || !matchesAny(f.pods, metadata.name) {
+		return false
+	}
+
+	for _, r := range f.labels {
+		if !r.matches(metadata.labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// podLabelName returns the name of the metric label holding the value of
+// the pod label key, which may contain characters invalid in Prometheus,
+// e.g. "app.kubernetes.io/name" becomes "cri_label_app_kubernetes_io_name".
+func podLabelName(key string) string {
+	return podLabelPrefix + invalidLabelNameChars.ReplaceAllString(key, "_")
+}
+
+// addPodLabels relabels the metrics of a sandbox with the pod labels
+// listed in podLabels. Labels missing from the pod are set empty, so that
+// all the metrics of a family have the same label names.
+func addPodLabels(mfs []*dto.MetricFamily, metadata sandboxCRIMetadata, podLabels []string) {
+	if len(podLabels) == 0 {
+		return
+	}
+
+	for _, mf := range mfs {
+		for _, metric := range mf.Metric {
+			for _, key := range podLabels {
+				metric.Label = append(metric.Label, &dto.LabelPair{
+					Name:  mutils.String2Pointer(podLabelName(key)),
+					Value: mutils.String2Pointer(metadata.labels[key]),
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package katamonitor
+
+import (
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	assert := assert.New(t)
+
+	requirements, err := parseLabelSelector("app=web, tier!=db,canary,")
+	assert.NoError(err)
+	assert.Equal([]labelRequirement{
+		{key: "app", value: "web"},
+		{key: "tier", value: "db", negative: true},
+		{key: "canary", exists: true},
+	}, requirements)
+
+	requirements, err = parseLabelSelector("")
+	assert.NoError(err)
+	assert.Empty(requirements)
+
+	_, err = parseLabelSelector("=web")
+	assert.Error(err)
+}
+
+func TestSandboxFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	web := sandboxCRIMetadata{"1", "web-0", "prod", map[string]string{"app": "web", "tier": "front"}}
+	db := sandboxCRIMetadata{"2", "db-0", "prod", map[string]string{"app": "db"}}
+	dev := sandboxCRIMetadata{"3", "web-0", "dev", nil}
+
+	type testData struct {
+		query    string
+		expected []sandboxCRIMetadata
+	}
+
+	for _, d := range []testData{
+		{"", []sandboxCRIMetadata{web, db, dev}},
+		{"namespace=prod", []sandboxCRIMetadata{web, db}},
+		{"namespace=prod&namespace=dev", []sandboxCRIMetadata{web, db, dev}},
+		{"pod=web-0", []sandboxCRIMetadata{web, dev}},
+		{"namespace=dev&pod=web-0", []sandboxCRIMetadata{dev}},
+		{"labels=app=web", []sandboxCRIMetadata{web}},
+		{"labels=app!=web", []sandboxCRIMetadata{db, dev}},
+		{"labels=app", []sandboxCRIMetadata{web, db}},
+		{"labels=app,tier=front&labels=app!=db", []sandboxCRIMetadata{web}},
+		{"namespace=other", nil},
+	} {
+		req := httptest.NewRequest("GET", "/metrics?"+d.query, nil)
+		filter, err := getSandboxFilterFromReq(req)
+		assert.NoError(err, d.query)
+		assert.Equal(d.query == "", filter.isEmpty(), d.query)
+
+		var selected []sandboxCRIMetadata
+		for _, m := range []sandboxCRIMetadata{web, db, dev} {
+			if filter.matches(m) {
+				selected = append(selected, m)
+			}
+		}
+		assert.Equal(d.expected, selected, d.query)
+	}
+
+	req := httptest.NewRequest("GET", "/metrics?labels=!=x", nil)
+	_, err := getSandboxFilterFromReq(req)
+	assert.Error(err)
+}
+
+func TestGetFilteredSandboxList(t *testing.T) {
+	assert := assert.New(t)
+
+	km := &KataMonitor{
+		sandboxCache: &sandboxCache{
+			Mutex: &sync.Mutex{},
+			sandboxes: map[string]sandboxCRIMetadata{
+				"111": {"1", "web-0", "prod", map[string]string{"app": "web"}},
+				"222": {"2", "db-0", "prod", map[string]string{"app": "db"}},
+				// metadata not synced yet
+				"333": {},
+			},
+		},
+	}
+
+	sandboxes := km.getFilteredSandboxList(sandboxFilter{})
+	sort.Strings(sandboxes)
+	assert.Equal([]string{"111", "222", "333"}, sandboxes)
+
+	sandboxes = km.getFilteredSandboxList(sandboxFilter{namespaces: []string{"prod"}})
+	sort.Strings(sandboxes)
+	assert.Equal([]string{"111", "222"}, sandboxes)
+
+	sandboxes = km.getFilteredSandboxList(sandboxFilter{labels: []labelRequirement{{key: "app", value: "db"}}})
+	assert.Equal([]string{"222"}, sandboxes)
+
+	sandboxes = km.getFilteredSandboxList(sandboxFilter{labels: []labelRequirement{{key: "app", value: "db", negative: true}}})
+	assert.Equal([]string{"111"}, sandboxes)
+}
+
+func TestAddPodLabels(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("cri_label_app_kubernetes_io_name", podLabelName("app.kubernetes.io/name"))
+
+	metadata := sandboxCRIMetadata{"123", "pod-name", "pod-namespace", map[string]string{"app.kubernetes.io/name": "web"}}
+	list, err := parsePrometheusMetrics("sandboxID-abc", metadata, []byte(shimMetricBody))
+	assert.NoError(err)
+
+	addPodLabels(list, metadata, []string{"app.kubernetes.io/name", "team"})
+
+	for _, mf := range list {
+		for _, m := range mf.Metric {
+			assert.Len(m.Label, 6)
+			assert.Equal("cri_label_app_kubernetes_io_name", *m.Label[4].Name)
+			assert.Equal("web", *m.Label[4].Value)
+			assert.Equal("cri_label_team", *m.Label[5].Name)
+			assert.Equal("", *m.Label[5].Value)
+		}
+	}
+}
@@ -12,10 +12,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	goruntime "runtime"
 	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/artifacts"
 	"github.com/kata-containers/kata-containers/src/runtime/pkg/govmm"
 	govmmQemu "github.com/kata-containers/kata-containers/src/runtime/pkg/govmm/qemu"
 	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils/katatrace"
@@ -80,6 +83,7 @@ type hypervisor struct {
 	CtlPath                        string   `toml:"ctlpath"`
 	Initrd                         string   `toml:"initrd"`
 	Image                          string   `toml:"image"`
+	RootfsType                     string   `toml:"rootfs_type"`
 	Firmware                       string   `toml:"firmware"`
 	FirmwareVolume                 string   `toml:"firmware_volume"`
 	MachineAccelerators            string   `toml:"machine_accelerators"`
@@ -89,8 +93,18 @@ type hypervisor struct {
 	BlockDeviceDriver              string   `toml:"block_device_driver"`
 	EntropySource                  string   `toml:"entropy_source"`
 	SharedFS                       string   `toml:"shared_fs"`
+	SharedFSReadOnly               bool     `toml:"shared_fs_readonly"`
 	VirtioFSDaemon                 string   `toml:"virtio_fs_daemon"`
 	VirtioFSCache                  string   `toml:"virtio_fs_cache"`
+	VirtioFSSandbox                string   `toml:"virtio_fs_sandbox"`
+	VirtioFSSeccomp                string   `toml:"virtio_fs_seccomp"`
+	VirtioFSVolumesDaemon          bool     `toml:"virtio_fs_volumes_daemon"`
+	VirtioFSVolumesCache           string   `toml:"virtio_fs_volumes_cache"`
+	VirtioFSWriteback              bool     `toml:"virtio_fs_writeback"`
+	VirtioFSVolumesWriteback       bool     `toml:"virtio_fs_volumes_writeback"`
+	VirtioFSMilliCPUs              uint32   `toml:"virtio_fs_millicpus"`
+	VirtioFSMemoryMB               uint32   `toml:"virtio_fs_memory_mb"`
+	VirtioFSCPUWeight              uint32   `toml:"virtio_fs_cpu_weight"`
 	VhostUserStorePath             string   `toml:"vhost_user_store_path"`
 	FileBackedMemRootDir           string   `toml:"file_mem_backend"`
 	GuestHookPath                  string   `toml:"guest_hook_path"`
@@ -100,6 +114,7 @@ type hypervisor struct {
 	CtlPathList                    []string `toml:"valid_ctlpaths"`
 	VirtioFSDaemonList             []string `toml:"valid_virtio_fs_daemon_paths"`
 	VirtioFSExtraArgs              []string `toml:"virtio_fs_extra_args"`
+	VirtioFSModcaps                []string `toml:"virtio_fs_modcaps"`
 	PFlashList                     []string `toml:"pflashes"`
 	VhostUserStorePathList         []string `toml:"valid_vhost_user_store_paths"`
 	FileBackedMemRootList          []string `toml:"valid_file_mem_backends"`
@@ -117,21 +132,38 @@ type hypervisor struct {
 	NetRateLimiterOpsMaxRate       int64    `toml:"net_rate_limiter_ops_max_rate"`
 	NetRateLimiterOpsOneTimeBurst  int64    `toml:"net_rate_limiter_ops_one_time_burst"`
 	VirtioFSCacheSize              uint32   `toml:"virtio_fs_cache_size"`
+	VirtioFSCacheSizeMax           uint32   `toml:"virtio_fs_cache_size_max"`
+	NetworkQueues                  uint32   `toml:"network_queues"`
 	DefaultMaxVCPUs                uint32   `toml:"default_maxvcpus"`
 	MemorySize                     uint32   `toml:"default_memory"`
 	MemSlots                       uint32   `toml:"memory_slots"`
 	DefaultBridges                 uint32   `toml:"default_bridges"`
 	Msize9p                        uint32   `toml:"msize_9p"`
+	Cache9p                        string   `toml:"cache_9p"`
+	Version9p                      string   `toml:"version_9p"`
 	PCIeRootPort                   uint32   `toml:"pcie_root_port"`
+	SCSIControllers                uint32   `toml:"scsi_controllers"`
+	SCSIQueueDepth                 uint32   `toml:"scsi_queue_depth"`
 	NumVCPUs                       int32    `toml:"default_vcpus"`
 	BlockDeviceCacheSet            bool     `toml:"block_device_cache_set"`
 	BlockDeviceCacheDirect         bool     `toml:"block_device_cache_direct"`
 	BlockDeviceCacheNoflush        bool     `toml:"block_device_cache_noflush"`
+	BlockDeviceDiscard             bool     `toml:"block_device_discard"`
+	BlockRootfsThresholdMB         uint64   `toml:"block_rootfs_threshold_mb"`
+	LayerCachePath                 string   `toml:"layer_cache_path"`
+	LayerCacheSizeMB               uint64   `toml:"layer_cache_size_mb"`
+	FileBackedBlockDevice          string   `toml:"file_backed_block_device"`
+	UblkQueues                     uint32   `toml:"ublk_queues"`
+	UblkQueueDepth                 uint32   `toml:"ublk_queue_depth"`
+	PmemEmptyDirSizeMB             uint64   `toml:"pmem_empty_dir_size_mb"`
 	EnableVhostUserStore           bool     `toml:"enable_vhost_user_store"`
 	DisableBlockDeviceUse          bool     `toml:"disable_block_device_use"`
 	MemPrealloc                    bool     `toml:"enable_mem_prealloc"`
 	HugePages                      bool     `toml:"enable_hugepages"`
 	VirtioMem                      bool     `toml:"enable_virtio_mem"`
+	EnableMemoryBalloon            bool     `toml:"enable_memory_balloon"`
+	MemoryShrinkHysteresisMB       uint32   `toml:"memory_shrink_hysteresis_mb"`
+	EnableGuestNUMA                bool     `toml:"enable_guest_numa"`
 	IOMMU                          bool     `toml:"enable_iommu"`
 	IOMMUPlatform                  bool     `toml:"enable_iommu_platform"`
 	Debug                          bool     `toml:"enable_debug"`
@@ -140,6 +172,7 @@ type hypervisor struct {
 	DisableImageNvdimm             bool     `toml:"disable_image_nvdimm"`
 	HotplugVFIOOnRootBus           bool     `toml:"hotplug_vfio_on_root_bus"`
 	DisableVhostNet                bool     `toml:"disable_vhost_net"`
+	EnableNetRSS                   bool     `toml:"enable_net_rss"`
 	GuestMemoryDumpPaging          bool     `toml:"guest_memory_dump_paging"`
 	ConfidentialGuest              bool     `toml:"confidential_guest"`
 	GuestSwap                      bool     `toml:"enable_guest_swap"`
@@ -151,9 +184,17 @@ type hypervisor struct {
 
 type runtime struct {
 	InterNetworkModel         string   `toml:"internetworking_model"`
+	HostNetworkMode           string   `toml:"host_network_mode"`
+	MacvtapMode               string   `toml:"macvtap_mode"`
+	PasstPath                 string   `toml:"passt_path"`
 	JaegerEndpoint            string   `toml:"jaeger_endpoint"`
 	JaegerUser                string   `toml:"jaeger_user"`
 	JaegerPassword            string   `toml:"jaeger_password"`
+	TracingExporter           string   `toml:"tracing_exporter"`
+	OTLPEndpoint              string   `toml:"otlp_endpoint"`
+	OTLPProtocol              string   `toml:"otlp_protocol"`
+	OTLPCACert                string   `toml:"otlp_ca_cert"`
+	OTLPHeaders               []string `toml:"otlp_headers"`
 	VfioMode                  string   `toml:"vfio_mode"`
 	SandboxBindMounts         []string `toml:"sandbox_bind_mounts"`
 	Experimental              []string `toml:"experimental"`
@@ -161,9 +202,28 @@ type runtime struct {
 	Tracing                   bool     `toml:"enable_tracing"`
 	DisableNewNetNs           bool     `toml:"disable_new_netns"`
 	DisableGuestSeccomp       bool     `toml:"disable_guest_seccomp"`
+	RequireGuestSeccomp       bool     `toml:"require_guest_seccomp"`
+	GuestTimeSyncInterval     uint32   `toml:"guest_time_sync_interval"`
+	GuestTimeSource           string   `toml:"guest_time_source"`
+	GuestNTPServers           []string `toml:"guest_ntp_servers"`
+	EnableGuestHooks          bool     `toml:"enable_guest_hooks"`
+	CrashDiagnosticsDir       string   `toml:"crash_diagnostics_dir"`
+	ArtifactsPublicKey        string   `toml:"artifacts_public_key"`
+	ForwardGuestKernelLog     bool     `toml:"forward_guest_kernel_log"`
+	ConsoleCaptureSize        uint32   `toml:"console_capture_size"`
+	EnableAudit               bool     `toml:"enable_audit"`
+	LinuxAudit                bool     `toml:"linux_audit"`
+	SlowOperationThreshold    uint32   `toml:"slow_operation_threshold"`
+	LogRateLimit              uint32   `toml:"log_rate_limit"`
+	LogRotateSize             uint32   `toml:"log_rotate_size"`
+	ShimCPUWeight             uint32   `toml:"shim_cpu_weight"`
+	ShimMilliCPUs             uint32   `toml:"shim_millicpus"`
+	ShimMemoryMB              uint32   `toml:"shim_memory_mb"`
 	SandboxCgroupOnly         bool     `toml:"sandbox_cgroup_only"`
+	EnableVCPUsPinning        bool     `toml:"enable_vcpus_pinning"`
 	StaticSandboxResourceMgmt bool     `toml:"static_sandbox_resource_mgmt"`
 	EnablePprof               bool     `toml:"enable_pprof"`
+	VerifyArtifacts           bool     `toml:"verify_artifacts"`
 	DisableGuestEmptyDir      bool     `toml:"disable_guest_empty_dir"`
 }
 
@@ -173,6 +233,14 @@ type agent struct {
 	Tracing             bool     `toml:"enable_tracing"`
 	DebugConsoleEnabled bool     `toml:"debug_console_enabled"`
 	DialTimeout         uint32   `toml:"dial_timeout"`
+	HealthCheckInterval uint32   `toml:"health_check_interval"`
+	HealthCheckFailures uint32   `toml:"health_check_failures"`
+	PolicyFile          string   `toml:"policy_file"`
+
+	CreateContainerTimeout uint32 `toml:"create_container_timeout"`
+	ExecProcessTimeout     uint32 `toml:"exec_process_timeout"`
+	HotplugTimeout         uint32 `toml:"hotplug_timeout"`
+	ShutdownTimeout        uint32 `toml:"shutdown_timeout"`
 }
 
 func (h hypervisor) path() (string, error) {
@@ -435,8 +503,71 @@ func (h hypervisor) blockDeviceDriver() (string, error) {
 	return "", fmt.Errorf("Invalid hypervisor block storage driver %v specified (supported drivers: %v)", h.BlockDeviceDriver, supportedBlockDrivers)
 }
 
+func (h hypervisor) fileBackedBlockDevice() (string, error) {
+	supportedBlockDevices := []string{config.LoopBlockDevice, config.UblkBlockDevice}
+
+	if h.FileBackedBlockDevice == "" {
+		return config.LoopBlockDevice, nil
+	}
+
+	for _, d := range supportedBlockDevices {
+		if d == h.FileBackedBlockDevice {
+			return h.FileBackedBlockDevice, nil
+		}
+	}
+
+	return "", fmt.Errorf("Invalid file backed block device %v specified (supported devices: %v)", h.FileBackedBlockDevice, supportedBlockDevices)
+}
+
+// virtioFSModcapRegex matches a capability added to or removed from virtiofsd
+var virtioFSModcapRegex = regexp.MustCompile(`^[+-][a-z_]+$`)
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+// checkVirtioFSSandboxing checks the sandboxing options of virtiofsd, which
+// the daemon checks against its version when it is started.
+func (h hypervisor) checkVirtioFSSandboxing() error {
+	supportedSandboxModes := []string{"namespace", "chroot", "none"}
+	supportedSeccompActions := []string{"kill", "log", "trap", "none"}
+
+	if h.VirtioFSSandbox != "" && !contains(supportedSandboxModes, h.VirtioFSSandbox) {
+		return fmt.Errorf("Invalid virtio-fs sandbox mode %v specified (supported modes: %v)", h.VirtioFSSandbox, supportedSandboxModes)
+	}
+
+	if h.VirtioFSSeccomp != "" && !contains(supportedSeccompActions, h.VirtioFSSeccomp) {
+		return fmt.Errorf("Invalid virtio-fs seccomp action %v specified (supported actions: %v)", h.VirtioFSSeccomp, supportedSeccompActions)
+	}
+
+	for _, c := range h.VirtioFSModcaps {
+		if !virtioFSModcapRegex.MatchString(c) {
+			return fmt.Errorf("Invalid virtio-fs modcap %v specified (expected +<capability> or -<capability>)", c)
+		}
+	}
+
+	return nil
+}
+
+// checkVirtioFSVolumesCache checks the cache mode of the virtiofsd sharing the
+// volumes, which defaults to the one of the root filesystems.
+func (h hypervisor) checkVirtioFSVolumesCache() error {
+	supportedCacheModes := []string{"none", "auto", "always"}
+
+	if h.VirtioFSVolumesCache != "" && !contains(supportedCacheModes, h.VirtioFSVolumesCache) {
+		return fmt.Errorf("Invalid virtio-fs volumes cache mode %v specified (supported modes: %v)", h.VirtioFSVolumesCache, supportedCacheModes)
+	}
+
+	return nil
+}
+
 func (h hypervisor) sharedFS() (string, error) {
-	supportedSharedFS := []string{config.Virtio9P, config.VirtioFS, config.VirtioFSNydus}
+	supportedSharedFS := []string{config.Virtio9P, config.VirtioFS, config.VirtioFSNydus, config.NoSharedFS}
 
 	if h.SharedFS == "" {
 		return config.VirtioFS, nil
@@ -459,6 +590,28 @@ func (h hypervisor) msize9p() uint32 {
 	return h.Msize9p
 }
 
+// check9pOptions checks the cache mode and the protocol version of the 9p
+// shares.
+func (h hypervisor) check9pOptions() error {
+	if h.Cache9p != "" && !contains(vc.Supported9pCacheModes, h.Cache9p) {
+		return fmt.Errorf("Invalid 9p cache mode %v specified (supported modes: %v)", h.Cache9p, vc.Supported9pCacheModes)
+	}
+
+	if h.Version9p != "" && !contains(vc.Supported9pVersions, h.Version9p) {
+		return fmt.Errorf("Invalid 9p version %v specified (supported versions: %v)", h.Version9p, vc.Supported9pVersions)
+	}
+
+	return nil
+}
+
+func (h hypervisor) layerCacheSizeMB() uint64 {
+	if h.LayerCacheSizeMB == 0 {
+		return defaultLayerCacheSizeMB
+	}
+
+	return h.LayerCacheSizeMB
+}
+
 func (h hypervisor) guestHookPath() string {
 	if h.GuestHookPath == "" {
 		return defaultGuestHookPath
@@ -584,6 +737,19 @@ func (a agent) kernelModules() []string {
 	return a.KernelModules
 }
 
+func (a agent) policy() (string, error) {
+	if a.PolicyFile == "" {
+		return "", nil
+	}
+
+	policy, err := os.ReadFile(a.PolicyFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the agent policy: %v", err)
+	}
+
+	return string(policy), nil
+}
+
 func newFirecrackerHypervisorConfig(h hypervisor) (vc.HypervisorConfig, error) {
 	hypervisor, err := h.path()
 	if err != nil {
@@ -628,6 +794,7 @@ func newFirecrackerHypervisorConfig(h hypervisor) (vc.HypervisorConfig, error) {
 		KernelPath:            kernel,
 		InitrdPath:            initrd,
 		ImagePath:             image,
+		RootfsType:            h.RootfsType,
 		FirmwarePath:          firmware,
 		KernelParams:          vc.DeserializeParams(strings.Fields(kernelParams)),
 		NumVCPUs:              h.defaultVCPUs(),
@@ -700,6 +867,11 @@ func newQemuHypervisorConfig(h hypervisor) (vc.HypervisorConfig, error) {
 		return vc.HypervisorConfig{}, err
 	}
 
+	fileBackedBlockDevice, err := h.fileBackedBlockDevice()
+	if err != nil {
+		return vc.HypervisorConfig{}, err
+	}
+
 	sharedFS, err := h.sharedFS()
 	if err != nil {
 		return vc.HypervisorConfig{}, err
@@ -710,6 +882,33 @@ func newQemuHypervisorConfig(h hypervisor) (vc.HypervisorConfig, error) {
 			fmt.Errorf("cannot enable %s without daemon path in configuration file", sharedFS)
 	}
 
+	if sharedFS == config.NoSharedFS && h.DisableBlockDeviceUse {
+		return vc.HypervisorConfig{},
+			errors.New("cannot disable the block devices without a shared file system")
+	}
+
+	if err := h.checkVirtioFSSandboxing(); err != nil {
+		return vc.HypervisorConfig{}, err
+	}
+
+	if h.VirtioFSVolumesDaemon && sharedFS != config.VirtioFS {
+		return vc.HypervisorConfig{},
+			fmt.Errorf("cannot enable virtio_fs_volumes_daemon with %s", sharedFS)
+	}
+
+	if err := h.checkVirtioFSVolumesCache(); err != nil {
+		return vc.HypervisorConfig{}, err
+	}
+
+	if err := h.check9pOptions(); err != nil {
+		return vc.HypervisorConfig{}, err
+	}
+
+	if h.VirtioFSCacheSizeMax > 0 && h.VirtioFSCacheSize > h.VirtioFSCacheSizeMax {
+		return vc.HypervisorConfig{},
+			fmt.Errorf("virtio_fs_cache_size %d is greater than virtio_fs_cache_size_max %d", h.VirtioFSCacheSize, h.VirtioFSCacheSizeMax)
+	}
+
 	if vSock, err := utils.SupportsVsocks(); !vSock {
 		return vc.HypervisorConfig{}, err
 	}
@@ -723,6 +922,7 @@ func newQemuHypervisorConfig(h hypervisor) (vc.HypervisorConfig, error) {
 		KernelPath:              kernel,
 		InitrdPath:              initrd,
 		ImagePath:               image,
+		RootfsType:              h.RootfsType,
 		FirmwarePath:            firmware,
 		FirmwareVolumePath:      firmwareVolume,
 		PFlash:                  pflashes,
@@ -736,16 +936,27 @@ func newQemuHypervisorConfig(h hypervisor) (vc.HypervisorConfig, error) {
 		MemSlots:                h.defaultMemSlots(),
 		MemOffset:               h.defaultMemOffset(),
 		VirtioMem:               h.VirtioMem,
+		EnableMemoryBalloon:     h.EnableMemoryBalloon,
+		EnableGuestNUMA:         h.EnableGuestNUMA,
 		EntropySource:           h.GetEntropySource(),
 		EntropySourceList:       h.EntropySourceList,
 		DefaultBridges:          h.defaultBridges(),
 		DisableBlockDeviceUse:   h.DisableBlockDeviceUse,
 		SharedFS:                sharedFS,
+		SharedFSReadOnly:        h.SharedFSReadOnly,
 		VirtioFSDaemon:          h.VirtioFSDaemon,
 		VirtioFSDaemonList:      h.VirtioFSDaemonList,
 		VirtioFSCacheSize:       h.VirtioFSCacheSize,
+		VirtioFSCacheSizeMax:    h.VirtioFSCacheSizeMax,
 		VirtioFSCache:           h.defaultVirtioFSCache(),
 		VirtioFSExtraArgs:       h.VirtioFSExtraArgs,
+		VirtioFSSandbox:         h.VirtioFSSandbox,
+		VirtioFSSeccomp:         h.VirtioFSSeccomp,
+		VirtioFSModcaps:         h.VirtioFSModcaps,
+		VirtioFSVolumesDaemon:   h.VirtioFSVolumesDaemon,
+		VirtioFSMilliCPUs:       h.VirtioFSMilliCPUs,
+		VirtioFSMemoryMB:        h.VirtioFSMemoryMB,
+		VirtioFSCPUWeight:       h.VirtioFSCPUWeight,
 		MemPrealloc:             h.MemPrealloc,
 		HugePages:               h.HugePages,
 		IOMMU:                   h.IOMMU,
@@ -758,12 +969,26 @@ func newQemuHypervisorConfig(h hypervisor) (vc.HypervisorConfig, error) {
 		BlockDeviceCacheSet:     h.BlockDeviceCacheSet,
 		BlockDeviceCacheDirect:  h.BlockDeviceCacheDirect,
 		BlockDeviceCacheNoflush: h.BlockDeviceCacheNoflush,
+		BlockDeviceDiscard:      h.BlockDeviceDiscard,
+		BlockRootfsThresholdMB:  h.BlockRootfsThresholdMB,
+		LayerCachePath:          h.LayerCachePath,
+		LayerCacheSizeMB:        h.layerCacheSizeMB(),
+		FileBackedBlockDevice:   fileBackedBlockDevice,
+		UblkQueues:              h.UblkQueues,
+		UblkQueueDepth:          h.UblkQueueDepth,
+		PmemEmptyDirSizeMB:      h.PmemEmptyDirSizeMB,
 		EnableIOThreads:         h.EnableIOThreads,
+		SCSIControllers:         h.SCSIControllers,
+		SCSIQueueDepth:          h.SCSIQueueDepth,
 		Msize9p:                 h.msize9p(),
+		Cache9p:                 h.Cache9p,
+		Version9p:               h.Version9p,
 		DisableImageNvdimm:      h.DisableImageNvdimm,
 		HotplugVFIOOnRootBus:    h.HotplugVFIOOnRootBus,
 		PCIeRootPort:            h.PCIeRootPort,
 		DisableVhostNet:         h.DisableVhostNet,
+		NetworkQueues:           h.NetworkQueues,
+		EnableNetRSS:            h.EnableNetRSS,
 		EnableVhostUserStore:    h.EnableVhostUserStore,
 		VhostUserStorePath:      h.vhostUserStorePath(),
 		VhostUserStorePathList:  h.VhostUserStorePathList,
@@ -777,6 +1002,11 @@ func newQemuHypervisorConfig(h hypervisor) (vc.HypervisorConfig, error) {
 		GuestSwap:               h.GuestSwap,
 		Rootless:                h.Rootless,
 		LegacySerial:            h.LegacySerial,
+
+		VirtioFSVolumesCache:     h.VirtioFSVolumesCache,
+		VirtioFSWriteback:        h.VirtioFSWriteback,
+		VirtioFSVolumesWriteback: h.VirtioFSVolumesWriteback,
+		MemoryShrinkHysteresisMB: h.MemoryShrinkHysteresisMB,
 	}, nil
 }
 
@@ -823,6 +1053,7 @@ func newAcrnHypervisorConfig(h hypervisor) (vc.HypervisorConfig, error) {
 		HypervisorPathList:    h.HypervisorPathList,
 		KernelPath:            kernel,
 		ImagePath:             image,
+		RootfsType:            h.RootfsType,
 		HypervisorCtlPath:     hypervisorctl,
 		HypervisorCtlPathList: h.CtlPathList,
 		FirmwarePath:          firmware,
@@ -879,26 +1110,61 @@ func newClhHypervisorConfig(h hypervisor) (vc.HypervisorConfig, error) {
 		return vc.HypervisorConfig{}, err
 	}
 
+	fileBackedBlockDevice, err := h.fileBackedBlockDevice()
+	if err != nil {
+		return vc.HypervisorConfig{}, err
+	}
+
 	sharedFS, err := h.sharedFS()
 	if err != nil {
 		return vc.HypervisorConfig{}, err
 	}
 
-	if sharedFS != config.VirtioFS && sharedFS != config.VirtioFSNydus {
-		return vc.HypervisorConfig{}, errors.New("clh only support virtio-fs or virtio-fs-nydus")
+	if sharedFS != config.VirtioFS && sharedFS != config.VirtioFSNydus && sharedFS != config.NoSharedFS {
+		return vc.HypervisorConfig{}, errors.New("clh only support virtio-fs, virtio-fs-nydus or none")
 	}
 
-	if h.VirtioFSDaemon == "" {
+	if sharedFS != config.NoSharedFS && h.VirtioFSDaemon == "" {
 		return vc.HypervisorConfig{},
 			fmt.Errorf("cannot enable %s without daemon path in configuration file", sharedFS)
 	}
 
+	if sharedFS == config.NoSharedFS && h.DisableBlockDeviceUse {
+		return vc.HypervisorConfig{},
+			errors.New("cannot disable the block devices without a shared file system")
+	}
+
+	// The disks of Cloud Hypervisor cannot be configured to pass the
+	// discard requests down
+	if h.BlockDeviceDiscard {
+		return vc.HypervisorConfig{}, errors.New("block_device_discard is not supported by clh")
+	}
+
+	if err := h.checkVirtioFSSandboxing(); err != nil {
+		return vc.HypervisorConfig{}, err
+	}
+
+	if h.VirtioFSVolumesDaemon && sharedFS != config.VirtioFS {
+		return vc.HypervisorConfig{},
+			fmt.Errorf("cannot enable virtio_fs_volumes_daemon with %s", sharedFS)
+	}
+
+	if err := h.checkVirtioFSVolumesCache(); err != nil {
+		return vc.HypervisorConfig{}, err
+	}
+
+	if h.VirtioFSCacheSizeMax > 0 && h.VirtioFSCacheSize > h.VirtioFSCacheSizeMax {
+		return vc.HypervisorConfig{},
+			fmt.Errorf("virtio_fs_cache_size %d is greater than virtio_fs_cache_size_max %d", h.VirtioFSCacheSize, h.VirtioFSCacheSizeMax)
+	}
+
 	return vc.HypervisorConfig{
 		HypervisorPath:                 hypervisor,
 		HypervisorPathList:             h.HypervisorPathList,
 		KernelPath:                     kernel,
 		InitrdPath:                     initrd,
 		ImagePath:                      image,
+		RootfsType:                     h.RootfsType,
 		FirmwarePath:                   firmware,
 		MachineAccelerators:            machineAccelerators,
 		KernelParams:                   vc.DeserializeParams(strings.Fields(kernelParams)),
@@ -914,9 +1180,11 @@ func newClhHypervisorConfig(h hypervisor) (vc.HypervisorConfig, error) {
 		DefaultBridges:                 h.defaultBridges(),
 		DisableBlockDeviceUse:          h.DisableBlockDeviceUse,
 		SharedFS:                       sharedFS,
+		SharedFSReadOnly:               h.SharedFSReadOnly,
 		VirtioFSDaemon:                 h.VirtioFSDaemon,
 		VirtioFSDaemonList:             h.VirtioFSDaemonList,
 		VirtioFSCacheSize:              h.VirtioFSCacheSize,
+		VirtioFSCacheSizeMax:           h.VirtioFSCacheSizeMax,
 		VirtioFSCache:                  h.VirtioFSCache,
 		MemPrealloc:                    h.MemPrealloc,
 		HugePages:                      h.HugePages,
@@ -928,13 +1196,31 @@ func newClhHypervisorConfig(h hypervisor) (vc.HypervisorConfig, error) {
 		BlockDeviceCacheSet:            h.BlockDeviceCacheSet,
 		BlockDeviceCacheDirect:         h.BlockDeviceCacheDirect,
 		BlockDeviceCacheNoflush:        h.BlockDeviceCacheNoflush,
+		BlockRootfsThresholdMB:         h.BlockRootfsThresholdMB,
+		LayerCachePath:                 h.LayerCachePath,
+		LayerCacheSizeMB:               h.layerCacheSizeMB(),
+		FileBackedBlockDevice:          fileBackedBlockDevice,
+		UblkQueues:                     h.UblkQueues,
+		UblkQueueDepth:                 h.UblkQueueDepth,
 		EnableIOThreads:                h.EnableIOThreads,
 		Msize9p:                        h.msize9p(),
 		HotplugVFIOOnRootBus:           h.HotplugVFIOOnRootBus,
 		PCIeRootPort:                   h.PCIeRootPort,
 		DisableVhostNet:                true,
+		NetworkQueues:                  h.NetworkQueues,
+		EnableNetRSS:                   h.EnableNetRSS,
 		GuestHookPath:                  h.guestHookPath(),
 		VirtioFSExtraArgs:              h.VirtioFSExtraArgs,
+		VirtioFSSandbox:                h.VirtioFSSandbox,
+		VirtioFSSeccomp:                h.VirtioFSSeccomp,
+		VirtioFSModcaps:                h.VirtioFSModcaps,
+		VirtioFSVolumesDaemon:          h.VirtioFSVolumesDaemon,
+		VirtioFSVolumesCache:           h.VirtioFSVolumesCache,
+		VirtioFSWriteback:              h.VirtioFSWriteback,
+		VirtioFSVolumesWriteback:       h.VirtioFSVolumesWriteback,
+		VirtioFSMilliCPUs:              h.VirtioFSMilliCPUs,
+		VirtioFSMemoryMB:               h.VirtioFSMemoryMB,
+		VirtioFSCPUWeight:              h.VirtioFSCPUWeight,
 		SGXEPCSize:                     defaultSGXEPCSize,
 		EnableAnnotations:              h.EnableAnnotations,
 		DisableSeccomp:                 h.DisableSeccomp,
@@ -998,13 +1284,26 @@ func updateRuntimeConfigHypervisor(configPath string, tomlConf tomlConfig, confi
 
 func updateRuntimeConfigAgent(configPath string, tomlConf tomlConfig, config *oci.RuntimeConfig) error {
 	for _, agent := range tomlConf.Agent {
+		policy, err := agent.policy()
+		if err != nil {
+			return err
+		}
+
 		config.AgentConfig = vc.KataAgentConfig{
-			LongLiveConn:       true,
-			Debug:              agent.debug(),
-			Trace:              agent.trace(),
-			KernelModules:      agent.kernelModules(),
-			EnableDebugConsole: agent.debugConsoleEnabled(),
-			DialTimeout:        agent.dialTimout(),
+			LongLiveConn:        true,
+			Debug:               agent.debug(),
+			Trace:               agent.trace(),
+			KernelModules:       agent.kernelModules(),
+			EnableDebugConsole:  agent.debugConsoleEnabled(),
+			DialTimeout:         agent.dialTimout(),
+			HealthCheckInterval: agent.HealthCheckInterval,
+			HealthCheckFailures: agent.HealthCheckFailures,
+			Policy:              policy,
+
+			CreateContainerTimeout: agent.CreateContainerTimeout,
+			ExecProcessTimeout:     agent.ExecProcessTimeout,
+			HotplugTimeout:         agent.HotplugTimeout,
+			ShutdownTimeout:        agent.ShutdownTimeout,
 		}
 	}
 
@@ -1195,6 +1494,14 @@ func LoadConfiguration(configPath string, ignoreLogging bool) (resolvedConfigPat
 		}
 	}
 
+	if err = config.HostNetworkMode.Set(tomlConf.Runtime.HostNetworkMode); err != nil {
+		return "", config, err
+	}
+
+	if err = config.MacvtapMode.Set(tomlConf.Runtime.MacvtapMode); err != nil {
+		return "", config, err
+	}
+
 	if tomlConf.Runtime.VfioMode != "" {
 		err = config.VfioMode.VFIOSetMode(tomlConf.Runtime.VfioMode)
 
@@ -1221,14 +1528,41 @@ func LoadConfiguration(configPath string, ignoreLogging bool) (resolvedConfigPat
 	}
 
 	config.DisableGuestSeccomp = tomlConf.Runtime.DisableGuestSeccomp
+	config.RequireGuestSeccomp = tomlConf.Runtime.RequireGuestSeccomp
+	config.GuestTimeSyncInterval = time.Duration(tomlConf.Runtime.GuestTimeSyncInterval) * time.Second
+	config.GuestTimeSource = tomlConf.Runtime.GuestTimeSource
+	config.GuestNTPServers = tomlConf.Runtime.GuestNTPServers
+	config.EnableGuestHooks = tomlConf.Runtime.EnableGuestHooks
 
 	config.StaticSandboxResourceMgmt = tomlConf.Runtime.StaticSandboxResourceMgmt
 	config.SandboxCgroupOnly = tomlConf.Runtime.SandboxCgroupOnly
+	config.EnableVCPUsPinning = tomlConf.Runtime.EnableVCPUsPinning
 	config.DisableNewNetNs = tomlConf.Runtime.DisableNewNetNs
+	config.PasstPath = tomlConf.Runtime.PasstPath
 	config.EnablePprof = tomlConf.Runtime.EnablePprof
+	config.CrashDiagnosticsDir = tomlConf.Runtime.CrashDiagnosticsDir
+	config.VerifyArtifacts = tomlConf.Runtime.VerifyArtifacts
+	config.ArtifactsPublicKey = tomlConf.Runtime.ArtifactsPublicKey
+	config.ForwardGuestKernelLog = tomlConf.Runtime.ForwardGuestKernelLog
+	config.ConsoleCaptureSize = tomlConf.Runtime.ConsoleCaptureSize
+	config.EnableAudit = tomlConf.Runtime.EnableAudit
+	config.LinuxAudit = tomlConf.Runtime.LinuxAudit
+	config.SlowOperationThreshold = tomlConf.Runtime.SlowOperationThreshold
+	config.LogRateLimit = tomlConf.Runtime.LogRateLimit
+	config.LogRotateSize = tomlConf.Runtime.LogRotateSize
+	config.ShimCPUWeight = tomlConf.Runtime.ShimCPUWeight
+	config.ShimMilliCPUs = tomlConf.Runtime.ShimMilliCPUs
+	config.ShimMemoryMB = tomlConf.Runtime.ShimMemoryMB
 	config.JaegerEndpoint = tomlConf.Runtime.JaegerEndpoint
 	config.JaegerUser = tomlConf.Runtime.JaegerUser
 	config.JaegerPassword = tomlConf.Runtime.JaegerPassword
+	config.TracingExporter = tomlConf.Runtime.TracingExporter
+	config.OTLPEndpoint = tomlConf.Runtime.OTLPEndpoint
+	config.OTLPProtocol = tomlConf.Runtime.OTLPProtocol
+	config.OTLPCACert = tomlConf.Runtime.OTLPCACert
+	if config.OTLPHeaders, err = parseOTLPHeaders(tomlConf.Runtime.OTLPHeaders); err != nil {
+		return "", config, err
+	}
 	for _, f := range tomlConf.Runtime.Experimental {
 		feature := exp.Get(f)
 		if feature == nil {
@@ -1320,6 +1654,103 @@ func checkConfig(config oci.RuntimeConfig) error {
 		return err
 	}
 
+	if err := checkGuestSeccompConfig(config); err != nil {
+		return err
+	}
+
+	if err := checkGuestTimeSourceConfig(config); err != nil {
+		return err
+	}
+
+	if err := checkArtifactsConfig(config); err != nil {
+		return err
+	}
+
+	if err := checkTracingConfig(config); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// parseOTLPHeaders parses the "key=value" headers of the OTLP exports.
+func parseOTLPHeaders(headers []string) (map[string]string, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+
+	parsed := make(map[string]string)
+	for _, header := range headers {
+		kv := strings.SplitN(header, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, fmt.Errorf("invalid otlp_headers entry %q, must be key=value", header)
+		}
+		parsed[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+
+	return parsed, nil
+}
+
+// checkTracingConfig ensures the tracing exporter and the OTLP protocol are
+// known.
+func checkTracingConfig(config oci.RuntimeConfig) error {
+	switch config.TracingExporter {
+	case "", katatrace.JaegerExporter, katatrace.OTLPExporter:
+	default:
+		return fmt.Errorf("invalid tracing_exporter %q, must be %q or %q", config.TracingExporter, katatrace.JaegerExporter, katatrace.OTLPExporter)
+	}
+
+	switch config.OTLPProtocol {
+	case "", katatrace.OTLPProtocolGRPC, katatrace.OTLPProtocolHTTP:
+	default:
+		return fmt.Errorf("invalid otlp_protocol %q, must be %q or %q", config.OTLPProtocol, katatrace.OTLPProtocolGRPC, katatrace.OTLPProtocolHTTP)
+	}
+
+	return nil
+}
+
+// checkArtifactsConfig ensures the public key verifying the signatures of
+// the guest artifacts can be loaded.
+func checkArtifactsConfig(config oci.RuntimeConfig) error {
+	if config.ArtifactsPublicKey == "" {
+		return nil
+	}
+
+	if _, err := artifacts.LoadPublicKey(config.ArtifactsPublicKey); err != nil {
+		return fmt.Errorf("invalid artifacts_public_key: %v", err)
+	}
+
+	return nil
+}
+
+// checkGuestTimeSourceConfig ensures the guest time source is known, that
+// NTP servers are given for the "ntp" one, and that the guest clock is not
+// also set periodically, which would fight with chronyd.
+func checkGuestTimeSourceConfig(config oci.RuntimeConfig) error {
+	if config.GuestTimeSource != "" && config.GuestTimeSyncInterval > 0 {
+		return fmt.Errorf("config guest_time_source %q and guest_time_sync_interval are mutually exclusive", config.GuestTimeSource)
+	}
+
+	switch config.GuestTimeSource {
+	case "", vc.GuestTimeSourcePTPKVM:
+	case vc.GuestTimeSourceNTP:
+		if len(config.GuestNTPServers) == 0 {
+			return fmt.Errorf("config guest_time_source %q requires guest_ntp_servers", config.GuestTimeSource)
+		}
+	default:
+		return fmt.Errorf("invalid guest_time_source %q, must be %q or %q", config.GuestTimeSource, vc.GuestTimeSourcePTPKVM, vc.GuestTimeSourceNTP)
+	}
+
+	return nil
+}
+
+// checkGuestSeccompConfig ensures the seccomp profiles can be required in
+// the guest.
+func checkGuestSeccompConfig(config oci.RuntimeConfig) error {
+	if config.RequireGuestSeccomp && config.DisableGuestSeccomp {
+		return fmt.Errorf("config require_guest_seccomp conflicts with disable_guest_seccomp")
+	}
+
 	return nil
 }
 
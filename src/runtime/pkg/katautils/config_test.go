@@ -16,9 +16,11 @@ import (
 	"strings"
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/kata-containers/kata-containers/src/runtime/pkg/govmm"
 	ktu "github.com/kata-containers/kata-containers/src/runtime/pkg/katatestutils"
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils/katatrace"
 	"github.com/kata-containers/kata-containers/src/runtime/pkg/oci"
 	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/utils"
@@ -169,6 +171,8 @@ func createAllRuntimeConfigFiles(dir, hypervisor string) (config testRuntimeConf
 		VirtioFSCache:         defaultVirtioFSCacheMode,
 		PFlash:                []string{},
 		SGXEPCSize:            epcSize,
+		LayerCacheSizeMB:      defaultLayerCacheSizeMB,
+		FileBackedBlockDevice: "loop",
 	}
 
 	agentConfig := vc.KataAgentConfig{
@@ -187,6 +191,8 @@ func createAllRuntimeConfigFiles(dir, hypervisor string) (config testRuntimeConf
 		AgentConfig: agentConfig,
 
 		DisableNewNetNs: disableNewNetNs,
+		HostNetworkMode: vc.HostNetworkReject,
+		MacvtapMode:     vc.MacvtapModeBridge,
 		EnablePprof:     enablePprof,
 		JaegerEndpoint:  jaegerEndpoint,
 		JaegerUser:      jaegerUser,
@@ -546,6 +552,8 @@ func TestMinimalRuntimeConfig(t *testing.T) {
 		GuestHookPath:         defaultGuestHookPath,
 		VhostUserStorePath:    defaultVhostUserStorePath,
 		VirtioFSCache:         defaultVirtioFSCacheMode,
+		LayerCacheSizeMB:      defaultLayerCacheSizeMB,
+		FileBackedBlockDevice: "loop",
 	}
 
 	expectedAgentConfig := vc.KataAgentConfig{
@@ -565,6 +573,9 @@ func TestMinimalRuntimeConfig(t *testing.T) {
 
 		AgentConfig: expectedAgentConfig,
 
+		HostNetworkMode: vc.HostNetworkReject,
+		MacvtapMode:     vc.MacvtapModeBridge,
+
 		FactoryConfig: expectedFactoryConfig,
 	}
 	err = SetKernelParams(&expectedConfig)
@@ -901,6 +912,10 @@ func TestNewClhHypervisorConfig(t *testing.T) {
 	if config.DiskRateLimiterOpsOneTimeBurst != 0 {
 		t.Errorf("Expected value for disk operations one time burst %v, got %v", diskRateLimiterOpsOneTimeBurst, config.DiskRateLimiterOpsOneTimeBurst)
 	}
+
+	hypervisor.BlockDeviceDiscard = true
+	_, err = newClhHypervisorConfig(hypervisor)
+	assert.Error(err)
 }
 
 func TestHypervisorDefaults(t *testing.T) {
@@ -1131,6 +1146,19 @@ func TestAgentDefaults(t *testing.T) {
 
 	a.Tracing = true
 	assert.Equal(a.trace(), a.Tracing)
+
+	policy, err := a.policy()
+	assert.NoError(err)
+	assert.Empty(policy)
+
+	a.PolicyFile = filepath.Join(t.TempDir(), "policy.toml")
+	_, err = a.policy()
+	assert.Error(err)
+
+	assert.NoError(os.WriteFile(a.PolicyFile, []byte("[endpoints]\n"), 0600))
+	policy, err = a.policy()
+	assert.NoError(err)
+	assert.Equal("[endpoints]\n", policy)
 }
 
 func TestGetDefaultConfigFilePaths(t *testing.T) {
@@ -1231,6 +1259,44 @@ func TestDefaultVirtioFSCache(t *testing.T) {
 	assert.Equal("none", cache)
 }
 
+func TestCheckVirtioFSSandboxing(t *testing.T) {
+	assert := assert.New(t)
+
+	h := hypervisor{}
+	assert.NoError(h.checkVirtioFSSandboxing())
+
+	h = hypervisor{
+		VirtioFSSandbox: "chroot",
+		VirtioFSSeccomp: "log",
+		VirtioFSModcaps: []string{"+sys_admin", "-fsetid"},
+	}
+	assert.NoError(h.checkVirtioFSSandboxing())
+
+	h.VirtioFSSandbox = "jail"
+	assert.Error(h.checkVirtioFSSandboxing())
+
+	h.VirtioFSSandbox = "none"
+	h.VirtioFSSeccomp = "allow"
+	assert.Error(h.checkVirtioFSSandboxing())
+
+	h.VirtioFSSeccomp = "none"
+	h.VirtioFSModcaps = []string{"sys_admin"}
+	assert.Error(h.checkVirtioFSSandboxing())
+}
+
+func TestCheckVirtioFSVolumesCache(t *testing.T) {
+	assert := assert.New(t)
+
+	h := hypervisor{}
+	assert.NoError(h.checkVirtioFSVolumesCache())
+
+	h.VirtioFSVolumesCache = "always"
+	assert.NoError(h.checkVirtioFSVolumesCache())
+
+	h.VirtioFSVolumesCache = "never"
+	assert.Error(h.checkVirtioFSVolumesCache())
+}
+
 func TestDefaultFirmware(t *testing.T) {
 	assert := assert.New(t)
 
@@ -1610,6 +1676,76 @@ func TestCheckFactoryConfig(t *testing.T) {
 	}
 }
 
+func TestCheckGuestSeccompConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(checkGuestSeccompConfig(oci.RuntimeConfig{DisableGuestSeccomp: true}))
+	assert.NoError(checkGuestSeccompConfig(oci.RuntimeConfig{RequireGuestSeccomp: true}))
+	assert.Error(checkGuestSeccompConfig(oci.RuntimeConfig{
+		DisableGuestSeccomp: true,
+		RequireGuestSeccomp: true,
+	}))
+}
+
+func TestCheckGuestTimeSourceConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(checkGuestTimeSourceConfig(oci.RuntimeConfig{}))
+	assert.NoError(checkGuestTimeSourceConfig(oci.RuntimeConfig{GuestTimeSource: vc.GuestTimeSourcePTPKVM}))
+	assert.NoError(checkGuestTimeSourceConfig(oci.RuntimeConfig{
+		GuestTimeSource: vc.GuestTimeSourceNTP,
+		GuestNTPServers: []string{"pool.ntp.org"},
+	}))
+	assert.Error(checkGuestTimeSourceConfig(oci.RuntimeConfig{GuestTimeSource: vc.GuestTimeSourceNTP}))
+	assert.Error(checkGuestTimeSourceConfig(oci.RuntimeConfig{GuestTimeSource: "gps"}))
+	assert.NoError(checkGuestTimeSourceConfig(oci.RuntimeConfig{GuestTimeSyncInterval: time.Minute}))
+	assert.Error(checkGuestTimeSourceConfig(oci.RuntimeConfig{
+		GuestTimeSource:       vc.GuestTimeSourcePTPKVM,
+		GuestTimeSyncInterval: time.Minute,
+	}))
+}
+
+func TestCheckTracingConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(checkTracingConfig(oci.RuntimeConfig{}))
+	assert.NoError(checkTracingConfig(oci.RuntimeConfig{TracingExporter: katatrace.JaegerExporter}))
+	assert.NoError(checkTracingConfig(oci.RuntimeConfig{
+		TracingExporter: katatrace.OTLPExporter,
+		OTLPProtocol:    katatrace.OTLPProtocolHTTP,
+	}))
+	assert.Error(checkTracingConfig(oci.RuntimeConfig{TracingExporter: "zipkin"}))
+	assert.Error(checkTracingConfig(oci.RuntimeConfig{OTLPProtocol: "http/json"}))
+}
+
+func TestParseOTLPHeaders(t *testing.T) {
+	assert := assert.New(t)
+
+	headers, err := parseOTLPHeaders(nil)
+	assert.NoError(err)
+	assert.Nil(headers)
+
+	headers, err = parseOTLPHeaders([]string{"Authorization = Bearer a=b", "x-tenant=kata"})
+	assert.NoError(err)
+	assert.Equal(map[string]string{"Authorization": "Bearer a=b", "x-tenant": "kata"}, headers)
+
+	_, err = parseOTLPHeaders([]string{"Authorization"})
+	assert.Error(err)
+	_, err = parseOTLPHeaders([]string{"=value"})
+	assert.Error(err)
+}
+
+func TestCheckArtifactsConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(checkArtifactsConfig(oci.RuntimeConfig{}))
+	assert.NoError(checkArtifactsConfig(oci.RuntimeConfig{VerifyArtifacts: true}))
+
+	key := filepath.Join(t.TempDir(), "key.pem")
+	assert.NoError(os.WriteFile(key, []byte("not a key"), 0644))
+	assert.Error(checkArtifactsConfig(oci.RuntimeConfig{ArtifactsPublicKey: key}))
+}
+
 func TestValidateBindMounts(t *testing.T) {
 	assert := assert.New(t)
 
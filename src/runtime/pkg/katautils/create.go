@@ -9,16 +9,19 @@ package katautils
 
 import (
 	"context"
+	"crypto"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
 
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/artifacts"
 	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils/katatrace"
 	"github.com/kata-containers/kata-containers/src/runtime/pkg/oci"
 	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
 	vf "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/factory"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
 )
 
 // createTracingTags defines tags for the trace span
@@ -128,6 +131,14 @@ func CreateSandbox(ctx context.Context, vci vc.VC, ociSpec specs.Spec, runtimeCo
 		return nil, vc.Process{}, err
 	}
 
+	// the artifacts are verified once the annotations are applied, as they
+	// can change the artifacts booted
+	if runtimeConfig.VerifyArtifacts {
+		if _, err := VerifyGuestArtifacts(sandboxConfig.HypervisorConfig, runtimeConfig.ArtifactsPublicKey); err != nil {
+			return nil, vc.Process{}, err
+		}
+	}
+
 	if !rootFs.Mounted && len(sandboxConfig.Containers) == 1 {
 		if rootFs.Source != "" {
 			realPath, err := ResolvePath(rootFs.Source)
@@ -218,6 +229,53 @@ func checkForFIPS(sandboxConfig *vc.SandboxConfig) error {
 	return nil
 }
 
+// GuestArtifacts returns the guest artifacts booted by the hypervisor.
+func GuestArtifacts(config vc.HypervisorConfig) []artifacts.Artifact {
+	var list []artifacts.Artifact
+
+	for _, a := range []artifacts.Artifact{
+		{Name: "kernel", Path: config.KernelPath},
+		{Name: "initrd", Path: config.InitrdPath},
+		{Name: "image", Path: config.ImagePath},
+		{Name: "firmware", Path: config.FirmwarePath},
+	} {
+		if a.Path != "" {
+			list = append(list, a)
+		}
+	}
+
+	return list
+}
+
+// VerifyGuestArtifacts verifies the digests, and the signatures if
+// publicKey is set, of the guest artifacts booted by the hypervisor.
+func VerifyGuestArtifacts(config vc.HypervisorConfig, publicKey string) ([]artifacts.Result, error) {
+	var key crypto.PublicKey
+	if publicKey != "" {
+		var err error
+		if key, err = artifacts.LoadPublicKey(publicKey); err != nil {
+			return nil, err
+		}
+	}
+
+	results, err := artifacts.VerifyAll(GuestArtifacts(config), key)
+	if err != nil {
+		kataUtilsLogger.WithError(err).Error("refusing to boot unverified guest artifacts")
+		return results, err
+	}
+
+	for _, r := range results {
+		kataUtilsLogger.WithFields(logrus.Fields{
+			"artifact":  r.Name,
+			"path":      r.Path,
+			"digest":    r.Digest,
+			"signature": r.SignatureVerified,
+		}).Debug("guest artifact verified")
+	}
+
+	return results, nil
+}
+
 // CreateContainer create a container
 func CreateContainer(ctx context.Context, sandbox vc.VCSandbox, ociSpec specs.Spec, rootFs vc.RootFs, containerID, bundlePath, console string, disableOutput bool, disableGuestEmptyDir bool) (vc.Process, error) {
 	var c vc.VCContainer
@@ -9,6 +9,8 @@ package katautils
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
@@ -18,6 +20,7 @@ import (
 	"syscall"
 	"testing"
 
+	"github.com/kata-containers/kata-containers/src/runtime/pkg/artifacts"
 	ktu "github.com/kata-containers/kata-containers/src/runtime/pkg/katatestutils"
 	"github.com/kata-containers/kata-containers/src/runtime/pkg/oci"
 	vc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers"
@@ -298,6 +301,67 @@ func TestCreateSandboxAnnotations(t *testing.T) {
 	assert.Equal(path.Dir(netNsPath), "/var/run/netns")
 }
 
+func TestGuestArtifacts(t *testing.T) {
+	assert := assert.New(t)
+
+	list := GuestArtifacts(vc.HypervisorConfig{
+		KernelPath: "/kernel",
+		ImagePath:  "/image",
+	})
+	assert.Equal([]artifacts.Artifact{
+		{Name: "kernel", Path: "/kernel"},
+		{Name: "image", Path: "/image"},
+	}, list)
+}
+
+func TestVerifyGuestArtifacts(t *testing.T) {
+	assert := assert.New(t)
+
+	tmpdir := t.TempDir()
+	kernel := filepath.Join(tmpdir, "vmlinux")
+	assert.NoError(os.WriteFile(kernel, []byte("kernel"), 0644))
+
+	config := vc.HypervisorConfig{KernelPath: kernel}
+
+	_, err := VerifyGuestArtifacts(config, "")
+	assert.Error(err)
+
+	digest := sha256.Sum256([]byte("kernel"))
+	assert.NoError(os.WriteFile(kernel+artifacts.DigestSuffix, []byte(hex.EncodeToString(digest[:])), 0644))
+	results, err := VerifyGuestArtifacts(config, "")
+	assert.NoError(err)
+	assert.Len(results, 1)
+	assert.True(results[0].DigestVerified)
+
+	_, err = VerifyGuestArtifacts(config, filepath.Join(tmpdir, "missing.pem"))
+	assert.Error(err)
+}
+
+func TestCreateSandboxVerifyArtifacts(t *testing.T) {
+	if tc.NotValid(ktu.NeedRoot()) {
+		t.Skip(ktu.TestDisabledNeedRoot)
+	}
+
+	assert := assert.New(t)
+
+	tmpdir, bundlePath, _ := ktu.SetupOCIConfigFile(t)
+
+	runtimeConfig, err := newTestRuntimeConfig(tmpdir, testConsole, true)
+	assert.NoError(err)
+	runtimeConfig.VerifyArtifacts = true
+
+	spec, err := compatoci.ParseConfigJSON(bundlePath)
+	assert.NoError(err)
+
+	rootFs := vc.RootFs{Mounted: true}
+
+	// the artifacts have no digest files
+	_, _, err = CreateSandbox(context.Background(), testingImpl, spec, runtimeConfig, rootFs, testContainerID, bundlePath, testConsole, true, true)
+	assert.Error(err)
+	assert.False(vcmock.IsMockError(err))
+	assert.Contains(err.Error(), "artifacts verification failed")
+}
+
 func TestCheckForFips(t *testing.T) {
 	assert := assert.New(t)
 
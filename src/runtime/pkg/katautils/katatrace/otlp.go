@@ -0,0 +1,142 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package katatrace
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// OTLPProtocolGRPC exports the spans with OTLP over gRPC.
+	OTLPProtocolGRPC = "grpc"
+	// OTLPProtocolHTTP exports the spans with OTLP over HTTP, encoded in
+	// protobuf.
+	OTLPProtocolHTTP = "http/protobuf"
+
+	defaultOTLPGRPCEndpoint = "http://localhost:4317"
+	defaultOTLPHTTPEndpoint = "http://localhost:4318"
+
+	otlpExportTimeout = 10 * time.Second
+)
+
+// OTLPConfig defines the OpenTelemetry protocol collector the traces are
+// exported to.
+type OTLPConfig struct {
+	// Endpoint is the URL of the collector. The https scheme enables TLS.
+	// With HTTP, /v1/traces is the default path.
+	Endpoint string
+	// Protocol is OTLPProtocolGRPC or OTLPProtocolHTTP
+	Protocol string
+	// Headers are sent with each export, e.g. for authentication
+	Headers map[string]string
+	// CACert is the PEM file of the certificate authorities the collector
+	// certificate is verified with, instead of the ones of the host
+	CACert string
+}
+
+func otlpTLSConfig(caCert string) (*tls.Config, error) {
+	config := &tls.Config{}
+	if caCert == "" {
+		return config, nil
+	}
+
+	pem, err := os.ReadFile(caCert)
+	if err != nil {
+		return nil, err
+	}
+
+	config.RootCAs = x509.NewCertPool()
+	if !config.RootCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in %s", caCert)
+	}
+
+	return config, nil
+}
+
+// newOTLPExporter returns the exporter of the spans to the OTLP collector.
+// The spans being exported synchronously, the exports are not retried, for
+// an unreachable collector not to hold the runtime longer than the export
+// timeout.
+func newOTLPExporter(config OTLPConfig) (*otlptrace.Exporter, error) {
+	protocol := config.Protocol
+	if protocol == "" {
+		protocol = OTLPProtocolGRPC
+	}
+
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = defaultOTLPGRPCEndpoint
+		if protocol == OTLPProtocolHTTP {
+			endpoint = defaultOTLPHTTPEndpoint
+		}
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q: the scheme must be http or https", endpoint)
+	}
+
+	var tlsConfig *tls.Config
+	if u.Scheme == "https" {
+		if tlsConfig, err = otlpTLSConfig(config.CACert); err != nil {
+			return nil, err
+		}
+	}
+
+	var client otlptrace.Client
+	switch protocol {
+	case OTLPProtocolGRPC:
+		opts := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(u.Host),
+			otlptracegrpc.WithHeaders(config.Headers),
+			otlptracegrpc.WithTimeout(otlpExportTimeout),
+			otlptracegrpc.WithRetry(otlptracegrpc.RetryConfig{Enabled: false}),
+		}
+		if tlsConfig != nil {
+			opts = append(opts, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+		} else {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		client = otlptracegrpc.NewClient(opts...)
+
+	case OTLPProtocolHTTP:
+		opts := []otlptracehttp.Option{
+			otlptracehttp.WithEndpoint(u.Host),
+			otlptracehttp.WithHeaders(config.Headers),
+			otlptracehttp.WithTimeout(otlpExportTimeout),
+			otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: false}),
+		}
+		if u.Path != "" && u.Path != "/" {
+			opts = append(opts, otlptracehttp.WithURLPath(u.Path))
+		}
+		if tlsConfig != nil {
+			opts = append(opts, otlptracehttp.WithTLSClientConfig(tlsConfig))
+		} else {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		client = otlptracehttp.NewClient(opts...)
+
+	default:
+		return nil, fmt.Errorf("invalid OTLP protocol %q, must be %s or %s", protocol, OTLPProtocolGRPC, OTLPProtocolHTTP)
+	}
+
+	// the gRPC connection is established lazily, on the first export
+	return otlptrace.New(context.Background(), client)
+}
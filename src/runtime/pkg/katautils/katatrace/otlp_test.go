@@ -0,0 +1,166 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package katatrace
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+type spanRecorder struct {
+	spans []sdktrace.ReadOnlySpan
+}
+
+func (r *spanRecorder) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	r.spans = append(r.spans, spans...)
+	return nil
+}
+
+func (r *spanRecorder) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+// recordSpans returns a parent span and its child.
+func recordSpans(t *testing.T) []sdktrace.ReadOnlySpan {
+	recorder := &spanRecorder{}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(recorder),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", "kata"))),
+	)
+	defer provider.Shutdown(context.Background())
+
+	ctx, parent := provider.Tracer("kata").Start(context.Background(), "parent")
+	_, child := provider.Tracer("kata").Start(ctx, "child")
+	child.End()
+	parent.End()
+
+	assert.Len(t, recorder.spans, 2)
+	return recorder.spans
+}
+
+// spanNames returns the names of the spans of an export request.
+func spanNames(request *coltracepb.ExportTraceServiceRequest) []string {
+	var names []string
+	for _, rs := range request.GetResourceSpans() {
+		for _, ils := range rs.GetInstrumentationLibrarySpans() {
+			for _, span := range ils.GetSpans() {
+				names = append(names, span.GetName())
+			}
+		}
+	}
+	return names
+}
+
+func TestNewOTLPExporter(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, config := range []OTLPConfig{
+		{Endpoint: "localhost:4317"},
+		{Endpoint: "ftp://localhost:4317"},
+		{Protocol: "http/json"},
+		{Endpoint: "https://localhost:4317", CACert: "/nonexistent/ca.pem"},
+	} {
+		_, err := newOTLPExporter(config)
+		assert.Error(err, "%+v", config)
+	}
+
+	for _, config := range []OTLPConfig{
+		{},
+		{Protocol: OTLPProtocolHTTP},
+		{Protocol: OTLPProtocolHTTP, Endpoint: "https://collector/custom/traces"},
+	} {
+		e, err := newOTLPExporter(config)
+		assert.NoError(err, "%+v", config)
+		assert.NoError(e.Shutdown(context.Background()), "%+v", config)
+	}
+}
+
+func TestOTLPExporterHTTP(t *testing.T) {
+	assert := assert.New(t)
+
+	var path, contentType, auth string
+	request := &coltracepb.ExportTraceServiceRequest{}
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		contentType = r.Header.Get("Content-Type")
+		auth = r.Header.Get("Authorization")
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.NoError(proto.Unmarshal(body, request))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	e, err := newOTLPExporter(OTLPConfig{
+		Endpoint: server.URL,
+		Protocol: OTLPProtocolHTTP,
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+	})
+	assert.NoError(err)
+	defer e.Shutdown(context.Background())
+
+	spans := recordSpans(t)
+	assert.NoError(e.ExportSpans(context.Background(), spans))
+	assert.Equal("/v1/traces", path)
+	assert.Equal("application/x-protobuf", contentType)
+	assert.Equal("Bearer token", auth)
+	assert.Equal([]string{"child", "parent"}, spanNames(request))
+
+	status = http.StatusBadRequest
+	assert.Error(e.ExportSpans(context.Background(), spans))
+}
+
+type traceService struct {
+	coltracepb.UnimplementedTraceServiceServer
+	auth    string
+	request *coltracepb.ExportTraceServiceRequest
+}
+
+func (s *traceService) Export(ctx context.Context, request *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("authorization"); len(values) > 0 {
+		s.auth = values[0]
+	}
+	s.request = request
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+func TestOTLPExporterGRPC(t *testing.T) {
+	assert := assert.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(err)
+
+	service := &traceService{}
+	server := grpc.NewServer()
+	coltracepb.RegisterTraceServiceServer(server, service)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	e, err := newOTLPExporter(OTLPConfig{
+		Endpoint: "http://" + listener.Addr().String(),
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+	})
+	assert.NoError(err)
+	defer e.Shutdown(context.Background())
+
+	assert.NoError(e.ExportSpans(context.Background(), recordSpans(t)))
+	assert.Equal("Bearer token", service.auth)
+	assert.Equal([]string{"child", "parent"}, spanNames(service.request))
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package katatrace
+
+import (
+	"context"
+
+	"github.com/containerd/ttrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	otelTrace "go.opentelemetry.io/otel/trace"
+)
+
+// metadataCarrier adapts the metadata of a ttrpc request to the carrier of
+// the trace context propagators.
+type metadataCarrier ttrpc.MD
+
+var _ propagation.TextMapCarrier = metadataCarrier{}
+
+func (c metadataCarrier) Get(key string) string {
+	values, ok := ttrpc.MD(c).Get(key)
+	if !ok {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key string, value string) {
+	ttrpc.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// RequestContext returns the parent context of the spans of a ttrpc request.
+// When the caller propagated a W3C trace context in the request metadata, it
+// is the parent context with the remote span of the caller, so that the spans
+// of the runtime, and of the agent it calls, join the trace of the caller.
+// Otherwise, it is the parent context.
+func RequestContext(ctx context.Context, parent context.Context) context.Context {
+	if !IsTracing() {
+		return parent
+	}
+
+	md, ok := ttrpc.GetMetadata(ctx)
+	if !ok {
+		return parent
+	}
+
+	remote := otel.GetTextMapPropagator().Extract(context.Background(), metadataCarrier(md))
+	spanContext := otelTrace.SpanContextFromContext(remote)
+	if !spanContext.IsValid() {
+		return parent
+	}
+
+	if parent == nil {
+		parent = context.Background()
+	}
+	// Keep the baggage of the caller along with its span.
+	parent = otelTrace.ContextWithRemoteSpanContext(parent, spanContext)
+	return baggage.ContextWithBaggage(parent, baggage.FromContext(remote))
+}
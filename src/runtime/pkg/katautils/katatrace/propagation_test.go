@@ -0,0 +1,85 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package katatrace
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/containerd/ttrpc"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	testTraceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testParentID    = "00f067aa0ba902b7"
+	testTraceParent = "00-" + testTraceID + "-" + testParentID + "-01"
+)
+
+func TestRequestContext(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := &spanRecorder{}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(recorder))
+	defer provider.Shutdown(context.Background())
+
+	tracerProvider := otel.GetTracerProvider()
+	propagator := otel.GetTextMapPropagator()
+	defer func() {
+		otel.SetTracerProvider(tracerProvider)
+		otel.SetTextMapPropagator(propagator)
+		SetTracing(false)
+	}()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	parent := context.WithValue(context.Background(), testKey{}, "value")
+	request := ttrpc.WithMetadata(context.Background(), ttrpc.MD{
+		"traceparent": []string{testTraceParent},
+		"baggage":     []string{"pod=test"},
+	})
+
+	// Tracing disabled: the parent context is used as is
+	assert.Equal(parent, RequestContext(request, parent))
+
+	SetTracing(true)
+
+	// No trace context in the request
+	assert.Equal(parent, RequestContext(context.Background(), parent))
+	assert.Equal(parent, RequestContext(ttrpc.WithMetadata(context.Background(), ttrpc.MD{}), parent))
+	invalid := ttrpc.WithMetadata(context.Background(), ttrpc.MD{"traceparent": []string{"invalid"}})
+	assert.Equal(parent, RequestContext(invalid, parent))
+
+	ctx := RequestContext(request, parent)
+	assert.Equal("value", ctx.Value(testKey{}))
+	assert.Equal("test", baggage.FromContext(ctx).Member("pod").Value())
+
+	// The spans join the trace of the caller
+	span, spanCtx := Trace(ctx, nil, "create")
+	span.End()
+
+	assert.Len(recorder.spans, 1)
+	assert.Equal(testTraceID, recorder.spans[0].SpanContext().TraceID().String())
+	assert.Equal(testParentID, recorder.spans[0].Parent().SpanID().String())
+	assert.True(recorder.spans[0].Parent().IsRemote())
+
+	// and the agent requests carry it on
+	md := ttrpc.MD{}
+	otel.GetTextMapPropagator().Inject(spanCtx, metadataCarrier(md))
+	values, ok := md.Get("traceparent")
+	assert.True(ok)
+	assert.True(strings.Contains(values[0], testTraceID))
+
+	// A nil parent context is replaced
+	assert.NotNil(RequestContext(request, nil))
+}
+
+type testKey struct{}
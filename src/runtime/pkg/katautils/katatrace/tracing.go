@@ -8,6 +8,9 @@ package katatrace
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
 
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel"
@@ -47,14 +50,35 @@ var tp *sdktrace.TracerProvider
 
 var kataTraceLogger = logrus.NewEntry(logrus.New())
 
-// tracing determines whether tracing is enabled.
-var tracing bool
+// tracing determines whether tracing is enabled. It is read atomically as
+// tracing can be toggled while spans are created.
+var tracing int32
+
+// tracerLock serializes the creation and shutdown of the trace provider.
+var tracerLock sync.Mutex
 
 // SetTracing turns tracing on or off. Called by the configuration.
 func SetTracing(isTracing bool) {
-	tracing = isTracing
+	var value int32
+	if isTracing {
+		value = 1
+	}
+	atomic.StoreInt32(&tracing, value)
+}
+
+// IsTracing returns true if tracing is enabled.
+func IsTracing() bool {
+	return atomic.LoadInt32(&tracing) == 1
 }
 
+const (
+	// JaegerExporter exports the traces to a Jaeger collector.
+	JaegerExporter = "jaeger"
+	// OTLPExporter exports the traces to an OpenTelemetry protocol
+	// collector.
+	OTLPExporter = "otlp"
+)
+
 // JaegerConfig defines necessary Jaeger config for exporting traces.
 type JaegerConfig struct {
 	JaegerEndpoint string
@@ -62,41 +86,73 @@ type JaegerConfig struct {
 	JaegerPassword string
 }
 
+// TracerConfig defines the exporter of the traces, and its configuration.
+type TracerConfig struct {
+	// Exporter is JaegerExporter, the default, or OTLPExporter
+	Exporter string
+	Jaeger   JaegerConfig
+	OTLP     OTLPConfig
+}
+
 // CreateTracer create a tracer
-func CreateTracer(name string, config *JaegerConfig) (*sdktrace.TracerProvider, error) {
-	if !tracing {
-		otel.SetTracerProvider(trace.NewNoopTracerProvider())
-		return nil, nil
-	}
+func CreateTracer(name string, config *TracerConfig) (*sdktrace.TracerProvider, error) {
+	tracerLock.Lock()
+	defer tracerLock.Unlock()
 
-	// build kata exporter to log reporting span records
-	kataExporter := &kataSpanExporter{}
+	return createTracer(name, config)
+}
 
-	// build jaeger exporter
+func newJaegerExporter(config JaegerConfig) (sdktrace.SpanExporter, error) {
 	collectorEndpoint := config.JaegerEndpoint
 	if collectorEndpoint == "" {
 		collectorEndpoint = "http://localhost:14268/api/traces"
 	}
 
-	jaegerExporter, err := jaeger.New(
+	return jaeger.New(
 		jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(collectorEndpoint),
 			jaeger.WithUsername(config.JaegerUser),
 			jaeger.WithPassword(config.JaegerPassword),
 		),
 	)
+}
+
+func createTracer(name string, config *TracerConfig) (*sdktrace.TracerProvider, error) {
+	if !IsTracing() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		return nil, nil
+	}
+
+	// build kata exporter to log reporting span records
+	kataExporter := &kataSpanExporter{}
+
+	exporterName := config.Exporter
+	if exporterName == "" {
+		exporterName = JaegerExporter
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch exporterName {
+	case JaegerExporter:
+		exporter, err = newJaegerExporter(config.Jaeger)
+	case OTLPExporter:
+		exporter, err = newOTLPExporter(config.OTLP)
+	default:
+		err = fmt.Errorf("invalid tracing exporter %q, must be %s or %s", exporterName, JaegerExporter, OTLPExporter)
+	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	// build tracer provider, that combining both jaeger exporter and kata exporter.
-	tp := sdktrace.NewTracerProvider(
+	// build tracer provider, that combining both the collector exporter and kata exporter.
+	tp = sdktrace.NewTracerProvider(
 		sdktrace.WithSampler(sdktrace.AlwaysSample()),
 		sdktrace.WithSyncer(kataExporter),
-		sdktrace.WithSyncer(jaegerExporter),
+		sdktrace.WithSyncer(exporter),
 		sdktrace.WithResource(resource.NewSchemaless(
 			semconv.ServiceNameKey.String(name),
-			attribute.String("exporter", "jaeger"),
+			attribute.String("exporter", exporterName),
 			attribute.String("lib", "opentelemetry"),
 		)),
 	)
@@ -108,7 +164,7 @@ func CreateTracer(name string, config *JaegerConfig) (*sdktrace.TracerProvider,
 
 // StopTracing ends all tracing, reporting the spans to the collector.
 func StopTracing(ctx context.Context) {
-	if !tracing {
+	if !IsTracing() {
 		return
 	}
 
@@ -117,9 +173,57 @@ func StopTracing(ctx context.Context) {
 		span.End()
 	}
 
-	// report all possible spans to the collector
+	tracerLock.Lock()
+	defer tracerLock.Unlock()
+
+	shutdownTracer(ctx)
+}
+
+// shutdownTracer reports all the pending spans to the collector and shuts
+// the trace provider down.
+func shutdownTracer(ctx context.Context) {
+	if tp == nil {
+		return
+	}
+
 	tp.ForceFlush(ctx)
 	tp.Shutdown(ctx)
+	tp = nil
+}
+
+// EnableTracing turns tracing on in a running process, creating the tracer
+// as CreateTracer. It does nothing if tracing is already enabled.
+func EnableTracing(name string, config *TracerConfig) error {
+	tracerLock.Lock()
+	defer tracerLock.Unlock()
+
+	if IsTracing() {
+		return nil
+	}
+
+	SetTracing(true)
+	if _, err := createTracer(name, config); err != nil {
+		SetTracing(false)
+		return err
+	}
+
+	return nil
+}
+
+// DisableTracing turns tracing off in a running process, reporting the
+// pending spans to the collector. The spans created afterwards are not
+// recorded.
+func DisableTracing(ctx context.Context) {
+	tracerLock.Lock()
+	defer tracerLock.Unlock()
+
+	if !IsTracing() {
+		return
+	}
+
+	SetTracing(false)
+	otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	shutdownTracer(ctx)
 }
 
 // Trace creates a new tracing span based on the specified name and parent context.
@@ -136,7 +240,7 @@ func Trace(parent context.Context, logger *logrus.Entry, name string, tags ...ma
 
 	var otelTags []attribute.KeyValue
 	// do not append tags if tracing is disabled
-	if tracing {
+	if IsTracing() {
 		for _, tagSet := range tags {
 			for k, v := range tagSet {
 				otelTags = append(otelTags, attribute.Key(k).String(v))
@@ -150,7 +254,7 @@ func Trace(parent context.Context, logger *logrus.Entry, name string, tags ...ma
 	// This is slightly confusing: when tracing is disabled, trace spans
 	// are still created - but the tracer used is a NOP. Therefore, only
 	// display the message when tracing is really enabled.
-	if tracing {
+	if IsTracing() {
 		// This log message is *essential*: it is used by:
 		// https: //github.com/kata-containers/tests/blob/master/tracing/tracing-test.sh
 		kataTraceLogger.Debugf("created span %v", span)
@@ -161,7 +265,7 @@ func Trace(parent context.Context, logger *logrus.Entry, name string, tags ...ma
 
 func addTag(span otelTrace.Span, key string, value interface{}) {
 	// do not append tags if tracing is disabled
-	if !tracing {
+	if !IsTracing() {
 		return
 	}
 	if value == nil {
@@ -200,7 +304,7 @@ func addTag(span otelTrace.Span, key string, value interface{}) {
 // dynamic tags that are determined at runtime and tags with a non-string value.
 // Must have an even number of keyValues with keys being strings.
 func AddTags(span otelTrace.Span, keyValues ...interface{}) {
-	if !tracing {
+	if !IsTracing() {
 		return
 	}
 	if len(keyValues) < 2 {
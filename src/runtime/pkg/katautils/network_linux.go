@@ -88,7 +88,12 @@ func SetupNetworkNamespace(config *vc.NetworkConfig) error {
 		return err
 	}
 	if isHostNs {
-		return fmt.Errorf("Host networking requested, not supported by runtime")
+		if config.HostNetworkMode != vc.HostNetworkNone && config.HostNetworkMode != vc.HostNetworkUser {
+			return fmt.Errorf("Host networking requested, not supported by runtime")
+		}
+
+		kataUtilsLogger.WithField("host-network-mode", config.HostNetworkMode).Info("Host networking requested")
+		config.HostNetwork = true
 	}
 
 	return nil
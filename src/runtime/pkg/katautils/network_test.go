@@ -115,6 +115,15 @@ func TestSetupNetworkNamespace(t *testing.T) {
 	err := SetupNetworkNamespace(config)
 	assert.Error(err)
 
+	// Network namespace same as the host, host networking allowed
+	config = &vc.NetworkConfig{
+		NetworkID:       "/proc/self/ns/net",
+		HostNetworkMode: vc.HostNetworkNone,
+	}
+	err = SetupNetworkNamespace(config)
+	assert.NoError(err)
+	assert.True(config.HostNetwork)
+
 	// Non-existent netns path
 	config = &vc.NetworkConfig{
 		NetworkID: "/proc/123456789/ns/net",
@@ -8,6 +8,7 @@ package oci
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	ctrAnnotations "github.com/containerd/containerd/pkg/cri/annotations"
 	crioAnnotations "github.com/cri-o/cri-o/pkg/annotations"
@@ -81,6 +83,10 @@ const (
 
 const KernelModulesSeparator = ";"
 
+// agentPolicyAnnotation is the name enabling the agent policy annotation in
+// the enable_annotations list.
+const agentPolicyAnnotation = "agent.policy"
+
 // FactoryConfig is a structure to set the VM factory configuration.
 type FactoryConfig struct {
 	// TemplatePath specifies the path of template.
@@ -111,6 +117,16 @@ type RuntimeConfig struct {
 	JaegerPassword string
 	HypervisorType vc.HypervisorType
 
+	// TracingExporter exports the traces to a Jaeger collector, the
+	// default, or to an OpenTelemetry protocol (OTLP) collector
+	TracingExporter string
+	OTLPEndpoint    string
+	OTLPProtocol    string
+	// OTLPHeaders are sent with each export to the OTLP collector
+	OTLPHeaders map[string]string
+	// OTLPCACert verifies the certificate of the OTLP collector
+	OTLPCACert string
+
 	FactoryConfig    FactoryConfig
 	HypervisorConfig vc.HypervisorConfig
 	AgentConfig      vc.KataAgentConfig
@@ -129,6 +145,21 @@ type RuntimeConfig struct {
 	//Determines if seccomp should be applied inside guest
 	DisableGuestSeccomp bool
 
+	// Determines if the containers fail to be created when their seccomp
+	// profile is not applied inside the guest
+	RequireGuestSeccomp bool
+
+	// Interval at which the guest clock is set to the host one
+	GuestTimeSyncInterval time.Duration
+
+	// Time source the guest clock is kept in sync with, and the NTP servers
+	// of the "ntp" one
+	GuestTimeSource string
+	GuestNTPServers []string
+
+	// Determines if the containers may declare hooks run in the guest
+	EnableGuestHooks bool
+
 	// Sandbox sizing information which, if provided, indicates the size of
 	// the sandbox needed for the workload(s)
 	SandboxCPUs  uint32
@@ -141,12 +172,64 @@ type RuntimeConfig struct {
 	// Determines if create a netns for hypervisor process
 	DisableNewNetNs bool
 
+	// Determines how sandboxes sharing the host network namespace are handled
+	HostNetworkMode vc.HostNetworkMode
+
+	// Determines the mode of the macvtap devices of the macvtap internetworking model
+	MacvtapMode vc.MacvtapMode
+
+	// PasstPath is the path to the passt binary
+	PasstPath string
+
 	//Determines kata processes are managed only in sandbox cgroup
 	SandboxCgroupOnly bool
 
+	// Determines if the vCPU threads are pinned to the exclusive CPUs of
+	// the sandbox
+	EnableVCPUsPinning bool
+
 	// Determines if enable pprof
 	EnablePprof bool
 
+	// Determines if the guest artifacts are verified before each sandbox
+	// boot, refusing to start the sandbox on a mismatch
+	VerifyArtifacts bool
+
+	// PEM public key verifying the signatures of the guest artifacts. When
+	// empty, only their digests are verified
+	ArtifactsPublicKey string
+
+	// Directory the diagnostics of the crashed containers are written to
+	CrashDiagnosticsDir string
+
+	// Determines if the guest kernel log is written to the shim log
+	ForwardGuestKernelLog bool
+
+	// Size in KiB of the last guest console output captured on failures
+	ConsoleCaptureSize uint32
+
+	// Determines if the device hotplugs, resource resizes and mounts of the
+	// sandbox are audited, and if the audit records are also sent to the
+	// Linux audit subsystem
+	EnableAudit bool
+	LinuxAudit  bool
+
+	// Duration in milliseconds after which the agent requests, hypervisor
+	// operations and mounts are reported as slow
+	SlowOperationThreshold uint32
+
+	// Lines per second of each log source of the sandbox forwarded to the
+	// shim log, and size in KiB at which the files of the forwarded logs
+	// are rotated
+	LogRateLimit  uint32
+	LogRotateSize uint32
+
+	// CPU weight, CPU time in thousandths of CPU and memory in MiB of the
+	// shim, in its own cgroup in the one of the VMM
+	ShimCPUWeight uint32
+	ShimMilliCPUs uint32
+	ShimMemoryMB  uint32
+
 	// Determines if Kata creates emptyDir on the guest
 	DisableGuestEmptyDir bool
 }
@@ -303,12 +386,43 @@ func containerDeviceInfos(spec specs.Spec) ([]config.DeviceInfo, error) {
 			return []config.DeviceInfo{}, err
 		}
 
+		// A raw block device the container can only read, e.g. a read-only
+		// Kubernetes volumeDevice, is attached read-only.
+		if d.Type == "b" && !deviceWritable(spec, d) {
+			linuxDeviceInfo.ReadOnly = true
+		}
+
 		devices = append(devices, *linuxDeviceInfo)
 	}
 
 	return devices, nil
 }
 
+// deviceWritable returns true unless the device cgroup rules of the spec
+// deny writes to the device. As for the kernel, the last matching rule wins.
+func deviceWritable(spec specs.Spec, d specs.LinuxDevice) bool {
+	if spec.Linux.Resources == nil {
+		return true
+	}
+
+	writable := true
+	for _, r := range spec.Linux.Resources.Devices {
+		if r.Type != "" && r.Type != "a" && r.Type != d.Type {
+			continue
+		}
+		if (r.Major != nil && *r.Major != d.Major) || (r.Minor != nil && *r.Minor != d.Minor) {
+			continue
+		}
+		// An empty access means all of them
+		if r.Access != "" && !strings.Contains(r.Access, "w") {
+			continue
+		}
+		writable = r.Allow
+	}
+
+	return writable
+}
+
 func networkConfig(ocispec specs.Spec, config RuntimeConfig) (vc.NetworkConfig, error) {
 	linux := ocispec.Linux
 	if linux == nil {
@@ -328,6 +442,9 @@ func networkConfig(ocispec specs.Spec, config RuntimeConfig) (vc.NetworkConfig,
 	}
 	netConf.InterworkingModel = config.InterNetworkModel
 	netConf.DisableNewNetwork = config.DisableNewNetNs
+	netConf.HostNetworkMode = config.HostNetworkMode
+	netConf.MacvtapMode = config.MacvtapMode
+	netConf.PasstPath = config.PasstPath
 
 	return netConf, nil
 }
@@ -377,6 +494,12 @@ func addAnnotations(ocispec specs.Spec, config *vc.SandboxConfig, runtime Runtim
 		}
 	}
 
+	// The policy of the agent API replaces the one of the configuration, so
+	// it is rejected unless enable_annotations holds "agent.policy".
+	if _, ok := ocispec.Annotations[vcAnnotations.AgentPolicy]; ok && !regexpContains(runtime.HypervisorConfig.EnableAnnotations, agentPolicyAnnotation) {
+		return fmt.Errorf("annotation %v is not enabled", vcAnnotations.AgentPolicy)
+	}
+
 	err := addAssetAnnotations(ocispec, config)
 	if err != nil {
 		return err
@@ -582,6 +705,24 @@ func addHypervisorMemoryOverrides(ocispec specs.Spec, sbConfig *vc.SandboxConfig
 		return err
 	}
 
+	if err := newAnnotationConfiguration(ocispec, vcAnnotations.EnableMemoryBalloon).setBool(func(enableMemoryBalloon bool) {
+		sbConfig.HypervisorConfig.EnableMemoryBalloon = enableMemoryBalloon
+	}); err != nil {
+		return err
+	}
+
+	if err := newAnnotationConfiguration(ocispec, vcAnnotations.MemoryShrinkHysteresisMB).setUint(func(hysteresis uint64) {
+		sbConfig.HypervisorConfig.MemoryShrinkHysteresisMB = uint32(hysteresis)
+	}); err != nil {
+		return err
+	}
+
+	if err := newAnnotationConfiguration(ocispec, vcAnnotations.EnableGuestNUMA).setBool(func(enableGuestNUMA bool) {
+		sbConfig.HypervisorConfig.EnableGuestNUMA = enableGuestNUMA
+	}); err != nil {
+		return err
+	}
+
 	if err := newAnnotationConfiguration(ocispec, vcAnnotations.MemPrealloc).setBool(func(memPrealloc bool) {
 		sbConfig.HypervisorConfig.MemPrealloc = memPrealloc
 	}); err != nil {
@@ -718,6 +859,15 @@ func addHypervisorVirtioFsOverrides(ocispec specs.Spec, sbConfig *vc.SandboxConf
 		}
 	}
 
+	// The annotation can't make writable a shared file system configured read-only
+	if err := newAnnotationConfiguration(ocispec, vcAnnotations.SharedFSReadOnly).setBool(func(sharedFSReadOnly bool) {
+		if sharedFSReadOnly {
+			sbConfig.HypervisorConfig.SharedFSReadOnly = true
+		}
+	}); err != nil {
+		return err
+	}
+
 	if value, ok := ocispec.Annotations[vcAnnotations.VirtioFSDaemon]; ok {
 		if !checkPathIsInGlobs(runtime.HypervisorConfig.VirtioFSDaemonList, value) {
 			return fmt.Errorf("virtiofs daemon %v required from annotation is not valid", value)
@@ -742,12 +892,32 @@ func addHypervisorVirtioFsOverrides(ocispec specs.Spec, sbConfig *vc.SandboxConf
 		sbConfig.HypervisorConfig.VirtioFSCache = value
 	}
 
-	if err := newAnnotationConfiguration(ocispec, vcAnnotations.VirtioFSCacheSize).setUint(func(cacheSize uint64) {
+	if err := newAnnotationConfiguration(ocispec, vcAnnotations.VirtioFSCacheSize).setUintWithCheck(func(cacheSize uint64) error {
+		// A size of 0 disables DAX, which is always allowed
+		max := runtime.HypervisorConfig.VirtioFSCacheSizeMax
+		if max > 0 && cacheSize > uint64(max) {
+			return fmt.Errorf("DAX cache size %d MiB specified in annotation %s is greater than the maximum %d MiB", cacheSize, vcAnnotations.VirtioFSCacheSize, max)
+		}
 		sbConfig.HypervisorConfig.VirtioFSCacheSize = uint32(cacheSize)
+		return nil
 	}); err != nil {
 		return err
 	}
 
+	if value, ok := ocispec.Annotations[vcAnnotations.Cache9p]; ok {
+		if !contains(vc.Supported9pCacheModes, value) {
+			return fmt.Errorf("Invalid 9p cache mode %v specified for annotation cache_9p, (supported modes: %v)", value, vc.Supported9pCacheModes)
+		}
+		sbConfig.HypervisorConfig.Cache9p = value
+	}
+
+	if value, ok := ocispec.Annotations[vcAnnotations.Version9p]; ok {
+		if !contains(vc.Supported9pVersions, value) {
+			return fmt.Errorf("Invalid 9p version %v specified for annotation version_9p, (supported versions: %v)", value, vc.Supported9pVersions)
+		}
+		sbConfig.HypervisorConfig.Version9p = value
+	}
+
 	return newAnnotationConfiguration(ocispec, vcAnnotations.Msize9p).setUintWithCheck(func(msize9p uint64) error {
 		if msize9p == 0 {
 			return fmt.Errorf("Error parsing annotation for msize_9p, please specify positive numeric value")
@@ -770,6 +940,18 @@ func addHypervisporNetworkOverrides(ocispec specs.Spec, sbConfig *vc.SandboxConf
 		return err
 	}
 
+	if err := newAnnotationConfiguration(ocispec, vcAnnotations.NetworkQueues).setUint(func(networkQueues uint64) {
+		sbConfig.HypervisorConfig.NetworkQueues = uint32(networkQueues)
+	}); err != nil {
+		return err
+	}
+
+	if err := newAnnotationConfiguration(ocispec, vcAnnotations.EnableNetRSS).setBool(func(enableNetRSS bool) {
+		sbConfig.HypervisorConfig.EnableNetRSS = enableNetRSS
+	}); err != nil {
+		return err
+	}
+
 	if err := newAnnotationConfiguration(ocispec, vcAnnotations.RxRateLimiterMaxRate).setUint(func(rxRateLimiterMaxRate uint64) {
 		sbConfig.HypervisorConfig.RxRateLimiterMaxRate = rxRateLimiterMaxRate
 	}); err != nil {
@@ -795,6 +977,12 @@ func addRuntimeConfigOverrides(ocispec specs.Spec, sbConfig *vc.SandboxConfig, r
 		return err
 	}
 
+	if err := newAnnotationConfiguration(ocispec, vcAnnotations.EnableVCPUsPinning).setBool(func(enableVCPUsPinning bool) {
+		sbConfig.EnableVCPUsPinning = enableVCPUsPinning
+	}); err != nil {
+		return err
+	}
+
 	if value, ok := ocispec.Annotations[vcAnnotations.Experimental]; ok {
 		features := strings.Split(value, " ")
 		sbConfig.Experimental = []exp.Feature{}
@@ -854,6 +1042,14 @@ func addAgentConfigOverrides(ocispec specs.Spec, config *vc.SandboxConfig) error
 		return err
 	}
 
+	if value, ok := ocispec.Annotations[vcAnnotations.AgentPolicy]; ok {
+		policy, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return fmt.Errorf("Error decoding annotation %s: %v", vcAnnotations.AgentPolicy, err)
+		}
+		c.Policy = string(policy)
+	}
+
 	config.AgentConfig = c
 
 	return nil
@@ -908,10 +1104,32 @@ func SandboxConfig(ocispec specs.Spec, runtime RuntimeConfig, bundlePath, cid, c
 
 		SystemdCgroup: systemdCgroup,
 
-		SandboxCgroupOnly: runtime.SandboxCgroupOnly,
-		SandboxBindMounts: runtime.SandboxBindMounts,
+		SandboxCgroupOnly:  runtime.SandboxCgroupOnly,
+		EnableVCPUsPinning: runtime.EnableVCPUsPinning,
+		SandboxBindMounts:  runtime.SandboxBindMounts,
 
 		DisableGuestSeccomp: runtime.DisableGuestSeccomp,
+		RequireGuestSeccomp: runtime.RequireGuestSeccomp,
+
+		GuestTimeSyncInterval: runtime.GuestTimeSyncInterval,
+		GuestTimeSource:       runtime.GuestTimeSource,
+		GuestNTPServers:       runtime.GuestNTPServers,
+
+		EnableGuestHooks: runtime.EnableGuestHooks,
+
+		ConsoleCaptureSize: runtime.ConsoleCaptureSize,
+
+		EnableAudit: runtime.EnableAudit,
+		LinuxAudit:  runtime.LinuxAudit,
+
+		SlowOperationThreshold: runtime.SlowOperationThreshold,
+
+		LogRateLimit:  runtime.LogRateLimit,
+		LogRotateSize: runtime.LogRotateSize,
+
+		ShimCPUWeight: runtime.ShimCPUWeight,
+		ShimMilliCPUs: runtime.ShimMilliCPUs,
+		ShimMemoryMB:  runtime.ShimMemoryMB,
 
 		// Q: Is this really necessary? @weizhang555
 		// Spec: &ocispec,
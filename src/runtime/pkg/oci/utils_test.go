@@ -6,6 +6,7 @@
 package oci
 
 import (
+	"encoding/base64"
 	"fmt"
 	"os"
 	"path"
@@ -353,6 +354,42 @@ func TestDeviceTypeFailure(t *testing.T) {
 	assert.NotNil(t, err, "This test should fail as device type [%s] is invalid ", invalidDeviceType)
 }
 
+func TestReadOnlyBlockDevice(t *testing.T) {
+	assert := assert.New(t)
+
+	major, minor := int64(8), int64(16)
+	ociSpec := specs.Spec{
+		Linux: &specs.Linux{
+			Devices: []specs.LinuxDevice{
+				{Path: "/dev/xvda", Type: "b", Major: major, Minor: minor},
+				{Path: "/dev/fuse", Type: "c", Major: 10, Minor: 229},
+			},
+		},
+	}
+
+	// No device cgroup rules
+	devices, err := containerDeviceInfos(ociSpec)
+	assert.NoError(err)
+	assert.False(devices[0].ReadOnly)
+
+	ociSpec.Linux.Resources = &specs.LinuxResources{
+		Devices: []specs.LinuxDeviceCgroup{
+			{Allow: false, Access: "rwm"},
+			{Allow: true, Type: "b", Major: &major, Minor: &minor, Access: "r"},
+			{Allow: true, Type: "c", Major: &major, Minor: &minor, Access: "rw"},
+		},
+	}
+	devices, err = containerDeviceInfos(ociSpec)
+	assert.NoError(err)
+	assert.True(devices[0].ReadOnly)
+	assert.False(devices[1].ReadOnly)
+
+	ociSpec.Linux.Resources.Devices[1].Access = "rw"
+	devices, err = containerDeviceInfos(ociSpec)
+	assert.NoError(err)
+	assert.False(devices[0].ReadOnly)
+}
+
 func TestContains(t *testing.T) {
 	s := []string{"char", "block", "pipe"}
 
@@ -563,6 +600,7 @@ func TestAddAgentAnnotations(t *testing.T) {
 			"i915 enable_ppgtt=0",
 		},
 		ContainerPipeSize: 1024,
+		Policy:            "[endpoints]\nallowed = [\"CreateContainerRequest\"]\n",
 	}
 
 	runtimeConfig := RuntimeConfig{
@@ -572,8 +610,18 @@ func TestAddAgentAnnotations(t *testing.T) {
 
 	ocispec.Annotations[vcAnnotations.KernelModules] = strings.Join(expectedAgentConfig.KernelModules, KernelModulesSeparator)
 	ocispec.Annotations[vcAnnotations.AgentContainerPipeSize] = "1024"
+	ocispec.Annotations[vcAnnotations.AgentPolicy] = base64.StdEncoding.EncodeToString([]byte(expectedAgentConfig.Policy))
+
+	// The policy annotation is rejected by default
+	assert.Error(addAnnotations(ocispec, &config, runtimeConfig))
+	assert.Empty(config.AgentConfig.Policy)
+
+	runtimeConfig.HypervisorConfig.EnableAnnotations = []string{"agent.policy"}
 	addAnnotations(ocispec, &config, runtimeConfig)
 	assert.Exactly(expectedAgentConfig, config.AgentConfig)
+
+	ocispec.Annotations[vcAnnotations.AgentPolicy] = "not base64"
+	assert.Error(addAnnotations(ocispec, &config, runtimeConfig))
 }
 
 func TestContainerPipeSizeAnnotation(t *testing.T) {
@@ -645,6 +693,8 @@ func TestAddHypervisorAnnotations(t *testing.T) {
 	ocispec.Annotations[vcAnnotations.MemSlots] = "20"
 	ocispec.Annotations[vcAnnotations.MemOffset] = "512"
 	ocispec.Annotations[vcAnnotations.VirtioMem] = "true"
+	ocispec.Annotations[vcAnnotations.EnableMemoryBalloon] = "true"
+	ocispec.Annotations[vcAnnotations.MemoryShrinkHysteresisMB] = "256"
 	ocispec.Annotations[vcAnnotations.MemPrealloc] = "true"
 	ocispec.Annotations[vcAnnotations.FileBackedMemRootDir] = "/dev/shm"
 	ocispec.Annotations[vcAnnotations.HugePages] = "true"
@@ -656,10 +706,13 @@ func TestAddHypervisorAnnotations(t *testing.T) {
 	ocispec.Annotations[vcAnnotations.BlockDeviceCacheDirect] = "true"
 	ocispec.Annotations[vcAnnotations.BlockDeviceCacheNoflush] = "true"
 	ocispec.Annotations[vcAnnotations.SharedFS] = "virtio-fs"
+	ocispec.Annotations[vcAnnotations.SharedFSReadOnly] = "true"
 	ocispec.Annotations[vcAnnotations.VirtioFSDaemon] = "/bin/false"
 	ocispec.Annotations[vcAnnotations.VirtioFSCache] = "/home/cache"
 	ocispec.Annotations[vcAnnotations.VirtioFSExtraArgs] = "[ \"arg0\", \"arg1\" ]"
 	ocispec.Annotations[vcAnnotations.Msize9p] = "512"
+	ocispec.Annotations[vcAnnotations.Cache9p] = "loose"
+	ocispec.Annotations[vcAnnotations.Version9p] = "9p2000.u"
 	ocispec.Annotations[vcAnnotations.MachineType] = "q35"
 	ocispec.Annotations[vcAnnotations.MachineAccelerators] = "nofw"
 	ocispec.Annotations[vcAnnotations.CPUFeatures] = "pmu=off"
@@ -682,6 +735,8 @@ func TestAddHypervisorAnnotations(t *testing.T) {
 	assert.Equal(config.HypervisorConfig.MemSlots, uint32(20))
 	assert.Equal(config.HypervisorConfig.MemOffset, uint64(512))
 	assert.Equal(config.HypervisorConfig.VirtioMem, true)
+	assert.Equal(config.HypervisorConfig.EnableMemoryBalloon, true)
+	assert.Equal(config.HypervisorConfig.MemoryShrinkHysteresisMB, uint32(256))
 	assert.Equal(config.HypervisorConfig.MemPrealloc, true)
 	assert.Equal(config.HypervisorConfig.FileBackedMemRootDir, "/dev/shm")
 	assert.Equal(config.HypervisorConfig.HugePages, true)
@@ -693,10 +748,13 @@ func TestAddHypervisorAnnotations(t *testing.T) {
 	assert.Equal(config.HypervisorConfig.BlockDeviceCacheDirect, true)
 	assert.Equal(config.HypervisorConfig.BlockDeviceCacheNoflush, true)
 	assert.Equal(config.HypervisorConfig.SharedFS, "virtio-fs")
+	assert.Equal(config.HypervisorConfig.SharedFSReadOnly, true)
 	assert.Equal(config.HypervisorConfig.VirtioFSDaemon, "/bin/false")
 	assert.Equal(config.HypervisorConfig.VirtioFSCache, "/home/cache")
 	assert.ElementsMatch(config.HypervisorConfig.VirtioFSExtraArgs, [2]string{"arg0", "arg1"})
 	assert.Equal(config.HypervisorConfig.Msize9p, uint32(512))
+	assert.Equal(config.HypervisorConfig.Cache9p, "loose")
+	assert.Equal(config.HypervisorConfig.Version9p, "9p2000.u")
 	assert.Equal(config.HypervisorConfig.HypervisorMachineType, "q35")
 	assert.Equal(config.HypervisorConfig.MachineAccelerators, "nofw")
 	assert.Equal(config.HypervisorConfig.CPUFeatures, "pmu=off")
@@ -796,6 +854,49 @@ func TestAddProtectedHypervisorAnnotations(t *testing.T) {
 	assert.Error(err)
 }
 
+func TestAddVirtioFSCacheSizeAnnotation(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, d := range []struct {
+		annotation   string
+		maxCacheSize uint32
+		cacheSize    uint32
+		expectError  bool
+	}{
+		{"2048", 0, 2048, false},
+		{"1024", 1024, 1024, false},
+		{"2048", 1024, 0, true},
+		// A size of 0 disables DAX, whatever the maximum
+		{"0", 1024, 0, false},
+		{"foo", 1024, 0, true},
+	} {
+		config := vc.SandboxConfig{
+			Annotations: make(map[string]string),
+		}
+
+		ocispec := specs.Spec{
+			Annotations: map[string]string{
+				vcAnnotations.VirtioFSCacheSize: d.annotation,
+			},
+		}
+
+		runtimeConfig := RuntimeConfig{
+			HypervisorType: vc.QemuHypervisor,
+			Console:        consolePath,
+		}
+		runtimeConfig.HypervisorConfig.EnableAnnotations = []string{".*"}
+		runtimeConfig.HypervisorConfig.VirtioFSCacheSizeMax = d.maxCacheSize
+
+		err := addAnnotations(ocispec, &config, runtimeConfig)
+		if d.expectError {
+			assert.Error(err, "annotation %q, maximum %d", d.annotation, d.maxCacheSize)
+			continue
+		}
+		assert.NoError(err, "annotation %q, maximum %d", d.annotation, d.maxCacheSize)
+		assert.Equal(d.cacheSize, config.HypervisorConfig.VirtioFSCacheSize)
+	}
+}
+
 func TestAddRuntimeAnnotations(t *testing.T) {
 	assert := assert.New(t)
 
@@ -814,12 +915,14 @@ func TestAddRuntimeAnnotations(t *testing.T) {
 
 	ocispec.Annotations[vcAnnotations.DisableGuestSeccomp] = "true"
 	ocispec.Annotations[vcAnnotations.SandboxCgroupOnly] = "true"
+	ocispec.Annotations[vcAnnotations.EnableVCPUsPinning] = "true"
 	ocispec.Annotations[vcAnnotations.DisableNewNetNs] = "true"
 	ocispec.Annotations[vcAnnotations.InterNetworkModel] = "macvtap"
 
 	addAnnotations(ocispec, &config, runtimeConfig)
 	assert.Equal(config.DisableGuestSeccomp, true)
 	assert.Equal(config.SandboxCgroupOnly, true)
+	assert.Equal(config.EnableVCPUsPinning, true)
 	assert.Equal(config.NetworkConfig.DisableNewNetwork, true)
 	assert.Equal(config.NetworkConfig.InterworkingModel, vc.NetXConnectMacVtapModel)
 }
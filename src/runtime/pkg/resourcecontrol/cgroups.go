@@ -12,10 +12,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/containerd/cgroups"
 	v1 "github.com/containerd/cgroups/stats/v1"
+	runcCgroups "github.com/opencontainers/runc/libcontainer/cgroups"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
 )
@@ -134,6 +136,10 @@ func NewResourceController(path string, resources *specs.LinuxResources) (Resour
 		return nil, err
 	}
 
+	if IsCgroupV2() && !IsSystemdCgroup(cgroupPath) {
+		return newResourceControllerV2(cgroupPath, resources)
+	}
+
 	cgroup, err := cgroups.New(cgroups.V1, cgroups.StaticPath(cgroupPath), resources)
 	if err != nil {
 		return nil, err
@@ -152,6 +158,13 @@ func NewSandboxResourceController(path string, resources *specs.LinuxResources,
 	sandboxResources := *resources
 	sandboxResources.Devices = append(sandboxResources.Devices, sandboxDevices()...)
 
+	// cgroup v2 does not allow the threads of a process in different domain
+	// cgroups, such as the vCPU threads in the sandbox cgroup and the other
+	// threads of the VMM in the overhead one.
+	if IsCgroupV2() && !sandboxCgroupOnly {
+		return nil, fmt.Errorf("sandbox_cgroup_only=false is not supported with cgroup v2: the vCPU threads cannot be placed apart from the other threads of the VMM")
+	}
+
 	// Currently we know to handle systemd cgroup path only when it's the only cgroup (no overhead group), hence,
 	// if sandboxCgroupOnly is not true we treat it as cgroupfs path as it used to be, although it may be incorrect
 	if !IsSystemdCgroup(path) || !sandboxCgroupOnly {
@@ -193,6 +206,10 @@ func NewSandboxResourceController(path string, resources *specs.LinuxResources,
 }
 
 func LoadResourceController(path string) (ResourceController, error) {
+	if IsCgroupV2() && !IsSystemdCgroup(path) {
+		return loadResourceControllerV2(path)
+	}
+
 	cgHierarchy, cgPath, err := cgroupHierarchy(path)
 	if err != nil {
 		return nil, err
@@ -320,6 +337,39 @@ func (c *LinuxCgroup) UpdateCpuSet(cpuset, memset string) error {
 	})
 }
 
+func (c *LinuxCgroup) CPUSet() (string, error) {
+	return c.readCpuset("cpuset.effective_cpus")
+}
+
+func (c *LinuxCgroup) MemSet() (string, error) {
+	return c.readCpuset("cpuset.effective_mems")
+}
+
+// readCpuset reads a file of the cpuset subsystem of the cgroup.
+func (c *LinuxCgroup) readCpuset(file string) (string, error) {
+	mountpoint, err := runcCgroups.FindCgroupMountpoint("", "cpuset")
+	if err != nil {
+		return "", err
+	}
+
+	_, cgPath, err := cgroupHierarchy(c.path)
+	if err != nil {
+		return "", err
+	}
+
+	path, err := cgPath(cgroups.Cpuset)
+	if err != nil {
+		return "", err
+	}
+
+	value, err := runcCgroups.ReadFile(filepath.Join(mountpoint, path), file)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(value), nil
+}
+
 func (c *LinuxCgroup) Type() ResourceControllerType {
 	return LinuxCgroups
 }
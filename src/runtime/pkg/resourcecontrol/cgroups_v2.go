@@ -0,0 +1,406 @@
+//go:build linux
+// +build linux
+
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package resourcecontrol
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/containerd/cgroups"
+	v1 "github.com/containerd/cgroups/stats/v1"
+	runcCgroups "github.com/opencontainers/runc/libcontainer/cgroups"
+	"github.com/opencontainers/runc/libcontainer/cgroups/fs2"
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/opencontainers/runc/libcontainer/devices"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+)
+
+// IsCgroupV2 returns whether the host only has the cgroup v2 unified
+// hierarchy mounted.
+func IsCgroupV2() bool {
+	return cgroups.Mode() == cgroups.Unified
+}
+
+// LinuxCgroupV2 is a resource controller of the cgroup v2 unified hierarchy.
+// The access to the devices is controlled by an eBPF device filter attached
+// to the cgroup, built from its device rules, which also applies to its
+// children.
+type LinuxCgroupV2 struct {
+	manager runcCgroups.Manager
+	path    string
+	cpusets *specs.LinuxCPU
+	devices []specs.LinuxDeviceCgroup
+
+	// devicesLoaded is false for a loaded controller, whose device rules
+	// cannot be read back from its eBPF program.
+	devicesLoaded bool
+
+	sync.Mutex
+}
+
+func newCgroupV2Manager(path string) (runcCgroups.Manager, error) {
+	return fs2.NewManager(&configs.Cgroup{Resources: &configs.Resources{}}, filepath.Join(fs2.UnifiedMountpoint, path), false)
+}
+
+// newResourceControllerV2 creates a cgroup v2 controller at path, enabling
+// the controllers of its ancestors.
+func newResourceControllerV2(path string, resources *specs.LinuxResources) (ResourceController, error) {
+	manager, err := newCgroupV2Manager(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// -1 creates the cgroup without adding a process to it
+	if err := manager.Apply(-1); err != nil {
+		return nil, err
+	}
+
+	c := &LinuxCgroupV2{
+		manager:       manager,
+		path:          path,
+		cpusets:       resources.CPU,
+		devicesLoaded: true,
+	}
+
+	if err := c.Update(resources); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func loadResourceControllerV2(path string) (ResourceController, error) {
+	manager, err := newCgroupV2Manager(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !manager.Exists() {
+		return nil, fmt.Errorf("cgroup %s does not exist", path)
+	}
+
+	c := &LinuxCgroupV2{
+		manager: manager,
+		path:    path,
+	}
+
+	// The cpusets are restored for UpdateCpuSet to keep the ones which are
+	// not updated.
+	cpus, err := c.readFile("cpuset.cpus")
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	mems, err := c.readFile("cpuset.mems")
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if cpus != "" || mems != "" {
+		c.cpusets = &specs.LinuxCPU{
+			Cpus: cpus,
+			Mems: mems,
+		}
+	}
+
+	return c, nil
+}
+
+// cgroupV2DeviceRules converts the OCI device rules to the ones of the eBPF
+// device filter.
+func cgroupV2DeviceRules(deviceRules []specs.LinuxDeviceCgroup) ([]*devices.Rule, error) {
+	var rules []*devices.Rule
+
+	for _, d := range deviceRules {
+		rule := &devices.Rule{
+			Type:        devices.WildcardDevice,
+			Major:       devices.Wildcard,
+			Minor:       devices.Wildcard,
+			Permissions: devices.Permissions(d.Access),
+			Allow:       d.Allow,
+		}
+
+		if d.Type != "" {
+			rule.Type = devices.Type(d.Type[0])
+		}
+		if d.Major != nil {
+			rule.Major = *d.Major
+		}
+		if d.Minor != nil {
+			rule.Minor = *d.Minor
+		}
+		if rule.Permissions == "" {
+			rule.Permissions = "rwm"
+		}
+
+		if !rule.Type.CanCgroup() || !rule.Permissions.IsValid() {
+			return nil, fmt.Errorf("invalid device rule %+v", d)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// cgroupV2Resources converts the OCI resources, described for cgroup v1, to
+// the ones of a cgroup v2. Without device rules, the device filter of the
+// cgroup is left as is, for its parent one to apply.
+func cgroupV2Resources(resources *specs.LinuxResources) (*configs.Resources, error) {
+	r := &configs.Resources{SkipDevices: len(resources.Devices) == 0}
+
+	if !r.SkipDevices {
+		rules, err := cgroupV2DeviceRules(resources.Devices)
+		if err != nil {
+			return nil, err
+		}
+		r.Devices = rules
+	}
+
+	if cpu := resources.CPU; cpu != nil {
+		if cpu.Shares != nil {
+			r.CpuWeight = runcCgroups.ConvertCPUSharesToCgroupV2Value(*cpu.Shares)
+		}
+		if cpu.Quota != nil {
+			r.CpuQuota = *cpu.Quota
+		}
+		if cpu.Period != nil {
+			r.CpuPeriod = *cpu.Period
+		}
+		r.CpusetCpus = cpu.Cpus
+		r.CpusetMems = cpu.Mems
+	}
+
+	if memory := resources.Memory; memory != nil && memory.Limit != nil {
+		r.Memory = *memory.Limit
+	}
+
+	if pids := resources.Pids; pids != nil {
+		r.PidsLimit = pids.Limit
+	}
+
+	return r, nil
+}
+
+func (c *LinuxCgroupV2) Logger() *logrus.Entry {
+	return controllerLogger.WithField("source", "cgroups-v2")
+}
+
+func (c *LinuxCgroupV2) Delete() error {
+	return c.manager.Destroy()
+}
+
+func (c *LinuxCgroupV2) Stat() (*v1.Metrics, error) {
+	stats, err := c.manager.GetStats()
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.Metrics{
+		CPU: &v1.CPUStat{
+			Usage: &v1.CPUUsage{
+				Total:  stats.CpuStats.CpuUsage.TotalUsage,
+				Kernel: stats.CpuStats.CpuUsage.UsageInKernelmode,
+				User:   stats.CpuStats.CpuUsage.UsageInUsermode,
+			},
+			Throttling: &v1.Throttle{
+				Periods:          stats.CpuStats.ThrottlingData.Periods,
+				ThrottledPeriods: stats.CpuStats.ThrottlingData.ThrottledPeriods,
+				ThrottledTime:    stats.CpuStats.ThrottlingData.ThrottledTime,
+			},
+		},
+		Memory: &v1.MemoryStat{
+			Usage: &v1.MemoryEntry{
+				Usage:   stats.MemoryStats.Usage.Usage,
+				Max:     stats.MemoryStats.Usage.MaxUsage,
+				Failcnt: stats.MemoryStats.Usage.Failcnt,
+				Limit:   stats.MemoryStats.Usage.Limit,
+			},
+		},
+		Pids: &v1.PidsStat{
+			Current: stats.PidsStats.Current,
+			Limit:   stats.PidsStats.Limit,
+		},
+	}, nil
+}
+
+func (c *LinuxCgroupV2) AddProcess(pid int, subsystems ...string) error {
+	return runcCgroups.WriteCgroupProc(c.manager.Path(""), pid)
+}
+
+// AddThread adds a thread to the controller, which cgroup v2 only allows
+// in threaded cgroups: the threads of a process cannot be split between
+// domain cgroups.
+func (c *LinuxCgroupV2) AddThread(pid int, subsystems ...string) error {
+	cgroupType, err := c.readFile("cgroup.type")
+	if err != nil {
+		return err
+	}
+
+	if cgroupType != "threaded" {
+		return fmt.Errorf("cannot add thread %d to the %s cgroup v2 of type %q: only threaded cgroups can hold the threads of a process apart", pid, c.path, cgroupType)
+	}
+
+	return runcCgroups.WriteFile(c.manager.Path(""), "cgroup.threads", strconv.Itoa(pid))
+}
+
+func (c *LinuxCgroupV2) Update(resources *specs.LinuxResources) error {
+	c.Lock()
+	defer c.Unlock()
+
+	r, err := cgroupV2Resources(resources)
+	if err != nil {
+		return err
+	}
+
+	if err := c.manager.Set(r); err != nil {
+		return err
+	}
+
+	if len(resources.Devices) > 0 {
+		c.devices = resources.Devices
+		c.devicesLoaded = true
+	}
+
+	return nil
+}
+
+// setDevices replaces the device filter of the controller with the one of
+// its device rules. It fails for a loaded controller, for the access to the
+// devices not to be silently opened or closed.
+func (c *LinuxCgroupV2) setDevices(deviceRules []specs.LinuxDeviceCgroup) error {
+	if !c.devicesLoaded {
+		return fmt.Errorf("the device rules of the %s cgroup are unknown", c.path)
+	}
+
+	rules, err := cgroupV2DeviceRules(deviceRules)
+	if err != nil {
+		return err
+	}
+
+	// An empty list of rules denies the access to all the devices.
+	if err := c.manager.Set(&configs.Resources{Devices: rules}); err != nil {
+		return err
+	}
+
+	c.devices = deviceRules
+	return nil
+}
+
+func (c *LinuxCgroupV2) MoveTo(path string) error {
+	pids, err := c.manager.GetPids()
+	if err != nil {
+		return err
+	}
+
+	// cgroup v2 does not allow processes in a cgroup other than the root one
+	// with controllers enabled for its children, such as the pod cgroup: the
+	// processes are then moved to the root cgroup, as they are on their way
+	// out.
+	dir := filepath.Join(fs2.UnifiedMountpoint, path)
+	if controllers, _ := runcCgroups.ReadFile(dir, "cgroup.subtree_control"); strings.TrimSpace(controllers) != "" {
+		dir = fs2.UnifiedMountpoint
+	}
+
+	for _, pid := range pids {
+		if err := runcCgroups.WriteCgroupProc(dir, pid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *LinuxCgroupV2) AddDevice(deviceHostPath string) error {
+	deviceResource, err := DeviceToLinuxDevice(deviceHostPath)
+	if err != nil {
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	deviceRules := append(append([]specs.LinuxDeviceCgroup{}, c.devices...), deviceResource)
+	return c.setDevices(deviceRules)
+}
+
+func (c *LinuxCgroupV2) RemoveDevice(deviceHostPath string) error {
+	deviceResource, err := DeviceToLinuxDevice(deviceHostPath)
+	if err != nil {
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	var deviceRules []specs.LinuxDeviceCgroup
+	for _, d := range c.devices {
+		if d.Type == deviceResource.Type &&
+			d.Major != nil && *d.Major == *deviceResource.Major &&
+			d.Minor != nil && *d.Minor == *deviceResource.Minor {
+			continue
+		}
+		deviceRules = append(deviceRules, d)
+	}
+
+	return c.setDevices(deviceRules)
+}
+
+func (c *LinuxCgroupV2) UpdateCpuSet(cpuset, memset string) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.cpusets == nil {
+		c.cpusets = &specs.LinuxCPU{}
+	}
+	if len(cpuset) > 0 {
+		c.cpusets.Cpus = cpuset
+	}
+	if len(memset) > 0 {
+		c.cpusets.Mems = memset
+	}
+
+	return c.manager.Set(&configs.Resources{
+		SkipDevices: true,
+		CpusetCpus:  c.cpusets.Cpus,
+		CpusetMems:  c.cpusets.Mems,
+	})
+}
+
+func (c *LinuxCgroupV2) CPUSet() (string, error) {
+	return c.readFile("cpuset.cpus.effective")
+}
+
+func (c *LinuxCgroupV2) MemSet() (string, error) {
+	return c.readFile("cpuset.mems.effective")
+}
+
+func (c *LinuxCgroupV2) readFile(file string) (string, error) {
+	value, err := runcCgroups.ReadFile(c.manager.Path(""), file)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(value), nil
+}
+
+func (c *LinuxCgroupV2) Type() ResourceControllerType {
+	return LinuxCgroups
+}
+
+func (c *LinuxCgroupV2) ID() string {
+	return c.path
+}
+
+func (c *LinuxCgroupV2) Parent() string {
+	return filepath.Dir(c.path)
+}
@@ -0,0 +1,90 @@
+//go:build linux
+// +build linux
+
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package resourcecontrol
+
+import (
+	"testing"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCgroupV2Resources(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := cgroupV2Resources(&specs.LinuxResources{})
+	assert.NoError(err)
+	assert.True(r.SkipDevices)
+	assert.Zero(r.CpuWeight)
+	assert.Zero(r.Memory)
+
+	shares := uint64(1024)
+	quota := int64(50000)
+	period := uint64(100000)
+	limit := int64(256 << 20)
+	r, err = cgroupV2Resources(&specs.LinuxResources{
+		CPU: &specs.LinuxCPU{
+			Shares: &shares,
+			Quota:  &quota,
+			Period: &period,
+			Cpus:   "0-1",
+			Mems:   "0",
+		},
+		Memory: &specs.LinuxMemory{Limit: &limit},
+		Pids:   &specs.LinuxPids{Limit: 100},
+	})
+	assert.NoError(err)
+
+	assert.Equal(uint64(39), r.CpuWeight)
+	assert.Equal(quota, r.CpuQuota)
+	assert.Equal(period, r.CpuPeriod)
+	assert.Equal("0-1", r.CpusetCpus)
+	assert.Equal("0", r.CpusetMems)
+	assert.Equal(limit, r.Memory)
+	assert.Equal(int64(100), r.PidsLimit)
+}
+
+func TestCgroupV2DeviceRules(t *testing.T) {
+	assert := assert.New(t)
+
+	major := int64(10)
+	minor := int64(232)
+	r, err := cgroupV2Resources(&specs.LinuxResources{
+		Devices: []specs.LinuxDeviceCgroup{
+			{Type: "c", Major: &major, Minor: &minor, Access: "rw", Allow: true},
+			{Type: "b", Major: &major, Allow: true},
+			{Allow: false, Access: "rwm"},
+		},
+	})
+	assert.NoError(err)
+	assert.False(r.SkipDevices)
+	assert.Len(r.Devices, 3)
+	assert.Equal("c 10:232 rw", r.Devices[0].CgroupString())
+	assert.Equal("b 10:* rwm", r.Devices[1].CgroupString())
+	assert.Equal("a *:* rwm", r.Devices[2].CgroupString())
+	assert.False(r.Devices[2].Allow)
+
+	_, err = cgroupV2Resources(&specs.LinuxResources{
+		Devices: []specs.LinuxDeviceCgroup{{Type: "x", Access: "rwm", Allow: true}},
+	})
+	assert.Error(err)
+
+	_, err = cgroupV2Resources(&specs.LinuxResources{
+		Devices: []specs.LinuxDeviceCgroup{{Type: "c", Access: "rwx", Allow: true}},
+	})
+	assert.Error(err)
+}
+
+func TestCgroupV2LoadedDevices(t *testing.T) {
+	assert := assert.New(t)
+
+	c := &LinuxCgroupV2{path: "/kata_loaded"}
+	assert.Error(c.AddDevice("/dev/null"))
+	assert.Error(c.RemoveDevice("/dev/null"))
+}
@@ -79,4 +79,12 @@ type ResourceController interface {
 
 	// UpdateCpuSet updates the set of controlled CPUs and memory nodes.
 	UpdateCpuSet(string, string) error
+
+	// CPUSet returns the set of CPUs the processes of the controller are
+	// effectively allowed to run on.
+	CPUSet() (string, error)
+
+	// MemSet returns the set of memory nodes the processes of the
+	// controller are effectively allowed to allocate memory on.
+	MemSet() (string, error)
 }
@@ -11,6 +11,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	cdshim "github.com/containerd/containerd/runtime/v2/shim"
@@ -42,13 +43,20 @@ func buildUnixSocketClient(socketAddr string, timeout time.Duration) (*http.Clie
 	return client, nil
 }
 
+// shimURL returns the URL of the management endpoint urlPath. The paths
+// exported by the shim start with a slash, and a double slash would be
+// redirected by the server, turning the request into a GET without body.
+func shimURL(urlPath string) string {
+	return "http://shim/" + strings.TrimPrefix(urlPath, "/")
+}
+
 func DoGet(sandboxID string, timeoutInSeconds time.Duration, urlPath string) ([]byte, error) {
 	client, err := BuildShimClient(sandboxID, timeoutInSeconds)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := client.Get(fmt.Sprintf("http://shim/%s", urlPath))
+	resp, err := client.Get(shimURL(urlPath))
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +70,10 @@ func DoGet(sandboxID string, timeoutInSeconds time.Duration, urlPath string) ([]
 		return nil, err
 	}
 
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", resp.Status, body)
+	}
+
 	return body, nil
 }
 
@@ -71,9 +83,48 @@ func DoPost(sandboxID string, timeoutInSeconds time.Duration, urlPath string, pa
 		return err
 	}
 
-	resp, err := client.Post(fmt.Sprintf("http://shim/%s", urlPath), "application/json", bytes.NewBuffer(payload))
+	resp, err := client.Post(shimURL(urlPath), "application/json", bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%s: %s", resp.Status, body)
+	}
+
+	return nil
+}
+
+func DoPut(sandboxID string, timeoutInSeconds time.Duration, urlPath, contentType string, payload []byte) error {
+	client, err := BuildShimClient(sandboxID, timeoutInSeconds)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, shimURL(urlPath), bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
 	defer func() {
 		resp.Body.Close()
 	}()
-	return err
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%s: %s", resp.Status, body)
+	}
+
+	return nil
 }
@@ -533,6 +533,18 @@ func (a *Acrn) updateBlockDevice(drive *config.BlockDrive) error {
 		return fmt.Errorf("Acrn doesn't support swap")
 	}
 
+	if drive.NVMe {
+		return fmt.Errorf("Acrn doesn't support NVMe namespaces")
+	}
+
+	if drive.VirtioPmem {
+		return fmt.Errorf("Acrn doesn't support virtio-pmem devices")
+	}
+
+	if drive.IOLimits != nil {
+		return fmt.Errorf("Acrn doesn't support block device I/O limits")
+	}
+
 	var err error
 	if drive.File == "" || drive.Index >= AcrnBlkDevPoolSz {
 		return fmt.Errorf("Empty filepath or invalid drive index, Dive ID:%s, Drive Index:%d",
@@ -646,7 +658,7 @@ func (a *Acrn) GetVMConsole(ctx context.Context, id string) (string, string, err
 	return consoleProtoUnix, consoleURL, nil
 }
 
-func (a *Acrn) SaveVM() error {
+func (a *Acrn) SaveVM(statePath string) error {
 	a.Logger().Info("Save sandbox")
 
 	// Not supported. return success
@@ -679,6 +691,10 @@ func (a *Acrn) ResizeVCPUs(ctx context.Context, reqVCPUs uint32) (currentVCPUs u
 	return 0, 0, nil
 }
 
+func (a *Acrn) ResizeBlockDevice(ctx context.Context, drive *config.BlockDrive, size uint64) error {
+	return fmt.Errorf("acrn does not support resizing block devices")
+}
+
 func (a *Acrn) Cleanup(ctx context.Context) error {
 	span, _ := katatrace.Trace(ctx, a.Logger(), "Cleanup", acrnTracingTags, map[string]string{"sandbox_id": a.id})
 	defer span.End()
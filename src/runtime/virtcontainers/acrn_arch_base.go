@@ -132,6 +132,11 @@ var acrnKernelRootParams = []Param{
 	{"root", "/dev/vda1 rw rootwait"},
 }
 
+var acrnEROFSKernelRootParams = []Param{
+	{"root", "/dev/vda1 ro rootwait"},
+	{"rootfstype", RootfsTypeEROFS},
+}
+
 var acrnKernelParams = []Param{
 	{"tsc", "reliable"},
 	{"no_timer_check", ""},
@@ -750,7 +755,12 @@ func (a *acrnArchBase) appendBlockDevice(devices []Device, drive config.BlockDri
 
 func (a *acrnArchBase) handleImagePath(config HypervisorConfig) {
 	if config.ImagePath != "" {
-		a.kernelParams = append(a.kernelParams, acrnKernelRootParams...)
+		if config.RootfsType == RootfsTypeEROFS {
+			// EROFS cannot be mounted read-write
+			a.kernelParams = append(a.kernelParams, acrnEROFSKernelRootParams...)
+		} else {
+			a.kernelParams = append(a.kernelParams, acrnKernelRootParams...)
+		}
 		a.kernelParamsNonDebug = append(a.kernelParamsNonDebug, acrnKernelParamsSystemdNonDebug...)
 		a.kernelParamsDebug = append(a.kernelParamsDebug, acrnKernelParamsSystemdDebug...)
 	}
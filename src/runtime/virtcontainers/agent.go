@@ -6,6 +6,7 @@
 package virtcontainers
 
 import (
+	"net"
 	"syscall"
 	"time"
 
@@ -111,6 +112,14 @@ type agent interface {
 	// readProcessStderr will tell the agent to read a process stderr
 	readProcessStderr(ctx context.Context, c *Container, processID string, data []byte) (int, error)
 
+	// openProcessIO will tell the agent to stream a process stdio over
+	// dedicated vsock connections, the stdin and stdout over a single one
+	// when the agent supports it, returning the ports to connect to
+	openProcessIO(ctx context.Context, c *Container, processID string) (processIOPorts, error)
+
+	// dialPort connects to a vsock port the agent listens on
+	dialPort(ctx context.Context, port uint32) (net.Conn, error)
+
 	// updateContainer will update the resources of a running container
 	updateContainer(ctx context.Context, sandbox *Sandbox, c Container, resources specs.LinuxResources) error
 
@@ -121,7 +130,9 @@ type agent interface {
 	// This function should be called after hot adding vCPUs or Memory.
 	// cpus specifies the number of CPUs that were added and the agent should online
 	// cpuOnly specifies that we should online cpu or online memory or both
-	onlineCPUMem(ctx context.Context, cpus uint32, cpuOnly bool) error
+	// memBlocks specifies the number of memory blocks hot added since the VM booted,
+	// the agent returns once they are online
+	onlineCPUMem(ctx context.Context, cpus uint32, cpuOnly bool, memBlocks uint32) error
 
 	// memHotplugByProbe will notify the guest kernel about memory hotplug event through
 	// probe interface.
@@ -156,6 +167,9 @@ type agent interface {
 	// updateRoutes will tell the agent to update route table for an existed Sandbox.
 	updateRoutes(ctx context.Context, routes []*pbTypes.Route) ([]*pbTypes.Route, error)
 
+	// addARPNeighbors will tell the agent to add ARP/NDP neighbor entries for an existed Sandbox.
+	addARPNeighbors(ctx context.Context, neighs []*pbTypes.ARPNeighbor) error
+
 	// listRoutes will tell the agent to list routes of an existed Sandbox
 	listRoutes(ctx context.Context) ([]*pbTypes.Route, error)
 
@@ -195,4 +209,46 @@ type agent interface {
 
 	// resizeGuestVolume resizes a volume specified by the volume mount path on the guest.
 	resizeGuestVolume(ctx context.Context, volumeGuestPath string, size uint64) error
+
+	// getIPTables returns the iptables, or ip6tables if isIPv6 is set,
+	// rules of the guest in the iptables-save format.
+	getIPTables(ctx context.Context, isIPv6 bool) ([]byte, error)
+
+	// setIPTables replaces the iptables, or ip6tables if isIPv6 is set,
+	// rules of the guest by the ones in the iptables-save format.
+	setIPTables(ctx context.Context, isIPv6 bool, data []byte) error
+
+	// setAgentTracing starts the tracing of the agent if enable is set, or
+	// stops it.
+	setAgentTracing(ctx context.Context, enable bool) error
+
+	// syncWatchableMount applies the changes of a watchable mount on the host to its copy in the guest.
+	syncWatchableMount(ctx context.Context, req *grpc.SyncWatchableMountRequest) error
+
+	// setPolicy replaces the policy restricting the agent API.
+	setPolicy(ctx context.Context, policy string) error
+
+	// getPolicy returns the active policy of the agent API.
+	getPolicy(ctx context.Context) (string, error)
+
+	// executeHooks runs hooks in the guest with the state of a container.
+	executeHooks(ctx context.Context, containerID string, hooks []specs.Hook) error
+
+	// getDiagnostics collects debugging artifacts of the guest, and of a
+	// container if containerID is not empty.
+	getDiagnostics(ctx context.Context, containerID string) (*grpc.Diagnostics, error)
+
+	// readCoreDump reads a core dump of a container from offset, as far
+	// as the agent maximum diagnostics size.
+	readCoreDump(ctx context.Context, containerID, name string, offset uint64) (*grpc.DiagnosticsFile, error)
+
+	// setLogLevel changes the log level of the agent.
+	setLogLevel(ctx context.Context, level string) error
+
+	// readKernelLog waits for the next records of the guest kernel log.
+	readKernelLog(ctx context.Context) ([]*grpc.KernelLogRecord, error)
+
+	// setGuestTimeSource keeps the guest clock in sync with a time source,
+	// either the host clock through the KVM PTP clock, or NTP servers.
+	setGuestTimeSource(ctx context.Context, source string, ntpServers []string) error
 }
@@ -7,6 +7,7 @@ package virtcontainers
 
 import (
 	"context"
+	"fmt"
 	"runtime"
 
 	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils/katatrace"
@@ -53,6 +54,49 @@ func CreateSandbox(ctx context.Context, sandboxConfig SandboxConfig, factory Fac
 	return s, err
 }
 
+// AdoptSandbox is the virtcontainers entry point for taking over a running
+// sandbox from another process, such as a shim being upgraded. The sandbox and
+// its containers are restored from the persisted state, the VM is left
+// untouched.
+func AdoptSandbox(ctx context.Context, sandboxID string) (VCSandbox, error) {
+	span, ctx := katatrace.Trace(ctx, virtLog, "AdoptSandbox", apiTracingTags)
+	defer span.End()
+
+	if sandboxID == "" {
+		return nil, vcTypes.ErrNeedSandboxID
+	}
+
+	unlock, err := rwLockSandbox(sandboxID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	s, err := fetchSandbox(ctx, sandboxID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.state.State != vcTypes.StateRunning {
+		return nil, errSandboxNotRunning
+	}
+
+	if err := s.agent.check(ctx); err != nil {
+		s.Release(ctx)
+		return nil, fmt.Errorf("agent of sandbox %s is not reachable: %v", sandboxID, err)
+	}
+
+	// The daemons of the previous shim are reparented to init when it exits,
+	// watch them for the VM to be stopped when they quit.
+	if adopter, ok := s.hypervisor.(virtiofsDaemonsAdopter); ok {
+		if err := adopter.adoptVirtiofsDaemons(ctx); err != nil {
+			s.Logger().WithError(err).Warn("failed to watch the virtiofs daemons")
+		}
+	}
+
+	return s, nil
+}
+
 func createSandboxFromConfig(ctx context.Context, sandboxConfig SandboxConfig, factory Factory) (_ *Sandbox, err error) {
 	span, ctx := katatrace.Trace(ctx, virtLog, "createSandboxFromConfig", apiTracingTags)
 	defer span.End()
@@ -70,9 +114,16 @@ func createSandboxFromConfig(ctx context.Context, sandboxConfig SandboxConfig, f
 		}
 	}()
 
+	// the guest console is captured before the sandbox is deleted
+	defer func() {
+		if err != nil {
+			s.captureGuestConsole(err)
+		}
+	}()
+
 	// Create the sandbox network
 	if err = s.createNetwork(ctx); err != nil {
-		return nil, err
+		return nil, vcTypes.NewError(vcTypes.ErrorInternal, vcTypes.SubsystemNetwork, false, err)
 	}
 
 	// network rollback
@@ -84,7 +135,7 @@ func createSandboxFromConfig(ctx context.Context, sandboxConfig SandboxConfig, f
 
 	// Set the sandbox host cgroups.
 	if err := s.setupResourceController(); err != nil {
-		return nil, err
+		return nil, vcTypes.NewError(vcTypes.ErrorInternal, vcTypes.SubsystemResources, false, err)
 	}
 
 	// Start the VM
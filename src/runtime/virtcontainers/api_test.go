@@ -311,3 +311,40 @@ func TestCleanupContainer(t *testing.T) {
 		t.Fatal("sandbox dir should be deleted")
 	}
 }
+
+func TestAdoptSandboxNoID(t *testing.T) {
+	_, err := AdoptSandbox(context.Background(), "")
+	assert.Equal(t, types.ErrNeedSandboxID, err)
+}
+
+func TestAdoptSandbox(t *testing.T) {
+	if tc.NotValid(ktu.NeedRoot()) {
+		t.Skip(testDisabledAsNonRoot)
+	}
+	defer cleanUp()
+
+	config := newTestSandboxConfigNoop()
+	assert := assert.New(t)
+
+	ctx := WithNewAgentFunc(context.Background(), newMockAgent)
+
+	p, _, err := createAndStartSandbox(ctx, config)
+	if p == nil || err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		assert.NoError(p.Stop(ctx, true))
+		assert.NoError(p.Delete(ctx))
+	}()
+
+	c, err := p.CreateContainer(ctx, newTestContainerConfigNoop("100"))
+	if c == nil || err != nil {
+		t.Fatal(err)
+	}
+
+	adopted, err := AdoptSandbox(ctx, p.ID())
+	assert.NoError(err)
+	assert.Equal(p.ID(), adopted.ID())
+	assert.NotNil(adopted.GetContainer("100"))
+	assert.NoError(adopted.Release(ctx))
+}
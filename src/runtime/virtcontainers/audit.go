@@ -0,0 +1,187 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// The operations changing the devices, the resources or the mounts of a
+// sandbox, which are audited.
+const (
+	AuditDeviceHotplug   = "device-hotplug"
+	AuditDeviceHotunplug = "device-hotunplug"
+	AuditCPUResize       = "cpu-resize"
+	AuditMemoryResize    = "memory-resize"
+	AuditMount           = "mount"
+	AuditUnmount         = "unmount"
+	AuditVolumeResize    = "volume-resize"
+)
+
+// The results of the audited operations.
+const (
+	AuditSuccess = "success"
+	AuditFailure = "failure"
+)
+
+// auditTrustedApp is the type of the messages of the trusted applications of
+// the Linux audit subsystem, AUDIT_TRUSTED_APP.
+const auditTrustedApp = 1121
+
+// auditLogger returns the logger of the audit records. They are logged at
+// the info level whatever the level of the runtime logger, to its output.
+func auditLogger() *logrus.Entry {
+	std := logrus.StandardLogger()
+
+	logger := logrus.New()
+	logger.SetOutput(std.Out)
+	logger.SetFormatter(std.Formatter)
+	logger.ReplaceHooks(std.Hooks)
+
+	return logger.WithField("source", "virtcontainers").WithField("subsystem", "audit")
+}
+
+// AuditRecord is the record of an operation changing the devices, the
+// resources or the mounts of a sandbox: who performed it, on what and when,
+// and its result.
+type AuditRecord struct {
+	Timestamp time.Time
+	Details   map[string]string
+	Operation string
+	SandboxID string
+	// ContainerID is the container the operation is performed for, if any
+	ContainerID string
+	// Object is the device, the resource or the path the operation is
+	// performed on
+	Object string
+	Result string
+	Error  string
+	// Pid and UID are the ones of the runtime process performing the
+	// operation
+	Pid int
+	UID int
+}
+
+// newAuditRecord returns the record of an operation of the sandbox, failed
+// if err is not nil.
+func (s *Sandbox) newAuditRecord(operation, containerID, object string, details map[string]string, err error) AuditRecord {
+	r := AuditRecord{
+		Timestamp:   time.Now(),
+		Details:     details,
+		Operation:   operation,
+		SandboxID:   s.id,
+		ContainerID: containerID,
+		Object:      object,
+		Result:      AuditSuccess,
+		Pid:         os.Getpid(),
+		UID:         os.Getuid(),
+	}
+
+	if err != nil {
+		r.Result = AuditFailure
+		r.Error = err.Error()
+	}
+
+	return r
+}
+
+// audit logs the record of an operation of the sandbox, and sends it to the
+// Linux audit subsystem if configured, when auditing is enabled.
+func (s *Sandbox) audit(operation, containerID, object string, details map[string]string, err error) {
+	if s.config == nil || !s.config.EnableAudit {
+		return
+	}
+
+	r := s.newAuditRecord(operation, containerID, object, details, err)
+
+	fields := logrus.Fields{
+		"operation": r.Operation,
+		"sandbox":   r.SandboxID,
+		"object":    r.Object,
+		"result":    r.Result,
+		"pid":       r.Pid,
+		"uid":       r.UID,
+	}
+	if r.ContainerID != "" {
+		fields["container"] = r.ContainerID
+	}
+	if r.Error != "" {
+		fields["error"] = r.Error
+	}
+	for key, value := range r.Details {
+		fields[key] = value
+	}
+	logger := auditLogger()
+	logger.WithTime(r.Timestamp).WithFields(fields).Info("audit")
+
+	if s.config.LinuxAudit {
+		if err := sendLinuxAudit(r.message()); err != nil {
+			logger.WithError(err).WithField("sandbox", s.id).Warn("failed to send the audit record to the Linux audit subsystem")
+		}
+	}
+}
+
+// message returns the record as the key=value fields of a Linux audit
+// message, the values with spaces being quoted.
+func (r AuditRecord) message() string {
+	fields := []string{
+		"op=" + auditValue(r.Operation),
+		"sandbox=" + auditValue(r.SandboxID),
+	}
+	if r.ContainerID != "" {
+		fields = append(fields, "container="+auditValue(r.ContainerID))
+	}
+	fields = append(fields, "object="+auditValue(r.Object))
+
+	var details []string
+	for key, value := range r.Details {
+		details = append(details, key+"="+auditValue(value))
+	}
+	sort.Strings(details)
+	fields = append(fields, details...)
+
+	if r.Error != "" {
+		fields = append(fields, "error="+auditValue(r.Error))
+	}
+	fields = append(fields, "res="+r.Result)
+
+	return strings.Join(fields, " ")
+}
+
+func auditValue(value string) string {
+	if value == "" || strings.ContainsAny(value, " \t\n\"'=") {
+		return strconv.Quote(value)
+	}
+	return value
+}
+
+// sendLinuxAudit sends a trusted application message to the Linux audit
+// subsystem, which requires CAP_AUDIT_WRITE.
+func sendLinuxAudit(message string) error {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_AUDIT)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+
+	req := nl.NewNetlinkRequest(auditTrustedApp, 0)
+	req.AddRawData(append([]byte(message), 0))
+
+	if err := unix.Sendto(fd, req.Serialize(), 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return fmt.Errorf("send audit message: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type auditHook struct {
+	entries []*logrus.Entry
+}
+
+func (h *auditHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *auditHook) Fire(entry *logrus.Entry) error {
+	if entry.Data["subsystem"] == "audit" {
+		h.entries = append(h.entries, entry)
+	}
+	return nil
+}
+
+func TestAuditRecordMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &Sandbox{id: "sb"}
+
+	r := s.newAuditRecord(AuditMount, "ctr", "/var/lib/kubelet/pods/volume", map[string]string{
+		"type":        "bind",
+		"destination": "/data",
+	}, nil)
+	assert.Equal(AuditSuccess, r.Result)
+	assert.Equal(os.Getpid(), r.Pid)
+	assert.Equal("op=mount sandbox=sb container=ctr object=/var/lib/kubelet/pods/volume destination=/data type=bind res=success", r.message())
+
+	r = s.newAuditRecord(AuditCPUResize, "", "vcpus", nil, errors.New("no more vCPU slots"))
+	assert.Equal(AuditFailure, r.Result)
+	assert.Equal(`op=cpu-resize sandbox=sb object=vcpus error="no more vCPU slots" res=failure`, r.message())
+}
+
+func TestSandboxAudit(t *testing.T) {
+	assert := assert.New(t)
+
+	hook := &auditHook{}
+	logger := logrus.StandardLogger()
+	hooks := logger.ReplaceHooks(logrus.LevelHooks{})
+	defer logger.ReplaceHooks(hooks)
+	logger.AddHook(hook)
+
+	// auditing disabled
+	s := &Sandbox{id: "sb", config: &SandboxConfig{}}
+	s.audit(AuditDeviceHotplug, "", "drive-1", nil, nil)
+	assert.Empty(hook.entries)
+
+	s.config.EnableAudit = true
+	s.audit(AuditDeviceHotplug, "", "drive-1", map[string]string{"type": "block"}, errors.New("no free slot"))
+	assert.Len(hook.entries, 1)
+
+	data := hook.entries[0].Data
+	assert.Equal(AuditDeviceHotplug, data["operation"])
+	assert.Equal("sb", data["sandbox"])
+	assert.Equal("drive-1", data["object"])
+	assert.Equal("block", data["type"])
+	assert.Equal(AuditFailure, data["result"])
+	assert.Equal("no free slot", data["error"])
+	assert.NotContains(data, "container")
+}
+
+func TestSandboxAuditLogLevel(t *testing.T) {
+	assert := assert.New(t)
+
+	hook := &auditHook{}
+	logger := logrus.StandardLogger()
+	hooks := logger.ReplaceHooks(logrus.LevelHooks{})
+	defer logger.ReplaceHooks(hooks)
+	logger.AddHook(hook)
+
+	level := logger.GetLevel()
+	defer logger.SetLevel(level)
+	logger.SetLevel(logrus.WarnLevel)
+
+	// the records are logged whatever the log level
+	s := &Sandbox{id: "sb", config: &SandboxConfig{EnableAudit: true}}
+	s.audit(AuditMemoryResize, "", "memory", nil, nil)
+	assert.Len(hook.entries, 1)
+	assert.Equal(logrus.InfoLevel, hook.entries[0].Level)
+}
@@ -146,28 +146,31 @@ func (c *clhClientApi) VmRemoveDevicePut(ctx context.Context, vmRemoveDevice chc
 // Cloud hypervisor state
 //
 type CloudHypervisorState struct {
-	apiSocket         string
-	PID               int
-	VirtiofsDaemonPid int
-	state             clhState
+	apiSocket                string
+	PID                      int
+	VirtiofsDaemonPid        int
+	VolumesVirtiofsDaemonPid int
+	state                    clhState
 }
 
 func (s *CloudHypervisorState) reset() {
 	s.PID = 0
 	s.VirtiofsDaemonPid = 0
+	s.VolumesVirtiofsDaemonPid = 0
 	s.state = clhNotReady
 }
 
 type cloudHypervisor struct {
-	console        console.Console
-	virtiofsDaemon VirtiofsDaemon
-	APIClient      clhClient
-	ctx            context.Context
-	id             string
-	devicesIds     map[string]string
-	vmconfig       chclient.VmConfig
-	state          CloudHypervisorState
-	config         HypervisorConfig
+	console               console.Console
+	virtiofsDaemon        VirtiofsDaemon
+	volumesVirtiofsDaemon VirtiofsDaemon
+	APIClient             clhClient
+	ctx                   context.Context
+	id                    string
+	devicesIds            map[string]string
+	vmconfig              chclient.VmConfig
+	state                 CloudHypervisorState
+	config                HypervisorConfig
 }
 
 var clhKernelParams = []Param{
@@ -235,6 +238,10 @@ func (clh *cloudHypervisor) createVirtiofsDaemon(sharedPath string) (VirtiofsDae
 		socketPath: virtiofsdSocketPath,
 		extraArgs:  clh.config.VirtioFSExtraArgs,
 		cache:      clh.config.VirtioFSCache,
+		writeback:  clh.config.VirtioFSWriteback,
+		sandbox:    clh.config.VirtioFSSandbox,
+		seccomp:    clh.config.VirtioFSSeccomp,
+		modcaps:    clh.config.VirtioFSModcaps,
 	}, nil
 }
 
@@ -263,6 +270,17 @@ func (clh *cloudHypervisor) setupVirtiofsDaemon(ctx context.Context) error {
 	}
 	clh.state.VirtiofsDaemonPid = pid
 
+	if clh.volumesVirtiofsDaemon != nil {
+		pid, err = startVolumesVirtiofsd(ctx, clh.volumesVirtiofsDaemon)
+		if err != nil {
+			if stopErr := clh.stopVirtiofsDaemon(ctx); stopErr != nil {
+				clh.Logger().WithError(stopErr).Warn("error shutting down VirtiofsDaemon")
+			}
+			return err
+		}
+		clh.state.VolumesVirtiofsDaemonPid = pid
+	}
+
 	return nil
 }
 
@@ -272,6 +290,13 @@ func (clh *cloudHypervisor) stopVirtiofsDaemon(ctx context.Context) (err error)
 		return nil
 	}
 
+	if clh.state.VolumesVirtiofsDaemonPid != 0 && clh.volumesVirtiofsDaemon != nil {
+		if err = clh.volumesVirtiofsDaemon.Stop(ctx); err != nil {
+			return err
+		}
+		clh.state.VolumesVirtiofsDaemonPid = 0
+	}
+
 	if clh.state.VirtiofsDaemonPid == 0 {
 		clh.Logger().Warn("The virtiofsd had stopped")
 		return nil
@@ -371,6 +396,18 @@ func (clh *cloudHypervisor) CreateVM(ctx context.Context, id string, network Net
 		}
 		clh.virtiofsDaemon = virtiofsDaemon
 
+		if clh.state.VolumesVirtiofsDaemonPid > 0 {
+			sockPath, err := clh.virtioFsVolumesSocketPath(clh.id)
+			if err != nil {
+				return err
+			}
+			clh.volumesVirtiofsDaemon = &virtiofsd{
+				PID:        clh.state.VolumesVirtiofsDaemonPid,
+				sourcePath: GetVolumesSharePath(clh.id),
+				socketPath: sockPath,
+			}
+		}
+
 		return nil
 	}
 
@@ -414,7 +451,7 @@ func (clh *cloudHypervisor) CreateVM(ctx context.Context, id string, network Net
 	if clh.config.ConfidentialGuest {
 		params = commonVirtioblkKernelRootParams
 	}
-	params = append(params, clhKernelParams...)
+	params = append(kernelRootParams(params, clh.config.RootfsType), clhKernelParams...)
 
 	// Followed by extra debug parameters if debug enabled in configuration file
 	if clh.config.Debug {
@@ -522,6 +559,14 @@ func (clh *cloudHypervisor) CreateVM(ctx context.Context, id string, network Net
 		return err
 	}
 
+	if clh.supportsSharedFS() && useVolumesVirtiofsd(&clh.config) {
+		sockPath, err := clh.virtioFsVolumesSocketPath(clh.id)
+		if err != nil {
+			return err
+		}
+		clh.volumesVirtiofsDaemon = newVolumesVirtiofsd(&clh.config, clh.id, sockPath)
+	}
+
 	if clh.config.SGXEPCSize > 0 {
 		epcSection := chclient.NewSgxEpcConfig("kata-epc", clh.config.SGXEPCSize)
 		epcSection.Prefault = func(b bool) *bool { return &b }(true)
@@ -650,6 +695,14 @@ func (clh *cloudHypervisor) hotplugAddBlockDevice(drive *config.BlockDrive) erro
 		return fmt.Errorf("cloudHypervisor doesn't support swap")
 	}
 
+	if drive.NVMe {
+		return fmt.Errorf("cloudHypervisor doesn't support NVMe namespaces")
+	}
+
+	if drive.VirtioPmem {
+		return fmt.Errorf("cloudHypervisor doesn't support virtio-pmem hotplug")
+	}
+
 	if clh.config.BlockDeviceDriver != config.VirtioBlock {
 		return fmt.Errorf("incorrect hypervisor configuration on 'block_device_driver':"+
 			" using '%v' but only support '%v'", clh.config.BlockDeviceDriver, config.VirtioBlock)
@@ -671,8 +724,15 @@ func (clh *cloudHypervisor) hotplugAddBlockDevice(drive *config.BlockDrive) erro
 	clhDisk := *chclient.NewDiskConfig(drive.File)
 	clhDisk.Readonly = &drive.ReadOnly
 	clhDisk.VhostUser = func(b bool) *bool { return &b }(false)
+	if drive.NumQueues > 0 {
+		clhDisk.SetNumQueues(int32(drive.NumQueues))
+	}
 
 	diskRateLimiterConfig := clh.getDiskRateLimiterConfig()
+	// The limits of the volume take precedence over the VM wide ones
+	if drive.IOLimits != nil {
+		diskRateLimiterConfig = clh.getRateLimiterConfig(int64(drive.IOLimits.BPS), 0, int64(drive.IOLimits.IOPS), 0)
+	}
 	if diskRateLimiterConfig != nil {
 		clhDisk.SetRateLimiterConfig(*diskRateLimiterConfig)
 	}
@@ -844,6 +904,10 @@ func (clh *cloudHypervisor) ResizeMemory(ctx context.Context, reqMemMB uint32, m
 	return uint32(newMem.ToMiB()), MemoryDevice{SizeMB: int(hotplugSize.ToMiB())}, nil
 }
 
+func (clh *cloudHypervisor) ResizeBlockDevice(ctx context.Context, drive *config.BlockDrive, size uint64) error {
+	return fmt.Errorf("cloud hypervisor does not support resizing block devices")
+}
+
 func (clh *cloudHypervisor) ResizeVCPUs(ctx context.Context, reqVCPUs uint32) (currentVCPUs uint32, newVCPUs uint32, err error) {
 	cl := clh.client()
 
@@ -896,7 +960,7 @@ func (clh *cloudHypervisor) PauseVM(ctx context.Context) error {
 	return nil
 }
 
-func (clh *cloudHypervisor) SaveVM() error {
+func (clh *cloudHypervisor) SaveVM(statePath string) error {
 	clh.Logger().WithField("function", "saveSandboxC").Info("Save Sandbox")
 	return nil
 }
@@ -926,6 +990,7 @@ func (clh *cloudHypervisor) Save() (s hv.HypervisorState) {
 	s.Pid = clh.state.PID
 	s.Type = string(ClhHypervisor)
 	s.VirtiofsDaemonPid = clh.state.VirtiofsDaemonPid
+	s.VolumesVirtiofsDaemonPid = clh.state.VolumesVirtiofsDaemonPid
 	s.APISocket = clh.state.apiSocket
 	return
 }
@@ -933,6 +998,7 @@ func (clh *cloudHypervisor) Save() (s hv.HypervisorState) {
 func (clh *cloudHypervisor) Load(s hv.HypervisorState) {
 	clh.state.PID = s.Pid
 	clh.state.VirtiofsDaemonPid = s.VirtiofsDaemonPid
+	clh.state.VolumesVirtiofsDaemonPid = s.VolumesVirtiofsDaemonPid
 	clh.state.apiSocket = s.APISocket
 }
 
@@ -959,6 +1025,33 @@ func (clh *cloudHypervisor) GetVirtioFsPid() *int {
 	return &clh.state.VirtiofsDaemonPid
 }
 
+func (clh *cloudHypervisor) adoptVirtiofsDaemons(ctx context.Context) error {
+	if clh.state.VirtiofsDaemonPid != 0 && clh.virtiofsDaemon != nil {
+		if err := clh.virtiofsDaemon.Adopt(ctx, clh.state.VirtiofsDaemonPid, func() {
+			clh.StopVM(ctx, false)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if clh.state.VolumesVirtiofsDaemonPid != 0 && clh.volumesVirtiofsDaemon != nil {
+		return clh.volumesVirtiofsDaemon.Adopt(ctx, clh.state.VolumesVirtiofsDaemonPid, volumesVirtiofsdQuit)
+	}
+
+	return nil
+}
+
+func (clh *cloudHypervisor) virtiofsdPids() []int {
+	var pids []int
+	if clh.state.VirtiofsDaemonPid != 0 {
+		pids = append(pids, clh.state.VirtiofsDaemonPid)
+	}
+	if clh.state.VolumesVirtiofsDaemonPid != 0 {
+		pids = append(pids, clh.state.VolumesVirtiofsDaemonPid)
+	}
+	return pids
+}
+
 func (clh *cloudHypervisor) AddDevice(ctx context.Context, devInfo interface{}, devType DeviceType) error {
 	span, _ := katatrace.Trace(ctx, clh.Logger(), "AddDevice", clhTracingTags, map[string]string{"sandbox_id": clh.id})
 	defer span.End()
@@ -966,6 +1059,10 @@ func (clh *cloudHypervisor) AddDevice(ctx context.Context, devInfo interface{},
 	var err error
 
 	switch v := devInfo.(type) {
+	case *VdpaEndpoint:
+		clh.addVdpa(v)
+	case *PasstEndpoint:
+		return fmt.Errorf("passt network endpoints are not supported by cloud-hypervisor")
 	case Endpoint:
 		if err := clh.addNet(v); err != nil {
 			return err
@@ -1003,10 +1100,13 @@ func (clh *cloudHypervisor) Capabilities(ctx context.Context) types.Capabilities
 
 	clh.Logger().WithField("function", "Capabilities").Info("get Capabilities")
 	var caps types.Capabilities
-	if !clh.config.ConfidentialGuest {
+	if !clh.config.ConfidentialGuest && clh.config.SharedFS != config.NoSharedFS {
 		caps.SetFsSharingSupport()
 	}
 	caps.SetBlockDeviceHotplugSupport()
+	if clh.config.NetworkQueues > 1 {
+		caps.SetMultiQueueSupport()
+	}
 	return caps
 }
 
@@ -1074,6 +1174,10 @@ func (clh *cloudHypervisor) virtioFsSocketPath(id string) (string, error) {
 	return utils.BuildSocketPath(clh.config.VMStorePath, id, virtioFsSocket)
 }
 
+func (clh *cloudHypervisor) virtioFsVolumesSocketPath(id string) (string, error) {
+	return utils.BuildSocketPath(clh.config.VMStorePath, id, vhostFSVolumesSocket)
+}
+
 func (clh *cloudHypervisor) vsockSocketPath(id string) (string, error) {
 	return utils.BuildSocketPath(clh.config.VMStorePath, id, clhSocket)
 }
@@ -1391,6 +1495,21 @@ func (clh *cloudHypervisor) addNet(e Endpoint) error {
 		net.SetRateLimiterConfig(*netRateLimiterConfig)
 	}
 
+	if clh.config.NetworkQueues > 1 {
+		// cloud-hypervisor opens the TAP queues by itself, one RX and
+		// one TX virtqueue per queue pair. Release the queues opened by
+		// the runtime so that no traffic is steered to unused queues.
+		net.SetNumQueues(int32(2 * clh.config.NetQueues()))
+		for _, f := range netPair.VMFds {
+			f.Close()
+		}
+		netPair.VMFds = nil
+	}
+
+	if clh.config.EnableNetRSS {
+		clh.Logger().Warn("RSS is not supported by cloud-hypervisor, ignoring")
+	}
+
 	if clh.vmconfig.Net != nil {
 		*clh.vmconfig.Net = append(*clh.vmconfig.Net, *net)
 	} else {
@@ -1400,13 +1519,35 @@ func (clh *cloudHypervisor) addNet(e Endpoint) error {
 	return nil
 }
 
+func (clh *cloudHypervisor) addVdpa(e *VdpaEndpoint) {
+	clh.Logger().WithFields(log.Fields{
+		"vhost-dev": e.VhostDevPath,
+		"iface":     e.Name(),
+	}).Info("Adding vDPA device")
+
+	vdpa := chclient.NewVdpaConfigWithDefaults()
+	vdpa.SetPath(e.VhostDevPath)
+
+	if clh.vmconfig.Vdpa != nil {
+		*clh.vmconfig.Vdpa = append(*clh.vmconfig.Vdpa, *vdpa)
+	} else {
+		clh.vmconfig.Vdpa = &[]chclient.VdpaConfig{*vdpa}
+	}
+}
+
 // Add shared Volume using virtiofs
 func (clh *cloudHypervisor) addVolume(volume types.Volume) error {
 	if clh.config.SharedFS != config.VirtioFS && clh.config.SharedFS != config.VirtioFSNydus {
 		return fmt.Errorf("shared fs method not supported %s", clh.config.SharedFS)
 	}
 
-	vfsdSockPath, err := clh.virtioFsSocketPath(clh.id)
+	var vfsdSockPath string
+	var err error
+	if volume.MountTag == mountGuestVolumesTag {
+		vfsdSockPath, err = clh.virtioFsVolumesSocketPath(clh.id)
+	} else {
+		vfsdSockPath, err = clh.virtioFsSocketPath(clh.id)
+	}
 	if err != nil {
 		return err
 	}
@@ -1420,7 +1561,11 @@ func (clh *cloudHypervisor) addVolume(volume types.Volume) error {
 	queueSize := int32(1024)
 
 	fs := chclient.NewFsConfig(volume.MountTag, vfsdSockPath, numQueues, queueSize, dax, int64(clh.config.VirtioFSCacheSize<<20))
-	clh.vmconfig.Fs = &[]chclient.FsConfig{*fs}
+	if clh.vmconfig.Fs != nil {
+		*clh.vmconfig.Fs = append(*clh.vmconfig.Fs, *fs)
+	} else {
+		clh.vmconfig.Fs = &[]chclient.FsConfig{*fs}
+	}
 
 	clh.Logger().Debug("Adding share volume to hypervisor: ", volume.MountTag)
 	return nil
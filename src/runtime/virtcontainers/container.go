@@ -7,6 +7,7 @@ package virtcontainers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -331,6 +332,8 @@ type Container struct {
 
 	mounts []Mount
 
+	watchableMounts []*watchableMount
+
 	devices []ContainerDevice
 
 	state types.ContainerState
@@ -450,6 +453,13 @@ func (c *Container) mountSharedDirMounts(ctx context.Context, sharedDirMounts, i
 				return storages, err
 			}
 			devicesToDetach = append(devicesToDetach, m.BlockDeviceID)
+
+			// Track the size of the device, to grow the volume when the device grows
+			if _, size, err := c.sandbox.volumeDrive(m.BlockDeviceID); err != nil {
+				c.Logger().WithError(err).WithField("mount-source", m.Source).Warn("failed to get volume device size")
+			} else {
+				c.mounts[idx].BlockDeviceSize = size
+			}
 			continue
 		}
 
@@ -472,7 +482,15 @@ func (c *Container) mountSharedDirMounts(ctx context.Context, sharedDirMounts, i
 			continue
 		}
 
+		// Only the mounts shared with the guest are made read-only by a
+		// read-only shared file system, not the root filesystems.
+		if c.sandbox.config.HypervisorConfig.SharedFSReadOnly {
+			c.mounts[idx].ReadOnly = true
+			m.ReadOnly = true
+		}
+
 		sharedFile, err := c.sandbox.fsShare.ShareFile(ctx, c, &c.mounts[idx])
+		c.auditMount(AuditMount, m, err)
 		if err != nil {
 			return storages, err
 		}
@@ -494,11 +512,11 @@ func (c *Container) mountSharedDirMounts(ctx context.Context, sharedDirMounts, i
 		// mounts that are commonly 'watched'. "watchable" mounts include:
 		//  - Kubernetes configmap
 		//  - Kubernetes secret
-		// If we identify one of these, we'll need to carry out polling in the guest in order to present the
+		// If we identify one of these, we'll need to mirror it in the guest in order to present the
 		// container with a mount that supports inotify. To do this, we create a Storage object for
-		// the "watchable-bind" driver. This will have the agent create a new mount that is watchable,
-		// who's effective source is the original mount (the agent will poll the original mount for changes and
-		// manually update the path that is mounted into the container).
+		// the "watchable-sync" driver, and sync the content of the original mount to the agent, which
+		// writes it to the new mount. The changes of the original mount are then watched on the host
+		// and synced to the agent, which updates the new mount.
 		// Based on this, let's make sure we update the sharedDirMount structure with the new watchable-mount as
 		// the source (this is what is utilized to update the OCI spec).
 		caps := c.sandbox.hypervisor.Capabilities(ctx)
@@ -512,8 +530,14 @@ func (c *Container) mountSharedDirMounts(ctx context.Context, sharedDirMounts, i
 
 			watchableGuestMount := filepath.Join(kataGuestSharedDir(), "watchable", filepath.Base(sharedFile.guestPath))
 
+			watchable := newWatchableMount(c.sandbox.agent, m.Source, watchableGuestMount)
+			if err = watchable.start(c.sandbox.ctx); err != nil {
+				return storages, fmt.Errorf("unable to sync watchable mount %s: %v", m.Source, err)
+			}
+			c.watchableMounts = append(c.watchableMounts, watchable)
+
 			storage := &grpc.Storage{
-				Driver:     kataWatchableBindDevType,
+				Driver:     kataWatchableSyncDevType,
 				Source:     sharedFile.guestPath,
 				Fstype:     "bind",
 				MountPoint: watchableGuestMount,
@@ -532,6 +556,15 @@ func (c *Container) mountSharedDirMounts(ctx context.Context, sharedDirMounts, i
 	return storages, nil
 }
 
+// auditMount audits the mount of a host path of the container in the shared
+// directory of the sandbox, or its unmount.
+func (c *Container) auditMount(operation string, m Mount, err error) {
+	c.sandbox.audit(operation, c.id, m.Source, map[string]string{
+		"destination": m.Destination,
+		"type":        m.Type,
+	}, err)
+}
+
 func (c *Container) unmountHostMounts(ctx context.Context) error {
 	span, ctx := katatrace.Trace(ctx, c.Logger(), "unmountHostMounts", containerTracingTags, map[string]string{"container_id": c.id})
 	defer span.End()
@@ -545,7 +578,9 @@ func (c *Container) unmountHostMounts(ctx context.Context) error {
 			span.End()
 		}()
 
-		if err = c.sandbox.fsShare.UnshareFile(ctx, c, &m); err != nil {
+		err = c.sandbox.fsShare.UnshareFile(ctx, c, &m)
+		c.auditMount(AuditUnmount, m, err)
+		if err != nil {
 			c.Logger().WithFields(logrus.Fields{
 				"host-path": m.HostPath,
 				"error":     err,
@@ -556,6 +591,11 @@ func (c *Container) unmountHostMounts(ctx context.Context) error {
 		return nil
 	}
 
+	for _, watchable := range c.watchableMounts {
+		watchable.stop()
+	}
+	c.watchableMounts = nil
+
 	for _, m := range c.mounts {
 		if m.HostPath != "" {
 			if err := unmountFunc(m); err != nil {
@@ -598,6 +638,16 @@ func (c *Container) createBlockDevices(ctx context.Context) error {
 		return nil
 	}
 
+	ioLimits, err := volumeIOLimits(c.config.Annotations)
+	if err != nil {
+		return err
+	}
+
+	imageVolumes, err := imageVolumes(c.config.Annotations)
+	if err != nil {
+		return err
+	}
+
 	// iterate all mounts and create block device if it's block based.
 	for i := range c.mounts {
 		if len(c.mounts[i].BlockDeviceID) > 0 {
@@ -607,6 +657,15 @@ func (c *Container) createBlockDevices(ctx context.Context) error {
 			continue
 		}
 
+		// A guest emptyDir may be backed by a virtio-pmem image rather
+		// than created on the guest filesystem.
+		if c.usePmemEmptyDir(c.mounts[i]) {
+			if err := c.createPmemEmptyDir(&c.mounts[i]); err != nil {
+				return err
+			}
+			continue
+		}
+
 		if c.mounts[i].Type != "bind" {
 			// We only handle for bind-mounts
 			continue
@@ -654,23 +713,95 @@ func (c *Container) createBlockDevices(ctx context.Context) error {
 						continue
 					}
 					c.mounts[i].FSGroupChangePolicy = volume.FSGroupChangePolicy(value)
+				case volume.EncryptionMetadataKey:
+					c.mounts[i].Encryption = value
+				case volume.EncryptionKeyFileMetadataKey:
+					c.mounts[i].EncryptionKeyFile = value
 				default:
 					c.Logger().Warnf("Ignoring unsupported direct-assignd volume metadata key: %s, value: %s", key, value)
 				}
 			}
 		}
 
+		// A NVMe volume is a namespace the hypervisor accesses through its
+		// userspace NVMe driver, it has no device node on the host.
+		isNVMe := mntInfo != nil && mntInfo.VolumeType == volume.NVMeVolumeType
+
 		var stat unix.Stat_t
-		if err := unix.Stat(c.mounts[i].Source, &stat); err != nil {
-			return fmt.Errorf("stat %q failed: %v", c.mounts[i].Source, err)
+		if !isNVMe {
+			if err := unix.Stat(c.mounts[i].Source, &stat); err != nil {
+				return fmt.Errorf("stat %q failed: %v", c.mounts[i].Source, err)
+			}
 		}
 
 		var di *config.DeviceInfo
 		var err error
 
-		// Check if mount is a block device file. If it is, the block device will be attached to the host
-		// instead of passing this as a shared mount.
-		if stat.Mode&unix.S_IFBLK == unix.S_IFBLK {
+		fsType, isImageVolume := imageVolumes[c.mounts[i].Destination]
+
+		if isNVMe {
+			if _, _, err := config.ParseNVMeURI(c.mounts[i].Source); err != nil {
+				return err
+			}
+			di = &config.DeviceInfo{
+				HostPath:      c.mounts[i].Source,
+				ContainerPath: c.mounts[i].Destination,
+				DevType:       "b",
+				ReadOnly:      c.mounts[i].ReadOnly,
+				NVMe:          true,
+			}
+			// A SPDK volume is attached as a vhost-user-blk device connected to
+			// the socket of its target.
+		} else if mntInfo != nil && mntInfo.VolumeType == volume.SPDKVolumeType {
+			if stat.Mode&unix.S_IFMT != unix.S_IFSOCK {
+				return fmt.Errorf("%s volume device %q is not a vhost-user socket", volume.SPDKVolumeType, c.mounts[i].Source)
+			}
+			di = &config.DeviceInfo{
+				HostPath:        c.mounts[i].Source,
+				ContainerPath:   c.mounts[i].Destination,
+				DevType:         "b",
+				ReadOnly:        c.mounts[i].ReadOnly,
+				VhostUserSocket: true,
+			}
+			// A pmem volume maps its backing file into the guest as a
+			// virtio-pmem device, for the guest to access it with DAX.
+		} else if mntInfo != nil && mntInfo.VolumeType == volume.PmemVolumeType {
+			if stat.Mode&unix.S_IFMT != unix.S_IFREG {
+				return fmt.Errorf("%s volume device %q is not a regular file", volume.PmemVolumeType, c.mounts[i].Source)
+			}
+			di = &config.DeviceInfo{
+				HostPath:      c.mounts[i].Source,
+				ContainerPath: c.mounts[i].Destination,
+				DevType:       "b",
+				ReadOnly:      c.mounts[i].ReadOnly,
+				VirtioPmem:    true,
+			}
+			// A read-only direct volume backed by an image file, e.g. a common
+			// dataset, is attached as a block device which other sandboxes can
+			// attach at the same time.
+		} else if mntInfo != nil && c.mounts[i].ReadOnly && stat.Mode&unix.S_IFMT == unix.S_IFREG {
+			di = &config.DeviceInfo{
+				HostPath:      c.mounts[i].Source,
+				ContainerPath: c.mounts[i].Destination,
+				DevType:       "b",
+				ReadOnly:      true,
+				ImageFile:     true,
+			}
+			// A writable direct volume, or a volume given by the image
+			// volumes annotation, backed by an image file is attached
+			// through a loop device the device manager sets up.
+		} else if (mntInfo != nil || isImageVolume) && stat.Mode&unix.S_IFMT == unix.S_IFREG {
+			di = &config.DeviceInfo{
+				HostPath:      c.mounts[i].Source,
+				ContainerPath: c.mounts[i].Destination,
+				DevType:       "b",
+				ReadOnly:      c.mounts[i].ReadOnly,
+				LoopFile:      true,
+				Ublk:          c.sandbox.ublkConfig(),
+			}
+			// Check if mount is a block device file. If it is, the block device will be attached to the host
+			// instead of passing this as a shared mount.
+		} else if stat.Mode&unix.S_IFBLK == unix.S_IFBLK {
 			di = &config.DeviceInfo{
 				HostPath:      c.mounts[i].Source,
 				ContainerPath: c.mounts[i].Destination,
@@ -687,6 +818,10 @@ func (c *Container) createBlockDevices(ctx context.Context) error {
 		}
 
 		if err == nil && di != nil {
+			if limits, ok := ioLimits[c.mounts[i].Destination]; ok {
+				di.IOLimits = &limits
+			}
+
 			b, err := c.sandbox.devManager.NewDevice(*di)
 			if err != nil {
 				// Do not return an error, try to create
@@ -698,6 +833,16 @@ func (c *Container) createBlockDevices(ctx context.Context) error {
 			}
 
 			c.mounts[i].BlockDeviceID = b.DeviceID()
+
+			// The image is no longer bind mounted, but its filesystem
+			// mounted from the block device.
+			if isImageVolume && mntInfo == nil {
+				c.mounts[i].Type = fsType
+				c.mounts[i].Options = nil
+				if c.mounts[i].ReadOnly {
+					c.mounts[i].Options = []string{"ro"}
+				}
+			}
 		}
 	}
 
@@ -710,6 +855,44 @@ func (c *Container) initConfigResourcesMemory() {
 	ociSpec.Linux.Resources.Memory = c.config.Resources.Memory
 }
 
+// volumeIOLimits returns the I/O limits of the block device volumes given by
+// the container annotations, indexed by the destination of the volumes.
+func volumeIOLimits(annotations map[string]string) (map[string]config.BlockIOLimits, error) {
+	value, ok := annotations[vcAnnotations.ContainerResourcesVolumeIOLimits]
+	if !ok {
+		return nil, nil
+	}
+
+	var limits map[string]config.BlockIOLimits
+	if err := json.Unmarshal([]byte(value), &limits); err != nil {
+		return nil, fmt.Errorf("Invalid container configuration Annotations %s %v", vcAnnotations.ContainerResourcesVolumeIOLimits, err)
+	}
+
+	return limits, nil
+}
+
+// imageVolumes returns the filesystem types of the image file volumes given
+// by the container annotations, indexed by the destination of the volumes.
+func imageVolumes(annotations map[string]string) (map[string]string, error) {
+	value, ok := annotations[vcAnnotations.ContainerResourcesImageVolumes]
+	if !ok {
+		return nil, nil
+	}
+
+	var volumes map[string]string
+	if err := json.Unmarshal([]byte(value), &volumes); err != nil {
+		return nil, fmt.Errorf("Invalid container configuration Annotations %s %v", vcAnnotations.ContainerResourcesImageVolumes, err)
+	}
+
+	for dest, fsType := range volumes {
+		if fsType == "" {
+			return nil, fmt.Errorf("Invalid container configuration Annotations %s: no filesystem type for %s", vcAnnotations.ContainerResourcesImageVolumes, dest)
+		}
+	}
+
+	return volumes, nil
+}
+
 // newContainer creates a Container structure from a sandbox and a container configuration.
 func newContainer(ctx context.Context, sandbox *Sandbox, contConfig *ContainerConfig) (*Container, error) {
 	span, ctx := katatrace.Trace(ctx, nil, "newContainer", containerTracingTags, map[string]string{"container_id": contConfig.ID, "sandbox_id": sandbox.id})
@@ -785,6 +968,11 @@ func newContainer(ctx context.Context, sandbox *Sandbox, contConfig *ContainerCo
 }
 
 func (c *Container) createMounts(ctx context.Context) error {
+	// Network volumes are mounted by the agent
+	if err := c.createNetworkVolumes(); err != nil {
+		return err
+	}
+
 	// Create block devices for newly created container
 	return c.createBlockDevices(ctx)
 }
@@ -862,17 +1050,47 @@ func (c *Container) create(ctx context.Context) (err error) {
 		}
 	}()
 
+	if err = c.checkGuestHooks(); err != nil {
+		return
+	}
+
 	if c.checkBlockDeviceSupport(ctx) && c.rootFs.Type != NydusRootFSType {
 		// If the rootfs is backed by a block device, go ahead and hotplug it to the guest
 		if err = c.hotplugDrive(ctx); err != nil {
+			err = types.NewError(types.ErrorInternal, types.SubsystemStorage, false, err)
 			return
 		}
+
+		// Without a shared filesystem, the rootfs overlay is built in the
+		// guest from its layers.
+		if c.state.BlockDeviceID == "" && c.useBlockLayers() {
+			if err = c.plugBlockLayers(ctx); err != nil {
+				err = types.NewError(types.ErrorInternal, types.SubsystemStorage, false, err)
+				return
+			}
+		}
+
+		// Large rootfs perform poorly on the shared filesystem, attach the
+		// block devices of their layers instead.
+		if c.state.BlockDeviceID == "" && c.state.ScratchDeviceID == "" {
+			var layers []*erofsLayer
+			if layers, err = c.useErofsLayers(); err != nil {
+				return
+			}
+			if len(layers) > 0 {
+				if err = c.plugErofsLayers(ctx, layers); err != nil {
+					err = types.NewError(types.ErrorInternal, types.SubsystemStorage, false, err)
+					return
+				}
+			}
+		}
 	}
 
 	c.Logger().WithFields(logrus.Fields{
 		"devices": c.devices,
 	}).Info("Attach devices")
 	if err = c.attachDevices(ctx); err != nil {
+		err = types.NewError(types.ErrorInternal, types.SubsystemStorage, false, err)
 		return
 	}
 
@@ -899,6 +1117,14 @@ func (c *Container) delete(ctx context.Context) error {
 		return fmt.Errorf("Container not ready or stopped, impossible to delete")
 	}
 
+	// The rootfs drive is unplugged when the container stops, unless this
+	// failed while the container was forcefully stopped.
+	if c.state.State == types.StateStopped {
+		if err := c.removeDrive(ctx); err != nil {
+			return err
+		}
+	}
+
 	// Remove the container from sandbox structure
 	if err := c.sandbox.removeContainer(c.id); err != nil {
 		return err
@@ -951,6 +1177,11 @@ func (c *Container) start(ctx context.Context) error {
 		return err
 	}
 
+	if err := c.runGuestHooks(ctx, false); err != nil {
+		return fmt.Errorf("Failed to run the prestart guest hooks: %v", err)
+	}
+
+	start := time.Now()
 	if err := c.sandbox.agent.startContainer(ctx, c.sandbox, c); err != nil {
 		c.Logger().WithError(err).Error("Failed to start container")
 
@@ -959,6 +1190,7 @@ func (c *Container) start(ctx context.Context) error {
 		}
 		return err
 	}
+	observeBootPhase(bootPhaseContainerStart, start)
 
 	return c.setContainerState(types.StateRunning)
 }
@@ -1006,6 +1238,11 @@ func (c *Container) stop(ctx context.Context, force bool) error {
 		return err
 	}
 
+	// like the OCI poststop hooks, their failures are only logged
+	if err := c.runGuestHooks(ctx, true); err != nil {
+		c.Logger().WithError(err).Warn("Failed to run the poststop guest hooks")
+	}
+
 	if err := c.unmountHostMounts(ctx); err != nil && !force {
 		return err
 	}
@@ -1167,6 +1404,10 @@ func (c *Container) update(ctx context.Context, resources specs.LinuxResources)
 		resources.CPU.Cpus = ""
 	}
 
+	if err := c.resizeDrive(ctx); err != nil {
+		c.Logger().WithError(err).Warn("Failed to resize rootfs block device")
+	}
+
 	return c.sandbox.agent.updateContainer(ctx, c.sandbox, *c, resources)
 }
 
@@ -1296,6 +1537,12 @@ func (c *Container) plugDevice(ctx context.Context, devicePath string) error {
 		if err := c.sandbox.devManager.AttachDevice(ctx, b.DeviceID(), c.sandbox); err != nil {
 			return err
 		}
+
+		size, err := utils.GetBlockDeviceSize(devicePath)
+		if err != nil {
+			return err
+		}
+		c.state.BlockDeviceSize = size
 	}
 	return nil
 }
@@ -1305,26 +1552,164 @@ func (c *Container) isDriveUsed() bool {
 	return !(c.state.Fstype == "")
 }
 
+// removeBlockDevice detaches a rootfs block device from the VM and removes it.
+func (c *Container) removeBlockDevice(ctx context.Context, devID string) error {
+	err := c.sandbox.devManager.DetachDevice(ctx, devID, c.sandbox)
+	if err != nil && err != manager.ErrDeviceNotAttached {
+		return err
+	}
+
+	if err = c.sandbox.devManager.RemoveDevice(devID); err != nil {
+		c.Logger().WithFields(logrus.Fields{
+			"container": c.id,
+			"device-id": devID,
+		}).WithError(err).Error("remove device failed")
+
+		// ignore the device not exist error
+		if err != manager.ErrDeviceNotExist {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (c *Container) removeDrive(ctx context.Context) (err error) {
-	if c.isDriveUsed() {
+	if len(c.state.LayerDeviceIDs) > 0 || c.state.ScratchDeviceID != "" {
+		c.Logger().Info("unplugging rootfs layers")
+
+		if err = c.unplugBlockLayers(ctx); err != nil {
+			return err
+		}
+	}
+
+	if c.isDriveUsed() && c.state.BlockDeviceID != "" {
 		c.Logger().Info("unplugging block device")
 
-		devID := c.state.BlockDeviceID
-		err := c.sandbox.devManager.DetachDevice(ctx, devID, c.sandbox)
-		if err != nil && err != manager.ErrDeviceNotAttached {
+		if err = c.removeBlockDevice(ctx, c.state.BlockDeviceID); err != nil {
 			return err
 		}
 
-		if err = c.sandbox.devManager.RemoveDevice(devID); err != nil {
-			c.Logger().WithFields(logrus.Fields{
-				"container": c.id,
-				"device-id": devID,
-			}).WithError(err).Error("remove device failed")
+		c.state.BlockDeviceID = ""
+		c.state.BlockDeviceSize = 0
+	}
 
-			// ignore the device not exist error
-			if err != manager.ErrDeviceNotExist {
-				return err
-			}
+	return nil
+}
+
+// grownDrive returns the rootfs block device of the container if it grew on
+// the host. The devmapper snapshotter may grow the thin device of a container
+// snapshot while the container runs.
+func (c *Container) grownDrive() (*GrownVolume, error) {
+	if !c.isDriveUsed() || c.state.BlockDeviceID == "" {
+		return nil, nil
+	}
+
+	device := c.sandbox.devManager.GetDeviceByID(c.state.BlockDeviceID)
+	if device == nil {
+		return nil, fmt.Errorf("failed to find device by id %q", c.state.BlockDeviceID)
+	}
+
+	drive, ok := device.GetDeviceInfo().(*config.BlockDrive)
+	if !ok || drive == nil {
+		return nil, fmt.Errorf("malformed block drive")
+	}
+
+	size, err := utils.GetBlockDeviceSize(drive.File)
+	if err != nil {
+		return nil, err
+	}
+
+	// Shrinking a mounted filesystem is not supported
+	if size <= c.state.BlockDeviceSize {
+		return nil, nil
+	}
+
+	c.Logger().WithFields(logrus.Fields{
+		"device-path": drive.File,
+		"old-size":    c.state.BlockDeviceSize,
+		"new-size":    size,
+	}).Info("Rootfs block device grew")
+
+	return &GrownVolume{
+		ContainerID: c.id,
+		Size:        size,
+		drive:       drive,
+		guestPath:   filepath.Join(kataGuestSharedDir(), c.id),
+	}, nil
+}
+
+// resizeDrive propagates the growth of the rootfs block device to the guest,
+// and grows the rootfs filesystem accordingly.
+func (c *Container) resizeDrive(ctx context.Context) error {
+	grown, err := c.grownDrive()
+	if err != nil || grown == nil {
+		return err
+	}
+
+	if err := c.sandbox.ResizeVolume(ctx, *grown); err != nil {
+		return err
+	}
+
+	return c.volumeResized(*grown)
+}
+
+// grownVolumes returns the block devices backing the volumes of the container
+// which grew on the host. The device of a volume may be grown by a CSI volume
+// expansion while the container runs. A volume failing to be checked is
+// skipped, not to prevent the others from being resized.
+func (c *Container) grownVolumes() []GrownVolume {
+	var grown []GrownVolume
+
+	for _, m := range c.mounts {
+		if m.BlockDeviceID == "" || m.GuestDeviceMount == "" || m.ReadOnly {
+			continue
+		}
+
+		drive, size, err := c.sandbox.volumeDrive(m.BlockDeviceID)
+		if err != nil {
+			c.Logger().WithError(err).WithField("destination", m.Destination).Warn("failed to check volume block device size")
+			continue
+		}
+
+		// Shrinking a mounted filesystem is not supported
+		if drive == nil || size <= m.BlockDeviceSize {
+			continue
+		}
+
+		c.Logger().WithFields(logrus.Fields{
+			"device-path": drive.File,
+			"destination": m.Destination,
+			"old-size":    m.BlockDeviceSize,
+			"new-size":    size,
+		}).Info("Volume block device grew")
+
+		grown = append(grown, GrownVolume{
+			ContainerID: c.id,
+			Destination: m.Destination,
+			Size:        size,
+			drive:       drive,
+			guestPath:   m.GuestDeviceMount,
+		})
+	}
+
+	return grown
+}
+
+// volumeResized records the new size of a grown volume of the container,
+// once the guest was resized.
+func (c *Container) volumeResized(v GrownVolume) error {
+	if v.Destination == "" {
+		if v.Size <= c.state.BlockDeviceSize {
+			return nil
+		}
+		c.state.BlockDeviceSize = v.Size
+		return c.sandbox.Save()
+	}
+
+	for i, m := range c.mounts {
+		if m.Destination == v.Destination && v.Size > m.BlockDeviceSize {
+			c.mounts[i].BlockDeviceSize = v.Size
 		}
 	}
 
@@ -20,6 +20,7 @@ import (
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/drivers"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/manager"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/persist"
+	vcAnnotations "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/annotations"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -44,6 +45,53 @@ func TestGetAnnotations(t *testing.T) {
 	}
 }
 
+func TestVolumeIOLimits(t *testing.T) {
+	assert := assert.New(t)
+
+	limits, err := volumeIOLimits(nil)
+	assert.NoError(err)
+	assert.Empty(limits)
+
+	limits, err = volumeIOLimits(map[string]string{
+		vcAnnotations.ContainerResourcesVolumeIOLimits: `{"/data": {"iops": 1000, "bps": 104857600}, "/logs": {"bps": 1048576}}`,
+	})
+	assert.NoError(err)
+	assert.Equal(map[string]config.BlockIOLimits{
+		"/data": {IOPS: 1000, BPS: 100 << 20},
+		"/logs": {BPS: 1 << 20},
+	}, limits)
+
+	_, err = volumeIOLimits(map[string]string{
+		vcAnnotations.ContainerResourcesVolumeIOLimits: `{"/data": {"iops": -1}}`,
+	})
+	assert.Error(err)
+}
+
+func TestImageVolumes(t *testing.T) {
+	assert := assert.New(t)
+
+	volumes, err := imageVolumes(nil)
+	assert.NoError(err)
+	assert.Empty(volumes)
+
+	volumes, err = imageVolumes(map[string]string{
+		vcAnnotations.ContainerResourcesImageVolumes: `{"/data": "ext4", "/models": "erofs"}`,
+	})
+	assert.NoError(err)
+	assert.Equal(map[string]string{"/data": "ext4", "/models": "erofs"}, volumes)
+
+	// The filesystem type is required
+	_, err = imageVolumes(map[string]string{
+		vcAnnotations.ContainerResourcesImageVolumes: `{"/data": ""}`,
+	})
+	assert.Error(err)
+
+	_, err = imageVolumes(map[string]string{
+		vcAnnotations.ContainerResourcesImageVolumes: `["/data"]`,
+	})
+	assert.Error(err)
+}
+
 func TestContainerSystemMountsInfo(t *testing.T) {
 	mounts := []Mount{
 		{
@@ -122,6 +170,138 @@ func TestContainerRemoveDrive(t *testing.T) {
 	container.state.BlockDeviceID = device.DeviceID()
 	err = container.removeDrive(sandbox.ctx)
 	assert.Nil(t, err, "remove drive should succeed")
+	assert.Empty(t, container.state.BlockDeviceID)
+
+	// The drive can be removed again when the container is deleted
+	err = container.removeDrive(sandbox.ctx)
+	assert.Nil(t, err, "remove drive should succeed")
+}
+
+func TestContainerResizeDrive(t *testing.T) {
+	assert := assert.New(t)
+
+	sandbox := &Sandbox{
+		ctx:        context.Background(),
+		id:         testSandboxID,
+		devManager: manager.NewDeviceManager(config.VirtioSCSI, false, "", nil),
+		hypervisor: &mockHypervisor{},
+		agent:      &mockAgent{},
+		network:    &LinuxNetwork{},
+		config:     &SandboxConfig{},
+		state:      types.SandboxState{BlockIndexMap: make(map[int]struct{})},
+	}
+
+	var err error
+	sandbox.store, err = persist.GetDriver()
+	assert.NoError(err)
+	defer sandbox.store.Destroy(sandbox.id)
+
+	container := Container{
+		sandbox: sandbox,
+		id:      "testContainer",
+	}
+
+	// No rootfs drive
+	grown, err := container.grownDrive()
+	assert.NoError(err)
+	assert.Nil(grown)
+
+	path := filepath.Join(t.TempDir(), "snapshot.img")
+	assert.NoError(os.WriteFile(path, make([]byte, 4096), 0600))
+
+	device, err := sandbox.devManager.NewDevice(config.DeviceInfo{
+		HostPath:      path,
+		ContainerPath: path,
+		DevType:       "b",
+	})
+	assert.NoError(err)
+	assert.NoError(sandbox.devManager.AttachDevice(sandbox.ctx, device.DeviceID(), sandbox))
+
+	container.state.Fstype = "ext4"
+	container.state.BlockDeviceID = device.DeviceID()
+	container.state.BlockDeviceSize = 4096
+
+	// Unchanged size
+	grown, err = container.grownDrive()
+	assert.NoError(err)
+	assert.Nil(grown)
+
+	assert.NoError(os.Truncate(path, 8192))
+	grown, err = container.grownDrive()
+	assert.NoError(err)
+	assert.NotNil(grown)
+	assert.Equal("", grown.Destination)
+	assert.Equal(uint64(8192), grown.Size)
+	assert.Equal(uint64(4096), container.state.BlockDeviceSize)
+
+	assert.NoError(sandbox.ResizeVolume(sandbox.ctx, *grown))
+	assert.NoError(container.volumeResized(*grown))
+	assert.Equal(uint64(8192), container.state.BlockDeviceSize)
+}
+
+func TestContainerResizeVolumes(t *testing.T) {
+	assert := assert.New(t)
+
+	sandbox := &Sandbox{
+		ctx:        context.Background(),
+		id:         testSandboxID,
+		devManager: manager.NewDeviceManager(config.VirtioSCSI, false, "", nil),
+		hypervisor: &mockHypervisor{},
+		agent:      &mockAgent{},
+		config:     &SandboxConfig{},
+		state:      types.SandboxState{BlockIndexMap: make(map[int]struct{})},
+	}
+
+	path := filepath.Join(t.TempDir(), "volume.img")
+	assert.NoError(os.WriteFile(path, make([]byte, 4096), 0600))
+
+	device, err := sandbox.devManager.NewDevice(config.DeviceInfo{
+		HostPath:      path,
+		ContainerPath: path,
+		DevType:       "b",
+	})
+	assert.NoError(err)
+	assert.NoError(sandbox.devManager.AttachDevice(sandbox.ctx, device.DeviceID(), sandbox))
+
+	container := Container{
+		sandbox: sandbox,
+		id:      "testContainer",
+		mounts: []Mount{
+			{
+				Destination:      "/data",
+				BlockDeviceID:    device.DeviceID(),
+				BlockDeviceSize:  4096,
+				GuestDeviceMount: "/run/kata-containers/sandbox/storage/data",
+			},
+			{
+				Destination:      "/logs",
+				BlockDeviceID:    device.DeviceID(),
+				BlockDeviceSize:  4096,
+				GuestDeviceMount: "/run/kata-containers/sandbox/storage/logs",
+				ReadOnly:         true,
+			},
+		},
+	}
+
+	// Unchanged size
+	assert.Empty(container.grownVolumes())
+
+	// Read-only volumes are not resized
+	assert.NoError(os.Truncate(path, 8192))
+	grown := container.grownVolumes()
+	assert.Len(grown, 1)
+	assert.Equal("/data", grown[0].Destination)
+	assert.Equal(uint64(8192), grown[0].Size)
+
+	assert.NoError(sandbox.ResizeVolume(sandbox.ctx, grown[0]))
+	assert.NoError(container.volumeResized(grown[0]))
+	assert.Equal(uint64(8192), container.mounts[0].BlockDeviceSize)
+	assert.Equal(uint64(4096), container.mounts[1].BlockDeviceSize)
+
+	// Shrunk devices are ignored
+	assert.NoError(os.Truncate(path, 4096))
+	assert.Empty(container.grownVolumes())
+	assert.Equal(uint64(8192), container.mounts[0].BlockDeviceSize)
 }
 
 func TestUnmountHostMountsRemoveBindHostPath(t *testing.T) {
@@ -587,6 +767,7 @@ func TestMountSharedDirMounts(t *testing.T) {
 		ctx:        context.Background(),
 		id:         "foobar",
 		hypervisor: &mockHypervisor{},
+		agent:      &mockAgent{},
 		config: &SandboxConfig{
 			HypervisorConfig: HypervisorConfig{
 				BlockDeviceDriver: config.VirtioBlock,
@@ -648,9 +829,13 @@ func TestMountSharedDirMounts(t *testing.T) {
 
 	// We expect a single new storage object who's source is the original mount's base path and desitation is same with -watchable appended:
 	assert.Equal(len(storage), 1)
+	assert.Equal(kataWatchableSyncDevType, storage[0].Driver)
 	assert.Equal(expectedStorageSource, storage[0].Source)
 	assert.Equal(expectedStorageDest, storage[0].MountPoint)
 
+	// The content of the mount is synced to the guest until the mount is unmounted
+	assert.Len(container.watchableMounts, 1)
+
 	// We expect a single updated mount, who's source is the watchable mount path, and destination remains unchanged:
 	assert.Equal(len(updatedMounts), 1)
 	assert.Equal(updatedMounts[mountDestination].Source, expectedStorageDest)
@@ -39,6 +39,9 @@ type DeviceReceiver interface {
 	UnsetSandboxBlockIndex(int) error
 	GetHypervisorType() string
 
+	// this is for devices shared by several sandboxes of the host
+	GetSandboxID() string
+
 	// this is for appending device to hypervisor boot params
 	AppendDevice(context.Context, Device) error
 }
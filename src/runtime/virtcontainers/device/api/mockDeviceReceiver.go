@@ -43,3 +43,8 @@ func (mockDC *MockDeviceReceiver) AppendDevice(context.Context, Device) error {
 func (mockDC *MockDeviceReceiver) GetHypervisorType() string {
 	return ""
 }
+
+// GetSandboxID is used for getting the ID of the sandbox.
+func (mockDC *MockDeviceReceiver) GetSandboxID() string {
+	return ""
+}
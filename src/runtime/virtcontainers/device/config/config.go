@@ -70,6 +70,18 @@ const (
 
 	// VirtioFSNydus means use nydus for the shared file system
 	VirtioFSNydus = "virtio-fs-nydus"
+
+	// NoSharedFS means no file system is shared with the guest, the
+	// container rootfs are attached as block devices
+	NoSharedFS = "none"
+)
+
+const (
+	// LoopBlockDevice means image files are attached through loop devices
+	LoopBlockDevice = "loop"
+
+	// UblkBlockDevice means image files are attached through ublk devices
+	UblkBlockDevice = "ublk"
 )
 
 const (
@@ -145,6 +157,61 @@ type DeviceInfo struct {
 	// ColdPlug specifies whether the device must be cold plugged (true)
 	// or hot plugged (false).
 	ColdPlug bool
+
+	// VhostUserSocket is set when HostPath is the socket of a vhost-user
+	// target, e.g. SPDK, rather than a device node of the vhost-user store.
+	VhostUserSocket bool
+
+	// NVMe is set when HostPath is the nvme://<bdf>/<namespace> URI of a
+	// namespace of a NVMe controller bound to vfio-pci.
+	NVMe bool
+
+	// ImageFile is set when HostPath is a regular file holding a disk
+	// image rather than a device node. Image files are attached read-only
+	// and can be shared by several sandboxes.
+	ImageFile bool
+
+	// VirtioPmem uses HostPath as backing file for a virtio-pmem device,
+	// which the guest can mount with DAX.
+	VirtioPmem bool
+
+	// IOLimits caps the I/Os the guest issues to the block device.
+	IOLimits *BlockIOLimits
+
+	// LoopFile is set when HostPath is a raw image file the device
+	// manager attaches through a loop device, released when the device
+	// is removed.
+	LoopFile bool
+
+	// Ublk is set along with LoopFile to attach the image file through a
+	// ublk device, served by the loop target of the ublk server, rather
+	// than through a loop device.
+	Ublk *UblkConfig
+
+	// NumQueues is the number of queues of the device in the guest, set
+	// to the number of hardware queues of ublk devices. 0 leaves the
+	// hypervisor default.
+	NumQueues int
+}
+
+// UblkConfig is the queue configuration of the ublk devices set up for
+// image files.
+type UblkConfig struct {
+	// Queues is the number of hardware queues of the device.
+	Queues uint32
+
+	// QueueDepth is the depth of each queue.
+	QueueDepth uint32
+}
+
+// BlockIOLimits caps the I/Os of a block device, a zero limit meaning
+// unlimited.
+type BlockIOLimits struct {
+	// IOPS is the maximum number of I/O operations per second.
+	IOPS uint64 `json:"iops,omitempty"`
+
+	// BPS is the maximum bandwidth, in bytes per second.
+	BPS uint64 `json:"bps,omitempty"`
 }
 
 // BlockDrive represents a block storage drive which may be used in case the storage
@@ -193,6 +260,24 @@ type BlockDrive struct {
 
 	// This block device is for swap
 	Swap bool
+
+	// NVMe is set when File is the nvme://<bdf>/<namespace> URI of a
+	// namespace the hypervisor accesses through its userspace NVMe driver
+	NVMe bool
+
+	// ImageFile is set when File is a regular disk image file, possibly
+	// shared read-only with other sandboxes
+	ImageFile bool
+
+	// VirtioPmem uses File as backing file for a virtio-pmem device
+	VirtioPmem bool
+
+	// IOLimits caps the I/Os the guest issues to the drive
+	IOLimits *BlockIOLimits
+
+	// NumQueues is the number of queues of the drive, 0 leaving the
+	// hypervisor default.
+	NumQueues int
 }
 
 // VFIOMode indicates e behaviour mode for handling devices in the VM
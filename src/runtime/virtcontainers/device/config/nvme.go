@@ -0,0 +1,54 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// NVMeURIScheme is the scheme of the URIs identifying a NVMe namespace,
+	// nvme://<bdf>/<namespace>, as used by the QEMU userspace NVMe driver.
+	NVMeURIScheme = "nvme://"
+
+	// PCINVMeClass is the PCI class code of NVMe controllers.
+	PCINVMeClass = "0x010802"
+
+	// PCIBridgeClassPrefix is the prefix of the PCI class code of PCI
+	// bridges.
+	PCIBridgeClassPrefix = "0x0604"
+)
+
+var pciBDFRegex = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-7]$`)
+
+// ParseNVMeURI returns the PCI address of the controller and the id of the
+// namespace identified by a nvme://<bdf>/<namespace> URI,
+// e.g. nvme://0000:01:00.0/1.
+func ParseNVMeURI(uri string) (string, uint32, error) {
+	if !strings.HasPrefix(uri, NVMeURIScheme) {
+		return "", 0, fmt.Errorf("invalid NVMe namespace %q: missing %s scheme", uri, NVMeURIScheme)
+	}
+
+	fields := strings.Split(strings.TrimPrefix(uri, NVMeURIScheme), "/")
+	if len(fields) != 2 {
+		return "", 0, fmt.Errorf("invalid NVMe namespace %q: expected %s<bdf>/<namespace>", uri, NVMeURIScheme)
+	}
+
+	bdf := fields[0]
+	if !pciBDFRegex.MatchString(bdf) {
+		return "", 0, fmt.Errorf("invalid NVMe namespace %q: invalid PCI address %q", uri, bdf)
+	}
+
+	namespace, err := strconv.ParseUint(fields[1], 10, 32)
+	if err != nil || namespace == 0 {
+		return "", 0, fmt.Errorf("invalid NVMe namespace %q: invalid namespace id %q", uri, fields[1])
+	}
+
+	return bdf, uint32(namespace), nil
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNVMeURI(t *testing.T) {
+	assert := assert.New(t)
+
+	bdf, namespace, err := ParseNVMeURI("nvme://0000:01:00.0/1")
+	assert.NoError(err)
+	assert.Equal("0000:01:00.0", bdf)
+	assert.Equal(uint32(1), namespace)
+
+	for _, uri := range []string{
+		"",
+		"/dev/nvme0n1",
+		"nvme://0000:01:00.0",
+		"nvme://0000:01:00.0/",
+		"nvme://0000:01:00.0/0",
+		"nvme://0000:01:00.0/ns1",
+		"nvme://01:00.0/1",
+		"nvme://0000:01:00.0/1/2",
+	} {
+		_, _, err := ParseNVMeURI(uri)
+		assert.Error(err, uri)
+	}
+}
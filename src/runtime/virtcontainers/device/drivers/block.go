@@ -45,6 +45,13 @@ func (device *BlockDevice) Attach(ctx context.Context, devReceiver api.DeviceRec
 		return nil
 	}
 
+	if device.DeviceInfo.NVMe {
+		if err = validateNVMeNamespace(device.DeviceInfo.HostPath); err != nil {
+			device.bumpAttachCount(false)
+			return err
+		}
+	}
+
 	// Increment the block index for the sandbox. This is used to determine the name
 	// for the block device in the case where the block device is used as container
 	// rootfs and the predicted block device name needs to be provided to the agent.
@@ -62,12 +69,17 @@ func (device *BlockDevice) Attach(ctx context.Context, devReceiver api.DeviceRec
 	}
 
 	drive := &config.BlockDrive{
-		File:     device.DeviceInfo.HostPath,
-		Format:   "raw",
-		ID:       utils.MakeNameID("drive", device.DeviceInfo.ID, maxDevIDSize),
-		Index:    index,
-		Pmem:     device.DeviceInfo.Pmem,
-		ReadOnly: device.DeviceInfo.ReadOnly,
+		File:       device.DeviceInfo.HostPath,
+		Format:     "raw",
+		ID:         utils.MakeNameID("drive", device.DeviceInfo.ID, maxDevIDSize),
+		Index:      index,
+		Pmem:       device.DeviceInfo.Pmem,
+		ReadOnly:   device.DeviceInfo.ReadOnly,
+		NVMe:       device.DeviceInfo.NVMe,
+		ImageFile:  device.DeviceInfo.ImageFile,
+		VirtioPmem: device.DeviceInfo.VirtioPmem,
+		IOLimits:   device.DeviceInfo.IOLimits,
+		NumQueues:  device.DeviceInfo.NumQueues,
 	}
 
 	if fs, ok := device.DeviceInfo.DriverOptions[config.FsTypeOpt]; ok {
@@ -166,17 +178,20 @@ func (device *BlockDevice) Save() persistapi.DeviceState {
 	drive := device.BlockDrive
 	if drive != nil {
 		ds.BlockDrive = &persistapi.BlockDrive{
-			File:     drive.File,
-			Format:   drive.Format,
-			ID:       drive.ID,
-			Index:    drive.Index,
-			MmioAddr: drive.MmioAddr,
-			PCIPath:  drive.PCIPath,
-			SCSIAddr: drive.SCSIAddr,
-			NvdimmID: drive.NvdimmID,
-			VirtPath: drive.VirtPath,
-			DevNo:    drive.DevNo,
-			Pmem:     drive.Pmem,
+			File:       drive.File,
+			Format:     drive.Format,
+			ID:         drive.ID,
+			Index:      drive.Index,
+			MmioAddr:   drive.MmioAddr,
+			PCIPath:    drive.PCIPath,
+			SCSIAddr:   drive.SCSIAddr,
+			NvdimmID:   drive.NvdimmID,
+			VirtPath:   drive.VirtPath,
+			DevNo:      drive.DevNo,
+			Pmem:       drive.Pmem,
+			NVMe:       drive.NVMe,
+			ImageFile:  drive.ImageFile,
+			VirtioPmem: drive.VirtioPmem,
 		}
 	}
 	return ds
@@ -192,17 +207,20 @@ func (device *BlockDevice) Load(ds persistapi.DeviceState) {
 		return
 	}
 	device.BlockDrive = &config.BlockDrive{
-		File:     bd.File,
-		Format:   bd.Format,
-		ID:       bd.ID,
-		Index:    bd.Index,
-		MmioAddr: bd.MmioAddr,
-		PCIPath:  bd.PCIPath,
-		SCSIAddr: bd.SCSIAddr,
-		NvdimmID: bd.NvdimmID,
-		VirtPath: bd.VirtPath,
-		DevNo:    bd.DevNo,
-		Pmem:     bd.Pmem,
+		File:       bd.File,
+		Format:     bd.Format,
+		ID:         bd.ID,
+		Index:      bd.Index,
+		MmioAddr:   bd.MmioAddr,
+		PCIPath:    bd.PCIPath,
+		SCSIAddr:   bd.SCSIAddr,
+		NvdimmID:   bd.NvdimmID,
+		VirtPath:   bd.VirtPath,
+		DevNo:      bd.DevNo,
+		Pmem:       bd.Pmem,
+		NVMe:       bd.NVMe,
+		ImageFile:  bd.ImageFile,
+		VirtioPmem: bd.VirtioPmem,
 	}
 }
 
@@ -0,0 +1,86 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package drivers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
+)
+
+const vfioPCIDriver = "vfio-pci"
+
+// isNVMeController returns true if the PCI device is a NVMe controller.
+func isNVMeController(bdf string) bool {
+	return getPCIDeviceProperty(bdf, PCISysFsDevicesClass) == config.PCINVMeClass
+}
+
+// getPCIDeviceDriver returns the name of the host driver a PCI device is
+// bound to, or an empty string if it is not bound to any driver.
+func getPCIDeviceDriver(bdf string) string {
+	link, err := os.Readlink(filepath.Join(config.SysBusPciDevicesPath, bdf, "driver"))
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(link)
+}
+
+// validateNVMeController checks that a NVMe controller can be assigned to the
+// VM, either as a whole or to access its namespaces from the hypervisor: it
+// must be a PCIe device bound to vfio-pci, in a viable IOMMU group.
+func validateNVMeController(bdf, iommuDevicesPath string) error {
+	if !isNVMeController(bdf) {
+		return fmt.Errorf("PCI device %s is not a NVMe controller", bdf)
+	}
+
+	if !isPCIeDevice(bdf) {
+		return fmt.Errorf("NVMe controller %s is not a PCIe device", bdf)
+	}
+
+	if driver := getPCIDeviceDriver(bdf); driver != vfioPCIDriver {
+		return fmt.Errorf("NVMe controller %s must be bound to %s, not %q", bdf, vfioPCIDriver, driver)
+	}
+
+	return validateIOMMUGroup(bdf, iommuDevicesPath)
+}
+
+// validateIOMMUGroup checks that the IOMMU group of a PCI device is viable,
+// i.e. that every endpoint of the group is bound to vfio-pci or to no driver
+// at all. Otherwise the host driver could still access the group while the
+// guest owns it, and VFIO refuses to open it.
+func validateIOMMUGroup(bdf, iommuDevicesPath string) error {
+	devices, err := os.ReadDir(iommuDevicesPath)
+	if err != nil {
+		return fmt.Errorf("failed to read IOMMU group of %s: %v", bdf, err)
+	}
+
+	for _, dev := range devices {
+		devBDF := dev.Name()
+		if strings.HasPrefix(getPCIDeviceProperty(devBDF, PCISysFsDevicesClass), config.PCIBridgeClassPrefix) {
+			continue
+		}
+
+		if driver := getPCIDeviceDriver(devBDF); driver != "" && driver != vfioPCIDriver {
+			return fmt.Errorf("IOMMU group of %s is not viable: %s is bound to %s", bdf, devBDF, driver)
+		}
+	}
+
+	return nil
+}
+
+// validateNVMeNamespace checks that the controller of a NVMe namespace,
+// given by its nvme://<bdf>/<namespace> URI, can be driven by the hypervisor.
+func validateNVMeNamespace(uri string) error {
+	bdf, _, err := config.ParseNVMeURI(uri)
+	if err != nil {
+		return err
+	}
+
+	return validateNVMeController(bdf, filepath.Join(config.SysBusPciDevicesPath, bdf, "iommu_group", "devices"))
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package drivers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakePCIDevice struct {
+	bdf    string
+	class  string
+	driver string
+	pcie   bool
+}
+
+// setupFakePCIDevices creates a fake /sys/bus/pci/devices hierarchy, with
+// all the devices in the same IOMMU group.
+func setupFakePCIDevices(t *testing.T, devices []fakePCIDevice) {
+	assert := assert.New(t)
+
+	tmpDir := t.TempDir()
+	devicesDir := filepath.Join(tmpDir, "devices")
+	groupDevicesDir := filepath.Join(tmpDir, "iommu_groups", "12", "devices")
+	assert.NoError(os.MkdirAll(groupDevicesDir, 0750))
+
+	for _, dev := range devices {
+		devDir := filepath.Join(devicesDir, dev.bdf)
+		assert.NoError(os.MkdirAll(devDir, 0750))
+		assert.NoError(os.WriteFile(filepath.Join(devDir, "class"), []byte(dev.class+"\n"), 0640))
+
+		configSize := PCIConfigSpaceSize
+		if dev.pcie {
+			configSize = 4096
+		}
+		assert.NoError(os.WriteFile(filepath.Join(devDir, "config"), make([]byte, configSize), 0640))
+
+		if dev.driver != "" {
+			assert.NoError(os.Symlink(filepath.Join(tmpDir, "drivers", dev.driver), filepath.Join(devDir, "driver")))
+		}
+		assert.NoError(os.Symlink(filepath.Dir(groupDevicesDir), filepath.Join(devDir, "iommu_group")))
+		assert.NoError(os.WriteFile(filepath.Join(groupDevicesDir, dev.bdf), nil, 0640))
+	}
+
+	savedSysBusPciDevicesPath := config.SysBusPciDevicesPath
+	config.SysBusPciDevicesPath = devicesDir
+	t.Cleanup(func() {
+		config.SysBusPciDevicesPath = savedSysBusPciDevicesPath
+	})
+}
+
+func TestValidateNVMeNamespace(t *testing.T) {
+	assert := assert.New(t)
+
+	nvme := fakePCIDevice{"0000:01:00.0", config.PCINVMeClass, vfioPCIDriver, true}
+	bridge := fakePCIDevice{"0000:00:01.0", "0x060400", "pcieport", true}
+
+	setupFakePCIDevices(t, []fakePCIDevice{nvme, bridge})
+	assert.NoError(validateNVMeNamespace("nvme://0000:01:00.0/1"))
+	assert.Error(validateNVMeNamespace("/dev/nvme0n1"))
+
+	// Not a NVMe controller
+	setupFakePCIDevices(t, []fakePCIDevice{{"0000:01:00.0", "0x020000", vfioPCIDriver, true}})
+	assert.Error(validateNVMeNamespace("nvme://0000:01:00.0/1"))
+
+	// Still bound to the host NVMe driver
+	setupFakePCIDevices(t, []fakePCIDevice{{"0000:01:00.0", config.PCINVMeClass, "nvme", true}})
+	assert.Error(validateNVMeNamespace("nvme://0000:01:00.0/1"))
+
+	// Not a PCIe device
+	setupFakePCIDevices(t, []fakePCIDevice{{"0000:01:00.0", config.PCINVMeClass, vfioPCIDriver, false}})
+	assert.Error(validateNVMeNamespace("nvme://0000:01:00.0/1"))
+
+	// Another endpoint of the IOMMU group is used by the host
+	setupFakePCIDevices(t, []fakePCIDevice{nvme, bridge, {"0000:01:00.1", "0x020000", "ixgbe", true}})
+	assert.Error(validateNVMeNamespace("nvme://0000:01:00.0/1"))
+
+	// Unbound endpoints do not prevent VFIO from using the group
+	setupFakePCIDevices(t, []fakePCIDevice{nvme, bridge, {"0000:01:00.1", "0x020000", "", true}})
+	assert.NoError(validateNVMeNamespace("nvme://0000:01:00.0/1"))
+}
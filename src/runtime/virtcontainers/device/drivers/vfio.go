@@ -86,6 +86,11 @@ func (device *VFIODevice) Attach(ctx context.Context, devReceiver api.DeviceRece
 		if err != nil {
 			return err
 		}
+		if vfioDeviceType == config.VFIODeviceNormalType && isNVMeController(deviceFile.Name()) {
+			if err := validateNVMeController(deviceFile.Name(), iommuDevicesPath); err != nil {
+				return err
+			}
+		}
 		vfio := &config.VFIODev{
 			ID:       utils.MakeNameID("vfio", device.DeviceInfo.ID+strconv.Itoa(i), maxDevIDSize),
 			Type:     vfioDeviceType,
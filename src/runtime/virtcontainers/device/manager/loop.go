@@ -0,0 +1,138 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package manager
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	loopControlPath = "/dev/loop-control"
+
+	// Number of times a free loop device is looked for, when racing with
+	// other processes setting up loop devices.
+	loopSetupRetries = 10
+)
+
+var qcow2Magic = []byte{'Q', 'F', 'I', 0xfb}
+
+// loopDevice is a loop device the device manager set up for an image file.
+// The loop device is opened until the device using it is removed, and is
+// released by the kernel once it is closed by the hypervisor too. The image
+// file may also be attached to a ublk device rather than a loop device.
+type loopDevice struct {
+	file        *os.File
+	backingFile string
+
+	ublk   bool
+	ublkID int
+}
+
+// checkRawImage checks that an image file holds a raw disk image, which is
+// the only format loop devices can expose.
+func checkRawImage(imagePath string) error {
+	f, err := os.Open(imagePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	magic := make([]byte, len(qcow2Magic))
+	if _, err := io.ReadFull(f, magic); err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return err
+	}
+	if bytes.Equal(magic, qcow2Magic) {
+		return fmt.Errorf("image %s is a qcow2 image, only raw images can be attached through loop devices", imagePath)
+	}
+
+	return nil
+}
+
+// setupLoopDevice binds a free loop device to an image file. The loop
+// device is set to be released automatically by the kernel when it is
+// closed for the last time, so that it does not outlive its users.
+func setupLoopDevice(imagePath string, readOnly bool) (*loopDevice, error) {
+	if err := checkRawImage(imagePath); err != nil {
+		return nil, err
+	}
+
+	flags := os.O_RDWR
+	if readOnly {
+		flags = os.O_RDONLY
+	}
+
+	image, err := os.OpenFile(imagePath, flags, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer image.Close()
+
+	ctl, err := os.OpenFile(loopControlPath, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer ctl.Close()
+
+	for i := 0; i < loopSetupRetries; i++ {
+		index, err := unix.IoctlRetInt(int(ctl.Fd()), unix.LOOP_CTL_GET_FREE)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get a free loop device: %v", err)
+		}
+
+		loop, err := os.OpenFile(fmt.Sprintf("/dev/loop%d", index), flags, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := unix.IoctlSetInt(int(loop.Fd()), unix.LOOP_SET_FD, int(image.Fd())); err != nil {
+			loop.Close()
+			// Another process got the loop device first
+			if err == unix.EBUSY {
+				continue
+			}
+			return nil, fmt.Errorf("failed to bind %s to %s: %v", imagePath, loop.Name(), err)
+		}
+
+		info := unix.LoopInfo64{
+			Flags: unix.LO_FLAGS_AUTOCLEAR,
+		}
+		if readOnly {
+			info.Flags |= unix.LO_FLAGS_READ_ONLY
+		}
+		copy(info.File_name[:len(info.File_name)-1], imagePath)
+
+		if _, _, errno := unix.Syscall(unix.SYS_IOCTL, loop.Fd(), unix.LOOP_SET_STATUS64, uintptr(unsafe.Pointer(&info))); errno != 0 {
+			unix.IoctlSetInt(int(loop.Fd()), unix.LOOP_CLR_FD, 0)
+			loop.Close()
+			return nil, fmt.Errorf("failed to configure %s: %v", loop.Name(), errno)
+		}
+
+		return &loopDevice{
+			file:        loop,
+			backingFile: imagePath,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("failed to find a free loop device for %s", imagePath)
+}
+
+// release closes the loop device, which the kernel detaches from its image
+// once the hypervisor closed it too. A ublk device is deleted.
+func (l *loopDevice) release() error {
+	err := l.file.Close()
+	if l.ublk {
+		if e := deleteUblkDevice(l.ublkID); e != nil {
+			return e
+		}
+	}
+	return err
+}
@@ -13,6 +13,7 @@ import (
 	"sync"
 
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
 
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/api"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
@@ -32,11 +33,18 @@ var (
 	// ErrRemoveAttachedDevice represents the device isn't detached
 	// so not allow to remove from list
 	ErrRemoveAttachedDevice = errors.New("can't remove attached device")
+	// ErrWritableImageFile represents an image file attached read-write,
+	// which could be corrupted by several sandboxes using it
+	ErrWritableImageFile = errors.New("image files can only be attached read-only")
 )
 
 type deviceManager struct {
 	devices map[string]api.Device
 
+	// loopDevices are the loop devices set up for the image files of
+	// the devices, indexed by device ID.
+	loopDevices map[string]*loopDevice
+
 	blockDriver        string
 	vhostUserStorePath string
 
@@ -55,6 +63,7 @@ func NewDeviceManager(blockDriver string, vhostUserStoreEnabled bool, vhostUserS
 		vhostUserStoreEnabled: vhostUserStoreEnabled,
 		vhostUserStorePath:    vhostUserStorePath,
 		devices:               make(map[string]api.Device),
+		loopDevices:           make(map[string]*loopDevice),
 	}
 	if blockDriver == config.VirtioMmio {
 		dm.blockDriver = config.VirtioMmio
@@ -86,11 +95,67 @@ func (dm *deviceManager) findDeviceByMajorMinor(major, minor int64) api.Device {
 	return nil
 }
 
+func (dm *deviceManager) findDeviceByHostPath(hostPath string) api.Device {
+	for _, dev := range dm.devices {
+		if dev.GetHostPath() == hostPath {
+			return dev
+		}
+	}
+	return nil
+}
+
+func (dm *deviceManager) findDeviceByLoopFile(backingFile string) api.Device {
+	for id, loop := range dm.loopDevices {
+		if loop.backingFile == backingFile {
+			return dm.devices[id]
+		}
+	}
+	return nil
+}
+
 // createDevice creates one device based on DeviceInfo
 func (dm *deviceManager) createDevice(devInfo config.DeviceInfo) (dev api.Device, err error) {
+	if devInfo.ImageFile && !devInfo.ReadOnly {
+		return nil, ErrWritableImageFile
+	}
+
+	// Image files attached through loop devices become regular block
+	// devices, backed by the loop device set up for them.
+	var loop *loopDevice
+	if devInfo.LoopFile {
+		if existingDev := dm.findDeviceByLoopFile(devInfo.HostPath); existingDev != nil {
+			existingDev.Reference()
+			return existingDev, nil
+		}
+
+		if devInfo.Ublk != nil {
+			loop, err = setupUblkDevice(devInfo.HostPath, devInfo.ReadOnly, devInfo.Ublk)
+		} else {
+			loop, err = setupLoopDevice(devInfo.HostPath, devInfo.ReadOnly)
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				loop.release()
+			}
+		}()
+
+		var stat unix.Stat_t
+		if err = unix.Fstat(int(loop.file.Fd()), &stat); err != nil {
+			return nil, err
+		}
+		devInfo.HostPath = loop.file.Name()
+		devInfo.Major = int64(unix.Major(uint64(stat.Rdev)))
+		devInfo.Minor = int64(unix.Minor(uint64(stat.Rdev)))
+	}
+
 	// pmem device may points to block devices or raw files,
-	// do not change its HostPath.
-	if !devInfo.Pmem {
+	// vhost-user targets are given by their socket path, NVMe
+	// namespaces by their URI and image files by their path,
+	// do not change their HostPath.
+	if !devInfo.Pmem && !devInfo.VirtioPmem && !devInfo.VhostUserSocket && !devInfo.NVMe && !devInfo.ImageFile && !devInfo.LoopFile {
 		path, err := config.GetHostPathFunc(devInfo, dm.vhostUserStoreEnabled, dm.vhostUserStorePath)
 		if err != nil {
 			return nil, err
@@ -98,13 +163,35 @@ func (dm *deviceManager) createDevice(devInfo config.DeviceInfo) (dev api.Device
 		devInfo.HostPath = path
 	}
 
+	// The guest gets as many queues as the ublk devices have
+	if devInfo.DevType == "b" && !devInfo.VhostUserSocket && !devInfo.NVMe && !devInfo.ImageFile && !devInfo.Pmem && !devInfo.VirtioPmem {
+		ublk, err := getUblkInfo(devInfo.Major, devInfo.Minor)
+		if err != nil {
+			return nil, err
+		}
+		if ublk != nil {
+			deviceLogger().WithFields(logrus.Fields{
+				"device": devInfo.HostPath,
+				"queues": ublk.queues,
+			}).Info("Attaching ublk device")
+			devInfo.NumQueues = ublk.queues
+		}
+	}
+
 	defer func() {
 		if err == nil {
 			dev.Reference()
 		}
 	}()
 
-	if existingDev := dm.findDeviceByMajorMinor(devInfo.Major, devInfo.Minor); existingDev != nil {
+	// vhost-user targets given by their socket, NVMe namespaces, image
+	// and virtio-pmem files have no device node, hence no meaningful
+	// major/minor numbers.
+	if devInfo.VhostUserSocket || devInfo.NVMe || devInfo.ImageFile || devInfo.VirtioPmem {
+		if existingDev := dm.findDeviceByHostPath(devInfo.HostPath); existingDev != nil {
+			return existingDev, nil
+		}
+	} else if existingDev := dm.findDeviceByMajorMinor(devInfo.Major, devInfo.Minor); existingDev != nil {
 		return existingDev, nil
 	}
 
@@ -113,6 +200,9 @@ func (dm *deviceManager) createDevice(devInfo config.DeviceInfo) (dev api.Device
 	if devInfo.ID, err = dm.newDeviceID(); err != nil {
 		return nil, err
 	}
+	if loop != nil {
+		dm.loopDevices[devInfo.ID] = loop
+	}
 	if isVFIO(devInfo.HostPath) {
 		return drivers.NewVFIODevice(&devInfo), nil
 	} else if isVhostUserBlk(devInfo) {
@@ -158,6 +248,11 @@ func (dm *deviceManager) RemoveDevice(id string) error {
 			return ErrRemoveAttachedDevice
 		}
 		delete(dm.devices, id)
+
+		if loop, ok := dm.loopDevices[id]; ok {
+			delete(dm.loopDevices, id)
+			return loop.release()
+		}
 	}
 	return nil
 }
@@ -191,6 +286,17 @@ func (dm *deviceManager) AttachDevice(ctx context.Context, id string, dr api.Dev
 	if err := d.Attach(ctx, dr); err != nil {
 		return err
 	}
+
+	// The image may be used by other sandboxes, record that this one
+	// uses it until its last user in the sandbox detaches it.
+	if isImageFile(d) && d.GetAttachCount() == 1 {
+		if err := acquireSharedDevice(d.GetHostPath(), dr.GetSandboxID()); err != nil {
+			if detachErr := d.Detach(ctx, dr); detachErr != nil {
+				deviceLogger().WithError(detachErr).WithField("device", d.GetHostPath()).Error("failed to detach shared image")
+			}
+			return err
+		}
+	}
 	return nil
 }
 
@@ -209,6 +315,10 @@ func (dm *deviceManager) DetachDevice(ctx context.Context, id string, dr api.Dev
 	if err := d.Detach(ctx, dr); err != nil {
 		return err
 	}
+
+	if isImageFile(d) && d.GetAttachCount() == 0 {
+		return releaseSharedDevice(d.GetHostPath(), dr.GetSandboxID())
+	}
 	return nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	ktu "github.com/kata-containers/kata-containers/src/runtime/pkg/katatestutils"
@@ -96,3 +97,160 @@ func TestAttachVhostUserBlkDevice(t *testing.T) {
 	err = device.Detach(context.Background(), devReceiver)
 	assert.Nil(t, err)
 }
+
+func TestAttachVhostUserBlkSocketDevice(t *testing.T) {
+	dm := &deviceManager{
+		blockDriver: config.VirtioBlock,
+		devices:     make(map[string]api.Device),
+	}
+
+	sockPath := filepath.Join(t.TempDir(), "spdk-blk0.sock")
+	deviceInfo := config.DeviceInfo{
+		HostPath:        sockPath,
+		ContainerPath:   "/mnt/data",
+		DevType:         "b",
+		VhostUserSocket: true,
+	}
+
+	device, err := dm.NewDevice(deviceInfo)
+	assert.Nil(t, err)
+	blkDevice, ok := device.(*drivers.VhostUserBlkDevice)
+	assert.True(t, ok)
+	// The socket path is used as is
+	assert.Equal(t, sockPath, blkDevice.GetHostPath())
+
+	// The same target is shared, another one gets its own device
+	sameDevice, err := dm.NewDevice(deviceInfo)
+	assert.Nil(t, err)
+	assert.Equal(t, device.DeviceID(), sameDevice.DeviceID())
+
+	deviceInfo.HostPath = filepath.Join(filepath.Dir(sockPath), "spdk-blk1.sock")
+	otherDevice, err := dm.NewDevice(deviceInfo)
+	assert.Nil(t, err)
+	assert.NotEqual(t, device.DeviceID(), otherDevice.DeviceID())
+
+	devReceiver := &api.MockDeviceReceiver{}
+	err = device.Attach(context.Background(), devReceiver)
+	assert.Nil(t, err)
+	assert.Equal(t, sockPath, blkDevice.VhostUserDeviceAttrs.SocketPath)
+
+	err = device.Detach(context.Background(), devReceiver)
+	assert.Nil(t, err)
+}
+
+func TestNewLoopFileDevice(t *testing.T) {
+	assert := assert.New(t)
+	tc := ktu.NewTestConstraint(false)
+	if tc.NotValid(ktu.NeedRoot()) {
+		t.Skip(ktu.TestDisabledNeedRoot)
+	}
+	if _, err := os.Stat(loopControlPath); err != nil {
+		t.Skipf("loop devices are not available: %v", err)
+	}
+
+	tmpDir := t.TempDir()
+
+	// qcow2 images can't be attached through loop devices
+	qcow2Image := filepath.Join(tmpDir, "volume.qcow2")
+	assert.NoError(os.WriteFile(qcow2Image, append([]byte{'Q', 'F', 'I', 0xfb}, make([]byte, 4092)...), 0600))
+
+	dm := NewDeviceManager(config.VirtioBlock, false, "", nil)
+	_, err := dm.NewDevice(config.DeviceInfo{
+		HostPath:      qcow2Image,
+		ContainerPath: "/data",
+		DevType:       "b",
+		LoopFile:      true,
+	})
+	assert.Error(err)
+
+	image := filepath.Join(tmpDir, "volume.img")
+	assert.NoError(os.WriteFile(image, make([]byte, 1<<20), 0600))
+
+	deviceInfo := config.DeviceInfo{
+		HostPath:      image,
+		ContainerPath: "/data",
+		DevType:       "b",
+		LoopFile:      true,
+	}
+	device, err := dm.NewDevice(deviceInfo)
+	assert.NoError(err)
+	_, ok := device.(*drivers.BlockDevice)
+	assert.True(ok)
+
+	loopPath := device.GetHostPath()
+	assert.Contains(loopPath, "/dev/loop")
+	backingFile, err := os.ReadFile(filepath.Join("/sys/block", filepath.Base(loopPath), "loop", "backing_file"))
+	assert.NoError(err)
+	assert.Equal(image, strings.TrimSpace(string(backingFile)))
+
+	// Containers of a sandbox use the same device
+	device2, err := dm.NewDevice(deviceInfo)
+	assert.NoError(err)
+	assert.Equal(device.DeviceID(), device2.DeviceID())
+
+	// The loop device is released with the last user of the device
+	assert.NoError(dm.RemoveDevice(device.DeviceID()))
+	assert.NotNil(dm.GetDeviceByID(device.DeviceID()))
+	assert.NoError(dm.RemoveDevice(device.DeviceID()))
+	assert.Nil(dm.GetDeviceByID(device.DeviceID()))
+
+	_, err = os.Stat(filepath.Join("/sys/block", filepath.Base(loopPath), "loop"))
+	assert.True(os.IsNotExist(err))
+}
+
+func TestGetUblkInfo(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	savedSysDevBlockPath, savedSysBlockPath, savedDevPath := sysDevBlockPath, sysBlockPath, devPath
+	defer func() {
+		sysDevBlockPath, sysBlockPath, devPath = savedSysDevBlockPath, savedSysBlockPath, savedDevPath
+	}()
+	sysDevBlockPath = filepath.Join(dir, "sys", "dev", "block")
+	sysBlockPath = filepath.Join(dir, "sys", "block")
+	devPath = filepath.Join(dir, "dev")
+	assert.NoError(os.MkdirAll(devPath, 0755))
+
+	addBlockDevice := func(major, minor int64, name string, queues int) {
+		devDir := filepath.Join(sysDevBlockPath, fmt.Sprintf("%d:%d", major, minor))
+		assert.NoError(os.MkdirAll(devDir, 0755))
+		uevent := fmt.Sprintf("MAJOR=%d\nMINOR=%d\nDEVNAME=%s\nDEVTYPE=disk\n", major, minor, name)
+		assert.NoError(os.WriteFile(filepath.Join(devDir, "uevent"), []byte(uevent), 0644))
+
+		for i := 0; i < queues; i++ {
+			assert.NoError(os.MkdirAll(filepath.Join(sysBlockPath, name, "mq", fmt.Sprint(i)), 0755))
+		}
+	}
+
+	addBlockDevice(8, 0, "sda", 1)
+	addBlockDevice(259, 3, "ublkb3", 4)
+	addBlockDevice(259, 4, "ublkb4", 1)
+
+	name, err := blockDeviceName(8, 0)
+	assert.NoError(err)
+	assert.Equal("sda", name)
+
+	// Not a ublk device
+	info, err := getUblkInfo(8, 0)
+	assert.NoError(err)
+	assert.Nil(info)
+
+	// Not listed in sysfs
+	info, err = getUblkInfo(1, 1)
+	assert.NoError(err)
+	assert.Nil(info)
+
+	// No character device, the ublk server is gone
+	_, err = getUblkInfo(259, 3)
+	assert.Error(err)
+
+	assert.NoError(os.Symlink("/dev/null", filepath.Join(devPath, "ublkc3")))
+	info, err = getUblkInfo(259, 3)
+	assert.NoError(err)
+	assert.Equal(&ublkInfo{id: 3, queues: 4}, info)
+
+	// The character device is not a character device
+	assert.NoError(os.WriteFile(filepath.Join(devPath, "ublkc4"), nil, 0644))
+	_, err = getUblkInfo(259, 4)
+	assert.Error(err)
+}
@@ -257,3 +257,80 @@ func TestAttachDetachDevice(t *testing.T) {
 	err = dm.RemoveDevice(device.DeviceID())
 	assert.Nil(t, err)
 }
+
+type sandboxDeviceReceiver struct {
+	api.MockDeviceReceiver
+	id string
+}
+
+func (r *sandboxDeviceReceiver) GetSandboxID() string {
+	return r.id
+}
+
+func TestAttachDetachSharedImage(t *testing.T) {
+	assert := assert.New(t)
+
+	savedSharedDevicesPath := sharedDevicesPath
+	defer func() { sharedDevicesPath = savedSharedDevicesPath }()
+	sharedDevicesPath = filepath.Join(t.TempDir(), "shared")
+
+	image := filepath.Join(t.TempDir(), "dataset.img")
+	assert.NoError(os.WriteFile(image, make([]byte, 4096), 0640))
+
+	deviceInfo := config.DeviceInfo{
+		HostPath:      image,
+		ContainerPath: "/data",
+		DevType:       "b",
+		ImageFile:     true,
+	}
+
+	// Image files can only be shared read-only
+	dm1 := NewDeviceManager(config.VirtioBlock, false, "", nil)
+	_, err := dm1.NewDevice(deviceInfo)
+	assert.Equal(ErrWritableImageFile, err)
+
+	deviceInfo.ReadOnly = true
+	device1, err := dm1.NewDevice(deviceInfo)
+	assert.NoError(err)
+	_, ok := device1.(*drivers.BlockDevice)
+	assert.True(ok)
+	assert.Equal(image, device1.GetHostPath())
+
+	// Containers of a sandbox use the same device
+	device, err := dm1.NewDevice(deviceInfo)
+	assert.NoError(err)
+	assert.Equal(device1.DeviceID(), device.DeviceID())
+
+	sandbox1 := &sandboxDeviceReceiver{id: "sandbox1"}
+	assert.NoError(dm1.AttachDevice(context.Background(), device1.DeviceID(), sandbox1))
+	assert.NoError(dm1.AttachDevice(context.Background(), device1.DeviceID(), sandbox1))
+
+	dm2 := NewDeviceManager(config.VirtioBlock, false, "", nil)
+	device2, err := dm2.NewDevice(deviceInfo)
+	assert.NoError(err)
+	sandbox2 := &sandboxDeviceReceiver{id: "sandbox2"}
+	assert.NoError(dm2.AttachDevice(context.Background(), device2.DeviceID(), sandbox2))
+
+	holders, err := SharedDeviceHolders(image)
+	assert.NoError(err)
+	assert.ElementsMatch([]string{"sandbox1", "sandbox2"}, holders)
+
+	// The image is still used by the sandbox until its last user detaches it
+	assert.NoError(dm1.DetachDevice(context.Background(), device1.DeviceID(), sandbox1))
+	holders, err = SharedDeviceHolders(image)
+	assert.NoError(err)
+	assert.ElementsMatch([]string{"sandbox1", "sandbox2"}, holders)
+
+	assert.NoError(dm1.DetachDevice(context.Background(), device1.DeviceID(), sandbox1))
+	holders, err = SharedDeviceHolders(image)
+	assert.NoError(err)
+	assert.Equal([]string{"sandbox2"}, holders)
+
+	// A sandbox deleted without detaching its devices
+	assert.NoError(ReleaseSandboxSharedDevices("sandbox2"))
+	holders, err = SharedDeviceHolders(image)
+	assert.NoError(err)
+	assert.Empty(holders)
+	_, err = os.Stat(sharedDeviceDir(image))
+	assert.True(os.IsNotExist(err))
+}
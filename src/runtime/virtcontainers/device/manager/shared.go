@@ -0,0 +1,130 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package manager
+
+import (
+	b64 "encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// sharedDevicesPath holds a directory per read-only image file attached
+// to sandboxes, named after the encoded path of the image, with an empty
+// file per sandbox using it. A shared image must not be released, e.g.
+// deleted or detached from the node, while its directory exists.
+var sharedDevicesPath = "/run/kata-containers/shared/ro-block-devices"
+
+func sharedDeviceDir(hostPath string) string {
+	return filepath.Join(sharedDevicesPath, b64.URLEncoding.EncodeToString([]byte(hostPath)))
+}
+
+// withSharedDevicesLock runs f holding an exclusive lock on the shared
+// devices directory, serializing the updates of the sandboxes of the node.
+func withSharedDevicesLock(f func() error) error {
+	if err := os.MkdirAll(sharedDevicesPath, 0700); err != nil {
+		return err
+	}
+
+	dir, err := os.Open(sharedDevicesPath)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+
+	if err := syscall.Flock(int(dir.Fd()), syscall.LOCK_EX); err != nil {
+		return fmt.Errorf("failed to lock %s: %v", sharedDevicesPath, err)
+	}
+	defer syscall.Flock(int(dir.Fd()), syscall.LOCK_UN)
+
+	return f()
+}
+
+// acquireSharedDevice records that a sandbox uses a shared image.
+func acquireSharedDevice(hostPath, sandboxID string) error {
+	return withSharedDevicesLock(func() error {
+		dir := sharedDeviceDir(hostPath)
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dir, sandboxID), nil, 0600)
+	})
+}
+
+// releaseSharedDevice records that a sandbox no longer uses a shared image,
+// removing the directory of the image once its last user is gone.
+func releaseSharedDevice(hostPath, sandboxID string) error {
+	return withSharedDevicesLock(func() error {
+		dir := sharedDeviceDir(hostPath)
+		if err := os.Remove(filepath.Join(dir, sandboxID)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return removeUnusedSharedDevice(dir)
+	})
+}
+
+func removeUnusedSharedDevice(dir string) error {
+	holders, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if len(holders) == 0 {
+		return os.Remove(dir)
+	}
+	return nil
+}
+
+// SharedDeviceHolders returns the IDs of the sandboxes using a shared
+// read-only image.
+func SharedDeviceHolders(hostPath string) ([]string, error) {
+	var holders []string
+
+	entries, err := os.ReadDir(sharedDeviceDir(hostPath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, e := range entries {
+		holders = append(holders, e.Name())
+	}
+	return holders, nil
+}
+
+// ReleaseSandboxSharedDevices drops the references a sandbox holds on
+// shared images, e.g. when it is deleted without detaching its devices.
+func ReleaseSandboxSharedDevices(sandboxID string) error {
+	if _, err := os.Stat(sharedDevicesPath); os.IsNotExist(err) {
+		return nil
+	}
+
+	return withSharedDevicesLock(func() error {
+		images, err := os.ReadDir(sharedDevicesPath)
+		if err != nil {
+			return err
+		}
+
+		for _, image := range images {
+			if !image.IsDir() {
+				continue
+			}
+			dir := filepath.Join(sharedDevicesPath, image.Name())
+			if err := os.Remove(filepath.Join(dir, sandboxID)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			if err := removeUnusedSharedDevice(dir); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package manager
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
+)
+
+const (
+	ublkBlockPrefix = "ublkb"
+	ublkCharPrefix  = "ublkc"
+
+	// ublkCmd is the command line tool of the ublk server, ublksrv.
+	ublkCmd = "ublk"
+)
+
+var (
+	// Overridden by the tests
+	sysDevBlockPath = "/sys/dev/block"
+	sysBlockPath    = "/sys/block"
+	devPath         = "/dev"
+
+	ublkAddOutputRegex = regexp.MustCompile(`dev id (\d+):`)
+)
+
+// ublkInfo is the configuration of a ublk block device.
+type ublkInfo struct {
+	id     int
+	queues int
+}
+
+// blockDeviceName returns the kernel name of a block device.
+func blockDeviceName(major, minor int64) (string, error) {
+	f, err := os.Open(filepath.Join(sysDevBlockPath, fmt.Sprintf("%d:%d", major, minor), "uevent"))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if name := strings.TrimPrefix(scanner.Text(), "DEVNAME="); name != scanner.Text() {
+			return name, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("no name found for block device %d:%d", major, minor)
+}
+
+// getUblkInfo returns the configuration of a block device if it is a ublk
+// device, or nil otherwise. A ublk block device, /dev/ublkb<id>, is served
+// by a userspace server through its character device, /dev/ublkc<id>,
+// which must still exist for the block device to be usable.
+func getUblkInfo(major, minor int64) (*ublkInfo, error) {
+	name, err := blockDeviceName(major, minor)
+	if err != nil {
+		// Not all block devices are listed in sysfs, e.g. in the tests
+		deviceLogger().WithError(err).Debug("Could not check if the block device is a ublk device")
+		return nil, nil
+	}
+	if !strings.HasPrefix(name, ublkBlockPrefix) {
+		return nil, nil
+	}
+
+	id, err := strconv.Atoi(strings.TrimPrefix(name, ublkBlockPrefix))
+	if err != nil {
+		return nil, nil
+	}
+
+	charDev := filepath.Join(devPath, fmt.Sprintf("%s%d", ublkCharPrefix, id))
+	st, err := os.Stat(charDev)
+	if err != nil {
+		return nil, fmt.Errorf("ublk device %s has no character device %s, is its server running? %v", name, charDev, err)
+	}
+	if st.Mode()&os.ModeCharDevice == 0 {
+		return nil, fmt.Errorf("%s of ublk device %s is not a character device", charDev, name)
+	}
+
+	// The block device has one directory per hardware queue
+	queues, err := filepath.Glob(filepath.Join(sysBlockPath, name, "mq", "[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+	if len(queues) == 0 {
+		return nil, fmt.Errorf("no queue found for ublk device %s", name)
+	}
+
+	return &ublkInfo{
+		id:     id,
+		queues: len(queues),
+	}, nil
+}
+
+// setupUblkDevice attaches an image file to a ublk device served by the
+// loop target of the ublk server. Unlike a loop device, which the kernel
+// releases once closed, the ublk device must be deleted when released.
+func setupUblkDevice(imagePath string, readOnly bool, cfg *config.UblkConfig) (*loopDevice, error) {
+	if err := checkRawImage(imagePath); err != nil {
+		return nil, err
+	}
+
+	args := []string{"add", "-t", "loop", "-f", imagePath}
+	if cfg.Queues > 0 {
+		args = append(args, "-q", strconv.FormatUint(uint64(cfg.Queues), 10))
+	}
+	if cfg.QueueDepth > 0 {
+		args = append(args, "-d", strconv.FormatUint(uint64(cfg.QueueDepth), 10))
+	}
+
+	output, err := exec.Command(ublkCmd, args...).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("failed to add ublk device for %s: %v: %s", imagePath, err, output)
+	}
+
+	match := ublkAddOutputRegex.FindSubmatch(output)
+	if match == nil {
+		return nil, fmt.Errorf("could not find the ublk device added for %s: %s", imagePath, output)
+	}
+	id, _ := strconv.Atoi(string(match[1]))
+
+	ublk := &loopDevice{
+		backingFile: imagePath,
+		ublk:        true,
+		ublkID:      id,
+	}
+
+	flags := os.O_RDWR
+	if readOnly {
+		flags = os.O_RDONLY
+	}
+	if ublk.file, err = os.OpenFile(filepath.Join(devPath, fmt.Sprintf("%s%d", ublkBlockPrefix, id)), flags, 0); err != nil {
+		deleteUblkDevice(id)
+		return nil, err
+	}
+
+	// The loop target has no read-only mode, the block device is made
+	// read-only instead.
+	if readOnly {
+		if err := unix.IoctlSetPointerInt(int(ublk.file.Fd()), unix.BLKROSET, 1); err != nil {
+			ublk.release()
+			return nil, fmt.Errorf("failed to set %s read-only: %v", ublk.file.Name(), err)
+		}
+	}
+
+	return ublk, nil
+}
+
+func deleteUblkDevice(id int) error {
+	if output, err := exec.Command(ublkCmd, "del", "-n", strconv.Itoa(id)).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to delete ublk device %d: %v: %s", id, err, output)
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/api"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
 )
 
@@ -38,10 +39,17 @@ func isBlock(devInfo config.DeviceInfo) bool {
 
 // isVhostUserBlk checks if the device is a VhostUserBlk device.
 func isVhostUserBlk(devInfo config.DeviceInfo) bool {
-	return devInfo.DevType == "b" && devInfo.Major == config.VhostUserBlkMajor
+	return devInfo.VhostUserSocket || (devInfo.DevType == "b" && devInfo.Major == config.VhostUserBlkMajor)
 }
 
 // isVhostUserSCSI checks if the device is a VhostUserSCSI device.
 func isVhostUserSCSI(devInfo config.DeviceInfo) bool {
 	return devInfo.DevType == "b" && devInfo.Major == config.VhostUserSCSIMajor
 }
+
+// isImageFile checks if the device is an attached disk image file, which
+// may be shared with other sandboxes.
+func isImageFile(d api.Device) bool {
+	drive, ok := d.GetDeviceInfo().(*config.BlockDrive)
+	return ok && drive != nil && drive.ImageFile
+}
@@ -77,6 +77,8 @@ func TestIsVhostUserBlk(t *testing.T) {
 			})
 		assert.Equal(t, d.expected, isVhostUserBlk)
 	}
+
+	assert.True(t, isVhostUserBlk(config.DeviceInfo{VhostUserSocket: true}))
 }
 
 func TestIsVhostUserSCSI(t *testing.T) {
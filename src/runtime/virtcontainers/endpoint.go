@@ -65,6 +65,12 @@ const (
 
 	// IPVlanEndpointType is ipvlan network interface.
 	IPVlanEndpointType EndpointType = "ipvlan"
+
+	// VdpaEndpointType is the vhost-vdpa network interface.
+	VdpaEndpointType EndpointType = "vdpa"
+
+	// PasstEndpointType is the passt user-mode network interface.
+	PasstEndpointType EndpointType = "passt"
 )
 
 // Set sets an endpoint type based on the input string.
@@ -94,6 +100,12 @@ func (endpointType *EndpointType) Set(value string) error {
 	case "ipvlan":
 		*endpointType = IPVlanEndpointType
 		return nil
+	case "vdpa":
+		*endpointType = VdpaEndpointType
+		return nil
+	case "passt":
+		*endpointType = PasstEndpointType
+		return nil
 	default:
 		return fmt.Errorf("Unknown endpoint type %s", value)
 	}
@@ -118,6 +130,10 @@ func (endpointType *EndpointType) String() string {
 		return string(TuntapEndpointType)
 	case IPVlanEndpointType:
 		return string(IPVlanEndpointType)
+	case VdpaEndpointType:
+		return string(VdpaEndpointType)
+	case PasstEndpointType:
+		return string(PasstEndpointType)
 	default:
 		return ""
 	}
@@ -174,6 +190,7 @@ func saveNetIfPair(pair *NetworkInterfacePair) *persistapi.NetworkInterfacePair
 		TapInterface:         *tapif,
 		VirtIface:            virtif,
 		NetInterworkingModel: int(pair.NetInterworkingModel),
+		MacvtapMode:          string(pair.MacvtapMode),
 	}
 }
 
@@ -196,6 +213,7 @@ func loadNetIfPair(pair *persistapi.NetworkInterfacePair) *NetworkInterfacePair
 		TapInterface:         *tapif,
 		VirtIface:            virtif,
 		NetInterworkingModel: NetInterworkingModel(pair.NetInterworkingModel),
+		MacvtapMode:          MacvtapMode(pair.MacvtapMode),
 	}
 }
 
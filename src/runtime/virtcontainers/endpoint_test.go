@@ -42,6 +42,14 @@ func TestMacvtapEndpointTypeSet(t *testing.T) {
 	testEndpointTypeSet(t, "macvtap", MacvtapEndpointType)
 }
 
+func TestVdpaEndpointTypeSet(t *testing.T) {
+	testEndpointTypeSet(t, "vdpa", VdpaEndpointType)
+}
+
+func TestPasstEndpointTypeSet(t *testing.T) {
+	testEndpointTypeSet(t, "passt", PasstEndpointType)
+}
+
 func TestEndpointTypeSetFailure(t *testing.T) {
 	var endpointType EndpointType
 
@@ -78,6 +86,16 @@ func TestMacvtapEndpointTypeString(t *testing.T) {
 	testEndpointTypeString(t, &endpointType, string(MacvtapEndpointType))
 }
 
+func TestVdpaEndpointTypeString(t *testing.T) {
+	endpointType := VdpaEndpointType
+	testEndpointTypeString(t, &endpointType, string(VdpaEndpointType))
+}
+
+func TestPasstEndpointTypeString(t *testing.T) {
+	endpointType := PasstEndpointType
+	testEndpointTypeString(t, &endpointType, string(PasstEndpointType))
+}
+
 func TestIncorrectEndpointTypeString(t *testing.T) {
 	var endpointType EndpointType
 	testEndpointTypeString(t, &endpointType, "")
@@ -97,6 +97,21 @@ func (t *template) close() {
 	}
 }
 
+// Destroy unmounts and removes the template in templatePath, including
+// a partially created one that cannot be fetched anymore.
+func Destroy(templatePath string) error {
+	if _, err := os.Stat(templatePath); os.IsNotExist(err) {
+		return nil
+	}
+
+	// EINVAL means that templatePath is not a mount point.
+	if err := syscall.Unmount(templatePath, syscall.MNT_DETACH); err != nil && err != syscall.EINVAL {
+		return fmt.Errorf("failed to unmount %s: %v", templatePath, err)
+	}
+
+	return os.RemoveAll(templatePath)
+}
+
 func (t *template) prepareTemplateFiles() error {
 	// create and mount tmpfs for the shared memory file
 	err := os.MkdirAll(t.statePath, 0700)
@@ -141,3 +141,30 @@ func TestTemplateFactory(t *testing.T) {
 	}
 	assert.True(os.IsNotExist(err), fmt.Sprintf("mount still present after waiting %d seconds", waitTime))
 }
+
+func TestTemplateDestroy(t *testing.T) {
+	assert := assert.New(t)
+
+	templatePath := t.TempDir() + "/template"
+
+	// nothing to destroy
+	assert.NoError(Destroy(templatePath))
+
+	// a leftover of an interrupted template creation
+	assert.NoError(os.MkdirAll(templatePath, 0700))
+	f, err := os.Create(templatePath + "/memory")
+	assert.NoError(err)
+	f.Close()
+
+	_, err = Fetch(vc.VMConfig{}, templatePath)
+	assert.Error(err)
+
+	err = Destroy(templatePath)
+	if os.Geteuid() != 0 {
+		// unprivileged umount fails with EPERM
+		return
+	}
+	assert.NoError(err)
+	_, err = os.Stat(templatePath)
+	assert.True(os.IsNotExist(err))
+}
@@ -652,11 +652,17 @@ func (fc *firecracker) fcListenToFifo(fifoName string, consumer fifoConsumer) (s
 	}
 
 	go func() {
+		var forwarder *LogForwarder
+		if consumer == nil {
+			forwarder = newSandboxLogForwarder(logSourceFirecracker, fc.vmPath)
+			defer forwarder.Close()
+		}
+
 		scanner := bufio.NewScanner(fcFifo)
 		for scanner.Scan() {
 			if consumer != nil {
 				consumer(scanner.Text())
-			} else {
+			} else if forwarder.Forward(scanner.Text()) {
 				fc.Logger().WithFields(logrus.Fields{
 					"fifoName": fifoName,
 					"contents": scanner.Text()}).Debug("read firecracker fifo")
@@ -715,7 +721,7 @@ func (fc *firecracker) fcInitConfiguration(ctx context.Context) error {
 		}...)
 	}
 
-	kernelParams := append(fc.config.KernelParams, fcKernelParams...)
+	kernelParams := append(fc.config.KernelParams, kernelRootParams(fcKernelParams, fc.config.RootfsType)...)
 	strParams := SerializeParams(kernelParams, "=")
 	formattedParams := strings.Join(strParams, " ")
 	if err := fc.fcSetBootSource(ctx, kernelPath, formattedParams); err != nil {
@@ -893,7 +899,7 @@ func (fc *firecracker) PauseVM(ctx context.Context) error {
 	return nil
 }
 
-func (fc *firecracker) SaveVM() error {
+func (fc *firecracker) SaveVM(statePath string) error {
 	return nil
 }
 
@@ -1003,7 +1009,7 @@ func (fc *firecracker) fcAddBlockDrive(ctx context.Context, drive config.BlockDr
 }
 
 // Firecracker supports replacing the host drive used once the VM has booted up
-func (fc *firecracker) fcUpdateBlockDrive(ctx context.Context, path, id string) error {
+func (fc *firecracker) fcUpdateBlockDrive(ctx context.Context, path, id string, limits *config.BlockIOLimits) error {
 	span, _ := katatrace.Trace(ctx, fc.Logger(), "fcUpdateBlockDrive", fcTracingTags, map[string]string{"sandbox_id": fc.id})
 	defer span.End()
 
@@ -1013,8 +1019,9 @@ func (fc *firecracker) fcUpdateBlockDrive(ctx context.Context, path, id string)
 	driveParams.SetDriveID(id)
 
 	driveFc := &models.PartialDrive{
-		DriveID:    &id,
-		PathOnHost: &path, //This is the only property that can be modified
+		DriveID:     &id,
+		PathOnHost:  &path,
+		RateLimiter: fcDriveRateLimiter(limits),
 	}
 
 	driveParams.SetBody(driveFc)
@@ -1025,6 +1032,34 @@ func (fc *firecracker) fcUpdateBlockDrive(ctx context.Context, path, id string)
 	return nil
 }
 
+// fcDriveRateLimiter returns the rate limiter of a drive. The drives being
+// reused, a drive without limits gets an empty rate limiter, removing the
+// limits of the previous one.
+func fcDriveRateLimiter(limits *config.BlockIOLimits) *models.RateLimiter {
+	rateLimiter := &models.RateLimiter{}
+	if limits == nil {
+		return rateLimiter
+	}
+
+	refillTime := uint64(utils.DefaultRateLimiterRefillTimeMilliSecs)
+	if limits.BPS > 0 {
+		size := limits.BPS
+		rateLimiter.Bandwidth = &models.TokenBucket{
+			RefillTime: &refillTime,
+			Size:       &size,
+		}
+	}
+	if limits.IOPS > 0 {
+		size := limits.IOPS
+		rateLimiter.Ops = &models.TokenBucket{
+			RefillTime: &refillTime,
+			Size:       &size,
+		}
+	}
+
+	return rateLimiter
+}
+
 // AddDevice will add extra devices to firecracker.  Limited to configure before the
 // virtual machine starts.  Devices include drivers and network interfaces only.
 func (fc *firecracker) AddDevice(ctx context.Context, devInfo interface{}, devType DeviceType) error {
@@ -1069,8 +1104,17 @@ func (fc *firecracker) hotplugBlockDevice(ctx context.Context, drive config.Bloc
 		return nil, fmt.Errorf("firecracker doesn't support swap")
 	}
 
+	if drive.NVMe {
+		return nil, fmt.Errorf("firecracker doesn't support NVMe namespaces")
+	}
+
+	if drive.VirtioPmem {
+		return nil, fmt.Errorf("firecracker doesn't support virtio-pmem devices")
+	}
+
 	var path string
 	var err error
+	limits := drive.IOLimits
 	driveID := fcDriveIndexToID(drive.Index)
 
 	if op == AddDevice {
@@ -1091,9 +1135,10 @@ func (fc *firecracker) hotplugBlockDevice(ctx context.Context, drive config.Bloc
 		} else {
 			path = filepath.Join(fc.jailerRoot, driveID)
 		}
+		limits = nil
 	}
 
-	return nil, fc.fcUpdateBlockDrive(ctx, path, driveID)
+	return nil, fc.fcUpdateBlockDrive(ctx, path, driveID, limits)
 }
 
 // hotplugAddDevice supported in Firecracker VMM
@@ -1167,6 +1212,20 @@ func (fc *firecracker) ResizeVCPUs(ctx context.Context, reqVCPUs uint32) (curren
 	return 0, 0, nil
 }
 
+// ResizeBlockDevice makes firecracker read the size of a hot plugged drive
+// again by updating it with its current path.
+func (fc *firecracker) ResizeBlockDevice(ctx context.Context, drive *config.BlockDrive, size uint64) error {
+	driveID := fcDriveIndexToID(drive.Index)
+
+	path := filepath.Join(fc.jailerRoot, driveID)
+	if fc.jailed {
+		// use path relative to the jail
+		path = filepath.Join("/", driveID)
+	}
+
+	return fc.fcUpdateBlockDrive(ctx, path, driveID, drive.IOLimits)
+}
+
 // This is used to apply cgroup information on the host.
 //
 // As suggested by https://github.com/firecracker-microvm/firecracker/issues/718,
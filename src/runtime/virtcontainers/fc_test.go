@@ -12,6 +12,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -82,3 +83,22 @@ func TestFcSetConfig(t *testing.T) {
 
 	assert.Equal(fc.config, config)
 }
+
+func TestFCDriveRateLimiter(t *testing.T) {
+	assert := assert.New(t)
+
+	// No limits, the limits of a previous drive are removed
+	rateLimiter := fcDriveRateLimiter(nil)
+	assert.NotNil(rateLimiter)
+	assert.Nil(rateLimiter.Bandwidth)
+	assert.Nil(rateLimiter.Ops)
+
+	rateLimiter = fcDriveRateLimiter(&config.BlockIOLimits{IOPS: 1000})
+	assert.Nil(rateLimiter.Bandwidth)
+	assert.Equal(uint64(1000), *rateLimiter.Ops.Size)
+	assert.Equal(uint64(1000), *rateLimiter.Ops.RefillTime)
+
+	rateLimiter = fcDriveRateLimiter(&config.BlockIOLimits{IOPS: 1000, BPS: 10 << 20})
+	assert.Equal(uint64(10<<20), *rateLimiter.Bandwidth.Size)
+	assert.Equal(uint64(1000), *rateLimiter.Ops.Size)
+}
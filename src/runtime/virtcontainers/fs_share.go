@@ -23,6 +23,8 @@ var fsShareTracingTags = map[string]string{
 type SharedFile struct {
 	storage   *grpc.Storage
 	guestPath string
+	// storages mounted by the agent before storage, which is built on them
+	deps []*grpc.Storage
 }
 
 type FilesystemSharer interface {
@@ -26,6 +26,7 @@ import (
 )
 
 func unmountNoFollow(path string) error {
+	defer watchOperation(slowOperationMount, "unmount", path)()
 	return syscall.Unmount(path, syscall.MNT_DETACH|UmountNoFollow)
 }
 
@@ -169,6 +170,28 @@ func (f *FilesystemShare) Prepare(ctx context.Context) error {
 		}
 	}()
 
+	if f.useVolumesVirtiofsd() {
+		volumesSharePath := GetVolumesSharePath(f.sandbox.ID())
+		volumesMountPath := getVolumesMountPath(f.sandbox.ID())
+		if err = os.MkdirAll(volumesSharePath, sharedDirMode); err != nil {
+			return err
+		}
+		if err = os.MkdirAll(volumesMountPath, DirMode); err != nil {
+			return err
+		}
+
+		if err = bindMount(ctx, volumesMountPath, volumesSharePath, true, "slave"); err != nil {
+			return err
+		}
+		defer func() {
+			if err != nil {
+				if umountErr := unmountNoFollow(volumesSharePath); umountErr != nil {
+					f.Logger().WithError(umountErr).Errorf("failed to unmount vm volumes share path %s", volumesSharePath)
+				}
+			}
+		}()
+	}
+
 	// Setup sandbox bindmounts, if specified.
 	if err = f.prepareBindMounts(ctx); err != nil {
 		return err
@@ -177,6 +200,21 @@ func (f *FilesystemShare) Prepare(ctx context.Context) error {
 	return nil
 }
 
+// useVolumesVirtiofsd returns true if the shared files are shared by their own
+// virtio-fs daemon, rather than along with the root filesystems.
+func (f *FilesystemShare) useVolumesVirtiofsd() bool {
+	return f.sandbox.config != nil && useVolumesVirtiofsd(&f.sandbox.config.HypervisorConfig)
+}
+
+// shareByVolumesVirtiofsd returns true if the mount is shared by the virtio-fs
+// daemon of the shared files, given the cache policy the mount asks for.
+func (f *FilesystemShare) shareByVolumesVirtiofsd(m *Mount) (bool, error) {
+	if f.sandbox.config == nil {
+		return false, nil
+	}
+	return shareByVolumesVirtiofsd(&f.sandbox.config.HypervisorConfig, m)
+}
+
 func (f *FilesystemShare) Cleanup(ctx context.Context) error {
 	var err error
 
@@ -202,6 +240,14 @@ func (f *FilesystemShare) Cleanup(ctx context.Context) error {
 		return err
 	}
 
+	if f.useVolumesVirtiofsd() {
+		path := GetVolumesSharePath(f.sandbox.ID())
+		if err = unmountNoFollow(path); err != nil {
+			f.Logger().WithError(err).Errorf("failed to unmount vm volumes share path %s", path)
+			return err
+		}
+	}
+
 	// Unmount shared path
 	path := GetSharePath(f.sandbox.ID())
 	f.Logger().WithField("path", path).Infof("Cleanup agent")
@@ -232,6 +278,15 @@ func (f *FilesystemShare) ShareFile(ctx context.Context, c *Container, m *Mount)
 
 	filename := fmt.Sprintf("%s-%s-%s", c.id, hex.EncodeToString(randBytes), filepath.Base(m.Destination))
 	guestPath := filepath.Join(kataGuestSharedDir(), filename)
+	mountPath := getMountPath(f.sandbox.ID())
+	useVolumes, err := f.shareByVolumesVirtiofsd(m)
+	if err != nil {
+		return nil, err
+	}
+	if useVolumes {
+		guestPath = filepath.Join(kataGuestVolumesDir(), filename)
+		mountPath = getVolumesMountPath(f.sandbox.ID())
+	}
 
 	// copy file to container's rootfs if filesystem sharing is not supported, otherwise
 	// bind mount it in the shared directory.
@@ -258,7 +313,7 @@ func (f *FilesystemShare) ShareFile(ctx context.Context, c *Container, m *Mount)
 		}
 	} else {
 		// These mounts are created in the shared dir
-		mountDest := filepath.Join(getMountPath(f.sandbox.ID()), filename)
+		mountDest := filepath.Join(mountPath, filename)
 		if !m.ReadOnly {
 			if err := bindMount(ctx, m.Source, mountDest, false, "private"); err != nil {
 				return nil, err
@@ -370,6 +425,42 @@ func (f *FilesystemShare) shareRootFilesystemWithNydus(ctx context.Context, c *C
 }
 
 //func (c *Container) shareRootfs(ctx context.Context) (*grpc.Storage, string, error) {
+// blockDeviceStorage returns the storage of a block device attached to the
+// VM, for the agent to find it in the guest.
+func (f *FilesystemShare) blockDeviceStorage(devID string) (*grpc.Storage, *config.BlockDrive, error) {
+	device := f.sandbox.devManager.GetDeviceByID(devID)
+	if device == nil {
+		f.Logger().WithField("device", devID).Error("failed to find device by id")
+		return nil, nil, fmt.Errorf("failed to find device by id %q", devID)
+	}
+
+	blockDrive, ok := device.GetDeviceInfo().(*config.BlockDrive)
+	if !ok || blockDrive == nil {
+		f.Logger().Error("malformed block drive")
+		return nil, nil, fmt.Errorf("malformed block drive")
+	}
+
+	storage := &grpc.Storage{}
+	switch {
+	case f.sandbox.config.HypervisorConfig.BlockDeviceDriver == config.VirtioMmio:
+		storage.Driver = kataMmioBlkDevType
+		storage.Source = blockDrive.VirtPath
+	case f.sandbox.config.HypervisorConfig.BlockDeviceDriver == config.VirtioBlockCCW:
+		storage.Driver = kataBlkCCWDevType
+		storage.Source = blockDrive.DevNo
+	case f.sandbox.config.HypervisorConfig.BlockDeviceDriver == config.VirtioBlock:
+		storage.Driver = kataBlkDevType
+		storage.Source = blockDrive.PCIPath.String()
+	case f.sandbox.config.HypervisorConfig.BlockDeviceDriver == config.VirtioSCSI:
+		storage.Driver = kataSCSIDevType
+		storage.Source = blockDrive.SCSIAddr
+	default:
+		return nil, nil, fmt.Errorf("Unknown block device driver: %s", f.sandbox.config.HypervisorConfig.BlockDeviceDriver)
+	}
+
+	return storage, blockDrive, nil
+}
+
 func (f *FilesystemShare) ShareRootFilesystem(ctx context.Context, c *Container) (*SharedFile, error) {
 	if c.rootFs.Type == NydusRootFSType {
 		return f.shareRootFilesystemWithNydus(ctx, c)
@@ -382,35 +473,10 @@ func (f *FilesystemShare) ShareRootFilesystem(ctx context.Context, c *Container)
 		// It can be a block based device (when using block based container
 		// overlay on the host) mount or a 9pfs one (for all other overlay
 		// implementations).
-		rootfsStorage := &grpc.Storage{}
-
 		// This is a block based device rootfs.
-		device := f.sandbox.devManager.GetDeviceByID(c.state.BlockDeviceID)
-		if device == nil {
-			f.Logger().WithField("device", c.state.BlockDeviceID).Error("failed to find device by id")
-			return nil, fmt.Errorf("failed to find device by id %q", c.state.BlockDeviceID)
-		}
-
-		blockDrive, ok := device.GetDeviceInfo().(*config.BlockDrive)
-		if !ok || blockDrive == nil {
-			f.Logger().Error("malformed block drive")
-			return nil, fmt.Errorf("malformed block drive")
-		}
-		switch {
-		case f.sandbox.config.HypervisorConfig.BlockDeviceDriver == config.VirtioMmio:
-			rootfsStorage.Driver = kataMmioBlkDevType
-			rootfsStorage.Source = blockDrive.VirtPath
-		case f.sandbox.config.HypervisorConfig.BlockDeviceDriver == config.VirtioBlockCCW:
-			rootfsStorage.Driver = kataBlkCCWDevType
-			rootfsStorage.Source = blockDrive.DevNo
-		case f.sandbox.config.HypervisorConfig.BlockDeviceDriver == config.VirtioBlock:
-			rootfsStorage.Driver = kataBlkDevType
-			rootfsStorage.Source = blockDrive.PCIPath.String()
-		case f.sandbox.config.HypervisorConfig.BlockDeviceDriver == config.VirtioSCSI:
-			rootfsStorage.Driver = kataSCSIDevType
-			rootfsStorage.Source = blockDrive.SCSIAddr
-		default:
-			return nil, fmt.Errorf("Unknown block device driver: %s", f.sandbox.config.HypervisorConfig.BlockDeviceDriver)
+		rootfsStorage, blockDrive, err := f.blockDeviceStorage(c.state.BlockDeviceID)
+		if err != nil {
+			return nil, err
 		}
 
 		// We can't use filepath.Dir(rootfsGuestPath) (The rootfs parent) because
@@ -422,6 +488,7 @@ func (f *FilesystemShare) ShareRootFilesystem(ctx context.Context, c *Container)
 		if c.state.Fstype == "xfs" {
 			rootfsStorage.Options = []string{"nouuid"}
 		}
+		rootfsStorage.Options = withDiscardOption(&f.sandbox.config.HypervisorConfig, c.state.Fstype, blockDrive.ReadOnly, rootfsStorage.Options)
 
 		// Ensure container mount destination exists
 		// TODO: remove dependency on shared fs path. shared fs is just one kind of storage source.
@@ -437,6 +504,21 @@ func (f *FilesystemShare) ShareRootFilesystem(ctx context.Context, c *Container)
 		}, nil
 	}
 
+	if c.state.ScratchDeviceID != "" {
+		return f.shareRootFilesystemWithBlockLayers(c)
+	}
+
+	if len(c.state.LayerDeviceIDs) > 0 {
+		return f.shareRootFilesystemWithErofsLayers(ctx, c)
+	}
+
+	if c.useLayerCache() {
+		// The rootfs is shared as a whole if the layer cache can't be used
+		if shared, err := f.shareRootFilesystemWithLayerCache(ctx, c); shared != nil || err != nil {
+			return shared, err
+		}
+	}
+
 	// This is not a block based device rootfs. We are going to bind mount it into the shared drive
 	// between the host and the guest.
 	// With virtiofs/9pfs we don't need to ask the agent to mount the rootfs as the shared directory
@@ -457,6 +539,14 @@ func (f *FilesystemShare) UnshareRootFilesystem(ctx context.Context, c *Containe
 		if err2 := nydusContainerCleanup(ctx, getMountPath(c.sandbox.id), c); err2 != nil {
 			f.Logger().WithError(err2).Error("rollback failed nydusContainerCleanup")
 		}
+	} else if _, err := os.Stat(filepath.Join(getMountPath(f.sandbox.ID()), c.id, layersDir)); err == nil {
+		if err := layerCacheContainerCleanup(ctx, getMountPath(f.sandbox.ID()), c); err != nil {
+			return err
+		}
+	} else if _, err := os.Stat(filepath.Join(getMountPath(f.sandbox.ID()), c.id, snapshotDir)); err == nil {
+		if err := erofsLayersContainerCleanup(ctx, getMountPath(f.sandbox.ID()), c); err != nil {
+			return err
+		}
 	} else {
 		if err := bindUnmountContainerRootfs(ctx, getMountPath(f.sandbox.ID()), c.id); err != nil {
 			return err
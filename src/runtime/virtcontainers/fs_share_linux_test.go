@@ -14,6 +14,7 @@ import (
 	"syscall"
 	"testing"
 
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -96,3 +97,62 @@ func TestSandboxSharedFilesystem(t *testing.T) {
 	err = sandbox.fsShare.Cleanup(sandbox.ctx)
 	assert.NoError(err)
 }
+
+func TestSandboxSharedFilesystemVolumes(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("Test disabled as requires root user")
+	}
+
+	assert := assert.New(t)
+
+	kataHostSharedDirSaved := kataHostSharedDir
+	testHostDir := t.TempDir()
+	kataHostSharedDir = func() string {
+		return testHostDir
+	}
+	defer func() {
+		kataHostSharedDir = kataHostSharedDirSaved
+	}()
+
+	volume := t.TempDir()
+	err := os.WriteFile(filepath.Join(volume, "data"), []byte("data"), 0644)
+	assert.NoError(err)
+
+	sandbox := &Sandbox{
+		ctx:        context.Background(),
+		id:         "foobar",
+		hypervisor: &mockHypervisor{},
+		config: &SandboxConfig{
+			HypervisorConfig: HypervisorConfig{
+				SharedFS:              config.VirtioFS,
+				VirtioFSVolumesDaemon: true,
+			},
+		},
+	}
+
+	fsShare, err := NewFilesystemShare(sandbox)
+	assert.NoError(err)
+	sandbox.fsShare = fsShare
+
+	err = sandbox.fsShare.Prepare(sandbox.ctx)
+	assert.NoError(err)
+
+	c := &Container{id: "c1", sandbox: sandbox}
+	m := &Mount{Source: volume, Destination: "/data", Type: "bind"}
+	sharedFile, err := sandbox.fsShare.ShareFile(sandbox.ctx, c, m)
+	assert.NoError(err)
+
+	// The volume is shared by its own virtiofsd
+	assert.Equal(kataGuestVolumesDir(), filepath.Dir(sharedFile.guestPath)+"/")
+	assert.Equal(getVolumesMountPath(sandbox.id), filepath.Dir(m.HostPath))
+	_, err = os.Stat(filepath.Join(m.HostPath, "data"))
+	assert.NoError(err)
+
+	err = sandbox.fsShare.UnshareFile(sandbox.ctx, c, m)
+	assert.NoError(err)
+
+	err = sandbox.fsShare.Cleanup(sandbox.ctx)
+	assert.NoError(err)
+	_, err = os.Stat(getSandboxPath(sandbox.id))
+	assert.True(os.IsNotExist(err))
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/grpc"
+)
+
+// diagnosticsTruncatedFile lists the files of the diagnostics bundle cut by
+// the agent to fit in its maximum size.
+const diagnosticsTruncatedFile = "TRUNCATED"
+
+// diagnosticsCoresDir is the directory of the core dumps in the bundle.
+const diagnosticsCoresDir = "cores"
+
+// coreDumpReader reads a core dump of the container from offset.
+type coreDumpReader func(name string, offset uint64) (*grpc.DiagnosticsFile, error)
+
+// CollectDiagnostics writes a gzip compressed tar archive of the guest
+// /proc snapshots, kernel log and agent logs, and if containerID is set, of
+// the /proc snapshots of the processes of the container and of its core
+// dumps. The core dumps truncated by the agent are read by parts.
+func (s *Sandbox) CollectDiagnostics(ctx context.Context, containerID string, w io.Writer) error {
+	if containerID != "" {
+		if _, err := s.findContainer(containerID); err != nil {
+			return err
+		}
+	}
+
+	diagnostics, err := s.agent.getDiagnostics(ctx, containerID)
+	if err != nil {
+		return err
+	}
+
+	truncated, err := writeDiagnostics(w, diagnostics, func(name string, offset uint64) (*grpc.DiagnosticsFile, error) {
+		return s.agent.readCoreDump(ctx, containerID, name, offset)
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(truncated) > 0 {
+		s.Logger().WithFields(logrus.Fields{
+			"container": containerID,
+			"files":     truncated,
+		}).Warn("Some diagnostics files are truncated")
+	}
+
+	return nil
+}
+
+// diagnosticsFileName returns the name of a file of the diagnostics in the
+// bundle, and for a core dump, its name in the guest.
+func diagnosticsFileName(name string) (string, string, error) {
+	if name == "" || path.IsAbs(name) || path.Clean(name) != name || name == ".." || strings.HasPrefix(name, "../") {
+		return "", "", fmt.Errorf("invalid diagnostics file name %q", name)
+	}
+
+	if dir, core := path.Split(name); dir == diagnosticsCoresDir+"/" {
+		return name, core, nil
+	}
+
+	return name, "", nil
+}
+
+// writeDiagnostics writes the diagnostics to w, reading the remaining parts
+// of the core dumps truncated by the agent with readCoreDump. It returns the
+// files that are still truncated.
+func writeDiagnostics(w io.Writer, diagnostics *grpc.Diagnostics, readCoreDump coreDumpReader) ([]string, error) {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	now := time.Now()
+
+	var truncated []string
+	for _, f := range diagnostics.Files {
+		name, core, err := diagnosticsFileName(f.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		complete := !f.Truncated
+		if core != "" && f.Size_ > uint64(len(f.Data)) {
+			complete, err = writeCoreDump(tw, name, core, f, now, readCoreDump)
+		} else {
+			err = writeDiagnosticsFile(tw, name, f.Data, now)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !complete {
+			truncated = append(truncated, name)
+		}
+	}
+
+	if len(truncated) > 0 {
+		data := []byte(strings.Join(truncated, "\n") + "\n")
+		if err := writeDiagnosticsFile(tw, diagnosticsTruncatedFile, data, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	return truncated, gw.Close()
+}
+
+func writeDiagnosticsFile(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+
+	_, err := tw.Write(data)
+	return err
+}
+
+// writeCoreDump writes a core dump of which the agent returned the first
+// part, reading the others. A core dump that cannot be read completely is
+// padded with zeros to its size, and reported as incomplete.
+func writeCoreDump(tw *tar.Writer, name, core string, f *grpc.DiagnosticsFile, modTime time.Time, readCoreDump coreDumpReader) (bool, error) {
+	size := f.Size_
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(size),
+		ModTime: modTime,
+	}
+
+	if err := tw.WriteHeader(hdr); err != nil {
+		return false, err
+	}
+
+	data := f.Data
+	offset := uint64(0)
+	for {
+		if uint64(len(data)) > size-offset {
+			data = data[:size-offset]
+		}
+
+		if _, err := tw.Write(data); err != nil {
+			return false, err
+		}
+		offset += uint64(len(data))
+
+		if offset == size {
+			return true, nil
+		}
+
+		part, err := readCoreDump(core, offset)
+		if err != nil || len(part.Data) == 0 {
+			virtLog.WithError(err).WithField("core-dump", name).Warn("Could not read the whole core dump")
+			break
+		}
+		data = part.Data
+	}
+
+	if _, err := io.CopyN(tw, zeroReader{}, int64(size-offset)); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// zeroReader reads zeros.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
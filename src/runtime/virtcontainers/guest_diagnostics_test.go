@@ -0,0 +1,110 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/grpc"
+)
+
+// readDiagnostics returns the files of a diagnostics bundle.
+func readDiagnostics(t *testing.T, r io.Reader) map[string]string {
+	gr, err := gzip.NewReader(r)
+	assert.NoError(t, err)
+
+	files := make(map[string]string)
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+
+		data, err := io.ReadAll(tr)
+		assert.NoError(t, err)
+		files[hdr.Name] = string(data)
+	}
+
+	return files
+}
+
+func TestWriteDiagnostics(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	truncated, err := writeDiagnostics(&buf, &grpc.Diagnostics{
+		Files: []*grpc.DiagnosticsFile{
+			{Name: "proc/meminfo", Data: []byte("MemTotal: 2048 kB\n")},
+			{Name: "kmsg", Data: []byte("[1] boot"), Truncated: true},
+		},
+	}, nil)
+	assert.NoError(err)
+	assert.Equal([]string{"kmsg"}, truncated)
+
+	assert.Equal(map[string]string{
+		"proc/meminfo":           "MemTotal: 2048 kB\n",
+		"kmsg":                   "[1] boot",
+		diagnosticsTruncatedFile: "kmsg\n",
+	}, readDiagnostics(t, &buf))
+}
+
+func TestWriteDiagnosticsCoreDumps(t *testing.T) {
+	assert := assert.New(t)
+
+	core := "0123456789"
+	var reads []string
+	readCoreDump := func(name string, offset uint64) (*grpc.DiagnosticsFile, error) {
+		reads = append(reads, name)
+		if name != "core.2" {
+			return nil, errors.New("no such core dump")
+		}
+
+		end := offset + 4
+		if end > uint64(len(core)) {
+			end = uint64(len(core))
+		}
+		return &grpc.DiagnosticsFile{Data: []byte(core[offset:end]), Size_: uint64(len(core))}, nil
+	}
+
+	var buf bytes.Buffer
+	truncated, err := writeDiagnostics(&buf, &grpc.Diagnostics{
+		Files: []*grpc.DiagnosticsFile{
+			{Name: "cores/core.1", Data: []byte("ELF")},
+			{Name: "cores/core.2", Data: []byte("01"), Size_: 10, Truncated: true},
+			{Name: "cores/core.3", Data: []byte("01"), Size_: 5, Truncated: true},
+		},
+	}, readCoreDump)
+	assert.NoError(err)
+	assert.Equal([]string{"cores/core.3"}, truncated)
+	assert.Equal([]string{"core.2", "core.2", "core.3"}, reads)
+
+	assert.Equal(map[string]string{
+		"cores/core.1":           "ELF",
+		"cores/core.2":           core,
+		"cores/core.3":           "01\x00\x00\x00",
+		diagnosticsTruncatedFile: "cores/core.3\n",
+	}, readDiagnostics(t, &buf))
+}
+
+func TestWriteDiagnosticsInvalidNames(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, name := range []string{"", "/etc/passwd", "..", "../kmsg", "cores/../../kmsg", "proc//meminfo", "./kmsg"} {
+		_, err := writeDiagnostics(io.Discard, &grpc.Diagnostics{
+			Files: []*grpc.DiagnosticsFile{{Name: name}},
+		}, nil)
+		assert.Error(err, name)
+	}
+}
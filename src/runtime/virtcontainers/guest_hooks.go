@@ -0,0 +1,76 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+
+	vcAnnotations "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/annotations"
+)
+
+// guestHooks returns the hooks the container annotations declare to run in
+// the guest, or nil if there are none.
+func guestHooks(annotations map[string]string) (*specs.Hooks, error) {
+	value, ok := annotations[vcAnnotations.ContainerGuestHooks]
+	if !ok {
+		return nil, nil
+	}
+
+	var hooks specs.Hooks
+	if err := json.Unmarshal([]byte(value), &hooks); err != nil {
+		return nil, fmt.Errorf("Invalid container configuration Annotations %s %v", vcAnnotations.ContainerGuestHooks, err)
+	}
+
+	if len(hooks.Poststart) > 0 || len(hooks.CreateRuntime) > 0 || len(hooks.CreateContainer) > 0 || len(hooks.StartContainer) > 0 {
+		return nil, fmt.Errorf("Invalid container configuration Annotations %s: only prestart and poststop guest hooks are supported", vcAnnotations.ContainerGuestHooks)
+	}
+
+	for _, hook := range append(hooks.Prestart, hooks.Poststop...) {
+		if hook.Path == "" {
+			return nil, fmt.Errorf("Invalid container configuration Annotations %s: missing hook path", vcAnnotations.ContainerGuestHooks)
+		}
+	}
+
+	return &hooks, nil
+}
+
+// checkGuestHooks ensures the guest hooks of the container are valid and
+// allowed.
+func (c *Container) checkGuestHooks() error {
+	hooks, err := guestHooks(c.config.Annotations)
+	if err != nil || hooks == nil {
+		return err
+	}
+
+	if !c.sandbox.config.EnableGuestHooks {
+		return fmt.Errorf("The guest hooks of container %s are not allowed, enable_guest_hooks is not set", c.id)
+	}
+
+	return nil
+}
+
+// runGuestHooks runs the prestart or poststop guest hooks of the container.
+func (c *Container) runGuestHooks(ctx context.Context, poststop bool) error {
+	hooks, err := guestHooks(c.config.Annotations)
+	if err != nil || hooks == nil {
+		return err
+	}
+
+	list := hooks.Prestart
+	if poststop {
+		list = hooks.Poststop
+	}
+
+	if len(list) == 0 {
+		return nil
+	}
+
+	return c.sandbox.agent.executeHooks(ctx, c.id, list)
+}
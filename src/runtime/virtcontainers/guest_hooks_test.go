@@ -0,0 +1,60 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	vcAnnotations "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/annotations"
+)
+
+func TestGuestHooks(t *testing.T) {
+	assert := assert.New(t)
+
+	hooks, err := guestHooks(map[string]string{})
+	assert.NoError(err)
+	assert.Nil(hooks)
+
+	hooks, err = guestHooks(map[string]string{
+		vcAnnotations.ContainerGuestHooks: `{"prestart": [{"path": "/sbin/sysctl", "args": ["sysctl", "-w", "vm.max_map_count=262144"], "timeout": 5}]}`,
+	})
+	assert.NoError(err)
+	assert.Len(hooks.Prestart, 1)
+	assert.Equal("/sbin/sysctl", hooks.Prestart[0].Path)
+	assert.Equal(5, *hooks.Prestart[0].Timeout)
+	assert.Empty(hooks.Poststop)
+
+	for _, value := range []string{
+		`{"prestart": [{"args": ["true"]}]}`,
+		`{"poststart": [{"path": "/bin/true"}]}`,
+		`[]`,
+	} {
+		_, err = guestHooks(map[string]string{vcAnnotations.ContainerGuestHooks: value})
+		assert.Error(err, value)
+	}
+}
+
+func TestCheckGuestHooks(t *testing.T) {
+	assert := assert.New(t)
+
+	c := &Container{
+		sandbox: &Sandbox{
+			config: &SandboxConfig{},
+		},
+		config: &ContainerConfig{
+			Annotations: map[string]string{
+				vcAnnotations.ContainerGuestHooks: `{"poststop": [{"path": "/bin/true"}]}`,
+			},
+		},
+	}
+
+	assert.Error(c.checkGuestHooks())
+
+	c.sandbox.config.EnableGuestHooks = true
+	assert.NoError(c.checkGuestHooks())
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"time"
+)
+
+// GuestKernelLogRecord is a record of the guest kernel log.
+type GuestKernelLogRecord struct {
+	Message string
+	// Syslog level and facility of the record
+	Level    uint32
+	Facility uint32
+	Sequence uint64
+	// Time of the record since the guest booted
+	Timestamp time.Duration
+}
+
+// ReadGuestKernelLog waits for the next records of the guest kernel log.
+// The first call returns the records since the guest booted, as far as the
+// guest kernel log buffer holds them.
+func (s *Sandbox) ReadGuestKernelLog(ctx context.Context) ([]GuestKernelLogRecord, error) {
+	records, err := s.agent.readKernelLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var log []GuestKernelLogRecord
+	for _, r := range records {
+		log = append(log, GuestKernelLogRecord{
+			Message:   r.Message,
+			Level:     r.Level,
+			Facility:  r.Facility,
+			Sequence:  r.Sequence,
+			Timestamp: time.Duration(r.TimestampUsec) * time.Microsecond,
+		})
+	}
+
+	return log, nil
+}
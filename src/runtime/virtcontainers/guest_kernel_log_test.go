@@ -0,0 +1,53 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/grpc"
+)
+
+type kernelLogMockAgent struct {
+	mockAgent
+	records []*grpc.KernelLogRecord
+}
+
+func (k *kernelLogMockAgent) readKernelLog(ctx context.Context) ([]*grpc.KernelLogRecord, error) {
+	return k.records, nil
+}
+
+func TestReadGuestKernelLog(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &Sandbox{
+		agent: &kernelLogMockAgent{
+			records: []*grpc.KernelLogRecord{
+				{
+					Level:         4,
+					Sequence:      1200,
+					TimestampUsec: 5140900,
+					Message:       "Memory cgroup out of memory: Killed process 1042 (stress)",
+				},
+			},
+		},
+	}
+
+	log, err := s.ReadGuestKernelLog(context.Background())
+	assert.NoError(err)
+	assert.Equal([]GuestKernelLogRecord{
+		{
+			Message:   "Memory cgroup out of memory: Killed process 1042 (stress)",
+			Level:     4,
+			Sequence:  1200,
+			Timestamp: 5140900 * time.Microsecond,
+		},
+	}, log)
+}
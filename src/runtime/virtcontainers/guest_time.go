@@ -0,0 +1,108 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
+)
+
+const (
+	// GuestTimeSourcePTPKVM keeps the guest clock in sync with the host one,
+	// through the KVM PTP clock.
+	GuestTimeSourcePTPKVM = "ptp_kvm"
+
+	// GuestTimeSourceNTP keeps the guest clock in sync with NTP servers.
+	GuestTimeSourceNTP = "ntp"
+)
+
+// hostSuspendTime returns the time the host spent suspended since it booted,
+// which the boot clock counts, unlike the monotonic one.
+func hostSuspendTime() (time.Duration, error) {
+	var boot, mono unix.Timespec
+
+	if err := unix.ClockGettime(unix.CLOCK_BOOTTIME, &boot); err != nil {
+		return 0, err
+	}
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &mono); err != nil {
+		return 0, err
+	}
+
+	suspend := time.Duration(boot.Nano() - mono.Nano())
+	// both clocks are not read at once
+	return suspend.Truncate(time.Second), nil
+}
+
+// guestTimeSynced records the time the guest clock was set to.
+func (s *Sandbox) guestTimeSynced(now time.Time) {
+	s.timeSyncLock.Lock()
+	defer s.timeSyncLock.Unlock()
+
+	s.lastTimeSync = now
+	s.hostSuspendTime, _ = hostSuspendTime()
+}
+
+// setGuestTime sets the guest clock to the host one.
+func (s *Sandbox) setGuestTime(ctx context.Context) error {
+	now := time.Now()
+
+	if err := s.agent.setGuestDateTime(ctx, now); err != nil {
+		return err
+	}
+
+	s.guestTimeSynced(now)
+
+	return nil
+}
+
+// GuestTimeSyncDue tells if the guest clock must be set to the host one,
+// when the host was suspended since the last sync, the guest clock then
+// being late, or when the configured sync interval elapsed.
+func (s *Sandbox) GuestTimeSyncDue() (bool, error) {
+	if s.state.State != types.StateRunning {
+		return false, nil
+	}
+
+	suspend, err := hostSuspendTime()
+	if err != nil {
+		return false, err
+	}
+
+	s.timeSyncLock.Lock()
+	defer s.timeSyncLock.Unlock()
+
+	resumed := suspend != s.hostSuspendTime
+	due := s.config.GuestTimeSyncInterval > 0 && time.Since(s.lastTimeSync) >= s.config.GuestTimeSyncInterval
+	if resumed {
+		s.Logger().Info("host resumed from suspend")
+	}
+
+	return resumed || due, nil
+}
+
+// SyncGuestTime sets the guest clock to the host one. It does not need the
+// sandbox to be locked, the agent request possibly being slow.
+func (s *Sandbox) SyncGuestTime(ctx context.Context) error {
+	s.Logger().Info("sync guest time")
+
+	return s.setGuestTime(ctx)
+}
+
+// setGuestTimeSource starts keeping the guest clock in sync with the
+// configured time source, if any.
+func (s *Sandbox) setGuestTimeSource(ctx context.Context) error {
+	if s.config.GuestTimeSource == "" {
+		return nil
+	}
+
+	s.Logger().WithField("source", s.config.GuestTimeSource).Info("set guest time source")
+
+	return s.agent.setGuestTimeSource(ctx, s.config.GuestTimeSource, s.config.GuestNTPServers)
+}
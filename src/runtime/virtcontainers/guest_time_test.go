@@ -0,0 +1,73 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
+)
+
+func TestHostSuspendTime(t *testing.T) {
+	suspend, err := hostSuspendTime()
+	assert.NoError(t, err)
+	assert.True(t, suspend >= 0)
+}
+
+func TestSyncGuestTime(t *testing.T) {
+	assert := assert.New(t)
+
+	suspend, err := hostSuspendTime()
+	assert.NoError(err)
+
+	synced := time.Now().Add(-time.Minute)
+	s := &Sandbox{
+		agent:           &mockAgent{},
+		config:          &SandboxConfig{},
+		state:           types.SandboxState{State: types.StateRunning},
+		lastTimeSync:    synced,
+		hostSuspendTime: suspend,
+	}
+
+	// no suspend and no periodic sync
+	due, err := s.GuestTimeSyncDue()
+	assert.NoError(err)
+	assert.False(due)
+
+	// periodic sync
+	s.config.GuestTimeSyncInterval = 30 * time.Second
+	due, err = s.GuestTimeSyncDue()
+	assert.NoError(err)
+	assert.True(due)
+	assert.NoError(s.SyncGuestTime(context.Background()))
+	assert.True(s.lastTimeSync.After(synced))
+
+	due, err = s.GuestTimeSyncDue()
+	assert.NoError(err)
+	assert.False(due)
+
+	// host resumed from suspend
+	s.config.GuestTimeSyncInterval = 0
+	synced = s.lastTimeSync
+	s.hostSuspendTime = suspend + time.Hour
+	due, err = s.GuestTimeSyncDue()
+	assert.NoError(err)
+	assert.True(due)
+	assert.NoError(s.SyncGuestTime(context.Background()))
+	assert.True(s.lastTimeSync.After(synced))
+	assert.Equal(suspend, s.hostSuspendTime)
+
+	// not running
+	s.state.State = types.StatePaused
+	s.hostSuspendTime = suspend + time.Hour
+	due, err = s.GuestTimeSyncDue()
+	assert.NoError(err)
+	assert.False(due)
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+
+	resCtrl "github.com/kata-containers/kata-containers/src/runtime/pkg/resourcecontrol"
+)
+
+// The names of the resource controllers of the helper processes of the
+// sandbox, in the one of the VMM.
+const (
+	virtiofsdResCtrlName = "virtiofsd"
+	shimResCtrlName      = "shim"
+
+	// vmmResCtrlName holds the VMM on cgroup v2, which does not allow
+	// processes in a cgroup with controllers enabled for its children.
+	vmmResCtrlName = "vmm"
+
+	helperCPUPeriod = 100000
+)
+
+// virtiofsdPidsGetter is implemented by the hypervisors starting virtiofsd
+// daemons, for the sandbox to constrain them.
+type virtiofsdPidsGetter interface {
+	virtiofsdPids() []int
+}
+
+// helperLimits are the constraints of a helper process of the sandbox: its
+// CPU weight, from 1 to 10000, relative to the other processes of the VMM
+// resource controller, its CPU time in thousandths of CPU, and its memory.
+type helperLimits struct {
+	cpuWeight uint32
+	milliCPUs uint32
+	memoryMB  uint32
+}
+
+// resources returns the constraints of a helper process, or nil if it is
+// not constrained.
+func (l helperLimits) resources() *specs.LinuxResources {
+	if l.cpuWeight == 0 && l.milliCPUs == 0 && l.memoryMB == 0 {
+		return nil
+	}
+
+	resources := &specs.LinuxResources{}
+
+	if l.cpuWeight > 0 || l.milliCPUs > 0 {
+		resources.CPU = &specs.LinuxCPU{}
+	}
+
+	if l.cpuWeight > 0 {
+		// cgroup v1 shares, converted back to the weight on cgroup v2
+		shares := cpuWeightToShares(l.cpuWeight)
+		resources.CPU.Shares = &shares
+	}
+
+	if l.milliCPUs > 0 {
+		period := uint64(helperCPUPeriod)
+		quota := int64(l.milliCPUs) * helperCPUPeriod / 1000
+		resources.CPU.Period = &period
+		resources.CPU.Quota = &quota
+	}
+
+	if l.memoryMB > 0 {
+		limit := int64(l.memoryMB) << 20
+		resources.Memory = &specs.LinuxMemory{
+			Limit: &limit,
+		}
+	}
+
+	return resources
+}
+
+// cpuWeightToShares converts a cgroup v2 CPU weight to cgroup v1 shares, as
+// the inverse of the conversion of runc, rounded up for the weight to be
+// preserved on cgroup v2.
+func cpuWeightToShares(weight uint32) uint64 {
+	if weight > 10000 {
+		weight = 10000
+	}
+	return 2 + ((uint64(weight)-1)*262142+9998)/9999
+}
+
+// virtiofsdLimits returns the constraints of the virtiofsd daemons.
+func virtiofsdLimits(conf *HypervisorConfig) helperLimits {
+	return helperLimits{
+		cpuWeight: conf.VirtioFSCPUWeight,
+		milliCPUs: conf.VirtioFSMilliCPUs,
+		memoryMB:  conf.VirtioFSMemoryMB,
+	}
+}
+
+// shimLimits returns the constraints of the shim, which include its
+// forwarding of the logs of the sandbox.
+func shimLimits(conf *SandboxConfig) helperLimits {
+	return helperLimits{
+		cpuWeight: conf.ShimCPUWeight,
+		milliCPUs: conf.ShimMilliCPUs,
+		memoryMB:  conf.ShimMemoryMB,
+	}
+}
+
+// createHelperResourceControllers creates the resource controllers of the
+// helper processes which are constrained, in the one of the VMM, so that
+// they stay accounted to the pod, or to the overhead of the sandboxes.
+func (s *Sandbox) createHelperResourceControllers(vmmController resCtrl.ResourceController) error {
+	s.state.VirtiofsdCgroupPath = ""
+	s.state.ShimCgroupPath = ""
+	s.state.VMMCgroupPath = ""
+
+	virtiofsdResources := virtiofsdLimits(&s.config.HypervisorConfig).resources()
+	shimResources := shimLimits(s.config).resources()
+	if virtiofsdResources == nil && shimResources == nil {
+		return nil
+	}
+
+	// The limits are rejected rather than dropped, for the helper processes
+	// not to run unconstrained when they are expected to be.
+	// TODO: support systemd cgroups
+	if resCtrl.IsSystemdCgroup(vmmController.ID()) {
+		return fmt.Errorf("the virtiofsd and shim resource limits are not supported with systemd managed cgroups")
+	}
+
+	newController := func(name string, resources *specs.LinuxResources) (resCtrl.ResourceController, error) {
+		controller, err := resCtrl.NewResourceController(filepath.Join(vmmController.ID(), name), resources)
+		if err != nil {
+			return nil, fmt.Errorf("Could not create the %s resource controller: %v", name, err)
+		}
+		return controller, nil
+	}
+
+	var err error
+	if resCtrl.IsCgroupV2() {
+		if s.vmmController, err = newController(vmmResCtrlName, &specs.LinuxResources{}); err != nil {
+			return err
+		}
+		s.state.VMMCgroupPath = s.vmmController.ID()
+	}
+
+	if virtiofsdResources != nil {
+		if s.virtiofsdController, err = newController(virtiofsdResCtrlName, virtiofsdResources); err != nil {
+			return err
+		}
+		s.state.VirtiofsdCgroupPath = s.virtiofsdController.ID()
+	}
+
+	if shimResources != nil {
+		if s.shimController, err = newController(shimResCtrlName, shimResources); err != nil {
+			return err
+		}
+		s.state.ShimCgroupPath = s.shimController.ID()
+	}
+
+	return nil
+}
+
+// constrainHelpers moves the helper processes, started in the resource
+// controller of the VMM, or of the shim once it is constrained, to their
+// own one. The shim is moved once the VMM is started, for the VMM to stay
+// in its controller.
+func (s *Sandbox) constrainHelpers() error {
+	if s.virtiofsdController != nil {
+		if getter, ok := s.hypervisor.(virtiofsdPidsGetter); ok {
+			for _, pid := range getter.virtiofsdPids() {
+				if err := s.virtiofsdController.AddProcess(pid); err != nil {
+					return fmt.Errorf("Could not add virtiofsd PID %d to the %s resource controller: %v", pid, s.virtiofsdController.ID(), err)
+				}
+			}
+		}
+	}
+
+	if s.shimController != nil {
+		shimPid := os.Getpid()
+		if err := s.shimController.AddProcess(shimPid); err != nil {
+			return fmt.Errorf("Could not add shim PID %d to the %s resource controller: %v", shimPid, s.shimController.ID(), err)
+		}
+	}
+
+	return nil
+}
+
+// deleteHelperResourceControllers deletes the resource controllers of the
+// helper processes, which must be done before deleting the one of the VMM.
+func (s *Sandbox) deleteHelperResourceControllers() error {
+	for _, path := range []string{s.state.VirtiofsdCgroupPath, s.state.ShimCgroupPath, s.state.VMMCgroupPath} {
+		if path == "" {
+			continue
+		}
+
+		controller, err := resCtrl.LoadResourceController(path)
+		if err != nil {
+			return err
+		}
+
+		if err := controller.MoveTo(controller.Parent()); err != nil {
+			return err
+		}
+
+		if err := controller.Delete(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
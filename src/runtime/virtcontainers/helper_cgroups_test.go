@@ -0,0 +1,60 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"testing"
+
+	runcCgroups "github.com/opencontainers/runc/libcontainer/cgroups"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHelperLimitsResources(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(virtiofsdLimits(&HypervisorConfig{}).resources())
+	assert.Nil(shimLimits(&SandboxConfig{}).resources())
+
+	resources := virtiofsdLimits(&HypervisorConfig{VirtioFSMilliCPUs: 500}).resources()
+	assert.NotNil(resources)
+	assert.Nil(resources.Memory)
+	assert.Nil(resources.CPU.Shares)
+	assert.Equal(uint64(100000), *resources.CPU.Period)
+	assert.Equal(int64(50000), *resources.CPU.Quota)
+
+	resources = virtiofsdLimits(&HypervisorConfig{VirtioFSMemoryMB: 256}).resources()
+	assert.NotNil(resources)
+	assert.Nil(resources.CPU)
+	assert.Equal(int64(256<<20), *resources.Memory.Limit)
+
+	resources = shimLimits(&SandboxConfig{ShimCPUWeight: 100, ShimMemoryMB: 64}).resources()
+	assert.NotNil(resources)
+	assert.Nil(resources.CPU.Quota)
+	assert.Equal(cpuWeightToShares(100), *resources.CPU.Shares)
+	assert.Equal(int64(64<<20), *resources.Memory.Limit)
+}
+
+func TestCPUWeightToShares(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(uint64(2), cpuWeightToShares(1))
+	assert.Equal(uint64(262144), cpuWeightToShares(10000))
+	assert.Equal(uint64(262144), cpuWeightToShares(20000))
+
+	// the weight is preserved by the conversion of the shares on cgroup v2
+	for _, weight := range []uint32{1, 39, 100, 5000, 10000} {
+		assert.Equal(uint64(weight), runcCgroups.ConvertCPUSharesToCgroupV2Value(cpuWeightToShares(weight)))
+	}
+}
+
+func TestConstrainHelpersUnlimited(t *testing.T) {
+	s := &Sandbox{
+		hypervisor: &mockHypervisor{},
+	}
+
+	// Nothing to do without a resource controller
+	assert.NoError(t, s.constrainHelpers())
+}
@@ -59,6 +59,14 @@ const (
 
 	defaultBridges = 1
 
+	// maxSCSIControllers is the maximum number of virtio-scsi controllers
+	// the hotplugged block devices can be distributed across.
+	maxSCSIControllers = 16
+
+	// virtio-scsi virtqueue size limits, as enforced by QEMU.
+	minSCSIQueueDepth = 4
+	maxSCSIQueueDepth = 1024
+
 	defaultBlockDriver = config.VirtioSCSI
 
 	// port numbers below 1024 are called privileged ports. Only a process with
@@ -72,7 +80,15 @@ const (
 	// MinHypervisorMemory is the minimum memory required for a VM.
 	MinHypervisorMemory = 256
 
-	defaultMsize9p = 8192
+	// defaultMsize9p is the largest payload the virtio transport of the
+	// guest kernel carries in a single 9p request with 4 KiB pages, one
+	// page per descriptor of its 128 entries virtqueue but for the ones
+	// of the headers. The guests with larger pages carry more.
+	defaultMsize9p = (128 - 3) * 4096
+
+	// maxNetQueues is the maximum number of queues of a multiqueue tap
+	// device (MAX_TAP_QUEUES in the kernel).
+	maxNetQueues = 256
 )
 
 var (
@@ -104,6 +120,45 @@ var commonVirtioblkKernelRootParams = []Param{ //nolint: unused, deadcode, varch
 	{"rootfstype", "ext4"},
 }
 
+const (
+	// RootfsTypeExt4 is the filesystem type of the default guest images.
+	RootfsTypeExt4 = "ext4"
+
+	// RootfsTypeXFS is the filesystem type of XFS guest images.
+	RootfsTypeXFS = "xfs"
+
+	// RootfsTypeEROFS is the filesystem type of EROFS guest images, which
+	// are read-only by design.
+	RootfsTypeEROFS = "erofs"
+)
+
+// kernelRootParams adapts a list of kernel parameters, whose root parameters
+// are defined for ext4 guest images, to the filesystem type of the guest image.
+func kernelRootParams(params []Param, rootfsType string) []Param {
+	if rootfsType == "" || rootfsType == RootfsTypeExt4 {
+		return params
+	}
+
+	result := make([]Param, 0, len(params))
+	for _, p := range params {
+		switch p.Key {
+		case "rootflags":
+			// The data and errors options are specific to ext4, and the
+			// root filesystem is always mounted read-only.
+			if strings.HasPrefix(p.Value, "dax,") {
+				p.Value = "dax ro"
+			} else {
+				p.Value = "ro"
+			}
+		case "rootfstype":
+			p.Value = rootfsType
+		}
+		result = append(result, p)
+	}
+
+	return result
+}
+
 // DeviceType describes a virtualized device type.
 type DeviceType int
 
@@ -255,6 +310,10 @@ type HypervisorConfig struct {
 	// ImagePath is the guest image host path.
 	ImagePath string
 
+	// RootfsType is the filesystem type of the guest image. It is detected
+	// from the image when not set.
+	RootfsType string
+
 	// InitrdPath is the guest initrd image host path.
 	// ImagePath and InitrdPath cannot be set at the same time.
 	InitrdPath string
@@ -305,6 +364,10 @@ type HypervisorConfig struct {
 	//   - virtio-fs (default)
 	SharedFS string
 
+	// SharedFSReadOnly shares the volumes, not the root filesystems, read-only
+	// with the guest
+	SharedFSReadOnly bool
+
 	// Path for filesystem sharing
 	SharedPath string
 
@@ -358,6 +421,39 @@ type HypervisorConfig struct {
 	// VirtioFSExtraArgs passes options to virtiofsd daemon
 	VirtioFSExtraArgs []string
 
+	// VirtioFSSandbox is the sandbox mode of virtiofsd daemon
+	VirtioFSSandbox string
+
+	// VirtioFSSeccomp is the seccomp action of virtiofsd daemon
+	VirtioFSSeccomp string
+
+	// VirtioFSModcaps modifies the capabilities of virtiofsd daemon
+	VirtioFSModcaps []string
+
+	// VirtioFSVolumesDaemon runs another virtiofsd daemon, sharing the
+	// volumes of the containers apart from their root filesystems.
+	VirtioFSVolumesDaemon bool
+
+	// VirtioFSVolumesCache is the cache mode of the virtiofsd daemon sharing
+	// the volumes, VirtioFSCache if empty
+	VirtioFSVolumesCache string
+
+	// VirtioFSWriteback enables the writeback cache of virtiofsd daemon
+	VirtioFSWriteback bool
+
+	// VirtioFSVolumesWriteback enables the writeback cache of the virtiofsd
+	// daemon sharing the volumes
+	VirtioFSVolumesWriteback bool
+
+	// VirtioFSMilliCPUs and VirtioFSMemoryMB limit the CPU time, in
+	// thousandths of CPU, and the memory of the virtiofsd daemons
+	VirtioFSMilliCPUs uint32
+	VirtioFSMemoryMB  uint32
+
+	// VirtioFSCPUWeight is the CPU weight, from 1 to 10000, of the
+	// virtiofsd daemons relative to the VMM
+	VirtioFSCPUWeight uint32
+
 	// Enable annotations by name
 	EnableAnnotations []string
 
@@ -445,12 +541,26 @@ type HypervisorConfig struct {
 	// Msize9p is used as the msize for 9p shares
 	Msize9p uint32
 
+	// Cache9p is the cache mode of the 9p shares
+	Cache9p string
+
+	// Version9p is the protocol version of the 9p shares
+	Version9p string
+
 	// MemSlots specifies default memory slots the VM.
 	MemSlots uint32
 
 	// VirtioFSCacheSize is the DAX cache size in MiB
 	VirtioFSCacheSize uint32
 
+	// VirtioFSCacheSizeMax is the largest DAX cache size in MiB which can
+	// be set through annotations, 0 means no limit.
+	VirtioFSCacheSizeMax uint32
+
+	// NetworkQueues is the number of queue pairs of the virtio-net devices.
+	// When 0, the number of queues follows the number of vCPUs.
+	NetworkQueues uint32
+
 	// User ID.
 	Uid uint32
 
@@ -468,6 +578,43 @@ type HypervisorConfig struct {
 	// Denotes whether flush requests for the device are ignored.
 	BlockDeviceCacheNoflush bool
 
+	// BlockDeviceDiscard enables passing the discard (TRIM/UNMAP) requests
+	// of the guest down to the block devices, and mounting their
+	// filesystems with the discard option.
+	BlockDeviceDiscard bool
+
+	// BlockRootfsThresholdMB is the size of the EROFS image layers of the
+	// container rootfs, in MiB, above which the block devices of the
+	// layers are attached to the VM rather than shared with the guest.
+	// 0 disables it.
+	BlockRootfsThresholdMB uint64
+
+	// LayerCachePath is the path of the per-node layer cache image, attached
+	// to every sandbox for the agent to keep the container image layers in.
+	// Empty disables the layer cache.
+	LayerCachePath string
+
+	// LayerCacheSizeMB is the size, in MiB, of the layer cache image when
+	// it is created.
+	LayerCacheSizeMB uint64
+
+	// FileBackedBlockDevice is the kind of host block device image file
+	// volumes are attached through, loop or ublk devices.
+	FileBackedBlockDevice string
+
+	// UblkQueues is the number of hardware queues of the ublk devices,
+	// 0 leaving the ublk server default.
+	UblkQueues uint32
+
+	// UblkQueueDepth is the depth of the queues of the ublk devices,
+	// 0 leaving the ublk server default.
+	UblkQueueDepth uint32
+
+	// PmemEmptyDirSizeMB is the size, in MiB, of the virtio-pmem images
+	// backing the emptyDirs created in the guest. 0 creates them on the
+	// guest filesystem.
+	PmemEmptyDirSizeMB uint64
+
 	// DisableBlockDeviceUse disallows a block device from being used.
 	DisableBlockDeviceUse bool
 
@@ -475,6 +622,15 @@ type HypervisorConfig struct {
 	// Supported currently for virtio-scsi driver.
 	EnableIOThreads bool
 
+	// SCSIControllers is the number of virtio-scsi controllers the
+	// hotplugged block devices are distributed across. Zero means one.
+	SCSIControllers uint32
+
+	// SCSIQueueDepth is the virtqueue size of the virtio-scsi controllers,
+	// i.e. the maximum number of in-flight requests per queue. Zero keeps
+	// the hypervisor default.
+	SCSIQueueDepth uint32
+
 	// Debug changes the default hypervisor and kernel parameters to
 	// enable debug output where available.
 	Debug bool
@@ -488,6 +644,21 @@ type HypervisorConfig struct {
 	// VirtioMem is used to enable/disable virtio-mem
 	VirtioMem bool
 
+	// EnableMemoryBalloon adds a memory balloon to the VM, inflated to give
+	// the memory of the removed containers back to the host when virtio-mem
+	// is not enabled.
+	EnableMemoryBalloon bool
+
+	// MemoryShrinkHysteresisMB is the amount of memory, in MiB, the VM
+	// memory must be above what the containers need for it to be shrunk.
+	MemoryShrinkHysteresisMB uint32
+
+	// EnableGuestNUMA gives the guest the NUMA topology of the host nodes
+	// the sandbox is bound to, described by GuestNUMANodes once the
+	// sandbox is created.
+	EnableGuestNUMA bool
+	GuestNUMANodes  []GuestNUMANode
+
 	// IOMMU specifies if the VM should have a vIOMMU
 	IOMMU bool
 
@@ -523,6 +694,10 @@ type HypervisorConfig struct {
 	// DisableVhostNet is used to indicate if host supports vhost_net
 	DisableVhostNet bool
 
+	// EnableNetRSS enables receive side scaling and hash reporting
+	// on the virtio-net devices.
+	EnableNetRSS bool
+
 	// EnableVhostUserStore is used to indicate if host supports vhost-user-blk/scsi
 	EnableVhostUserStore bool
 
@@ -586,6 +761,20 @@ func (conf *HypervisorConfig) Valid() error {
 		return err
 	}
 
+	if conf.ImagePath != "" && conf.RootfsType == "" {
+		rootfsType, err := detectRootfsType(conf.ImagePath)
+		if err != nil {
+			hvLogger.WithError(err).WithField("image", conf.ImagePath).Debug("Could not detect the guest image filesystem type")
+		}
+		conf.RootfsType = rootfsType
+	}
+
+	switch conf.RootfsType {
+	case "", RootfsTypeExt4, RootfsTypeXFS, RootfsTypeEROFS:
+	default:
+		return fmt.Errorf("Unsupported guest image filesystem type %q", conf.RootfsType)
+	}
+
 	if conf.NumVCPUs == 0 {
 		conf.NumVCPUs = defaultVCPUs
 	}
@@ -598,6 +787,14 @@ func (conf *HypervisorConfig) Valid() error {
 		conf.DefaultBridges = defaultBridges
 	}
 
+	if conf.SCSIControllers > maxSCSIControllers {
+		return fmt.Errorf("Too many virtio-scsi controllers %d, maximum is %d", conf.SCSIControllers, maxSCSIControllers)
+	}
+
+	if depth := conf.SCSIQueueDepth; depth != 0 && (depth < minSCSIQueueDepth || depth > maxSCSIQueueDepth || depth&(depth-1) != 0) {
+		return fmt.Errorf("Invalid virtio-scsi queue depth %d, must be a power of 2 between %d and %d", depth, minSCSIQueueDepth, maxSCSIQueueDepth)
+	}
+
 	if conf.BlockDeviceDriver == "" {
 		conf.BlockDeviceDriver = defaultBlockDriver
 	} else if conf.BlockDeviceDriver == config.VirtioBlock && conf.HypervisorMachineType == QemuCCWVirtio {
@@ -620,6 +817,29 @@ func (conf *HypervisorConfig) Valid() error {
 	return nil
 }
 
+// NetQueues returns the number of queue pairs to configure on the
+// virtio-net devices of the VM. By default, the queues are provisioned for
+// the maximum number of vCPUs so that the agent can enable them as vCPUs
+// get hot added.
+func (conf *HypervisorConfig) NetQueues() uint32 {
+	queues := conf.NetworkQueues
+
+	if queues == 0 {
+		queues = conf.NumVCPUs
+		if conf.DefaultMaxVCPUs > queues {
+			queues = conf.DefaultMaxVCPUs
+		}
+	} else if conf.DefaultMaxVCPUs != 0 && queues > conf.DefaultMaxVCPUs {
+		queues = conf.DefaultMaxVCPUs
+	}
+
+	if queues > maxNetQueues {
+		queues = maxNetQueues
+	}
+
+	return queues
+}
+
 // AddKernelParam allows the addition of new kernel parameters to an existing
 // hypervisor configuration.
 func (conf *HypervisorConfig) AddKernelParam(p Param) error {
@@ -990,13 +1210,16 @@ type Hypervisor interface {
 	// just perform cleanup.
 	StopVM(ctx context.Context, waitOnly bool) error
 	PauseVM(ctx context.Context) error
-	SaveVM() error
+	SaveVM(statePath string) error
 	ResumeVM(ctx context.Context) error
 	AddDevice(ctx context.Context, devInfo interface{}, devType DeviceType) error
 	HotplugAddDevice(ctx context.Context, devInfo interface{}, devType DeviceType) (interface{}, error)
 	HotplugRemoveDevice(ctx context.Context, devInfo interface{}, devType DeviceType) (interface{}, error)
 	ResizeMemory(ctx context.Context, memMB uint32, memoryBlockSizeMB uint32, probe bool) (uint32, MemoryDevice, error)
 	ResizeVCPUs(ctx context.Context, vcpus uint32) (uint32, uint32, error)
+	// ResizeBlockDevice notifies the guest that the size of a hot plugged
+	// block device changed to size bytes.
+	ResizeBlockDevice(ctx context.Context, drive *config.BlockDrive, size uint64) error
 	GetVMConsole(ctx context.Context, sandboxID string) (string, string, error)
 	Disconnect(ctx context.Context)
 	Capabilities(ctx context.Context) types.Capabilities
@@ -156,6 +156,68 @@ func TestHypervisorConfigSecureExecution(t *testing.T) {
 	testHypervisorConfigValid(t, hypervisorConfig, false)
 }
 
+func TestHypervisorConfigRootfsType(t *testing.T) {
+	hypervisorConfig := &HypervisorConfig{
+		KernelPath:     fmt.Sprintf("%s/%s", testDir, testKernel),
+		ImagePath:      fmt.Sprintf("%s/%s", testDir, testImage),
+		HypervisorPath: fmt.Sprintf("%s/%s", testDir, testHypervisor),
+		RootfsType:     RootfsTypeEROFS,
+	}
+	testHypervisorConfigValid(t, hypervisorConfig, true)
+
+	hypervisorConfig.RootfsType = "squashfs"
+	testHypervisorConfigValid(t, hypervisorConfig, false)
+}
+
+func TestHypervisorConfigSCSIControllers(t *testing.T) {
+	hypervisorConfig := &HypervisorConfig{
+		KernelPath:      fmt.Sprintf("%s/%s", testDir, testKernel),
+		ImagePath:       fmt.Sprintf("%s/%s", testDir, testImage),
+		HypervisorPath:  fmt.Sprintf("%s/%s", testDir, testHypervisor),
+		SCSIControllers: 4,
+		SCSIQueueDepth:  512,
+	}
+	testHypervisorConfigValid(t, hypervisorConfig, true)
+
+	hypervisorConfig.SCSIControllers = maxSCSIControllers + 1
+	testHypervisorConfigValid(t, hypervisorConfig, false)
+
+	hypervisorConfig.SCSIControllers = 4
+	for _, depth := range []uint32{2, 384, 2048} {
+		hypervisorConfig.SCSIQueueDepth = depth
+		testHypervisorConfigValid(t, hypervisorConfig, false)
+	}
+}
+
+func TestKernelRootParams(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(commonNvdimmKernelRootParams, kernelRootParams(commonNvdimmKernelRootParams, ""))
+	assert.Equal(commonVirtioblkKernelRootParams, kernelRootParams(commonVirtioblkKernelRootParams, RootfsTypeExt4))
+
+	assert.Equal([]Param{
+		{"root", "/dev/pmem0p1"},
+		{"rootflags", "dax ro"},
+		{"rootfstype", "erofs"},
+	}, kernelRootParams(commonNvdimmKernelRootParams, RootfsTypeEROFS))
+
+	assert.Equal([]Param{
+		{"root", "/dev/vda1"},
+		{"rootflags", "ro"},
+		{"rootfstype", "erofs"},
+		{"panic", "1"},
+	}, kernelRootParams(append(commonVirtioblkKernelRootParams, Param{"panic", "1"}), RootfsTypeEROFS))
+
+	assert.Equal([]Param{
+		{"root", "/dev/pmem0p1"},
+		{"rootflags", "ro"},
+		{"rootfstype", "xfs"},
+	}, kernelRootParams(commonNvdimmNoDAXKernelRootParams, RootfsTypeXFS))
+
+	// The common parameters are left untouched
+	assert.Equal("ext4", commonVirtioblkKernelRootParams[2].Value)
+}
+
 func TestHypervisorConfigValidTemplateConfig(t *testing.T) {
 	hypervisorConfig := &HypervisorConfig{
 		KernelPath:       fmt.Sprintf("%s/%s", testDir, testKernel),
@@ -204,6 +266,32 @@ func TestHypervisorConfigDefaults(t *testing.T) {
 	assert.Exactly(hypervisorConfig, hypervisorConfigDefaultsExpected)
 }
 
+func TestHypervisorConfigNetQueues(t *testing.T) {
+	assert := assert.New(t)
+	hypervisorConfig := &HypervisorConfig{
+		NumVCPUs:        2,
+		DefaultMaxVCPUs: 8,
+	}
+
+	// provisioned for the maximum number of vCPUs by default
+	assert.Equal(uint32(8), hypervisorConfig.NetQueues())
+
+	hypervisorConfig.DefaultMaxVCPUs = 0
+	assert.Equal(uint32(2), hypervisorConfig.NetQueues())
+
+	hypervisorConfig.DefaultMaxVCPUs = 512
+	assert.Equal(uint32(maxNetQueues), hypervisorConfig.NetQueues())
+
+	hypervisorConfig.DefaultMaxVCPUs = 8
+
+	hypervisorConfig.NetworkQueues = 4
+	assert.Equal(uint32(4), hypervisorConfig.NetQueues())
+
+	// capped to the maximum number of vCPUs
+	hypervisorConfig.NetworkQueues = 16
+	assert.Equal(uint32(8), hypervisorConfig.NetQueues())
+}
+
 func TestAppendParams(t *testing.T) {
 	assert := assert.New(t)
 	paramList := []Param{
@@ -35,6 +35,11 @@ func (impl *VCImpl) CreateSandbox(ctx context.Context, sandboxConfig SandboxConf
 	return CreateSandbox(ctx, sandboxConfig, impl.factory)
 }
 
+// AdoptSandbox implements the VC function of the same name.
+func (impl *VCImpl) AdoptSandbox(ctx context.Context, sandboxID string) (VCSandbox, error) {
+	return AdoptSandbox(ctx, sandboxID)
+}
+
 // CleanupContainer is used by shimv2 to stop and delete a container exclusively, once there is no container
 // in the sandbox left, do stop the sandbox and delete it. Those serial operations will be done exclusively by
 // locking the sandbox.
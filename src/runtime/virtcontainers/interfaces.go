@@ -24,6 +24,7 @@ type VC interface {
 	SetFactory(ctx context.Context, factory Factory)
 
 	CreateSandbox(ctx context.Context, sandboxConfig SandboxConfig) (VCSandbox, error)
+	AdoptSandbox(ctx context.Context, sandboxID string) (VCSandbox, error)
 	CleanupContainer(ctx context.Context, sandboxID, containerID string, force bool) error
 }
 
@@ -39,9 +40,13 @@ type VCSandbox interface {
 	SetAnnotations(annotations map[string]string) error
 
 	Stats(ctx context.Context) (SandboxStats, error)
+	OverheadStats() (OverheadStats, error)
+	GetAllocatedResources(ctx context.Context) (AllocatedResources, error)
 
 	Start(ctx context.Context) error
 	Stop(ctx context.Context, force bool) error
+	PauseForCheckpoint(ctx context.Context) (*CheckpointState, error)
+	SaveCheckpoint(ctx context.Context, dir string, state *CheckpointState) error
 	Release(ctx context.Context) error
 	Monitor(ctx context.Context) (chan error, error)
 	Delete(ctx context.Context) error
@@ -69,16 +74,31 @@ type VCSandbox interface {
 	ListInterfaces(ctx context.Context) ([]*pbTypes.Interface, error)
 	UpdateRoutes(ctx context.Context, routes []*pbTypes.Route) ([]*pbTypes.Route, error)
 	ListRoutes(ctx context.Context) ([]*pbTypes.Route, error)
+	GetIPTables(ctx context.Context, isIPv6 bool) ([]byte, error)
+	SetIPTables(ctx context.Context, isIPv6 bool, data []byte) error
+	SetAgentTracing(ctx context.Context, enable bool) error
 
 	GetOOMEvent(ctx context.Context) (string, error)
 	GetHypervisorPid() (int, error)
 
+	// DebugState returns a snapshot of the sandbox, for debugging.
+	DebugState() SandboxDebugState
+
 	UpdateRuntimeMetrics() error
 	GetAgentMetrics(ctx context.Context) (string, error)
 	GetAgentURL() (string, error)
+	GetAgentPolicyHash(ctx context.Context) (string, error)
+	CollectDiagnostics(ctx context.Context, containerID string, w io.Writer) error
+	SetAgentLogLevel(ctx context.Context, level string) error
+	ReadGuestKernelLog(ctx context.Context) ([]GuestKernelLogRecord, error)
 
 	GuestVolumeStats(ctx context.Context, volumePath string) ([]byte, error)
 	ResizeGuestVolume(ctx context.Context, volumePath string, size uint64) error
+	GrownVolumes() []GrownVolume
+	ResizeVolume(ctx context.Context, v GrownVolume) error
+	VolumeResized(v GrownVolume) error
+	GuestTimeSyncDue() (bool, error)
+	SyncGuestTime(ctx context.Context) error
 }
 
 // VCContainer is the Container interface
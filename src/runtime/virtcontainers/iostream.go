@@ -8,14 +8,40 @@ package virtcontainers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"net"
+	"sync"
 )
 
+// processIOPorts are the vsock ports streaming the stdio of a process, 0 for
+// a stream going through the agent API. The stdin and stdout of a process
+// streamed over a single connection, in both directions, have the same port.
+type processIOPorts struct {
+	stdin  uint32
+	stdout uint32
+	stderr uint32
+}
+
+// directStream is a dedicated vsock connection streaming the stdio of a
+// process, dialed on first use.
+type directStream struct {
+	conn net.Conn
+	once sync.Once
+}
+
 type iostream struct {
 	sandbox   *Sandbox
 	container *Container
 	process   string
 	closed    bool
+
+	ports    processIOPorts
+	openOnce sync.Once
+
+	// the connections of the streams, by port
+	streams     map[uint32]*directStream
+	streamsLock sync.Mutex // protects ports and streams
 }
 
 // io.WriteCloser
@@ -54,11 +80,72 @@ func (s *iostream) stderr() io.Reader {
 	return &stderrStream{s}
 }
 
+// open requests the agent, once, to stream the stdio of the process over
+// dedicated vsock connections, the stdin and stdout over a single one in
+// both directions, which avoids the round trips of the agent API and the
+// head-of-line blocking between the processes sharing it. The agent API is
+// used when the agent cannot. It returns the ports of the streams.
+func (s *iostream) open() processIOPorts {
+	s.openOnce.Do(func() {
+		// can not pass context to Read() and Write(), so use background context
+		ports, err := s.sandbox.agent.openProcessIO(context.Background(), s.container, s.process)
+		if err != nil {
+			s.sandbox.Logger().WithError(err).WithField("process", s.process).Debug("streaming the process IO through the agent API")
+			return
+		}
+		s.streamsLock.Lock()
+		s.ports = ports
+		s.streamsLock.Unlock()
+	})
+
+	s.streamsLock.Lock()
+	defer s.streamsLock.Unlock()
+
+	return s.ports
+}
+
+// dial returns the connection of the stream on port, nil when the stream goes
+// through the agent API, as it does when the connection fails, the agent
+// only taking the stream over once connected. The streams on the same port
+// share their connection.
+func (s *iostream) dial(port uint32) net.Conn {
+	if port == 0 {
+		return nil
+	}
+
+	s.streamsLock.Lock()
+	if s.streams == nil {
+		s.streams = make(map[uint32]*directStream)
+	}
+	ds, ok := s.streams[port]
+	if !ok {
+		ds = &directStream{}
+		s.streams[port] = ds
+	}
+	s.streamsLock.Unlock()
+
+	ds.once.Do(func() {
+		conn, err := s.sandbox.agent.dialPort(context.Background(), port)
+		if err != nil {
+			s.sandbox.Logger().WithError(err).WithField("port", port).Warn("streaming the process IO through the agent API")
+			return
+		}
+		ds.conn = conn
+	})
+
+	return ds.conn
+}
+
 func (s *stdinStream) Write(data []byte) (n int, err error) {
 	if s.closed {
 		return 0, errors.New("stream closed")
 	}
 
+	ports := s.open()
+	if conn := s.dial(ports.stdin); conn != nil {
+		return conn.Write(data)
+	}
+
 	// can not pass context to Write(), so use background context
 	return s.sandbox.agent.writeProcessStdin(context.Background(), s.container, s.process, data)
 }
@@ -68,8 +155,24 @@ func (s *stdinStream) Close() error {
 		return errors.New("stream closed")
 	}
 
-	// can not pass context to Close(), so use background context
-	err := s.sandbox.agent.closeProcessStdin(context.Background(), s.container, s.process)
+	// the agent closes the process stdin once its connection is closed, or
+	// its write side shut down when the connection carries the stdout too
+	ports := s.open()
+	var err error
+	if conn := s.dial(ports.stdin); conn == nil {
+		// can not pass context to Close(), so use background context
+		err = s.sandbox.agent.closeProcessStdin(context.Background(), s.container, s.process)
+	} else if ports.stdin != ports.stdout {
+		err = conn.Close()
+	} else if cw, ok := conn.(interface{ CloseWrite() error }); ok {
+		// the connection is closed already once the stdout ended
+		if err = cw.CloseWrite(); errors.Is(err, net.ErrClosed) {
+			err = nil
+		}
+	} else {
+		err = fmt.Errorf("can not close the stdin of the process %s sharing its connection with the stdout", s.process)
+	}
+
 	if err == nil {
 		s.closed = true
 	}
@@ -82,6 +185,11 @@ func (s *stdoutStream) Read(data []byte) (n int, err error) {
 		return 0, errors.New("stream closed")
 	}
 
+	ports := s.open()
+	if conn := s.dial(ports.stdout); conn != nil {
+		return readConn(conn, data)
+	}
+
 	// can not pass context to Read(), so use background context
 	return s.sandbox.agent.readProcessStdout(context.Background(), s.container, s.process, data)
 }
@@ -91,6 +199,22 @@ func (s *stderrStream) Read(data []byte) (n int, err error) {
 		return 0, errors.New("stream closed")
 	}
 
+	ports := s.open()
+	if conn := s.dial(ports.stderr); conn != nil {
+		return readConn(conn, data)
+	}
+
 	// can not pass context to Read(), so use background context
 	return s.sandbox.agent.readProcessStderr(context.Background(), s.container, s.process, data)
 }
+
+// readConn reads from the connection of a stream, closed once the agent
+// closed it, at the end of the stream.
+func readConn(conn net.Conn, data []byte) (int, error) {
+	n, err := conn.Read(data)
+	if err == io.EOF {
+		conn.Close()
+	}
+
+	return n, err
+}
@@ -6,9 +6,14 @@
 package virtcontainers
 
 import (
+	"context"
+	"io"
+	"net"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
 )
 
 func TestIOStream(t *testing.T) {
@@ -55,3 +60,109 @@ func TestIOStream(t *testing.T) {
 	err = stdin.Close()
 	assert.NotNil(t, err, "stdin close closed should fail")
 }
+
+// directIOAgent streams the stdio of the processes over pipes.
+type directIOAgent struct {
+	mockAgent
+	conns map[uint32]net.Conn
+}
+
+func (a *directIOAgent) openProcessIO(ctx context.Context, c *Container, processID string) (processIOPorts, error) {
+	return processIOPorts{stdin: 1, stdout: 2}, nil
+}
+
+func (a *directIOAgent) dialPort(ctx context.Context, port uint32) (net.Conn, error) {
+	return a.conns[port], nil
+}
+
+func TestIOStreamDirect(t *testing.T) {
+	assert := assert.New(t)
+
+	stdinConn, stdinPeer := net.Pipe()
+	stdoutConn, stdoutPeer := net.Pipe()
+
+	s := &Sandbox{
+		agent: &directIOAgent{
+			conns: map[uint32]net.Conn{1: stdinConn, 2: stdoutConn},
+		},
+	}
+	stream := newIOStream(s, &Container{sandbox: s}, "foo")
+
+	go func() {
+		stdoutPeer.Write([]byte("out"))
+		stdoutPeer.Close()
+	}()
+	data, err := io.ReadAll(stream.stdout())
+	assert.NoError(err)
+	assert.Equal("out", string(data))
+
+	// stderr goes through the agent API
+	_, err = stream.stderr().Read(make([]byte, 8))
+	assert.NoError(err)
+
+	go func() {
+		stream.stdin().Write([]byte("in"))
+		stream.stdin().Close()
+	}()
+	data, err = io.ReadAll(stdinPeer)
+	assert.NoError(err)
+	assert.Equal("in", string(data))
+}
+
+// bidirectionalIOAgent streams the stdin and stdout of the processes over a
+// single connection.
+type bidirectionalIOAgent struct {
+	mockAgent
+	conn net.Conn
+}
+
+func (a *bidirectionalIOAgent) openProcessIO(ctx context.Context, c *Container, processID string) (processIOPorts, error) {
+	return processIOPorts{stdin: 1, stdout: 1}, nil
+}
+
+func (a *bidirectionalIOAgent) dialPort(ctx context.Context, port uint32) (net.Conn, error) {
+	return a.conn, nil
+}
+
+func TestIOStreamBidirectional(t *testing.T) {
+	assert := assert.New(t)
+
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM, 0)
+	assert.NoError(err)
+
+	newConn := func(fd int) net.Conn {
+		f := os.NewFile(uintptr(fd), "stdio")
+		defer f.Close()
+		conn, err := net.FileConn(f)
+		assert.NoError(err)
+		return conn
+	}
+	conn := newConn(fds[0])
+	peer := newConn(fds[1])
+	defer peer.Close()
+
+	s := &Sandbox{
+		agent: &bidirectionalIOAgent{conn: conn},
+	}
+	stream := newIOStream(s, &Container{sandbox: s}, "foo")
+
+	_, err = stream.stdin().Write([]byte("in"))
+	assert.NoError(err)
+
+	_, err = peer.Write([]byte("out"))
+	assert.NoError(err)
+	data := make([]byte, 3)
+	_, err = io.ReadFull(stream.stdout(), data)
+	assert.NoError(err)
+	assert.Equal("out", string(data))
+
+	// the stdin is closed by shutting down the write side of the connection
+	assert.NoError(stream.stdin().Close())
+	data, err = io.ReadAll(peer)
+	assert.NoError(err)
+	assert.Equal("in", string(data))
+
+	// the connection stays open for the stdout
+	_, err = peer.Write([]byte("exited"))
+	assert.NoError(err)
+}
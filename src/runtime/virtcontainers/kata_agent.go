@@ -10,8 +10,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strconv"
 	"strings"
 	"sync"
@@ -34,6 +36,7 @@ import (
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/utils"
 
+	"github.com/containerd/ttrpc"
 	"github.com/gogo/protobuf/proto"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
@@ -79,7 +82,9 @@ var (
 	defaultKataHostSharedDir     = "/run/kata-containers/shared/sandboxes/"
 	defaultKataGuestSharedDir    = "/run/kata-containers/shared/containers/"
 	defaultKataGuestNydusRootDir = "/run/kata-containers/shared/"
+	defaultKataGuestVolumesDir   = "/run/kata-containers/shared/volumes/"
 	mountGuestTag                = "kataShared"
+	mountGuestVolumesTag         = "kataSharedVolumes"
 	defaultKataGuestSandboxDir   = "/run/kata-containers/sandbox/"
 	type9pFs                     = "9p"
 	typeVirtioFS                 = "virtiofs"
@@ -91,12 +96,13 @@ var (
 	kataBlkCCWDevType            = "blk-ccw"
 	kataSCSIDevType              = "scsi"
 	kataNvdimmDevType            = "nvdimm"
+	kataVirtioPmemDevType        = "virtio-pmem"
 	kataVirtioFSDevType          = "virtio-fs"
 	kataOverlayDevType           = "overlayfs"
-	kataWatchableBindDevType     = "watchable-bind"
+	kataWatchableSyncDevType     = "watchable-sync"
+	kataNetfsDevType             = "netfs"
 	kataVfioDevType              = "vfio"    // VFIO device to used as VFIO in the container
 	kataVfioGuestKernelDevType   = "vfio-gk" // VFIO device for consumption by the guest kernel
-	sharedDir9pOptions           = []string{"trans=virtio,version=9p2000.L,cache=mmap", "nodev"}
 	sharedDirVirtioFSOptions     = []string{}
 	sharedDirVirtioFSDaxOptions  = "dax"
 	shmDir                       = "shm"
@@ -106,43 +112,87 @@ var (
 	localDirOptions              = []string{"mode=0777"}
 	maxHostnameLen               = 64
 	GuestDNSFile                 = "/etc/resolv.conf"
+	guestDNSFileName             = "resolv.conf"
 )
 
 const (
-	grpcCheckRequest             = "grpc.CheckRequest"
-	grpcExecProcessRequest       = "grpc.ExecProcessRequest"
-	grpcCreateSandboxRequest     = "grpc.CreateSandboxRequest"
-	grpcDestroySandboxRequest    = "grpc.DestroySandboxRequest"
-	grpcCreateContainerRequest   = "grpc.CreateContainerRequest"
-	grpcStartContainerRequest    = "grpc.StartContainerRequest"
-	grpcRemoveContainerRequest   = "grpc.RemoveContainerRequest"
-	grpcSignalProcessRequest     = "grpc.SignalProcessRequest"
-	grpcUpdateRoutesRequest      = "grpc.UpdateRoutesRequest"
-	grpcUpdateInterfaceRequest   = "grpc.UpdateInterfaceRequest"
-	grpcListInterfacesRequest    = "grpc.ListInterfacesRequest"
-	grpcListRoutesRequest        = "grpc.ListRoutesRequest"
-	grpcAddARPNeighborsRequest   = "grpc.AddARPNeighborsRequest"
-	grpcOnlineCPUMemRequest      = "grpc.OnlineCPUMemRequest"
-	grpcUpdateContainerRequest   = "grpc.UpdateContainerRequest"
-	grpcWaitProcessRequest       = "grpc.WaitProcessRequest"
-	grpcTtyWinResizeRequest      = "grpc.TtyWinResizeRequest"
-	grpcWriteStreamRequest       = "grpc.WriteStreamRequest"
-	grpcCloseStdinRequest        = "grpc.CloseStdinRequest"
-	grpcStatsContainerRequest    = "grpc.StatsContainerRequest"
-	grpcPauseContainerRequest    = "grpc.PauseContainerRequest"
-	grpcResumeContainerRequest   = "grpc.ResumeContainerRequest"
-	grpcReseedRandomDevRequest   = "grpc.ReseedRandomDevRequest"
-	grpcGuestDetailsRequest      = "grpc.GuestDetailsRequest"
-	grpcMemHotplugByProbeRequest = "grpc.MemHotplugByProbeRequest"
-	grpcCopyFileRequest          = "grpc.CopyFileRequest"
-	grpcSetGuestDateTimeRequest  = "grpc.SetGuestDateTimeRequest"
-	grpcGetOOMEventRequest       = "grpc.GetOOMEventRequest"
-	grpcGetMetricsRequest        = "grpc.GetMetricsRequest"
-	grpcAddSwapRequest           = "grpc.AddSwapRequest"
-	grpcVolumeStatsRequest       = "grpc.VolumeStatsRequest"
-	grpcResizeVolumeRequest      = "grpc.ResizeVolumeRequest"
+	grpcCheckRequest              = "grpc.CheckRequest"
+	grpcExecProcessRequest        = "grpc.ExecProcessRequest"
+	grpcCreateSandboxRequest      = "grpc.CreateSandboxRequest"
+	grpcDestroySandboxRequest     = "grpc.DestroySandboxRequest"
+	grpcCreateContainerRequest    = "grpc.CreateContainerRequest"
+	grpcStartContainerRequest     = "grpc.StartContainerRequest"
+	grpcRemoveContainerRequest    = "grpc.RemoveContainerRequest"
+	grpcSignalProcessRequest      = "grpc.SignalProcessRequest"
+	grpcUpdateRoutesRequest       = "grpc.UpdateRoutesRequest"
+	grpcUpdateInterfaceRequest    = "grpc.UpdateInterfaceRequest"
+	grpcListInterfacesRequest     = "grpc.ListInterfacesRequest"
+	grpcListRoutesRequest         = "grpc.ListRoutesRequest"
+	grpcAddARPNeighborsRequest    = "grpc.AddARPNeighborsRequest"
+	grpcGetIPTablesRequest        = "grpc.GetIPTablesRequest"
+	grpcSetIPTablesRequest        = "grpc.SetIPTablesRequest"
+	grpcStartTracingRequest       = "grpc.StartTracingRequest"
+	grpcStopTracingRequest        = "grpc.StopTracingRequest"
+	grpcOnlineCPUMemRequest       = "grpc.OnlineCPUMemRequest"
+	grpcUpdateContainerRequest    = "grpc.UpdateContainerRequest"
+	grpcWaitProcessRequest        = "grpc.WaitProcessRequest"
+	grpcTtyWinResizeRequest       = "grpc.TtyWinResizeRequest"
+	grpcWriteStreamRequest        = "grpc.WriteStreamRequest"
+	grpcCloseStdinRequest         = "grpc.CloseStdinRequest"
+	grpcOpenProcessIORequest      = "grpc.OpenProcessIORequest"
+	grpcStatsContainerRequest     = "grpc.StatsContainerRequest"
+	grpcPauseContainerRequest     = "grpc.PauseContainerRequest"
+	grpcResumeContainerRequest    = "grpc.ResumeContainerRequest"
+	grpcReseedRandomDevRequest    = "grpc.ReseedRandomDevRequest"
+	grpcGuestDetailsRequest       = "grpc.GuestDetailsRequest"
+	grpcMemHotplugByProbeRequest  = "grpc.MemHotplugByProbeRequest"
+	grpcCopyFileRequest           = "grpc.CopyFileRequest"
+	grpcSetGuestDateTimeRequest   = "grpc.SetGuestDateTimeRequest"
+	grpcGetOOMEventRequest        = "grpc.GetOOMEventRequest"
+	grpcGetMetricsRequest         = "grpc.GetMetricsRequest"
+	grpcAddSwapRequest            = "grpc.AddSwapRequest"
+	grpcVolumeStatsRequest        = "grpc.VolumeStatsRequest"
+	grpcResizeVolumeRequest       = "grpc.ResizeVolumeRequest"
+	grpcSyncWatchableRequest      = "grpc.SyncWatchableMountRequest"
+	grpcSetPolicyRequest          = "grpc.SetPolicyRequest"
+	grpcGetPolicyRequest          = "grpc.GetPolicyRequest"
+	grpcExecuteHooksRequest       = "grpc.ExecuteHooksRequest"
+	grpcGetDiagnosticsRequest     = "grpc.GetDiagnosticsRequest"
+	grpcSetLogLevelRequest        = "grpc.SetLogLevelRequest"
+	grpcReadKernelLogRequest      = "grpc.ReadKernelLogRequest"
+	grpcSetGuestTimeSourceRequest = "grpc.SetGuestTimeSourceRequest"
 )
 
+const (
+	// agentReconnectAttempts is the number of times the agent is redialed
+	// after the connection to it was lost, waiting twice as long between
+	// each attempt from agentReconnectDelay, jittered.
+	agentReconnectAttempts = 4
+	agentReconnectDelay    = 100 * time.Millisecond
+
+	// agentReconnectTimeout bounds the time a request waits for the
+	// connection to the agent to be back, within its own deadline.
+	agentReconnectTimeout = 5 * time.Second
+)
+
+// agentRetriableRequests are the requests sent again after the connection to
+// the agent was lost, as the agent may have handled them before, only the
+// ones without side effects, or whose side effects are idempotent, are.
+var agentRetriableRequests = map[string]bool{
+	grpcCheckRequest:            true,
+	grpcListInterfacesRequest:   true,
+	grpcListRoutesRequest:       true,
+	grpcGetIPTablesRequest:      true,
+	grpcStatsContainerRequest:   true,
+	grpcGuestDetailsRequest:     true,
+	grpcSetGuestDateTimeRequest: true,
+	grpcGetMetricsRequest:       true,
+	grpcVolumeStatsRequest:      true,
+	grpcGetPolicyRequest:        true,
+	grpcGetDiagnosticsRequest:   true,
+	grpcSetLogLevelRequest:      true,
+}
+
 // newKataAgent returns an agent from an agent type.
 func newKataAgent() agent {
 	return &kataAgent{}
@@ -176,6 +226,33 @@ func getFSGroupChangePolicy(policy volume.FSGroupChangePolicy) pbTypes.FSGroupCh
 	}
 }
 
+// discardFsTypes are the filesystems supporting the discard mount option.
+var discardFsTypes = map[string]bool{
+	"ext4":  true,
+	"xfs":   true,
+	"btrfs": true,
+	"f2fs":  true,
+}
+
+// withDiscardOption appends the discard option to the mount options of a
+// filesystem on a hotplugged block device, when discard is enabled for the
+// block devices, so that the guest issues the TRIM/UNMAP requests reclaiming
+// the freed blocks. Read-only mounts and explicit (no)discard options are
+// left unchanged.
+func withDiscardOption(hconfig *HypervisorConfig, fstype string, readOnly bool, options []string) []string {
+	if !hconfig.BlockDeviceDiscard || readOnly || !discardFsTypes[fstype] {
+		return options
+	}
+
+	for _, opt := range options {
+		if opt == "ro" || opt == "discard" || opt == "nodiscard" {
+			return options
+		}
+	}
+
+	return append(append([]string{}, options...), "discard")
+}
+
 // Shared path handling:
 // 1. create three directories for each sandbox:
 // -. /run/kata-containers/shared/sandboxes/$sbx_id/mounts/, a directory to hold all host/guest shared mounts
@@ -185,6 +262,10 @@ func getFSGroupChangePolicy(policy volume.FSGroupChangePolicy) pbTypes.FSGroupCh
 // 2. /run/kata-containers/shared/sandboxes/$sbx_id/mounts/ is bind mounted readonly to /run/kata-containers/shared/sandboxes/$sbx_id/shared/, so guest cannot modify it
 //
 // 3. host-guest shared files/directories are mounted one-level under /run/kata-containers/shared/sandboxes/$sbx_id/mounts/ and thus present to guest at one level under /run/kata-containers/shared/sandboxes/$sbx_id/shared/
+//
+// 4. when the volumes have their own virtio-fs daemon, the shared files/directories are mounted under
+// /run/kata-containers/shared/sandboxes/$sbx_id/volume-mounts/ instead, which is bind mounted readonly to
+// /run/kata-containers/shared/sandboxes/$sbx_id/volume-shared/, the source dir of that daemon
 func GetSharePath(id string) string {
 	return filepath.Join(kataHostSharedDir(), id, "shared")
 }
@@ -193,6 +274,14 @@ func getMountPath(id string) string {
 	return filepath.Join(kataHostSharedDir(), id, "mounts")
 }
 
+func GetVolumesSharePath(id string) string {
+	return filepath.Join(kataHostSharedDir(), id, "volume-shared")
+}
+
+func getVolumesMountPath(id string) string {
+	return filepath.Join(kataHostSharedDir(), id, "volume-mounts")
+}
+
 func getPrivatePath(id string) string {
 	return filepath.Join(kataHostSharedDir(), id, "private")
 }
@@ -236,6 +325,15 @@ var kataGuestSharedDir = func() string {
 	return defaultKataGuestSharedDir
 }
 
+// The function is declared this way for mocking in unit tests
+var kataGuestVolumesDir = func() string {
+	if rootless.IsRootless() {
+		// filepath.Join removes trailing slashes, but it is necessary for mounting
+		return filepath.Join(rootless.GetRootlessDir(), defaultKataGuestVolumesDir) + "/"
+	}
+	return defaultKataGuestVolumesDir
+}
+
 // The function is declared this way for mocking in unit tests
 var kataGuestSandboxDir = func() string {
 	if rootless.IsRootless() {
@@ -266,6 +364,23 @@ type KataAgentConfig struct {
 	Debug              bool
 	Trace              bool
 	EnableDebugConsole bool
+
+	// HealthCheckInterval is the interval, in seconds, at which the agent
+	// is checked, and HealthCheckFailures the number of consecutive failed
+	// checks after which the sandbox is unhealthy.
+	HealthCheckInterval uint32
+	HealthCheckFailures uint32
+
+	// Timeouts, in seconds, of the requests creating a container, executing
+	// a process, completing a hotplug in the guest and destroying the
+	// sandbox, the default request timeout applying when 0.
+	CreateContainerTimeout uint32
+	ExecProcessTimeout     uint32
+	HotplugTimeout         uint32
+	ShutdownTimeout        uint32
+
+	// Policy restricting the agent API, set when the sandbox starts
+	Policy string
 }
 
 // KataAgentState is the structure describing the data stored from this
@@ -288,11 +403,18 @@ type kataAgent struct {
 
 	reqHandlers map[string]reqFunc
 	kmodules    []string
+	policy      string
 
-	dialTimout uint32
+	dialTimout  uint32
+	reqTimeouts map[string]time.Duration
 
 	keepConn bool
 	dead     bool
+
+	// hypervisor runs the VM of the agent
+	hypervisor Hypervisor
+	// reconnecting is closed once the client being redialed is replaced
+	reconnecting chan struct{}
 }
 
 func (k *kataAgent) Logger() *logrus.Entry {
@@ -346,6 +468,7 @@ func (k *kataAgent) handleTraceSettings(config KataAgentConfig) bool {
 func (k *kataAgent) init(ctx context.Context, sandbox *Sandbox, config KataAgentConfig) (disableVMShutdown bool, err error) {
 	// Save
 	k.ctx = sandbox.ctx
+	k.hypervisor = sandbox.hypervisor
 
 	span, _ := katatrace.Trace(ctx, k.Logger(), "init", kataAgentTracingTags)
 	defer span.End()
@@ -354,6 +477,8 @@ func (k *kataAgent) init(ctx context.Context, sandbox *Sandbox, config KataAgent
 	k.keepConn = config.LongLiveConn
 	k.kmodules = config.KernelModules
 	k.dialTimout = config.DialTimeout
+	k.reqTimeouts = requestTimeouts(config)
+	k.policy = config.Policy
 
 	return disableVMShutdown, nil
 }
@@ -437,7 +562,26 @@ func (k *kataAgent) configure(ctx context.Context, h Hypervisor, id, sharePath s
 		return err
 	}
 
-	return h.AddDevice(ctx, sharedVolume, FsDev)
+	if err = h.AddDevice(ctx, sharedVolume, FsDev); err != nil {
+		return err
+	}
+
+	// The volumes are shared by their own virtio-fs daemon and device.
+	hconfig := h.HypervisorConfig()
+	if !useVolumesVirtiofsd(&hconfig) {
+		return nil
+	}
+
+	volumesVolume := types.Volume{
+		MountTag: mountGuestVolumesTag,
+		HostPath: GetVolumesSharePath(id),
+	}
+
+	if err = os.MkdirAll(volumesVolume.HostPath, DirMode); err != nil {
+		return err
+	}
+
+	return h.AddDevice(ctx, volumesVolume, FsDev)
 }
 
 func (k *kataAgent) configureFromGrpc(ctx context.Context, h Hypervisor, id string, config KataAgentConfig) error {
@@ -671,7 +815,7 @@ func (k *kataAgent) reuseAgent(agent agent) error {
 		return fmt.Errorf("Bug: get a wrong type of agent")
 	}
 
-	k.installReqFunc(a.client)
+	k.installReqFunc()
 	k.client = a.client
 	return nil
 }
@@ -683,6 +827,9 @@ func (k *kataAgent) getDNS(sandbox *Sandbox) ([]string, error) {
 		return nil, nil
 	}
 
+	// DNS settings returned by the network plugins
+	dnsInfo := networkDNSInfo(sandbox.network)
+
 	ociMounts := ociSpec.Mounts
 
 	for _, m := range ociMounts {
@@ -692,10 +839,16 @@ func (k *kataAgent) getDNS(sandbox *Sandbox) ([]string, error) {
 				return nil, fmt.Errorf("Could not read file %s: %s", m.Source, err)
 			}
 			dns := strings.Split(string(content), "\n")
-			return dns, nil
+			return mergeDNS(dns, dnsInfo), nil
 
 		}
 	}
+
+	if dns := mergeDNS(nil, dnsInfo); len(dns) > 0 {
+		k.Logger().Debug("DNS file not present in ociMounts. Using the DNS settings of the network plugins.")
+		return dns, nil
+	}
+
 	k.Logger().Debug("DNS file not present in ociMounts. Sandbox DNS will not be set.")
 	return nil, nil
 }
@@ -723,6 +876,13 @@ func (k *kataAgent) startSandbox(ctx context.Context, sandbox *Sandbox) error {
 		return err
 	}
 
+	// The policy restricts all the following requests
+	if k.policy != "" {
+		if err = k.setPolicy(ctx, k.policy); err != nil {
+			return fmt.Errorf("failed to set the agent policy: %v", err)
+		}
+	}
+
 	// Setup network interfaces and routes
 	interfaces, routes, neighs, err := generateVCNetworkStructures(ctx, sandbox.network)
 	if err != nil {
@@ -799,10 +959,13 @@ func setupStorages(ctx context.Context, sandbox *Sandbox) []*grpc.Storage {
 			// directly map contents from the host. When set to 'none', the mount
 			// options should not contain 'dax' lest the virtio-fs daemon crashing
 			// with an invalid address reference.
+			// The storages are set up again when virtiofsd is restarted,
+			// the options are not appended to the defaults in place.
+			options := append([]string{}, sharedDirVirtioFSOptions...)
 			if sandbox.config.HypervisorConfig.VirtioFSCache != typeVirtioFSNoCache {
 				// If virtio_fs_cache_size = 0, dax should not be used.
 				if sandbox.config.HypervisorConfig.VirtioFSCacheSize != 0 {
-					sharedDirVirtioFSOptions = append(sharedDirVirtioFSOptions, sharedDirVirtioFSDaxOptions)
+					options = append(options, sharedDirVirtioFSDaxOptions)
 				}
 			}
 			mountPoint := kataGuestSharedDir()
@@ -814,19 +977,36 @@ func setupStorages(ctx context.Context, sandbox *Sandbox) []*grpc.Storage {
 				Source:     mountGuestTag,
 				MountPoint: mountPoint,
 				Fstype:     typeVirtioFS,
-				Options:    sharedDirVirtioFSOptions,
+				Options:    options,
 			}
 
 			storages = append(storages, sharedVolume)
+
+			if hconfig := &sandbox.config.HypervisorConfig; useVolumesVirtiofsd(hconfig) {
+				// The volumes can have another cache mode than the
+				// root filesystems.
+				var volumesOptions []string
+				if volumesVirtioFSCache(hconfig) != typeVirtioFSNoCache && hconfig.VirtioFSCacheSize != 0 {
+					volumesOptions = append(volumesOptions, sharedDirVirtioFSDaxOptions)
+				}
+
+				storages = append(storages, &grpc.Storage{
+					Driver:     kataVirtioFSDevType,
+					Source:     mountGuestVolumesTag,
+					MountPoint: kataGuestVolumesDir(),
+					Fstype:     typeVirtioFS,
+					Options:    volumesOptions,
+				})
+			}
 		} else {
-			sharedDir9pOptions = append(sharedDir9pOptions, fmt.Sprintf("msize=%d", sandbox.config.HypervisorConfig.Msize9p))
+			options := sharedDir9pOptions(&sandbox.config.HypervisorConfig)
 
 			sharedVolume := &grpc.Storage{
 				Driver:     kata9pDevType,
 				Source:     mountGuestTag,
 				MountPoint: kataGuestSharedDir(),
 				Fstype:     type9pFs,
-				Options:    sharedDir9pOptions,
+				Options:    options,
 			}
 
 			storages = append(storages, sharedVolume)
@@ -868,10 +1048,28 @@ func (k *kataAgent) replaceOCIMountSource(spec *specs.Spec, guestMounts map[stri
 	ociMounts := spec.Mounts
 
 	for index, m := range ociMounts {
-		if guestMount, ok := guestMounts[m.Destination]; ok {
+		guestMount, ok := guestMounts[m.Destination]
+		if ok {
 			k.Logger().Debugf("Replacing OCI mount (%s) source %s with %s", m.Destination, m.Source, guestMount.Source)
 			ociMounts[index].Source = guestMount.Source
 		}
+
+		// The virtio-fs cache policy options are for the runtime only,
+		// and a mount made read-only by the runtime, e.g. because the
+		// shared file system is read-only, is read-only in the guest too.
+		readOnly := ok && guestMount.ReadOnly
+		var options []string
+		for _, o := range m.Options {
+			if !isVirtioFSMountOption(o) && !(readOnly && o == "rw") {
+				options = append(options, o)
+			}
+		}
+		if readOnly && !containsString(options, "ro") {
+			options = append(options, "ro")
+		}
+		if len(options) != len(m.Options) || readOnly {
+			ociMounts[index].Options = options
+		}
 	}
 
 	return nil
@@ -913,7 +1111,8 @@ func (k *kataAgent) constrainGRPCSpec(grpcSpec *grpc.Spec, passSeccomp bool, str
 		grpcSpec.Process.SelinuxLabel = ""
 	}
 
-	// By now only CPU constraints are supported
+	// By now only CPU constraints are supported, the device cgroup rules
+	// are set from the OCI spec by guestDeviceCgroups().
 	// Issue: https://github.com/kata-containers/runtime/issues/158
 	// Issue: https://github.com/kata-containers/runtime/issues/204
 	grpcSpec.Linux.Resources.Devices = nil
@@ -973,6 +1172,70 @@ func (k *kataAgent) constrainGRPCSpec(grpcSpec *grpc.Spec, passSeccomp bool, str
 	}
 }
 
+// guestDeviceCgroups returns the device cgroup rules of the container to be
+// enforced in the guest. The rules matching any major and minor numbers are
+// kept, as well as the ones of the devices of the container, whose host major
+// and minor numbers are replaced with the guest ones by the agent. The other
+// rules, including the ones matching any minor number of a host major one,
+// refer to host devices the guest does not have, and are dropped.
+func guestDeviceCgroups(rules []specs.LinuxDeviceCgroup, devices []grpc.LinuxDevice) []grpc.LinuxDeviceCgroup {
+	var guestRules []grpc.LinuxDeviceCgroup
+
+	for _, r := range rules {
+		// The agent expects -1 for the wildcard numbers
+		major, minor := int64(-1), int64(-1)
+		if r.Major != nil {
+			major = *r.Major
+		}
+		if r.Minor != nil {
+			minor = *r.Minor
+		}
+
+		if major != -1 || minor != -1 {
+			found := false
+			for _, dev := range devices {
+				if dev.Type == r.Type && dev.Major == major && dev.Minor == minor {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				continue
+			}
+		}
+
+		guestRules = append(guestRules, grpc.LinuxDeviceCgroup{
+			Allow:  r.Allow,
+			Type:   r.Type,
+			Major:  major,
+			Minor:  minor,
+			Access: r.Access,
+		})
+	}
+
+	return guestRules
+}
+
+// handleDNS makes the container resolv.conf a bind mount of the sandbox one
+// when the latter holds DNS settings returned by the network plugins, which
+// the resolv.conf file shared from the host lacks.
+func (k *kataAgent) handleDNS(mounts []specs.Mount, sandbox *Sandbox) {
+	if len(mergeDNS(nil, networkDNSInfo(sandbox.network))) == 0 {
+		return
+	}
+
+	for idx, mnt := range mounts {
+		if mnt.Destination != GuestDNSFile {
+			continue
+		}
+
+		mounts[idx].Type = "bind"
+		mounts[idx].Source = filepath.Join(kataGuestSandboxDir(), guestDNSFileName)
+		k.Logger().Info("Using sandbox resolv.conf")
+	}
+}
+
 func (k *kataAgent) handleShm(mounts []specs.Mount, sandbox *Sandbox) {
 	for idx, mnt := range mounts {
 		if mnt.Destination != "/dev/shm" {
@@ -1165,15 +1428,18 @@ func (k *kataAgent) createContainer(ctx context.Context, sandbox *Sandbox, c *Co
 	// Share the container rootfs -- if its block based, we'll receive a non-nil storage object representing
 	// the block device for the rootfs, which us utilized for mounting in the guest. This'll be handled
 	// already for non-block based rootfs
+	shareStart := time.Now()
 	if sharedRootfs, err = sandbox.fsShare.ShareRootFilesystem(ctx, c); err != nil {
-		return nil, err
+		return nil, types.NewError(types.ErrorInternal, types.SubsystemStorage, false, err)
 	}
+	observeBootPhase(bootPhaseRootfsMount, shareStart)
 
 	if sharedRootfs.storage != nil {
 		// Add rootfs to the list of container storage.
 		// We only need to do this for block based rootfs, as we
 		// want the agent to mount it into the right location
 		// (kataGuestSharedDir/ctrID/
+		ctrStorages = append(ctrStorages, sharedRootfs.deps...)
 		ctrStorages = append(ctrStorages, sharedRootfs.storage)
 	}
 
@@ -1188,12 +1454,14 @@ func (k *kataAgent) createContainer(ctx context.Context, sandbox *Sandbox, c *Co
 
 	shareStorages, err := c.mountSharedDirMounts(ctx, sharedDirMounts, ignoredMounts)
 	if err != nil {
-		return nil, err
+		return nil, types.NewError(types.ErrorInternal, types.SubsystemStorage, false, err)
 	}
 	ctrStorages = append(ctrStorages, shareStorages...)
 
 	k.handleShm(ociSpec.Mounts, sandbox)
 
+	k.handleDNS(ociSpec.Mounts, sandbox)
+
 	epheStorages, err := k.handleEphemeralStorage(ociSpec.Mounts)
 	if err != nil {
 		return nil, err
@@ -1238,6 +1506,14 @@ func (k *kataAgent) createContainer(ctx context.Context, sandbox *Sandbox, c *Co
 
 	ctrStorages = append(ctrStorages, volumeStorages...)
 
+	// NFS and SMB volumes are mounted over the pod network
+	netStorages, err := k.handleNetworkVolumes(c, ociSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrStorages = append(ctrStorages, netStorages...)
+
 	grpcSpec, err := grpc.OCItoGRPC(ociSpec)
 	if err != nil {
 		return nil, err
@@ -1254,10 +1530,21 @@ func (k *kataAgent) createContainer(ctx context.Context, sandbox *Sandbox, c *Co
 
 	passSeccomp := !sandbox.config.DisableGuestSeccomp && sandbox.seccompSupported
 
+	if grpcSpec.Linux != nil && grpcSpec.Linux.Seccomp != nil && !passSeccomp {
+		if sandbox.config.RequireGuestSeccomp {
+			return nil, fmt.Errorf("The seccomp profile of container %s is required but not applied in the virtual machine", c.id)
+		}
+		k.Logger().WithField("container", c.id).Warn("Seccomp profile not applied in the virtual machine, disable_guest_seccomp is set")
+	}
+
 	// We need to constrain the spec to make sure we're not
 	// passing irrelevant information to the agent.
 	k.constrainGRPCSpec(grpcSpec, passSeccomp, sandbox.config.VfioMode == config.VFIOModeGuestKernel)
 
+	if ociSpec.Linux != nil && ociSpec.Linux.Resources != nil && grpcSpec.Linux.Resources != nil {
+		grpcSpec.Linux.Resources.Devices = guestDeviceCgroups(ociSpec.Linux.Resources.Devices, grpcSpec.Linux.Devices)
+	}
+
 	req := &grpc.CreateContainerRequest{
 		ContainerId:  c.id,
 		ExecId:       c.id,
@@ -1446,6 +1733,14 @@ func (k *kataAgent) handleDeviceBlockVolume(c *Container, m Mount, device api.De
 		return nil, fmt.Errorf("malformed block drive")
 	}
 	switch {
+	// virtio-pmem volumes case, mounted with DAX to bypass the guest page cache
+	case blockDrive.VirtioPmem:
+		vol.Driver = kataVirtioPmemDevType
+		vol.Source = blockDrive.PCIPath.String()
+		vol.Options = append([]string{}, m.Options...)
+		if !containsString(vol.Options, "dax") {
+			vol.Options = append(vol.Options, "dax")
+		}
 	// pmem volumes case
 	case blockDrive.Pmem:
 		vol.Driver = kataNvdimmDevType
@@ -1477,16 +1772,121 @@ func (k *kataAgent) handleDeviceBlockVolume(c *Container, m Mount, device api.De
 	if len(vol.Options) == 0 {
 		vol.Options = m.Options
 	}
+	if !blockDrive.Pmem && !blockDrive.VirtioPmem {
+		vol.Options = withDiscardOption(&c.sandbox.config.HypervisorConfig, vol.Fstype, blockDrive.ReadOnly || m.ReadOnly, vol.Options)
+	}
 	if m.FSGroup != nil {
 		vol.FsGroup = &grpc.FSGroup{
 			GroupId:           uint32(*m.FSGroup),
 			GroupChangePolicy: getFSGroupChangePolicy(m.FSGroupChangePolicy),
 		}
 	}
+	if m.Encryption != "" {
+		if blockDrive.Pmem || blockDrive.VirtioPmem {
+			return nil, fmt.Errorf("encryption is not supported for pmem volumes")
+		}
+		encryption, err := storageEncryption(m)
+		if err != nil {
+			return nil, err
+		}
+		vol.Encryption = encryption
+	}
 
 	return vol, nil
 }
 
+// storageEncryption returns the encryption of a block device mount, for the
+// agent to decrypt it with the key read from the key file of the mount.
+func storageEncryption(m Mount) (*grpc.StorageEncryption, error) {
+	if m.Encryption != volume.LUKSEncryption {
+		return nil, fmt.Errorf("unsupported encryption %q for mount %s", m.Encryption, m.Destination)
+	}
+
+	key, err := os.ReadFile(m.EncryptionKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the key of mount %s: %v", m.Destination, err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("empty key file %s for mount %s", m.EncryptionKeyFile, m.Destination)
+	}
+
+	return &grpc.StorageEncryption{
+		Type: m.Encryption,
+		Key:  key,
+	}, nil
+}
+
+// loggableStorages returns the storages without the keys of the encrypted
+// ones and the credentials of the network volumes.
+func loggableStorages(storages []*grpc.Storage) []*grpc.Storage {
+	redacted := make([]*grpc.Storage, len(storages))
+	for i, s := range storages {
+		storage := *s
+		if s.Encryption != nil {
+			storage.Encryption = &grpc.StorageEncryption{Type: s.Encryption.Type}
+		}
+		storage.Options = redactCredentialOptions(s.Options)
+		redacted[i] = &storage
+	}
+	return redacted
+}
+
+// loggableRequest returns the string of a request, without the keys of the
+// encrypted storages, the credentials of the network volumes or the content
+// of the watchable mount files, such as the ones of the secrets, it may
+// contain.
+func loggableRequest(message proto.Message) string {
+	switch req := message.(type) {
+	case *grpc.CreateContainerRequest:
+		redacted := *req
+		redacted.Storages = loggableStorages(req.Storages)
+		return redacted.String()
+	case *grpc.CreateSandboxRequest:
+		redacted := *req
+		redacted.Storages = loggableStorages(req.Storages)
+		return redacted.String()
+	case *grpc.SyncWatchableMountRequest:
+		redacted := *req
+		redacted.Entries = make([]*grpc.WatchableEntry, len(req.Entries))
+		for i, e := range req.Entries {
+			redacted.Entries[i] = e
+			if e.Data != nil {
+				entry := *e
+				entry.Data = nil
+				redacted.Entries[i] = &entry
+			}
+		}
+		return redacted.String()
+	default:
+		return message.String()
+	}
+}
+
+// agentRequestIDs identifies the container and the process a request to the
+// agent is about, which is all of the request the watchdog logs.
+type agentRequestIDs struct {
+	message proto.Message
+}
+
+// The generated requests have no getters, their fields are looked up by name.
+func (r agentRequestIDs) String() string {
+	req := reflect.Indirect(reflect.ValueOf(r.message))
+	if req.Kind() != reflect.Struct {
+		return ""
+	}
+
+	var ids []string
+	for _, id := range []struct{ field, name string }{
+		{"ContainerId", "container_id"},
+		{"ExecId", "exec_id"},
+	} {
+		if v := req.FieldByName(id.field); v.Kind() == reflect.String && v.String() != "" {
+			ids = append(ids, fmt.Sprintf("%s:%q", id.name, v.String()))
+		}
+	}
+	return strings.Join(ids, " ")
+}
+
 // handleVhostUserBlkVolume handles volume that is block device file
 // and VhostUserBlk type.
 func (k *kataAgent) handleVhostUserBlkVolume(c *Container, m Mount, device api.Device) (*grpc.Storage, error) {
@@ -1510,6 +1910,14 @@ func (k *kataAgent) handleVhostUserBlkVolume(c *Container, m Mount, device api.D
 		vol.Options = m.Options
 	}
 
+	if m.Encryption != "" {
+		encryption, err := storageEncryption(m)
+		if err != nil {
+			return nil, err
+		}
+		vol.Encryption = encryption
+	}
+
 	return vol, nil
 }
 
@@ -1674,6 +2082,10 @@ func (k *kataAgent) updateContainer(ctx context.Context, sandbox *Sandbox, c Con
 		return err
 	}
 
+	// The device cgroup rules are only set when creating the container,
+	// along with the devices of the container.
+	grpcResources.Devices = nil
+
 	req := &grpc.UpdateContainerRequest{
 		ContainerId: c.id,
 		Resources:   grpcResources,
@@ -1725,11 +2137,12 @@ func (k *kataAgent) memHotplugByProbe(ctx context.Context, addr uint64, sizeMB u
 	return err
 }
 
-func (k *kataAgent) onlineCPUMem(ctx context.Context, cpus uint32, cpuOnly bool) error {
+func (k *kataAgent) onlineCPUMem(ctx context.Context, cpus uint32, cpuOnly bool, memBlocks uint32) error {
 	req := &grpc.OnlineCPUMemRequest{
-		Wait:    false,
-		NbCpus:  cpus,
-		CpuOnly: cpuOnly,
+		Wait:        false,
+		NbCpus:      cpus,
+		CpuOnly:     cpuOnly,
+		NbMemBlocks: memBlocks,
 	}
 
 	_, err := k.sendReq(ctx, req)
@@ -1765,6 +2178,21 @@ func (k *kataAgent) statsContainer(ctx context.Context, sandbox *Sandbox, c Cont
 	containerStats := &ContainerStats{
 		CgroupStats: &cgroupStats,
 	}
+
+	for _, netStats := range stats.NetworkStats {
+		containerStats.NetworkStats = append(containerStats.NetworkStats, &NetworkStats{
+			Name:      netStats.Name,
+			RxBytes:   netStats.RxBytes,
+			RxPackets: netStats.RxPackets,
+			RxErrors:  netStats.RxErrors,
+			RxDropped: netStats.RxDropped,
+			TxBytes:   netStats.TxBytes,
+			TxPackets: netStats.TxPackets,
+			TxErrors:  netStats.TxErrors,
+			TxDropped: netStats.TxDropped,
+		})
+	}
+
 	return containerStats, nil
 }
 
@@ -1772,8 +2200,8 @@ func (k *kataAgent) connect(ctx context.Context) error {
 	if k.dead {
 		return errors.New("Dead agent")
 	}
-	// lockless quick pass
-	if k.client != nil {
+	// quick pass, the client may be replaced when reconnecting
+	if k.currentClient() != nil {
 		return nil
 	}
 
@@ -1794,12 +2222,138 @@ func (k *kataAgent) connect(ctx context.Context) error {
 		return err
 	}
 
-	k.installReqFunc(client)
+	k.installReqFunc()
+	k.client = client
+
+	return nil
+}
+
+// reconnect replaces the client whose connection to the agent was lost by a
+// new one. The agent is redialed without holding the lock, the requests sent
+// meanwhile wait for the outcome of the dial in progress, within their own
+// deadline. The ttrpc client multiplexes the concurrent requests over its
+// connection, a single one is kept.
+func (k *kataAgent) reconnect(ctx context.Context, lost *kataclient.AgentClient) error {
+	span, _ := katatrace.Trace(ctx, k.Logger(), "reconnect", kataAgentTracingTags)
+	defer span.End()
+
+	k.Lock()
+
+	// Another request already reconnected, or the client was disconnected
+	if k.client != lost {
+		k.Unlock()
+		return nil
+	}
+
+	if done := k.reconnecting; done != nil {
+		k.Unlock()
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		k.Lock()
+		defer k.Unlock()
+		if k.client == lost {
+			return errors.New("Could not reconnect to the agent")
+		}
+		return nil
+	}
+
+	done := make(chan struct{})
+	k.reconnecting = done
+	lost.Close()
+
+	k.Unlock()
+
+	client, err := k.redial(ctx)
+
+	k.Lock()
+	defer k.Unlock()
+
+	k.reconnecting = nil
+	close(done)
+
+	if err != nil {
+		return err
+	}
+
+	// The client was disconnected meanwhile
+	if k.client != lost {
+		client.Close()
+		return nil
+	}
+
+	agentReconnections.Inc()
+
+	// The request handlers use the current client
 	k.client = client
 
 	return nil
 }
 
+// redial dials the agent again, with a jittered exponential backoff, for
+// agentReconnectTimeout at most. It gives up at once when the hypervisor
+// process is gone, the agent being gone with it.
+func (k *kataAgent) redial(ctx context.Context) (*kataclient.AgentClient, error) {
+	ctx, cancel := context.WithTimeout(ctx, agentReconnectTimeout)
+	defer cancel()
+
+	var err error
+	delay := agentReconnectDelay
+	for i := 1; i <= agentReconnectAttempts; i++ {
+		if k.hypervisorGone() {
+			return nil, errors.New("The hypervisor process is gone")
+		}
+
+		var client *kataclient.AgentClient
+		client, err = kataclient.NewAgentClient(ctx, k.state.URL, k.dialTimout)
+		if err == nil {
+			k.Logger().WithField("attempt", i).Info("Reconnected to the agent")
+			return client, nil
+		}
+
+		k.Logger().WithError(err).WithField("attempt", i).Warn("Could not reconnect to the agent")
+		if i == agentReconnectAttempts {
+			break
+		}
+
+		select {
+		case <-time.After(kataclient.Jitter(delay)):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		delay *= 2
+	}
+
+	return nil, err
+}
+
+// hypervisorGone tells if the hypervisor process running the VM of the agent
+// exited.
+func (k *kataAgent) hypervisorGone() bool {
+	if k.hypervisor == nil {
+		return false
+	}
+
+	pids := k.hypervisor.GetPids()
+	if len(pids) == 0 || pids[0] <= 0 {
+		return false
+	}
+
+	return syscall.Kill(pids[0], syscall.Signal(0)) == syscall.ESRCH
+}
+
+// currentClient returns the client the requests are sent with.
+func (k *kataAgent) currentClient() *kataclient.AgentClient {
+	k.Lock()
+	defer k.Unlock()
+
+	return k.client
+}
+
 func (k *kataAgent) disconnect(ctx context.Context) error {
 	span, _ := katatrace.Trace(ctx, k.Logger(), "Disconnect", kataAgentTracingTags)
 	defer span.End()
@@ -1868,6 +2422,29 @@ func (k *kataAgent) closeProcessStdin(ctx context.Context, c *Container, Process
 	return err
 }
 
+func (k *kataAgent) openProcessIO(ctx context.Context, c *Container, processID string) (processIOPorts, error) {
+	resp, err := k.sendReq(ctx, &grpc.OpenProcessIORequest{
+		ContainerId:   c.id,
+		ExecId:        processID,
+		Bidirectional: true,
+	})
+	if err != nil {
+		return processIOPorts{}, err
+	}
+
+	ports := resp.(*grpc.ProcessIOPorts)
+
+	return processIOPorts{
+		stdin:  ports.StdinPort,
+		stdout: ports.StdoutPort,
+		stderr: ports.StderrPort,
+	}, nil
+}
+
+func (k *kataAgent) dialPort(ctx context.Context, port uint32) (net.Conn, error) {
+	return kataclient.DialPort(k.state.URL, port, k.dialTimout)
+}
+
 func (k *kataAgent) reseedRNG(ctx context.Context, data []byte) error {
 	_, err := k.sendReq(ctx, &grpc.ReseedRandomDevRequest{
 		Data: data,
@@ -1876,117 +2453,183 @@ func (k *kataAgent) reseedRNG(ctx context.Context, data []byte) error {
 	return err
 }
 
-type reqFunc func(context.Context, interface{}) (interface{}, error)
+// reqFunc sends a request with the client given, the one current when the
+// request is handled: the client is replaced when reconnecting to the agent.
+type reqFunc func(context.Context, *kataclient.AgentClient, interface{}) (interface{}, error)
 
-func (k *kataAgent) installReqFunc(c *kataclient.AgentClient) {
+func (k *kataAgent) installReqFunc() {
 	k.reqHandlers = make(map[string]reqFunc)
-	k.reqHandlers[grpcCheckRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.HealthClient.Check(ctx, req.(*grpc.CheckRequest))
+	k.reqHandlers[grpcCheckRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.HealthClient.Check(ctx, req.(*grpc.CheckRequest))
+	}
+	k.reqHandlers[grpcExecProcessRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.ExecProcess(ctx, req.(*grpc.ExecProcessRequest))
+	}
+	k.reqHandlers[grpcCreateSandboxRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.CreateSandbox(ctx, req.(*grpc.CreateSandboxRequest))
+	}
+	k.reqHandlers[grpcDestroySandboxRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.DestroySandbox(ctx, req.(*grpc.DestroySandboxRequest))
+	}
+	k.reqHandlers[grpcCreateContainerRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.CreateContainer(ctx, req.(*grpc.CreateContainerRequest))
 	}
-	k.reqHandlers[grpcExecProcessRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.ExecProcess(ctx, req.(*grpc.ExecProcessRequest))
+	k.reqHandlers[grpcStartContainerRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.StartContainer(ctx, req.(*grpc.StartContainerRequest))
 	}
-	k.reqHandlers[grpcCreateSandboxRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.CreateSandbox(ctx, req.(*grpc.CreateSandboxRequest))
+	k.reqHandlers[grpcRemoveContainerRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.RemoveContainer(ctx, req.(*grpc.RemoveContainerRequest))
 	}
-	k.reqHandlers[grpcDestroySandboxRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.DestroySandbox(ctx, req.(*grpc.DestroySandboxRequest))
+	k.reqHandlers[grpcSignalProcessRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.SignalProcess(ctx, req.(*grpc.SignalProcessRequest))
 	}
-	k.reqHandlers[grpcCreateContainerRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.CreateContainer(ctx, req.(*grpc.CreateContainerRequest))
+	k.reqHandlers[grpcUpdateRoutesRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.UpdateRoutes(ctx, req.(*grpc.UpdateRoutesRequest))
 	}
-	k.reqHandlers[grpcStartContainerRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.StartContainer(ctx, req.(*grpc.StartContainerRequest))
+	k.reqHandlers[grpcUpdateInterfaceRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.UpdateInterface(ctx, req.(*grpc.UpdateInterfaceRequest))
 	}
-	k.reqHandlers[grpcRemoveContainerRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.RemoveContainer(ctx, req.(*grpc.RemoveContainerRequest))
+	k.reqHandlers[grpcListInterfacesRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.ListInterfaces(ctx, req.(*grpc.ListInterfacesRequest))
 	}
-	k.reqHandlers[grpcSignalProcessRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.SignalProcess(ctx, req.(*grpc.SignalProcessRequest))
+	k.reqHandlers[grpcListRoutesRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.ListRoutes(ctx, req.(*grpc.ListRoutesRequest))
 	}
-	k.reqHandlers[grpcUpdateRoutesRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.UpdateRoutes(ctx, req.(*grpc.UpdateRoutesRequest))
+	k.reqHandlers[grpcAddARPNeighborsRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.AddARPNeighbors(ctx, req.(*grpc.AddARPNeighborsRequest))
 	}
-	k.reqHandlers[grpcUpdateInterfaceRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.UpdateInterface(ctx, req.(*grpc.UpdateInterfaceRequest))
+	k.reqHandlers[grpcGetIPTablesRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.GetIPTables(ctx, req.(*grpc.GetIPTablesRequest))
 	}
-	k.reqHandlers[grpcListInterfacesRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.ListInterfaces(ctx, req.(*grpc.ListInterfacesRequest))
+	k.reqHandlers[grpcSetIPTablesRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.SetIPTables(ctx, req.(*grpc.SetIPTablesRequest))
 	}
-	k.reqHandlers[grpcListRoutesRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.ListRoutes(ctx, req.(*grpc.ListRoutesRequest))
+	k.reqHandlers[grpcStartTracingRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.StartTracing(ctx, req.(*grpc.StartTracingRequest))
 	}
-	k.reqHandlers[grpcAddARPNeighborsRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.AddARPNeighbors(ctx, req.(*grpc.AddARPNeighborsRequest))
+	k.reqHandlers[grpcStopTracingRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.StopTracing(ctx, req.(*grpc.StopTracingRequest))
 	}
-	k.reqHandlers[grpcOnlineCPUMemRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.OnlineCPUMem(ctx, req.(*grpc.OnlineCPUMemRequest))
+	k.reqHandlers[grpcOnlineCPUMemRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.OnlineCPUMem(ctx, req.(*grpc.OnlineCPUMemRequest))
 	}
-	k.reqHandlers[grpcUpdateContainerRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.UpdateContainer(ctx, req.(*grpc.UpdateContainerRequest))
+	k.reqHandlers[grpcUpdateContainerRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.UpdateContainer(ctx, req.(*grpc.UpdateContainerRequest))
 	}
-	k.reqHandlers[grpcWaitProcessRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.WaitProcess(ctx, req.(*grpc.WaitProcessRequest))
+	k.reqHandlers[grpcWaitProcessRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.WaitProcess(ctx, req.(*grpc.WaitProcessRequest))
 	}
-	k.reqHandlers[grpcTtyWinResizeRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.TtyWinResize(ctx, req.(*grpc.TtyWinResizeRequest))
+	k.reqHandlers[grpcTtyWinResizeRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.TtyWinResize(ctx, req.(*grpc.TtyWinResizeRequest))
 	}
-	k.reqHandlers[grpcWriteStreamRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.WriteStdin(ctx, req.(*grpc.WriteStreamRequest))
+	k.reqHandlers[grpcOpenProcessIORequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.OpenProcessIO(ctx, req.(*grpc.OpenProcessIORequest))
 	}
-	k.reqHandlers[grpcCloseStdinRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.CloseStdin(ctx, req.(*grpc.CloseStdinRequest))
+	k.reqHandlers[grpcWriteStreamRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.WriteStdin(ctx, req.(*grpc.WriteStreamRequest))
 	}
-	k.reqHandlers[grpcStatsContainerRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.StatsContainer(ctx, req.(*grpc.StatsContainerRequest))
+	k.reqHandlers[grpcCloseStdinRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.CloseStdin(ctx, req.(*grpc.CloseStdinRequest))
 	}
-	k.reqHandlers[grpcPauseContainerRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.PauseContainer(ctx, req.(*grpc.PauseContainerRequest))
+	k.reqHandlers[grpcStatsContainerRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.StatsContainer(ctx, req.(*grpc.StatsContainerRequest))
 	}
-	k.reqHandlers[grpcResumeContainerRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.ResumeContainer(ctx, req.(*grpc.ResumeContainerRequest))
+	k.reqHandlers[grpcPauseContainerRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.PauseContainer(ctx, req.(*grpc.PauseContainerRequest))
 	}
-	k.reqHandlers[grpcReseedRandomDevRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.ReseedRandomDev(ctx, req.(*grpc.ReseedRandomDevRequest))
+	k.reqHandlers[grpcResumeContainerRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.ResumeContainer(ctx, req.(*grpc.ResumeContainerRequest))
 	}
-	k.reqHandlers[grpcGuestDetailsRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.GetGuestDetails(ctx, req.(*grpc.GuestDetailsRequest))
+	k.reqHandlers[grpcReseedRandomDevRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.ReseedRandomDev(ctx, req.(*grpc.ReseedRandomDevRequest))
 	}
-	k.reqHandlers[grpcMemHotplugByProbeRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.MemHotplugByProbe(ctx, req.(*grpc.MemHotplugByProbeRequest))
+	k.reqHandlers[grpcGuestDetailsRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.GetGuestDetails(ctx, req.(*grpc.GuestDetailsRequest))
 	}
-	k.reqHandlers[grpcCopyFileRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.CopyFile(ctx, req.(*grpc.CopyFileRequest))
+	k.reqHandlers[grpcMemHotplugByProbeRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.MemHotplugByProbe(ctx, req.(*grpc.MemHotplugByProbeRequest))
 	}
-	k.reqHandlers[grpcSetGuestDateTimeRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.SetGuestDateTime(ctx, req.(*grpc.SetGuestDateTimeRequest))
+	k.reqHandlers[grpcCopyFileRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.CopyFile(ctx, req.(*grpc.CopyFileRequest))
 	}
-	k.reqHandlers[grpcGetOOMEventRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.GetOOMEvent(ctx, req.(*grpc.GetOOMEventRequest))
+	k.reqHandlers[grpcSetGuestDateTimeRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.SetGuestDateTime(ctx, req.(*grpc.SetGuestDateTimeRequest))
 	}
-	k.reqHandlers[grpcGetMetricsRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.GetMetrics(ctx, req.(*grpc.GetMetricsRequest))
+	k.reqHandlers[grpcGetOOMEventRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.GetOOMEvent(ctx, req.(*grpc.GetOOMEventRequest))
 	}
-	k.reqHandlers[grpcAddSwapRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.AddSwap(ctx, req.(*grpc.AddSwapRequest))
+	k.reqHandlers[grpcGetMetricsRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.GetMetrics(ctx, req.(*grpc.GetMetricsRequest))
 	}
-	k.reqHandlers[grpcVolumeStatsRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.GetVolumeStats(ctx, req.(*grpc.VolumeStatsRequest))
+	k.reqHandlers[grpcAddSwapRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.AddSwap(ctx, req.(*grpc.AddSwapRequest))
 	}
-	k.reqHandlers[grpcResizeVolumeRequest] = func(ctx context.Context, req interface{}) (interface{}, error) {
-		return k.client.AgentServiceClient.ResizeVolume(ctx, req.(*grpc.ResizeVolumeRequest))
+	k.reqHandlers[grpcVolumeStatsRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.GetVolumeStats(ctx, req.(*grpc.VolumeStatsRequest))
+	}
+	k.reqHandlers[grpcResizeVolumeRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.ResizeVolume(ctx, req.(*grpc.ResizeVolumeRequest))
+	}
+	k.reqHandlers[grpcSyncWatchableRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.SyncWatchableMount(ctx, req.(*grpc.SyncWatchableMountRequest))
+	}
+	k.reqHandlers[grpcSetPolicyRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.SetPolicy(ctx, req.(*grpc.SetPolicyRequest))
+	}
+	k.reqHandlers[grpcGetPolicyRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.GetPolicy(ctx, req.(*grpc.GetPolicyRequest))
+	}
+	k.reqHandlers[grpcExecuteHooksRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.ExecuteHooks(ctx, req.(*grpc.ExecuteHooksRequest))
+	}
+	k.reqHandlers[grpcGetDiagnosticsRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.GetDiagnostics(ctx, req.(*grpc.GetDiagnosticsRequest))
+	}
+	k.reqHandlers[grpcSetLogLevelRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.SetLogLevel(ctx, req.(*grpc.SetLogLevelRequest))
+	}
+	k.reqHandlers[grpcReadKernelLogRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.ReadKernelLog(ctx, req.(*grpc.ReadKernelLogRequest))
+	}
+	k.reqHandlers[grpcSetGuestTimeSourceRequest] = func(ctx context.Context, c *kataclient.AgentClient, req interface{}) (interface{}, error) {
+		return c.AgentServiceClient.SetGuestTimeSource(ctx, req.(*grpc.SetGuestTimeSourceRequest))
+	}
+}
+
+// requestTimeouts returns the timeouts of the requests configured.
+func requestTimeouts(config KataAgentConfig) map[string]time.Duration {
+	timeouts := make(map[string]time.Duration)
+
+	set := func(timeout uint32, reqNames ...string) {
+		if timeout == 0 {
+			return
+		}
+		for _, reqName := range reqNames {
+			timeouts[reqName] = time.Duration(timeout) * time.Second
+		}
 	}
+
+	set(config.CreateContainerTimeout, grpcCreateContainerRequest)
+	set(config.ExecProcessTimeout, grpcExecProcessRequest)
+	set(config.HotplugTimeout, grpcOnlineCPUMemRequest, grpcMemHotplugByProbeRequest, grpcUpdateInterfaceRequest)
+	set(config.ShutdownTimeout, grpcDestroySandboxRequest)
+
+	return timeouts
 }
 
 func (k *kataAgent) getReqContext(ctx context.Context, reqName string) (newCtx context.Context, cancel context.CancelFunc) {
 	newCtx = ctx
 	switch reqName {
-	case grpcWaitProcessRequest, grpcGetOOMEventRequest:
-		// Wait and GetOOMEvent have no timeout
+	case grpcWaitProcessRequest, grpcGetOOMEventRequest, grpcReadKernelLogRequest:
+		// Wait, GetOOMEvent and ReadKernelLog have no timeout
 	case grpcCheckRequest:
 		newCtx, cancel = context.WithTimeout(ctx, checkRequestTimeout)
 	default:
-		newCtx, cancel = context.WithTimeout(ctx, defaultRequestTimeout)
+		timeout, ok := k.reqTimeouts[reqName]
+		if !ok {
+			timeout = defaultRequestTimeout
+		}
+		newCtx, cancel = context.WithTimeout(ctx, timeout)
 	}
 
 	return newCtx, cancel
@@ -1996,7 +2639,7 @@ func (k *kataAgent) sendReq(spanCtx context.Context, request interface{}) (inter
 	start := time.Now()
 
 	if err := k.connect(spanCtx); err != nil {
-		return nil, err
+		return nil, types.NewError(types.ErrorUnavailable, types.SubsystemAgent, false, err)
 	}
 	if !k.keepConn {
 		defer k.disconnect(spanCtx)
@@ -2004,30 +2647,91 @@ func (k *kataAgent) sendReq(spanCtx context.Context, request interface{}) (inter
 
 	msgName := proto.MessageName(request.(proto.Message))
 
+	message := request.(proto.Message)
+	ctx, cancel := k.getReqContext(spanCtx, msgName)
+	if cancel != nil {
+		defer cancel()
+		// the requests waiting for an event, without timeout, are not
+		// watched. Their payload, which may hold the data of the processes
+		// or of the files copied, is not logged, only the container and
+		// process they are about.
+		defer watchOperation(slowOperationAgent, msgName, agentRequestIDs{message})()
+	}
+	k.Logger().WithField("name", msgName).WithField("req", loggableRequest(message)).Trace("sending request")
+
+	defer func() {
+		agentRPCDurationsHistogram.WithLabelValues(msgName).Observe(float64(time.Since(start).Nanoseconds() / int64(time.Millisecond)))
+	}()
+
+	resp, err := k.handleReq(ctx, msgName, request)
+	if err != nil && agentRetriableRequests[msgName] && errors.Is(err, errAgentReconnected) {
+		k.Logger().WithField("name", msgName).Info("Sending the request again")
+		resp, err = k.handleReq(ctx, msgName, request)
+	}
+
+	return resp, agentError(msgName, err)
+}
+
+// agentError classifies the errors of the requests to the agent. The errors
+// returned by the agent are grpc statuses already, the others are failures
+// to reach it. These are only retriable for the requests without side
+// effects, the agent may have handled the others before the failure.
+func agentError(msgName string, err error) error {
+	if _, ok := grpcStatus.FromError(err); ok {
+		return err
+	}
+
+	category := types.ErrorUnavailable
+	if errors.Is(err, context.DeadlineExceeded) {
+		category = types.ErrorTimeout
+	}
+
+	return &types.Error{
+		Err:       err,
+		Category:  category,
+		Subsystem: types.SubsystemAgent,
+		Retriable: agentRetriableRequests[msgName],
+	}
+}
+
+// errAgentReconnected reports a request failed because the connection to the
+// agent was lost, and the client reconnected.
+var errAgentReconnected = errors.New("The connection to the agent was lost")
+
+// handleReq sends a request to the agent, and reconnects to it when the
+// connection was lost.
+func (k *kataAgent) handleReq(ctx context.Context, msgName string, request interface{}) (interface{}, error) {
 	k.Lock()
 
 	if k.reqHandlers == nil {
+		k.Unlock()
 		return nil, errors.New("Client has already disconnected")
 	}
 
 	handler := k.reqHandlers[msgName]
 	if msgName == "" || handler == nil {
+		k.Unlock()
 		return nil, errors.New("Invalid request type")
 	}
 
+	client := k.client
+
 	k.Unlock()
 
-	message := request.(proto.Message)
-	ctx, cancel := k.getReqContext(spanCtx, msgName)
-	if cancel != nil {
-		defer cancel()
+	resp, err := handler(ctx, client, request)
+	if !errors.Is(err, ttrpc.ErrClosed) {
+		return resp, err
 	}
-	k.Logger().WithField("name", msgName).WithField("req", message.String()).Trace("sending request")
 
-	defer func() {
-		agentRPCDurationsHistogram.WithLabelValues(msgName).Observe(float64(time.Since(start).Nanoseconds() / int64(time.Millisecond)))
-	}()
-	return handler(ctx, request)
+	k.Logger().WithError(err).WithField("name", msgName).Warn("The connection to the agent was lost")
+
+	if rerr := k.reconnect(ctx, client); rerr != nil {
+		// The callers watching the agent check for the closed connection
+		k.Logger().WithError(rerr).Error("Could not reconnect to the agent")
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("%w: %v", errAgentReconnected, err)
 }
 
 // readStdout and readStderr are special that we cannot differentiate them with the request types...
@@ -2039,7 +2743,12 @@ func (k *kataAgent) readProcessStdout(ctx context.Context, c *Container, process
 		defer k.disconnect(ctx)
 	}
 
-	return k.readProcessStream(c.id, processID, data, k.client.AgentServiceClient.ReadStdout)
+	client := k.currentClient()
+	if client == nil {
+		return 0, errors.New("Client has already disconnected")
+	}
+
+	return k.readProcessStream(c.id, processID, data, client.AgentServiceClient.ReadStdout)
 }
 
 // readStdout and readStderr are special that we cannot differentiate them with the request types...
@@ -2051,7 +2760,12 @@ func (k *kataAgent) readProcessStderr(ctx context.Context, c *Container, process
 		defer k.disconnect(ctx)
 	}
 
-	return k.readProcessStream(c.id, processID, data, k.client.AgentServiceClient.ReadStderr)
+	client := k.currentClient()
+	if client == nil {
+		return 0, errors.New("Client has already disconnected")
+	}
+
+	return k.readProcessStream(c.id, processID, data, client.AgentServiceClient.ReadStderr)
 }
 
 type readFn func(context.Context, *grpc.ReadStreamRequest) (*grpc.ReadStreamResponse, error)
@@ -2212,3 +2926,136 @@ func (k *kataAgent) resizeGuestVolume(ctx context.Context, volumeGuestPath strin
 	_, err := k.sendReq(ctx, &grpc.ResizeVolumeRequest{VolumeGuestPath: volumeGuestPath, Size_: size})
 	return err
 }
+
+func (k *kataAgent) getIPTables(ctx context.Context, isIPv6 bool) ([]byte, error) {
+	resp, err := k.sendReq(ctx, &grpc.GetIPTablesRequest{IsIpv6: isIPv6})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*grpc.GetIPTablesResponse).Data, nil
+}
+
+func (k *kataAgent) setIPTables(ctx context.Context, isIPv6 bool, data []byte) error {
+	resp, err := k.sendReq(ctx, &grpc.SetIPTablesRequest{IsIpv6: isIPv6, Data: data})
+	if err != nil {
+		return err
+	}
+
+	if output := resp.(*grpc.SetIPTablesResponse).Data; len(output) > 0 {
+		k.Logger().WithField("ipv6", isIPv6).Debugf("set iptables: %s", output)
+	}
+
+	return nil
+}
+
+func (k *kataAgent) setAgentTracing(ctx context.Context, enable bool) error {
+	var req interface{} = &grpc.StopTracingRequest{}
+	if enable {
+		req = &grpc.StartTracingRequest{}
+	}
+
+	_, err := k.sendReq(ctx, req)
+	return err
+}
+
+func (k *kataAgent) syncWatchableMount(ctx context.Context, req *grpc.SyncWatchableMountRequest) error {
+	_, err := k.sendReq(ctx, req)
+	return err
+}
+
+func (k *kataAgent) setPolicy(ctx context.Context, policy string) error {
+	_, err := k.sendReq(ctx, &grpc.SetPolicyRequest{Policy: policy})
+	return err
+}
+
+func (k *kataAgent) getPolicy(ctx context.Context) (string, error) {
+	resp, err := k.sendReq(ctx, &grpc.GetPolicyRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.(*grpc.Policy).Policy, nil
+}
+
+func (k *kataAgent) executeHooks(ctx context.Context, containerID string, hooks []specs.Hook) error {
+	req := &grpc.ExecuteHooksRequest{
+		ContainerId: containerID,
+	}
+
+	for _, h := range hooks {
+		hook := &grpc.Hook{
+			Path: h.Path,
+			Args: h.Args,
+			Env:  h.Env,
+		}
+		if h.Timeout != nil {
+			hook.Timeout = int64(*h.Timeout)
+		}
+		req.Hooks = append(req.Hooks, hook)
+	}
+
+	_, err := k.sendReq(ctx, req)
+	return err
+}
+
+func (k *kataAgent) getDiagnostics(ctx context.Context, containerID string) (*grpc.Diagnostics, error) {
+	req := &grpc.GetDiagnosticsRequest{
+		ContainerId: containerID,
+	}
+
+	resp, err := k.sendReq(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*grpc.Diagnostics), nil
+}
+
+func (k *kataAgent) readCoreDump(ctx context.Context, containerID, name string, offset uint64) (*grpc.DiagnosticsFile, error) {
+	req := &grpc.GetDiagnosticsRequest{
+		ContainerId: containerID,
+		CoreDump:    name,
+		Offset:      offset,
+	}
+
+	resp, err := k.sendReq(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	files := resp.(*grpc.Diagnostics).Files
+	if len(files) != 1 {
+		return nil, fmt.Errorf("the agent returned %d files for the core dump %s", len(files), name)
+	}
+
+	return files[0], nil
+}
+
+func (k *kataAgent) setLogLevel(ctx context.Context, level string) error {
+	req := &grpc.SetLogLevelRequest{
+		Level: level,
+	}
+
+	_, err := k.sendReq(ctx, req)
+	return err
+}
+
+func (k *kataAgent) readKernelLog(ctx context.Context) ([]*grpc.KernelLogRecord, error) {
+	resp, err := k.sendReq(ctx, &grpc.ReadKernelLogRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*grpc.KernelLog).Records, nil
+}
+
+func (k *kataAgent) setGuestTimeSource(ctx context.Context, source string, ntpServers []string) error {
+	req := &grpc.SetGuestTimeSourceRequest{
+		Source:     source,
+		NtpServers: ntpServers,
+	}
+
+	_, err := k.sendReq(ctx, req)
+	return err
+}
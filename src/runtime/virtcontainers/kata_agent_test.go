@@ -8,9 +8,11 @@ package virtcontainers
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path"
 	"path/filepath"
 	"reflect"
@@ -18,9 +20,13 @@ import (
 	"strings"
 	"syscall"
 	"testing"
+	"time"
 
+	"github.com/containerd/ttrpc"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 
 	"code.cloudfoundry.org/bytefmt"
 	volume "github.com/kata-containers/kata-containers/src/runtime/pkg/direct-volume"
@@ -73,6 +79,87 @@ func TestKataAgentConnect(t *testing.T) {
 	assert.NotNil(k.client)
 }
 
+func TestKataAgentReconnect(t *testing.T) {
+	assert := assert.New(t)
+
+	url, err := mock.GenerateKataMockHybridVSock()
+	assert.NoError(err)
+	defer mock.RemoveKataMockHybridVSock(url)
+
+	hybridVSockTTRPCMock := mock.HybridVSockTTRPCMock{}
+	err = hybridVSockTTRPCMock.Start(url)
+	assert.NoError(err)
+	defer hybridVSockTTRPCMock.Stop()
+
+	k := &kataAgent{
+		ctx: context.Background(),
+		state: KataAgentState{
+			URL: url,
+		},
+		keepConn: true,
+	}
+
+	ctx := context.Background()
+
+	assert.NoError(k.check(ctx))
+	lost := k.client
+
+	// The retriable requests are sent again on the new connection
+	lost.Close()
+	assert.NoError(k.check(ctx))
+	assert.NotSame(lost, k.currentClient())
+
+	// The other ones fail, but the next requests succeed
+	lost = k.client
+	lost.Close()
+	err = k.startContainer(ctx, &Sandbox{}, &Container{})
+	assert.ErrorIs(err, errAgentReconnected)
+	assert.NotSame(lost, k.currentClient())
+	assert.NoError(k.startContainer(ctx, &Sandbox{}, &Container{}))
+}
+
+func TestKataAgentReconnectGiveUp(t *testing.T) {
+	assert := assert.New(t)
+
+	url, err := mock.GenerateKataMockHybridVSock()
+	assert.NoError(err)
+	defer mock.RemoveKataMockHybridVSock(url)
+
+	hybridVSockTTRPCMock := mock.HybridVSockTTRPCMock{}
+	err = hybridVSockTTRPCMock.Start(url)
+	assert.NoError(err)
+
+	k := &kataAgent{
+		ctx: context.Background(),
+		state: KataAgentState{
+			URL: url,
+		},
+		keepConn: true,
+	}
+
+	assert.NoError(k.connect(context.Background()))
+	hybridVSockTTRPCMock.Stop()
+
+	// The redial does not outlast the deadline of the request
+	lost := k.client
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	assert.Error(k.reconnect(ctx, lost))
+	assert.Less(time.Since(start), agentReconnectTimeout)
+	assert.Nil(k.reconnecting)
+
+	// Nor is the agent redialed once the hypervisor process is gone
+	cmd := exec.Command("true")
+	assert.NoError(cmd.Run())
+	k.hypervisor = &mockHypervisor{mockPid: cmd.Process.Pid}
+
+	start = time.Now()
+	err = k.reconnect(context.Background(), lost)
+	assert.EqualError(err, "The hypervisor process is gone")
+	assert.Less(time.Since(start), agentReconnectDelay)
+}
+
 func TestKataAgentDisconnect(t *testing.T) {
 	assert := assert.New(t)
 
@@ -159,7 +246,7 @@ func TestKataAgentSendReq(t *testing.T) {
 	err = k.resumeContainer(ctx, sandbox, Container{})
 	assert.Nil(err)
 
-	err = k.onlineCPUMem(ctx, 1, true)
+	err = k.onlineCPUMem(ctx, 1, true, 0)
 	assert.Nil(err)
 
 	_, err = k.statsContainer(ctx, sandbox, Container{})
@@ -185,6 +272,46 @@ func TestKataAgentSendReq(t *testing.T) {
 
 	_, err = k.getOOMEvent(ctx)
 	assert.Nil(err)
+
+	err = k.setPolicy(ctx, "[endpoints]\nallowed = []\n")
+	assert.Nil(err)
+
+	_, err = k.getPolicy(ctx)
+	assert.Nil(err)
+
+	err = k.setLogLevel(ctx, "debug")
+	assert.Nil(err)
+}
+
+func TestAgentError(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(agentError(grpcCheckRequest, nil))
+
+	// the errors of the agent are kept as is
+	err := grpcStatus.Error(codes.NotFound, "container not found")
+	assert.Equal(err, agentError(grpcCheckRequest, err))
+
+	var e *types.Error
+	assert.True(errors.As(agentError(grpcCheckRequest, ttrpc.ErrClosed), &e))
+	assert.Equal(types.ErrorUnavailable, e.Category)
+	assert.Equal(types.SubsystemAgent, e.Subsystem)
+	assert.True(e.Retriable)
+	assert.True(errors.Is(e, ttrpc.ErrClosed))
+
+	assert.True(errors.As(agentError(grpcCheckRequest, context.DeadlineExceeded), &e))
+	assert.Equal(types.ErrorTimeout, e.Category)
+	assert.True(e.Retriable)
+
+	// the requests with side effects are not retriable
+	err = fmt.Errorf("%w: %v", errAgentReconnected, ttrpc.ErrClosed)
+	assert.True(errors.As(agentError(grpcStartContainerRequest, err), &e))
+	assert.Equal(types.ErrorUnavailable, e.Category)
+	assert.False(e.Retriable)
+
+	assert.True(errors.As(agentError(grpcStartContainerRequest, context.DeadlineExceeded), &e))
+	assert.Equal(types.ErrorTimeout, e.Category)
+	assert.False(e.Retriable)
 }
 
 func TestHandleEphemeralStorage(t *testing.T) {
@@ -258,6 +385,25 @@ func TestHandleDeviceBlockVolume(t *testing.T) {
 				Options: []string{"dax"},
 			},
 		},
+		{
+			BlockDeviceDriver: config.VirtioSCSI,
+			inputDev: &drivers.BlockDevice{
+				BlockDrive: &config.BlockDrive{
+					VirtioPmem: true,
+					PCIPath:    testPCIPath,
+				},
+			},
+			inputMount: Mount{
+				Type:    "xfs",
+				Options: []string{"ro"},
+			},
+			resultVol: &pb.Storage{
+				Driver:  kataVirtioPmemDevType,
+				Source:  testPCIPath.String(),
+				Fstype:  "xfs",
+				Options: []string{"ro", "dax"},
+			},
+		},
 		{
 			BlockDeviceDriver: config.VirtioBlockCCW,
 			inputMount: Mount{
@@ -355,6 +501,105 @@ func TestHandleDeviceBlockVolume(t *testing.T) {
 	}
 }
 
+func TestWithDiscardOption(t *testing.T) {
+	assert := assert.New(t)
+
+	hconfig := &HypervisorConfig{}
+	assert.Equal([]string{"rw"}, withDiscardOption(hconfig, "ext4", false, []string{"rw"}))
+
+	hconfig.BlockDeviceDiscard = true
+	options := []string{"nouuid"}
+	assert.Equal([]string{"nouuid", "discard"}, withDiscardOption(hconfig, "xfs", false, options))
+	assert.Equal([]string{"nouuid"}, options)
+	assert.Equal([]string{"discard"}, withDiscardOption(hconfig, "ext4", false, nil))
+
+	// Unsupported filesystems, read-only and explicit options
+	assert.Nil(withDiscardOption(hconfig, "bind", false, nil))
+	assert.Nil(withDiscardOption(hconfig, "ext4", true, nil))
+	assert.Equal([]string{"ro"}, withDiscardOption(hconfig, "ext4", false, []string{"ro"}))
+	assert.Equal([]string{"nodiscard"}, withDiscardOption(hconfig, "ext4", false, []string{"nodiscard"}))
+}
+
+func TestStorageEncryption(t *testing.T) {
+	assert := assert.New(t)
+
+	keyFile := filepath.Join(t.TempDir(), "key")
+	m := Mount{
+		Destination:       "/data",
+		Encryption:        volume.LUKSEncryption,
+		EncryptionKeyFile: keyFile,
+	}
+
+	// Missing key file
+	_, err := storageEncryption(m)
+	assert.Error(err)
+
+	// Empty key
+	assert.NoError(os.WriteFile(keyFile, nil, 0600))
+	_, err = storageEncryption(m)
+	assert.Error(err)
+
+	assert.NoError(os.WriteFile(keyFile, []byte("secret"), 0600))
+	encryption, err := storageEncryption(m)
+	assert.NoError(err)
+	assert.Equal(&pb.StorageEncryption{Type: volume.LUKSEncryption, Key: []byte("secret")}, encryption)
+
+	m.Encryption = "dm-crypt"
+	_, err = storageEncryption(m)
+	assert.Error(err)
+
+	// The key must not be logged
+	req := &pb.CreateContainerRequest{
+		ContainerId: "foo",
+		Storages: []*pb.Storage{
+			{Driver: kataBlkDevType, MountPoint: "/data", Encryption: encryption},
+			{Driver: kataEphemeralDevType, MountPoint: "/tmp"},
+		},
+	}
+	logged := loggableRequest(req)
+	assert.NotContains(logged, "secret")
+	assert.Contains(logged, "/tmp")
+	assert.Equal([]byte("secret"), req.Storages[0].Encryption.Key)
+}
+
+func TestLoggableRequestWatchableMount(t *testing.T) {
+	assert := assert.New(t)
+
+	// The content of the files, such as the ones of the secrets, must not
+	// be logged
+	req := &pb.SyncWatchableMountRequest{
+		MountPoint: "/run/kata-containers/shared/containers/watchable/foo",
+		Entries: []*pb.WatchableEntry{
+			{Path: "password", Mode: 0100600, Size_: 6, Data: []byte("secret")},
+			{Path: "link", Mode: 0120777, LinkTarget: "password"},
+		},
+		Removed: []string{"token"},
+	}
+	secret := fmt.Sprintf("%v", []byte("secret"))
+	assert.Contains(req.String(), secret)
+
+	logged := loggableRequest(req)
+	assert.NotContains(logged, secret)
+	assert.Contains(logged, "password")
+	assert.Contains(logged, "token")
+	assert.Equal([]byte("secret"), req.Entries[0].Data)
+}
+
+func TestAgentRequestIDs(t *testing.T) {
+	assert := assert.New(t)
+
+	// Only the container and process of the request are logged, not its
+	// payload
+	ids := agentRequestIDs{&pb.WriteStreamRequest{ContainerId: "foo", ExecId: "bar", Data: []byte("secret")}}
+	assert.Equal(`container_id:"foo" exec_id:"bar"`, ids.String())
+
+	ids = agentRequestIDs{&pb.StatsContainerRequest{ContainerId: "foo"}}
+	assert.Equal(`container_id:"foo"`, ids.String())
+
+	ids = agentRequestIDs{&pb.GuestDetailsRequest{}}
+	assert.Empty(ids.String())
+}
+
 func TestHandleBlockVolume(t *testing.T) {
 	k := kataAgent{}
 
@@ -572,6 +817,31 @@ func TestAppendVhostUserBlkDevices(t *testing.T) {
 		updatedDevList, expected)
 }
 
+func TestGuestDeviceCgroups(t *testing.T) {
+	assert := assert.New(t)
+
+	major, minor := int64(8), int64(16)
+	otherMinor := int64(32)
+
+	rules := []specs.LinuxDeviceCgroup{
+		{Allow: false, Access: "rwm"},
+		{Allow: true, Type: "b", Major: &major, Minor: &minor, Access: "rw"},
+		{Allow: true, Type: "b", Major: &major, Minor: &otherMinor, Access: "rw"},
+		{Allow: true, Type: "c", Major: &major, Access: "m"},
+	}
+	devices := []pb.LinuxDevice{
+		{Path: "/dev/xda", Type: "b", Major: major, Minor: minor},
+	}
+
+	// the rule of the host major number is dropped
+	assert.Equal([]pb.LinuxDeviceCgroup{
+		{Allow: false, Major: -1, Minor: -1, Access: "rwm"},
+		{Allow: true, Type: "b", Major: major, Minor: minor, Access: "rw"},
+	}, guestDeviceCgroups(rules, devices))
+
+	assert.Nil(guestDeviceCgroups(nil, devices))
+}
+
 func TestConstrainGRPCSpec(t *testing.T) {
 	assert := assert.New(t)
 	expectedCgroupPath := "/foo/bar"
@@ -648,6 +918,70 @@ func TestConstrainGRPCSpec(t *testing.T) {
 	assert.Empty(g.Linux.Devices)
 }
 
+func TestReplaceOCIMountSource(t *testing.T) {
+	assert := assert.New(t)
+
+	k := kataAgent{}
+	spec := &specs.Spec{
+		Mounts: []specs.Mount{
+			{Destination: "/data", Source: "/host/data", Type: "bind", Options: []string{"rbind", "rw", virtioFSCacheMountOption + "none"}},
+			{Destination: "/config", Source: "/host/config", Type: "bind", Options: []string{"rbind", "rw"}},
+			{Destination: "/proc", Source: "proc", Type: "proc"},
+		},
+	}
+	guestMounts := map[string]Mount{
+		"/data":   {Source: "/run/kata-containers/shared/containers/data", ReadOnly: true},
+		"/config": {Source: "/run/kata-containers/shared/containers/config"},
+	}
+
+	assert.NoError(k.replaceOCIMountSource(spec, guestMounts))
+
+	// The runtime options are dropped and the read-only mount is
+	// read-only in the guest.
+	assert.Equal("/run/kata-containers/shared/containers/data", spec.Mounts[0].Source)
+	assert.Equal([]string{"rbind", "ro"}, spec.Mounts[0].Options)
+	assert.Equal("/run/kata-containers/shared/containers/config", spec.Mounts[1].Source)
+	assert.Equal([]string{"rbind", "rw"}, spec.Mounts[1].Options)
+	assert.Equal("proc", spec.Mounts[2].Source)
+	assert.Nil(spec.Mounts[2].Options)
+}
+
+func TestHandleDNS(t *testing.T) {
+	assert := assert.New(t)
+	k := kataAgent{}
+
+	network, err := NewNetwork()
+	assert.NoError(err)
+	sandbox := &Sandbox{
+		network: network,
+	}
+
+	ociMounts := []specs.Mount{
+		{
+			Type:        "bind",
+			Source:      "/run/kata-containers/shared/containers/resolv.conf",
+			Destination: GuestDNSFile,
+			Options:     []string{"rbind", "ro"},
+		},
+	}
+
+	// No DNS settings from the network plugins
+	k.handleDNS(ociMounts, sandbox)
+	assert.Equal("/run/kata-containers/shared/containers/resolv.conf", ociMounts[0].Source)
+
+	network.SetEndpoints([]Endpoint{
+		&VethEndpoint{
+			EndpointProperties: NetworkInfo{
+				DNS: DNSInfo{Servers: []string{"10.0.0.10"}},
+			},
+		},
+	})
+	k.handleDNS(ociMounts, sandbox)
+	assert.Equal(filepath.Join(kataGuestSandboxDir(), guestDNSFileName), ociMounts[0].Source)
+	assert.Equal("bind", ociMounts[0].Type)
+	assert.Equal([]string{"rbind", "ro"}, ociMounts[0].Options)
+}
+
 func TestHandleShm(t *testing.T) {
 	assert := assert.New(t)
 	k := kataAgent{}
@@ -789,6 +1123,36 @@ func TestAgentConfigure(t *testing.T) {
 	assert.Nil(err)
 }
 
+func TestAgentRequestTimeouts(t *testing.T) {
+	assert := assert.New(t)
+
+	k := &kataAgent{
+		reqTimeouts: requestTimeouts(KataAgentConfig{
+			CreateContainerTimeout: 120,
+			HotplugTimeout:         5,
+		}),
+	}
+
+	deadline := func(reqName string) time.Duration {
+		ctx, cancel := k.getReqContext(context.Background(), reqName)
+		if cancel == nil {
+			return 0
+		}
+		defer cancel()
+
+		d, ok := ctx.Deadline()
+		assert.True(ok)
+		return time.Until(d).Round(time.Second)
+	}
+
+	assert.Equal(120*time.Second, deadline(grpcCreateContainerRequest))
+	assert.Equal(5*time.Second, deadline(grpcOnlineCPUMemRequest))
+	assert.Equal(5*time.Second, deadline(grpcUpdateInterfaceRequest))
+	assert.Equal(defaultRequestTimeout, deadline(grpcExecProcessRequest))
+	assert.Equal(checkRequestTimeout, deadline(grpcCheckRequest))
+	assert.Equal(time.Duration(0), deadline(grpcWaitProcessRequest))
+}
+
 func TestCmdToKataProcess(t *testing.T) {
 	assert := assert.New(t)
 
@@ -0,0 +1,538 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/grpc"
+)
+
+const (
+	layerCacheID     = "layer-cache"
+	layerCacheFsType = "ext4"
+
+	overlayOpaqueXattr = "trusted.overlay.opaque"
+
+	// kataLayerCacheDevType mounts a container rootfs as an overlay of
+	// image layers, taken from the layer cache when present.
+	kataLayerCacheDevType = "layer-cache"
+)
+
+// layerCache is the per-node layer cache image attached to the sandbox.
+// As the guests don't coordinate their accesses to the filesystem of the
+// image, it is attached read-write to a single sandbox, or read-only to
+// any number of them, and stays locked for as long as the VM uses it.
+//
+// The layers cached by the agent are listed in an index next to the image,
+// for the runtime to only attach the cache read-write to the sandboxes
+// running layers it doesn't hold yet.
+type layerCache struct {
+	path     string
+	lock     *os.File
+	drive    *config.BlockDrive
+	readOnly bool
+	// keys of the layers the agent was asked to cache
+	added []string
+}
+
+func layerCacheIndexPath(path string) string {
+	return path + ".index"
+}
+
+// readLayerCacheIndex returns the keys of the layers held by the cache.
+func readLayerCacheIndex(path string) (map[string]bool, error) {
+	keys := make(map[string]bool)
+
+	f, err := os.Open(layerCacheIndexPath(path))
+	if os.IsNotExist(err) {
+		return keys, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		keys[scanner.Text()] = true
+	}
+
+	return keys, scanner.Err()
+}
+
+// openLayerCache locks the layer cache image for a sandbox running the
+// layers of the given keys, creating the image when missing. The image is
+// locked exclusively, to be written to, when some of the layers are not
+// cached yet and no other sandbox uses the cache. Otherwise it is locked
+// shared, to be read from, unless a sandbox writes to it, in which case
+// nil is returned.
+func openLayerCache(path string, sizeMB uint64, keys []string) (*layerCache, error) {
+	index, err := readLayerCacheIndex(path)
+	if err != nil {
+		return nil, err
+	}
+	cached := true
+	for _, key := range keys {
+		cached = cached && index[key]
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), DirMode); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &layerCache{
+		path: path,
+		lock: f,
+	}
+
+	err = syscall.EWOULDBLOCK
+	if !cached {
+		if err = unix.Flock(int(f.Fd()), unix.LOCK_EX|unix.LOCK_NB); err == nil {
+			err = prepareLayerCache(path, sizeMB)
+		}
+	}
+	if err == syscall.EWOULDBLOCK {
+		cache.readOnly = true
+		err = unix.Flock(int(f.Fd()), unix.LOCK_SH|unix.LOCK_NB)
+	}
+	if err != nil {
+		f.Close()
+		if err == syscall.EWOULDBLOCK {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// The image of a cache which failed to be formatted is empty
+	if st, err := f.Stat(); err != nil || st.Size() == 0 {
+		f.Close()
+		return nil, err
+	}
+
+	return cache, nil
+}
+
+// prepareLayerCache formats the layer cache image if it was just created,
+// and checks it otherwise, in case the last sandbox writing to it crashed.
+// A cache which can't be repaired is formatted again.
+func prepareLayerCache(path string, sizeMB uint64) error {
+	st, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	if st.Size() > 0 {
+		output, err := exec.Command("e2fsck", "-p", path).CombinedOutput()
+		if err == nil {
+			return nil
+		}
+		// e2fsck exits with 1 when it fixed the filesystem errors
+		if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == 1 {
+			return nil
+		}
+		virtLog.WithField("layer-cache", path).WithError(err).Warnf("Formatting layer cache again: %s", output)
+		if err := os.Truncate(path, 0); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Remove(layerCacheIndexPath(path)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	// mkfs.ext4 takes the size in KiB, the image is created sparse
+	sizeKB := strconv.FormatUint(sizeMB<<10, 10)
+	if output, err := exec.Command("mkfs."+layerCacheFsType, "-q", "-F", path, sizeKB).CombinedOutput(); err != nil {
+		os.Truncate(path, 0)
+		return fmt.Errorf("failed to format layer cache %s: %v: %s", path, err, output)
+	}
+
+	return nil
+}
+
+// close adds the layers cached by the sandbox to the index, and unlocks the
+// cache. The VM must be stopped.
+func (l *layerCache) close() error {
+	defer l.lock.Close()
+
+	if l.readOnly || len(l.added) == 0 {
+		return nil
+	}
+
+	f, err := os.OpenFile(layerCacheIndexPath(l.path), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.WriteString(strings.Join(l.added, "\n") + "\n")
+	return err
+}
+
+// options returns the options of a rootfs storage telling the agent how to
+// mount the cache.
+func (l *layerCache) options() []string {
+	if l.readOnly {
+		return []string{"cache=ro"}
+	}
+	return []string{"cache=rw"}
+}
+
+// layerCacheFor returns the layer cache attached to the sandbox, attaching
+// it first if needed. It returns nil if the cache can't be used, which
+// doesn't fail the container, the cache being an optimization.
+func (s *Sandbox) layerCacheFor(ctx context.Context, keys []string) *layerCache {
+	if s.layerCache != nil {
+		return s.layerCache
+	}
+
+	hConfig := s.config.HypervisorConfig
+	logger := s.Logger().WithField("layer-cache", hConfig.LayerCachePath)
+
+	// The agent finds hotplugged drives by their PCI path
+	if hConfig.BlockDeviceDriver != config.VirtioBlock {
+		logger.Warnf("Layer cache not supported with block device driver %s", hConfig.BlockDeviceDriver)
+		return nil
+	}
+
+	cache, err := openLayerCache(hConfig.LayerCachePath, hConfig.LayerCacheSizeMB, keys)
+	if err != nil {
+		logger.WithError(err).Warn("Could not open layer cache")
+		return nil
+	}
+	if cache == nil {
+		logger.Info("Layer cache unavailable, another sandbox writes to it")
+		return nil
+	}
+
+	cache.drive = &config.BlockDrive{
+		File:     hConfig.LayerCachePath,
+		Format:   "raw",
+		ID:       layerCacheID,
+		ReadOnly: cache.readOnly,
+	}
+	if _, err := s.hypervisor.HotplugAddDevice(ctx, cache.drive, BlockDev); err != nil {
+		logger.WithError(err).Warn("Could not attach layer cache")
+		cache.lock.Close()
+		return nil
+	}
+
+	logger.WithField("read-only", cache.readOnly).Info("Layer cache attached")
+	s.layerCache = cache
+
+	return cache
+}
+
+// releaseLayerCache lets the other sandboxes use the layer cache, once the
+// VM is stopped.
+func (s *Sandbox) releaseLayerCache() {
+	if s.layerCache == nil {
+		return
+	}
+
+	if err := s.layerCache.close(); err != nil {
+		s.Logger().WithError(err).Warn("Could not update the layer cache index")
+	}
+	s.layerCache = nil
+}
+
+func layerCacheDigestsPath(path string) string {
+	return path + ".digests"
+}
+
+// layerDigest returns the digest of the content of a layer, the key of the
+// layer in the cache, which the agent verifies the cached copies of the
+// layer against each time it uses them. It is the SHA-256 of the entries
+// of the layer, sorted by path, each described by a line
+// "path\0mode\0uid\0gid\0rdev\0size\0link\0opaque\0sha256\n", with the
+// overlay opaque attribute value and the SHA-256 of the regular files
+// content in hex.
+func layerDigest(root string) (string, error) {
+	var paths []string
+	if err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		// Sockets are not part of image layers
+		if d.Type()&os.ModeSocket == 0 {
+			paths = append(paths, path)
+		}
+		return nil
+	}); err != nil {
+		return "", err
+	}
+
+	rels := make(map[string]string, len(paths))
+	for i, path := range paths {
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return "", err
+		}
+		if rel == "." {
+			rel = ""
+		}
+		rels[rel] = path
+		paths[i] = rel
+	}
+	sort.Strings(paths)
+
+	digest := sha256.New()
+	for _, rel := range paths {
+		path := rels[rel]
+
+		var st unix.Stat_t
+		if err := unix.Lstat(path, &st); err != nil {
+			return "", err
+		}
+
+		var rdev, size uint64
+		var link, opaque, content string
+		switch st.Mode & unix.S_IFMT {
+		case unix.S_IFCHR, unix.S_IFBLK:
+			rdev = st.Rdev
+		case unix.S_IFLNK:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return "", err
+			}
+			link = target
+		case unix.S_IFDIR:
+			value := make([]byte, 8)
+			if n, err := unix.Lgetxattr(path, overlayOpaqueXattr, value); err == nil {
+				opaque = hex.EncodeToString(value[:n])
+			}
+		case unix.S_IFREG:
+			size = uint64(st.Size)
+			sum, err := fileDigest(path)
+			if err != nil {
+				return "", err
+			}
+			content = sum
+		}
+
+		fmt.Fprintf(digest, "%s\x00%d\x00%d\x00%d\x00%d\x00%d\x00%s\x00%s\x00%s\n",
+			rel, st.Mode, st.Uid, st.Gid, rdev, size, link, opaque, content)
+	}
+
+	return hex.EncodeToString(digest.Sum(nil)), nil
+}
+
+func fileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	digest := sha256.New()
+	if _, err := io.Copy(digest, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(digest.Sum(nil)), nil
+}
+
+// layerCacheKey returns the key of a layer in the layer cache, its content
+// digest. As the layers of the snapshotters are never modified once
+// committed, the digests are recorded by layerKey next to the cache, for
+// the layers not to be read again each time a container uses them.
+func layerCacheKey(cachePath, layer string) (string, error) {
+	id, err := layerKey(layer)
+	if err != nil {
+		return "", err
+	}
+
+	digests, err := os.ReadFile(layerCacheDigestsPath(cachePath))
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	for _, line := range strings.Split(string(digests), "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 && fields[0] == id {
+			return fields[1], nil
+		}
+	}
+
+	digest, err := layerDigest(layer)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.OpenFile(layerCacheDigestsPath(cachePath), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := f.WriteString(id + " " + digest + "\n"); err != nil {
+		return "", err
+	}
+
+	return digest, nil
+}
+
+// layerKey identifies a layer of the host, as the layers of the overlay
+// snapshotter are never modified once committed. The inode and change time
+// of the layer directory tell apart the layers of recreated snapshots.
+func layerKey(path string) (string, error) {
+	var st unix.Stat_t
+	if err := unix.Stat(path, &st); err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%d:%d.%d", path, st.Ino, st.Ctim.Sec, st.Ctim.Nsec)))
+	return fmt.Sprintf("%x", sum), nil
+}
+
+// overlayLayers returns the layers, from the top one, and the upper and
+// work directories of an overlay rootfs.
+func overlayLayers(options []string) (lowers []string, upper, work string) {
+	for _, opt := range options {
+		kv := strings.SplitN(opt, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case lowerDir:
+			lowers = strings.Split(kv[1], ":")
+		case upperDir:
+			upper = kv[1]
+		case workDir:
+			work = kv[1]
+		}
+	}
+
+	return lowers, upper, work
+}
+
+// useLayerCache tells if the rootfs of the container can be built in the
+// guest from the image layers of the layer cache.
+func (c *Container) useLayerCache() bool {
+	if c.sandbox.config.HypervisorConfig.LayerCachePath == "" || c.rootFs.Type != typeOverlayFS {
+		return false
+	}
+
+	lowers, upper, work := overlayLayers(c.rootFs.Options)
+	// The upper and work directories are shared together
+	return len(lowers) > 0 && upper != "" && filepath.Dir(upper) == filepath.Dir(work)
+}
+
+// shareRootFilesystemWithLayerCache shares the layers of the rootfs and its
+// snapshot directory with the guest, and lets the agent build the rootfs
+// overlay from the layers of the layer cache, or from the shared ones for
+// the layers it doesn't hold yet, which the agent copies to the cache when
+// it is writable. It returns nil if the layer cache can't be used.
+func (f *FilesystemShare) shareRootFilesystemWithLayerCache(ctx context.Context, c *Container) (*SharedFile, error) {
+	lowers, upper, work := overlayLayers(c.rootFs.Options)
+
+	cachePath := f.sandbox.config.HypervisorConfig.LayerCachePath
+	if err := os.MkdirAll(filepath.Dir(cachePath), DirMode); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, len(lowers))
+	for i, lower := range lowers {
+		key, err := layerCacheKey(cachePath, lower)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+
+	cache := f.sandbox.layerCacheFor(ctx, keys)
+	if cache == nil {
+		return nil, nil
+	}
+
+	rootfsGuestPath := filepath.Join(kataGuestSharedDir(), c.id, c.rootfsSuffix)
+	containerShareDir := filepath.Join(getMountPath(f.sandbox.ID()), c.id)
+
+	// mkdir rootfs, guest at /run/kata-containers/shared/containers/<cid>/rootfs
+	if err := os.MkdirAll(filepath.Join(containerShareDir, c.rootfsSuffix), DirMode); err != nil {
+		return nil, err
+	}
+
+	rootfs := &grpc.Storage{
+		Driver:     kataLayerCacheDevType,
+		Source:     cache.drive.PCIPath.String(),
+		Fstype:     typeOverlayFS,
+		MountPoint: rootfsGuestPath,
+		Options:    cache.options(),
+	}
+
+	// bindmount the layers to guest /run/kata-containers/shared/containers/<cid>/layers/<n>,
+	// they are only read by the guest when missing from the cache
+	for i, lower := range lowers {
+		layer := filepath.Join(layersDir, strconv.Itoa(i))
+		if err := bindMount(ctx, lower, filepath.Join(containerShareDir, layer), true, "slave"); err != nil {
+			return nil, err
+		}
+		rootfs.Options = append(rootfs.Options, fmt.Sprintf("layer=%s:%s", keys[i], filepath.Join(kataGuestSharedDir(), c.id, layer)))
+	}
+
+	// bindmount the snapshot dir holding the upper and work dirs
+	// to guest /run/kata-containers/shared/containers/<cid>/snapshotdir
+	if err := bindMount(ctx, filepath.Dir(upper), filepath.Join(containerShareDir, snapshotDir), false, "slave"); err != nil {
+		return nil, err
+	}
+	rootfs.Options = append(rootfs.Options, fmt.Sprintf("%s=%s", upperDir, filepath.Join(kataGuestSharedDir(), c.id, snapshotDir, filepath.Base(upper))))
+	rootfs.Options = append(rootfs.Options, fmt.Sprintf("%s=%s", workDir, filepath.Join(kataGuestSharedDir(), c.id, snapshotDir, filepath.Base(work))))
+	rootfs.Options = append(rootfs.Options, "index=off")
+
+	if !cache.readOnly {
+		cache.added = append(cache.added, keys...)
+	}
+
+	f.Logger().WithFields(logrus.Fields{
+		"container": c.id,
+		"layers":    len(lowers),
+		"read-only": cache.readOnly,
+	}).Info("Sharing rootfs layers with the layer cache")
+
+	return &SharedFile{
+		storage:   rootfs,
+		guestPath: rootfsGuestPath,
+	}, nil
+}
+
+// layerCacheContainerCleanup unmounts the layers and the snapshot directory
+// shared for a rootfs built from the layer cache.
+func layerCacheContainerCleanup(ctx context.Context, sharedDir string, c *Container) error {
+	layers, err := os.ReadDir(filepath.Join(sharedDir, c.id, layersDir))
+	if err != nil {
+		return err
+	}
+	for _, layer := range layers {
+		if err := bindUnmountContainerShareDir(ctx, sharedDir, c.id, filepath.Join(layersDir, layer.Name())); err != nil {
+			return err
+		}
+	}
+	if err := syscall.Rmdir(filepath.Join(sharedDir, c.id, layersDir)); err != nil {
+		return err
+	}
+	if err := bindUnmountContainerSnapshotDir(ctx, sharedDir, c.id); err != nil {
+		return err
+	}
+	return syscall.Rmdir(filepath.Join(sharedDir, c.id, c.rootfsSuffix))
+}
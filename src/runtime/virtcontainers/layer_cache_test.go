@@ -0,0 +1,229 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	ktu "github.com/kata-containers/kata-containers/src/runtime/pkg/katatestutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlayLayers(t *testing.T) {
+	assert := assert.New(t)
+
+	lowers, upper, work := overlayLayers([]string{
+		"index=off",
+		"workdir=/snapshots/3/work",
+		"upperdir=/snapshots/3/fs",
+		"lowerdir=/snapshots/2/fs:/snapshots/1/fs",
+	})
+	assert.Equal([]string{"/snapshots/2/fs", "/snapshots/1/fs"}, lowers)
+	assert.Equal("/snapshots/3/fs", upper)
+	assert.Equal("/snapshots/3/work", work)
+
+	lowers, upper, work = overlayLayers([]string{"ro"})
+	assert.Empty(lowers)
+	assert.Empty(upper)
+	assert.Empty(work)
+}
+
+func TestUseLayerCache(t *testing.T) {
+	assert := assert.New(t)
+
+	options := []string{"lowerdir=/snapshots/1/fs", "upperdir=/snapshots/2/fs", "workdir=/snapshots/2/work"}
+	newContainer := func(cachePath, fsType string, options []string) *Container {
+		return &Container{
+			sandbox: &Sandbox{
+				config: &SandboxConfig{
+					HypervisorConfig: HypervisorConfig{
+						LayerCachePath: cachePath,
+					},
+				},
+			},
+			rootFs: RootFs{
+				Type:    fsType,
+				Options: options,
+			},
+		}
+	}
+
+	cachePath := "/var/lib/kata-containers/layer-cache.img"
+	assert.True(newContainer(cachePath, typeOverlayFS, options).useLayerCache())
+	assert.False(newContainer("", typeOverlayFS, options).useLayerCache())
+	assert.False(newContainer(cachePath, "ext4", options).useLayerCache())
+	assert.False(newContainer(cachePath, typeOverlayFS, options[:1]).useLayerCache())
+	assert.False(newContainer(cachePath, typeOverlayFS, []string{options[0], options[1], "workdir=/work"}).useLayerCache())
+}
+
+func TestLayerKey(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	layer := filepath.Join(dir, "1")
+	assert.NoError(os.Mkdir(layer, DirMode))
+
+	key, err := layerKey(layer)
+	assert.NoError(err)
+	assert.Len(key, 64)
+
+	again, err := layerKey(layer)
+	assert.NoError(err)
+	assert.Equal(key, again)
+
+	// A snapshot recreated at the same path is another layer
+	assert.NoError(os.Remove(layer))
+	assert.NoError(os.Mkdir(layer, DirMode))
+	assert.NoError(os.WriteFile(filepath.Join(layer, "file"), nil, 0644))
+	recreated, err := layerKey(layer)
+	assert.NoError(err)
+	assert.NotEqual(key, recreated)
+
+	_, err = layerKey(filepath.Join(dir, "missing"))
+	assert.Error(err)
+}
+
+// newTestLayer creates a layer, owned by root, the agent tests verifying
+// the copies of the same layer against its digest.
+func newTestLayer(t *testing.T) string {
+	layer := filepath.Join(t.TempDir(), "layer")
+	for _, err := range []error{
+		os.Mkdir(layer, DirMode),
+		os.Mkdir(filepath.Join(layer, "d"), DirMode),
+		os.WriteFile(filepath.Join(layer, "a"), []byte("kata"), 0644),
+		os.Symlink("../a", filepath.Join(layer, "d", "l")),
+		os.Chmod(layer, 0755),
+		os.Chmod(filepath.Join(layer, "d"), 0755),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return layer
+}
+
+func TestLayerDigest(t *testing.T) {
+	assert := assert.New(t)
+
+	if tc.NotValid(ktu.NeedRoot()) {
+		t.Skip(testDisabledAsNonRoot)
+	}
+
+	layer := newTestLayer(t)
+
+	digest, err := layerDigest(layer)
+	assert.NoError(err)
+	assert.Equal("4505fcc75992a207eee73b9647285f48013fab9e08e278cb2c3aec3cefcdc5db", digest)
+
+	// The content of the files is part of the digest
+	assert.NoError(os.WriteFile(filepath.Join(layer, "a"), []byte("KATA"), 0644))
+	modified, err := layerDigest(layer)
+	assert.NoError(err)
+	assert.NotEqual(digest, modified)
+
+	// and so is their ownership
+	assert.NoError(os.WriteFile(filepath.Join(layer, "a"), []byte("kata"), 0644))
+	assert.NoError(os.Lchown(filepath.Join(layer, "a"), 1000, 1000))
+	modified, err = layerDigest(layer)
+	assert.NoError(err)
+	assert.NotEqual(digest, modified)
+
+	_, err = layerDigest(filepath.Join(layer, "missing"))
+	assert.Error(err)
+}
+
+func TestLayerCacheKey(t *testing.T) {
+	assert := assert.New(t)
+
+	cachePath := filepath.Join(t.TempDir(), "layer-cache.img")
+	layer := newTestLayer(t)
+
+	key, err := layerCacheKey(cachePath, layer)
+	assert.NoError(err)
+	digest, err := layerDigest(layer)
+	assert.NoError(err)
+	assert.Equal(digest, key)
+
+	// The digest of the layer is recorded
+	id, err := layerKey(layer)
+	assert.NoError(err)
+	digests, err := os.ReadFile(layerCacheDigestsPath(cachePath))
+	assert.NoError(err)
+	assert.Equal(id+" "+digest+"\n", string(digests))
+
+	again, err := layerCacheKey(cachePath, layer)
+	assert.NoError(err)
+	assert.Equal(key, again)
+	digests, err = os.ReadFile(layerCacheDigestsPath(cachePath))
+	assert.NoError(err)
+	assert.Equal(id+" "+digest+"\n", string(digests))
+}
+
+func TestOpenLayerCache(t *testing.T) {
+	assert := assert.New(t)
+
+	if _, err := exec.LookPath("mkfs." + layerCacheFsType); err != nil {
+		t.Skip("mkfs." + layerCacheFsType + " not found")
+	}
+
+	path := filepath.Join(t.TempDir(), "cache", "layer-cache.img")
+	keys := []string{"a", "b"}
+
+	// A sandbox running layers missing from the cache writes to it, the
+	// cache is created first
+	writer, err := openLayerCache(path, 16, keys)
+	assert.NoError(err)
+	assert.NotNil(writer)
+	assert.False(writer.readOnly)
+	assert.Equal([]string{"cache=rw"}, writer.options())
+	st, err := os.Stat(path)
+	assert.NoError(err)
+	assert.Equal(int64(16<<20), st.Size())
+
+	// No other sandbox uses the cache while it is written to
+	cache, err := openLayerCache(path, 16, keys)
+	assert.NoError(err)
+	assert.Nil(cache)
+
+	writer.added = keys
+	assert.NoError(writer.close())
+	index, err := readLayerCacheIndex(path)
+	assert.NoError(err)
+	assert.Equal(map[string]bool{"a": true, "b": true}, index)
+
+	// The sandboxes running cached layers read from the cache
+	reader, err := openLayerCache(path, 16, keys[:1])
+	assert.NoError(err)
+	assert.NotNil(reader)
+	assert.True(reader.readOnly)
+	assert.Equal([]string{"cache=ro"}, reader.options())
+
+	other, err := openLayerCache(path, 16, keys)
+	assert.NoError(err)
+	assert.True(other.readOnly)
+
+	// and so do the other ones while the cache is read from
+	missing, err := openLayerCache(path, 16, []string{"c"})
+	assert.NoError(err)
+	assert.True(missing.readOnly)
+	missing.added = []string{"c"}
+	assert.NoError(missing.close())
+	assert.NoError(other.close())
+	assert.NoError(reader.close())
+
+	// Once released, the cache is checked and written to again
+	writer, err = openLayerCache(path, 16, []string{"c"})
+	assert.NoError(err)
+	assert.False(writer.readOnly)
+	assert.NoError(writer.close())
+
+	index, err = readLayerCacheIndex(path)
+	assert.NoError(err)
+	assert.Len(index, 2)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// LifecycleEventType is the type of a transition of the lifecycle of the VM
+// of a sandbox.
+type LifecycleEventType string
+
+const (
+	// HypervisorStartedEvent is emitted once the hypervisor has booted the VM.
+	HypervisorStartedEvent LifecycleEventType = "hypervisor-started"
+
+	// AgentConnectedEvent is emitted once the agent has started the sandbox
+	// in the VM.
+	AgentConnectedEvent LifecycleEventType = "agent-connected"
+
+	// DeviceHotpluggedEvent is emitted for each device hotplugged to the VM.
+	DeviceHotpluggedEvent LifecycleEventType = "device-hotplugged"
+
+	// HypervisorExitedEvent is emitted once the hypervisor is stopped, or
+	// found dead by the monitor of the sandbox.
+	HypervisorExitedEvent LifecycleEventType = "hypervisor-exited"
+)
+
+// LifecycleEvent describes a transition of the lifecycle of the VM of a
+// sandbox.
+type LifecycleEvent struct {
+	Timestamp  time.Time
+	Attributes map[string]string
+	SandboxID  string
+	Type       LifecycleEventType
+}
+
+// LifecycleEventHandler is called on the lifecycle events of a sandbox. It
+// must not block.
+type LifecycleEventHandler func(LifecycleEvent)
+
+type lifecycleEventHandlerKey struct{}
+
+// WithLifecycleEventHandler sets in `ctx` the handler of the lifecycle events
+// of the sandboxes created or fetched with it.
+func WithLifecycleEventHandler(ctx context.Context, handler LifecycleEventHandler) context.Context {
+	return context.WithValue(ctx, lifecycleEventHandlerKey{}, handler)
+}
+
+func getLifecycleEventHandler(ctx context.Context) LifecycleEventHandler {
+	if handler, ok := ctx.Value(lifecycleEventHandlerKey{}).(LifecycleEventHandler); ok {
+		return handler
+	}
+	return nil
+}
+
+// emitLifecycleEvent calls the lifecycle event handler of the sandbox, if any.
+func (s *Sandbox) emitLifecycleEvent(eventType LifecycleEventType, attributes map[string]string) {
+	if s.lifecycleEventHandler == nil {
+		return
+	}
+
+	s.lifecycleEventHandler(LifecycleEvent{
+		Timestamp:  time.Now(),
+		Attributes: attributes,
+		SandboxID:  s.id,
+		Type:       eventType,
+	})
+}
+
+// emitHypervisorExited emits the HypervisorExitedEvent once per VM, the
+// sandbox stopping the VM after the monitor found the hypervisor dead.
+func (s *Sandbox) emitHypervisorExited(attributes map[string]string) {
+	if !atomic.CompareAndSwapInt32(&s.hypervisorExited, 0, 1) {
+		return
+	}
+
+	s.emitLifecycleEvent(HypervisorExitedEvent, attributes)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/drivers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLifecycleEventHandler(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(getLifecycleEventHandler(context.Background()))
+
+	var events []LifecycleEvent
+	ctx := WithLifecycleEventHandler(context.Background(), func(e LifecycleEvent) {
+		events = append(events, e)
+	})
+
+	handler := getLifecycleEventHandler(ctx)
+	assert.NotNil(handler)
+
+	handler(LifecycleEvent{Type: AgentConnectedEvent})
+	assert.Len(events, 1)
+}
+
+func TestSandboxLifecycleEvents(t *testing.T) {
+	assert := assert.New(t)
+
+	var events []LifecycleEvent
+	s := &Sandbox{
+		id:         testSandboxID,
+		ctx:        context.Background(),
+		hypervisor: &mockHypervisor{},
+		agent:      &mockAgent{},
+		config:     &SandboxConfig{},
+		lifecycleEventHandler: func(e LifecycleEvent) {
+			events = append(events, e)
+		},
+	}
+
+	device := &drivers.BlockDevice{
+		GenericDevice: &drivers.GenericDevice{
+			ID:         "block",
+			DeviceInfo: &config.DeviceInfo{HostPath: "/dev/hda"},
+		},
+		BlockDrive: &config.BlockDrive{},
+	}
+
+	assert.NoError(s.HotplugAddDevice(context.Background(), device, config.DeviceBlock))
+	assert.Len(events, 1)
+	assert.Equal(DeviceHotpluggedEvent, events[0].Type)
+	assert.Equal(testSandboxID, events[0].SandboxID)
+	assert.Equal("block", events[0].Attributes["device"])
+	assert.Equal("/dev/hda", events[0].Attributes["host_path"])
+
+	// the device type does not match the one of the device
+	assert.Error(s.HotplugAddDevice(context.Background(), device, config.VhostUserBlk))
+	assert.Len(events, 1)
+
+	assert.NoError(s.stopVM(context.Background()))
+	assert.Len(events, 2)
+	assert.Equal(HypervisorExitedEvent, events[1].Type)
+}
+
+type deadHypervisor struct {
+	mockHypervisor
+}
+
+func (h *deadHypervisor) Check() error {
+	return errors.New("hypervisor process is dead")
+}
+
+func TestMonitorHypervisorExitedEvent(t *testing.T) {
+	assert := assert.New(t)
+
+	var events []LifecycleEvent
+	s := &Sandbox{
+		id:         testSandboxID,
+		ctx:        context.Background(),
+		hypervisor: &deadHypervisor{},
+		agent:      &mockAgent{},
+		config:     &SandboxConfig{},
+		lifecycleEventHandler: func(e LifecycleEvent) {
+			events = append(events, e)
+		},
+	}
+
+	m := newMonitor(s)
+	assert.Error(m.watchHypervisor(context.Background()))
+	assert.Len(events, 1)
+	assert.Equal(HypervisorExitedEvent, events[0].Type)
+	assert.Equal("hypervisor process is dead", events[0].Attributes["error"])
+
+	// the VM stopped once the hypervisor found dead is not reported again
+	assert.NoError(s.stopVM(context.Background()))
+	assert.Len(events, 1)
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// The log sources of the sandbox forwarded to the shim log.
+const (
+	logSourceConsole     = "console"
+	logSourceFirecracker = "firecracker"
+)
+
+// forwardedLogDir is the directory of the sandbox store the forwarded logs
+// are written to.
+const forwardedLogDir = "logs"
+
+// The rate limit, in lines per second, and the rotation size, in KiB, of the
+// logs forwarded by the sandbox. They are set per process, as the shim runs
+// a single sandbox.
+var (
+	logRateLimit  uint32
+	logRotateSize uint32
+)
+
+// setLogForwarding sets the rate limit, in lines per second, of the logs
+// forwarded to the shim log, and the size, in KiB, at which their files are
+// rotated. 0 disables the rate limiting and the files respectively.
+func setLogForwarding(rateLimit, rotateSize uint32) {
+	atomic.StoreUint32(&logRateLimit, rateLimit)
+	atomic.StoreUint32(&logRotateSize, rotateSize)
+}
+
+// newSandboxLogForwarder returns the forwarder of a log source of the
+// sandbox, writing its lines to a file of dir.
+func newSandboxLogForwarder(source, dir string) *LogForwarder {
+	return NewLogForwarder(source, atomic.LoadUint32(&logRateLimit),
+		filepath.Join(dir, source+".log"), atomic.LoadUint32(&logRotateSize))
+}
+
+// LogForwarder forwards the lines of a log source of the sandbox, such as
+// the guest console, so that a guest spamming its logs can neither fill the
+// system journal nor block the reader of the source: the lines are written
+// to the shim log at most at a rate, the others being dropped and counted,
+// and all of them to a file rotated at a size.
+type LogForwarder struct {
+	source string
+	logger *logrus.Entry
+
+	// token bucket of the lines written to the shim log, holding up to
+	// one second of lines
+	rate    float64
+	tokens  float64
+	last    time.Time
+	dropped uint64
+
+	file *rotatingFile
+
+	sync.Mutex
+}
+
+// NewLogForwarder returns the forwarder of a log source, writing at most
+// rateLimit lines per second to the shim log, and all the lines to path,
+// rotated at rotateSize KiB. 0 disables the rate limiting and the file
+// respectively.
+func NewLogForwarder(source string, rateLimit uint32, path string, rotateSize uint32) *LogForwarder {
+	f := &LogForwarder{
+		source: source,
+		logger: virtLog.WithFields(logrus.Fields{
+			"subsystem":  "log-forwarder",
+			"log-source": source,
+		}),
+		rate:   float64(rateLimit),
+		tokens: float64(rateLimit),
+		last:   time.Now(),
+	}
+
+	if path != "" && rotateSize > 0 {
+		f.file = &rotatingFile{path: path, maxSize: int64(rotateSize) * 1024}
+	}
+
+	return f
+}
+
+// Forward records a line of the source, and returns whether it is to be
+// written to the shim log.
+func (f *LogForwarder) Forward(line string) bool {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.file != nil {
+		if err := f.file.writeLine(line); err != nil {
+			f.logger.WithError(err).WithField("path", f.file.path).Warn("failed to write the forwarded log, disabling it")
+			f.file.close()
+			f.file = nil
+		}
+	}
+
+	if f.rate == 0 {
+		return true
+	}
+
+	now := time.Now()
+	f.tokens += now.Sub(f.last).Seconds() * f.rate
+	if f.tokens > f.rate {
+		f.tokens = f.rate
+	}
+	f.last = now
+
+	if f.tokens < 1 {
+		f.dropped++
+		droppedLogLines.WithLabelValues(f.source).Inc()
+		return false
+	}
+	f.tokens--
+
+	if f.dropped > 0 {
+		f.logger.WithFields(logrus.Fields{
+			"dropped":    f.dropped,
+			"rate-limit": f.rate,
+		}).Warn("dropped log lines exceeding the rate limit")
+		f.dropped = 0
+	}
+
+	return true
+}
+
+// Close closes the file of the forwarded log. The lines forwarded after are
+// only written to the shim log.
+func (f *LogForwarder) Close() error {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.close()
+	f.file = nil
+	return err
+}
+
+// rotatingFile is a file of lines which is moved to path.1 when it reaches
+// maxSize, so the lines take at most twice maxSize.
+type rotatingFile struct {
+	path    string
+	maxSize int64
+	file    *os.File
+	size    int64
+}
+
+func (r *rotatingFile) writeLine(line string) error {
+	if r.file != nil && r.size+int64(len(line))+1 > r.maxSize {
+		if err := r.close(); err != nil {
+			return err
+		}
+		if err := os.Rename(r.path, r.path+".1"); err != nil {
+			return err
+		}
+	}
+
+	if r.file == nil {
+		if err := os.MkdirAll(filepath.Dir(r.path), DirMode); err != nil {
+			return err
+		}
+		file, err := os.OpenFile(r.path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		r.file = file
+		r.size = 0
+	}
+
+	n, err := r.file.WriteString(line + "\n")
+	r.size += int64(n)
+	return err
+}
+
+func (r *rotatingFile) close() error {
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
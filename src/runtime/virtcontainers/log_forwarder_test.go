@@ -0,0 +1,86 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func droppedLogLinesCount(t *testing.T, source string) float64 {
+	var m dto.Metric
+	assert.NoError(t, droppedLogLines.WithLabelValues(source).Write(&m))
+	return m.GetCounter().GetValue()
+}
+
+func TestLogForwarderRateLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	// unlimited
+	f := NewLogForwarder("test-unlimited", 0, "", 0)
+	for i := 0; i < 100; i++ {
+		assert.True(f.Forward("line"))
+	}
+	assert.NoError(f.Close())
+
+	count := droppedLogLinesCount(t, "test")
+	f = NewLogForwarder("test", 10, "", 0)
+
+	// a second of lines is forwarded at once, the next ones are dropped
+	forwarded := 0
+	for i := 0; i < 15; i++ {
+		if f.Forward("line") {
+			forwarded++
+		}
+	}
+	assert.Equal(10, forwarded)
+	assert.Equal(count+5, droppedLogLinesCount(t, "test"))
+
+	// the bucket is refilled over time
+	time.Sleep(200 * time.Millisecond)
+	assert.True(f.Forward("line"))
+	assert.Zero(f.dropped)
+}
+
+func TestLogForwarderRotation(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), forwardedLogDir, "console.log")
+	f := NewLogForwarder(logSourceConsole, 1, path, 1)
+
+	// 100 lines of 16 bytes, in files of 1KiB
+	var lines []string
+	for i := 0; i < 100; i++ {
+		line := fmt.Sprintf("line %10d", i)
+		lines = append(lines, line)
+		f.Forward(line)
+	}
+	assert.NoError(f.Close())
+
+	// closed: the lines are no longer written to the file
+	f.Forward("closed")
+
+	current, err := os.ReadFile(path)
+	assert.NoError(err)
+	previous, err := os.ReadFile(path + ".1")
+	assert.NoError(err)
+
+	assert.LessOrEqual(len(current), 1024)
+	assert.LessOrEqual(len(previous), 1024)
+
+	// the files hold the last lines, in order
+	all := string(previous) + string(current)
+	assert.True(strings.HasSuffix(strings.Join(lines, "\n")+"\n", all))
+	assert.Equal(len(previous)/16+len(current)/16, strings.Count(all, "\n"))
+	assert.Equal(64, len(previous)/16)
+}
@@ -6,6 +6,7 @@
 package virtcontainers
 
 import (
+	"net"
 	"syscall"
 	"time"
 
@@ -97,7 +98,7 @@ func (n *mockAgent) memHotplugByProbe(ctx context.Context, addr uint64, sizeMB u
 }
 
 // onlineCPUMem is the Noop agent Container online CPU and Memory implementation. It does nothing.
-func (n *mockAgent) onlineCPUMem(ctx context.Context, cpus uint32, cpuOnly bool) error {
+func (n *mockAgent) onlineCPUMem(ctx context.Context, cpus uint32, cpuOnly bool, memBlocks uint32) error {
 	return nil
 }
 
@@ -116,6 +117,11 @@ func (n *mockAgent) updateRoutes(ctx context.Context, routes []*pbTypes.Route) (
 	return nil, nil
 }
 
+// addARPNeighbors is the Noop agent ARP neighbors add implementation. It does nothing.
+func (n *mockAgent) addARPNeighbors(ctx context.Context, neighs []*pbTypes.ARPNeighbor) error {
+	return nil
+}
+
 // listRoutes is the Noop agent Routes list implementation. It does nothing.
 func (n *mockAgent) listRoutes(ctx context.Context) ([]*pbTypes.Route, error) {
 	return nil, nil
@@ -161,6 +167,16 @@ func (n *mockAgent) readProcessStderr(ctx context.Context, c *Container, process
 	return 0, nil
 }
 
+// openProcessIO is the Noop agent process IO opener. It does nothing.
+func (n *mockAgent) openProcessIO(ctx context.Context, c *Container, processID string) (processIOPorts, error) {
+	return processIOPorts{}, nil
+}
+
+// dialPort is the Noop agent port dialer. It does nothing.
+func (n *mockAgent) dialPort(ctx context.Context, port uint32) (net.Conn, error) {
+	return nil, nil
+}
+
 // pauseContainer is the Noop agent Container pause implementation. It does nothing.
 func (n *mockAgent) pauseContainer(ctx context.Context, sandbox *Sandbox, c Container) error {
 	return nil
@@ -249,3 +265,51 @@ func (n *mockAgent) getGuestVolumeStats(ctx context.Context, volumeGuestPath str
 func (n *mockAgent) resizeGuestVolume(ctx context.Context, volumeGuestPath string, size uint64) error {
 	return nil
 }
+
+func (n *mockAgent) getIPTables(ctx context.Context, isIPv6 bool) ([]byte, error) {
+	return nil, nil
+}
+
+func (n *mockAgent) setIPTables(ctx context.Context, isIPv6 bool, data []byte) error {
+	return nil
+}
+
+func (n *mockAgent) setAgentTracing(ctx context.Context, enable bool) error {
+	return nil
+}
+
+func (n *mockAgent) syncWatchableMount(ctx context.Context, req *grpc.SyncWatchableMountRequest) error {
+	return nil
+}
+
+func (n *mockAgent) setPolicy(ctx context.Context, policy string) error {
+	return nil
+}
+
+func (n *mockAgent) getPolicy(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (n *mockAgent) executeHooks(ctx context.Context, containerID string, hooks []specs.Hook) error {
+	return nil
+}
+
+func (n *mockAgent) getDiagnostics(ctx context.Context, containerID string) (*grpc.Diagnostics, error) {
+	return &grpc.Diagnostics{}, nil
+}
+
+func (n *mockAgent) readCoreDump(ctx context.Context, containerID, name string, offset uint64) (*grpc.DiagnosticsFile, error) {
+	return &grpc.DiagnosticsFile{}, nil
+}
+
+func (n *mockAgent) setLogLevel(ctx context.Context, level string) error {
+	return nil
+}
+
+func (n *mockAgent) readKernelLog(ctx context.Context) ([]*grpc.KernelLogRecord, error) {
+	return nil, nil
+}
+
+func (n *mockAgent) setGuestTimeSource(ctx context.Context, source string, ntpServers []string) error {
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"os"
 
 	hv "github.com/kata-containers/kata-containers/src/runtime/pkg/hypervisors"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
 )
 
@@ -62,7 +63,7 @@ func (m *mockHypervisor) ResumeVM(ctx context.Context) error {
 	return nil
 }
 
-func (m *mockHypervisor) SaveVM() error {
+func (m *mockHypervisor) SaveVM(statePath string) error {
 	return nil
 }
 
@@ -102,6 +103,10 @@ func (m *mockHypervisor) ResizeVCPUs(ctx context.Context, cpus uint32) (uint32,
 	return 0, 0, nil
 }
 
+func (m *mockHypervisor) ResizeBlockDevice(ctx context.Context, drive *config.BlockDrive, size uint64) error {
+	return nil
+}
+
 func (m *mockHypervisor) Disconnect(ctx context.Context) {
 }
 
@@ -28,8 +28,16 @@ type monitor struct {
 	wg sync.WaitGroup
 	sync.Mutex
 
-	stopCh        chan bool
-	checkInterval time.Duration
+	stopCh chan bool
+	// The hypervisor is checked at the default interval whatever the
+	// configured agent health check interval.
+	hypervisorCheckInterval time.Duration
+	agentCheckInterval      time.Duration
+
+	// failureThreshold is the number of consecutive failed agent checks
+	// after which the agent is unhealthy.
+	failureThreshold uint32
+	failures         uint32
 
 	running bool
 }
@@ -38,11 +46,24 @@ func newMonitor(s *Sandbox) *monitor {
 	// there should only be one monitor for one sandbox,
 	// so it's safe to let monitorLog as a global variable.
 	monitorLog = monitorLog.WithField("sandbox", s.ID())
-	return &monitor{
-		sandbox:       s,
-		checkInterval: defaultCheckInterval,
-		stopCh:        make(chan bool, 1),
+	m := &monitor{
+		sandbox:                 s,
+		hypervisorCheckInterval: defaultCheckInterval,
+		agentCheckInterval:      defaultCheckInterval,
+		failureThreshold:        1,
+		stopCh:                  make(chan bool, 1),
+	}
+
+	if s.config != nil {
+		if s.config.AgentConfig.HealthCheckInterval > 0 {
+			m.agentCheckInterval = time.Duration(s.config.AgentConfig.HealthCheckInterval) * time.Second
+		}
+		if s.config.AgentConfig.HealthCheckFailures > 0 {
+			m.failureThreshold = s.config.AgentConfig.HealthCheckFailures
+		}
 	}
+
+	return m
 }
 
 func (m *monitor) newWatcher(ctx context.Context) (chan error, error) {
@@ -58,15 +79,18 @@ func (m *monitor) newWatcher(ctx context.Context) (chan error, error) {
 
 		// create and start agent watcher
 		go func() {
-			tick := time.NewTicker(m.checkInterval)
+			hypervisorTick := time.NewTicker(m.hypervisorCheckInterval)
+			agentTick := time.NewTicker(m.agentCheckInterval)
 			for {
 				select {
 				case <-m.stopCh:
-					tick.Stop()
+					hypervisorTick.Stop()
+					agentTick.Stop()
 					m.wg.Done()
 					return
-				case <-tick.C:
+				case <-hypervisorTick.C:
 					m.watchHypervisor(ctx)
+				case <-agentTick.C:
 					m.watchAgent(ctx)
 				}
 			}
@@ -140,15 +164,32 @@ func (m *monitor) stop() {
 }
 
 func (m *monitor) watchAgent(ctx context.Context) {
+	start := time.Now()
 	err := m.sandbox.agent.check(ctx)
-	if err != nil {
-		// TODO: define and export error types
-		m.notify(ctx, errors.Wrapf(err, "failed to ping agent"))
+	if err == nil {
+		agentHealthCheckLatency.Set(float64(time.Since(start).Milliseconds()))
+		agentHealthy.Set(1)
+		m.failures = 0
+		return
+	}
+
+	agentHealthCheckFailures.Inc()
+	m.failures++
+	monitorLog.WithError(err).WithField("failures", m.failures).Warn("agent health check failed")
+
+	if m.failures < m.failureThreshold {
+		return
 	}
+
+	agentHealthy.Set(0)
+	m.sandbox.captureGuestConsole(err)
+	// TODO: define and export error types
+	m.notify(ctx, errors.Wrapf(err, "failed to ping agent"))
 }
 
 func (m *monitor) watchHypervisor(ctx context.Context) error {
 	if err := m.sandbox.hypervisor.Check(); err != nil {
+		m.sandbox.emitHypervisorExited(map[string]string{"error": err.Error()})
 		m.notify(ctx, errors.Wrapf(err, "failed to ping hypervisor process"))
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -59,3 +60,44 @@ func TestMonitorClosedChannel(t *testing.T) {
 
 	m.stop()
 }
+
+type failingCheckAgent struct {
+	mockAgent
+}
+
+func (n *failingCheckAgent) check(ctx context.Context) error {
+	return errors.New("check failed")
+}
+
+func TestMonitorAgentFailures(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &Sandbox{
+		id:    testSandboxID,
+		agent: &failingCheckAgent{},
+		config: &SandboxConfig{
+			AgentConfig: KataAgentConfig{
+				HealthCheckInterval: 1,
+				HealthCheckFailures: 2,
+			},
+		},
+	}
+
+	m := newMonitor(s)
+	assert.Equal(time.Second, m.agentCheckInterval)
+	assert.Equal(defaultCheckInterval, m.hypervisorCheckInterval)
+	assert.Equal(uint32(2), m.failureThreshold)
+
+	ch, err := m.newWatcher(context.Background())
+	assert.NoError(err)
+	// stop the periodic checks, watchAgent is called below
+	m.stopCh <- true
+	m.wg.Wait()
+
+	m.watchAgent(context.Background())
+	assert.Len(ch, 0)
+
+	m.watchAgent(context.Background())
+	assert.Len(ch, 1)
+	assert.Equal(uint32(2), m.failures)
+}
@@ -35,6 +35,7 @@ const (
 	upperDir    = "upperdir"
 	workDir     = "workdir"
 	snapshotDir = "snapshotdir"
+	layersDir   = "layers"
 )
 
 var systemMountPrefixes = []string{"/proc", "/sys"}
@@ -257,6 +258,7 @@ func evalMountPath(source, destination string) (string, string, error) {
 func bindMount(ctx context.Context, source, destination string, readonly bool, pgtypes string) error {
 	span, _ := katatrace.Trace(ctx, nil, "bindMount", mountTracingTags)
 	defer span.End()
+	defer watchOperation(slowOperationMount, "bindMount", map[string]string{"source": source, "destination": destination})()
 	span.SetAttributes(otelLabel.String("source", source), otelLabel.String("destination", destination))
 
 	absSource, destination, err := evalMountPath(source, destination)
@@ -294,6 +296,7 @@ func bindMount(ctx context.Context, source, destination string, readonly bool, p
 func remount(ctx context.Context, mountflags uintptr, src string) error {
 	span, _ := katatrace.Trace(ctx, nil, "remount", mountTracingTags)
 	defer span.End()
+	defer watchOperation(slowOperationMount, "remount", src)()
 	span.SetAttributes(otelLabel.String("source", src))
 
 	absSrc, err := filepath.EvalSymlinks(src)
@@ -349,6 +352,10 @@ type Mount struct {
 	// backed by a block device.
 	BlockDeviceID string
 
+	// BlockDeviceSize is the size of the block device backing the mount,
+	// as last propagated to the guest.
+	BlockDeviceSize uint64
+
 	// Options list all the mount options of the filesystem.
 	Options []string
 
@@ -362,6 +369,22 @@ type Mount struct {
 	// FSGroupChangePolicy specifies the policy that will be used when applying
 	// group id ownership change for a volume.
 	FSGroupChangePolicy volume.FSGroupChangePolicy
+
+	// Encryption is the encryption format of a block device mount, decrypted
+	// by the agent with the key read from EncryptionKeyFile.
+	Encryption string
+
+	// EncryptionKeyFile is the host file holding the key of an encrypted
+	// block device mount.
+	EncryptionKeyFile string
+
+	// NetworkVolume is set for the NFS and SMB volumes the agent mounts
+	// over the pod network, Source being their export or share.
+	NetworkVolume bool
+
+	// CredentialsFile is the host file holding the credentials of a SMB
+	// network volume.
+	CredentialsFile string
 }
 
 func isSymlink(path string) bool {
@@ -379,7 +402,9 @@ func bindUnmountContainerShareDir(ctx context.Context, sharedDir, cID, target st
 		return nil
 	}
 
+	done := watchOperation(slowOperationMount, "unmount", destDir)
 	err := syscall.Unmount(destDir, syscall.MNT_DETACH|UmountNoFollow)
+	done()
 	if err == syscall.ENOENT {
 		mountLogger().WithError(err).WithField("share-dir", destDir).Warn()
 		return nil
@@ -582,12 +607,11 @@ func countFiles(path string, limit int) (numFiles int, err error) {
 
 func isWatchableMount(path string) bool {
 	if isSecret(path) || isConfigMap(path) {
-		// we have a cap on number of FDs which can be present in mount
-		// to determine if watchable. A similar Check exists within the agent,
-		// which may or may not help handle case where extra files are added to
-		// a mount after the fact
-		count, _ := countFiles(path, 8)
-		if count > 0 {
+		// The content of watchable mounts is synced to the guest by chunks,
+		// so their size and number of files are not limited: the mount only
+		// needs to hold at least one file.
+		count, _ := countFiles(path, 1)
+		if count != 0 {
 			return true
 		}
 	}
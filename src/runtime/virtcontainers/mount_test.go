@@ -331,29 +331,25 @@ func TestIsWatchable(t *testing.T) {
 	result = isWatchableMount(secret)
 	assert.True(result)
 
-	// Verify that if we have too many files, it will no longer be watchable:
+	// Verify that the number of files is not limited, but that an empty
+	// mount is not watchable:
 	// /tmp/kubernetes.io~configmap/amazing-dir-of-configs/
 	//                                  | - c0
 	//                                  | - c1
 	//                                    ...
-	//                                  | - c7
-	// should be okay.
-	//
-	// 9 files should cause the mount to be deemed "not watchable"
+	//                                  | - c63
 	configs := filepath.Join(testPath, K8sConfigMap, "amazing-dir-of-configs")
 	err = os.MkdirAll(configs, 0777)
 	assert.NoError(err)
+	result = isWatchableMount(configs)
+	assert.False(result)
 
-	for i := 0; i < 8; i++ {
+	for i := 0; i < 64; i++ {
 		_, err := os.Create(filepath.Join(configs, fmt.Sprintf("c%v", i)))
 		assert.NoError(err)
 		result = isWatchableMount(configs)
 		assert.True(result)
 	}
-	_, err = os.Create(filepath.Join(configs, "toomuch"))
-	assert.NoError(err)
-	result = isWatchableMount(configs)
-	assert.False(result)
 }
 
 func TestBindMountInvalidSourceSymlink(t *testing.T) {
@@ -84,6 +84,9 @@ type NetworkInterfacePair struct {
 	TapInterface
 	VirtIface NetworkInterface
 	NetInterworkingModel
+	// MacvtapMode is the mode of the macvtap device created by the
+	// macvtap internetworking model.
+	MacvtapMode MacvtapMode
 }
 
 // NetlinkIface describes fully a network interface.
@@ -123,6 +126,17 @@ const (
 	// NetXConnectNoneModel can be used when the VM is in the host network namespace
 	NetXConnectNoneModel
 
+	// NetXConnectPasstModel connects the VM to the network interface
+	// through passt, a user-mode networking daemon. It does not require
+	// the creation of tap devices.
+	NetXConnectPasstModel
+
+	// NetXConnectEBPFModel redirects traffic from the network interface
+	// provided by the network plugin to a tap interface, like
+	// NetXConnectTCFilterModel, but using eBPF programs instead of
+	// u32 filters and mirred actions.
+	NetXConnectEBPFModel
+
 	// NetXConnectInvalidModel is the last item to Check valid values by IsValid()
 	NetXConnectInvalidModel
 )
@@ -140,6 +154,10 @@ const (
 	tcFilterNetModelStr = "tcfilter"
 
 	noneNetModelStr = "none"
+
+	passtNetModelStr = "passt"
+
+	ebpfNetModelStr = "ebpf"
 )
 
 //GetModel returns the string value of a NetInterworkingModel
@@ -153,6 +171,10 @@ func (n *NetInterworkingModel) GetModel() string {
 		return tcFilterNetModelStr
 	case NetXConnectNoneModel:
 		return noneNetModelStr
+	case NetXConnectPasstModel:
+		return passtNetModelStr
+	case NetXConnectEBPFModel:
+		return ebpfNetModelStr
 	}
 	return "unknown"
 }
@@ -172,6 +194,12 @@ func (n *NetInterworkingModel) SetModel(modelName string) error {
 	case noneNetModelStr:
 		*n = NetXConnectNoneModel
 		return nil
+	case passtNetModelStr:
+		*n = NetXConnectPasstModel
+		return nil
+	case ebpfNetModelStr:
+		*n = NetXConnectEBPFModel
+		return nil
 	}
 	return fmt.Errorf("Unknown type %s", modelName)
 }
@@ -181,6 +209,79 @@ func (n *NetInterworkingModel) SetModel(modelName string) error {
 // the container network interface
 var DefaultNetInterworkingModel = NetXConnectTCFilterModel
 
+// HostNetworkMode defines how a sandbox sharing the network namespace
+// of the host gets connected.
+type HostNetworkMode string
+
+const (
+	// HostNetworkReject rejects sandboxes sharing the host network namespace.
+	HostNetworkReject HostNetworkMode = "reject"
+
+	// HostNetworkNone boots the VM without any network interface but the
+	// loopback one. The host network namespace is never scanned.
+	HostNetworkNone HostNetworkMode = "none"
+
+	// HostNetworkUser connects the VM to the host default route interface
+	// through passt. No tap device is created in the host network namespace.
+	HostNetworkUser HostNetworkMode = "user"
+)
+
+// Set sets a host network mode based on the input string.
+func (m *HostNetworkMode) Set(value string) error {
+	switch HostNetworkMode(value) {
+	case "", HostNetworkReject:
+		*m = HostNetworkReject
+	case HostNetworkNone:
+		*m = HostNetworkNone
+	case HostNetworkUser:
+		*m = HostNetworkUser
+	default:
+		return fmt.Errorf("Unknown host network mode %s", value)
+	}
+
+	return nil
+}
+
+// MacvtapMode defines the mode of the macvtap devices created by the
+// macvtap internetworking model.
+type MacvtapMode string
+
+const (
+	// MacvtapModeBridge lets the macvtap devices sharing a lower device
+	// talk to each other directly.
+	MacvtapModeBridge MacvtapMode = "bridge"
+
+	// MacvtapModePrivate isolates the macvtap devices sharing a lower device
+	// from each other.
+	MacvtapModePrivate MacvtapMode = "private"
+
+	// MacvtapModeVEPA sends all the traffic to the external switch.
+	MacvtapModeVEPA MacvtapMode = "vepa"
+
+	// MacvtapModePassthru hands the lower device over to a single macvtap
+	// device, e.g. when a host NIC is dedicated to a pod. The MAC address
+	// of the lower device is preserved and used by the guest.
+	MacvtapModePassthru MacvtapMode = "passthru"
+)
+
+// Set sets a macvtap mode based on the input string.
+func (m *MacvtapMode) Set(value string) error {
+	switch MacvtapMode(value) {
+	case "", MacvtapModeBridge:
+		*m = MacvtapModeBridge
+	case MacvtapModePrivate:
+		*m = MacvtapModePrivate
+	case MacvtapModeVEPA:
+		*m = MacvtapModeVEPA
+	case MacvtapModePassthru:
+		*m = MacvtapModePassthru
+	default:
+		return fmt.Errorf("Unknown macvtap mode %s", value)
+	}
+
+	return nil
+}
+
 // DNSInfo describes the DNS setup related to a network interface.
 type DNSInfo struct {
 	Servers  []string
@@ -192,9 +293,16 @@ type DNSInfo struct {
 // NetworkConfig is the network configuration related to a network.
 type NetworkConfig struct {
 	NetworkID         string
+	PasstPath         string
+	HostNetworkMode   HostNetworkMode
+	MacvtapMode       MacvtapMode
 	InterworkingModel NetInterworkingModel
 	NetworkCreated    bool
 	DisableNewNetwork bool
+	// HostNetwork is set when the sandbox shares the network namespace
+	// of the host, in which case the namespace is not scanned for
+	// endpoints.
+	HostNetwork bool
 }
 
 type Network interface {
@@ -226,6 +334,10 @@ type Network interface {
 
 	// SetEndpoints sets a sandbox's network endpoints.
 	SetEndpoints([]Endpoint)
+
+	// Stats returns the host side statistics of the network interfaces
+	// backing the sandbox's network endpoints.
+	Stats(context.Context) ([]*NetworkStats, error)
 }
 
 func generateVCNetworkStructures(ctx context.Context, network Network) ([]*pbTypes.Interface, []*pbTypes.Route, []*pbTypes.ARPNeighbor, error) {
@@ -298,34 +410,44 @@ func generateVCNetworkStructures(ctx context.Context, network Network) ([]*pbTyp
 			routes = append(routes, &r)
 		}
 
-		for _, neigh := range endpoint.Properties().Neighbors {
-			var n pbTypes.ARPNeighbor
+		neighs = append(neighs, generateVCNeighbors(endpoint)...)
+	}
 
-			if !validGuestNeighbor(neigh) {
-				continue
-			}
+	return ifaces, routes, neighs, nil
+}
+
+// generateVCNeighbors returns the static ARP/NDP neighbor entries of an
+// endpoint, as expected by the agent.
+func generateVCNeighbors(endpoint Endpoint) []*pbTypes.ARPNeighbor {
+	var neighs []*pbTypes.ARPNeighbor
 
-			n.Device = endpoint.Name()
-			n.State = int32(neigh.State)
-			n.Flags = int32(neigh.Flags)
+	for _, neigh := range endpoint.Properties().Neighbors {
+		var n pbTypes.ARPNeighbor
 
-			if neigh.HardwareAddr != nil {
-				n.Lladdr = neigh.HardwareAddr.String()
-			}
+		if !validGuestNeighbor(neigh) {
+			continue
+		}
 
-			n.ToIPAddress = &pbTypes.IPAddress{
-				Family:  pbTypes.IPFamily_v4,
-				Address: neigh.IP.String(),
-			}
-			if neigh.IP.To4() == nil {
-				n.ToIPAddress.Family = pbTypes.IPFamily_v6
-			}
+		n.Device = endpoint.Name()
+		n.State = int32(neigh.State)
+		n.Flags = int32(neigh.Flags)
 
-			neighs = append(neighs, &n)
+		if neigh.HardwareAddr != nil {
+			n.Lladdr = neigh.HardwareAddr.String()
 		}
+
+		n.ToIPAddress = &pbTypes.IPAddress{
+			Family:  pbTypes.IPFamily_v4,
+			Address: neigh.IP.String(),
+		}
+		if neigh.IP.To4() == nil {
+			n.ToIPAddress.Family = pbTypes.IPFamily_v6
+		}
+
+		neighs = append(neighs, &n)
 	}
 
-	return ifaces, routes, neighs, nil
+	return neighs
 }
 
 func createNetworkInterfacePair(idx int, ifName string, interworkingModel NetInterworkingModel) (NetworkInterfacePair, error) {
@@ -0,0 +1,135 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// bpfFuncRedirect is the id of the bpf_redirect() helper.
+	bpfFuncRedirect = 23
+
+	bpfRedirectProgName = "kata_redirect"
+	bpfRedirectLicense  = "Apache-2.0"
+)
+
+// bpfInsn mirrors struct bpf_insn from <linux/bpf.h>.
+type bpfInsn struct {
+	code uint8
+	regs uint8
+	off  int16
+	imm  int32
+}
+
+// bpfProgLoadAttr mirrors the BPF_PROG_LOAD part of union bpf_attr.
+type bpfProgLoadAttr struct {
+	progType    uint32
+	insnCnt     uint32
+	insns       uint64
+	license     uint64
+	logLevel    uint32
+	logSize     uint32
+	logBuf      uint64
+	kernVersion uint32
+	progFlags   uint32
+	progName    [unix.BPF_OBJ_NAME_LEN]byte
+}
+
+var hostBigEndian = func() bool {
+	x := uint16(1)
+	return *(*byte)(unsafe.Pointer(&x)) == 0
+}()
+
+// bpfRegs encodes the dst_reg:4 and src_reg:4 bitfields of struct bpf_insn,
+// whose layout depends on the host byte order.
+func bpfRegs(dst, src uint8) uint8 {
+	if hostBigEndian {
+		return dst<<4 | src
+	}
+	return src<<4 | dst
+}
+
+// bpfRedirectInsns returns a program redirecting every packet to the egress
+// path of the interface with index "destIndex". It is the equivalent of:
+//
+//	int redirect(struct __sk_buff *skb) { return bpf_redirect(destIndex, 0); }
+func bpfRedirectInsns(destIndex int) []bpfInsn {
+	return []bpfInsn{
+		// r1 = destIndex
+		{code: unix.BPF_ALU64 | unix.BPF_MOV | unix.BPF_K, regs: bpfRegs(1, 0), imm: int32(destIndex)},
+		// r2 = 0 (egress)
+		{code: unix.BPF_ALU64 | unix.BPF_MOV | unix.BPF_K, regs: bpfRegs(2, 0), imm: 0},
+		// r0 = bpf_redirect(r1, r2), i.e. TC_ACT_REDIRECT
+		{code: unix.BPF_JMP | unix.BPF_CALL, imm: bpfFuncRedirect},
+		{code: unix.BPF_JMP | unix.BPF_EXIT},
+	}
+}
+
+// loadBPFRedirectProg loads a sched_cls program redirecting all traffic to
+// the interface with index "destIndex", and returns its file descriptor.
+func loadBPFRedirectProg(destIndex int) (int, error) {
+	insns := bpfRedirectInsns(destIndex)
+
+	license, err := unix.ByteSliceFromString(bpfRedirectLicense)
+	if err != nil {
+		return -1, err
+	}
+
+	attr := bpfProgLoadAttr{
+		progType: unix.BPF_PROG_TYPE_SCHED_CLS,
+		insnCnt:  uint32(len(insns)),
+		insns:    uint64(uintptr(unsafe.Pointer(&insns[0]))),
+		license:  uint64(uintptr(unsafe.Pointer(&license[0]))),
+	}
+	copy(attr.progName[:], bpfRedirectProgName)
+
+	fd, _, errno := unix.Syscall(unix.SYS_BPF, unix.BPF_PROG_LOAD, uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr))
+	runtime.KeepAlive(insns)
+	runtime.KeepAlive(license)
+	if errno != 0 {
+		return -1, fmt.Errorf("Failed to load eBPF redirect program: %s", errno)
+	}
+
+	return int(fd), nil
+}
+
+// addRedirectBPFFilter adds a tc bpf filter for device with index "sourceIndex".
+// All traffic for interface with index "sourceIndex" is redirected to interface with
+// index "destIndex" by an eBPF program in direct-action mode.
+//
+// This is equivalent to calling:
+// `tc filter add dev source parent ffff: protocol all bpf direct-action obj redirect.o`
+func addRedirectBPFFilter(sourceIndex, destIndex int) error {
+	fd, err := loadBPFRedirectProg(destIndex)
+	if err != nil {
+		return err
+	}
+	// The filter holds its own reference to the program.
+	defer unix.Close(fd)
+
+	filter := &netlink.BpfFilter{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: sourceIndex,
+			Parent:    netlink.MakeHandle(0xffff, 0),
+			Protocol:  unix.ETH_P_ALL,
+		},
+		Fd:           fd,
+		Name:         bpfRedirectProgName,
+		DirectAction: true,
+	}
+
+	if err := netlink.FilterAdd(filter); err != nil {
+		return fmt.Errorf("Failed to add eBPF filter for index %d : %s", sourceIndex, err)
+	}
+
+	return nil
+}
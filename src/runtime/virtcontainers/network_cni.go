@@ -0,0 +1,184 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// cniResultsDir is where libcni caches the results of the CNI ADD
+// operations, one file per network and interface of a container.
+var cniResultsDir = "/var/lib/cni/results"
+
+const cniCacheKindV1 = "cniCacheV1"
+
+// cniCachedResult is the subset of a libcni cached result the runtime uses.
+type cniCachedResult struct {
+	Kind        string `json:"kind"`
+	ContainerID string `json:"containerId"`
+	IfName      string `json:"ifName"`
+	Result      struct {
+		DNS struct {
+			Nameservers []string `json:"nameservers,omitempty"`
+			Domain      string   `json:"domain,omitempty"`
+			Search      []string `json:"search,omitempty"`
+			Options     []string `json:"options,omitempty"`
+		} `json:"dns,omitempty"`
+	} `json:"result"`
+}
+
+// cniResultsDNS returns the DNS settings found in the cached CNI results of
+// the sandbox, indexed by interface name.
+func cniResultsDNS(sandboxID string) map[string]DNSInfo {
+	dnsInfo := make(map[string]DNSInfo)
+
+	files, err := os.ReadDir(cniResultsDir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			networkLogger().WithError(err).Warn("Could not read CNI results")
+		}
+		return dnsInfo
+	}
+
+	for _, f := range files {
+		// Results are stored as <network>-<container id>-<interface>
+		if f.IsDir() || !strings.Contains(f.Name(), "-"+sandboxID+"-") {
+			continue
+		}
+
+		path := filepath.Join(cniResultsDir, f.Name())
+		content, err := os.ReadFile(path)
+		if err != nil {
+			networkLogger().WithError(err).WithField("file", path).Warn("Could not read CNI result")
+			continue
+		}
+
+		var cached cniCachedResult
+		if err := json.Unmarshal(content, &cached); err != nil {
+			networkLogger().WithError(err).WithField("file", path).Warn("Could not parse CNI result")
+			continue
+		}
+
+		if cached.Kind != cniCacheKindV1 || cached.ContainerID != sandboxID {
+			continue
+		}
+
+		dns := cached.Result.DNS
+		dnsInfo[cached.IfName] = DNSInfo{
+			Servers:  dns.Nameservers,
+			Domain:   dns.Domain,
+			Searches: dns.Search,
+			Options:  dns.Options,
+		}
+	}
+
+	return dnsInfo
+}
+
+// networkDNSInfo returns the DNS settings of the network endpoints.
+func networkDNSInfo(network Network) []DNSInfo {
+	var dnsInfo []DNSInfo
+	for _, ep := range network.Endpoints() {
+		dnsInfo = append(dnsInfo, ep.Properties().DNS)
+	}
+	return dnsInfo
+}
+
+// mergeDNS merges the DNS settings provided by the network plugins into the
+// resolv.conf lines of the sandbox. Settings already present are kept first,
+// so that the DNS configuration requested for the pod takes precedence.
+func mergeDNS(lines []string, dnsInfo []DNSInfo) []string {
+	var servers, searches, options []string
+	var domain string
+
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "nameserver":
+			servers = append(servers, fields[1:]...)
+		case "search":
+			searches = append(searches, fields[1:]...)
+		case "options":
+			options = append(options, fields[1:]...)
+		case "domain":
+			if len(fields) > 1 {
+				domain = fields[1]
+			}
+		}
+	}
+
+	var newServers, newSearches, newOptions []string
+	for _, info := range dnsInfo {
+		newServers = appendMissing(newServers, servers, info.Servers)
+		newSearches = appendMissing(newSearches, searches, info.Searches)
+		newOptions = appendMissing(newOptions, options, info.Options)
+		if domain == "" {
+			domain = info.Domain
+			if domain != "" {
+				lines = append(lines, "domain "+domain)
+			}
+		}
+	}
+
+	if len(newServers) == 0 && len(newSearches) == 0 && len(newOptions) == 0 {
+		return lines
+	}
+
+	merged := make([]string, 0, len(lines)+len(newServers))
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) > 0 {
+			// The resolver only honours the last search and options
+			// lines, hence they are rewritten below.
+			if fields[0] == "search" && len(newSearches) > 0 {
+				continue
+			}
+			if fields[0] == "options" && len(newOptions) > 0 {
+				continue
+			}
+		}
+		merged = append(merged, line)
+	}
+
+	for _, server := range newServers {
+		merged = append(merged, "nameserver "+server)
+	}
+	if len(newSearches) > 0 {
+		merged = append(merged, "search "+strings.Join(append(searches, newSearches...), " "))
+	}
+	if len(newOptions) > 0 {
+		merged = append(merged, "options "+strings.Join(append(options, newOptions...), " "))
+	}
+
+	return merged
+}
+
+// appendMissing appends to "added" the values that are neither in
+// "existing" nor already in "added".
+func appendMissing(added, existing, values []string) []string {
+	for _, v := range values {
+		if v == "" || containsString(existing, v) || containsString(added, v) {
+			continue
+		}
+		added = append(added, v)
+	}
+	return added
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
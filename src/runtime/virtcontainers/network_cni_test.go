@@ -0,0 +1,98 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCniResultsDNS(t *testing.T) {
+	assert := assert.New(t)
+
+	savedCniResultsDir := cniResultsDir
+	defer func() { cniResultsDir = savedCniResultsDir }()
+	cniResultsDir = t.TempDir()
+
+	sandboxID := "4f3a2b"
+
+	results := map[string]string{
+		"mynet-4f3a2b-eth0": `{"kind":"cniCacheV1","containerId":"4f3a2b","ifName":"eth0","networkName":"mynet",
+			"result":{"cniVersion":"0.4.0","dns":{"nameservers":["10.0.0.10"],"domain":"example.com","search":["svc.example.com"],"options":["ndots:2"]}}}`,
+		"mynet-4f3a2b-net1": `{"kind":"cniCacheV1","containerId":"4f3a2b","ifName":"net1","networkName":"mynet","result":{"cniVersion":"0.4.0"}}`,
+		"mynet-8c7d6e-eth0": `{"kind":"cniCacheV1","containerId":"8c7d6e","ifName":"eth0","networkName":"mynet",
+			"result":{"dns":{"nameservers":["10.0.0.11"]}}}`,
+		"mynet-4f3a2b-eth1": `invalid`,
+	}
+	for name, content := range results {
+		err := os.WriteFile(filepath.Join(cniResultsDir, name), []byte(content), 0600)
+		assert.NoError(err)
+	}
+
+	dnsInfo := cniResultsDNS(sandboxID)
+	assert.Len(dnsInfo, 2)
+	assert.Equal(DNSInfo{
+		Servers:  []string{"10.0.0.10"},
+		Domain:   "example.com",
+		Searches: []string{"svc.example.com"},
+		Options:  []string{"ndots:2"},
+	}, dnsInfo["eth0"])
+	assert.Equal(DNSInfo{}, dnsInfo["net1"])
+
+	cniResultsDir = filepath.Join(cniResultsDir, "nonexistent")
+	assert.Empty(cniResultsDNS(sandboxID))
+}
+
+func TestMergeDNS(t *testing.T) {
+	assert := assert.New(t)
+
+	resolvConf := []string{
+		"nameserver 10.96.0.10",
+		"search default.svc.cluster.local svc.cluster.local",
+		"options ndots:5",
+		"",
+	}
+
+	// No DNS settings from the network plugins
+	assert.Equal(resolvConf, mergeDNS(resolvConf, nil))
+	assert.Equal(resolvConf, mergeDNS(resolvConf, []DNSInfo{{}}))
+
+	merged := mergeDNS(resolvConf, []DNSInfo{
+		{
+			Servers:  []string{"10.96.0.10", "10.0.0.10"},
+			Searches: []string{"svc.cluster.local", "example.com"},
+		},
+		{
+			Servers: []string{"10.0.0.11"},
+			Options: []string{"ndots:5", "timeout:2"},
+		},
+	})
+	assert.Equal([]string{
+		"nameserver 10.96.0.10",
+		"",
+		"nameserver 10.0.0.10",
+		"nameserver 10.0.0.11",
+		"search default.svc.cluster.local svc.cluster.local example.com",
+		"options ndots:5 timeout:2",
+	}, merged)
+
+	// No resolv.conf provided for the sandbox
+	merged = mergeDNS(nil, []DNSInfo{
+		{
+			Servers:  []string{"10.0.0.10"},
+			Domain:   "example.com",
+			Searches: []string{"example.com"},
+		},
+	})
+	assert.Equal([]string{
+		"domain example.com",
+		"nameserver 10.0.0.10",
+		"search example.com",
+	}, merged)
+}
@@ -14,9 +14,11 @@ import (
 	"os/exec"
 	"runtime"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
 	"github.com/vishvananda/netns"
 	otelTrace "go.opentelemetry.io/otel/trace"
@@ -36,9 +38,16 @@ const (
 // LinuxNetwork represents a sandbox networking setup.
 type LinuxNetwork struct {
 	netNSPath         string
+	passtPath         string
+	macvtapMode       MacvtapMode
 	eps               []Endpoint
 	interworkingModel NetInterworkingModel
 	netNSCreated      bool
+	hostNetwork       bool
+
+	// Serializes the endpoint updates, which are also done by the
+	// network namespace watcher.
+	epsLock sync.Mutex
 }
 
 // NewNetwork creates a new Linux Network from a NetworkConfig.
@@ -61,11 +70,19 @@ func NewNetwork(configs ...*NetworkConfig) (Network, error) {
 		return nil, fmt.Errorf("Missing network configuration")
 	}
 
+	interworkingModel := config.InterworkingModel
+	if config.HostNetwork && config.HostNetworkMode == HostNetworkUser {
+		interworkingModel = NetXConnectPasstModel
+	}
+
 	return &LinuxNetwork{
-		config.NetworkID,
-		[]Endpoint{},
-		config.InterworkingModel,
-		config.NetworkCreated,
+		netNSPath:         config.NetworkID,
+		passtPath:         config.PasstPath,
+		macvtapMode:       config.MacvtapMode,
+		eps:               []Endpoint{},
+		interworkingModel: interworkingModel,
+		netNSCreated:      config.NetworkCreated,
+		hostNetwork:       config.HostNetwork,
 	}, nil
 }
 
@@ -92,6 +109,10 @@ func LoadNetwork(netInfo persistapi.NetworkInfo) Network {
 			ep = &TapEndpoint{}
 		case IPVlanEndpointType:
 			ep = &IPVlanEndpoint{}
+		case VdpaEndpointType:
+			ep = &VdpaEndpoint{}
+		case PasstEndpointType:
+			ep = &PasstEndpoint{}
 		default:
 			networkLogger().WithField("endpoint-type", e.Type).Error("unknown endpoint type")
 			continue
@@ -114,16 +135,46 @@ func (n *LinuxNetwork) addSingleEndpoint(ctx context.Context, s *Sandbox, netInf
 	// an appropriate EndPoint based on interface type
 	// This should be a switch
 
-	// Check if interface is a physical interface. Do not create
-	// tap interface/bridge if it is.
-	isPhysical, err := isPhysicalIface(netInfo.Iface.Name)
-	if err != nil {
-		return nil, err
+	var err error
+	var isPhysical bool
+
+	// With the host network, the interfaces are the ones of the host
+	// holding the default route: they are only reached through the
+	// sockets passt opens, and must never be handed over to the guest,
+	// e.g. with VFIO, even if they are physical.
+	if n.hostNetwork && n.interworkingModel != NetXConnectPasstModel {
+		return nil, fmt.Errorf("host network interface %s can only be connected through passt", netInfo.Iface.Name)
+	}
+
+	if n.interworkingModel != NetXConnectPasstModel {
+		// Check if interface is a physical interface. Do not create
+		// tap interface/bridge if it is.
+		isPhysical, err = isPhysicalIface(netInfo.Iface.Name)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if isPhysical {
-		networkLogger().WithField("interface", netInfo.Iface.Name).Info("Physical network interface found")
-		endpoint, err = createPhysicalEndpoint(netInfo)
+	if n.interworkingModel == NetXConnectPasstModel {
+		networkLogger().WithField("interface", netInfo.Iface.Name).Info("Connecting interface through passt")
+		endpoint, err = createPasstEndpoint(netInfo, n.passtPath)
+	} else if isPhysical {
+		var vhostDevPath string
+
+		// Check if a vDPA device is bound to the vhost-vdpa driver on
+		// top of the physical interface.
+		vhostDevPath, err = vdpaVhostDevPath(netInfo.Iface.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if vhostDevPath != "" {
+			networkLogger().WithField("interface", netInfo.Iface.Name).Info("vDPA network interface found")
+			endpoint, err = createVdpaEndpoint(netInfo, vhostDevPath)
+		} else {
+			networkLogger().WithField("interface", netInfo.Iface.Name).Info("Physical network interface found")
+			endpoint, err = createPhysicalEndpoint(netInfo)
+		}
 	} else {
 		var socketPath string
 		idx := len(n.eps)
@@ -178,6 +229,10 @@ func (n *LinuxNetwork) addSingleEndpoint(ctx context.Context, s *Sandbox, netInf
 
 	endpoint.SetProperties(netInfo)
 
+	if netPair := endpoint.NetworkPair(); netPair != nil {
+		netPair.MacvtapMode = n.macvtapMode
+	}
+
 	networkLogger().WithField("endpoint-type", endpoint.Type()).WithField("hotplug", hotplug).Info("Attaching endpoint")
 	if hotplug {
 		if err := endpoint.HotAttach(ctx, s.hypervisor); err != nil {
@@ -268,16 +323,26 @@ func (n *LinuxNetwork) addAllEndpoints(ctx context.Context, s *Sandbox, hotplug
 	}
 	defer netlinkHandle.Close()
 
-	linkList, err := netlinkHandle.LinkList()
+	var linkList []netlink.Link
+	if n.hostNetwork {
+		// Only the interfaces holding a default route are connected
+		// when sharing the host network namespace.
+		linkList, err = defaultRouteLinks(netlinkHandle)
+	} else {
+		linkList, err = netlinkHandle.LinkList()
+	}
 	if err != nil {
 		return err
 	}
 
+	cniDNS := cniResultsDNS(s.id)
+
 	for _, link := range linkList {
 		netInfo, err := networkInfoFromLink(netlinkHandle, link)
 		if err != nil {
 			return err
 		}
+		netInfo.DNS = cniDNS[netInfo.Iface.Name]
 
 		// Ignore unconfigured network interfaces. These are
 		// either base tunnel devices that are not namespaced
@@ -310,6 +375,32 @@ func (n *LinuxNetwork) addAllEndpoints(ctx context.Context, s *Sandbox, hotplug
 	return nil
 }
 
+// defaultRouteLinks returns the links holding a default route.
+func defaultRouteLinks(handle *netlink.Handle) ([]netlink.Link, error) {
+	routes, err := handle.RouteList(nil, netlink.FAMILY_ALL)
+	if err != nil {
+		return nil, err
+	}
+
+	var links []netlink.Link
+	found := make(map[int]bool)
+	for _, route := range routes {
+		if route.Dst != nil || route.LinkIndex <= 0 || found[route.LinkIndex] {
+			continue
+		}
+
+		link, err := handle.LinkByIndex(route.LinkIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		found[route.LinkIndex] = true
+		links = append(links, link)
+	}
+
+	return links, nil
+}
+
 // Run runs a callback in the specified network namespace.
 func (n *LinuxNetwork) Run(ctx context.Context, cb func() error) error {
 	span, _ := n.trace(ctx, "Run")
@@ -326,6 +417,9 @@ func (n *LinuxNetwork) AddEndpoints(ctx context.Context, s *Sandbox, endpointsIn
 	katatrace.AddTags(span, "type", n.interworkingModel.GetModel())
 	defer span.End()
 
+	n.epsLock.Lock()
+	defer n.epsLock.Unlock()
+
 	if endpointsInfo == nil {
 		if err := n.addAllEndpoints(ctx, s, hotplug); err != nil {
 			return nil, err
@@ -333,6 +427,10 @@ func (n *LinuxNetwork) AddEndpoints(ctx context.Context, s *Sandbox, endpointsIn
 	} else {
 		for _, ep := range endpointsInfo {
 			if err := doNetNS(n.netNSPath, func(_ ns.NetNS) error {
+				if ep.Neighbors == nil {
+					ep.Neighbors = linkNeighbors(ep.Iface.Name)
+				}
+
 				if _, err := n.addSingleEndpoint(ctx, s, ep, hotplug); err != nil {
 					n.eps = nil
 					return err
@@ -348,7 +446,7 @@ func (n *LinuxNetwork) AddEndpoints(ctx context.Context, s *Sandbox, endpointsIn
 	katatrace.AddTags(span, "endpoints", n.eps, "hotplug", hotplug)
 	networkLogger().Debug("Endpoints added")
 
-	return n.eps, nil
+	return n.endpoints(), nil
 }
 
 // Remove network endpoints in the network namespace. It also deletes the network
@@ -357,17 +455,23 @@ func (n *LinuxNetwork) RemoveEndpoints(ctx context.Context, s *Sandbox, endpoint
 	span, ctx := n.trace(ctx, "RemoveEndpoints")
 	defer span.End()
 
-	eps := n.eps
+	n.epsLock.Lock()
+	defer n.epsLock.Unlock()
+
+	eps := n.endpoints()
 	if endpoints != nil {
 		eps = endpoints
 	}
 
 	for idx, ep := range eps {
 		if endpoints != nil {
-			new_ep, _ := findEndpoint(ep, n.eps)
+			new_ep, epIdx := findEndpoint(ep, n.eps)
 			if new_ep == nil {
 				continue
 			}
+			// Remove the endpoint at its position in the network
+			// endpoints, not in the list given by the caller.
+			idx = epIdx
 		}
 
 		if err := n.removeSingleEndpoint(ctx, s, idx, hotplug); err != nil {
@@ -395,13 +499,97 @@ func (n *LinuxNetwork) NetworkCreated() bool {
 }
 
 func (n *LinuxNetwork) Endpoints() []Endpoint {
-	return n.eps
+	n.epsLock.Lock()
+	defer n.epsLock.Unlock()
+
+	return n.endpoints()
 }
 
 func (n *LinuxNetwork) SetEndpoints(endpoints []Endpoint) {
+	n.epsLock.Lock()
+	defer n.epsLock.Unlock()
+
 	n.eps = endpoints
 }
 
+// endpoints returns a copy of the endpoints, which the removals update in
+// place. The caller holds epsLock.
+func (n *LinuxNetwork) endpoints() []Endpoint {
+	if n.eps == nil {
+		return nil
+	}
+
+	return append([]Endpoint{}, n.eps...)
+}
+
+// Stats returns the statistics of the host interfaces, e.g. the veth and
+// tap pair, connecting each endpoint to the VM.
+func (n *LinuxNetwork) Stats(ctx context.Context) ([]*NetworkStats, error) {
+	span, _ := n.trace(ctx, "Stats")
+	defer span.End()
+
+	var stats []*NetworkStats
+	eps := n.Endpoints()
+
+	err := doNetNS(n.netNSPath, func(_ ns.NetNS) error {
+		netHandle, err := netlink.NewHandle()
+		if err != nil {
+			return err
+		}
+		defer netHandle.Close()
+
+		for _, ep := range eps {
+			for _, name := range endpointHostIfaces(ep) {
+				link, err := netHandle.LinkByName(name)
+				if err != nil {
+					// Some endpoints, e.g. vhost-user ones, have no
+					// host interface in the network namespace.
+					continue
+				}
+				stats = append(stats, linkNetworkStats(link))
+			}
+		}
+
+		return nil
+	})
+
+	return stats, err
+}
+
+// endpointHostIfaces returns the names of the host interfaces used by an endpoint.
+func endpointHostIfaces(endpoint Endpoint) []string {
+	netPair := endpoint.NetworkPair()
+	if netPair == nil {
+		return []string{endpoint.Name()}
+	}
+
+	names := []string{netPair.VirtIface.Name}
+	if netPair.TAPIface.Name != "" {
+		names = append(names, netPair.TAPIface.Name)
+	}
+	return names
+}
+
+func linkNetworkStats(link netlink.Link) *NetworkStats {
+	attrs := link.Attrs()
+	stats := &NetworkStats{
+		Name: attrs.Name,
+	}
+
+	if s := attrs.Statistics; s != nil {
+		stats.RxBytes = s.RxBytes
+		stats.RxPackets = s.RxPackets
+		stats.RxErrors = s.RxErrors
+		stats.RxDropped = s.RxDropped
+		stats.TxBytes = s.TxBytes
+		stats.TxPackets = s.TxPackets
+		stats.TxErrors = s.TxErrors
+		stats.TxDropped = s.TxDropped
+	}
+
+	return stats
+}
+
 func createLink(netHandle *netlink.Handle, name string, expectedLink netlink.Link, queues int) (netlink.Link, []*os.File, error) {
 	var newLink netlink.Link
 	var fds []*os.File
@@ -423,9 +611,13 @@ func createLink(netHandle *netlink.Handle, name string, expectedLink netlink.Lin
 		if qlen <= 0 {
 			qlen = defaultQlen
 		}
+		mode := expectedLink.(*netlink.Macvtap).Mode
+		if mode == netlink.MACVLAN_MODE_DEFAULT {
+			mode = netlink.MACVLAN_MODE_BRIDGE
+		}
 		newLink = &netlink.Macvtap{
 			Macvlan: netlink.Macvlan{
-				Mode: netlink.MACVLAN_MODE_BRIDGE,
+				Mode: mode,
 				LinkAttrs: netlink.LinkAttrs{
 					Index:       expectedLink.Attrs().Index,
 					Name:        name,
@@ -451,6 +643,40 @@ func createLink(netHandle *netlink.Handle, name string, expectedLink netlink.Lin
 	return newLink, fds, err
 }
 
+// netlinkMode returns the netlink macvlan mode matching a macvtap mode.
+func (m MacvtapMode) netlinkMode() netlink.MacvlanMode {
+	switch m {
+	case MacvtapModePrivate:
+		return netlink.MACVLAN_MODE_PRIVATE
+	case MacvtapModeVEPA:
+		return netlink.MACVLAN_MODE_VEPA
+	case MacvtapModePassthru:
+		return netlink.MACVLAN_MODE_PASSTHRU
+	default:
+		return netlink.MACVLAN_MODE_BRIDGE
+	}
+}
+
+// syncEndpointMTU updates the MTU handed over to the guest when the MTU of
+// the host interface changed since the network namespace was scanned, e.g.
+// when the CNI plugin adjusted it late. This keeps the tap device, the
+// virtio-net device and the guest interface consistent.
+func syncEndpointMTU(endpoint Endpoint, mtu int) {
+	properties := endpoint.Properties()
+	if mtu <= 0 || properties.Iface.MTU == mtu {
+		return
+	}
+
+	networkLogger().WithFields(logrus.Fields{
+		"endpoint": endpoint.Name(),
+		"old-mtu":  properties.Iface.MTU,
+		"new-mtu":  mtu,
+	}).Info("Updating endpoint MTU")
+
+	properties.Iface.MTU = mtu
+	endpoint.SetProperties(properties)
+}
+
 func getLinkForEndpoint(endpoint Endpoint, netHandle *netlink.Handle) (netlink.Link, error) {
 	var link netlink.Link
 
@@ -514,12 +740,13 @@ func xConnectVMNetwork(ctx context.Context, endpoint Endpoint, h Hypervisor) err
 	netPair := endpoint.NetworkPair()
 
 	queues := 0
+	hConfig := h.HypervisorConfig()
 	caps := h.Capabilities(ctx)
 	if caps.IsMultiQueueSupported() {
-		queues = int(h.HypervisorConfig().NumVCPUs)
+		queues = int(hConfig.NetQueues())
 	}
 
-	disableVhostNet := h.HypervisorConfig().DisableVhostNet
+	disableVhostNet := hConfig.DisableVhostNet
 
 	if netPair.NetInterworkingModel == NetXConnectDefaultModel {
 		netPair.NetInterworkingModel = DefaultNetInterworkingModel
@@ -532,6 +759,9 @@ func xConnectVMNetwork(ctx context.Context, endpoint Endpoint, h Hypervisor) err
 	case NetXConnectTCFilterModel:
 		networkLogger().Info("connect TCFilter to VM network")
 		err = setupTCFiltering(ctx, endpoint, queues, disableVhostNet)
+	case NetXConnectEBPFModel:
+		networkLogger().Info("connect eBPF redirect to VM network")
+		err = setupEBPFRedirect(ctx, endpoint, queues, disableVhostNet)
 	default:
 		err = fmt.Errorf("Invalid internetworking model")
 	}
@@ -554,7 +784,7 @@ func xDisconnectVMNetwork(ctx context.Context, endpoint Endpoint) error {
 	switch netPair.NetInterworkingModel {
 	case NetXConnectMacVtapModel:
 		err = untapNetworkPair(ctx, endpoint)
-	case NetXConnectTCFilterModel:
+	case NetXConnectTCFilterModel, NetXConnectEBPFModel:
 		err = removeTCFiltering(ctx, endpoint)
 	default:
 		err = fmt.Errorf("Invalid internetworking model")
@@ -669,6 +899,7 @@ func tapNetworkPair(ctx context.Context, endpoint Endpoint, queues int, disableV
 					TxQLen:      attrs.TxQLen,
 					ParentIndex: attrs.Index,
 				},
+				Mode: netPair.MacvtapMode.netlinkMode(),
 			},
 		}, queues)
 
@@ -687,19 +918,25 @@ func tapNetworkPair(ctx context.Context, endpoint Endpoint, queues int, disableV
 	if err := netHandle.LinkSetMTU(tapLink, attrs.MTU); err != nil {
 		return fmt.Errorf("Could not set TAP MTU %d: %s", attrs.MTU, err)
 	}
+	syncEndpointMTU(endpoint, attrs.MTU)
 
-	hardAddr, err := net.ParseMAC(netPair.VirtIface.HardAddr)
-	if err != nil {
-		return err
-	}
-	if err := netHandle.LinkSetHardwareAddr(link, hardAddr); err != nil {
-		return fmt.Errorf("Could not set MAC address %s for veth interface %s: %s",
-			netPair.VirtIface.HardAddr, netPair.VirtIface.Name, err)
-	}
+	// In passthru mode the macvtap device inherits the MAC address of
+	// the lower device, which has to be preserved, e.g. for a host NIC
+	// dedicated to the pod.
+	if netPair.MacvtapMode != MacvtapModePassthru {
+		hardAddr, err := net.ParseMAC(netPair.VirtIface.HardAddr)
+		if err != nil {
+			return err
+		}
+		if err := netHandle.LinkSetHardwareAddr(link, hardAddr); err != nil {
+			return fmt.Errorf("Could not set MAC address %s for veth interface %s: %s",
+				netPair.VirtIface.HardAddr, netPair.VirtIface.Name, err)
+		}
 
-	if err := netHandle.LinkSetHardwareAddr(tapLink, tapHardAddr); err != nil {
-		return fmt.Errorf("Could not set MAC address %s for veth interface %s: %s",
-			netPair.VirtIface.HardAddr, netPair.VirtIface.Name, err)
+		if err := netHandle.LinkSetHardwareAddr(tapLink, tapHardAddr); err != nil {
+			return fmt.Errorf("Could not set MAC address %s for veth interface %s: %s",
+				netPair.VirtIface.HardAddr, netPair.VirtIface.Name, err)
+		}
 	}
 
 	if err := netHandle.LinkSetUp(tapLink); err != nil {
@@ -742,6 +979,23 @@ func setupTCFiltering(ctx context.Context, endpoint Endpoint, queues int, disabl
 	span, _ := networkTrace(ctx, "setupTCFiltering", endpoint)
 	defer span.End()
 
+	return setupTapRedirect(endpoint, queues, disableVhostNet, addRedirectTCFilter)
+}
+
+// setupEBPFRedirect connects the network interface provided by the network
+// plugin to a tap interface through eBPF programs calling bpf_redirect(),
+// which avoids the u32 classifier and mirred action of the tcfilter model.
+func setupEBPFRedirect(ctx context.Context, endpoint Endpoint, queues int, disableVhostNet bool) error {
+	span, _ := networkTrace(ctx, "setupEBPFRedirect", endpoint)
+	defer span.End()
+
+	return setupTapRedirect(endpoint, queues, disableVhostNet, addRedirectBPFFilter)
+}
+
+// setupTapRedirect creates the tap interface of the endpoint and uses
+// addRedirect to redirect traffic in both directions between the tap
+// interface and the network interface provided by the network plugin.
+func setupTapRedirect(endpoint Endpoint, queues int, disableVhostNet bool, addRedirect func(sourceIndex, destIndex int) error) error {
 	netHandle, err := netlink.NewHandle()
 	if err != nil {
 		return err
@@ -784,6 +1038,7 @@ func setupTCFiltering(ctx context.Context, endpoint Endpoint, queues int, disabl
 	if err := netHandle.LinkSetMTU(tapLink, attrs.MTU); err != nil {
 		return fmt.Errorf("Could not set TAP MTU %d: %s", attrs.MTU, err)
 	}
+	syncEndpointMTU(endpoint, attrs.MTU)
 
 	if err := netHandle.LinkSetUp(tapLink); err != nil {
 		return fmt.Errorf("Could not enable TAP %s: %s", netPair.TAPIface.Name, err)
@@ -799,11 +1054,11 @@ func setupTCFiltering(ctx context.Context, endpoint Endpoint, queues int, disabl
 		return err
 	}
 
-	if err := addRedirectTCFilter(attrs.Index, tapAttrs.Index); err != nil {
+	if err := addRedirect(attrs.Index, tapAttrs.Index); err != nil {
 		return err
 	}
 
-	if err := addRedirectTCFilter(tapAttrs.Index, attrs.Index); err != nil {
+	if err := addRedirect(tapAttrs.Index, attrs.Index); err != nil {
 		return err
 	}
 
@@ -863,7 +1118,7 @@ func addRedirectTCFilter(sourceIndex, destIndex int) error {
 	return nil
 }
 
-// removeRedirectTCFilter removes all tc u32 filters created on ingress qdisc for "link".
+// removeRedirectTCFilter removes all tc u32 and bpf filters created on ingress qdisc for "link".
 func removeRedirectTCFilter(link netlink.Link) error {
 	if link == nil {
 		return nil
@@ -876,13 +1131,13 @@ func removeRedirectTCFilter(link netlink.Link) error {
 	}
 
 	for _, f := range filters {
-		u32, ok := f.(*netlink.U32)
-
-		if !ok {
+		switch f.(type) {
+		case *netlink.U32, *netlink.BpfFilter:
+		default:
 			continue
 		}
 
-		if err := netlink.FilterDel(u32); err != nil {
+		if err := netlink.FilterDel(f); err != nil {
 			return err
 		}
 	}
@@ -939,13 +1194,15 @@ func untapNetworkPair(ctx context.Context, endpoint Endpoint) error {
 		return err
 	}
 
-	hardAddr, err := net.ParseMAC(netPair.TAPIface.HardAddr)
-	if err != nil {
-		return err
-	}
-	if err := netHandle.LinkSetHardwareAddr(link, hardAddr); err != nil {
-		return fmt.Errorf("Could not set MAC address %s for veth interface %s: %s",
-			netPair.VirtIface.HardAddr, netPair.VirtIface.Name, err)
+	if netPair.MacvtapMode != MacvtapModePassthru {
+		hardAddr, err := net.ParseMAC(netPair.TAPIface.HardAddr)
+		if err != nil {
+			return err
+		}
+		if err := netHandle.LinkSetHardwareAddr(link, hardAddr); err != nil {
+			return fmt.Errorf("Could not set MAC address %s for veth interface %s: %s",
+				netPair.VirtIface.HardAddr, netPair.VirtIface.Name, err)
+		}
 	}
 
 	if err := netHandle.LinkSetDown(link); err != nil {
@@ -1002,6 +1259,41 @@ func removeTCFiltering(ctx context.Context, endpoint Endpoint) error {
 	return nil
 }
 
+// watchDeletedLinks calls cb with the name of each link deleted from the
+// network namespace, e.g. by a CNI DEL, until done is closed.
+func watchDeletedLinks(netNSPath string, done <-chan struct{}, cb func(name string)) error {
+	netnsHandle, err := netns.GetFromPath(netNSPath)
+	if err != nil {
+		return err
+	}
+	defer netnsHandle.Close()
+
+	updates := make(chan netlink.LinkUpdate)
+	if err := netlink.LinkSubscribeWithOptions(updates, done, netlink.LinkSubscribeOptions{
+		Namespace: &netnsHandle,
+		ErrorCallback: func(err error) {
+			select {
+			case <-done:
+				// Closing the subscription makes the pending receive fail.
+			default:
+				networkLogger().WithError(err).WithField("netns", netNSPath).Warn("Error watching network namespace links")
+			}
+		},
+	}); err != nil {
+		return fmt.Errorf("Could not watch links of network namespace %s: %v", netNSPath, err)
+	}
+
+	go func() {
+		for update := range updates {
+			if update.Header.Type == unix.RTM_DELLINK {
+				cb(update.Attrs().Name)
+			}
+		}
+	}()
+
+	return nil
+}
+
 // doNetNS is free from any call to a go routine, and it calls
 // into runtime.LockOSThread(), meaning it won't be executed in a
 // different thread than the one expected by the caller.
@@ -1405,7 +1697,26 @@ func validGuestRoute(route netlink.Route) bool {
 	return route.Protocol != unix.RTPROT_KERNEL
 }
 
+// linkNeighbors returns the neighbor entries of the interface "name" in the
+// current network namespace, if any. Some CNI plugins set static ARP/NDP
+// entries on the interfaces they create, which have to be propagated to the
+// guest.
+func linkNeighbors(name string) []netlink.Neigh {
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		return nil
+	}
+
+	neighbors, err := netlink.NeighList(link.Attrs().Index, netlink.FAMILY_ALL)
+	if err != nil {
+		networkLogger().WithError(err).WithField("interface", name).Warn("Could not list neighbors")
+		return nil
+	}
+
+	return neighbors
+}
+
 func validGuestNeighbor(neigh netlink.Neigh) bool {
-	// We add only static ARP entries
-	return neigh.State == netlink.NUD_PERMANENT
+	// We add only static ARP entries, which need a link layer address
+	return neigh.State == netlink.NUD_PERMANENT && neigh.HardwareAddr != nil
 }
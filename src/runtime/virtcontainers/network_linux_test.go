@@ -11,8 +11,10 @@ import (
 	"net"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containernetworking/plugins/pkg/testutils"
 	ktu "github.com/kata-containers/kata-containers/src/runtime/pkg/katatestutils"
 	pbTypes "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/utils"
@@ -121,6 +123,145 @@ func TestGenerateInterfacesAndRoutes(t *testing.T) {
 		"ARP Neighbors returned didn't match: got %+v, expecting %+v", resNeighs, expectedNeighs)
 }
 
+func TestSyncEndpointMTU(t *testing.T) {
+	assert := assert.New(t)
+
+	endpoint := &VethEndpoint{
+		EndpointProperties: NetworkInfo{
+			Iface: NetlinkIface{
+				LinkAttrs: netlink.LinkAttrs{
+					Name: "eth0",
+					MTU:  1500,
+				},
+			},
+		},
+	}
+
+	syncEndpointMTU(endpoint, 0)
+	assert.Equal(1500, endpoint.Properties().Iface.MTU)
+
+	syncEndpointMTU(endpoint, 1450)
+	assert.Equal(1450, endpoint.Properties().Iface.MTU)
+}
+
+func TestEndpointHostIfaces(t *testing.T) {
+	assert := assert.New(t)
+
+	veth := &VethEndpoint{
+		NetPair: NetworkInterfacePair{
+			TapInterface: TapInterface{
+				Name: "br0_kata",
+				TAPIface: NetworkInterface{
+					Name: "tap0_kata",
+				},
+			},
+			VirtIface: NetworkInterface{
+				Name: "eth0",
+			},
+		},
+	}
+	assert.Equal([]string{"eth0", "tap0_kata"}, endpointHostIfaces(veth))
+
+	vdpa := &VdpaEndpoint{IfaceName: "eth1"}
+	assert.Equal([]string{"eth1"}, endpointHostIfaces(vdpa))
+}
+
+func TestLinkNetworkStats(t *testing.T) {
+	assert := assert.New(t)
+
+	link := &netlink.Veth{
+		LinkAttrs: netlink.LinkAttrs{
+			Name: "eth0",
+			Statistics: &netlink.LinkStatistics{
+				RxBytes:   100,
+				RxPackets: 10,
+				TxBytes:   200,
+				TxPackets: 20,
+				TxDropped: 1,
+			},
+		},
+	}
+
+	stats := linkNetworkStats(link)
+	assert.Equal(&NetworkStats{
+		Name:      "eth0",
+		RxBytes:   100,
+		RxPackets: 10,
+		TxBytes:   200,
+		TxPackets: 20,
+		TxDropped: 1,
+	}, stats)
+
+	link.Statistics = nil
+	assert.Equal(&NetworkStats{Name: "eth0"}, linkNetworkStats(link))
+}
+
+func TestGenerateVCNeighbors(t *testing.T) {
+	assert := assert.New(t)
+
+	arpMAC, err := net.ParseMAC("6a:92:3a:59:70:aa")
+	assert.NoError(err)
+
+	endpoint := &VethEndpoint{
+		NetPair: NetworkInterfacePair{
+			VirtIface: NetworkInterface{
+				Name: "eth0",
+			},
+		},
+		EndpointProperties: NetworkInfo{
+			Neighbors: []netlink.Neigh{
+				{IP: net.IPv4(169, 254, 1, 1), State: netlink.NUD_PERMANENT, HardwareAddr: arpMAC},
+				{IP: net.ParseIP("fe80::1"), State: netlink.NUD_PERMANENT, HardwareAddr: arpMAC},
+				// Dynamic entries are left to the guest
+				{IP: net.IPv4(192, 168, 0, 1), State: netlink.NUD_REACHABLE, HardwareAddr: arpMAC},
+				// Static entries without link layer address are invalid
+				{IP: net.IPv4(192, 168, 0, 2), State: netlink.NUD_PERMANENT},
+			},
+		},
+	}
+
+	neighs := generateVCNeighbors(endpoint)
+	assert.Len(neighs, 2)
+
+	assert.Equal("eth0", neighs[0].Device)
+	assert.Equal("6a:92:3a:59:70:aa", neighs[0].Lladdr)
+	assert.Equal(&pbTypes.IPAddress{Family: pbTypes.IPFamily_v4, Address: "169.254.1.1"}, neighs[0].ToIPAddress)
+	assert.Equal(&pbTypes.IPAddress{Family: pbTypes.IPFamily_v6, Address: "fe80::1"}, neighs[1].ToIPAddress)
+}
+
+func TestLinkNeighbors(t *testing.T) {
+	if tc.NotValid(ktu.NeedRoot()) {
+		t.Skip(testDisabledAsNonRoot)
+	}
+
+	assert := assert.New(t)
+
+	assert.Nil(linkNeighbors("nonexistent0"))
+
+	veth := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: "testneigh0"}, PeerName: "testneigh1"}
+	assert.NoError(netlink.LinkAdd(veth))
+	defer netlink.LinkDel(veth)
+
+	link, err := netlink.LinkByName("testneigh0")
+	assert.NoError(err)
+
+	arpMAC, err := net.ParseMAC("6a:92:3a:59:70:aa")
+	assert.NoError(err)
+
+	err = netlink.NeighAdd(&netlink.Neigh{
+		LinkIndex:    link.Attrs().Index,
+		Family:       netlink.FAMILY_V4,
+		State:        netlink.NUD_PERMANENT,
+		IP:           net.IPv4(169, 254, 1, 1),
+		HardwareAddr: arpMAC,
+	})
+	assert.NoError(err)
+
+	neighs := linkNeighbors("testneigh0")
+	assert.Len(neighs, 1)
+	assert.True(validGuestNeighbor(neighs[0]))
+}
+
 func TestCreateGetTunTapLink(t *testing.T) {
 	if tc.NotValid(ktu.NeedRoot()) {
 		t.Skip(testDisabledAsNonRoot)
@@ -147,6 +288,16 @@ func TestCreateGetTunTapLink(t *testing.T) {
 	assert.NoError(err)
 }
 
+func TestMacvtapModeNetlinkMode(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(netlink.MACVLAN_MODE_BRIDGE, MacvtapMode("").netlinkMode())
+	assert.Equal(netlink.MACVLAN_MODE_BRIDGE, MacvtapModeBridge.netlinkMode())
+	assert.Equal(netlink.MACVLAN_MODE_PRIVATE, MacvtapModePrivate.netlinkMode())
+	assert.Equal(netlink.MACVLAN_MODE_VEPA, MacvtapModeVEPA.netlinkMode())
+	assert.Equal(netlink.MACVLAN_MODE_PASSTHRU, MacvtapModePassthru.netlinkMode())
+}
+
 func TestCreateMacVtap(t *testing.T) {
 	if tc.NotValid(ktu.NeedRoot()) {
 		t.Skip(testDisabledAsNonRoot)
@@ -230,6 +381,65 @@ func TestTcRedirectNetwork(t *testing.T) {
 	assert.NoError(err)
 }
 
+func TestEBPFRedirectNetwork(t *testing.T) {
+	if tc.NotValid(ktu.NeedRoot()) {
+		t.Skip(testDisabledAsNonRoot)
+	}
+
+	assert := assert.New(t)
+
+	netHandle, err := netlink.NewHandle()
+	assert.NoError(err)
+	defer netHandle.Close()
+
+	// Create a test veth interface.
+	vethName := "foo"
+	veth := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: vethName, TxQLen: 200, MTU: 1400}, PeerName: "bar"}
+
+	err = netlink.LinkAdd(veth)
+	assert.NoError(err)
+
+	endpoint, err := createVethNetworkEndpoint(1, vethName, NetXConnectEBPFModel)
+	assert.NoError(err)
+
+	link, err := netlink.LinkByName(vethName)
+	assert.NoError(err)
+
+	err = netHandle.LinkSetUp(link)
+	assert.NoError(err)
+
+	err = setupEBPFRedirect(context.Background(), endpoint, 1, true)
+	assert.NoError(err)
+
+	filters, err := netlink.FilterList(link, netlink.MakeHandle(0xffff, 0))
+	assert.NoError(err)
+	assert.Len(filters, 1)
+	assert.IsType(&netlink.BpfFilter{}, filters[0])
+
+	err = removeTCFiltering(context.Background(), endpoint)
+	assert.NoError(err)
+
+	// Remove the veth created for testing.
+	err = netHandle.LinkDel(link)
+	assert.NoError(err)
+}
+
+func TestBPFRedirectInsns(t *testing.T) {
+	assert := assert.New(t)
+
+	insns := bpfRedirectInsns(42)
+	assert.Len(insns, 4)
+
+	assert.Equal(int32(42), insns[0].imm)
+	assert.Equal(int32(bpfFuncRedirect), insns[2].imm)
+	assert.Equal(uint8(0x95), insns[3].code)
+
+	if !hostBigEndian {
+		assert.Equal(uint8(0x01), insns[0].regs)
+		assert.Equal(uint8(0x02), insns[1].regs)
+	}
+}
+
 func TestRxRateLimiter(t *testing.T) {
 	if tc.NotValid(ktu.NeedRoot()) {
 		t.Skip(testDisabledAsNonRoot)
@@ -327,3 +537,84 @@ func TestTxRateLimiter(t *testing.T) {
 	err = netHandle.LinkDel(link)
 	assert.NoError(err)
 }
+
+func TestRemoveEndpoints(t *testing.T) {
+	assert := assert.New(t)
+
+	ep1 := &VhostUserEndpoint{HardAddr: "02:00:ca:fe:00:01", EndpointType: VhostUserEndpointType}
+	ep2 := &VhostUserEndpoint{HardAddr: "02:00:ca:fe:00:02", EndpointType: VhostUserEndpointType}
+	ep3 := &VhostUserEndpoint{HardAddr: "02:00:ca:fe:00:03", EndpointType: VhostUserEndpointType}
+
+	n := &LinuxNetwork{
+		netNSPath: "/var/run/netns/test",
+		eps:       []Endpoint{ep1, ep2, ep3},
+	}
+
+	// Only the given endpoint is removed, whatever its position.
+	err := n.RemoveEndpoints(context.Background(), nil, []Endpoint{ep2}, false)
+	assert.NoError(err)
+	assert.Equal([]Endpoint{ep1, ep3}, n.Endpoints())
+
+	// Unknown endpoints are ignored.
+	err = n.RemoveEndpoints(context.Background(), nil, []Endpoint{ep2}, false)
+	assert.NoError(err)
+	assert.Equal([]Endpoint{ep1, ep3}, n.Endpoints())
+}
+
+func TestAddSingleEndpointHostNetwork(t *testing.T) {
+	assert := assert.New(t)
+
+	n := &LinuxNetwork{
+		hostNetwork:       true,
+		interworkingModel: NetXConnectMacVtapModel,
+	}
+
+	// The interfaces of the host are never handed over to the guest.
+	netInfo := NetworkInfo{Iface: NetlinkIface{LinkAttrs: netlink.LinkAttrs{Name: "eth0"}}}
+	_, err := n.addSingleEndpoint(context.Background(), nil, netInfo, false)
+	assert.Error(err)
+	assert.Empty(n.Endpoints())
+}
+
+func TestWatchDeletedLinks(t *testing.T) {
+	if tc.NotValid(ktu.NeedRoot()) {
+		t.Skip(testDisabledAsNonRoot)
+	}
+
+	assert := assert.New(t)
+
+	netNS, err := testutils.NewNS()
+	assert.NoError(err)
+	defer netNS.Close()
+
+	deleted := make(chan string, 2)
+	done := make(chan struct{})
+	err = watchDeletedLinks(netNS.Path(), done, func(name string) {
+		deleted <- name
+	})
+	assert.NoError(err)
+	defer close(done)
+
+	err = netNS.Do(func(_ ns.NetNS) error {
+		veth := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: "testwatch0"}, PeerName: "testwatch1"}
+		if err := netlink.LinkAdd(veth); err != nil {
+			return err
+		}
+		return netlink.LinkDel(veth)
+	})
+	assert.NoError(err)
+
+	var names []string
+	for len(names) < 2 {
+		select {
+		case name := <-deleted:
+			names = append(names, name)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for deleted links")
+		}
+	}
+	assert.ElementsMatch([]string{"testwatch0", "testwatch1"}, names)
+
+	err = watchDeletedLinks("/nonexistent/netns", done, func(string) {})
+	assert.Error(err)
+}
@@ -22,6 +22,7 @@ func TestNetInterworkingModelIsValid(t *testing.T) {
 		{"Default Model", NetXConnectDefaultModel, true},
 		{"TC Filter Model", NetXConnectTCFilterModel, true},
 		{"Macvtap Model", NetXConnectMacVtapModel, true},
+		{"eBPF Model", NetXConnectEBPFModel, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -44,6 +45,8 @@ func TestNetInterworkingModelSetModel(t *testing.T) {
 		{"macvtap Model", macvtapNetModelStr, false},
 		{"tcfilter Model", tcFilterNetModelStr, false},
 		{"none Model", noneNetModelStr, false},
+		{"passt Model", passtNetModelStr, false},
+		{"ebpf Model", ebpfNetModelStr, false},
 	}
 
 	for _, tt := range tests {
@@ -55,6 +58,61 @@ func TestNetInterworkingModelSetModel(t *testing.T) {
 	}
 }
 
+func TestHostNetworkModeSet(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected HostNetworkMode
+		wantErr  bool
+	}{
+		{"Invalid mode", "Invalid", "", true},
+		{"empty mode", "", HostNetworkReject, false},
+		{"reject mode", "reject", HostNetworkReject, false},
+		{"none mode", "none", HostNetworkNone, false},
+		{"user mode", "user", HostNetworkUser, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m HostNetworkMode
+			if err := m.Set(tt.value); (err != nil) != tt.wantErr {
+				t.Errorf("HostNetworkMode.Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if m != tt.expected {
+				t.Errorf("HostNetworkMode.Set() got %v, expected %v", m, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMacvtapModeSet(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected MacvtapMode
+		wantErr  bool
+	}{
+		{"Invalid mode", "Invalid", "", true},
+		{"empty mode", "", MacvtapModeBridge, false},
+		{"bridge mode", "bridge", MacvtapModeBridge, false},
+		{"private mode", "private", MacvtapModePrivate, false},
+		{"vepa mode", "vepa", MacvtapModeVEPA, false},
+		{"passthru mode", "passthru", MacvtapModePassthru, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m MacvtapMode
+			if err := m.Set(tt.value); (err != nil) != tt.wantErr {
+				t.Errorf("MacvtapMode.Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if m != tt.expected {
+				t.Errorf("MacvtapMode.Set() got %v, expected %v", m, tt.expected)
+			}
+		})
+	}
+}
+
 func TestGenerateRandomPrivateMacAdd(t *testing.T) {
 	assert := assert.New(t)
 
@@ -0,0 +1,225 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	b64 "encoding/base64"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	volume "github.com/kata-containers/kata-containers/src/runtime/pkg/direct-volume"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/grpc"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+)
+
+// lookupIP is overridden by the tests
+var lookupIP = net.LookupIP
+
+// networkVolumeServer returns the server of a NFS export, <server>:<path>, or
+// of a SMB share, //<server>/<share>.
+func networkVolumeServer(fsType, device string) (string, error) {
+	var server string
+	if strings.HasPrefix(fsType, "nfs") {
+		i := strings.LastIndex(device, ":/")
+		if i <= 0 {
+			return "", fmt.Errorf("invalid NFS export %q, expected <server>:<path>", device)
+		}
+		server = device[:i]
+	} else {
+		share := strings.TrimPrefix(device, "//")
+		if share == device {
+			return "", fmt.Errorf("invalid SMB share %q, expected //<server>/<share>", device)
+		}
+		server = strings.SplitN(share, "/", 2)[0]
+	}
+
+	server = strings.TrimSuffix(strings.TrimPrefix(server, "["), "]")
+	if server == "" {
+		return "", fmt.Errorf("no server in network volume %q", device)
+	}
+
+	return server, nil
+}
+
+// networkVolumeOptions returns the mount options of a network volume. The
+// kernel only accepts the address of the server, which mount.nfs and
+// mount.cifs otherwise resolve, so it is resolved on the host unless given.
+func networkVolumeOptions(fsType, device string, options []string) ([]string, error) {
+	addrOption := "addr="
+	if !strings.HasPrefix(fsType, "nfs") {
+		addrOption = "ip="
+	}
+
+	for _, o := range options {
+		if strings.HasPrefix(o, addrOption) {
+			return options, nil
+		}
+	}
+
+	server, err := networkVolumeServer(fsType, device)
+	if err != nil {
+		return nil, err
+	}
+
+	addr := net.ParseIP(server)
+	if addr == nil {
+		addrs, err := lookupIP(server)
+		if err != nil {
+			return nil, fmt.Errorf("could not resolve the server of network volume %q: %v", device, err)
+		}
+		if len(addrs) == 0 {
+			return nil, fmt.Errorf("no address found for the server of network volume %q", device)
+		}
+		addr = addrs[0]
+	}
+
+	return append(append([]string{}, options...), addrOption+addr.String()), nil
+}
+
+// createNetworkVolumes sets up the NFS and SMB direct volumes of the
+// container, for the agent to mount them over the pod network rather than
+// sharing a host mount, on which locks would not be seen by other clients.
+func (c *Container) createNetworkVolumes() error {
+	for i := range c.mounts {
+		if c.mounts[i].Type != "bind" {
+			continue
+		}
+
+		mntInfo, err := volume.VolumeMountInfo(c.mounts[i].Source)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				c.Logger().WithError(err).WithField("mount-source", c.mounts[i].Source).
+					Error("failed to parse the mount info file for a direct assigned volume")
+			}
+			continue
+		}
+		if mntInfo.VolumeType != volume.NetworkVolumeType {
+			continue
+		}
+
+		// Write out sandbox info file on the mount source to allow CSI to communicate with the runtime
+		if err := volume.RecordSandboxId(c.sandboxID, c.mounts[i].Source); err != nil {
+			c.Logger().WithError(err).Error("error writing sandbox info")
+		}
+
+		options, err := networkVolumeOptions(mntInfo.FsType, mntInfo.Device, mntInfo.Options)
+		if err != nil {
+			return err
+		}
+
+		c.mounts[i].Source = mntInfo.Device
+		c.mounts[i].Type = mntInfo.FsType
+		c.mounts[i].Options = options
+		c.mounts[i].ReadOnly = containsString(options, "ro")
+		c.mounts[i].NetworkVolume = true
+		c.mounts[i].CredentialsFile = mntInfo.Metadata[volume.CredentialsFileMetadataKey]
+	}
+
+	return nil
+}
+
+// handleNetworkVolumes creates the storages of the network volumes of the
+// container, mounted once in the sandbox storage directory, and replaces the
+// sources of their OCI mounts with them.
+func (k *kataAgent) handleNetworkVolumes(c *Container, spec *specs.Spec) ([]*grpc.Storage, error) {
+	var storages []*grpc.Storage
+
+	for i, m := range c.mounts {
+		if !m.NetworkVolume {
+			continue
+		}
+
+		options := m.Options
+		if m.CredentialsFile != "" {
+			credentials, err := readNetworkVolumeCredentials(m.CredentialsFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the credentials of mount %s: %v", m.Destination, err)
+			}
+			options = append(append([]string{}, options...), credentials...)
+		}
+
+		path := filepath.Join(kataGuestSandboxStorageDir(), b64.URLEncoding.EncodeToString([]byte(m.Type+":"+m.Source)))
+
+		for idx := range spec.Mounts {
+			if spec.Mounts[idx].Destination != m.Destination {
+				continue
+			}
+			k.Logger().WithFields(logrus.Fields{
+				"original-source": spec.Mounts[idx].Source,
+				"new-source":      path,
+			}).Debug("Replacing OCI mount source")
+			spec.Mounts[idx].Source = path
+			break
+		}
+
+		c.mounts[i].GuestDeviceMount = path
+
+		storages = append(storages, &grpc.Storage{
+			Driver:     kataNetfsDevType,
+			Source:     m.Source,
+			Fstype:     m.Type,
+			Options:    options,
+			MountPoint: path,
+		})
+	}
+
+	return storages, nil
+}
+
+const redactedValue = "<redacted>"
+
+// networkVolumeCredentialOptions are the mount options of the credentials
+// of a SMB volume, as accepted by mount.cifs.
+var networkVolumeCredentialOptions = []string{"user", "username", "pass", "password", "password2", "dom", "domain"}
+
+// redactCredentialOptions returns the mount options with the values of the
+// credentials ones replaced, for the options to be logged and traced.
+func redactCredentialOptions(options []string) []string {
+	var redacted []string
+	for i, option := range options {
+		fields := strings.SplitN(option, "=", 2)
+		if len(fields) != 2 || !containsString(networkVolumeCredentialOptions, fields[0]) {
+			continue
+		}
+		if redacted == nil {
+			redacted = append([]string{}, options...)
+		}
+		redacted[i] = fields[0] + "=" + redactedValue
+	}
+
+	if redacted == nil {
+		return options
+	}
+	return redacted
+}
+
+// readNetworkVolumeCredentials returns the mount options of the credentials
+// file of a SMB volume, which holds one <option>=<value> line per option,
+// e.g. username=, password= and domain=, as for mount.cifs.
+func readNetworkVolumeCredentials(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var options []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !strings.Contains(line, "=") || strings.Contains(line, ",") {
+			return nil, fmt.Errorf("invalid credentials line %q", line)
+		}
+		options = append(options, line)
+	}
+
+	return options, nil
+}
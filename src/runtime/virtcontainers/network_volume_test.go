@@ -0,0 +1,162 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/grpc"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkVolumeServer(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, d := range []struct {
+		fsType string
+		device string
+		server string
+	}{
+		{"nfs", "nfs.example.com:/exports/data", "nfs.example.com"},
+		{"nfs4", "10.0.0.5:/", "10.0.0.5"},
+		{"nfs4", "[fd00::5]:/exports", "fd00::5"},
+		{"cifs", "//smb.example.com/share", "smb.example.com"},
+		{"smb3", "//10.0.0.6/share/dir", "10.0.0.6"},
+	} {
+		server, err := networkVolumeServer(d.fsType, d.device)
+		assert.NoError(err)
+		assert.Equal(d.server, server)
+	}
+
+	for _, d := range []struct {
+		fsType string
+		device string
+	}{
+		{"nfs", "/exports/data"},
+		{"nfs", ":/exports/data"},
+		{"cifs", "smb.example.com/share"},
+		{"cifs", "///share"},
+	} {
+		_, err := networkVolumeServer(d.fsType, d.device)
+		assert.Error(err, "%s %s", d.fsType, d.device)
+	}
+}
+
+func TestNetworkVolumeOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	savedLookupIP := lookupIP
+	defer func() {
+		lookupIP = savedLookupIP
+	}()
+	lookupIP = func(host string) ([]net.IP, error) {
+		if host == "nfs.example.com" {
+			return []net.IP{net.ParseIP("10.0.0.5")}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+
+	options, err := networkVolumeOptions("nfs4", "nfs.example.com:/exports", []string{"vers=4.1"})
+	assert.NoError(err)
+	assert.Equal([]string{"vers=4.1", "addr=10.0.0.5"}, options)
+
+	options, err = networkVolumeOptions("cifs", "//10.0.0.6/share", []string{"ro", "username=kata"})
+	assert.NoError(err)
+	assert.Equal([]string{"ro", "username=kata", "ip=10.0.0.6"}, options)
+
+	// The address given is kept
+	options, err = networkVolumeOptions("nfs", "nfs.example.com:/exports", []string{"addr=10.0.0.7"})
+	assert.NoError(err)
+	assert.Equal([]string{"addr=10.0.0.7"}, options)
+
+	_, err = networkVolumeOptions("nfs", "unknown.example.com:/exports", nil)
+	assert.Error(err)
+}
+
+func TestHandleNetworkVolumes(t *testing.T) {
+	assert := assert.New(t)
+
+	k := &kataAgent{}
+	c := &Container{
+		mounts: []Mount{
+			{
+				Source:        "nfs.example.com:/exports",
+				Destination:   "/data",
+				Type:          "nfs4",
+				Options:       []string{"addr=10.0.0.5"},
+				NetworkVolume: true,
+			},
+			{
+				Source:      "/host/config",
+				Destination: "/config",
+				Type:        "bind",
+			},
+		},
+	}
+	spec := &specs.Spec{
+		Mounts: []specs.Mount{
+			{Source: "/var/lib/kubelet/pods/uid/volumes/kubernetes.io~csi/data/mount", Destination: "/data", Type: "bind"},
+			{Source: "/host/config", Destination: "/config", Type: "bind"},
+		},
+	}
+
+	storages, err := k.handleNetworkVolumes(c, spec)
+	assert.NoError(err)
+	assert.Len(storages, 1)
+	assert.Equal(kataNetfsDevType, storages[0].Driver)
+	assert.Equal("nfs.example.com:/exports", storages[0].Source)
+	assert.Equal("nfs4", storages[0].Fstype)
+	assert.Equal([]string{"addr=10.0.0.5"}, storages[0].Options)
+	assert.Equal(storages[0].MountPoint, spec.Mounts[0].Source)
+	assert.Equal(storages[0].MountPoint, c.mounts[0].GuestDeviceMount)
+	assert.Equal("/host/config", spec.Mounts[1].Source)
+}
+
+func TestReadNetworkVolumeCredentials(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "credentials")
+	assert.NoError(os.WriteFile(path, []byte("# share\nusername=kata\npassword=s3cr=t\n\ndomain=EXAMPLE\n"), 0600))
+
+	options, err := readNetworkVolumeCredentials(path)
+	assert.NoError(err)
+	assert.Equal([]string{"username=kata", "password=s3cr=t", "domain=EXAMPLE"}, options)
+
+	// The options of the credentials would be split
+	assert.NoError(os.WriteFile(path, []byte("password=a,b\n"), 0600))
+	_, err = readNetworkVolumeCredentials(path)
+	assert.Error(err)
+
+	_, err = readNetworkVolumeCredentials(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(err)
+}
+
+func TestRedactCredentialOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	options := []string{"vers=3.0", "username=kata", "password=s3cr=t", "domain=EXAMPLE"}
+	redacted := redactCredentialOptions(options)
+	assert.Equal([]string{"vers=3.0", "username=<redacted>", "password=<redacted>", "domain=<redacted>"}, redacted)
+	// The options of the storage are left untouched
+	assert.Equal("password=s3cr=t", options[2])
+
+	// The request sent to the agent is logged without the credentials
+	req := &pb.CreateSandboxRequest{
+		Storages: []*pb.Storage{
+			{Driver: kataNetfsDevType, Fstype: "cifs", MountPoint: "/data", Options: options},
+		},
+	}
+	logged := loggableRequest(req)
+	assert.NotContains(logged, "s3cr=t")
+	assert.NotContains(logged, "EXAMPLE")
+	assert.Contains(logged, "vers=3.0")
+	assert.Equal(options, req.Storages[0].Options)
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/cpuset"
+)
+
+// The memory of the guest NUMA nodes is a multiple of numaMemoryAlignMB,
+// the remainder going to the first node.
+const numaMemoryAlignMB = 2
+
+var sysNodePath = "/sys/devices/system/node"
+
+// GuestNUMANode is a NUMA node of the guest, matching a NUMA node of the
+// host the sandbox is bound to.
+type GuestNUMANode struct {
+	// HostNode is the host NUMA node the memory of the node is bound to.
+	HostNode uint32
+
+	// VCPUs are the IDs of the vCPUs of the node, hotplugged or not.
+	VCPUs []uint32
+
+	// MemorySize is the memory of the node, in MiB.
+	MemorySize uint32
+
+	// Distances are the distances from the node to each node, indexed by
+	// their IDs.
+	Distances []uint32
+}
+
+// hostNUMANode is a NUMA node of the host.
+type hostNUMANode struct {
+	cpus cpuset.CPUSet
+
+	// distances to each host node, indexed by their IDs.
+	distances []uint32
+}
+
+func readHostNUMANode(id int) (hostNUMANode, error) {
+	dir := filepath.Join(sysNodePath, fmt.Sprintf("node%d", id))
+
+	cpuList, err := os.ReadFile(filepath.Join(dir, "cpulist"))
+	if err != nil {
+		return hostNUMANode{}, err
+	}
+
+	cpus, err := cpuset.Parse(strings.TrimSpace(string(cpuList)))
+	if err != nil {
+		return hostNUMANode{}, fmt.Errorf("invalid CPUs of NUMA node %d: %v", id, err)
+	}
+
+	distanceList, err := os.ReadFile(filepath.Join(dir, "distance"))
+	if err != nil {
+		return hostNUMANode{}, err
+	}
+
+	var distances []uint32
+	for _, field := range strings.Fields(string(distanceList)) {
+		distance, err := strconv.ParseUint(field, 10, 32)
+		if err != nil {
+			return hostNUMANode{}, fmt.Errorf("invalid distances of NUMA node %d: %v", id, err)
+		}
+		distances = append(distances, uint32(distance))
+	}
+
+	return hostNUMANode{
+		cpus:      cpus,
+		distances: distances,
+	}, nil
+}
+
+// guestNUMATopology returns the guest NUMA nodes matching the placement of a
+// sandbox on the host: one per host NUMA node of mems holding some of the
+// host CPUs cpus of the sandbox, with a share of the maxVCPUs vCPUs and of
+// the memoryMB memory proportional to those CPUs, and the distances of the
+// host nodes. It returns nil when the sandbox is bound to a single host node.
+func guestNUMATopology(cpus, mems cpuset.CPUSet, maxVCPUs, memoryMB uint32) ([]GuestNUMANode, error) {
+	var (
+		ids     []int
+		weights []uint32
+		hosts   []hostNUMANode
+	)
+
+	for _, id := range mems.ToSlice() {
+		host, err := readHostNUMANode(id)
+		if err != nil {
+			return nil, err
+		}
+
+		// The sandbox does not run on the node, it only gets memory from it.
+		weight := uint32(host.cpus.Intersection(cpus).Size())
+		if weight == 0 {
+			continue
+		}
+
+		ids = append(ids, id)
+		weights = append(weights, weight)
+		hosts = append(hosts, host)
+	}
+
+	if len(ids) < 2 || maxVCPUs < uint32(len(ids)) {
+		return nil, nil
+	}
+
+	var totalWeight uint32
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+
+	nodes := make([]GuestNUMANode, len(ids))
+	allocatedMB := uint32(0)
+	for i, id := range ids {
+		nodes[i].HostNode = uint32(id)
+
+		nodes[i].MemorySize = uint32(uint64(memoryMB) * uint64(weights[i]) / uint64(totalWeight))
+		nodes[i].MemorySize -= nodes[i].MemorySize % numaMemoryAlignMB
+		allocatedMB += nodes[i].MemorySize
+
+		for _, other := range ids {
+			if other >= len(hosts[i].distances) {
+				return nil, fmt.Errorf("missing distance from NUMA node %d to node %d", id, other)
+			}
+			nodes[i].Distances = append(nodes[i].Distances, hosts[i].distances[other])
+		}
+	}
+	nodes[0].MemorySize += memoryMB - allocatedMB
+
+	// The vCPUs are interleaved, for the vCPUs hotplugged in any order to
+	// keep being spread according to the weights of the nodes.
+	for vcpu := uint32(0); vcpu < maxVCPUs; vcpu++ {
+		next := 0
+		for i := range nodes {
+			// len(VCPUs[i]) / weights[i] < len(VCPUs[next]) / weights[next]
+			if uint32(len(nodes[i].VCPUs))*weights[next] < uint32(len(nodes[next].VCPUs))*weights[i] {
+				next = i
+			}
+		}
+		nodes[next].VCPUs = append(nodes[next].VCPUs, vcpu)
+	}
+
+	return nodes, nil
+}
+
+// guestNUMANodes returns the guest NUMA nodes matching the placement of the
+// sandbox on the host NUMA nodes: the cpuset of its containers, which the
+// sandbox resource controller is only given once the VM is started, or the
+// cpuset the controller inherited when they have none.
+func (s *Sandbox) guestNUMANodes() ([]GuestNUMANode, error) {
+	cpuList, memList, err := s.getSandboxCPUSet()
+	if err != nil {
+		return nil, err
+	}
+
+	if cpuList == "" {
+		if cpuList, err = s.sandboxController.CPUSet(); err != nil {
+			return nil, fmt.Errorf("failed to get the cpuset of the sandbox cgroup: %v", err)
+		}
+	}
+
+	cpus, err := cpuset.Parse(cpuList)
+	if err != nil {
+		return nil, err
+	}
+
+	if memList == "" {
+		if memList, err = s.sandboxController.MemSet(); err != nil {
+			return nil, fmt.Errorf("failed to get the memory nodes of the sandbox cgroup: %v", err)
+		}
+	}
+
+	mems, err := cpuset.Parse(memList)
+	if err != nil {
+		return nil, err
+	}
+
+	hypervisorConfig := &s.config.HypervisorConfig
+	return guestNUMATopology(cpus, mems, hypervisorConfig.DefaultMaxVCPUs, hypervisorConfig.MemorySize)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/cpuset"
+)
+
+func writeHostNUMANode(t *testing.T, id, cpuList, distances string) {
+	dir := filepath.Join(sysNodePath, "node"+id)
+	assert.NoError(t, os.MkdirAll(dir, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "cpulist"), []byte(cpuList+"\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "distance"), []byte(distances+"\n"), 0644))
+}
+
+func TestGuestNUMATopology(t *testing.T) {
+	assert := assert.New(t)
+
+	savedSysNodePath := sysNodePath
+	defer func() {
+		sysNodePath = savedSysNodePath
+	}()
+	sysNodePath = t.TempDir()
+
+	writeHostNUMANode(t, "0", "0-3", "10 21")
+	writeHostNUMANode(t, "1", "4-7", "21 10")
+
+	// Bound to a single host node
+	nodes, err := guestNUMATopology(cpuset.NewCPUSet(0, 1), cpuset.NewCPUSet(0, 1), 4, 2048)
+	assert.NoError(err)
+	assert.Nil(nodes)
+
+	nodes, err = guestNUMATopology(cpuset.NewCPUSet(0, 1, 4, 5), cpuset.NewCPUSet(0, 1), 4, 2048)
+	assert.NoError(err)
+	assert.Equal([]GuestNUMANode{
+		{HostNode: 0, VCPUs: []uint32{0, 2}, MemorySize: 1024, Distances: []uint32{10, 21}},
+		{HostNode: 1, VCPUs: []uint32{1, 3}, MemorySize: 1024, Distances: []uint32{21, 10}},
+	}, nodes)
+
+	nodes, err = guestNUMATopology(cpuset.NewCPUSet(0, 1, 2, 4), cpuset.NewCPUSet(0, 1), 8, 1001)
+	assert.NoError(err)
+	assert.Len(nodes, 2)
+	assert.Equal(uint32(751), nodes[0].MemorySize)
+	assert.Equal(uint32(250), nodes[1].MemorySize)
+	assert.Equal([]uint32{0, 2, 3, 4, 6, 7}, nodes[0].VCPUs)
+	assert.Equal([]uint32{1, 5}, nodes[1].VCPUs)
+
+	_, err = guestNUMATopology(cpuset.NewCPUSet(0, 4), cpuset.NewCPUSet(0, 2), 4, 2048)
+	assert.Error(err)
+}
+
+func TestSandboxGuestNUMANodes(t *testing.T) {
+	assert := assert.New(t)
+
+	savedSysNodePath := sysNodePath
+	defer func() {
+		sysNodePath = savedSysNodePath
+	}()
+	sysNodePath = t.TempDir()
+
+	writeHostNUMANode(t, "0", "0-3", "10 21")
+	writeHostNUMANode(t, "1", "4-7", "21 10")
+
+	// The topology follows the cpuset of the containers, the sandbox
+	// resource controller is not given it yet
+	s := &Sandbox{
+		config: &SandboxConfig{
+			HypervisorConfig: HypervisorConfig{
+				DefaultMaxVCPUs: 4,
+				MemorySize:      2048,
+			},
+			Containers: []ContainerConfig{
+				{ID: "sandbox", Resources: specs.LinuxResources{CPU: &specs.LinuxCPU{Cpus: "0-1", Mems: "0-1"}}},
+				{ID: "container", Resources: specs.LinuxResources{CPU: &specs.LinuxCPU{Cpus: "4-5", Mems: "0-1"}}},
+			},
+		},
+	}
+
+	nodes, err := s.guestNUMANodes()
+	assert.NoError(err)
+	assert.Equal([]GuestNUMANode{
+		{HostNode: 0, VCPUs: []uint32{0, 2}, MemorySize: 1024, Distances: []uint32{10, 21}},
+		{HostNode: 1, VCPUs: []uint32{1, 3}, MemorySize: 1024, Distances: []uint32{21, 10}},
+	}, nodes)
+
+	// The topology is persisted, not computed again for the VM created
+	assert.Equal(nodes, loadGuestNUMANodes(saveGuestNUMANodes(nodes)))
+}
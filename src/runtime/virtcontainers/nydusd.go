@@ -142,6 +142,18 @@ func (nd *nydusd) Start(ctx context.Context, onQuit onQuitFunc) (int, error) {
 	return nd.pid, nil
 }
 
+// Adopt watches the nydusd process left by the shim which was upgraded.
+func (nd *nydusd) Adopt(ctx context.Context, pid int, onQuit onQuitFunc) error {
+	nd.pid = pid
+
+	return watchDaemon(pid, func() {
+		nd.Logger().Info("nydusd quits")
+		if onQuit != nil {
+			onQuit()
+		}
+	})
+}
+
 func (nd *nydusd) args() ([]string, error) {
 	logLevel := "info"
 	if nd.debug {
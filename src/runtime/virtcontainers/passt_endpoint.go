@@ -0,0 +1,243 @@
+//go:build linux
+// +build linux
+
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	persistapi "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/persist/api"
+	vcTypes "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/utils"
+)
+
+// defaultPasstPath is the passt binary used when none is configured.
+const defaultPasstPath = "/usr/bin/passt"
+
+var passtTrace = getNetworkTrace(PasstEndpointType)
+
+// PasstEndpoint represents a network interface connected to the VM through
+// passt, a user-mode networking daemon. No tap device is created so that no
+// network privilege is needed on the host.
+type PasstEndpoint struct {
+	// Path to the passt binary
+	PasstPath string
+	// Path to the UNIX socket passt listens on
+	SocketPath string
+	// MAC address of the interface
+	HardAddr           string
+	IfaceName          string
+	EndpointProperties NetworkInfo
+	EndpointType       EndpointType
+	PCIPath            vcTypes.PciPath
+	PID                int
+}
+
+// Properties returns the properties of the interface.
+func (endpoint *PasstEndpoint) Properties() NetworkInfo {
+	return endpoint.EndpointProperties
+}
+
+// Name returns name of the interface.
+func (endpoint *PasstEndpoint) Name() string {
+	return endpoint.IfaceName
+}
+
+// HardwareAddr returns the mac address of the network interface.
+func (endpoint *PasstEndpoint) HardwareAddr() string {
+	return endpoint.HardAddr
+}
+
+// Type indentifies the endpoint as a passt endpoint.
+func (endpoint *PasstEndpoint) Type() EndpointType {
+	return endpoint.EndpointType
+}
+
+// SetProperties sets the properties of the endpoint.
+func (endpoint *PasstEndpoint) SetProperties(properties NetworkInfo) {
+	endpoint.EndpointProperties = properties
+}
+
+// PciPath returns the PCI path of the endpoint.
+func (endpoint *PasstEndpoint) PciPath() vcTypes.PciPath {
+	return endpoint.PCIPath
+}
+
+// SetPciPath sets the PCI path of the endpoint.
+func (endpoint *PasstEndpoint) SetPciPath(pciPath vcTypes.PciPath) {
+	endpoint.PCIPath = pciPath
+}
+
+// NetworkPair returns the network pair of the endpoint.
+func (endpoint *PasstEndpoint) NetworkPair() *NetworkInterfacePair {
+	return nil
+}
+
+// Attach for passt endpoint starts the passt daemon in the network
+// namespace and adds the network device to the hypervisor.
+func (endpoint *PasstEndpoint) Attach(ctx context.Context, s *Sandbox) error {
+	span, ctx := passtTrace(ctx, "Attach", endpoint)
+	defer span.End()
+
+	socketPath, err := utils.BuildSocketPath(s.config.HypervisorConfig.VMStorePath, s.id, fmt.Sprintf("passt-%s.sock", endpoint.IfaceName))
+	if err != nil {
+		return err
+	}
+	endpoint.SocketPath = socketPath
+
+	if err := endpoint.start(); err != nil {
+		return err
+	}
+
+	if err := s.hypervisor.AddDevice(ctx, endpoint, NetDev); err != nil {
+		endpoint.stop()
+		return err
+	}
+
+	return nil
+}
+
+// Detach for passt endpoint stops the passt daemon.
+func (endpoint *PasstEndpoint) Detach(ctx context.Context, netNsCreated bool, netNsPath string) error {
+	span, _ := passtTrace(ctx, "Detach", endpoint)
+	defer span.End()
+
+	return endpoint.stop()
+}
+
+// HotAttach for passt endpoint not supported yet
+func (endpoint *PasstEndpoint) HotAttach(ctx context.Context, h Hypervisor) error {
+	return fmt.Errorf("PasstEndpoint does not support Hot attach")
+}
+
+// HotDetach for passt endpoint not supported yet
+func (endpoint *PasstEndpoint) HotDetach(ctx context.Context, h Hypervisor, netNsCreated bool, netNsPath string) error {
+	return fmt.Errorf("PasstEndpoint does not support Hot detach")
+}
+
+// start runs passt in the current network namespace. passt daemonizes once
+// its socket is ready to accept the hypervisor connection, and exits when
+// the hypervisor disconnects.
+func (endpoint *PasstEndpoint) start() error {
+	pidFile := strings.TrimSuffix(endpoint.SocketPath, ".sock") + ".pid"
+
+	if err := os.MkdirAll(filepath.Dir(endpoint.SocketPath), DirMode); err != nil {
+		return err
+	}
+
+	args := []string{
+		"--one-off",
+		"--socket", endpoint.SocketPath,
+		"--pid", pidFile,
+		"--interface", endpoint.IfaceName,
+	}
+
+	networkLogger().WithField("passt-args", args).Info("Starting passt")
+
+	if out, err := exec.Command(endpoint.PasstPath, args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to start passt: %v: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	pid, err := readPasstPid(pidFile)
+	if err != nil {
+		return err
+	}
+	endpoint.PID = pid
+
+	return nil
+}
+
+func (endpoint *PasstEndpoint) stop() error {
+	if endpoint.PID <= 0 {
+		return nil
+	}
+
+	if err := syscall.Kill(endpoint.PID, syscall.SIGTERM); err != nil && err != syscall.ESRCH {
+		return fmt.Errorf("failed to stop passt (pid %d): %v", endpoint.PID, err)
+	}
+	endpoint.PID = 0
+
+	return nil
+}
+
+func readPasstPid(pidFile string) (int, error) {
+	content, err := os.ReadFile(pidFile)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read passt pid file: %v", err)
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		return 0, fmt.Errorf("invalid passt pid file %s: %v", pidFile, err)
+	}
+
+	return pid, nil
+}
+
+// Create a passt endpoint
+func createPasstEndpoint(netInfo NetworkInfo, passtPath string) (*PasstEndpoint, error) {
+	if passtPath == "" {
+		passtPath = defaultPasstPath
+	}
+
+	passtEndpoint := &PasstEndpoint{
+		PasstPath:    passtPath,
+		HardAddr:     netInfo.Iface.HardwareAddr.String(),
+		IfaceName:    netInfo.Iface.Name,
+		EndpointType: PasstEndpointType,
+	}
+	return passtEndpoint, nil
+}
+
+func (endpoint *PasstEndpoint) save() persistapi.NetworkEndpoint {
+	return persistapi.NetworkEndpoint{
+		Type: string(endpoint.Type()),
+		Passt: &persistapi.PasstEndpoint{
+			SocketPath: endpoint.SocketPath,
+			PID:        endpoint.PID,
+			IfaceName:  endpoint.IfaceName,
+			PCIPath:    endpoint.PCIPath,
+		},
+	}
+}
+
+func (endpoint *PasstEndpoint) load(s persistapi.NetworkEndpoint) {
+	endpoint.EndpointType = PasstEndpointType
+
+	if s.Passt != nil {
+		endpoint.SocketPath = s.Passt.SocketPath
+		endpoint.PID = s.Passt.PID
+		endpoint.IfaceName = s.Passt.IfaceName
+		endpoint.PCIPath = s.Passt.PCIPath
+	}
+}
+
+// unsupported
+func (endpoint *PasstEndpoint) GetRxRateLimiter() bool {
+	return false
+}
+
+func (endpoint *PasstEndpoint) SetRxRateLimiter() error {
+	return fmt.Errorf("rx rate limiter is unsupported for passt endpoint")
+}
+
+// unsupported
+func (endpoint *PasstEndpoint) GetTxRateLimiter() bool {
+	return false
+}
+
+func (endpoint *PasstEndpoint) SetTxRateLimiter() error {
+	return fmt.Errorf("tx rate limiter is unsupported for passt endpoint")
+}
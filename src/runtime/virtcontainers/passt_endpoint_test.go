@@ -0,0 +1,99 @@
+//go:build linux
+// +build linux
+
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+)
+
+func TestCreatePasstEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	macAddr := net.HardwareAddr{0x02, 0x00, 0xCA, 0xFE, 0x00, 0x04}
+	netInfo := NetworkInfo{
+		Iface: NetlinkIface{
+			LinkAttrs: netlink.LinkAttrs{
+				Name:         "eth0",
+				HardwareAddr: macAddr,
+			},
+		},
+	}
+
+	endpoint, err := createPasstEndpoint(netInfo, "")
+	assert.NoError(err)
+	assert.Equal(PasstEndpointType, endpoint.Type())
+	assert.Equal(defaultPasstPath, endpoint.PasstPath)
+	assert.Equal("eth0", endpoint.Name())
+	assert.Equal(macAddr.String(), endpoint.HardwareAddr())
+	assert.Nil(endpoint.NetworkPair())
+
+	endpoint, err = createPasstEndpoint(netInfo, "/opt/passt")
+	assert.NoError(err)
+	assert.Equal("/opt/passt", endpoint.PasstPath)
+}
+
+func TestPasstEndpointSaveLoad(t *testing.T) {
+	assert := assert.New(t)
+
+	endpoint := &PasstEndpoint{
+		SocketPath:   "/run/vc/vm/foo/passt-eth0.sock",
+		PID:          1234,
+		IfaceName:    "eth0",
+		EndpointType: PasstEndpointType,
+	}
+
+	loaded := &PasstEndpoint{}
+	loaded.load(endpoint.save())
+	assert.Equal(PasstEndpointType, loaded.Type())
+	assert.Equal(endpoint.SocketPath, loaded.SocketPath)
+	assert.Equal(endpoint.PID, loaded.PID)
+	assert.Equal(endpoint.IfaceName, loaded.IfaceName)
+}
+
+func TestReadPasstPid(t *testing.T) {
+	assert := assert.New(t)
+
+	pidFile := filepath.Join(t.TempDir(), "passt.pid")
+
+	_, err := readPasstPid(pidFile)
+	assert.Error(err)
+
+	err = os.WriteFile(pidFile, []byte("garbage\n"), 0600)
+	assert.NoError(err)
+	_, err = readPasstPid(pidFile)
+	assert.Error(err)
+
+	err = os.WriteFile(pidFile, []byte("4242\n"), 0600)
+	assert.NoError(err)
+	pid, err := readPasstPid(pidFile)
+	assert.NoError(err)
+	assert.Equal(4242, pid)
+}
+
+func TestPasstEndpoint_HotAttach(t *testing.T) {
+	assert := assert.New(t)
+	v := &PasstEndpoint{
+		IfaceName: "eth0",
+	}
+
+	h := &mockHypervisor{}
+
+	err := v.HotAttach(context.Background(), h)
+	assert.Error(err)
+
+	// Nothing to stop when passt has not been started
+	assert.NoError(v.Detach(context.Background(), true, ""))
+}
@@ -36,6 +36,9 @@ func (s *Sandbox) dumpState(ss *persistapi.SandboxState, cs map[string]persistap
 	ss.State = string(s.state.State)
 	ss.SandboxCgroupPath = s.state.SandboxCgroupPath
 	ss.OverheadCgroupPath = s.state.OverheadCgroupPath
+	ss.VirtiofsdCgroupPath = s.state.VirtiofsdCgroupPath
+	ss.ShimCgroupPath = s.state.ShimCgroupPath
+	ss.VMMCgroupPath = s.state.VMMCgroupPath
 
 	for id, cont := range s.containers {
 		state := persistapi.ContainerState{}
@@ -44,8 +47,11 @@ func (s *Sandbox) dumpState(ss *persistapi.SandboxState, cs map[string]persistap
 		}
 		state.State = string(cont.state.State)
 		state.Rootfs = persistapi.RootfsState{
-			BlockDeviceID: cont.state.BlockDeviceID,
-			FsType:        cont.state.Fstype,
+			BlockDeviceID:   cont.state.BlockDeviceID,
+			FsType:          cont.state.Fstype,
+			BlockDeviceSize: cont.state.BlockDeviceSize,
+			LayerDeviceIDs:  cont.state.LayerDeviceIDs,
+			ScratchDeviceID: cont.state.ScratchDeviceID,
 		}
 		state.CgroupPath = cont.state.CgroupPath
 		cs[id] = state
@@ -178,8 +184,12 @@ func (s *Sandbox) dumpConfig(ss *persistapi.SandboxState) {
 		HypervisorType: string(sconfig.HypervisorType),
 		NetworkConfig: persistapi.NetworkConfig{
 			NetworkID:         sconfig.NetworkConfig.NetworkID,
+			PasstPath:         sconfig.NetworkConfig.PasstPath,
+			HostNetworkMode:   string(sconfig.NetworkConfig.HostNetworkMode),
+			MacvtapMode:       string(sconfig.NetworkConfig.MacvtapMode),
 			NetworkCreated:    sconfig.NetworkConfig.NetworkCreated,
 			DisableNewNetwork: sconfig.NetworkConfig.DisableNewNetwork,
+			HostNetwork:       sconfig.NetworkConfig.HostNetwork,
 			InterworkingModel: int(sconfig.NetworkConfig.InterworkingModel),
 		},
 
@@ -188,6 +198,25 @@ func (s *Sandbox) dumpConfig(ss *persistapi.SandboxState) {
 		SystemdCgroup:       sconfig.SystemdCgroup,
 		SandboxCgroupOnly:   sconfig.SandboxCgroupOnly,
 		DisableGuestSeccomp: sconfig.DisableGuestSeccomp,
+		RequireGuestSeccomp: sconfig.RequireGuestSeccomp,
+		EnableVCPUsPinning:  sconfig.EnableVCPUsPinning,
+
+		GuestTimeSyncInterval: sconfig.GuestTimeSyncInterval,
+		GuestTimeSource:       sconfig.GuestTimeSource,
+		GuestNTPServers:       sconfig.GuestNTPServers,
+		EnableGuestHooks:      sconfig.EnableGuestHooks,
+		ConsoleCaptureSize:    sconfig.ConsoleCaptureSize,
+		EnableAudit:           sconfig.EnableAudit,
+		LinuxAudit:            sconfig.LinuxAudit,
+
+		SlowOperationThreshold: sconfig.SlowOperationThreshold,
+
+		LogRateLimit:  sconfig.LogRateLimit,
+		LogRotateSize: sconfig.LogRotateSize,
+
+		ShimCPUWeight: sconfig.ShimCPUWeight,
+		ShimMilliCPUs: sconfig.ShimMilliCPUs,
+		ShimMemoryMB:  sconfig.ShimMemoryMB,
 	}
 
 	ss.Config.SandboxBindMounts = append(ss.Config.SandboxBindMounts, sconfig.SandboxBindMounts...)
@@ -202,12 +231,19 @@ func (s *Sandbox) dumpConfig(ss *persistapi.SandboxState) {
 		MemorySize:              sconfig.HypervisorConfig.MemorySize,
 		DefaultBridges:          sconfig.HypervisorConfig.DefaultBridges,
 		Msize9p:                 sconfig.HypervisorConfig.Msize9p,
+		Cache9p:                 sconfig.HypervisorConfig.Cache9p,
+		Version9p:               sconfig.HypervisorConfig.Version9p,
 		MemSlots:                sconfig.HypervisorConfig.MemSlots,
 		MemOffset:               sconfig.HypervisorConfig.MemOffset,
 		VirtioMem:               sconfig.HypervisorConfig.VirtioMem,
+		EnableMemoryBalloon:     sconfig.HypervisorConfig.EnableMemoryBalloon,
+		EnableGuestNUMA:         sconfig.HypervisorConfig.EnableGuestNUMA,
+		GuestNUMANodes:          saveGuestNUMANodes(sconfig.HypervisorConfig.GuestNUMANodes),
 		VirtioFSCacheSize:       sconfig.HypervisorConfig.VirtioFSCacheSize,
+		VirtioFSCacheSizeMax:    sconfig.HypervisorConfig.VirtioFSCacheSizeMax,
 		KernelPath:              sconfig.HypervisorConfig.KernelPath,
 		ImagePath:               sconfig.HypervisorConfig.ImagePath,
+		RootfsType:              sconfig.HypervisorConfig.RootfsType,
 		InitrdPath:              sconfig.HypervisorConfig.InitrdPath,
 		FirmwarePath:            sconfig.HypervisorConfig.FirmwarePath,
 		MachineAccelerators:     sconfig.HypervisorConfig.MachineAccelerators,
@@ -225,15 +261,33 @@ func (s *Sandbox) dumpConfig(ss *persistapi.SandboxState) {
 		EntropySource:           sconfig.HypervisorConfig.EntropySource,
 		EntropySourceList:       sconfig.HypervisorConfig.EntropySourceList,
 		SharedFS:                sconfig.HypervisorConfig.SharedFS,
+		SharedFSReadOnly:        sconfig.HypervisorConfig.SharedFSReadOnly,
 		VirtioFSDaemon:          sconfig.HypervisorConfig.VirtioFSDaemon,
 		VirtioFSDaemonList:      sconfig.HypervisorConfig.VirtioFSDaemonList,
 		VirtioFSCache:           sconfig.HypervisorConfig.VirtioFSCache,
 		VirtioFSExtraArgs:       sconfig.HypervisorConfig.VirtioFSExtraArgs[:],
+		VirtioFSSandbox:         sconfig.HypervisorConfig.VirtioFSSandbox,
+		VirtioFSSeccomp:         sconfig.HypervisorConfig.VirtioFSSeccomp,
+		VirtioFSModcaps:         sconfig.HypervisorConfig.VirtioFSModcaps[:],
+		VirtioFSVolumesDaemon:   sconfig.HypervisorConfig.VirtioFSVolumesDaemon,
+		VirtioFSMilliCPUs:       sconfig.HypervisorConfig.VirtioFSMilliCPUs,
+		VirtioFSMemoryMB:        sconfig.HypervisorConfig.VirtioFSMemoryMB,
+		VirtioFSCPUWeight:       sconfig.HypervisorConfig.VirtioFSCPUWeight,
 		BlockDeviceCacheSet:     sconfig.HypervisorConfig.BlockDeviceCacheSet,
 		BlockDeviceCacheDirect:  sconfig.HypervisorConfig.BlockDeviceCacheDirect,
 		BlockDeviceCacheNoflush: sconfig.HypervisorConfig.BlockDeviceCacheNoflush,
+		BlockDeviceDiscard:      sconfig.HypervisorConfig.BlockDeviceDiscard,
+		BlockRootfsThresholdMB:  sconfig.HypervisorConfig.BlockRootfsThresholdMB,
+		LayerCachePath:          sconfig.HypervisorConfig.LayerCachePath,
+		LayerCacheSizeMB:        sconfig.HypervisorConfig.LayerCacheSizeMB,
+		FileBackedBlockDevice:   sconfig.HypervisorConfig.FileBackedBlockDevice,
+		UblkQueues:              sconfig.HypervisorConfig.UblkQueues,
+		UblkQueueDepth:          sconfig.HypervisorConfig.UblkQueueDepth,
+		PmemEmptyDirSizeMB:      sconfig.HypervisorConfig.PmemEmptyDirSizeMB,
 		DisableBlockDeviceUse:   sconfig.HypervisorConfig.DisableBlockDeviceUse,
 		EnableIOThreads:         sconfig.HypervisorConfig.EnableIOThreads,
+		SCSIControllers:         sconfig.HypervisorConfig.SCSIControllers,
+		SCSIQueueDepth:          sconfig.HypervisorConfig.SCSIQueueDepth,
 		Debug:                   sconfig.HypervisorConfig.Debug,
 		MemPrealloc:             sconfig.HypervisorConfig.MemPrealloc,
 		HugePages:               sconfig.HypervisorConfig.HugePages,
@@ -255,10 +309,22 @@ func (s *Sandbox) dumpConfig(ss *persistapi.SandboxState) {
 		TxRateLimiterMaxRate:    sconfig.HypervisorConfig.TxRateLimiterMaxRate,
 		SGXEPCSize:              sconfig.HypervisorConfig.SGXEPCSize,
 		EnableAnnotations:       sconfig.HypervisorConfig.EnableAnnotations,
+
+		VirtioFSVolumesCache:     sconfig.HypervisorConfig.VirtioFSVolumesCache,
+		VirtioFSWriteback:        sconfig.HypervisorConfig.VirtioFSWriteback,
+		VirtioFSVolumesWriteback: sconfig.HypervisorConfig.VirtioFSVolumesWriteback,
+		MemoryShrinkHysteresisMB: sconfig.HypervisorConfig.MemoryShrinkHysteresisMB,
 	}
 
 	ss.Config.KataAgentConfig = &persistapi.KataAgentConfig{
-		LongLiveConn: sconfig.AgentConfig.LongLiveConn,
+		LongLiveConn:        sconfig.AgentConfig.LongLiveConn,
+		HealthCheckInterval: sconfig.AgentConfig.HealthCheckInterval,
+		HealthCheckFailures: sconfig.AgentConfig.HealthCheckFailures,
+
+		CreateContainerTimeout: sconfig.AgentConfig.CreateContainerTimeout,
+		ExecProcessTimeout:     sconfig.AgentConfig.ExecProcessTimeout,
+		HotplugTimeout:         sconfig.AgentConfig.HotplugTimeout,
+		ShutdownTimeout:        sconfig.AgentConfig.ShutdownTimeout,
 	}
 
 	for _, contConf := range sconfig.Containers {
@@ -272,6 +338,18 @@ func (s *Sandbox) dumpConfig(ss *persistapi.SandboxState) {
 }
 
 func (s *Sandbox) Save() error {
+	ss, cs := s.dumpPersistState()
+
+	if err := s.store.ToDisk(ss, cs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// dumpPersistState returns the state of the sandbox and of its containers
+// to persist.
+func (s *Sandbox) dumpPersistState() (persistapi.SandboxState, map[string]persistapi.ContainerState) {
 	var (
 		ss = persistapi.SandboxState{}
 		cs = make(map[string]persistapi.ContainerState)
@@ -287,11 +365,7 @@ func (s *Sandbox) Save() error {
 	s.dumpNetwork(&ss)
 	s.dumpConfig(&ss)
 
-	if err := s.store.ToDisk(ss, cs); err != nil {
-		return err
-	}
-
-	return nil
+	return ss, cs
 }
 
 func (s *Sandbox) loadState(ss persistapi.SandboxState) {
@@ -301,15 +375,21 @@ func (s *Sandbox) loadState(ss persistapi.SandboxState) {
 	s.state.State = types.StateString(ss.State)
 	s.state.SandboxCgroupPath = ss.SandboxCgroupPath
 	s.state.OverheadCgroupPath = ss.OverheadCgroupPath
+	s.state.VirtiofsdCgroupPath = ss.VirtiofsdCgroupPath
+	s.state.ShimCgroupPath = ss.ShimCgroupPath
+	s.state.VMMCgroupPath = ss.VMMCgroupPath
 	s.state.GuestMemoryHotplugProbe = ss.GuestMemoryHotplugProbe
 }
 
 func (c *Container) loadContState(cs persistapi.ContainerState) {
 	c.state = types.ContainerState{
-		State:         types.StateString(cs.State),
-		BlockDeviceID: cs.Rootfs.BlockDeviceID,
-		Fstype:        cs.Rootfs.FsType,
-		CgroupPath:    cs.CgroupPath,
+		State:           types.StateString(cs.State),
+		BlockDeviceID:   cs.Rootfs.BlockDeviceID,
+		Fstype:          cs.Rootfs.FsType,
+		BlockDeviceSize: cs.Rootfs.BlockDeviceSize,
+		LayerDeviceIDs:  cs.Rootfs.LayerDeviceIDs,
+		ScratchDeviceID: cs.Rootfs.ScratchDeviceID,
+		CgroupPath:      cs.CgroupPath,
 	}
 }
 
@@ -417,8 +497,12 @@ func loadSandboxConfig(id string) (*SandboxConfig, error) {
 		HypervisorType: HypervisorType(savedConf.HypervisorType),
 		NetworkConfig: NetworkConfig{
 			NetworkID:         savedConf.NetworkConfig.NetworkID,
+			PasstPath:         savedConf.NetworkConfig.PasstPath,
+			HostNetworkMode:   HostNetworkMode(savedConf.NetworkConfig.HostNetworkMode),
+			MacvtapMode:       MacvtapMode(savedConf.NetworkConfig.MacvtapMode),
 			NetworkCreated:    savedConf.NetworkConfig.NetworkCreated,
 			DisableNewNetwork: savedConf.NetworkConfig.DisableNewNetwork,
+			HostNetwork:       savedConf.NetworkConfig.HostNetwork,
 			InterworkingModel: NetInterworkingModel(savedConf.NetworkConfig.InterworkingModel),
 		},
 
@@ -427,6 +511,25 @@ func loadSandboxConfig(id string) (*SandboxConfig, error) {
 		SystemdCgroup:       savedConf.SystemdCgroup,
 		SandboxCgroupOnly:   savedConf.SandboxCgroupOnly,
 		DisableGuestSeccomp: savedConf.DisableGuestSeccomp,
+		RequireGuestSeccomp: savedConf.RequireGuestSeccomp,
+		EnableVCPUsPinning:  savedConf.EnableVCPUsPinning,
+
+		GuestTimeSyncInterval: savedConf.GuestTimeSyncInterval,
+		GuestTimeSource:       savedConf.GuestTimeSource,
+		GuestNTPServers:       savedConf.GuestNTPServers,
+		EnableGuestHooks:      savedConf.EnableGuestHooks,
+		ConsoleCaptureSize:    savedConf.ConsoleCaptureSize,
+		EnableAudit:           savedConf.EnableAudit,
+		LinuxAudit:            savedConf.LinuxAudit,
+
+		SlowOperationThreshold: savedConf.SlowOperationThreshold,
+
+		LogRateLimit:  savedConf.LogRateLimit,
+		LogRotateSize: savedConf.LogRotateSize,
+
+		ShimCPUWeight: savedConf.ShimCPUWeight,
+		ShimMilliCPUs: savedConf.ShimMilliCPUs,
+		ShimMemoryMB:  savedConf.ShimMemoryMB,
 	}
 	sconfig.SandboxBindMounts = append(sconfig.SandboxBindMounts, savedConf.SandboxBindMounts...)
 
@@ -441,12 +544,19 @@ func loadSandboxConfig(id string) (*SandboxConfig, error) {
 		MemorySize:              hconf.MemorySize,
 		DefaultBridges:          hconf.DefaultBridges,
 		Msize9p:                 hconf.Msize9p,
+		Cache9p:                 hconf.Cache9p,
+		Version9p:               hconf.Version9p,
 		MemSlots:                hconf.MemSlots,
 		MemOffset:               hconf.MemOffset,
 		VirtioMem:               hconf.VirtioMem,
+		EnableMemoryBalloon:     hconf.EnableMemoryBalloon,
+		EnableGuestNUMA:         hconf.EnableGuestNUMA,
+		GuestNUMANodes:          loadGuestNUMANodes(hconf.GuestNUMANodes),
 		VirtioFSCacheSize:       hconf.VirtioFSCacheSize,
+		VirtioFSCacheSizeMax:    hconf.VirtioFSCacheSizeMax,
 		KernelPath:              hconf.KernelPath,
 		ImagePath:               hconf.ImagePath,
+		RootfsType:              hconf.RootfsType,
 		InitrdPath:              hconf.InitrdPath,
 		FirmwarePath:            hconf.FirmwarePath,
 		MachineAccelerators:     hconf.MachineAccelerators,
@@ -464,15 +574,33 @@ func loadSandboxConfig(id string) (*SandboxConfig, error) {
 		EntropySource:           hconf.EntropySource,
 		EntropySourceList:       hconf.EntropySourceList,
 		SharedFS:                hconf.SharedFS,
+		SharedFSReadOnly:        hconf.SharedFSReadOnly,
 		VirtioFSDaemon:          hconf.VirtioFSDaemon,
 		VirtioFSDaemonList:      hconf.VirtioFSDaemonList,
 		VirtioFSCache:           hconf.VirtioFSCache,
 		VirtioFSExtraArgs:       hconf.VirtioFSExtraArgs[:],
+		VirtioFSSandbox:         hconf.VirtioFSSandbox,
+		VirtioFSSeccomp:         hconf.VirtioFSSeccomp,
+		VirtioFSModcaps:         hconf.VirtioFSModcaps[:],
+		VirtioFSVolumesDaemon:   hconf.VirtioFSVolumesDaemon,
+		VirtioFSMilliCPUs:       hconf.VirtioFSMilliCPUs,
+		VirtioFSMemoryMB:        hconf.VirtioFSMemoryMB,
+		VirtioFSCPUWeight:       hconf.VirtioFSCPUWeight,
 		BlockDeviceCacheSet:     hconf.BlockDeviceCacheSet,
 		BlockDeviceCacheDirect:  hconf.BlockDeviceCacheDirect,
 		BlockDeviceCacheNoflush: hconf.BlockDeviceCacheNoflush,
+		BlockDeviceDiscard:      hconf.BlockDeviceDiscard,
+		BlockRootfsThresholdMB:  hconf.BlockRootfsThresholdMB,
+		LayerCachePath:          hconf.LayerCachePath,
+		LayerCacheSizeMB:        hconf.LayerCacheSizeMB,
+		FileBackedBlockDevice:   hconf.FileBackedBlockDevice,
+		UblkQueues:              hconf.UblkQueues,
+		UblkQueueDepth:          hconf.UblkQueueDepth,
+		PmemEmptyDirSizeMB:      hconf.PmemEmptyDirSizeMB,
 		DisableBlockDeviceUse:   hconf.DisableBlockDeviceUse,
 		EnableIOThreads:         hconf.EnableIOThreads,
+		SCSIControllers:         hconf.SCSIControllers,
+		SCSIQueueDepth:          hconf.SCSIQueueDepth,
 		Debug:                   hconf.Debug,
 		MemPrealloc:             hconf.MemPrealloc,
 		HugePages:               hconf.HugePages,
@@ -494,10 +622,22 @@ func loadSandboxConfig(id string) (*SandboxConfig, error) {
 		TxRateLimiterMaxRate:    hconf.TxRateLimiterMaxRate,
 		SGXEPCSize:              hconf.SGXEPCSize,
 		EnableAnnotations:       hconf.EnableAnnotations,
+
+		VirtioFSVolumesCache:     hconf.VirtioFSVolumesCache,
+		VirtioFSWriteback:        hconf.VirtioFSWriteback,
+		VirtioFSVolumesWriteback: hconf.VirtioFSVolumesWriteback,
+		MemoryShrinkHysteresisMB: hconf.MemoryShrinkHysteresisMB,
 	}
 
 	sconfig.AgentConfig = KataAgentConfig{
-		LongLiveConn: savedConf.KataAgentConfig.LongLiveConn,
+		LongLiveConn:        savedConf.KataAgentConfig.LongLiveConn,
+		HealthCheckInterval: savedConf.KataAgentConfig.HealthCheckInterval,
+		HealthCheckFailures: savedConf.KataAgentConfig.HealthCheckFailures,
+
+		CreateContainerTimeout: savedConf.KataAgentConfig.CreateContainerTimeout,
+		ExecProcessTimeout:     savedConf.KataAgentConfig.ExecProcessTimeout,
+		HotplugTimeout:         savedConf.KataAgentConfig.HotplugTimeout,
+		ShutdownTimeout:        savedConf.KataAgentConfig.ShutdownTimeout,
 	}
 
 	for _, contConf := range savedConf.ContainerConfigs {
@@ -512,3 +652,29 @@ func loadSandboxConfig(id string) (*SandboxConfig, error) {
 	}
 	return sconfig, nil
 }
+
+func saveGuestNUMANodes(nodes []GuestNUMANode) []persistapi.GuestNUMANode {
+	var saved []persistapi.GuestNUMANode
+	for _, node := range nodes {
+		saved = append(saved, persistapi.GuestNUMANode{
+			VCPUs:      node.VCPUs,
+			Distances:  node.Distances,
+			HostNode:   node.HostNode,
+			MemorySize: node.MemorySize,
+		})
+	}
+	return saved
+}
+
+func loadGuestNUMANodes(saved []persistapi.GuestNUMANode) []GuestNUMANode {
+	var nodes []GuestNUMANode
+	for _, node := range saved {
+		nodes = append(nodes, GuestNUMANode{
+			HostNode:   node.HostNode,
+			VCPUs:      node.VCPUs,
+			MemorySize: node.MemorySize,
+			Distances:  node.Distances,
+		})
+	}
+	return nodes
+}
@@ -7,6 +7,8 @@
 package persistapi
 
 import (
+	"time"
+
 	"github.com/opencontainers/runc/libcontainer/configs"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -19,6 +21,9 @@ type HypervisorConfig struct {
 	// ImagePath is the guest image host path.
 	ImagePath string
 
+	// RootfsType is the filesystem type of the guest image.
+	RootfsType string
+
 	// InitrdPath is the guest initrd image host path.
 	// ImagePath and InitrdPath cannot be set at the same time.
 	InitrdPath string
@@ -67,6 +72,9 @@ type HypervisorConfig struct {
 	//   - virtio-fs
 	SharedFS string
 
+	// SharedFSReadOnly shares the volumes read-only with the guest
+	SharedFSReadOnly bool
+
 	// VirtioFSDaemon is the virtio-fs vhost-user daemon path
 	VirtioFSDaemon string
 
@@ -105,6 +113,39 @@ type HypervisorConfig struct {
 	// VirtioFSExtraArgs passes options to virtiofsd daemon
 	VirtioFSExtraArgs []string
 
+	// VirtioFSSandbox is the sandbox mode of virtiofsd daemon
+	VirtioFSSandbox string
+
+	// VirtioFSSeccomp is the seccomp action of virtiofsd daemon
+	VirtioFSSeccomp string
+
+	// VirtioFSModcaps modifies the capabilities of virtiofsd daemon
+	VirtioFSModcaps []string
+
+	// VirtioFSVolumesDaemon runs another virtiofsd daemon, sharing the
+	// volumes of the containers apart from their root filesystems.
+	VirtioFSVolumesDaemon bool
+
+	// VirtioFSVolumesCache is the cache mode of the virtiofsd daemon sharing
+	// the volumes, VirtioFSCache if empty
+	VirtioFSVolumesCache string
+
+	// VirtioFSWriteback enables the writeback cache of virtiofsd daemon
+	VirtioFSWriteback bool
+
+	// VirtioFSVolumesWriteback enables the writeback cache of the virtiofsd
+	// daemon sharing the volumes
+	VirtioFSVolumesWriteback bool
+
+	// VirtioFSMilliCPUs and VirtioFSMemoryMB limit the CPU time, in
+	// thousandths of CPU, and the memory of the virtiofsd daemons
+	VirtioFSMilliCPUs uint32
+	VirtioFSMemoryMB  uint32
+
+	// VirtioFSCPUWeight is the CPU weight, from 1 to 10000, of the
+	// virtiofsd daemons relative to the VMM
+	VirtioFSCPUWeight uint32
+
 	// FileBackedMemRootList is the list of valid root directories values for annotations
 	FileBackedMemRootList []string
 
@@ -147,12 +188,22 @@ type HypervisorConfig struct {
 	// Msize9p is used as the msize for 9p shares
 	Msize9p uint32
 
+	// Cache9p is the cache mode of the 9p shares
+	Cache9p string
+
+	// Version9p is the protocol version of the 9p shares
+	Version9p string
+
 	// MemSlots specifies default memory slots the VM.
 	MemSlots uint32
 
 	// VirtioFSCacheSize is the DAX cache size in MiB
 	VirtioFSCacheSize uint32
 
+	// VirtioFSCacheSizeMax is the largest DAX cache size in MiB which can
+	// be set through annotations, 0 means no limit.
+	VirtioFSCacheSizeMax uint32
+
 	// BlockDeviceCacheSet specifies cache-related options will be set to block devices or not.
 	BlockDeviceCacheSet bool
 
@@ -164,6 +215,34 @@ type HypervisorConfig struct {
 	// Denotes whether flush requests for the device are ignored.
 	BlockDeviceCacheNoflush bool
 
+	// BlockDeviceDiscard enables passing the discard requests of the
+	// guest down to the block devices.
+	BlockDeviceDiscard bool
+
+	// BlockRootfsThresholdMB is the size of the image layers of the
+	// container rootfs above which their block devices are attached.
+	BlockRootfsThresholdMB uint64
+
+	// LayerCachePath is the path of the layer cache image
+	LayerCachePath string
+
+	// LayerCacheSizeMB is the size of the layer cache image when created
+	LayerCacheSizeMB uint64
+
+	// FileBackedBlockDevice is the kind of host block device image files
+	// are attached through
+	FileBackedBlockDevice string
+
+	// UblkQueues is the number of hardware queues of the ublk devices
+	UblkQueues uint32
+
+	// UblkQueueDepth is the depth of the queues of the ublk devices
+	UblkQueueDepth uint32
+
+	// PmemEmptyDirSizeMB is the size of the virtio-pmem images backing
+	// the guest emptyDirs
+	PmemEmptyDirSizeMB uint64
+
 	// DisableBlockDeviceUse disallows a block device from being used.
 	DisableBlockDeviceUse bool
 
@@ -171,6 +250,13 @@ type HypervisorConfig struct {
 	// Supported currently for virtio-scsi driver.
 	EnableIOThreads bool
 
+	// SCSIControllers is the number of virtio-scsi controllers the
+	// hotplugged block devices are distributed across.
+	SCSIControllers uint32
+
+	// SCSIQueueDepth is the virtqueue size of the virtio-scsi controllers.
+	SCSIQueueDepth uint32
+
 	// Debug changes the default hypervisor and kernel parameters to
 	// enable debug output where available.
 	Debug bool
@@ -184,6 +270,20 @@ type HypervisorConfig struct {
 	// VirtioMem is used to enable/disable virtio-mem
 	VirtioMem bool
 
+	// EnableMemoryBalloon adds a memory balloon to the VM
+	EnableMemoryBalloon bool
+
+	// MemoryShrinkHysteresisMB is the amount of memory, in MiB, the VM
+	// memory must be above what the containers need for it to be shrunk
+	MemoryShrinkHysteresisMB uint32
+
+	// EnableGuestNUMA gives the guest the NUMA topology of the host nodes
+	// the sandbox is bound to
+	EnableGuestNUMA bool
+
+	// GuestNUMANodes are the NUMA nodes of the guest
+	GuestNUMANodes []GuestNUMANode
+
 	// DisableNestingChecks is used to override customizations performed
 	// when running on top of another VMM.
 	DisableNestingChecks bool
@@ -208,10 +308,26 @@ type HypervisorConfig struct {
 	EnableVhostUserStore bool
 }
 
+// GuestNUMANode is a NUMA node of the guest.
+// Refs: virtcontainers/numa.go:GuestNUMANode
+type GuestNUMANode struct {
+	VCPUs      []uint32
+	Distances  []uint32
+	HostNode   uint32
+	MemorySize uint32
+}
+
 // KataAgentConfig is a structure storing information needed
 // to reach the Kata Containers agent.
 type KataAgentConfig struct {
-	LongLiveConn bool
+	LongLiveConn        bool
+	HealthCheckInterval uint32
+	HealthCheckFailures uint32
+
+	CreateContainerTimeout uint32
+	ExecProcessTimeout     uint32
+	HotplugTimeout         uint32
+	ShutdownTimeout        uint32
 }
 
 // ShimConfig is the structure providing specific configuration
@@ -224,8 +340,12 @@ type ShimConfig struct {
 // NetworkConfig is the network configuration related to a network.
 type NetworkConfig struct {
 	NetworkID         string
+	PasstPath         string
+	HostNetworkMode   string
+	MacvtapMode       string
 	NetworkCreated    bool
 	DisableNewNetwork bool
+	HostNetwork       bool
 	InterworkingModel int
 }
 
@@ -281,5 +401,32 @@ type SandboxConfig struct {
 	// SandboxCgroupOnly enables cgroup only at podlevel in the host
 	SandboxCgroupOnly bool
 
+	// EnableVCPUsPinning pins the vCPU threads to the exclusive CPUs of
+	// the sandbox
+	EnableVCPUsPinning bool
+
 	DisableGuestSeccomp bool
+
+	RequireGuestSeccomp bool
+
+	GuestTimeSyncInterval time.Duration
+
+	GuestTimeSource string
+	GuestNTPServers []string
+
+	EnableGuestHooks bool
+
+	ConsoleCaptureSize uint32
+
+	EnableAudit bool
+	LinuxAudit  bool
+
+	SlowOperationThreshold uint32
+
+	LogRateLimit  uint32
+	LogRotateSize uint32
+
+	ShimCPUWeight uint32
+	ShimMilliCPUs uint32
+	ShimMemoryMB  uint32
 }
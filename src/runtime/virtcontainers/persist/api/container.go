@@ -64,6 +64,16 @@ type RootfsState struct {
 
 	// RootFStype is file system of the rootfs incase it is block device
 	FsType string
+
+	// BlockDeviceSize is the size of the rootfs block device known by
+	// the guest
+	BlockDeviceSize uint64
+
+	// LayerDeviceIDs and ScratchDeviceID represent the block devices of
+	// the image layers and of the scratch disk of a rootfs built in the
+	// guest, when no file system is shared with it
+	LayerDeviceIDs  []string
+	ScratchDeviceID string
 }
 
 // Process gathers data related to a container process.
@@ -47,6 +47,15 @@ type BlockDrive struct {
 	// Pmem enabled persistent memory. Use File as backing file
 	// for a nvdimm device in the guest.
 	Pmem bool
+
+	// NVMe is set when File is the URI of a NVMe namespace
+	NVMe bool
+
+	// ImageFile is set when File is a regular disk image file
+	ImageFile bool
+
+	// VirtioPmem uses File as backing file for a virtio-pmem device
+	VirtioPmem bool
 }
 
 // VFIODev represents a VFIO drive used for hotplugging
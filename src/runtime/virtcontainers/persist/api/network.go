@@ -38,6 +38,7 @@ type NetworkInterfacePair struct {
 	TapInterface
 	VirtIface            NetworkInterface
 	NetInterworkingModel int
+	MacvtapMode          string
 }
 
 type PhysicalEndpoint struct {
@@ -79,6 +80,23 @@ type VhostUserEndpoint struct {
 	PCIPath   vcTypes.PciPath
 }
 
+type VdpaEndpoint struct {
+	VhostDevPath string
+	// This is for showing information.
+	// Remove these fields won't impact anything.
+	IfaceName string
+	PCIPath   vcTypes.PciPath
+}
+
+type PasstEndpoint struct {
+	SocketPath string
+	PID        int
+	// This is for showing information.
+	// Remove these fields won't impact anything.
+	IfaceName string
+	PCIPath   vcTypes.PciPath
+}
+
 // NetworkEndpoint contains network interface information
 type NetworkEndpoint struct {
 	// One and only one of these below are not nil according to Type.
@@ -90,6 +108,8 @@ type NetworkEndpoint struct {
 	Tap       *TapEndpoint       `json:",omitempty"`
 	IPVlan    *IPVlanEndpoint    `json:",omitempty"`
 	Tuntap    *TuntapEndpoint    `json:",omitempty"`
+	Vdpa      *VdpaEndpoint      `json:",omitempty"`
+	Passt     *PasstEndpoint     `json:",omitempty"`
 
 	Type string
 }
@@ -41,6 +41,18 @@ type SandboxState struct {
 	// It can be an empty string if sandbox_cgroup_only is set.
 	OverheadCgroupPath string
 
+	// VirtiofsdCgroupPath is the cgroup path of the virtiofsd daemons.
+	// It can be an empty string if they are not limited.
+	VirtiofsdCgroupPath string
+
+	// ShimCgroupPath is the cgroup path of the shim.
+	// It can be an empty string if it is not limited.
+	ShimCgroupPath string
+
+	// VMMCgroupPath is the cgroup path of the VMM.
+	// It is only set on cgroup v2 when helper processes are limited.
+	VMMCgroupPath string
+
 	// HypervisorState saves hypervisor specific data
 	HypervisorState hv.HypervisorState
 
@@ -11,6 +11,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
 	"net"
 	"net/url"
 	"os"
@@ -39,6 +40,14 @@ const (
 
 var defaultDialTimeout = 30 * time.Second
 
+// The delays between the attempts to dial the agent, doubled after each
+// failure up to the max, and jittered not to retry in lockstep when many
+// sandboxes start at once.
+var (
+	dialRetryDelay    = 1 * time.Millisecond
+	maxDialRetryDelay = 50 * time.Millisecond
+)
+
 var hybridVSockPort uint32
 
 var agentClientFields = logrus.Fields{
@@ -78,6 +87,20 @@ func NewAgentClient(ctx context.Context, sock string, timeout uint32) (*AgentCli
 		agentClientLog.WithField("timeout", timeout).Debug("custom dialing timeout has been set")
 	}
 
+	// The dial does not outlast the deadline of the caller
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, context.DeadlineExceeded
+		}
+		if remaining < dialTimeout {
+			dialTimeout = remaining
+		}
+	}
+
 	var conn net.Conn
 	var d = agentDialer(parsedAddr)
 	conn, err = d(grpcAddr, dialTimeout)
@@ -85,7 +108,11 @@ func NewAgentClient(ctx context.Context, sock string, timeout uint32) (*AgentCli
 		return nil, err
 	}
 
-	client := ttrpc.NewClient(conn, ttrpc.WithUnaryClientInterceptor(TraceUnaryClientInterceptor()))
+	conn = newMeteredConn(conn, parsedAddr.Scheme)
+	client := ttrpc.NewClient(conn, ttrpc.WithUnaryClientInterceptor(chainUnaryClientInterceptors(
+		TraceUnaryClientInterceptor(),
+		metricsUnaryClientInterceptor(parsedAddr.Scheme),
+	)))
 
 	return &AgentClient{
 		AgentServiceClient: agentgrpc.NewAgentServiceClient(client),
@@ -314,6 +341,7 @@ func commonDialer(timeout time.Duration, dialFunc func() (net.Conn, error), time
 	t := time.NewTimer(timeout)
 	cancel := make(chan bool)
 	ch := make(chan net.Conn)
+	delay := dialRetryDelay
 	go func() {
 		for {
 			select {
@@ -334,6 +362,15 @@ func commonDialer(timeout time.Duration, dialFunc func() (net.Conn, error), time
 				}
 				return
 			}
+
+			select {
+			case <-cancel:
+				return
+			case <-time.After(Jitter(delay)):
+			}
+			if delay *= 2; delay > maxDialRetryDelay {
+				delay = maxDialRetryDelay
+			}
 		}
 	}()
 
@@ -352,6 +389,12 @@ func commonDialer(timeout time.Duration, dialFunc func() (net.Conn, error), time
 	return conn, nil
 }
 
+// Jitter returns a random delay between half and one and a half of delay,
+// not to retry in lockstep when many sandboxes start at once.
+func Jitter(delay time.Duration) time.Duration {
+	return delay/2 + time.Duration(rand.Int63n(int64(delay)+1))
+}
+
 func VsockDialer(sock string, timeout time.Duration) (net.Conn, error) {
 	cid, port, err := parseGrpcVsockAddr(sock)
 	if err != nil {
@@ -435,6 +478,29 @@ func HybridVSockDialer(sock string, timeout time.Duration) (net.Conn, error) {
 	return commonDialer(timeout, dialFunc, timeoutErr)
 }
 
+// DialPort connects to another port than the one of the agent API, over the
+// vsock of the agent address sock.
+func DialPort(sock string, port uint32, timeout uint32) (net.Conn, error) {
+	grpcAddr, parsedAddr, err := parse(sock)
+	if err != nil {
+		return nil, err
+	}
+
+	dialTimeout := defaultDialTimeout
+	if timeout > 0 {
+		dialTimeout = time.Duration(timeout) * time.Second
+	}
+
+	switch parsedAddr.Scheme {
+	case VSockSocketScheme:
+		return VsockDialer(fmt.Sprintf("%s:%s:%d", VSockSocketScheme, parsedAddr.Hostname(), port), dialTimeout)
+	case HybridVSockScheme:
+		return HybridVSockDialer(fmt.Sprintf("%s:%d", grpcAddr, port), dialTimeout)
+	default:
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "Cannot dial port %d of %s", port, sock)
+	}
+}
+
 // just for tests use.
 func MockHybridVSockDialer(sock string, timeout time.Duration) (net.Conn, error) {
 	sock = strings.TrimPrefix(sock, "mock:")
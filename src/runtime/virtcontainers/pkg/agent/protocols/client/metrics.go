@@ -0,0 +1,125 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package client
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/containerd/ttrpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/status"
+)
+
+const namespaceKatashim = "kata_shim"
+
+var (
+	transportRPCDurationsHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespaceKatashim,
+		Name:      "agent_transport_rpc_durations_histogram_milliseconds",
+		Help:      "Round-trip latency of the agent RPCs on the transport to the agent.",
+		// from 62.5µs to 2s
+		Buckets: prometheus.ExponentialBuckets(0.0625, 2, 16),
+	},
+		[]string{"transport", "method"},
+	)
+
+	transportBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespaceKatashim,
+		Name:      "agent_transport_bytes_total",
+		Help:      "Bytes sent and received on the transport to the agent.",
+	},
+		[]string{"transport", "direction"},
+	)
+
+	transportErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespaceKatashim,
+		Name:      "agent_transport_errors_total",
+		Help:      "Number of agent RPCs which failed without a reply of the agent, such as on a lost connection or a timeout.",
+	},
+		[]string{"transport"},
+	)
+)
+
+// RegisterMetrics registers the metrics of the transport to the agent.
+func RegisterMetrics() {
+	prometheus.MustRegister(transportRPCDurationsHistogram)
+	prometheus.MustRegister(transportBytes)
+	prometheus.MustRegister(transportErrors)
+}
+
+// meteredConn counts the bytes transferred on a connection to the agent.
+type meteredConn struct {
+	net.Conn
+	sent     prometheus.Counter
+	received prometheus.Counter
+}
+
+func newMeteredConn(conn net.Conn, transport string) net.Conn {
+	return &meteredConn{
+		Conn:     conn,
+		sent:     transportBytes.WithLabelValues(transport, "sent"),
+		received: transportBytes.WithLabelValues(transport, "received"),
+	}
+}
+
+func (c *meteredConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.received.Add(float64(n))
+	return n, err
+}
+
+func (c *meteredConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.sent.Add(float64(n))
+	return n, err
+}
+
+// metricsUnaryClientInterceptor observes the latency of the RPCs on the
+// transport, and counts the ones failing without a reply of the agent.
+func metricsUnaryClientInterceptor(transport string) ttrpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		req *ttrpc.Request,
+		resp *ttrpc.Response,
+		ci *ttrpc.UnaryClientInfo,
+		invoker ttrpc.Invoker,
+	) error {
+		start := time.Now()
+		err := invoker(ctx, req, resp)
+
+		// the errors returned by the agent are statuses
+		if _, ok := status.FromError(err); !ok {
+			transportErrors.WithLabelValues(transport).Inc()
+			return err
+		}
+
+		transportRPCDurationsHistogram.WithLabelValues(transport, req.Method).Observe(float64(time.Since(start)) / float64(time.Millisecond))
+		return err
+	}
+}
+
+// chainUnaryClientInterceptors returns an interceptor calling the
+// interceptors in order, as a ttrpc client has a single one.
+func chainUnaryClientInterceptors(interceptors ...ttrpc.UnaryClientInterceptor) ttrpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		req *ttrpc.Request,
+		resp *ttrpc.Response,
+		ci *ttrpc.UnaryClientInfo,
+		invoker ttrpc.Invoker,
+	) error {
+		chained := invoker
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, req *ttrpc.Request, resp *ttrpc.Response) error {
+				return interceptor(ctx, req, resp, ci, next)
+			}
+		}
+		return chained(ctx, req, resp)
+	}
+}
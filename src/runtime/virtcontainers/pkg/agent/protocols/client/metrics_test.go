@@ -0,0 +1,109 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package client
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/ttrpc"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	agentgrpc "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/grpc"
+)
+
+type healthService struct{}
+
+func (h *healthService) Check(ctx context.Context, req *agentgrpc.CheckRequest) (*agentgrpc.HealthCheckResponse, error) {
+	return &agentgrpc.HealthCheckResponse{Status: agentgrpc.HealthCheckResponse_SERVING}, nil
+}
+
+func (h *healthService) Version(ctx context.Context, req *agentgrpc.CheckRequest) (*agentgrpc.VersionCheckResponse, error) {
+	return nil, grpcStatus.Error(codes.Unimplemented, "no version")
+}
+
+func counterValue(t *testing.T, counter prometheus.Counter) float64 {
+	var m dto.Metric
+	assert.NoError(t, counter.Write(&m))
+	return m.GetCounter().GetValue()
+}
+
+func histogramCount(t *testing.T, transport, method string) uint64 {
+	var m dto.Metric
+	observer := transportRPCDurationsHistogram.WithLabelValues(transport, method)
+	assert.NoError(t, observer.(prometheus.Histogram).Write(&m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestTransportMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	sock := filepath.Join(t.TempDir(), "agent.sock")
+	listener, err := net.Listen("unix", sock)
+	assert.NoError(err)
+
+	server, err := ttrpc.NewServer()
+	assert.NoError(err)
+	agentgrpc.RegisterHealthService(server, &healthService{})
+	go server.Serve(context.Background(), listener)
+
+	client, err := NewAgentClient(context.Background(), MockHybridVSockScheme+"://"+sock, 5)
+	assert.NoError(err)
+	defer client.Close()
+
+	sent := transportBytes.WithLabelValues(MockHybridVSockScheme, "sent")
+	received := transportBytes.WithLabelValues(MockHybridVSockScheme, "received")
+	errors := transportErrors.WithLabelValues(MockHybridVSockScheme)
+	sentBefore, receivedBefore, errorsBefore := counterValue(t, sent), counterValue(t, received), counterValue(t, errors)
+	checks, versions := histogramCount(t, MockHybridVSockScheme, "Check"), histogramCount(t, MockHybridVSockScheme, "Version")
+
+	_, err = client.HealthClient.Check(context.Background(), &agentgrpc.CheckRequest{})
+	assert.NoError(err)
+	assert.Equal(checks+1, histogramCount(t, MockHybridVSockScheme, "Check"))
+	assert.Greater(counterValue(t, sent), sentBefore)
+	assert.Greater(counterValue(t, received), receivedBefore)
+
+	// An error of the agent is a reply on the transport
+	_, err = client.HealthClient.Version(context.Background(), &agentgrpc.CheckRequest{})
+	assert.Error(err)
+	assert.Equal(versions+1, histogramCount(t, MockHybridVSockScheme, "Version"))
+	assert.Equal(errorsBefore, counterValue(t, errors))
+
+	// The connection is lost
+	assert.NoError(server.Close())
+	_, err = client.HealthClient.Check(context.Background(), &agentgrpc.CheckRequest{})
+	assert.Error(err)
+	assert.Equal(errorsBefore+1, counterValue(t, errors))
+	assert.Equal(checks+1, histogramCount(t, MockHybridVSockScheme, "Check"))
+}
+
+func TestChainUnaryClientInterceptors(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls []string
+	interceptor := func(name string) ttrpc.UnaryClientInterceptor {
+		return func(ctx context.Context, req *ttrpc.Request, resp *ttrpc.Response, ci *ttrpc.UnaryClientInfo, invoker ttrpc.Invoker) error {
+			calls = append(calls, name+" before")
+			err := invoker(ctx, req, resp)
+			calls = append(calls, name+" after")
+			return err
+		}
+	}
+	invoker := func(ctx context.Context, req *ttrpc.Request, resp *ttrpc.Response) error {
+		calls = append(calls, "invoke "+req.Method)
+		return nil
+	}
+
+	chained := chainUnaryClientInterceptors(interceptor("first"), interceptor("second"))
+	assert.NoError(chained(context.Background(), &ttrpc.Request{Method: "Check"}, &ttrpc.Response{}, &ttrpc.UnaryClientInfo{}, invoker))
+	assert.Equal([]string{"first before", "second before", "invoke Check", "second after", "first after"}, calls)
+}
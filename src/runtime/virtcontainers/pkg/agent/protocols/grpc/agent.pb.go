@@ -1193,6 +1193,99 @@ func (m *CloseStdinRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CloseStdinRequest proto.InternalMessageInfo
 
+// OpenProcessIORequest requests the agent to stream the standard IO of a
+// process over dedicated vsock connections, rather than through the
+// ReadStdout, ReadStderr and WriteStdin requests.
+type OpenProcessIORequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ExecId      string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	// bidirectional requests the standard input and output of the process
+	// to be streamed over a single connection, in both directions, their
+	// ports being the same then. The standard input of the process is
+	// closed once the write side of the connection is shut down.
+	Bidirectional        bool     `protobuf:"varint,3,opt,name=bidirectional,proto3" json:"bidirectional,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpenProcessIORequest) Reset()      { *m = OpenProcessIORequest{} }
+func (*OpenProcessIORequest) ProtoMessage() {}
+func (*OpenProcessIORequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{28}
+}
+func (m *OpenProcessIORequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenProcessIORequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenProcessIORequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OpenProcessIORequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenProcessIORequest.Merge(m, src)
+}
+func (m *OpenProcessIORequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenProcessIORequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenProcessIORequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenProcessIORequest proto.InternalMessageInfo
+
+// ProcessIOPorts are the vsock ports the agent listens on, accepting a single
+// connection each, to stream the standard IO of a process, 0 for a stream the
+// process does not have. The standard input of the process is closed once its
+// connection is closed.
+type ProcessIOPorts struct {
+	StdinPort            uint32   `protobuf:"varint,1,opt,name=stdin_port,json=stdinPort,proto3" json:"stdin_port,omitempty"`
+	StdoutPort           uint32   `protobuf:"varint,2,opt,name=stdout_port,json=stdoutPort,proto3" json:"stdout_port,omitempty"`
+	StderrPort           uint32   `protobuf:"varint,3,opt,name=stderr_port,json=stderrPort,proto3" json:"stderr_port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProcessIOPorts) Reset()      { *m = ProcessIOPorts{} }
+func (*ProcessIOPorts) ProtoMessage() {}
+func (*ProcessIOPorts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{29}
+}
+func (m *ProcessIOPorts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProcessIOPorts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProcessIOPorts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProcessIOPorts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessIOPorts.Merge(m, src)
+}
+func (m *ProcessIOPorts) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProcessIOPorts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessIOPorts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessIOPorts proto.InternalMessageInfo
+
 type TtyWinResizeRequest struct {
 	ContainerId          string   `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	ExecId               string   `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
@@ -1206,7 +1299,7 @@ type TtyWinResizeRequest struct {
 func (m *TtyWinResizeRequest) Reset()      { *m = TtyWinResizeRequest{} }
 func (*TtyWinResizeRequest) ProtoMessage() {}
 func (*TtyWinResizeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{28}
+	return fileDescriptor_712ce9a559fda969, []int{30}
 }
 func (m *TtyWinResizeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1249,7 +1342,7 @@ type KernelModule struct {
 func (m *KernelModule) Reset()      { *m = KernelModule{} }
 func (*KernelModule) ProtoMessage() {}
 func (*KernelModule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{29}
+	return fileDescriptor_712ce9a559fda969, []int{31}
 }
 func (m *KernelModule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1397,7 @@ type CreateSandboxRequest struct {
 func (m *CreateSandboxRequest) Reset()      { *m = CreateSandboxRequest{} }
 func (*CreateSandboxRequest) ProtoMessage() {}
 func (*CreateSandboxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{30}
+	return fileDescriptor_712ce9a559fda969, []int{32}
 }
 func (m *CreateSandboxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1342,7 +1435,7 @@ type DestroySandboxRequest struct {
 func (m *DestroySandboxRequest) Reset()      { *m = DestroySandboxRequest{} }
 func (*DestroySandboxRequest) ProtoMessage() {}
 func (*DestroySandboxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{31}
+	return fileDescriptor_712ce9a559fda969, []int{33}
 }
 func (m *DestroySandboxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1381,7 +1474,7 @@ type Interfaces struct {
 func (m *Interfaces) Reset()      { *m = Interfaces{} }
 func (*Interfaces) ProtoMessage() {}
 func (*Interfaces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{32}
+	return fileDescriptor_712ce9a559fda969, []int{34}
 }
 func (m *Interfaces) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1420,7 +1513,7 @@ type Routes struct {
 func (m *Routes) Reset()      { *m = Routes{} }
 func (*Routes) ProtoMessage() {}
 func (*Routes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{33}
+	return fileDescriptor_712ce9a559fda969, []int{35}
 }
 func (m *Routes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1459,7 +1552,7 @@ type UpdateInterfaceRequest struct {
 func (m *UpdateInterfaceRequest) Reset()      { *m = UpdateInterfaceRequest{} }
 func (*UpdateInterfaceRequest) ProtoMessage() {}
 func (*UpdateInterfaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{34}
+	return fileDescriptor_712ce9a559fda969, []int{36}
 }
 func (m *UpdateInterfaceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1498,7 +1591,7 @@ type UpdateRoutesRequest struct {
 func (m *UpdateRoutesRequest) Reset()      { *m = UpdateRoutesRequest{} }
 func (*UpdateRoutesRequest) ProtoMessage() {}
 func (*UpdateRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{35}
+	return fileDescriptor_712ce9a559fda969, []int{37}
 }
 func (m *UpdateRoutesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1536,7 +1629,7 @@ type ListInterfacesRequest struct {
 func (m *ListInterfacesRequest) Reset()      { *m = ListInterfacesRequest{} }
 func (*ListInterfacesRequest) ProtoMessage() {}
 func (*ListInterfacesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{36}
+	return fileDescriptor_712ce9a559fda969, []int{38}
 }
 func (m *ListInterfacesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1574,7 +1667,7 @@ type ListRoutesRequest struct {
 func (m *ListRoutesRequest) Reset()      { *m = ListRoutesRequest{} }
 func (*ListRoutesRequest) ProtoMessage() {}
 func (*ListRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{37}
+	return fileDescriptor_712ce9a559fda969, []int{39}
 }
 func (m *ListRoutesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1613,7 +1706,7 @@ type ARPNeighbors struct {
 func (m *ARPNeighbors) Reset()      { *m = ARPNeighbors{} }
 func (*ARPNeighbors) ProtoMessage() {}
 func (*ARPNeighbors) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{38}
+	return fileDescriptor_712ce9a559fda969, []int{40}
 }
 func (m *ARPNeighbors) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1652,7 +1745,7 @@ type AddARPNeighborsRequest struct {
 func (m *AddARPNeighborsRequest) Reset()      { *m = AddARPNeighborsRequest{} }
 func (*AddARPNeighborsRequest) ProtoMessage() {}
 func (*AddARPNeighborsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{39}
+	return fileDescriptor_712ce9a559fda969, []int{41}
 }
 func (m *AddARPNeighborsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1681,6 +1774,169 @@ func (m *AddARPNeighborsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddARPNeighborsRequest proto.InternalMessageInfo
 
+type GetIPTablesRequest struct {
+	// is_ipv6 selects the ip6tables rules instead of the iptables ones
+	IsIpv6               bool     `protobuf:"varint,1,opt,name=is_ipv6,json=isIpv6,proto3" json:"is_ipv6,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetIPTablesRequest) Reset()      { *m = GetIPTablesRequest{} }
+func (*GetIPTablesRequest) ProtoMessage() {}
+func (*GetIPTablesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{42}
+}
+func (m *GetIPTablesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetIPTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetIPTablesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetIPTablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIPTablesRequest.Merge(m, src)
+}
+func (m *GetIPTablesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetIPTablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIPTablesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIPTablesRequest proto.InternalMessageInfo
+
+type GetIPTablesResponse struct {
+	// data is the output of iptables-save or ip6tables-save
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetIPTablesResponse) Reset()      { *m = GetIPTablesResponse{} }
+func (*GetIPTablesResponse) ProtoMessage() {}
+func (*GetIPTablesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{43}
+}
+func (m *GetIPTablesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetIPTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetIPTablesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetIPTablesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIPTablesResponse.Merge(m, src)
+}
+func (m *GetIPTablesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetIPTablesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIPTablesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIPTablesResponse proto.InternalMessageInfo
+
+type SetIPTablesRequest struct {
+	// is_ipv6 selects the ip6tables rules instead of the iptables ones
+	IsIpv6 bool `protobuf:"varint,1,opt,name=is_ipv6,json=isIpv6,proto3" json:"is_ipv6,omitempty"`
+	// data is the input of iptables-restore or ip6tables-restore,
+	// replacing the rules of the tables it contains
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetIPTablesRequest) Reset()      { *m = SetIPTablesRequest{} }
+func (*SetIPTablesRequest) ProtoMessage() {}
+func (*SetIPTablesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{44}
+}
+func (m *SetIPTablesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetIPTablesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetIPTablesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetIPTablesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetIPTablesRequest.Merge(m, src)
+}
+func (m *SetIPTablesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetIPTablesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetIPTablesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetIPTablesRequest proto.InternalMessageInfo
+
+type SetIPTablesResponse struct {
+	// data is the output of iptables-restore or ip6tables-restore
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetIPTablesResponse) Reset()      { *m = SetIPTablesResponse{} }
+func (*SetIPTablesResponse) ProtoMessage() {}
+func (*SetIPTablesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{45}
+}
+func (m *SetIPTablesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetIPTablesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetIPTablesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetIPTablesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetIPTablesResponse.Merge(m, src)
+}
+func (m *SetIPTablesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetIPTablesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetIPTablesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetIPTablesResponse proto.InternalMessageInfo
+
 type OnlineCPUMemRequest struct {
 	// Wait specifies if the caller waits for the agent to online all resources.
 	// If true the agent returns once all resources have been connected, otherwise all
@@ -1689,7 +1945,11 @@ type OnlineCPUMemRequest struct {
 	// NbCpus specifies the number of CPUs that were added and the agent has to online.
 	NbCpus uint32 `protobuf:"varint,2,opt,name=nb_cpus,json=nbCpus,proto3" json:"nb_cpus,omitempty"`
 	// CpuOnly specifies whether only online CPU or not.
-	CpuOnly              bool     `protobuf:"varint,3,opt,name=cpu_only,json=cpuOnly,proto3" json:"cpu_only,omitempty"`
+	CpuOnly bool `protobuf:"varint,3,opt,name=cpu_only,json=cpuOnly,proto3" json:"cpu_only,omitempty"`
+	// NbMemBlocks specifies the number of memory blocks hot added to the
+	// guest since it booted. If not zero, the agent returns once as many
+	// hot added memory blocks are online, or fails after the hotplug timeout.
+	NbMemBlocks          uint32   `protobuf:"varint,4,opt,name=nb_mem_blocks,json=nbMemBlocks,proto3" json:"nb_mem_blocks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1698,7 +1958,7 @@ type OnlineCPUMemRequest struct {
 func (m *OnlineCPUMemRequest) Reset()      { *m = OnlineCPUMemRequest{} }
 func (*OnlineCPUMemRequest) ProtoMessage() {}
 func (*OnlineCPUMemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{40}
+	return fileDescriptor_712ce9a559fda969, []int{46}
 }
 func (m *OnlineCPUMemRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1738,7 +1998,7 @@ type ReseedRandomDevRequest struct {
 func (m *ReseedRandomDevRequest) Reset()      { *m = ReseedRandomDevRequest{} }
 func (*ReseedRandomDevRequest) ProtoMessage() {}
 func (*ReseedRandomDevRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{41}
+	return fileDescriptor_712ce9a559fda969, []int{47}
 }
 func (m *ReseedRandomDevRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1788,7 +2048,7 @@ type AgentDetails struct {
 func (m *AgentDetails) Reset()      { *m = AgentDetails{} }
 func (*AgentDetails) ProtoMessage() {}
 func (*AgentDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{42}
+	return fileDescriptor_712ce9a559fda969, []int{48}
 }
 func (m *AgentDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1834,7 +2094,7 @@ type GuestDetailsRequest struct {
 func (m *GuestDetailsRequest) Reset()      { *m = GuestDetailsRequest{} }
 func (*GuestDetailsRequest) ProtoMessage() {}
 func (*GuestDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{43}
+	return fileDescriptor_712ce9a559fda969, []int{49}
 }
 func (m *GuestDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1876,7 +2136,7 @@ type GuestDetailsResponse struct {
 func (m *GuestDetailsResponse) Reset()      { *m = GuestDetailsResponse{} }
 func (*GuestDetailsResponse) ProtoMessage() {}
 func (*GuestDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{44}
+	return fileDescriptor_712ce9a559fda969, []int{50}
 }
 func (m *GuestDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1917,7 +2177,7 @@ type MemHotplugByProbeRequest struct {
 func (m *MemHotplugByProbeRequest) Reset()      { *m = MemHotplugByProbeRequest{} }
 func (*MemHotplugByProbeRequest) ProtoMessage() {}
 func (*MemHotplugByProbeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{45}
+	return fileDescriptor_712ce9a559fda969, []int{51}
 }
 func (m *MemHotplugByProbeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1959,7 +2219,7 @@ type SetGuestDateTimeRequest struct {
 func (m *SetGuestDateTimeRequest) Reset()      { *m = SetGuestDateTimeRequest{} }
 func (*SetGuestDateTimeRequest) ProtoMessage() {}
 func (*SetGuestDateTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{46}
+	return fileDescriptor_712ce9a559fda969, []int{52}
 }
 func (m *SetGuestDateTimeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2005,7 +2265,7 @@ type FSGroup struct {
 func (m *FSGroup) Reset()      { *m = FSGroup{} }
 func (*FSGroup) ProtoMessage() {}
 func (*FSGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{47}
+	return fileDescriptor_712ce9a559fda969, []int{53}
 }
 func (m *FSGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2064,16 +2324,19 @@ type Storage struct {
 	MountPoint string `protobuf:"bytes,6,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
 	// FSGroup consists of the group ID and group ownership change policy
 	// that the mounted volume must have its group ID changed to when specified.
-	FsGroup              *FSGroup `protobuf:"bytes,7,opt,name=fs_group,json=fsGroup,proto3" json:"fs_group,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	FsGroup *FSGroup `protobuf:"bytes,7,opt,name=fs_group,json=fsGroup,proto3" json:"fs_group,omitempty"`
+	// Encryption describes how the agent must decrypt the storage device
+	// before mounting it. It is only valid for block device storages.
+	Encryption           *StorageEncryption `protobuf:"bytes,8,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *Storage) Reset()      { *m = Storage{} }
 func (*Storage) ProtoMessage() {}
 func (*Storage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{48}
+	return fileDescriptor_712ce9a559fda969, []int{54}
 }
 func (m *Storage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2102,6 +2365,52 @@ func (m *Storage) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Storage proto.InternalMessageInfo
 
+// StorageEncryption describes the encryption of a block device storage,
+// opened by the agent with the given key, and formatted first if the
+// device is not encrypted yet.
+type StorageEncryption struct {
+	// Type is the encryption format of the device. Only "luks" is
+	// supported.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Key is the passphrase of the device.
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageEncryption) Reset()      { *m = StorageEncryption{} }
+func (*StorageEncryption) ProtoMessage() {}
+func (*StorageEncryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{55}
+}
+func (m *StorageEncryption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageEncryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageEncryption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageEncryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageEncryption.Merge(m, src)
+}
+func (m *StorageEncryption) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageEncryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageEncryption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageEncryption proto.InternalMessageInfo
+
 // Device represents only the devices that could have been defined through the
 // Linux Device list of the OCI specification.
 type Device struct {
@@ -2144,7 +2453,7 @@ type Device struct {
 func (m *Device) Reset()      { *m = Device{} }
 func (*Device) ProtoMessage() {}
 func (*Device) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{49}
+	return fileDescriptor_712ce9a559fda969, []int{56}
 }
 func (m *Device) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2185,7 +2494,7 @@ type StringUser struct {
 func (m *StringUser) Reset()      { *m = StringUser{} }
 func (*StringUser) ProtoMessage() {}
 func (*StringUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{50}
+	return fileDescriptor_712ce9a559fda969, []int{57}
 }
 func (m *StringUser) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2242,7 +2551,7 @@ type CopyFileRequest struct {
 func (m *CopyFileRequest) Reset()      { *m = CopyFileRequest{} }
 func (*CopyFileRequest) ProtoMessage() {}
 func (*CopyFileRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{51}
+	return fileDescriptor_712ce9a559fda969, []int{58}
 }
 func (m *CopyFileRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2280,7 +2589,7 @@ type GetOOMEventRequest struct {
 func (m *GetOOMEventRequest) Reset()      { *m = GetOOMEventRequest{} }
 func (*GetOOMEventRequest) ProtoMessage() {}
 func (*GetOOMEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{52}
+	return fileDescriptor_712ce9a559fda969, []int{59}
 }
 func (m *GetOOMEventRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2319,7 +2628,7 @@ type OOMEvent struct {
 func (m *OOMEvent) Reset()      { *m = OOMEvent{} }
 func (*OOMEvent) ProtoMessage() {}
 func (*OOMEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{53}
+	return fileDescriptor_712ce9a559fda969, []int{60}
 }
 func (m *OOMEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2358,7 +2667,7 @@ type AddSwapRequest struct {
 func (m *AddSwapRequest) Reset()      { *m = AddSwapRequest{} }
 func (*AddSwapRequest) ProtoMessage() {}
 func (*AddSwapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{54}
+	return fileDescriptor_712ce9a559fda969, []int{61}
 }
 func (m *AddSwapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2705,7 @@ type GetMetricsRequest struct {
 func (m *GetMetricsRequest) Reset()      { *m = GetMetricsRequest{} }
 func (*GetMetricsRequest) ProtoMessage() {}
 func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{55}
+	return fileDescriptor_712ce9a559fda969, []int{62}
 }
 func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2435,7 +2744,7 @@ type Metrics struct {
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{56}
+	return fileDescriptor_712ce9a559fda969, []int{63}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +2784,7 @@ type VolumeStatsRequest struct {
 func (m *VolumeStatsRequest) Reset()      { *m = VolumeStatsRequest{} }
 func (*VolumeStatsRequest) ProtoMessage() {}
 func (*VolumeStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{57}
+	return fileDescriptor_712ce9a559fda969, []int{64}
 }
 func (m *VolumeStatsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2516,7 +2825,7 @@ type ResizeVolumeRequest struct {
 func (m *ResizeVolumeRequest) Reset()      { *m = ResizeVolumeRequest{} }
 func (*ResizeVolumeRequest) ProtoMessage() {}
 func (*ResizeVolumeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_712ce9a559fda969, []int{58}
+	return fileDescriptor_712ce9a559fda969, []int{65}
 }
 func (m *ResizeVolumeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2545,885 +2854,1037 @@ func (m *ResizeVolumeRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResizeVolumeRequest proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*CreateContainerRequest)(nil), "grpc.CreateContainerRequest")
-	proto.RegisterType((*StartContainerRequest)(nil), "grpc.StartContainerRequest")
-	proto.RegisterType((*RemoveContainerRequest)(nil), "grpc.RemoveContainerRequest")
-	proto.RegisterType((*ExecProcessRequest)(nil), "grpc.ExecProcessRequest")
-	proto.RegisterType((*SignalProcessRequest)(nil), "grpc.SignalProcessRequest")
-	proto.RegisterType((*WaitProcessRequest)(nil), "grpc.WaitProcessRequest")
-	proto.RegisterType((*WaitProcessResponse)(nil), "grpc.WaitProcessResponse")
-	proto.RegisterType((*UpdateContainerRequest)(nil), "grpc.UpdateContainerRequest")
-	proto.RegisterType((*StatsContainerRequest)(nil), "grpc.StatsContainerRequest")
-	proto.RegisterType((*PauseContainerRequest)(nil), "grpc.PauseContainerRequest")
-	proto.RegisterType((*ResumeContainerRequest)(nil), "grpc.ResumeContainerRequest")
-	proto.RegisterType((*CpuUsage)(nil), "grpc.CpuUsage")
-	proto.RegisterType((*ThrottlingData)(nil), "grpc.ThrottlingData")
-	proto.RegisterType((*CpuStats)(nil), "grpc.CpuStats")
-	proto.RegisterType((*PidsStats)(nil), "grpc.PidsStats")
-	proto.RegisterType((*MemoryData)(nil), "grpc.MemoryData")
-	proto.RegisterType((*MemoryStats)(nil), "grpc.MemoryStats")
-	proto.RegisterMapType((map[string]uint64)(nil), "grpc.MemoryStats.StatsEntry")
-	proto.RegisterType((*BlkioStatsEntry)(nil), "grpc.BlkioStatsEntry")
-	proto.RegisterType((*BlkioStats)(nil), "grpc.BlkioStats")
-	proto.RegisterType((*HugetlbStats)(nil), "grpc.HugetlbStats")
-	proto.RegisterType((*CgroupStats)(nil), "grpc.CgroupStats")
-	proto.RegisterMapType((map[string]*HugetlbStats)(nil), "grpc.CgroupStats.HugetlbStatsEntry")
-	proto.RegisterType((*NetworkStats)(nil), "grpc.NetworkStats")
-	proto.RegisterType((*StatsContainerResponse)(nil), "grpc.StatsContainerResponse")
-	proto.RegisterType((*WriteStreamRequest)(nil), "grpc.WriteStreamRequest")
-	proto.RegisterType((*WriteStreamResponse)(nil), "grpc.WriteStreamResponse")
-	proto.RegisterType((*ReadStreamRequest)(nil), "grpc.ReadStreamRequest")
-	proto.RegisterType((*ReadStreamResponse)(nil), "grpc.ReadStreamResponse")
-	proto.RegisterType((*CloseStdinRequest)(nil), "grpc.CloseStdinRequest")
-	proto.RegisterType((*TtyWinResizeRequest)(nil), "grpc.TtyWinResizeRequest")
-	proto.RegisterType((*KernelModule)(nil), "grpc.KernelModule")
-	proto.RegisterType((*CreateSandboxRequest)(nil), "grpc.CreateSandboxRequest")
-	proto.RegisterType((*DestroySandboxRequest)(nil), "grpc.DestroySandboxRequest")
-	proto.RegisterType((*Interfaces)(nil), "grpc.Interfaces")
-	proto.RegisterType((*Routes)(nil), "grpc.Routes")
-	proto.RegisterType((*UpdateInterfaceRequest)(nil), "grpc.UpdateInterfaceRequest")
-	proto.RegisterType((*UpdateRoutesRequest)(nil), "grpc.UpdateRoutesRequest")
-	proto.RegisterType((*ListInterfacesRequest)(nil), "grpc.ListInterfacesRequest")
-	proto.RegisterType((*ListRoutesRequest)(nil), "grpc.ListRoutesRequest")
-	proto.RegisterType((*ARPNeighbors)(nil), "grpc.ARPNeighbors")
-	proto.RegisterType((*AddARPNeighborsRequest)(nil), "grpc.AddARPNeighborsRequest")
-	proto.RegisterType((*OnlineCPUMemRequest)(nil), "grpc.OnlineCPUMemRequest")
-	proto.RegisterType((*ReseedRandomDevRequest)(nil), "grpc.ReseedRandomDevRequest")
-	proto.RegisterType((*AgentDetails)(nil), "grpc.AgentDetails")
-	proto.RegisterType((*GuestDetailsRequest)(nil), "grpc.GuestDetailsRequest")
-	proto.RegisterType((*GuestDetailsResponse)(nil), "grpc.GuestDetailsResponse")
-	proto.RegisterType((*MemHotplugByProbeRequest)(nil), "grpc.MemHotplugByProbeRequest")
-	proto.RegisterType((*SetGuestDateTimeRequest)(nil), "grpc.SetGuestDateTimeRequest")
-	proto.RegisterType((*FSGroup)(nil), "grpc.FSGroup")
-	proto.RegisterType((*Storage)(nil), "grpc.Storage")
-	proto.RegisterType((*Device)(nil), "grpc.Device")
-	proto.RegisterType((*StringUser)(nil), "grpc.StringUser")
-	proto.RegisterType((*CopyFileRequest)(nil), "grpc.CopyFileRequest")
-	proto.RegisterType((*GetOOMEventRequest)(nil), "grpc.GetOOMEventRequest")
-	proto.RegisterType((*OOMEvent)(nil), "grpc.OOMEvent")
-	proto.RegisterType((*AddSwapRequest)(nil), "grpc.AddSwapRequest")
-	proto.RegisterType((*GetMetricsRequest)(nil), "grpc.GetMetricsRequest")
-	proto.RegisterType((*Metrics)(nil), "grpc.Metrics")
-	proto.RegisterType((*VolumeStatsRequest)(nil), "grpc.VolumeStatsRequest")
-	proto.RegisterType((*ResizeVolumeRequest)(nil), "grpc.ResizeVolumeRequest")
+// WatchableEntry is a file system entry of a watchable mount created or
+// updated on the host, or a chunk of the content of a regular file.
+type WatchableEntry struct {
+	// Path of the entry, relative to the root of the mount. It is empty for
+	// the root of the mount itself.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Mode is the file type and permission bits of the entry, as in st_mode.
+	Mode uint32 `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Uid  uint32 `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	Gid  uint32 `protobuf:"varint,4,opt,name=gid,proto3" json:"gid,omitempty"`
+	// LinkTarget is the target of a symbolic link.
+	LinkTarget string `protobuf:"bytes,5,opt,name=link_target,json=linkTarget,proto3" json:"link_target,omitempty"`
+	// Size is the size of a regular file. The file is updated in the guest
+	// once all its content is received.
+	Size_ uint64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	// Offset of the data in a regular file.
+	Offset               uint64   `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data                 []byte   `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("github.com/kata-containers/kata-containers/src/libs/protocols/protos/agent.proto", fileDescriptor_712ce9a559fda969)
+func (m *WatchableEntry) Reset()      { *m = WatchableEntry{} }
+func (*WatchableEntry) ProtoMessage() {}
+func (*WatchableEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{66}
 }
-
-var fileDescriptor_712ce9a559fda969 = []byte{
-	// 3127 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x1a, 0xcb, 0x72, 0x24, 0x47,
-	0xd1, 0xf3, 0x90, 0x66, 0x26, 0xe7, 0xa5, 0x69, 0x69, 0xb5, 0xb3, 0x63, 0x5b, 0xac, 0x7b, 0xed,
-	0xb5, 0x6c, 0x63, 0xc9, 0x5e, 0x3b, 0x58, 0x3f, 0xc2, 0x2c, 0x92, 0x56, 0x96, 0x64, 0x5b, 0xde,
-	0xa1, 0x65, 0x61, 0x02, 0x02, 0x3a, 0x7a, 0xba, 0x4b, 0x33, 0x65, 0x4d, 0x77, 0xb5, 0xab, 0xab,
-	0xb5, 0x1a, 0x13, 0x41, 0x70, 0x82, 0x1b, 0x47, 0x6e, 0xfc, 0x00, 0xc1, 0x1f, 0x70, 0xe1, 0xc0,
-	0xc1, 0xc1, 0x89, 0x23, 0x17, 0x08, 0xbc, 0x9f, 0xc0, 0x17, 0x10, 0xf5, 0xea, 0xc7, 0x3c, 0x64,
-	0x50, 0x6c, 0x04, 0x97, 0x89, 0xce, 0xac, 0xac, 0x7c, 0x55, 0x65, 0x56, 0x66, 0xd5, 0x40, 0x7f,
-	0x88, 0xd9, 0x28, 0x1e, 0x6c, 0xb9, 0xc4, 0xdf, 0x3e, 0x77, 0x98, 0xf3, 0xba, 0x4b, 0x02, 0xe6,
-	0xe0, 0x00, 0xd1, 0x68, 0x06, 0x8e, 0xa8, 0xbb, 0x3d, 0xc6, 0x83, 0x68, 0x3b, 0xa4, 0x84, 0x11,
-	0x97, 0x8c, 0xd5, 0x57, 0xb4, 0xed, 0x0c, 0x51, 0xc0, 0xb6, 0x04, 0x60, 0x94, 0x87, 0x34, 0x74,
-	0x7b, 0x35, 0xe2, 0x62, 0x89, 0xe8, 0xd5, 0xdc, 0x48, 0x7f, 0xd6, 0xd9, 0x24, 0x44, 0x91, 0x02,
-	0x9e, 0x1d, 0x12, 0x32, 0x1c, 0x23, 0xc9, 0x63, 0x10, 0x9f, 0x6d, 0x23, 0x3f, 0x64, 0x13, 0x39,
-	0x68, 0xfe, 0xbe, 0x08, 0xeb, 0x7b, 0x14, 0x39, 0x0c, 0xed, 0x69, 0x05, 0x2c, 0xf4, 0x65, 0x8c,
-	0x22, 0x66, 0xbc, 0x00, 0x8d, 0x44, 0x29, 0x1b, 0x7b, 0xdd, 0xc2, 0xed, 0xc2, 0x66, 0xcd, 0xaa,
-	0x27, 0xb8, 0x23, 0xcf, 0xb8, 0x09, 0x15, 0x74, 0x89, 0x5c, 0x3e, 0x5a, 0x14, 0xa3, 0xcb, 0x1c,
-	0x3c, 0xf2, 0x8c, 0x37, 0xa1, 0x1e, 0x31, 0x8a, 0x83, 0xa1, 0x1d, 0x47, 0x88, 0x76, 0x4b, 0xb7,
-	0x0b, 0x9b, 0xf5, 0x7b, 0x2b, 0x5b, 0x5c, 0xe5, 0xad, 0x13, 0x31, 0x70, 0x1a, 0x21, 0x6a, 0x41,
-	0x94, 0x7c, 0x1b, 0x77, 0xa1, 0xe2, 0xa1, 0x0b, 0xec, 0xa2, 0xa8, 0x5b, 0xbe, 0x5d, 0xda, 0xac,
-	0xdf, 0x6b, 0x48, 0xf2, 0x87, 0x02, 0x69, 0xe9, 0x41, 0xe3, 0x15, 0xa8, 0x46, 0x8c, 0x50, 0x67,
-	0x88, 0xa2, 0xee, 0x92, 0x20, 0x6c, 0x6a, 0xbe, 0x02, 0x6b, 0x25, 0xc3, 0xc6, 0x73, 0x50, 0x7a,
-	0xb4, 0x77, 0xd4, 0x5d, 0x16, 0xd2, 0x41, 0x51, 0x85, 0xc8, 0xb5, 0x38, 0xda, 0xb8, 0x03, 0xcd,
-	0xc8, 0x09, 0xbc, 0x01, 0xb9, 0xb4, 0x43, 0xec, 0x05, 0x51, 0xb7, 0x72, 0xbb, 0xb0, 0x59, 0xb5,
-	0x1a, 0x0a, 0xd9, 0xe7, 0x38, 0xf3, 0x3d, 0xb8, 0x71, 0xc2, 0x1c, 0xca, 0xae, 0xe1, 0x1d, 0xf3,
-	0x14, 0xd6, 0x2d, 0xe4, 0x93, 0x8b, 0x6b, 0xb9, 0xb6, 0x0b, 0x15, 0x86, 0x7d, 0x44, 0x62, 0x26,
-	0x5c, 0xdb, 0xb4, 0x34, 0x68, 0xfe, 0xb1, 0x00, 0xc6, 0xfe, 0x25, 0x72, 0xfb, 0x94, 0xb8, 0x28,
-	0x8a, 0xfe, 0x4f, 0xcb, 0xf5, 0x32, 0x54, 0x42, 0xa9, 0x40, 0xb7, 0x2c, 0xc8, 0xd5, 0x2a, 0x68,
-	0xad, 0xf4, 0xa8, 0xf9, 0x05, 0xac, 0x9d, 0xe0, 0x61, 0xe0, 0x8c, 0x9f, 0xa2, 0xbe, 0xeb, 0xb0,
-	0x1c, 0x09, 0x9e, 0x42, 0xd5, 0xa6, 0xa5, 0x20, 0xb3, 0x0f, 0xc6, 0xe7, 0x0e, 0x66, 0x4f, 0x4f,
-	0x92, 0xf9, 0x3a, 0xac, 0xe6, 0x38, 0x46, 0x21, 0x09, 0x22, 0x24, 0x14, 0x60, 0x0e, 0x8b, 0x23,
-	0xc1, 0x6c, 0xc9, 0x52, 0x90, 0x49, 0x60, 0xfd, 0x34, 0xf4, 0xae, 0x19, 0x4d, 0xf7, 0xa0, 0x46,
-	0x51, 0x44, 0x62, 0xca, 0x63, 0xa0, 0x28, 0x9c, 0xba, 0x26, 0x9d, 0xfa, 0x09, 0x0e, 0xe2, 0x4b,
-	0x4b, 0x8f, 0x59, 0x29, 0x99, 0xda, 0x9f, 0x2c, 0xba, 0xce, 0xfe, 0x7c, 0x0f, 0x6e, 0xf4, 0x9d,
-	0x38, 0xba, 0x8e, 0xae, 0xe6, 0xfb, 0x7c, 0x6f, 0x47, 0xb1, 0x7f, 0xad, 0xc9, 0x7f, 0x28, 0x40,
-	0x75, 0x2f, 0x8c, 0x4f, 0x23, 0x67, 0x88, 0x8c, 0xef, 0x40, 0x9d, 0x11, 0xe6, 0x8c, 0xed, 0x98,
-	0x83, 0x82, 0xbc, 0x6c, 0x81, 0x40, 0x49, 0x82, 0x17, 0xa0, 0x11, 0x22, 0xea, 0x86, 0xb1, 0xa2,
-	0x28, 0xde, 0x2e, 0x6d, 0x96, 0xad, 0xba, 0xc4, 0x49, 0x92, 0x2d, 0x58, 0x15, 0x63, 0x36, 0x0e,
-	0xec, 0x73, 0x44, 0x03, 0x34, 0xf6, 0x89, 0x87, 0xc4, 0xe6, 0x28, 0x5b, 0x1d, 0x31, 0x74, 0x14,
-	0x7c, 0x9c, 0x0c, 0x18, 0xaf, 0x42, 0x27, 0xa1, 0xe7, 0x3b, 0x5e, 0x50, 0x97, 0x05, 0x75, 0x5b,
-	0x51, 0x9f, 0x2a, 0xb4, 0xf9, 0x4b, 0x68, 0x7d, 0x36, 0xa2, 0x84, 0xb1, 0x31, 0x0e, 0x86, 0x0f,
-	0x1d, 0xe6, 0xf0, 0xd0, 0x0c, 0x11, 0xc5, 0xc4, 0x8b, 0x94, 0xb6, 0x1a, 0x34, 0x5e, 0x83, 0x0e,
-	0x93, 0xb4, 0xc8, 0xb3, 0x35, 0x4d, 0x51, 0xd0, 0xac, 0x24, 0x03, 0x7d, 0x45, 0xfc, 0x12, 0xb4,
-	0x52, 0x62, 0x1e, 0xdc, 0x4a, 0xdf, 0x66, 0x82, 0xfd, 0x0c, 0xfb, 0xc8, 0xbc, 0x10, 0xbe, 0x12,
-	0x8b, 0x6c, 0xbc, 0x06, 0xb5, 0xd4, 0x0f, 0x05, 0xb1, 0x43, 0x5a, 0x72, 0x87, 0x68, 0x77, 0x5a,
-	0xd5, 0xc4, 0x29, 0x1f, 0x40, 0x9b, 0x25, 0x8a, 0xdb, 0x9e, 0xc3, 0x9c, 0xfc, 0xa6, 0xca, 0x5b,
-	0x65, 0xb5, 0x58, 0x0e, 0x36, 0xdf, 0x87, 0x5a, 0x1f, 0x7b, 0x91, 0x14, 0xdc, 0x85, 0x8a, 0x1b,
-	0x53, 0x8a, 0x02, 0xa6, 0x4d, 0x56, 0xa0, 0xb1, 0x06, 0x4b, 0x63, 0xec, 0x63, 0xa6, 0xcc, 0x94,
-	0x80, 0x49, 0x00, 0x8e, 0x91, 0x4f, 0xe8, 0x44, 0x38, 0x6c, 0x0d, 0x96, 0xb2, 0x8b, 0x2b, 0x01,
-	0xe3, 0x59, 0xa8, 0xf9, 0xce, 0x65, 0xb2, 0xa8, 0x7c, 0xa4, 0xea, 0x3b, 0x97, 0x52, 0xf9, 0x2e,
-	0x54, 0xce, 0x1c, 0x3c, 0x76, 0x03, 0xa6, 0xbc, 0xa2, 0xc1, 0x54, 0x60, 0x39, 0x2b, 0xf0, 0x2f,
-	0x45, 0xa8, 0x4b, 0x89, 0x52, 0xe1, 0x35, 0x58, 0x72, 0x1d, 0x77, 0x94, 0x88, 0x14, 0x80, 0x71,
-	0x57, 0x2b, 0x52, 0xcc, 0x66, 0xb8, 0x54, 0x53, 0xad, 0xda, 0x36, 0x40, 0xf4, 0xd8, 0x09, 0x95,
-	0x6e, 0xa5, 0x05, 0xc4, 0x35, 0x4e, 0x23, 0xd5, 0x7d, 0x0b, 0x1a, 0x72, 0xdf, 0xa9, 0x29, 0xe5,
-	0x05, 0x53, 0xea, 0x92, 0x4a, 0x4e, 0xba, 0x03, 0xcd, 0x38, 0x42, 0xf6, 0x08, 0x23, 0xea, 0x50,
-	0x77, 0x34, 0xe9, 0x2e, 0xc9, 0x03, 0x28, 0x8e, 0xd0, 0xa1, 0xc6, 0x19, 0xf7, 0x60, 0x89, 0xe7,
-	0x96, 0xa8, 0xbb, 0x2c, 0xce, 0xba, 0xe7, 0xb2, 0x2c, 0x85, 0xa9, 0x5b, 0xe2, 0x77, 0x3f, 0x60,
-	0x74, 0x62, 0x49, 0xd2, 0xde, 0x3b, 0x00, 0x29, 0xd2, 0x58, 0x81, 0xd2, 0x39, 0x9a, 0xa8, 0x38,
-	0xe4, 0x9f, 0xdc, 0x39, 0x17, 0xce, 0x38, 0xd6, 0x5e, 0x97, 0xc0, 0x7b, 0xc5, 0x77, 0x0a, 0xa6,
-	0x0b, 0xed, 0xdd, 0xf1, 0x39, 0x26, 0x99, 0xe9, 0x6b, 0xb0, 0xe4, 0x3b, 0x5f, 0x10, 0xaa, 0x3d,
-	0x29, 0x00, 0x81, 0xc5, 0x01, 0xa1, 0x9a, 0x85, 0x00, 0x8c, 0x16, 0x14, 0x49, 0x28, 0xfc, 0x55,
-	0xb3, 0x8a, 0x24, 0x4c, 0x05, 0x95, 0x33, 0x82, 0xcc, 0x7f, 0x96, 0x01, 0x52, 0x29, 0x86, 0x05,
-	0x3d, 0x4c, 0xec, 0x08, 0x51, 0x7e, 0xbe, 0xdb, 0x83, 0x09, 0x43, 0x91, 0x4d, 0x91, 0x1b, 0xd3,
-	0x08, 0x5f, 0xf0, 0xf5, 0xe3, 0x66, 0xdf, 0x90, 0x66, 0x4f, 0xe9, 0x66, 0xdd, 0xc4, 0xe4, 0x44,
-	0xce, 0xdb, 0xe5, 0xd3, 0x2c, 0x3d, 0xcb, 0x38, 0x82, 0x1b, 0x29, 0x4f, 0x2f, 0xc3, 0xae, 0x78,
-	0x15, 0xbb, 0xd5, 0x84, 0x9d, 0x97, 0xb2, 0xda, 0x87, 0x55, 0x4c, 0xec, 0x2f, 0x63, 0x14, 0xe7,
-	0x18, 0x95, 0xae, 0x62, 0xd4, 0xc1, 0xe4, 0x87, 0x62, 0x42, 0xca, 0xa6, 0x0f, 0xb7, 0x32, 0x56,
-	0xf2, 0x70, 0xcf, 0x30, 0x2b, 0x5f, 0xc5, 0x6c, 0x3d, 0xd1, 0x8a, 0xe7, 0x83, 0x94, 0xe3, 0x47,
-	0xb0, 0x8e, 0x89, 0xfd, 0xd8, 0xc1, 0x6c, 0x9a, 0xdd, 0xd2, 0xb7, 0x18, 0xc9, 0x4f, 0xb4, 0x3c,
-	0x2f, 0x69, 0xa4, 0x8f, 0xe8, 0x30, 0x67, 0xe4, 0xf2, 0xb7, 0x18, 0x79, 0x2c, 0x26, 0xa4, 0x6c,
-	0x76, 0xa0, 0x83, 0xc9, 0xb4, 0x36, 0x95, 0xab, 0x98, 0xb4, 0x31, 0xc9, 0x6b, 0xb2, 0x0b, 0x9d,
-	0x08, 0xb9, 0x8c, 0xd0, 0xec, 0x26, 0xa8, 0x5e, 0xc5, 0x62, 0x45, 0xd1, 0x27, 0x3c, 0xcc, 0x9f,
-	0x42, 0xe3, 0x30, 0x1e, 0x22, 0x36, 0x1e, 0x24, 0xc9, 0xe0, 0xa9, 0xe5, 0x1f, 0xf3, 0xdf, 0x45,
-	0xa8, 0xef, 0x0d, 0x29, 0x89, 0xc3, 0x5c, 0x4e, 0x96, 0x41, 0x3a, 0x9d, 0x93, 0x05, 0x89, 0xc8,
-	0xc9, 0x92, 0xf8, 0x6d, 0x68, 0xf8, 0x22, 0x74, 0x15, 0xbd, 0xcc, 0x43, 0x9d, 0x99, 0xa0, 0xb6,
-	0xea, 0x7e, 0x26, 0x99, 0x6d, 0x01, 0x84, 0xd8, 0x8b, 0xd4, 0x1c, 0x99, 0x8e, 0xda, 0xaa, 0xdc,
-	0xd2, 0x29, 0xda, 0xaa, 0x85, 0x49, 0xb6, 0x7e, 0x13, 0xea, 0x03, 0xee, 0x24, 0x35, 0x21, 0x97,
-	0x8c, 0x52, 0xef, 0x59, 0x30, 0x48, 0x83, 0xf0, 0x10, 0x9a, 0x23, 0xe9, 0x32, 0x35, 0x49, 0xee,
-	0xa1, 0x3b, 0xca, 0x92, 0xd4, 0xde, 0xad, 0xac, 0x67, 0xe5, 0x02, 0x34, 0x46, 0x19, 0x54, 0xef,
-	0x04, 0x3a, 0x33, 0x24, 0x73, 0x72, 0xd0, 0x66, 0x36, 0x07, 0xd5, 0xef, 0x19, 0x52, 0x50, 0x76,
-	0x66, 0x36, 0x2f, 0xfd, 0xb6, 0x08, 0x8d, 0x4f, 0x11, 0x7b, 0x4c, 0xe8, 0xb9, 0xd4, 0xd7, 0x80,
-	0x72, 0xe0, 0xf8, 0x48, 0x71, 0x14, 0xdf, 0xc6, 0x2d, 0xa8, 0xd2, 0x4b, 0x99, 0x40, 0xd4, 0x7a,
-	0x56, 0xe8, 0xa5, 0x48, 0x0c, 0xc6, 0xf3, 0x00, 0xf4, 0xd2, 0x0e, 0x1d, 0xf7, 0x1c, 0x29, 0x0f,
-	0x96, 0xad, 0x1a, 0xbd, 0xec, 0x4b, 0x04, 0xdf, 0x0a, 0xf4, 0xd2, 0x46, 0x94, 0x12, 0x1a, 0xa9,
-	0x5c, 0x55, 0xa5, 0x97, 0xfb, 0x02, 0x56, 0x73, 0x3d, 0x4a, 0xc2, 0x10, 0x79, 0x22, 0x47, 0x8b,
-	0xb9, 0x0f, 0x25, 0x82, 0x4b, 0x65, 0x5a, 0xea, 0xb2, 0x94, 0xca, 0x52, 0xa9, 0x2c, 0x95, 0x5a,
-	0x91, 0x33, 0x59, 0x56, 0x2a, 0x4b, 0xa4, 0x56, 0xa5, 0x54, 0x96, 0x91, 0xca, 0x52, 0xa9, 0x35,
-	0x3d, 0x57, 0x49, 0x35, 0x7f, 0x53, 0x80, 0xf5, 0xe9, 0xc2, 0x4f, 0xd5, 0xa6, 0x6f, 0x43, 0xc3,
-	0x15, 0xeb, 0x95, 0xdb, 0x93, 0x9d, 0x99, 0x95, 0xb4, 0xea, 0x6e, 0x66, 0x1b, 0xdf, 0x87, 0x66,
-	0x20, 0x1d, 0x9c, 0x6c, 0xcd, 0x52, 0xba, 0x2e, 0x59, 0xdf, 0x5b, 0x8d, 0x20, 0x03, 0x99, 0x1e,
-	0x18, 0x9f, 0x53, 0xcc, 0xd0, 0x09, 0xa3, 0xc8, 0xf1, 0x9f, 0x46, 0x75, 0x6f, 0x40, 0x59, 0x54,
-	0x2b, 0x7c, 0x99, 0x1a, 0x96, 0xf8, 0x36, 0x5f, 0x86, 0xd5, 0x9c, 0x14, 0x65, 0xeb, 0x0a, 0x94,
-	0xc6, 0x28, 0x10, 0xdc, 0x9b, 0x16, 0xff, 0x34, 0x1d, 0xe8, 0x58, 0xc8, 0xf1, 0x9e, 0x9e, 0x36,
-	0x4a, 0x44, 0x29, 0x15, 0xb1, 0x09, 0x46, 0x56, 0x84, 0x52, 0x45, 0x6b, 0x5d, 0xc8, 0x68, 0xfd,
-	0x08, 0x3a, 0x7b, 0x63, 0x12, 0xa1, 0x13, 0xe6, 0xe1, 0xe0, 0x69, 0xb4, 0x23, 0xbf, 0x80, 0xd5,
-	0xcf, 0xd8, 0xe4, 0x73, 0xce, 0x2c, 0xc2, 0x5f, 0xa1, 0xa7, 0x64, 0x1f, 0x25, 0x8f, 0xb5, 0x7d,
-	0x94, 0x3c, 0xe6, 0xcd, 0x8d, 0x4b, 0xc6, 0xb1, 0x1f, 0x88, 0x50, 0x68, 0x5a, 0x0a, 0x32, 0x77,
-	0xa1, 0x21, 0x6b, 0xe8, 0x63, 0xe2, 0xc5, 0x63, 0x34, 0x37, 0x06, 0x37, 0x00, 0x42, 0x87, 0x3a,
-	0x3e, 0x62, 0x88, 0xca, 0x3d, 0x54, 0xb3, 0x32, 0x18, 0xf3, 0x77, 0x45, 0x58, 0x93, 0xf7, 0x0d,
-	0x27, 0xb2, 0xcd, 0xd6, 0x26, 0xf4, 0xa0, 0x3a, 0x22, 0x11, 0xcb, 0x30, 0x4c, 0x60, 0xae, 0x22,
-	0xef, 0xcf, 0x25, 0x37, 0xfe, 0x99, 0xbb, 0x04, 0x28, 0x5d, 0x7d, 0x09, 0x30, 0xd3, 0xe6, 0x97,
-	0x67, 0xdb, 0x7c, 0x1e, 0x6d, 0x9a, 0x08, 0xcb, 0x18, 0xaf, 0x59, 0x35, 0x85, 0x39, 0xf2, 0x8c,
-	0xbb, 0xd0, 0x1e, 0x72, 0x2d, 0xed, 0x11, 0x21, 0xe7, 0x76, 0xe8, 0xb0, 0x91, 0x08, 0xf5, 0x9a,
-	0xd5, 0x14, 0xe8, 0x43, 0x42, 0xce, 0xfb, 0x0e, 0x1b, 0x19, 0xef, 0x42, 0x4b, 0x95, 0x81, 0xbe,
-	0x70, 0x51, 0xa4, 0x0e, 0x3f, 0x15, 0x45, 0x59, 0xef, 0x59, 0xcd, 0xf3, 0x0c, 0x14, 0x99, 0x37,
-	0xe1, 0xc6, 0x43, 0x14, 0x31, 0x4a, 0x26, 0x79, 0xc7, 0x98, 0xdf, 0x07, 0x38, 0x0a, 0x18, 0xa2,
-	0x67, 0x8e, 0x8b, 0x22, 0xe3, 0x8d, 0x2c, 0xa4, 0x8a, 0xa3, 0x95, 0x2d, 0x79, 0xdd, 0x93, 0x0c,
-	0x58, 0x19, 0x1a, 0x73, 0x0b, 0x96, 0x2d, 0x12, 0xf3, 0x74, 0xf4, 0xa2, 0xfe, 0x52, 0xf3, 0x1a,
-	0x6a, 0x9e, 0x40, 0x5a, 0x6a, 0xcc, 0x3c, 0xd4, 0x2d, 0x6c, 0xca, 0x4e, 0x2d, 0xd1, 0x16, 0xd4,
-	0xb0, 0xc6, 0xa9, 0xac, 0x32, 0x2b, 0x3a, 0x25, 0x31, 0xdf, 0x87, 0x55, 0xc9, 0x49, 0x72, 0xd6,
-	0x6c, 0x5e, 0x84, 0x65, 0xaa, 0xd5, 0x28, 0xa4, 0xf7, 0x3c, 0x8a, 0x48, 0x8d, 0x71, 0x7f, 0x7c,
-	0x82, 0x23, 0x96, 0x1a, 0xa2, 0xfd, 0xb1, 0x0a, 0x1d, 0x3e, 0x90, 0xe3, 0x69, 0x7e, 0x08, 0x8d,
-	0x1d, 0xab, 0xff, 0x29, 0xc2, 0xc3, 0xd1, 0x80, 0x67, 0xcf, 0xef, 0xe5, 0x61, 0x65, 0xb0, 0xa1,
-	0xb4, 0xcd, 0x0c, 0x59, 0x39, 0x3a, 0xf3, 0x23, 0x58, 0xdf, 0xf1, 0xbc, 0x2c, 0x4a, 0x6b, 0xfd,
-	0x06, 0xd4, 0x82, 0x0c, 0xbb, 0xcc, 0x99, 0x95, 0xa3, 0x4e, 0x89, 0xcc, 0x9f, 0xc1, 0xea, 0xa3,
-	0x60, 0x8c, 0x03, 0xb4, 0xd7, 0x3f, 0x3d, 0x46, 0x49, 0x2e, 0x32, 0xa0, 0xcc, 0x6b, 0x36, 0xc1,
-	0xa3, 0x6a, 0x89, 0x6f, 0x1e, 0x9c, 0xc1, 0xc0, 0x76, 0xc3, 0x38, 0x52, 0x97, 0x3d, 0xcb, 0xc1,
-	0x60, 0x2f, 0x8c, 0x23, 0x7e, 0xb8, 0xf0, 0xe2, 0x82, 0x04, 0xe3, 0x89, 0x88, 0xd0, 0xaa, 0x55,
-	0x71, 0xc3, 0xf8, 0x51, 0x30, 0x9e, 0x98, 0xdf, 0x15, 0x1d, 0x38, 0x42, 0x9e, 0xe5, 0x04, 0x1e,
-	0xf1, 0x1f, 0xa2, 0x8b, 0x8c, 0x84, 0xa4, 0xdb, 0xd3, 0x99, 0xe8, 0xeb, 0x02, 0x34, 0x76, 0x86,
-	0x28, 0x60, 0x0f, 0x11, 0x73, 0xf0, 0x58, 0x74, 0x74, 0x17, 0x88, 0x46, 0x98, 0x04, 0x2a, 0xdc,
-	0x34, 0xc8, 0x1b, 0x72, 0x1c, 0x60, 0x66, 0x7b, 0x0e, 0xf2, 0x49, 0x20, 0xb8, 0x54, 0x2d, 0xe0,
-	0xa8, 0x87, 0x02, 0x63, 0xbc, 0x0c, 0x6d, 0x79, 0x19, 0x67, 0x8f, 0x9c, 0xc0, 0x1b, 0xf3, 0x40,
-	0x2f, 0x89, 0xd0, 0x6c, 0x49, 0xf4, 0xa1, 0xc2, 0x1a, 0xaf, 0xc0, 0x8a, 0x0a, 0xc3, 0x94, 0xb2,
-	0x2c, 0x28, 0xdb, 0x0a, 0x9f, 0x23, 0x8d, 0xc3, 0x90, 0x50, 0x16, 0xd9, 0x11, 0x72, 0x5d, 0xe2,
-	0x87, 0xaa, 0x1d, 0x6a, 0x6b, 0xfc, 0x89, 0x44, 0x9b, 0x43, 0x58, 0x3d, 0xe0, 0x76, 0x2a, 0x4b,
-	0xd2, 0x6d, 0xd5, 0xf2, 0x91, 0x6f, 0x0f, 0xc6, 0xc4, 0x3d, 0xb7, 0x79, 0x72, 0x54, 0x1e, 0xe6,
-	0x05, 0xd7, 0x2e, 0x47, 0x9e, 0xe0, 0xaf, 0x44, 0xe7, 0xcf, 0xa9, 0x46, 0x84, 0x85, 0xe3, 0x78,
-	0x68, 0x87, 0x94, 0x0c, 0x90, 0x32, 0xb1, 0xed, 0x23, 0xff, 0x50, 0xe2, 0xfb, 0x1c, 0x6d, 0xfe,
-	0xa9, 0x00, 0x6b, 0x79, 0x49, 0x2a, 0xd5, 0x6f, 0xc3, 0x5a, 0x5e, 0x94, 0x3a, 0xfe, 0x65, 0x79,
-	0xd9, 0xc9, 0x0a, 0x94, 0x85, 0xc0, 0x7d, 0x68, 0x8a, 0xab, 0x5b, 0xdb, 0x93, 0x9c, 0xf2, 0x45,
-	0x4f, 0x76, 0x5d, 0xac, 0x86, 0x93, 0x5d, 0xa5, 0x77, 0xe1, 0x96, 0x32, 0xdf, 0x9e, 0x55, 0x5b,
-	0x6e, 0x88, 0x75, 0x45, 0x70, 0x3c, 0xa5, 0xfd, 0x27, 0xd0, 0x4d, 0x51, 0xbb, 0x13, 0x81, 0x4c,
-	0x37, 0xf3, 0xea, 0x94, 0xb1, 0x3b, 0x9e, 0x47, 0x45, 0x94, 0x94, 0xad, 0x79, 0x43, 0xe6, 0x03,
-	0xb8, 0x79, 0x82, 0x98, 0xf4, 0x86, 0xc3, 0x54, 0x27, 0x22, 0x99, 0xad, 0x40, 0xe9, 0x04, 0xb9,
-	0xc2, 0xf8, 0x92, 0xc5, 0x3f, 0xf9, 0x06, 0x3c, 0x8d, 0x90, 0x2b, 0xac, 0x2c, 0x59, 0xe2, 0xdb,
-	0x0c, 0xa1, 0xf2, 0xe1, 0xc9, 0x01, 0xaf, 0x37, 0xf8, 0xa6, 0x96, 0xf5, 0x89, 0x3a, 0x8b, 0x9a,
-	0x56, 0x45, 0xc0, 0x47, 0x9e, 0xf1, 0x11, 0xac, 0xca, 0x21, 0x77, 0xe4, 0x04, 0x43, 0x64, 0x87,
-	0x64, 0x8c, 0x5d, 0xb9, 0xf5, 0x5b, 0xf7, 0x7a, 0x2a, 0x7c, 0x15, 0x9f, 0x3d, 0x41, 0xd2, 0x17,
-	0x14, 0x56, 0x67, 0x38, 0x8d, 0x32, 0xff, 0x51, 0x80, 0x8a, 0x3a, 0x0e, 0xf8, 0x91, 0xe6, 0x51,
-	0x7c, 0x81, 0xa8, 0xda, 0xec, 0x0a, 0x32, 0x5e, 0x82, 0x96, 0xfc, 0xb2, 0x49, 0xc8, 0x30, 0x49,
-	0x0e, 0x99, 0xa6, 0xc4, 0x3e, 0x92, 0x48, 0x71, 0xdd, 0x27, 0x2e, 0xdc, 0x54, 0x6f, 0xab, 0x20,
-	0x8e, 0x3f, 0x8b, 0xb8, 0x52, 0xe2, 0x50, 0xa9, 0x59, 0x0a, 0xe2, 0xc1, 0xa5, 0xf9, 0x2d, 0x09,
-	0x7e, 0x1a, 0xe4, 0xc1, 0xe5, 0x93, 0x38, 0x60, 0x76, 0x48, 0x70, 0xc0, 0xd4, 0x29, 0x02, 0x02,
-	0xd5, 0xe7, 0x18, 0x63, 0x13, 0xaa, 0x67, 0x91, 0x2d, 0xac, 0x11, 0x15, 0x63, 0x72, 0xb2, 0x29,
-	0xab, 0xad, 0xca, 0x59, 0x24, 0x3e, 0xcc, 0x5f, 0x17, 0x60, 0x59, 0x5e, 0x8e, 0xf3, 0xbe, 0x3b,
-	0x39, 0xf5, 0x8b, 0x58, 0x54, 0x50, 0x42, 0x2b, 0x79, 0xd2, 0x8b, 0x6f, 0x9e, 0x63, 0x2e, 0x7c,
-	0x79, 0x76, 0x29, 0x23, 0x2e, 0x7c, 0x71, 0x68, 0xbd, 0x04, 0xad, 0xb4, 0x78, 0x10, 0xe3, 0xd2,
-	0x98, 0x66, 0x82, 0x15, 0x64, 0x0b, 0x6d, 0x32, 0x7f, 0x0c, 0x90, 0x5e, 0x12, 0xf3, 0xed, 0x10,
-	0x27, 0xca, 0xf0, 0x4f, 0x8e, 0x19, 0x26, 0x65, 0x07, 0xff, 0x34, 0xee, 0x42, 0xcb, 0xf1, 0x3c,
-	0xcc, 0xa7, 0x3b, 0xe3, 0x03, 0xec, 0x25, 0x09, 0x24, 0x8f, 0x35, 0xff, 0x5a, 0x80, 0xf6, 0x1e,
-	0x09, 0x27, 0x1f, 0xe2, 0x31, 0xca, 0x64, 0x37, 0xa1, 0xa4, 0xaa, 0x3a, 0xf8, 0x37, 0xaf, 0xa4,
-	0xcf, 0xf0, 0x18, 0xc9, 0xb0, 0x97, 0xbb, 0xae, 0xca, 0x11, 0x22, 0xe4, 0xf5, 0x60, 0x72, 0x25,
-	0xd8, 0x94, 0x83, 0xc7, 0xc4, 0x13, 0x3d, 0x83, 0x87, 0xa9, 0x9d, 0x5c, 0x00, 0x36, 0xad, 0x8a,
-	0x87, 0xa9, 0x18, 0x52, 0x86, 0x2c, 0x89, 0x0b, 0xde, 0xac, 0x21, 0xcb, 0x12, 0xc3, 0x0d, 0x59,
-	0x87, 0x65, 0x72, 0x76, 0x16, 0x21, 0x26, 0xd6, 0xaa, 0x64, 0x29, 0x28, 0x49, 0xc1, 0xd5, 0x4c,
-	0x0a, 0x5e, 0x03, 0xe3, 0x00, 0xb1, 0x47, 0x8f, 0x8e, 0xf7, 0x2f, 0x50, 0xc0, 0xf4, 0xc9, 0xf5,
-	0x3a, 0x54, 0x35, 0xea, 0xbf, 0xb9, 0x3a, 0x7d, 0x15, 0x5a, 0x3b, 0x9e, 0x77, 0xf2, 0xd8, 0x09,
-	0xb5, 0x3f, 0xba, 0x50, 0xe9, 0xef, 0x1d, 0xf5, 0xa5, 0x4b, 0x4a, 0xdc, 0x00, 0x05, 0xf2, 0x93,
-	0xf2, 0x00, 0xb1, 0x63, 0xc4, 0x28, 0x76, 0x93, 0x93, 0xf2, 0x0e, 0x54, 0x14, 0x86, 0xcf, 0xf4,
-	0xe5, 0xa7, 0x3e, 0x02, 0x14, 0x68, 0xfe, 0x00, 0x8c, 0x1f, 0xf1, 0x9a, 0x0f, 0xc9, 0x82, 0x5f,
-	0x49, 0x7a, 0x15, 0x3a, 0x17, 0x02, 0x6b, 0xcb, 0x62, 0x28, 0xb3, 0x0c, 0x6d, 0x39, 0x20, 0xf2,
-	0x83, 0x90, 0x7d, 0x0a, 0xab, 0xb2, 0x44, 0x95, 0x7c, 0xae, 0xc1, 0x82, 0xfb, 0x30, 0x59, 0xcf,
-	0xb2, 0x25, 0xbe, 0xef, 0xfd, 0xb9, 0xa3, 0x8e, 0x31, 0x75, 0x23, 0x62, 0x1c, 0x40, 0x7b, 0xea,
-	0xf9, 0xca, 0x50, 0x57, 0x64, 0xf3, 0x5f, 0xb5, 0x7a, 0xeb, 0x5b, 0xf2, 0x39, 0x6c, 0x4b, 0x3f,
-	0x87, 0x6d, 0xed, 0xfb, 0x21, 0x9b, 0x18, 0xfb, 0xd0, 0xca, 0x3f, 0xf4, 0x18, 0xcf, 0xea, 0x8a,
-	0x72, 0xce, 0xf3, 0xcf, 0x42, 0x36, 0x07, 0xd0, 0x9e, 0x7a, 0xf3, 0xd1, 0xfa, 0xcc, 0x7f, 0x0a,
-	0x5a, 0xc8, 0xe8, 0x01, 0xd4, 0x33, 0x8f, 0x3c, 0x46, 0x57, 0x32, 0x99, 0x7d, 0xf7, 0x59, 0xc8,
-	0x60, 0x0f, 0x9a, 0xb9, 0x77, 0x17, 0xa3, 0xa7, 0xec, 0x99, 0xf3, 0x18, 0xb3, 0x90, 0xc9, 0x2e,
-	0xd4, 0x33, 0xcf, 0x1f, 0x5a, 0x8b, 0xd9, 0x37, 0x96, 0xde, 0xad, 0x39, 0x23, 0xea, 0xb4, 0x3c,
-	0x80, 0xf6, 0xd4, 0x9b, 0x88, 0x76, 0xc9, 0xfc, 0xa7, 0x92, 0x85, 0xca, 0x7c, 0x2c, 0x96, 0x28,
-	0xd3, 0xf2, 0x66, 0x96, 0x68, 0xf6, 0x05, 0xa4, 0xf7, 0xdc, 0xfc, 0x41, 0xa5, 0xd5, 0x3e, 0xb4,
-	0xf2, 0x8f, 0x1f, 0x9a, 0xd9, 0xdc, 0x27, 0x91, 0xab, 0xd7, 0x3b, 0xf7, 0x0e, 0x92, 0xae, 0xf7,
-	0xbc, 0xe7, 0x91, 0x85, 0x8c, 0x76, 0x00, 0x54, 0x83, 0xeb, 0xe1, 0x20, 0x71, 0xf4, 0x4c, 0x63,
-	0x9d, 0x38, 0x7a, 0x4e, 0x33, 0xfc, 0x00, 0x40, 0xf6, 0xa5, 0x1e, 0x89, 0x99, 0x71, 0x53, 0xab,
-	0x31, 0xd5, 0x0c, 0xf7, 0xba, 0xb3, 0x03, 0x33, 0x0c, 0x10, 0xa5, 0xd7, 0x61, 0xf0, 0x01, 0x40,
-	0xda, 0xef, 0x6a, 0x06, 0x33, 0x1d, 0xf0, 0x15, 0x3e, 0x68, 0x64, 0xbb, 0x5b, 0x43, 0xd9, 0x3a,
-	0xa7, 0xe3, 0xbd, 0x82, 0x45, 0x7b, 0xaa, 0x7b, 0xc9, 0x6f, 0xb6, 0xe9, 0xa6, 0xa6, 0x37, 0xd3,
-	0xc1, 0x18, 0xf7, 0xa1, 0x91, 0x6d, 0x5b, 0xb4, 0x16, 0x73, 0x5a, 0x99, 0x5e, 0xae, 0x75, 0x31,
-	0x1e, 0x40, 0x2b, 0xdf, 0xb2, 0xe8, 0x2d, 0x35, 0xb7, 0x91, 0xe9, 0xa9, 0x0b, 0xb9, 0x0c, 0xf9,
-	0x5b, 0x00, 0x69, 0x6b, 0xa3, 0xdd, 0x37, 0xd3, 0xec, 0x4c, 0x49, 0x3d, 0x80, 0xf6, 0x54, 0xcb,
-	0xa2, 0x2d, 0x9e, 0xdf, 0xc9, 0x2c, 0x74, 0xdd, 0xdb, 0x00, 0xe9, 0x71, 0xa1, 0xa5, 0xcf, 0x1c,
-	0x20, 0xbd, 0xa6, 0xbe, 0xac, 0x94, 0x74, 0x7b, 0xd0, 0xcc, 0xf5, 0xf3, 0x3a, 0xcd, 0xcc, 0x6b,
-	0xf2, 0xaf, 0x4a, 0xbe, 0xf9, 0xe6, 0x57, 0x7b, 0x6e, 0x6e, 0x4b, 0x7c, 0xd5, 0xfe, 0xc9, 0x76,
-	0x5c, 0x7a, 0xe5, 0xe6, 0x74, 0x61, 0xdf, 0x12, 0xcf, 0xd9, 0xae, 0x2a, 0x13, 0xcf, 0x73, 0x9a,
-	0xad, 0x85, 0x8c, 0x0e, 0xa1, 0x7d, 0xa0, 0x0b, 0x66, 0x55, 0xcc, 0x2b, 0x75, 0xe6, 0x34, 0x2f,
-	0xbd, 0xde, 0xbc, 0x21, 0x15, 0x54, 0x1f, 0x43, 0x67, 0xa6, 0x90, 0x37, 0x36, 0x92, 0x2b, 0xe3,
-	0xb9, 0x15, 0xfe, 0x42, 0xb5, 0x8e, 0x60, 0x65, 0xba, 0x8e, 0x37, 0x9e, 0x57, 0x89, 0x72, 0x7e,
-	0x7d, 0xbf, 0x90, 0xd5, 0xbb, 0x50, 0xd5, 0xb5, 0x99, 0xa1, 0xae, 0xe6, 0xa7, 0x6a, 0xb5, 0x85,
-	0x53, 0xef, 0x43, 0x3d, 0x53, 0x0a, 0xe9, 0x6c, 0x37, 0x5b, 0x1d, 0xf5, 0xd4, 0x4d, 0x7a, 0x42,
-	0x79, 0x1f, 0x2a, 0xaa, 0xfc, 0x31, 0xd6, 0x92, 0x4d, 0x9e, 0xa9, 0x86, 0xae, 0xda, 0x61, 0x07,
-	0x88, 0x65, 0x8a, 0x1a, 0x2d, 0x74, 0xb6, 0xce, 0xd1, 0x29, 0x36, 0x37, 0xa2, 0xd6, 0x62, 0x07,
-	0x1a, 0xd9, 0xb2, 0x46, 0x2f, 0xe9, 0x9c, 0x52, 0x67, 0x91, 0x26, 0xbb, 0x97, 0x5f, 0x7f, 0xb3,
-	0xf1, 0xcc, 0xdf, 0xbf, 0xd9, 0x78, 0xe6, 0x57, 0x4f, 0x36, 0x0a, 0x5f, 0x3f, 0xd9, 0x28, 0xfc,
-	0xed, 0xc9, 0x46, 0xe1, 0x5f, 0x4f, 0x36, 0x0a, 0x3f, 0xf9, 0xf9, 0xff, 0xf8, 0x1f, 0x21, 0x1a,
-	0x07, 0x0c, 0xfb, 0x68, 0xfb, 0x02, 0x53, 0x96, 0x19, 0x0a, 0xcf, 0x87, 0xf2, 0x8f, 0x42, 0x99,
-	0xff, 0x0f, 0x71, 0x2d, 0x07, 0xcb, 0x02, 0x7e, 0xeb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6c,
-	0xf4, 0x1d, 0x49, 0x8c, 0x24, 0x00, 0x00,
+func (m *WatchableEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *CreateContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WatchableEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchableEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *WatchableEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchableEntry.Merge(m, src)
+}
+func (m *WatchableEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchableEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchableEntry.DiscardUnknown(m)
 }
 
-func (m *CreateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_WatchableEntry proto.InternalMessageInfo
+
+type SyncWatchableMountRequest struct {
+	// Full VM guest path of the watchable mount, as given by its
+	// watchable-sync storage.
+	MountPoint string `protobuf:"bytes,1,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	// Entries created or updated, in the order they are to be applied.
+	Entries []*WatchableEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Removed are the paths, relative to the root of the mount, of the
+	// removed entries.
+	Removed              []string `protobuf:"bytes,3,rep,name=removed,proto3" json:"removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.SandboxPidns {
-		i--
-		if m.SandboxPidns {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.OCI != nil {
-		{
-			size, err := m.OCI.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Storages) > 0 {
-		for iNdEx := len(m.Storages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Storages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Devices) > 0 {
-		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Devices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.StringUser != nil {
-		{
-			size, err := m.StringUser.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
+func (m *SyncWatchableMountRequest) Reset()      { *m = SyncWatchableMountRequest{} }
+func (*SyncWatchableMountRequest) ProtoMessage() {}
+func (*SyncWatchableMountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{67}
+}
+func (m *SyncWatchableMountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWatchableMountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWatchableMountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ExecId) > 0 {
-		i -= len(m.ExecId)
-		copy(dAtA[i:], m.ExecId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *StartContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SyncWatchableMountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWatchableMountRequest.Merge(m, src)
 }
-
-func (m *StartContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SyncWatchableMountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWatchableMountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWatchableMountRequest.DiscardUnknown(m)
 }
 
-func (m *StartContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_SyncWatchableMountRequest proto.InternalMessageInfo
+
+// SetPolicyRequest replaces the policy restricting the agent API. The policy
+// is a document of the format of the agent configuration file, holding only
+// its endpoints section.
+type SetPolicyRequest struct {
+	Policy               string   `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetPolicyRequest) Reset()      { *m = SetPolicyRequest{} }
+func (*SetPolicyRequest) ProtoMessage() {}
+func (*SetPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{68}
+}
+func (m *SetPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *SetPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPolicyRequest.Merge(m, src)
+}
+func (m *SetPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPolicyRequest.DiscardUnknown(m)
 }
 
-func (m *RemoveContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SetPolicyRequest proto.InternalMessageInfo
+
+type GetPolicyRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Timeout != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *GetPolicyRequest) Reset()      { *m = GetPolicyRequest{} }
+func (*GetPolicyRequest) ProtoMessage() {}
+func (*GetPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{69}
 }
-
-func (m *ExecProcessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *GetPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPolicyRequest.Merge(m, src)
+}
+func (m *GetPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPolicyRequest.DiscardUnknown(m)
 }
 
-func (m *ExecProcessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_GetPolicyRequest proto.InternalMessageInfo
+
+// Policy is the active policy of the agent API, empty when all the endpoints
+// are allowed.
+type Policy struct {
+	Policy               string   `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ExecProcessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Process != nil {
-		{
-			size, err := m.Process.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.StringUser != nil {
-		{
-			size, err := m.StringUser.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
+func (m *Policy) Reset()      { *m = Policy{} }
+func (*Policy) ProtoMessage() {}
+func (*Policy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{70}
+}
+func (m *Policy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Policy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Policy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ExecId) > 0 {
-		i -= len(m.ExecId)
-		copy(dAtA[i:], m.ExecId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SignalProcessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Policy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Policy.Merge(m, src)
 }
-
-func (m *SignalProcessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Policy) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *SignalProcessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Signal != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Signal))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ExecId) > 0 {
-		i -= len(m.ExecId)
-		copy(dAtA[i:], m.ExecId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *Policy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Policy.DiscardUnknown(m)
 }
 
-func (m *WaitProcessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_Policy proto.InternalMessageInfo
 
-func (m *WaitProcessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ExecuteHooksRequest runs hooks in the guest, with the state of a container
+// on their standard input like the OCI hooks. The container may be removed,
+// its state then only holds its ID and the stopped status.
+type ExecuteHooksRequest struct {
+	ContainerId          string   `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Hooks                []*Hook  `protobuf:"bytes,2,rep,name=hooks,proto3" json:"hooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WaitProcessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ExecId) > 0 {
-		i -= len(m.ExecId)
-		copy(dAtA[i:], m.ExecId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *ExecuteHooksRequest) Reset()      { *m = ExecuteHooksRequest{} }
+func (*ExecuteHooksRequest) ProtoMessage() {}
+func (*ExecuteHooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{71}
 }
-
-func (m *WaitProcessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExecuteHooksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteHooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteHooksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *WaitProcessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExecuteHooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteHooksRequest.Merge(m, src)
+}
+func (m *ExecuteHooksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteHooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteHooksRequest.DiscardUnknown(m)
 }
 
-func (m *WaitProcessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_ExecuteHooksRequest proto.InternalMessageInfo
+
+// GetDiagnosticsRequest collects debugging artifacts of the guest: /proc
+// snapshots and the kernel log, and if container_id is set, the /proc
+// snapshots of the processes of the container and its core dumps.
+type GetDiagnosticsRequest struct {
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Maximum size of the data of the files, or 0 for the default of the agent.
+	MaxSize uint64 `protobuf:"varint,2,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	// If set, only this core dump of the container is read, from offset,
+	// e.g. to complete a truncated one.
+	CoreDump             string   `protobuf:"bytes,3,opt,name=core_dump,json=coreDump,proto3" json:"core_dump,omitempty"`
+	Offset               uint64   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetDiagnosticsRequest) Reset()      { *m = GetDiagnosticsRequest{} }
+func (*GetDiagnosticsRequest) ProtoMessage() {}
+func (*GetDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{72}
+}
+func (m *GetDiagnosticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDiagnosticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDiagnosticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *GetDiagnosticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDiagnosticsRequest.Merge(m, src)
+}
+func (m *GetDiagnosticsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDiagnosticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDiagnosticsRequest.DiscardUnknown(m)
 }
 
-func (m *UpdateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_GetDiagnosticsRequest proto.InternalMessageInfo
+
+type DiagnosticsFile struct {
+	// Relative path of the file in the bundle.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Set if the data was cut to fit max_size.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Size of the whole file, of which data may only be a part.
+	Size_                uint64   `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Resources != nil {
-		{
-			size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
+func (m *DiagnosticsFile) Reset()      { *m = DiagnosticsFile{} }
+func (*DiagnosticsFile) ProtoMessage() {}
+func (*DiagnosticsFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{73}
+}
+func (m *DiagnosticsFile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiagnosticsFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiagnosticsFile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *StatsContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DiagnosticsFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiagnosticsFile.Merge(m, src)
 }
-
-func (m *StatsContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DiagnosticsFile) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiagnosticsFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiagnosticsFile.DiscardUnknown(m)
 }
 
-func (m *StatsContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_DiagnosticsFile proto.InternalMessageInfo
+
+type Diagnostics struct {
+	Files                []*DiagnosticsFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *PauseContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Diagnostics) Reset()      { *m = Diagnostics{} }
+func (*Diagnostics) ProtoMessage() {}
+func (*Diagnostics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{74}
+}
+func (m *Diagnostics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Diagnostics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Diagnostics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *PauseContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Diagnostics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Diagnostics.Merge(m, src)
+}
+func (m *Diagnostics) XXX_Size() int {
+	return m.Size()
+}
+func (m *Diagnostics) XXX_DiscardUnknown() {
+	xxx_messageInfo_Diagnostics.DiscardUnknown(m)
 }
 
-func (m *PauseContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_Diagnostics proto.InternalMessageInfo
+
+// SetLogLevelRequest changes the log level of the agent, until it is
+// changed again or the agent restarts.
+type SetLogLevelRequest struct {
+	// One of "trace", "debug", "info", "warn", "error" and "critical".
+	Level                string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResumeContainerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetLogLevelRequest) Reset()      { *m = SetLogLevelRequest{} }
+func (*SetLogLevelRequest) ProtoMessage() {}
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{75}
+}
+func (m *SetLogLevelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetLogLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetLogLevelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ResumeContainerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetLogLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelRequest.Merge(m, src)
+}
+func (m *SetLogLevelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetLogLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelRequest.DiscardUnknown(m)
 }
 
-func (m *ResumeContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_SetLogLevelRequest proto.InternalMessageInfo
+
+// ReadKernelLogRequest waits for the next records of the guest kernel log.
+// The first request returns the records since the guest booted, as far as
+// the kernel log buffer holds them.
+type ReadKernelLogRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CpuUsage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReadKernelLogRequest) Reset()      { *m = ReadKernelLogRequest{} }
+func (*ReadKernelLogRequest) ProtoMessage() {}
+func (*ReadKernelLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{76}
+}
+func (m *ReadKernelLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadKernelLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadKernelLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ReadKernelLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadKernelLogRequest.Merge(m, src)
+}
+func (m *ReadKernelLogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadKernelLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadKernelLogRequest.DiscardUnknown(m)
 }
 
-func (m *CpuUsage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ReadKernelLogRequest proto.InternalMessageInfo
+
+type KernelLogRecord struct {
+	// Syslog level and facility of the record.
+	Level    uint32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	Facility uint32 `protobuf:"varint,2,opt,name=facility,proto3" json:"facility,omitempty"`
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Time of the record since the guest booted, in microseconds.
+	TimestampUsec        uint64   `protobuf:"varint,4,opt,name=timestamp_usec,json=timestampUsec,proto3" json:"timestamp_usec,omitempty"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CpuUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.UsageInUsermode != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.UsageInUsermode))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.UsageInKernelmode != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.UsageInKernelmode))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.PercpuUsage) > 0 {
-		dAtA7 := make([]byte, len(m.PercpuUsage)*10)
-		var j6 int
-		for _, num := range m.PercpuUsage {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
+func (m *KernelLogRecord) Reset()      { *m = KernelLogRecord{} }
+func (*KernelLogRecord) ProtoMessage() {}
+func (*KernelLogRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{77}
+}
+func (m *KernelLogRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KernelLogRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KernelLogRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintAgent(dAtA, i, uint64(j6))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TotalUsage != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.TotalUsage))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ThrottlingData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *KernelLogRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KernelLogRecord.Merge(m, src)
 }
-
-func (m *ThrottlingData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *KernelLogRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *KernelLogRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_KernelLogRecord.DiscardUnknown(m)
 }
 
-func (m *ThrottlingData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_KernelLogRecord proto.InternalMessageInfo
+
+type KernelLog struct {
+	Records              []*KernelLogRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *KernelLog) Reset()      { *m = KernelLog{} }
+func (*KernelLog) ProtoMessage() {}
+func (*KernelLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{78}
+}
+func (m *KernelLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KernelLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KernelLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ThrottledTime != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.ThrottledTime))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *KernelLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KernelLog.Merge(m, src)
+}
+func (m *KernelLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *KernelLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_KernelLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KernelLog proto.InternalMessageInfo
+
+// SetGuestTimeSourceRequest starts chronyd in the guest, to keep the guest
+// clock in sync with the given time source.
+type SetGuestTimeSourceRequest struct {
+	// "ptp_kvm" for the clock of the host, through the KVM PTP clock, or
+	// "ntp" for the given NTP servers.
+	Source               string   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	NtpServers           []string `protobuf:"bytes,2,rep,name=ntp_servers,json=ntpServers,proto3" json:"ntp_servers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetGuestTimeSourceRequest) Reset()      { *m = SetGuestTimeSourceRequest{} }
+func (*SetGuestTimeSourceRequest) ProtoMessage() {}
+func (*SetGuestTimeSourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{79}
+}
+func (m *SetGuestTimeSourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetGuestTimeSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetGuestTimeSourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ThrottledPeriods != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.ThrottledPeriods))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *SetGuestTimeSourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGuestTimeSourceRequest.Merge(m, src)
+}
+func (m *SetGuestTimeSourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetGuestTimeSourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGuestTimeSourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetGuestTimeSourceRequest proto.InternalMessageInfo
+
+// StartTracingRequest starts the tracing of the agent, whose spans are sent
+// to the trace forwarder of the host through vsock.
+type StartTracingRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartTracingRequest) Reset()      { *m = StartTracingRequest{} }
+func (*StartTracingRequest) ProtoMessage() {}
+func (*StartTracingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{80}
+}
+func (m *StartTracingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartTracingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartTracingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Periods != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Periods))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *StartTracingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartTracingRequest.Merge(m, src)
+}
+func (m *StartTracingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartTracingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartTracingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartTracingRequest proto.InternalMessageInfo
+
+// StopTracingRequest stops the tracing of the agent, once its pending spans
+// are sent.
+type StopTracingRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopTracingRequest) Reset()      { *m = StopTracingRequest{} }
+func (*StopTracingRequest) ProtoMessage() {}
+func (*StopTracingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_712ce9a559fda969, []int{81}
+}
+func (m *StopTracingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopTracingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopTracingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StopTracingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopTracingRequest.Merge(m, src)
+}
+func (m *StopTracingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopTracingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopTracingRequest.DiscardUnknown(m)
 }
 
-func (m *CpuStats) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_StopTracingRequest proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*CreateContainerRequest)(nil), "grpc.CreateContainerRequest")
+	proto.RegisterType((*StartContainerRequest)(nil), "grpc.StartContainerRequest")
+	proto.RegisterType((*RemoveContainerRequest)(nil), "grpc.RemoveContainerRequest")
+	proto.RegisterType((*ExecProcessRequest)(nil), "grpc.ExecProcessRequest")
+	proto.RegisterType((*SignalProcessRequest)(nil), "grpc.SignalProcessRequest")
+	proto.RegisterType((*WaitProcessRequest)(nil), "grpc.WaitProcessRequest")
+	proto.RegisterType((*WaitProcessResponse)(nil), "grpc.WaitProcessResponse")
+	proto.RegisterType((*UpdateContainerRequest)(nil), "grpc.UpdateContainerRequest")
+	proto.RegisterType((*StatsContainerRequest)(nil), "grpc.StatsContainerRequest")
+	proto.RegisterType((*PauseContainerRequest)(nil), "grpc.PauseContainerRequest")
+	proto.RegisterType((*ResumeContainerRequest)(nil), "grpc.ResumeContainerRequest")
+	proto.RegisterType((*CpuUsage)(nil), "grpc.CpuUsage")
+	proto.RegisterType((*ThrottlingData)(nil), "grpc.ThrottlingData")
+	proto.RegisterType((*CpuStats)(nil), "grpc.CpuStats")
+	proto.RegisterType((*PidsStats)(nil), "grpc.PidsStats")
+	proto.RegisterType((*MemoryData)(nil), "grpc.MemoryData")
+	proto.RegisterType((*MemoryStats)(nil), "grpc.MemoryStats")
+	proto.RegisterMapType((map[string]uint64)(nil), "grpc.MemoryStats.StatsEntry")
+	proto.RegisterType((*BlkioStatsEntry)(nil), "grpc.BlkioStatsEntry")
+	proto.RegisterType((*BlkioStats)(nil), "grpc.BlkioStats")
+	proto.RegisterType((*HugetlbStats)(nil), "grpc.HugetlbStats")
+	proto.RegisterType((*CgroupStats)(nil), "grpc.CgroupStats")
+	proto.RegisterMapType((map[string]*HugetlbStats)(nil), "grpc.CgroupStats.HugetlbStatsEntry")
+	proto.RegisterType((*NetworkStats)(nil), "grpc.NetworkStats")
+	proto.RegisterType((*StatsContainerResponse)(nil), "grpc.StatsContainerResponse")
+	proto.RegisterType((*WriteStreamRequest)(nil), "grpc.WriteStreamRequest")
+	proto.RegisterType((*WriteStreamResponse)(nil), "grpc.WriteStreamResponse")
+	proto.RegisterType((*ReadStreamRequest)(nil), "grpc.ReadStreamRequest")
+	proto.RegisterType((*ReadStreamResponse)(nil), "grpc.ReadStreamResponse")
+	proto.RegisterType((*CloseStdinRequest)(nil), "grpc.CloseStdinRequest")
+	proto.RegisterType((*OpenProcessIORequest)(nil), "grpc.OpenProcessIORequest")
+	proto.RegisterType((*ProcessIOPorts)(nil), "grpc.ProcessIOPorts")
+	proto.RegisterType((*TtyWinResizeRequest)(nil), "grpc.TtyWinResizeRequest")
+	proto.RegisterType((*KernelModule)(nil), "grpc.KernelModule")
+	proto.RegisterType((*CreateSandboxRequest)(nil), "grpc.CreateSandboxRequest")
+	proto.RegisterType((*DestroySandboxRequest)(nil), "grpc.DestroySandboxRequest")
+	proto.RegisterType((*Interfaces)(nil), "grpc.Interfaces")
+	proto.RegisterType((*Routes)(nil), "grpc.Routes")
+	proto.RegisterType((*UpdateInterfaceRequest)(nil), "grpc.UpdateInterfaceRequest")
+	proto.RegisterType((*UpdateRoutesRequest)(nil), "grpc.UpdateRoutesRequest")
+	proto.RegisterType((*ListInterfacesRequest)(nil), "grpc.ListInterfacesRequest")
+	proto.RegisterType((*ListRoutesRequest)(nil), "grpc.ListRoutesRequest")
+	proto.RegisterType((*ARPNeighbors)(nil), "grpc.ARPNeighbors")
+	proto.RegisterType((*AddARPNeighborsRequest)(nil), "grpc.AddARPNeighborsRequest")
+	proto.RegisterType((*GetIPTablesRequest)(nil), "grpc.GetIPTablesRequest")
+	proto.RegisterType((*GetIPTablesResponse)(nil), "grpc.GetIPTablesResponse")
+	proto.RegisterType((*SetIPTablesRequest)(nil), "grpc.SetIPTablesRequest")
+	proto.RegisterType((*SetIPTablesResponse)(nil), "grpc.SetIPTablesResponse")
+	proto.RegisterType((*OnlineCPUMemRequest)(nil), "grpc.OnlineCPUMemRequest")
+	proto.RegisterType((*ReseedRandomDevRequest)(nil), "grpc.ReseedRandomDevRequest")
+	proto.RegisterType((*AgentDetails)(nil), "grpc.AgentDetails")
+	proto.RegisterType((*GuestDetailsRequest)(nil), "grpc.GuestDetailsRequest")
+	proto.RegisterType((*GuestDetailsResponse)(nil), "grpc.GuestDetailsResponse")
+	proto.RegisterType((*MemHotplugByProbeRequest)(nil), "grpc.MemHotplugByProbeRequest")
+	proto.RegisterType((*SetGuestDateTimeRequest)(nil), "grpc.SetGuestDateTimeRequest")
+	proto.RegisterType((*FSGroup)(nil), "grpc.FSGroup")
+	proto.RegisterType((*Storage)(nil), "grpc.Storage")
+	proto.RegisterType((*StorageEncryption)(nil), "grpc.StorageEncryption")
+	proto.RegisterType((*Device)(nil), "grpc.Device")
+	proto.RegisterType((*StringUser)(nil), "grpc.StringUser")
+	proto.RegisterType((*CopyFileRequest)(nil), "grpc.CopyFileRequest")
+	proto.RegisterType((*GetOOMEventRequest)(nil), "grpc.GetOOMEventRequest")
+	proto.RegisterType((*OOMEvent)(nil), "grpc.OOMEvent")
+	proto.RegisterType((*AddSwapRequest)(nil), "grpc.AddSwapRequest")
+	proto.RegisterType((*GetMetricsRequest)(nil), "grpc.GetMetricsRequest")
+	proto.RegisterType((*Metrics)(nil), "grpc.Metrics")
+	proto.RegisterType((*VolumeStatsRequest)(nil), "grpc.VolumeStatsRequest")
+	proto.RegisterType((*ResizeVolumeRequest)(nil), "grpc.ResizeVolumeRequest")
+	proto.RegisterType((*WatchableEntry)(nil), "grpc.WatchableEntry")
+	proto.RegisterType((*SyncWatchableMountRequest)(nil), "grpc.SyncWatchableMountRequest")
+	proto.RegisterType((*SetPolicyRequest)(nil), "grpc.SetPolicyRequest")
+	proto.RegisterType((*GetPolicyRequest)(nil), "grpc.GetPolicyRequest")
+	proto.RegisterType((*Policy)(nil), "grpc.Policy")
+	proto.RegisterType((*ExecuteHooksRequest)(nil), "grpc.ExecuteHooksRequest")
+	proto.RegisterType((*GetDiagnosticsRequest)(nil), "grpc.GetDiagnosticsRequest")
+	proto.RegisterType((*DiagnosticsFile)(nil), "grpc.DiagnosticsFile")
+	proto.RegisterType((*Diagnostics)(nil), "grpc.Diagnostics")
+	proto.RegisterType((*SetLogLevelRequest)(nil), "grpc.SetLogLevelRequest")
+	proto.RegisterType((*ReadKernelLogRequest)(nil), "grpc.ReadKernelLogRequest")
+	proto.RegisterType((*KernelLogRecord)(nil), "grpc.KernelLogRecord")
+	proto.RegisterType((*KernelLog)(nil), "grpc.KernelLog")
+	proto.RegisterType((*SetGuestTimeSourceRequest)(nil), "grpc.SetGuestTimeSourceRequest")
+	proto.RegisterType((*StartTracingRequest)(nil), "grpc.StartTracingRequest")
+	proto.RegisterType((*StopTracingRequest)(nil), "grpc.StopTracingRequest")
+}
+
+func init() {
+	proto.RegisterFile("github.com/kata-containers/kata-containers/src/libs/protocols/protos/agent.proto", fileDescriptor_712ce9a559fda969)
+}
+
+var fileDescriptor_712ce9a559fda969 = []byte{
+	// 3938 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3a, 0x5d, 0x6f, 0x1c, 0x47,
+	0x72, 0xb7, 0xdc, 0x25, 0x77, 0xb7, 0xf6, 0x8b, 0xdb, 0xa4, 0xa8, 0xe5, 0xda, 0x27, 0xeb, 0xc6,
+	0x3e, 0x5b, 0xb6, 0x63, 0xea, 0x4e, 0x36, 0xac, 0xb3, 0x7d, 0x17, 0x1f, 0x45, 0xc9, 0x14, 0x6d,
+	0xf1, 0xb8, 0x99, 0x95, 0xe2, 0xe0, 0x02, 0x64, 0x30, 0x3b, 0xd3, 0x5c, 0xf6, 0x71, 0x67, 0x7a,
+	0xdc, 0xd3, 0x43, 0x91, 0x17, 0x20, 0x48, 0x5e, 0x2e, 0x40, 0x1e, 0xf2, 0x98, 0xb7, 0x00, 0xc9,
+	0x6b, 0x90, 0x7f, 0x10, 0xe4, 0x2d, 0x0f, 0x46, 0x9e, 0xf2, 0x98, 0xa7, 0x20, 0xe7, 0x9f, 0x90,
+	0x5f, 0x10, 0xf4, 0xd7, 0x4c, 0xcf, 0x7e, 0xd0, 0xb6, 0x20, 0xe0, 0x5e, 0x06, 0x5d, 0xd5, 0xd5,
+	0xd5, 0x55, 0xd5, 0xdd, 0xd5, 0x55, 0x35, 0x0d, 0xa3, 0x29, 0xe1, 0x67, 0xd9, 0x64, 0x2f, 0xa0,
+	0xd1, 0xdd, 0x73, 0x9f, 0xfb, 0xef, 0x05, 0x34, 0xe6, 0x3e, 0x89, 0x31, 0x4b, 0x17, 0xe0, 0x94,
+	0x05, 0x77, 0x67, 0x64, 0x92, 0xde, 0x4d, 0x18, 0xe5, 0x34, 0xa0, 0x33, 0xdd, 0x4a, 0xef, 0xfa,
+	0x53, 0x1c, 0xf3, 0x3d, 0x09, 0xa0, 0xda, 0x94, 0x25, 0xc1, 0xb0, 0x49, 0x03, 0xa2, 0x10, 0xc3,
+	0x66, 0x90, 0x9a, 0x66, 0x8b, 0x5f, 0x25, 0x38, 0xd5, 0xc0, 0x2b, 0x53, 0x4a, 0xa7, 0x33, 0xac,
+	0x78, 0x4c, 0xb2, 0xd3, 0xbb, 0x38, 0x4a, 0xf8, 0x95, 0xea, 0x74, 0xfe, 0x71, 0x0d, 0x76, 0x0e,
+	0x18, 0xf6, 0x39, 0x3e, 0x30, 0x02, 0xb8, 0xf8, 0xab, 0x0c, 0xa7, 0x1c, 0xfd, 0x08, 0xda, 0xb9,
+	0x50, 0x1e, 0x09, 0x07, 0x95, 0xdb, 0x95, 0x3b, 0x4d, 0xb7, 0x95, 0xe3, 0x8e, 0x42, 0x74, 0x13,
+	0xea, 0xf8, 0x12, 0x07, 0xa2, 0x77, 0x4d, 0xf6, 0x6e, 0x08, 0xf0, 0x28, 0x44, 0x3f, 0x85, 0x56,
+	0xca, 0x19, 0x89, 0xa7, 0x5e, 0x96, 0x62, 0x36, 0xa8, 0xde, 0xae, 0xdc, 0x69, 0xdd, 0xdb, 0xdc,
+	0x13, 0x22, 0xef, 0x8d, 0x65, 0xc7, 0xb3, 0x14, 0x33, 0x17, 0xd2, 0xbc, 0x8d, 0xde, 0x84, 0x7a,
+	0x88, 0x2f, 0x48, 0x80, 0xd3, 0x41, 0xed, 0x76, 0xf5, 0x4e, 0xeb, 0x5e, 0x5b, 0x91, 0x3f, 0x94,
+	0x48, 0xd7, 0x74, 0xa2, 0xb7, 0xa1, 0x91, 0x72, 0xca, 0xfc, 0x29, 0x4e, 0x07, 0xeb, 0x92, 0xb0,
+	0x63, 0xf8, 0x4a, 0xac, 0x9b, 0x77, 0xa3, 0x57, 0xa1, 0x7a, 0x72, 0x70, 0x34, 0xd8, 0x90, 0xb3,
+	0x83, 0xa6, 0x4a, 0x70, 0xe0, 0x0a, 0x34, 0x7a, 0x1d, 0x3a, 0xa9, 0x1f, 0x87, 0x13, 0x7a, 0xe9,
+	0x25, 0x24, 0x8c, 0xd3, 0x41, 0xfd, 0x76, 0xe5, 0x4e, 0xc3, 0x6d, 0x6b, 0xe4, 0x48, 0xe0, 0x9c,
+	0x8f, 0xe1, 0xc6, 0x98, 0xfb, 0x8c, 0xbf, 0x80, 0x75, 0x9c, 0x67, 0xb0, 0xe3, 0xe2, 0x88, 0x5e,
+	0xbc, 0x90, 0x69, 0x07, 0x50, 0xe7, 0x24, 0xc2, 0x34, 0xe3, 0xd2, 0xb4, 0x1d, 0xd7, 0x80, 0xce,
+	0xbf, 0x56, 0x00, 0x3d, 0xba, 0xc4, 0xc1, 0x88, 0xd1, 0x00, 0xa7, 0xe9, 0x1f, 0x68, 0xb9, 0xde,
+	0x82, 0x7a, 0xa2, 0x04, 0x18, 0xd4, 0x6e, 0x57, 0x8a, 0x55, 0x30, 0x52, 0x99, 0x5e, 0xe7, 0x37,
+	0xb0, 0x3d, 0x26, 0xd3, 0xd8, 0x9f, 0xbd, 0x44, 0x79, 0x77, 0x60, 0x23, 0x95, 0x3c, 0xa5, 0xa8,
+	0x1d, 0x57, 0x43, 0xce, 0x08, 0xd0, 0x97, 0x3e, 0xe1, 0x2f, 0x6f, 0x26, 0xe7, 0x3d, 0xd8, 0x2a,
+	0x71, 0x4c, 0x13, 0x1a, 0xa7, 0x58, 0x0a, 0xc0, 0x7d, 0x9e, 0xa5, 0x92, 0xd9, 0xba, 0xab, 0x21,
+	0x87, 0xc2, 0xce, 0xb3, 0x24, 0x7c, 0xc1, 0xd3, 0x74, 0x0f, 0x9a, 0x0c, 0xa7, 0x34, 0x63, 0xe2,
+	0x0c, 0xac, 0x49, 0xa3, 0x6e, 0x2b, 0xa3, 0x3e, 0x21, 0x71, 0x76, 0xe9, 0x9a, 0x3e, 0xb7, 0x20,
+	0xd3, 0xfb, 0x93, 0xa7, 0x2f, 0xb2, 0x3f, 0x3f, 0x86, 0x1b, 0x23, 0x3f, 0x4b, 0x5f, 0x44, 0x56,
+	0xe7, 0x13, 0xb1, 0xb7, 0xd3, 0x2c, 0x7a, 0xa1, 0xc1, 0xff, 0x52, 0x81, 0xc6, 0x41, 0x92, 0x3d,
+	0x4b, 0xfd, 0x29, 0x46, 0xaf, 0x41, 0x8b, 0x53, 0xee, 0xcf, 0xbc, 0x4c, 0x80, 0x92, 0xbc, 0xe6,
+	0x82, 0x44, 0x29, 0x82, 0x1f, 0x41, 0x3b, 0xc1, 0x2c, 0x48, 0x32, 0x4d, 0xb1, 0x76, 0xbb, 0x7a,
+	0xa7, 0xe6, 0xb6, 0x14, 0x4e, 0x91, 0xec, 0xc1, 0x96, 0xec, 0xf3, 0x48, 0xec, 0x9d, 0x63, 0x16,
+	0xe3, 0x59, 0x44, 0x43, 0x2c, 0x37, 0x47, 0xcd, 0xed, 0xcb, 0xae, 0xa3, 0xf8, 0x8b, 0xbc, 0x03,
+	0xbd, 0x03, 0xfd, 0x9c, 0x5e, 0xec, 0x78, 0x49, 0x5d, 0x93, 0xd4, 0x3d, 0x4d, 0xfd, 0x4c, 0xa3,
+	0x9d, 0xbf, 0x82, 0xee, 0xd3, 0x33, 0x46, 0x39, 0x9f, 0x91, 0x78, 0xfa, 0xd0, 0xe7, 0xbe, 0x38,
+	0x9a, 0x09, 0x66, 0x84, 0x86, 0xa9, 0x96, 0xd6, 0x80, 0xe8, 0x5d, 0xe8, 0x73, 0x45, 0x8b, 0x43,
+	0xcf, 0xd0, 0xac, 0x49, 0x9a, 0xcd, 0xbc, 0x63, 0xa4, 0x89, 0x7f, 0x0c, 0xdd, 0x82, 0x58, 0x1c,
+	0x6e, 0x2d, 0x6f, 0x27, 0xc7, 0x3e, 0x25, 0x11, 0x76, 0x2e, 0xa4, 0xad, 0xe4, 0x22, 0xa3, 0x77,
+	0xa1, 0x59, 0xd8, 0xa1, 0x22, 0x77, 0x48, 0x57, 0xed, 0x10, 0x63, 0x4e, 0xb7, 0x91, 0x1b, 0xe5,
+	0x17, 0xd0, 0xe3, 0xb9, 0xe0, 0x5e, 0xe8, 0x73, 0xbf, 0xbc, 0xa9, 0xca, 0x5a, 0xb9, 0x5d, 0x5e,
+	0x82, 0x9d, 0x4f, 0xa0, 0x39, 0x22, 0x61, 0xaa, 0x26, 0x1e, 0x40, 0x3d, 0xc8, 0x18, 0xc3, 0x31,
+	0x37, 0x2a, 0x6b, 0x10, 0x6d, 0xc3, 0xfa, 0x8c, 0x44, 0x84, 0x6b, 0x35, 0x15, 0xe0, 0x50, 0x80,
+	0x63, 0x1c, 0x51, 0x76, 0x25, 0x0d, 0xb6, 0x0d, 0xeb, 0xf6, 0xe2, 0x2a, 0x00, 0xbd, 0x02, 0xcd,
+	0xc8, 0xbf, 0xcc, 0x17, 0x55, 0xf4, 0x34, 0x22, 0xff, 0x52, 0x09, 0x3f, 0x80, 0xfa, 0xa9, 0x4f,
+	0x66, 0x41, 0xcc, 0xb5, 0x55, 0x0c, 0x58, 0x4c, 0x58, 0xb3, 0x27, 0xfc, 0x8f, 0x35, 0x68, 0xa9,
+	0x19, 0x95, 0xc0, 0xdb, 0xb0, 0x1e, 0xf8, 0xc1, 0x59, 0x3e, 0xa5, 0x04, 0xd0, 0x9b, 0xb0, 0x5e,
+	0x4c, 0x97, 0x7b, 0xb8, 0x42, 0x52, 0x23, 0xda, 0x5d, 0x80, 0xf4, 0xb9, 0x9f, 0x68, 0xd9, 0xaa,
+	0x2b, 0x88, 0x9b, 0x82, 0x46, 0x89, 0xfb, 0x3e, 0xb4, 0xd5, 0xbe, 0xd3, 0x43, 0x6a, 0x2b, 0x86,
+	0xb4, 0x14, 0x95, 0x1a, 0xf4, 0x3a, 0x74, 0xb2, 0x14, 0x7b, 0x67, 0x04, 0x33, 0x9f, 0x05, 0x67,
+	0x57, 0x83, 0x75, 0x75, 0x01, 0x65, 0x29, 0x7e, 0x6c, 0x70, 0xe8, 0x1e, 0xac, 0x0b, 0xdf, 0x92,
+	0x0e, 0x36, 0xe4, 0x5d, 0xf7, 0xaa, 0xcd, 0x52, 0xaa, 0xba, 0x27, 0xbf, 0x8f, 0x62, 0xce, 0xae,
+	0x5c, 0x45, 0x3a, 0xfc, 0x19, 0x40, 0x81, 0x44, 0x9b, 0x50, 0x3d, 0xc7, 0x57, 0xfa, 0x1c, 0x8a,
+	0xa6, 0x30, 0xce, 0x85, 0x3f, 0xcb, 0x8c, 0xd5, 0x15, 0xf0, 0xf1, 0xda, 0xcf, 0x2a, 0x4e, 0x00,
+	0xbd, 0x07, 0xb3, 0x73, 0x42, 0xad, 0xe1, 0xdb, 0xb0, 0x1e, 0xf9, 0xbf, 0xa1, 0xcc, 0x58, 0x52,
+	0x02, 0x12, 0x4b, 0x62, 0xca, 0x0c, 0x0b, 0x09, 0xa0, 0x2e, 0xac, 0xd1, 0x44, 0xda, 0xab, 0xe9,
+	0xae, 0xd1, 0xa4, 0x98, 0xa8, 0x66, 0x4d, 0xe4, 0xfc, 0x4f, 0x0d, 0xa0, 0x98, 0x05, 0xb9, 0x30,
+	0x24, 0xd4, 0x4b, 0x31, 0x13, 0xf7, 0xbb, 0x37, 0xb9, 0xe2, 0x38, 0xf5, 0x18, 0x0e, 0x32, 0x96,
+	0x92, 0x0b, 0xb1, 0x7e, 0x42, 0xed, 0x1b, 0x4a, 0xed, 0x39, 0xd9, 0xdc, 0x9b, 0x84, 0x8e, 0xd5,
+	0xb8, 0x07, 0x62, 0x98, 0x6b, 0x46, 0xa1, 0x23, 0xb8, 0x51, 0xf0, 0x0c, 0x2d, 0x76, 0x6b, 0xd7,
+	0xb1, 0xdb, 0xca, 0xd9, 0x85, 0x05, 0xab, 0x47, 0xb0, 0x45, 0xa8, 0xf7, 0x55, 0x86, 0xb3, 0x12,
+	0xa3, 0xea, 0x75, 0x8c, 0xfa, 0x84, 0xfe, 0x89, 0x1c, 0x50, 0xb0, 0x19, 0xc1, 0xae, 0xa5, 0xa5,
+	0x38, 0xee, 0x16, 0xb3, 0xda, 0x75, 0xcc, 0x76, 0x72, 0xa9, 0x84, 0x3f, 0x28, 0x38, 0x7e, 0x0e,
+	0x3b, 0x84, 0x7a, 0xcf, 0x7d, 0xc2, 0xe7, 0xd9, 0xad, 0x7f, 0x8b, 0x92, 0xe2, 0x46, 0x2b, 0xf3,
+	0x52, 0x4a, 0x46, 0x98, 0x4d, 0x4b, 0x4a, 0x6e, 0x7c, 0x8b, 0x92, 0xc7, 0x72, 0x40, 0xc1, 0x66,
+	0x1f, 0xfa, 0x84, 0xce, 0x4b, 0x53, 0xbf, 0x8e, 0x49, 0x8f, 0xd0, 0xb2, 0x24, 0x0f, 0xa0, 0x9f,
+	0xe2, 0x80, 0x53, 0x66, 0x6f, 0x82, 0xc6, 0x75, 0x2c, 0x36, 0x35, 0x7d, 0xce, 0xc3, 0xf9, 0x73,
+	0x68, 0x3f, 0xce, 0xa6, 0x98, 0xcf, 0x26, 0xb9, 0x33, 0x78, 0x69, 0xfe, 0xc7, 0xf9, 0xbf, 0x35,
+	0x68, 0x1d, 0x4c, 0x19, 0xcd, 0x92, 0x92, 0x4f, 0x56, 0x87, 0x74, 0xde, 0x27, 0x4b, 0x12, 0xe9,
+	0x93, 0x15, 0xf1, 0x07, 0xd0, 0x8e, 0xe4, 0xd1, 0xd5, 0xf4, 0xca, 0x0f, 0xf5, 0x17, 0x0e, 0xb5,
+	0xdb, 0x8a, 0x0a, 0x00, 0xed, 0x01, 0x24, 0x24, 0x4c, 0xf5, 0x18, 0xe5, 0x8e, 0x7a, 0x3a, 0xdc,
+	0x32, 0x2e, 0xda, 0x6d, 0x26, 0xa6, 0x29, 0xc2, 0xb9, 0x89, 0x30, 0x92, 0x1e, 0x50, 0x72, 0x46,
+	0x85, 0xf5, 0x5c, 0x98, 0xe4, 0x6d, 0xf4, 0x18, 0x3a, 0x67, 0xca, 0x64, 0x7a, 0x90, 0xda, 0x43,
+	0xaf, 0x6b, 0x4d, 0x0a, 0x7d, 0xf7, 0x6c, 0xcb, 0xaa, 0x05, 0x68, 0x9f, 0x59, 0xa8, 0xe1, 0x18,
+	0xfa, 0x0b, 0x24, 0x4b, 0x7c, 0xd0, 0x1d, 0xdb, 0x07, 0xb5, 0xee, 0x21, 0x35, 0x91, 0x3d, 0xd2,
+	0xf6, 0x4b, 0x7f, 0xbf, 0x06, 0xed, 0x5f, 0x61, 0xfe, 0x9c, 0xb2, 0x73, 0x25, 0x2f, 0x82, 0x5a,
+	0xec, 0x47, 0x58, 0x73, 0x94, 0x6d, 0xb4, 0x0b, 0x0d, 0x76, 0xa9, 0x1c, 0x88, 0x5e, 0xcf, 0x3a,
+	0xbb, 0x94, 0x8e, 0x01, 0xfd, 0x10, 0x80, 0x5d, 0x7a, 0x89, 0x1f, 0x9c, 0x63, 0x6d, 0xc1, 0x9a,
+	0xdb, 0x64, 0x97, 0x23, 0x85, 0x10, 0x5b, 0x81, 0x5d, 0x7a, 0x98, 0x31, 0xca, 0x52, 0xed, 0xab,
+	0x1a, 0xec, 0xf2, 0x91, 0x84, 0xf5, 0xd8, 0x90, 0xd1, 0x24, 0xc1, 0xe1, 0x60, 0xdd, 0x8c, 0x7d,
+	0xa8, 0x10, 0x62, 0x56, 0x6e, 0x66, 0xdd, 0x50, 0xb3, 0xf2, 0x62, 0x56, 0x5e, 0xcc, 0x5a, 0x57,
+	0x23, 0xb9, 0x3d, 0x2b, 0xcf, 0x67, 0x6d, 0xa8, 0x59, 0xb9, 0x35, 0x2b, 0x2f, 0x66, 0x6d, 0x9a,
+	0xb1, 0x7a, 0x56, 0xe7, 0x6f, 0x2b, 0xb0, 0x33, 0x1f, 0xf8, 0xe9, 0xd8, 0xf4, 0x03, 0x68, 0x07,
+	0x72, 0xbd, 0x4a, 0x7b, 0xb2, 0xbf, 0xb0, 0x92, 0x6e, 0x2b, 0x28, 0x00, 0x74, 0x1f, 0x3a, 0xb1,
+	0x32, 0x70, 0xbe, 0x35, 0xab, 0xc5, 0xba, 0xd8, 0xb6, 0x77, 0xdb, 0xb1, 0x05, 0x39, 0x21, 0xa0,
+	0x2f, 0x19, 0xe1, 0x78, 0xcc, 0x19, 0xf6, 0xa3, 0x97, 0x11, 0xdd, 0x23, 0xa8, 0xc9, 0x68, 0x45,
+	0x2c, 0x53, 0xdb, 0x95, 0x6d, 0xe7, 0x2d, 0xd8, 0x2a, 0xcd, 0xa2, 0x75, 0xdd, 0x84, 0xea, 0x0c,
+	0xc7, 0x92, 0x7b, 0xc7, 0x15, 0x4d, 0xc7, 0x87, 0xbe, 0x8b, 0xfd, 0xf0, 0xe5, 0x49, 0xa3, 0xa7,
+	0xa8, 0x16, 0x53, 0xdc, 0x01, 0x64, 0x4f, 0xa1, 0x45, 0x31, 0x52, 0x57, 0x2c, 0xa9, 0x4f, 0xa0,
+	0x7f, 0x30, 0xa3, 0x29, 0x1e, 0xf3, 0x90, 0xc4, 0x2f, 0x23, 0x1d, 0xb9, 0x84, 0xed, 0x93, 0x04,
+	0xc7, 0x3a, 0x1d, 0x39, 0x3a, 0x79, 0x19, 0x0a, 0xbe, 0x01, 0x9d, 0x09, 0x09, 0x09, 0xc3, 0x01,
+	0x27, 0xd4, 0xe4, 0x54, 0x0d, 0xb7, 0x8c, 0x74, 0xbe, 0x82, 0x6e, 0x3e, 0xeb, 0x88, 0x32, 0x2e,
+	0x77, 0x68, 0x2a, 0xf4, 0xf2, 0x12, 0xca, 0xb8, 0x5e, 0x82, 0xa6, 0xc4, 0x88, 0x7e, 0x11, 0xd7,
+	0xa7, 0x3c, 0xa4, 0x19, 0x57, 0xfd, 0x2a, 0x89, 0x05, 0x85, 0xb2, 0x08, 0x30, 0x63, 0x8a, 0xa0,
+	0x9a, 0x13, 0x60, 0xc6, 0x04, 0x81, 0xf3, 0x97, 0xb0, 0xf5, 0x94, 0x5f, 0x7d, 0x29, 0x2c, 0x97,
+	0x92, 0xdf, 0xe2, 0x97, 0xb4, 0x98, 0x8c, 0x3e, 0x37, 0x8b, 0xc9, 0xe8, 0x73, 0x91, 0xc9, 0x05,
+	0x74, 0x96, 0x45, 0xb1, 0x3c, 0xf7, 0x1d, 0x57, 0x43, 0xce, 0x03, 0x68, 0xab, 0x84, 0xe1, 0x98,
+	0x86, 0xd9, 0x0c, 0x2f, 0x75, 0x38, 0xb7, 0x00, 0x12, 0x9f, 0xf9, 0x11, 0xe6, 0x98, 0xa9, 0x03,
+	0xd3, 0x74, 0x2d, 0x8c, 0xf3, 0x0f, 0x6b, 0xb0, 0xad, 0x8a, 0x2b, 0x63, 0x55, 0x53, 0x30, 0x2a,
+	0x0c, 0xa1, 0x71, 0x46, 0x53, 0x6e, 0x31, 0xcc, 0x61, 0x21, 0x62, 0x18, 0x1b, 0x6e, 0xa2, 0x59,
+	0xaa, 0x78, 0x54, 0xaf, 0xaf, 0x78, 0x2c, 0xd4, 0x34, 0x6a, 0x8b, 0x35, 0x0d, 0xb9, 0x70, 0x9a,
+	0x88, 0x28, 0x87, 0xd6, 0x74, 0x9b, 0x1a, 0x73, 0x14, 0xa2, 0x37, 0xa1, 0x37, 0x15, 0x52, 0x7a,
+	0x67, 0x94, 0x9e, 0x7b, 0x89, 0xcf, 0xcf, 0xa4, 0x5f, 0x6b, 0xba, 0x1d, 0x89, 0x7e, 0x4c, 0xe9,
+	0xf9, 0xc8, 0xe7, 0x67, 0xe8, 0x23, 0xe8, 0xea, 0x98, 0x37, 0x92, 0x26, 0x4a, 0x07, 0x75, 0xdb,
+	0x65, 0xd8, 0xd6, 0x73, 0x3b, 0xe7, 0x16, 0x94, 0x3a, 0x37, 0xe1, 0xc6, 0x43, 0x9c, 0x72, 0x46,
+	0xaf, 0xca, 0x86, 0x71, 0xfe, 0x18, 0xe0, 0x28, 0xe6, 0x98, 0x9d, 0xfa, 0x01, 0x4e, 0xd1, 0x4f,
+	0x6c, 0x48, 0x47, 0x82, 0x9b, 0x7b, 0xaa, 0xb6, 0x95, 0x77, 0xb8, 0x16, 0x8d, 0xb3, 0x07, 0x1b,
+	0x2e, 0xcd, 0x84, 0xef, 0x7d, 0xc3, 0xb4, 0xf4, 0xb8, 0xb6, 0x1e, 0x27, 0x91, 0xae, 0xee, 0x73,
+	0x1e, 0x9b, 0x7c, 0xbd, 0x60, 0xa7, 0x97, 0x68, 0x0f, 0x9a, 0xc4, 0xe0, 0xb4, 0x0b, 0x5d, 0x9c,
+	0xba, 0x20, 0x71, 0x3e, 0x81, 0x2d, 0xc5, 0x49, 0x71, 0x36, 0x6c, 0xde, 0x80, 0x0d, 0x66, 0xc4,
+	0xa8, 0x14, 0x45, 0x2d, 0x4d, 0xa4, 0xfb, 0x84, 0x3d, 0x9e, 0x90, 0x94, 0x17, 0x8a, 0x18, 0x7b,
+	0x6c, 0x41, 0x5f, 0x74, 0x94, 0x78, 0x3a, 0x9f, 0x41, 0x7b, 0xdf, 0x1d, 0xfd, 0x0a, 0x93, 0xe9,
+	0xd9, 0x44, 0x5c, 0x15, 0x1f, 0x96, 0x61, 0xad, 0x30, 0xd2, 0xd2, 0x5a, 0x5d, 0x6e, 0x89, 0xce,
+	0xf9, 0x1c, 0x76, 0xf6, 0xc3, 0xd0, 0x46, 0x19, 0xa9, 0x7f, 0x02, 0xcd, 0xd8, 0x62, 0x67, 0x5d,
+	0xd0, 0x25, 0xea, 0x82, 0xc8, 0x79, 0x0f, 0xd0, 0x21, 0xe6, 0x47, 0xa3, 0xa7, 0xfe, 0x64, 0x56,
+	0x68, 0x7f, 0x13, 0xea, 0x24, 0xf5, 0x48, 0x72, 0xf1, 0xa1, 0xe4, 0xd2, 0x70, 0x37, 0x48, 0x7a,
+	0x94, 0x5c, 0x7c, 0xe8, 0xbc, 0x0d, 0x5b, 0x25, 0xf2, 0x6b, 0x7c, 0xe8, 0x3e, 0xa0, 0xf1, 0x77,
+	0xe7, 0x9c, 0xb3, 0x58, 0xb3, 0x58, 0xbc, 0x0d, 0x5b, 0xe3, 0xef, 0x38, 0xdb, 0xdf, 0x54, 0x60,
+	0xeb, 0x24, 0x9e, 0x91, 0x18, 0x1f, 0x8c, 0x9e, 0x1d, 0xe3, 0xfc, 0x06, 0x41, 0x50, 0x13, 0x91,
+	0xb6, 0x9e, 0x4c, 0xb6, 0x85, 0x0c, 0xf1, 0xc4, 0x0b, 0x92, 0x2c, 0xd5, 0xde, 0x6d, 0x23, 0x9e,
+	0x1c, 0x24, 0x59, 0x2a, 0x42, 0x02, 0x11, 0x12, 0xd2, 0x78, 0x76, 0xa5, 0x9d, 0x69, 0x3d, 0x48,
+	0xb2, 0x93, 0x78, 0x76, 0x85, 0x1c, 0xe8, 0xc4, 0x13, 0x2f, 0xc2, 0x91, 0x37, 0x99, 0xd1, 0xe0,
+	0x3c, 0xd5, 0x5e, 0xa7, 0x15, 0x4f, 0x8e, 0x71, 0xf4, 0x40, 0xa2, 0x9c, 0x3f, 0x92, 0xb5, 0x15,
+	0x8c, 0x43, 0xd7, 0x8f, 0x43, 0x1a, 0x3d, 0xc4, 0x17, 0x96, 0x14, 0x0b, 0xca, 0x7d, 0x5d, 0x81,
+	0xf6, 0xfe, 0x14, 0xc7, 0xfc, 0x21, 0xe6, 0x3e, 0x99, 0xc9, 0x5c, 0xfd, 0x02, 0xb3, 0x94, 0xd0,
+	0x58, 0xfb, 0x16, 0x03, 0x0a, 0x8f, 0x4b, 0x62, 0xc2, 0xbd, 0xd0, 0xc7, 0x11, 0x8d, 0x25, 0x97,
+	0x86, 0x0b, 0x02, 0xf5, 0x50, 0x62, 0xd0, 0x5b, 0xd0, 0x53, 0x65, 0x56, 0xef, 0xcc, 0x8f, 0xc3,
+	0x19, 0x66, 0xca, 0xe1, 0x34, 0xdd, 0xae, 0x42, 0x3f, 0xd6, 0x58, 0xf4, 0x36, 0x6c, 0x6a, 0x9f,
+	0x53, 0x50, 0xd6, 0x24, 0x65, 0x4f, 0xe3, 0x4b, 0xa4, 0x59, 0x22, 0x5c, 0x7c, 0xea, 0xa5, 0x38,
+	0x08, 0x68, 0x94, 0xe8, 0x44, 0xb7, 0x67, 0xf0, 0x63, 0x85, 0x76, 0xa6, 0xb0, 0x75, 0x28, 0xf4,
+	0xd4, 0x9a, 0x14, 0x67, 0xa8, 0x9b, 0x1b, 0xcc, 0x13, 0x37, 0x81, 0x5e, 0x85, 0x76, 0xa4, 0x4d,
+	0x36, 0x26, 0xbf, 0x95, 0x35, 0x1d, 0x41, 0x75, 0x46, 0x79, 0x32, 0xcb, 0xa6, 0x5e, 0xc2, 0xe8,
+	0x04, 0x6b, 0x15, 0x7b, 0x11, 0x8e, 0x1e, 0x2b, 0xfc, 0x48, 0xa0, 0x9d, 0x7f, 0xab, 0xc0, 0x76,
+	0x79, 0x26, 0xbd, 0x25, 0xee, 0xc2, 0x76, 0x79, 0x2a, 0x1d, 0xd8, 0xa9, 0xc4, 0xa1, 0x6f, 0x4f,
+	0xa8, 0x42, 0xbc, 0xfb, 0xd0, 0x91, 0x45, 0x79, 0x2f, 0x54, 0x9c, 0xca, 0xe1, 0xac, 0xbd, 0x2e,
+	0x6e, 0xdb, 0xb7, 0x20, 0xf4, 0x11, 0xec, 0x6a, 0xf5, 0xbd, 0x45, 0xb1, 0xd5, 0xa6, 0xd9, 0xd1,
+	0x04, 0xc7, 0x73, 0xd2, 0x3f, 0x81, 0x41, 0x81, 0x7a, 0x70, 0x25, 0x91, 0xc5, 0xc9, 0xdd, 0x9a,
+	0x53, 0x76, 0x3f, 0x0c, 0x99, 0x74, 0x09, 0x35, 0x77, 0x59, 0x97, 0xf3, 0x29, 0xdc, 0x1c, 0x63,
+	0xae, 0xac, 0xe1, 0x73, 0x9d, 0x63, 0x2a, 0x66, 0x9b, 0x50, 0x1d, 0xe3, 0x40, 0x2a, 0x5f, 0x75,
+	0x45, 0x53, 0x6c, 0xc0, 0x67, 0x29, 0x0e, 0xa4, 0x96, 0x55, 0x57, 0xb6, 0x9d, 0x04, 0xea, 0x9f,
+	0x8d, 0x0f, 0x45, 0x24, 0x29, 0x36, 0xbe, 0x8a, 0x3c, 0xf5, 0xc5, 0xdb, 0x71, 0xeb, 0x12, 0x3e,
+	0x0a, 0xd1, 0xe7, 0xb0, 0xa5, 0xba, 0x82, 0x33, 0x3f, 0x9e, 0x62, 0x2f, 0xa1, 0x33, 0x12, 0xa8,
+	0xe3, 0xd1, 0xbd, 0x37, 0xd4, 0xbe, 0x4a, 0xf3, 0x39, 0x90, 0x24, 0x23, 0x49, 0xe1, 0xf6, 0xa7,
+	0xf3, 0x28, 0x71, 0xaf, 0xd6, 0xf5, 0xdd, 0x27, 0xee, 0xef, 0x90, 0x91, 0x0b, 0xcc, 0xf4, 0x66,
+	0xd7, 0x90, 0xa8, 0xae, 0xa9, 0x96, 0x47, 0x13, 0x11, 0xc2, 0x98, 0x1b, 0xb5, 0xa3, 0xb0, 0x27,
+	0x0a, 0x29, 0x86, 0xab, 0x52, 0xaa, 0xae, 0x5a, 0x68, 0x48, 0xe0, 0x4f, 0x53, 0x21, 0x94, 0x3c,
+	0xa0, 0x4d, 0x57, 0x43, 0xe2, 0x70, 0x19, 0x7e, 0xeb, 0x92, 0x9f, 0x01, 0xc5, 0xe1, 0x8a, 0x68,
+	0x16, 0x8b, 0x70, 0x87, 0xc4, 0x5c, 0x5f, 0x99, 0x20, 0x51, 0x23, 0x81, 0x41, 0x77, 0xa0, 0x71,
+	0x9a, 0x7a, 0x52, 0x1b, 0x99, 0x0b, 0xe4, 0xd7, 0xb8, 0xd6, 0xda, 0xad, 0x9f, 0xa6, 0xb2, 0x81,
+	0xee, 0x03, 0xe0, 0x38, 0x60, 0x57, 0x92, 0xb3, 0xcc, 0x0c, 0x5a, 0xf7, 0x6e, 0x96, 0xae, 0xfc,
+	0x47, 0x79, 0xb7, 0x6b, 0x91, 0x3a, 0x1f, 0x41, 0x7f, 0x81, 0x40, 0xac, 0x99, 0x54, 0x44, 0x47,
+	0x2e, 0x52, 0x0d, 0x9d, 0x8f, 0x29, 0x3f, 0x22, 0x9a, 0xce, 0xef, 0x2a, 0xb0, 0xa1, 0x7e, 0xb5,
+	0x88, 0x2a, 0x4e, 0x1e, 0x56, 0xad, 0x91, 0x30, 0x67, 0xb0, 0x66, 0x31, 0xb8, 0x09, 0xf5, 0x8b,
+	0x48, 0x05, 0x07, 0xda, 0x70, 0x17, 0x91, 0x8c, 0x0a, 0x7e, 0x0c, 0xdd, 0x22, 0x3a, 0x93, 0xfd,
+	0xca, 0x80, 0x9d, 0x1c, 0x2b, 0xc9, 0x56, 0xda, 0xd1, 0xf9, 0x33, 0x51, 0xbc, 0xca, 0x7f, 0x33,
+	0x6c, 0x42, 0x35, 0xcb, 0x85, 0x11, 0x4d, 0x81, 0x99, 0xe6, 0x71, 0x9d, 0x68, 0xa2, 0x37, 0xa1,
+	0xeb, 0x87, 0x21, 0x51, 0x81, 0xea, 0x21, 0x09, 0x73, 0xa7, 0x55, 0xc6, 0x3a, 0xff, 0x59, 0x81,
+	0xde, 0x01, 0x4d, 0xae, 0x3e, 0x23, 0x33, 0x6c, 0x79, 0x54, 0x29, 0xa4, 0x36, 0x8e, 0x68, 0x8b,
+	0xbc, 0xec, 0x94, 0xcc, 0xb0, 0x72, 0x35, 0x6a, 0xa7, 0x37, 0x04, 0x42, 0xba, 0x19, 0xd3, 0x99,
+	0x17, 0x98, 0x3b, 0xaa, 0xf3, 0x58, 0xd4, 0x95, 0x77, 0xa1, 0x11, 0x12, 0xe6, 0xe5, 0xe5, 0xe4,
+	0x8e, 0x5b, 0x0f, 0x09, 0x93, 0x5d, 0x5a, 0x91, 0x75, 0xf9, 0xbb, 0xc0, 0x56, 0x64, 0x43, 0x61,
+	0x84, 0x22, 0x3b, 0xb0, 0x41, 0x4f, 0x4f, 0x53, 0xcc, 0xe5, 0xfe, 0xa8, 0xba, 0x1a, 0xca, 0xdd,
+	0x7e, 0xc3, 0x72, 0xfb, 0xdb, 0xf2, 0xc2, 0x3d, 0x39, 0x39, 0x7e, 0x74, 0x81, 0x63, 0x6e, 0x42,
+	0x83, 0xf7, 0xa0, 0x61, 0x50, 0xdf, 0xa5, 0x10, 0xff, 0x0e, 0x74, 0xf7, 0xc3, 0x70, 0xfc, 0xdc,
+	0x4f, 0x8c, 0x3d, 0x06, 0x50, 0x1f, 0x1d, 0x1c, 0x8d, 0x94, 0x49, 0xaa, 0x42, 0x01, 0x0d, 0x8a,
+	0x50, 0xe4, 0x10, 0xf3, 0x63, 0xcc, 0x19, 0x09, 0xf2, 0x50, 0xe4, 0x75, 0xa8, 0x6b, 0x8c, 0x18,
+	0x19, 0xa9, 0xa6, 0xb9, 0x76, 0x34, 0xe8, 0xfc, 0x12, 0xd0, 0x9f, 0x8a, 0xa0, 0x1a, 0xab, 0xf4,
+	0x51, 0xcf, 0xf4, 0x0e, 0xf4, 0x2f, 0x24, 0xd6, 0x53, 0xd1, 0xa6, 0xb5, 0x0c, 0x3d, 0xd5, 0x21,
+	0x7d, 0x92, 0x9c, 0xfb, 0x19, 0x6c, 0xa9, 0x1c, 0x40, 0xf1, 0x79, 0x01, 0x16, 0xc2, 0x86, 0xf9,
+	0x7a, 0xd6, 0x5c, 0xd9, 0x76, 0xfe, 0xbd, 0x02, 0xdd, 0x2f, 0x7d, 0x1e, 0x9c, 0x89, 0xb8, 0x40,
+	0x15, 0x2a, 0x96, 0xed, 0x07, 0x04, 0x35, 0xb9, 0xa2, 0xca, 0xa3, 0xc9, 0xb6, 0x59, 0x4e, 0x9d,
+	0x48, 0x58, 0xcb, 0xa9, 0x96, 0x5d, 0x34, 0x85, 0x47, 0x98, 0x91, 0xf8, 0xdc, 0xe3, 0x3e, 0x9b,
+	0x62, 0xae, 0x03, 0x6d, 0x10, 0xa8, 0xa7, 0x12, 0x93, 0xcb, 0xb4, 0x51, 0xc8, 0x34, 0xb7, 0x07,
+	0x6a, 0xd7, 0xee, 0x81, 0xdf, 0x55, 0x60, 0x77, 0x7c, 0x15, 0x07, 0xb9, 0x0e, 0xc7, 0xc2, 0xdb,
+	0x18, 0xeb, 0xcc, 0x39, 0xa4, 0xca, 0x82, 0x43, 0xda, 0x83, 0x3a, 0x8e, 0x39, 0x23, 0xd8, 0x24,
+	0xfb, 0xfa, 0xc7, 0x40, 0xd9, 0x24, 0xae, 0x21, 0x12, 0x2b, 0xcc, 0xe4, 0xff, 0xcc, 0x50, 0x1f,
+	0x30, 0x03, 0x3a, 0xef, 0xc0, 0xe6, 0x18, 0x73, 0xed, 0xb0, 0xf5, 0xf4, 0x3b, 0xb0, 0xa1, 0x7d,
+	0xbc, 0x76, 0xcc, 0x0a, 0x72, 0x10, 0x6c, 0x1e, 0xce, 0xd1, 0x3a, 0xb7, 0x61, 0x43, 0x21, 0x56,
+	0x8e, 0xfa, 0x35, 0x6c, 0x89, 0x7f, 0x9e, 0x19, 0xc7, 0x22, 0xff, 0xf8, 0x3e, 0xbf, 0xf6, 0x6e,
+	0xc3, 0xba, 0x48, 0x64, 0x8c, 0x8e, 0xfa, 0x37, 0xb0, 0xe0, 0xe2, 0xaa, 0x0e, 0xe7, 0xef, 0x2a,
+	0x70, 0xe3, 0x10, 0xf3, 0x87, 0xc4, 0x9f, 0xc6, 0x34, 0xe5, 0x24, 0xf8, 0x3e, 0xec, 0x77, 0x41,
+	0x14, 0x0d, 0x3d, 0x6b, 0x6f, 0xd5, 0x23, 0xff, 0xd2, 0xb8, 0x8a, 0x80, 0x32, 0xec, 0x85, 0x59,
+	0x64, 0x8a, 0xe2, 0x0d, 0x81, 0x78, 0x98, 0x45, 0x89, 0xb5, 0xce, 0x35, 0x7b, 0x9d, 0x9d, 0x73,
+	0xe8, 0x59, 0x82, 0x08, 0x57, 0xb5, 0x34, 0xf5, 0x5c, 0x12, 0x09, 0xa2, 0x57, 0xa1, 0xc9, 0x59,
+	0x16, 0x07, 0x3e, 0xc7, 0xa1, 0x0e, 0x21, 0x0a, 0x44, 0xbe, 0xd9, 0x6a, 0xd6, 0x01, 0xf8, 0x18,
+	0x5a, 0xd6, 0x64, 0xe8, 0x5d, 0x58, 0x17, 0xae, 0x2c, 0x2d, 0x17, 0xdd, 0xe7, 0xc4, 0x71, 0x15,
+	0x8d, 0xf3, 0x8e, 0x8c, 0xcb, 0x9f, 0xd0, 0xe9, 0x13, 0x7c, 0x81, 0x67, 0xc6, 0x62, 0xe2, 0xef,
+	0x8c, 0x80, 0xb5, 0xb0, 0x0a, 0x70, 0x76, 0x60, 0x5b, 0x54, 0x4c, 0x54, 0x4a, 0xf8, 0x84, 0x4e,
+	0xcd, 0xba, 0xff, 0x53, 0x05, 0x7a, 0x16, 0x32, 0xa0, 0x2c, 0x2c, 0x73, 0xe8, 0x68, 0x0e, 0x22,
+	0x63, 0x3e, 0xf5, 0x03, 0x32, 0x23, 0xfc, 0x4a, 0x9f, 0xc3, 0x1c, 0x16, 0x7d, 0xa9, 0x60, 0x18,
+	0x07, 0xe6, 0x17, 0x5a, 0x0e, 0xcb, 0x9f, 0x6c, 0x24, 0xc2, 0x29, 0xf7, 0x23, 0xf1, 0x3b, 0x07,
+	0x07, 0x5a, 0xff, 0x4e, 0x8e, 0x15, 0x31, 0x8c, 0x72, 0x5e, 0xa9, 0xac, 0x04, 0xaf, 0x1b, 0xe7,
+	0x25, 0x41, 0xe7, 0xe7, 0xd0, 0xcc, 0x25, 0x44, 0x77, 0xc5, 0x09, 0x10, 0x52, 0xce, 0x99, 0x68,
+	0x4e, 0x07, 0xd7, 0x50, 0x39, 0x4f, 0x61, 0xd7, 0x04, 0x57, 0x22, 0xb0, 0x1a, 0xcb, 0xe0, 0xc2,
+	0x3a, 0x21, 0x3a, 0xf6, 0xa8, 0x94, 0x62, 0x8f, 0xd7, 0xa0, 0x15, 0xf3, 0x44, 0xfe, 0x2b, 0xb0,
+	0xea, 0x0a, 0x31, 0x4f, 0xc6, 0x0a, 0xe3, 0xdc, 0x80, 0x2d, 0xf9, 0x28, 0xe1, 0x29, 0xf3, 0x03,
+	0x12, 0xe7, 0xd6, 0xdc, 0x06, 0x34, 0xe6, 0x34, 0x29, 0x63, 0xef, 0xfd, 0xf3, 0x40, 0xe7, 0x07,
+	0xfa, 0x27, 0x02, 0x3a, 0x84, 0xde, 0xdc, 0x8b, 0x0f, 0xa4, 0xff, 0x2a, 0x2d, 0x7f, 0x08, 0x32,
+	0xdc, 0xd9, 0x53, 0x2f, 0x48, 0xf6, 0xcc, 0x0b, 0x92, 0xbd, 0x47, 0xe2, 0x05, 0x09, 0x7a, 0x04,
+	0xdd, 0xf2, 0xdb, 0x08, 0xf4, 0x8a, 0x09, 0x52, 0x96, 0xbc, 0x98, 0x58, 0xc9, 0xe6, 0x10, 0x7a,
+	0x73, 0xcf, 0x24, 0x8c, 0x3c, 0xcb, 0x5f, 0x4f, 0xac, 0x64, 0xf4, 0x29, 0xb4, 0xac, 0x77, 0x11,
+	0x68, 0xa0, 0x98, 0x2c, 0x3e, 0x95, 0x58, 0xc9, 0xe0, 0x00, 0x3a, 0xa5, 0xa7, 0x0a, 0x68, 0xa8,
+	0xf5, 0x59, 0xf2, 0x7e, 0x61, 0x25, 0x93, 0x07, 0xd0, 0xb2, 0x5e, 0x0c, 0x18, 0x29, 0x16, 0x9f,
+	0x25, 0x0c, 0x77, 0x97, 0xf4, 0xe8, 0x34, 0xe4, 0x10, 0x7a, 0x73, 0xcf, 0x08, 0x8c, 0x49, 0x96,
+	0xbf, 0x2e, 0x58, 0x29, 0xcc, 0x17, 0xd0, 0x2d, 0x57, 0x89, 0xad, 0x25, 0x5a, 0x7c, 0x34, 0x30,
+	0x7c, 0x75, 0x79, 0xa7, 0x96, 0xea, 0x11, 0x74, 0xcb, 0xef, 0x05, 0x0c, 0xb3, 0xa5, 0xaf, 0x08,
+	0xae, 0x5f, 0xef, 0xd2, 0xd3, 0x81, 0x62, 0xbd, 0x97, 0xbd, 0x28, 0x58, 0xc9, 0x68, 0x1f, 0x40,
+	0xd7, 0x84, 0x43, 0x12, 0xe7, 0x86, 0x5e, 0xa8, 0x45, 0x0f, 0x77, 0x97, 0xf4, 0x68, 0x95, 0x3e,
+	0x05, 0x50, 0xa5, 0x5c, 0x51, 0x95, 0x44, 0x37, 0x8d, 0x18, 0x73, 0xf5, 0xe3, 0xe1, 0x60, 0xb1,
+	0x63, 0x81, 0x01, 0x66, 0xec, 0x45, 0x18, 0xfc, 0x02, 0xa0, 0x28, 0x11, 0x1b, 0x06, 0x0b, 0x45,
+	0xe3, 0x6b, 0x6c, 0xd0, 0xb6, 0x6b, 0xa4, 0x48, 0xeb, 0xba, 0xa4, 0x6e, 0x7a, 0x0d, 0x8b, 0x4e,
+	0xa9, 0xa6, 0x6c, 0x76, 0xfd, 0xb2, 0x42, 0xf3, 0x70, 0xbb, 0xf4, 0xca, 0xc7, 0x94, 0x82, 0xf7,
+	0xcd, 0x7e, 0xcd, 0x2b, 0x58, 0xe5, 0xfd, 0x3a, 0x5f, 0x5d, 0x1b, 0x2e, 0x94, 0xd2, 0xd0, 0x7d,
+	0x68, 0xdb, 0xf5, 0x33, 0xa3, 0xc8, 0x92, 0x9a, 0xda, 0xb0, 0x54, 0x43, 0x43, 0x9f, 0x42, 0xb7,
+	0x5c, 0x3b, 0x33, 0xbb, 0x72, 0x69, 0x45, 0x6d, 0xa8, 0x7f, 0x83, 0x59, 0xe4, 0xef, 0x03, 0x14,
+	0x35, 0x36, 0xb3, 0x02, 0x0b, 0x55, 0xb7, 0xb9, 0x59, 0x0f, 0xa1, 0x37, 0x57, 0x3b, 0x33, 0x1a,
+	0x2f, 0x2f, 0xa9, 0x5d, 0xe7, 0x2e, 0xac, 0x4a, 0x98, 0xd9, 0xc5, 0x8b, 0xb5, 0xb4, 0xe1, 0xee,
+	0x92, 0x1e, 0xbd, 0x87, 0x1e, 0x40, 0x6b, 0xbc, 0xc8, 0x63, 0xbc, 0x92, 0xc7, 0xb2, 0x62, 0xd8,
+	0x07, 0x00, 0x45, 0x78, 0x6f, 0xac, 0xb0, 0x10, 0xf0, 0x0f, 0x3b, 0xe6, 0x57, 0xa5, 0xa2, 0xdb,
+	0x87, 0xb6, 0x7d, 0x13, 0x99, 0x55, 0x5b, 0x72, 0x3b, 0x5d, 0xe7, 0xb5, 0xad, 0x5b, 0x2b, 0x17,
+	0x7e, 0xe1, 0x22, 0xbb, 0xce, 0x6b, 0x97, 0x8a, 0xec, 0x66, 0xff, 0x2e, 0xab, 0xbc, 0x5f, 0x77,
+	0x97, 0x95, 0x2b, 0xd2, 0x66, 0x17, 0x2d, 0xad, 0x53, 0x5f, 0x77, 0x1c, 0xed, 0xea, 0xa1, 0xb1,
+	0xc7, 0x92, 0x8a, 0xe2, 0xb7, 0xb8, 0x47, 0xbb, 0xfa, 0x67, 0xb9, 0xc7, 0x25, 0x45, 0xc1, 0x95,
+	0x8c, 0x1e, 0x43, 0xef, 0xd0, 0x14, 0x76, 0x74, 0xd1, 0xc9, 0xec, 0xa1, 0xc5, 0x22, 0xdb, 0x70,
+	0xb8, 0xac, 0x4b, 0xef, 0x8d, 0x2f, 0xa0, 0xbf, 0x50, 0x70, 0x42, 0xb7, 0xf2, 0x9f, 0xd6, 0x4b,
+	0x2b, 0x51, 0x2b, 0xc5, 0x3a, 0x92, 0xb9, 0x42, 0xa9, 0xde, 0x84, 0x7e, 0x98, 0xef, 0xcb, 0x65,
+	0x75, 0xa8, 0x95, 0xac, 0x3e, 0x82, 0x86, 0xc9, 0xe7, 0x91, 0x8e, 0xc4, 0xe6, 0xf2, 0xfb, 0x95,
+	0x43, 0xef, 0xcb, 0x63, 0x97, 0xe7, 0xca, 0xc5, 0xb1, 0x9b, 0xcb, 0xa8, 0x87, 0xfa, 0x5f, 0x7e,
+	0x4e, 0x79, 0x1f, 0xea, 0x3a, 0x65, 0x46, 0xdb, 0xf9, 0x81, 0xb7, 0x32, 0xe8, 0xeb, 0x76, 0xd8,
+	0x21, 0xe6, 0x56, 0x22, 0x6c, 0x26, 0x5d, 0xcc, 0x8d, 0x87, 0xbb, 0x4b, 0x7a, 0xf4, 0x5a, 0xec,
+	0x43, 0xdb, 0x4e, 0x85, 0xcd, 0x92, 0x2e, 0x49, 0x8f, 0x57, 0x4a, 0x72, 0x0c, 0x68, 0x31, 0x6b,
+	0x44, 0xaf, 0xe9, 0x35, 0x58, 0x95, 0x4f, 0xae, 0x64, 0xf7, 0x09, 0x34, 0xf3, 0xe4, 0x0f, 0xed,
+	0xe4, 0x2b, 0x59, 0xca, 0xf0, 0x56, 0x0e, 0xfe, 0x29, 0x34, 0x0f, 0xe7, 0x07, 0xcf, 0xa7, 0x87,
+	0xc6, 0xf5, 0x6a, 0xaa, 0x7d, 0x68, 0xdb, 0xa9, 0xa0, 0xb1, 0xc0, 0x92, 0xf4, 0x70, 0xe5, 0xac,
+	0xbf, 0x94, 0x6b, 0x61, 0xa7, 0x3e, 0xaf, 0xe4, 0x53, 0x2f, 0xa6, 0x81, 0xc3, 0xfe, 0x42, 0x22,
+	0x24, 0xbd, 0x56, 0x91, 0xfd, 0x58, 0x2e, 0x77, 0x2e, 0x21, 0x5a, 0x29, 0xc2, 0xcf, 0xa1, 0x53,
+	0x4a, 0x89, 0x8c, 0xd7, 0x5a, 0x96, 0x27, 0x0d, 0x7b, 0x73, 0x69, 0x86, 0x5c, 0xc2, 0x85, 0xbc,
+	0x22, 0x5f, 0xc2, 0x55, 0x19, 0xc7, 0x2a, 0x61, 0x1e, 0x5c, 0x7e, 0xfd, 0xfb, 0x5b, 0x3f, 0xf8,
+	0xef, 0xdf, 0xdf, 0xfa, 0xc1, 0x5f, 0x7f, 0x73, 0xab, 0xf2, 0xf5, 0x37, 0xb7, 0x2a, 0xff, 0xf5,
+	0xcd, 0xad, 0xca, 0xff, 0x7e, 0x73, 0xab, 0xf2, 0xeb, 0xbf, 0xf8, 0x9e, 0xef, 0xd6, 0x59, 0x16,
+	0x8b, 0xcc, 0xea, 0xee, 0x05, 0x61, 0xdc, 0xea, 0x4a, 0xce, 0xa7, 0xea, 0xf1, 0xba, 0xf5, 0xa6,
+	0x5d, 0xc8, 0x3a, 0xd9, 0x90, 0xf0, 0xfb, 0xff, 0x3f, 0x00, 0x86, 0x2a, 0x54, 0xd2, 0x20, 0x2f,
+	0x00, 0x00,
+}
+
+func (m *CreateContainerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3433,12 +3894,12 @@ func (m *CpuStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CpuStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CpuStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3447,9 +3908,19 @@ func (m *CpuStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ThrottlingData != nil {
+	if m.SandboxPidns {
+		i--
+		if m.SandboxPidns {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OCI != nil {
 		{
-			size, err := m.ThrottlingData.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.OCI.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3457,11 +3928,39 @@ func (m *CpuStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintAgent(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x32
 	}
-	if m.CpuUsage != nil {
+	if len(m.Storages) > 0 {
+		for iNdEx := len(m.Storages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Devices) > 0 {
+		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Devices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StringUser != nil {
 		{
-			size, err := m.CpuUsage.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StringUser.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3469,12 +3968,26 @@ func (m *CpuStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintAgent(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExecId) > 0 {
+		i -= len(m.ExecId)
+		copy(dAtA[i:], m.ExecId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PidsStats) Marshal() (dAtA []byte, err error) {
+func (m *StartContainerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3484,12 +3997,12 @@ func (m *PidsStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PidsStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *StartContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PidsStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StartContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3498,20 +4011,17 @@ func (m *PidsStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Limit != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Current != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Current))
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MemoryData) Marshal() (dAtA []byte, err error) {
+func (m *RemoveContainerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3521,12 +4031,12 @@ func (m *MemoryData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MemoryData) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MemoryData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3535,30 +4045,22 @@ func (m *MemoryData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Limit != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Failcnt != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Failcnt))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MaxUsage != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.MaxUsage))
+	if m.Timeout != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Timeout))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Usage != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Usage))
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MemoryStats) Marshal() (dAtA []byte, err error) {
+func (m *ExecProcessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3568,12 +4070,12 @@ func (m *MemoryStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MemoryStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecProcessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MemoryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExecProcessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3582,36 +4084,9 @@ func (m *MemoryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Stats) > 0 {
-		for k := range m.Stats {
-			v := m.Stats[k]
-			baseI := i
-			i = encodeVarintAgent(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintAgent(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.UseHierarchy {
-		i--
-		if m.UseHierarchy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.KernelUsage != nil {
+	if m.Process != nil {
 		{
-			size, err := m.KernelUsage.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Process.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3621,9 +4096,9 @@ func (m *MemoryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.SwapUsage != nil {
+	if m.StringUser != nil {
 		{
-			size, err := m.SwapUsage.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StringUser.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3633,27 +4108,24 @@ func (m *MemoryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Usage != nil {
-		{
-			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
-		}
+	if len(m.ExecId) > 0 {
+		i -= len(m.ExecId)
+		copy(dAtA[i:], m.ExecId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Cache != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Cache))
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlkioStatsEntry) Marshal() (dAtA []byte, err error) {
+func (m *SignalProcessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3663,12 +4135,12 @@ func (m *BlkioStatsEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlkioStatsEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalProcessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlkioStatsEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalProcessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3677,32 +4149,102 @@ func (m *BlkioStatsEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Value != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Value))
+	if m.Signal != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Signal))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x18
 	}
-	if len(m.Op) > 0 {
-		i -= len(m.Op)
-		copy(dAtA[i:], m.Op)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Op)))
+	if len(m.ExecId) > 0 {
+		i -= len(m.ExecId)
+		copy(dAtA[i:], m.ExecId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if m.Minor != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Minor))
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xa
 	}
-	if m.Major != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Major))
+	return len(dAtA) - i, nil
+}
+
+func (m *WaitProcessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WaitProcessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WaitProcessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExecId) > 0 {
+		i -= len(m.ExecId)
+		copy(dAtA[i:], m.ExecId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WaitProcessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WaitProcessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WaitProcessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Status))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlkioStats) Marshal() (dAtA []byte, err error) {
+func (m *UpdateContainerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3712,12 +4254,12 @@ func (m *BlkioStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlkioStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlkioStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3726,122 +4268,29 @@ func (m *BlkioStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.SectorsRecursive) > 0 {
-		for iNdEx := len(m.SectorsRecursive) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SectorsRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
+	if m.Resources != nil {
+		{
+			size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x42
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.IoTimeRecursive) > 0 {
-		for iNdEx := len(m.IoTimeRecursive) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IoTimeRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.IoMergedRecursive) > 0 {
-		for iNdEx := len(m.IoMergedRecursive) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IoMergedRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.IoWaitTimeRecursive) > 0 {
-		for iNdEx := len(m.IoWaitTimeRecursive) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IoWaitTimeRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.IoServiceTimeRecursive) > 0 {
-		for iNdEx := len(m.IoServiceTimeRecursive) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IoServiceTimeRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.IoQueuedRecursive) > 0 {
-		for iNdEx := len(m.IoQueuedRecursive) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IoQueuedRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.IoServicedRecursive) > 0 {
-		for iNdEx := len(m.IoServicedRecursive) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IoServicedRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.IoServiceBytesRecursive) > 0 {
-		for iNdEx := len(m.IoServiceBytesRecursive) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IoServiceBytesRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HugetlbStats) Marshal() (dAtA []byte, err error) {
+func (m *StatsContainerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3851,12 +4300,12 @@ func (m *HugetlbStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HugetlbStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *StatsContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HugetlbStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StatsContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3865,25 +4314,17 @@ func (m *HugetlbStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Failcnt != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Failcnt))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MaxUsage != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.MaxUsage))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Usage != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Usage))
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CgroupStats) Marshal() (dAtA []byte, err error) {
+func (m *PauseContainerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3893,12 +4334,12 @@ func (m *CgroupStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CgroupStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *PauseContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CgroupStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PauseContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3907,84 +4348,17 @@ func (m *CgroupStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.HugetlbStats) > 0 {
-		for k := range m.HugetlbStats {
-			v := m.HugetlbStats[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintAgent(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintAgent(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.BlkioStats != nil {
-		{
-			size, err := m.BlkioStats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.PidsStats != nil {
-		{
-			size, err := m.PidsStats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.MemoryStats != nil {
-		{
-			size, err := m.MemoryStats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CpuStats != nil {
-		{
-			size, err := m.CpuStats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
-		}
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NetworkStats) Marshal() (dAtA []byte, err error) {
+func (m *ResumeContainerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3994,12 +4368,12 @@ func (m *NetworkStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NetworkStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResumeContainerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NetworkStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResumeContainerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4008,57 +4382,17 @@ func (m *NetworkStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TxDropped != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.TxDropped))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.TxErrors != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.TxErrors))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.TxPackets != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.TxPackets))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.TxBytes != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.TxBytes))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.RxDropped != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.RxDropped))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.RxErrors != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.RxErrors))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.RxPackets != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.RxPackets))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.RxBytes != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.RxBytes))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StatsContainerResponse) Marshal() (dAtA []byte, err error) {
+func (m *CpuUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4068,12 +4402,12 @@ func (m *StatsContainerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StatsContainerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CpuUsage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StatsContainerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CpuUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4082,36 +4416,43 @@ func (m *StatsContainerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NetworkStats) > 0 {
-		for iNdEx := len(m.NetworkStats) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NetworkStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.UsageInUsermode != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.UsageInUsermode))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.CgroupStats != nil {
-		{
-			size, err := m.CgroupStats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if m.UsageInKernelmode != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.UsageInKernelmode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PercpuUsage) > 0 {
+		dAtA7 := make([]byte, len(m.PercpuUsage)*10)
+		var j6 int
+		for _, num := range m.PercpuUsage {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
 			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintAgent(dAtA, i, uint64(j6))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.TotalUsage != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.TotalUsage))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WriteStreamRequest) Marshal() (dAtA []byte, err error) {
+func (m *ThrottlingData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4121,12 +4462,12 @@ func (m *WriteStreamRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WriteStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ThrottlingData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WriteStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ThrottlingData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4135,31 +4476,25 @@ func (m *WriteStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+	if m.ThrottledTime != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ThrottledTime))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if len(m.ExecId) > 0 {
-		i -= len(m.ExecId)
-		copy(dAtA[i:], m.ExecId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+	if m.ThrottledPeriods != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ThrottledPeriods))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+	if m.Periods != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Periods))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WriteStreamResponse) Marshal() (dAtA []byte, err error) {
+func (m *CpuStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4169,12 +4504,12 @@ func (m *WriteStreamResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WriteStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CpuStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WriteStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CpuStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4183,15 +4518,34 @@ func (m *WriteStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Len != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Len))
+	if m.ThrottlingData != nil {
+		{
+			size, err := m.ThrottlingData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.CpuUsage != nil {
+		{
+			size, err := m.CpuUsage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReadStreamRequest) Marshal() (dAtA []byte, err error) {
+func (m *PidsStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4201,12 +4555,12 @@ func (m *ReadStreamRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReadStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PidsStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReadStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PidsStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4215,29 +4569,20 @@ func (m *ReadStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Len != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Len))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ExecId) > 0 {
-		i -= len(m.ExecId)
-		copy(dAtA[i:], m.ExecId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+	if m.Limit != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Limit))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+	if m.Current != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Current))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReadStreamResponse) Marshal() (dAtA []byte, err error) {
+func (m *MemoryData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4247,12 +4592,12 @@ func (m *ReadStreamResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReadStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MemoryData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReadStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MemoryData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4261,17 +4606,30 @@ func (m *ReadStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+	if m.Limit != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Limit))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x20
+	}
+	if m.Failcnt != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Failcnt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxUsage != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxUsage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Usage != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Usage))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CloseStdinRequest) Marshal() (dAtA []byte, err error) {
+func (m *MemoryStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4281,12 +4639,12 @@ func (m *CloseStdinRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CloseStdinRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MemoryStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CloseStdinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MemoryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4295,24 +4653,78 @@ func (m *CloseStdinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ExecId) > 0 {
-		i -= len(m.ExecId)
-		copy(dAtA[i:], m.ExecId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+	if len(m.Stats) > 0 {
+		for k := range m.Stats {
+			v := m.Stats[k]
+			baseI := i
+			i = encodeVarintAgent(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAgent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.UseHierarchy {
+		i--
+		if m.UseHierarchy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.KernelUsage != nil {
+		{
+			size, err := m.KernelUsage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SwapUsage != nil {
+		{
+			size, err := m.SwapUsage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+	if m.Cache != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Cache))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TtyWinResizeRequest) Marshal() (dAtA []byte, err error) {
+func (m *BlkioStatsEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4322,12 +4734,12 @@ func (m *TtyWinResizeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TtyWinResizeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlkioStatsEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TtyWinResizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlkioStatsEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4336,34 +4748,32 @@ func (m *TtyWinResizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Column != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Column))
+	if m.Value != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Value))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.Row != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Row))
+	if len(m.Op) > 0 {
+		i -= len(m.Op)
+		copy(dAtA[i:], m.Op)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Op)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if len(m.ExecId) > 0 {
-		i -= len(m.ExecId)
-		copy(dAtA[i:], m.ExecId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+	if m.Minor != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Minor))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+	if m.Major != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Major))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *KernelModule) Marshal() (dAtA []byte, err error) {
+func (m *BlkioStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4373,12 +4783,12 @@ func (m *KernelModule) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KernelModule) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlkioStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KernelModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlkioStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4387,53 +4797,24 @@ func (m *KernelModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Parameters) > 0 {
-		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Parameters[iNdEx])
-			copy(dAtA[i:], m.Parameters[iNdEx])
-			i = encodeVarintAgent(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+	if len(m.SectorsRecursive) > 0 {
+		for iNdEx := len(m.SectorsRecursive) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SectorsRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x42
 		}
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CreateSandboxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateSandboxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CreateSandboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.KernelModules) > 0 {
-		for iNdEx := len(m.KernelModules) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.IoTimeRecursive) > 0 {
+		for iNdEx := len(m.IoTimeRecursive) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.KernelModules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.IoTimeRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4444,34 +4825,52 @@ func (m *CreateSandboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.GuestHookPath) > 0 {
-		i -= len(m.GuestHookPath)
-		copy(dAtA[i:], m.GuestHookPath)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.GuestHookPath)))
-		i--
-		dAtA[i] = 0x32
+	if len(m.IoMergedRecursive) > 0 {
+		for iNdEx := len(m.IoMergedRecursive) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IoMergedRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if len(m.SandboxId) > 0 {
-		i -= len(m.SandboxId)
-		copy(dAtA[i:], m.SandboxId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.SandboxId)))
-		i--
-		dAtA[i] = 0x2a
+	if len(m.IoWaitTimeRecursive) > 0 {
+		for iNdEx := len(m.IoWaitTimeRecursive) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IoWaitTimeRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.SandboxPidns {
-		i--
-		if m.SandboxPidns {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.IoServiceTimeRecursive) > 0 {
+		for iNdEx := len(m.IoServiceTimeRecursive) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IoServiceTimeRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	if len(m.Storages) > 0 {
-		for iNdEx := len(m.Storages) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.IoQueuedRecursive) > 0 {
+		for iNdEx := len(m.IoQueuedRecursive) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Storages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.IoQueuedRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4482,26 +4881,38 @@ func (m *CreateSandboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Dns) > 0 {
-		for iNdEx := len(m.Dns) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Dns[iNdEx])
-			copy(dAtA[i:], m.Dns[iNdEx])
-			i = encodeVarintAgent(dAtA, i, uint64(len(m.Dns[iNdEx])))
+	if len(m.IoServicedRecursive) > 0 {
+		for iNdEx := len(m.IoServicedRecursive) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IoServicedRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Hostname) > 0 {
-		i -= len(m.Hostname)
-		copy(dAtA[i:], m.Hostname)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Hostname)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.IoServiceBytesRecursive) > 0 {
+		for iNdEx := len(m.IoServiceBytesRecursive) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IoServiceBytesRecursive[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DestroySandboxRequest) Marshal() (dAtA []byte, err error) {
+func (m *HugetlbStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4511,12 +4922,12 @@ func (m *DestroySandboxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DestroySandboxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *HugetlbStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DestroySandboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HugetlbStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4525,10 +4936,25 @@ func (m *DestroySandboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Failcnt != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Failcnt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxUsage != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxUsage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Usage != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Usage))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Interfaces) Marshal() (dAtA []byte, err error) {
+func (m *CgroupStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4538,12 +4964,12 @@ func (m *Interfaces) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Interfaces) MarshalTo(dAtA []byte) (int, error) {
+func (m *CgroupStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Interfaces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CgroupStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4552,65 +4978,84 @@ func (m *Interfaces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Interfaces) > 0 {
-		for iNdEx := len(m.Interfaces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Interfaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	if len(m.HugetlbStats) > 0 {
+		for k := range m.HugetlbStats {
+			v := m.HugetlbStats[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintAgent(dAtA, i, uint64(size))
 				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
+				i--
+				dAtA[i] = 0x12
 			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAgent(dAtA, i, uint64(len(k)))
 			i--
 			dAtA[i] = 0xa
+			i = encodeVarintAgent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Routes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.BlkioStats != nil {
+		{
+			size, err := m.BlkioStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Routes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Routes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.PidsStats != nil {
+		{
+			size, err := m.PidsStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
+	if m.MemoryStats != nil {
+		{
+			size, err := m.MemoryStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CpuStats != nil {
+		{
+			size, err := m.CpuStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateInterfaceRequest) Marshal() (dAtA []byte, err error) {
+func (m *NetworkStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4620,12 +5065,12 @@ func (m *UpdateInterfaceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateInterfaceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *NetworkStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateInterfaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NetworkStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4634,22 +5079,57 @@ func (m *UpdateInterfaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Interface != nil {
-		{
-			size, err := m.Interface.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
-		}
+	if m.TxDropped != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.TxDropped))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TxErrors != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.TxErrors))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TxPackets != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.TxPackets))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TxBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.TxBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RxDropped != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.RxDropped))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RxErrors != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.RxErrors))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RxPackets != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.RxPackets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RxBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.RxBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateRoutesRequest) Marshal() (dAtA []byte, err error) {
+func (m *StatsContainerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4659,12 +5139,12 @@ func (m *UpdateRoutesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StatsContainerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StatsContainerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4673,9 +5153,23 @@ func (m *UpdateRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Routes != nil {
+	if len(m.NetworkStats) > 0 {
+		for iNdEx := len(m.NetworkStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetworkStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CgroupStats != nil {
 		{
-			size, err := m.Routes.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CgroupStats.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4688,7 +5182,7 @@ func (m *UpdateRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ListInterfacesRequest) Marshal() (dAtA []byte, err error) {
+func (m *WriteStreamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4698,12 +5192,12 @@ func (m *ListInterfacesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListInterfacesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *WriteStreamRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListInterfacesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WriteStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4712,10 +5206,31 @@ func (m *ListInterfacesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExecId) > 0 {
+		i -= len(m.ExecId)
+		copy(dAtA[i:], m.ExecId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListRoutesRequest) Marshal() (dAtA []byte, err error) {
+func (m *WriteStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4725,12 +5240,12 @@ func (m *ListRoutesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *WriteStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WriteStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4739,10 +5254,15 @@ func (m *ListRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Len != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Len))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ARPNeighbors) Marshal() (dAtA []byte, err error) {
+func (m *ReadStreamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4752,12 +5272,12 @@ func (m *ARPNeighbors) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ARPNeighbors) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReadStreamRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ARPNeighbors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReadStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4766,24 +5286,29 @@ func (m *ARPNeighbors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ARPNeighbors) > 0 {
-		for iNdEx := len(m.ARPNeighbors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ARPNeighbors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.Len != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Len))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ExecId) > 0 {
+		i -= len(m.ExecId)
+		copy(dAtA[i:], m.ExecId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddARPNeighborsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReadStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4793,12 +5318,12 @@ func (m *AddARPNeighborsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddARPNeighborsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReadStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddARPNeighborsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReadStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4807,22 +5332,17 @@ func (m *AddARPNeighborsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Neighbors != nil {
-		{
-			size, err := m.Neighbors.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
-		}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OnlineCPUMemRequest) Marshal() (dAtA []byte, err error) {
+func (m *CloseStdinRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4832,12 +5352,12 @@ func (m *OnlineCPUMemRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OnlineCPUMemRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CloseStdinRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OnlineCPUMemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CloseStdinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4846,35 +5366,24 @@ func (m *OnlineCPUMemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CpuOnly {
-		i--
-		if m.CpuOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.ExecId) > 0 {
+		i -= len(m.ExecId)
+		copy(dAtA[i:], m.ExecId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
-	if m.NbCpus != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.NbCpus))
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.Wait {
-		i--
-		if m.Wait {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReseedRandomDevRequest) Marshal() (dAtA []byte, err error) {
+func (m *OpenProcessIORequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4884,12 +5393,12 @@ func (m *ReseedRandomDevRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReseedRandomDevRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OpenProcessIORequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReseedRandomDevRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OpenProcessIORequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4898,17 +5407,34 @@ func (m *ReseedRandomDevRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+	if m.Bidirectional {
+		i--
+		if m.Bidirectional {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ExecId) > 0 {
+		i -= len(m.ExecId)
+		copy(dAtA[i:], m.ExecId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
 		i--
 		dAtA[i] = 0x12
 	}
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AgentDetails) Marshal() (dAtA []byte, err error) {
+func (m *ProcessIOPorts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4918,12 +5444,12 @@ func (m *AgentDetails) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AgentDetails) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProcessIOPorts) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AgentDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProcessIOPorts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4932,55 +5458,25 @@ func (m *AgentDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SupportsSeccomp {
-		i--
-		if m.SupportsSeccomp {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.StderrPort != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.StderrPort))
 		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.StorageHandlers) > 0 {
-		for iNdEx := len(m.StorageHandlers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.StorageHandlers[iNdEx])
-			copy(dAtA[i:], m.StorageHandlers[iNdEx])
-			i = encodeVarintAgent(dAtA, i, uint64(len(m.StorageHandlers[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.DeviceHandlers) > 0 {
-		for iNdEx := len(m.DeviceHandlers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DeviceHandlers[iNdEx])
-			copy(dAtA[i:], m.DeviceHandlers[iNdEx])
-			i = encodeVarintAgent(dAtA, i, uint64(len(m.DeviceHandlers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+		dAtA[i] = 0x18
 	}
-	if m.InitDaemon {
-		i--
-		if m.InitDaemon {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.StdoutPort != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.StdoutPort))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Version)))
+	if m.StdinPort != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.StdinPort))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GuestDetailsRequest) Marshal() (dAtA []byte, err error) {
+func (m *TtyWinResizeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4990,12 +5486,12 @@ func (m *GuestDetailsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GuestDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TtyWinResizeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GuestDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TtyWinResizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5004,30 +5500,34 @@ func (m *GuestDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MemHotplugProbe {
+	if m.Column != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Column))
 		i--
-		if m.MemHotplugProbe {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x20
+	}
+	if m.Row != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Row))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
-	if m.MemBlockSize {
+	if len(m.ExecId) > 0 {
+		i -= len(m.ExecId)
+		copy(dAtA[i:], m.ExecId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ExecId)))
 		i--
-		if m.MemBlockSize {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x12
+	}
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GuestDetailsResponse) Marshal() (dAtA []byte, err error) {
+func (m *KernelModule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5037,12 +5537,12 @@ func (m *GuestDetailsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GuestDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *KernelModule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GuestDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KernelModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5051,37 +5551,26 @@ func (m *GuestDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SupportMemHotplugProbe {
-		i--
-		if m.SupportMemHotplugProbe {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AgentDetails != nil {
-		{
-			size, err := m.AgentDetails.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parameters[iNdEx])
+			copy(dAtA[i:], m.Parameters[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.MemBlockSizeBytes != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.MemBlockSizeBytes))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MemHotplugByProbeRequest) Marshal() (dAtA []byte, err error) {
+func (m *CreateSandboxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5091,12 +5580,12 @@ func (m *MemHotplugByProbeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MemHotplugByProbeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateSandboxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MemHotplugByProbeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateSandboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5105,28 +5594,78 @@ func (m *MemHotplugByProbeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.MemHotplugProbeAddr) > 0 {
-		dAtA24 := make([]byte, len(m.MemHotplugProbeAddr)*10)
-		var j23 int
-		for _, num := range m.MemHotplugProbeAddr {
-			for num >= 1<<7 {
-				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j23++
+	if len(m.KernelModules) > 0 {
+		for iNdEx := len(m.KernelModules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KernelModules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
 			}
-			dAtA24[j23] = uint8(num)
-			j23++
+			i--
+			dAtA[i] = 0x3a
 		}
-		i -= j23
-		copy(dAtA[i:], dAtA24[:j23])
-		i = encodeVarintAgent(dAtA, i, uint64(j23))
+	}
+	if len(m.GuestHookPath) > 0 {
+		i -= len(m.GuestHookPath)
+		copy(dAtA[i:], m.GuestHookPath)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.GuestHookPath)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SandboxId) > 0 {
+		i -= len(m.SandboxId)
+		copy(dAtA[i:], m.SandboxId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.SandboxId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SandboxPidns {
+		i--
+		if m.SandboxPidns {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Storages) > 0 {
+		for iNdEx := len(m.Storages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Dns) > 0 {
+		for iNdEx := len(m.Dns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dns[iNdEx])
+			copy(dAtA[i:], m.Dns[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Dns[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hostname) > 0 {
+		i -= len(m.Hostname)
+		copy(dAtA[i:], m.Hostname)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Hostname)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SetGuestDateTimeRequest) Marshal() (dAtA []byte, err error) {
+func (m *DestroySandboxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5136,12 +5675,12 @@ func (m *SetGuestDateTimeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetGuestDateTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DestroySandboxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetGuestDateTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DestroySandboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5150,20 +5689,10 @@ func (m *SetGuestDateTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Usec != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Usec))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Sec != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Sec))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FSGroup) Marshal() (dAtA []byte, err error) {
+func (m *Interfaces) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5173,12 +5702,12 @@ func (m *FSGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FSGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *Interfaces) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FSGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Interfaces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5187,20 +5716,24 @@ func (m *FSGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.GroupChangePolicy != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.GroupChangePolicy))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.GroupId != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Interfaces) > 0 {
+		for iNdEx := len(m.Interfaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Interfaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Storage) Marshal() (dAtA []byte, err error) {
+func (m *Routes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5210,12 +5743,12 @@ func (m *Storage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Storage) MarshalTo(dAtA []byte) (int, error) {
+func (m *Routes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Storage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Routes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5224,68 +5757,24 @@ func (m *Storage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.FsGroup != nil {
-		{
-			size, err := m.FsGroup.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.MountPoint) > 0 {
-		i -= len(m.MountPoint)
-		copy(dAtA[i:], m.MountPoint)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.MountPoint)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Options) > 0 {
-		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Options[iNdEx])
-			copy(dAtA[i:], m.Options[iNdEx])
-			i = encodeVarintAgent(dAtA, i, uint64(len(m.Options[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Fstype) > 0 {
-		i -= len(m.Fstype)
-		copy(dAtA[i:], m.Fstype)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Fstype)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DriverOptions) > 0 {
-		for iNdEx := len(m.DriverOptions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DriverOptions[iNdEx])
-			copy(dAtA[i:], m.DriverOptions[iNdEx])
-			i = encodeVarintAgent(dAtA, i, uint64(len(m.DriverOptions[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Driver) > 0 {
-		i -= len(m.Driver)
-		copy(dAtA[i:], m.Driver)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Driver)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Device) Marshal() (dAtA []byte, err error) {
+func (m *UpdateInterfaceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5295,12 +5784,12 @@ func (m *Device) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Device) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateInterfaceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Device) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateInterfaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5309,47 +5798,22 @@ func (m *Device) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Options) > 0 {
-		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Options[iNdEx])
-			copy(dAtA[i:], m.Options[iNdEx])
-			i = encodeVarintAgent(dAtA, i, uint64(len(m.Options[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+	if m.Interface != nil {
+		{
+			size, err := m.Interface.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.ContainerPath) > 0 {
-		i -= len(m.ContainerPath)
-		copy(dAtA[i:], m.ContainerPath)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerPath)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.VmPath) > 0 {
-		i -= len(m.VmPath)
-		copy(dAtA[i:], m.VmPath)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.VmPath)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StringUser) Marshal() (dAtA []byte, err error) {
+func (m *UpdateRoutesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5359,12 +5823,12 @@ func (m *StringUser) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StringUser) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StringUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5373,33 +5837,22 @@ func (m *StringUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.AdditionalGids) > 0 {
-		for iNdEx := len(m.AdditionalGids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AdditionalGids[iNdEx])
-			copy(dAtA[i:], m.AdditionalGids[iNdEx])
-			i = encodeVarintAgent(dAtA, i, uint64(len(m.AdditionalGids[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	if m.Routes != nil {
+		{
+			size, err := m.Routes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.Gid) > 0 {
-		i -= len(m.Gid)
-		copy(dAtA[i:], m.Gid)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Gid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Uid) > 0 {
-		i -= len(m.Uid)
-		copy(dAtA[i:], m.Uid)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Uid)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListInterfacesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5409,12 +5862,12 @@ func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CopyFileRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListInterfacesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CopyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListInterfacesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5423,54 +5876,10 @@ func (m *CopyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Offset != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Gid != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Gid))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Uid != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Uid))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.DirMode != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.DirMode))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.FileMode != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.FileMode))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.FileSize != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.FileSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetOOMEventRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListRoutesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5480,12 +5889,12 @@ func (m *GetOOMEventRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetOOMEventRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetOOMEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5497,7 +5906,7 @@ func (m *GetOOMEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *OOMEvent) Marshal() (dAtA []byte, err error) {
+func (m *ARPNeighbors) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5507,12 +5916,12 @@ func (m *OOMEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OOMEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *ARPNeighbors) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OOMEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ARPNeighbors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5521,17 +5930,24 @@ func (m *OOMEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ContainerId) > 0 {
-		i -= len(m.ContainerId)
-		copy(dAtA[i:], m.ContainerId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.ARPNeighbors) > 0 {
+		for iNdEx := len(m.ARPNeighbors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ARPNeighbors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddSwapRequest) Marshal() (dAtA []byte, err error) {
+func (m *AddARPNeighborsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5541,12 +5957,12 @@ func (m *AddSwapRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddARPNeighborsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddARPNeighborsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5555,28 +5971,22 @@ func (m *AddSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PCIPath) > 0 {
-		dAtA27 := make([]byte, len(m.PCIPath)*10)
-		var j26 int
-		for _, num := range m.PCIPath {
-			for num >= 1<<7 {
-				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j26++
+	if m.Neighbors != nil {
+		{
+			size, err := m.Neighbors.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA27[j26] = uint8(num)
-			j26++
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
 		}
-		i -= j26
-		copy(dAtA[i:], dAtA27[:j26])
-		i = encodeVarintAgent(dAtA, i, uint64(j26))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetMetricsRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetIPTablesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5586,12 +5996,12 @@ func (m *GetMetricsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetIPTablesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetIPTablesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5600,10 +6010,20 @@ func (m *GetMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IsIpv6 {
+		i--
+		if m.IsIpv6 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Metrics) Marshal() (dAtA []byte, err error) {
+func (m *GetIPTablesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5613,12 +6033,12 @@ func (m *Metrics) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetIPTablesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetIPTablesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5627,17 +6047,17 @@ func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Metrics) > 0 {
-		i -= len(m.Metrics)
-		copy(dAtA[i:], m.Metrics)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Metrics)))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VolumeStatsRequest) Marshal() (dAtA []byte, err error) {
+func (m *SetIPTablesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5647,12 +6067,12 @@ func (m *VolumeStatsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VolumeStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetIPTablesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VolumeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetIPTablesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5661,17 +6081,27 @@ func (m *VolumeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.VolumeGuestPath) > 0 {
-		i -= len(m.VolumeGuestPath)
-		copy(dAtA[i:], m.VolumeGuestPath)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.VolumeGuestPath)))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.IsIpv6 {
+		i--
+		if m.IsIpv6 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResizeVolumeRequest) Marshal() (dAtA []byte, err error) {
+func (m *SetIPTablesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5681,12 +6111,12 @@ func (m *ResizeVolumeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResizeVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetIPTablesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResizeVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetIPTablesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5695,1272 +6125,1777 @@ func (m *ResizeVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Size_ != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.VolumeGuestPath) > 0 {
-		i -= len(m.VolumeGuestPath)
-		copy(dAtA[i:], m.VolumeGuestPath)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.VolumeGuestPath)))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAgent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAgent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OnlineCPUMemRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CreateContainerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *OnlineCPUMemRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnlineCPUMemRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.StringUser != nil {
-		l = m.StringUser.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Devices) > 0 {
-		for _, e := range m.Devices {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.NbMemBlocks != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.NbMemBlocks))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Storages) > 0 {
-		for _, e := range m.Storages {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if m.CpuOnly {
+		i--
+		if m.CpuOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.OCI != nil {
-		l = m.OCI.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.SandboxPidns {
-		n += 2
+	if m.NbCpus != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.NbCpus))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Wait {
+		i--
+		if m.Wait {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StartContainerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ReseedRandomDevRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RemoveContainerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.Timeout != 0 {
-		n += 1 + sovAgent(uint64(m.Timeout))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *ReseedRandomDevRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExecProcessRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReseedRandomDevRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.StringUser != nil {
-		l = m.StringUser.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.Process != nil {
-		l = m.Process.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SignalProcessRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AgentDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AgentDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AgentDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.Signal != 0 {
-		n += 1 + sovAgent(uint64(m.Signal))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *WaitProcessRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SupportsSeccomp {
+		i--
+		if m.SupportsSeccomp {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.StorageHandlers) > 0 {
+		for iNdEx := len(m.StorageHandlers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StorageHandlers[iNdEx])
+			copy(dAtA[i:], m.StorageHandlers[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.StorageHandlers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.DeviceHandlers) > 0 {
+		for iNdEx := len(m.DeviceHandlers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeviceHandlers[iNdEx])
+			copy(dAtA[i:], m.DeviceHandlers[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.DeviceHandlers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.InitDaemon {
+		i--
+		if m.InitDaemon {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *WaitProcessResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovAgent(uint64(m.Status))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GuestDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpdateContainerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.Resources != nil {
-		l = m.Resources.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *GuestDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StatsContainerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GuestDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *PauseContainerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.MemHotplugProbe {
+		i--
+		if m.MemHotplugProbe {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.MemBlockSize {
+		i--
+		if m.MemBlockSize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResumeContainerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GuestDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CpuUsage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GuestDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GuestDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TotalUsage != 0 {
-		n += 1 + sovAgent(uint64(m.TotalUsage))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PercpuUsage) > 0 {
-		l = 0
-		for _, e := range m.PercpuUsage {
-			l += sovAgent(uint64(e))
+	if m.SupportMemHotplugProbe {
+		i--
+		if m.SupportMemHotplugProbe {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 1 + sovAgent(uint64(l)) + l
-	}
-	if m.UsageInKernelmode != 0 {
-		n += 1 + sovAgent(uint64(m.UsageInKernelmode))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.UsageInUsermode != 0 {
-		n += 1 + sovAgent(uint64(m.UsageInUsermode))
+	if m.AgentDetails != nil {
+		{
+			size, err := m.AgentDetails.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.MemBlockSizeBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MemBlockSizeBytes))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ThrottlingData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MemHotplugByProbeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MemHotplugByProbeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemHotplugByProbeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Periods != 0 {
-		n += 1 + sovAgent(uint64(m.Periods))
-	}
-	if m.ThrottledPeriods != 0 {
-		n += 1 + sovAgent(uint64(m.ThrottledPeriods))
-	}
-	if m.ThrottledTime != 0 {
-		n += 1 + sovAgent(uint64(m.ThrottledTime))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.MemHotplugProbeAddr) > 0 {
+		dAtA24 := make([]byte, len(m.MemHotplugProbeAddr)*10)
+		var j23 int
+		for _, num := range m.MemHotplugProbeAddr {
+			for num >= 1<<7 {
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA24[j23] = uint8(num)
+			j23++
+		}
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintAgent(dAtA, i, uint64(j23))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CpuStats) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetGuestDateTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetGuestDateTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetGuestDateTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CpuUsage != nil {
-		l = m.CpuUsage.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ThrottlingData != nil {
-		l = m.ThrottlingData.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Usec != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Usec))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Sec != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Sec))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PidsStats) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FSGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FSGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FSGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Current != 0 {
-		n += 1 + sovAgent(uint64(m.Current))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovAgent(uint64(m.Limit))
+	if m.GroupChangePolicy != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.GroupChangePolicy))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.GroupId != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MemoryData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Storage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Storage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Storage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Usage != 0 {
-		n += 1 + sovAgent(uint64(m.Usage))
-	}
-	if m.MaxUsage != 0 {
-		n += 1 + sovAgent(uint64(m.MaxUsage))
-	}
-	if m.Failcnt != 0 {
-		n += 1 + sovAgent(uint64(m.Failcnt))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovAgent(uint64(m.Limit))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *MemoryStats) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Encryption != nil {
+		{
+			size, err := m.Encryption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	var l int
-	_ = l
-	if m.Cache != 0 {
-		n += 1 + sovAgent(uint64(m.Cache))
+	if m.FsGroup != nil {
+		{
+			size, err := m.FsGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Usage != nil {
-		l = m.Usage.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.MountPoint) > 0 {
+		i -= len(m.MountPoint)
+		copy(dAtA[i:], m.MountPoint)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.MountPoint)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.SwapUsage != nil {
-		l = m.SwapUsage.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Options[iNdEx])
+			copy(dAtA[i:], m.Options[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Options[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.KernelUsage != nil {
-		l = m.KernelUsage.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Fstype) > 0 {
+		i -= len(m.Fstype)
+		copy(dAtA[i:], m.Fstype)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Fstype)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.UseHierarchy {
-		n += 2
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Stats) > 0 {
-		for k, v := range m.Stats {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + sovAgent(uint64(v))
-			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+	if len(m.DriverOptions) > 0 {
+		for iNdEx := len(m.DriverOptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DriverOptions[iNdEx])
+			copy(dAtA[i:], m.DriverOptions[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.DriverOptions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Driver) > 0 {
+		i -= len(m.Driver)
+		copy(dAtA[i:], m.Driver)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Driver)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BlkioStatsEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StorageEncryption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageEncryption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageEncryption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Major != 0 {
-		n += 1 + sovAgent(uint64(m.Major))
-	}
-	if m.Minor != 0 {
-		n += 1 + sovAgent(uint64(m.Minor))
-	}
-	l = len(m.Op)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Value != 0 {
-		n += 1 + sovAgent(uint64(m.Value))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BlkioStats) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Device) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Device) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Device) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.IoServiceBytesRecursive) > 0 {
-		for _, e := range m.IoServiceBytesRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
-	}
-	if len(m.IoServicedRecursive) > 0 {
-		for _, e := range m.IoServicedRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
-	}
-	if len(m.IoQueuedRecursive) > 0 {
-		for _, e := range m.IoQueuedRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
-	}
-	if len(m.IoServiceTimeRecursive) > 0 {
-		for _, e := range m.IoServiceTimeRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.IoWaitTimeRecursive) > 0 {
-		for _, e := range m.IoWaitTimeRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Options[iNdEx])
+			copy(dAtA[i:], m.Options[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Options[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.IoMergedRecursive) > 0 {
-		for _, e := range m.IoMergedRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if len(m.ContainerPath) > 0 {
+		i -= len(m.ContainerPath)
+		copy(dAtA[i:], m.ContainerPath)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerPath)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.IoTimeRecursive) > 0 {
-		for _, e := range m.IoTimeRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if len(m.VmPath) > 0 {
+		i -= len(m.VmPath)
+		copy(dAtA[i:], m.VmPath)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.VmPath)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.SectorsRecursive) > 0 {
-		for _, e := range m.SectorsRecursive {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HugetlbStats) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StringUser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StringUser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StringUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Usage != 0 {
-		n += 1 + sovAgent(uint64(m.Usage))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MaxUsage != 0 {
-		n += 1 + sovAgent(uint64(m.MaxUsage))
+	if len(m.AdditionalGids) > 0 {
+		for iNdEx := len(m.AdditionalGids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdditionalGids[iNdEx])
+			copy(dAtA[i:], m.AdditionalGids[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.AdditionalGids[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Failcnt != 0 {
-		n += 1 + sovAgent(uint64(m.Failcnt))
+	if len(m.Gid) > 0 {
+		i -= len(m.Gid)
+		copy(dAtA[i:], m.Gid)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Gid)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CgroupStats) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CopyFileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CpuStats != nil {
-		l = m.CpuStats.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.MemoryStats != nil {
-		l = m.MemoryStats.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PidsStats != nil {
-		l = m.PidsStats.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.BlkioStats != nil {
-		l = m.BlkioStats.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Offset != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.HugetlbStats) > 0 {
-		for k, v := range m.HugetlbStats {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovAgent(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
-		}
+	if m.Gid != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Gid))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Uid != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Uid))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *NetworkStats) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DirMode != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.DirMode))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.RxBytes != 0 {
-		n += 1 + sovAgent(uint64(m.RxBytes))
-	}
-	if m.RxPackets != 0 {
-		n += 1 + sovAgent(uint64(m.RxPackets))
-	}
-	if m.RxErrors != 0 {
-		n += 1 + sovAgent(uint64(m.RxErrors))
-	}
-	if m.RxDropped != 0 {
-		n += 1 + sovAgent(uint64(m.RxDropped))
-	}
-	if m.TxBytes != 0 {
-		n += 1 + sovAgent(uint64(m.TxBytes))
-	}
-	if m.TxPackets != 0 {
-		n += 1 + sovAgent(uint64(m.TxPackets))
-	}
-	if m.TxErrors != 0 {
-		n += 1 + sovAgent(uint64(m.TxErrors))
+	if m.FileMode != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.FileMode))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.TxDropped != 0 {
-		n += 1 + sovAgent(uint64(m.TxDropped))
+	if m.FileSize != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StatsContainerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CgroupStats != nil {
-		l = m.CgroupStats.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if len(m.NetworkStats) > 0 {
-		for _, e := range m.NetworkStats {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetOOMEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *WriteStreamRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetOOMEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOOMEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *WriteStreamResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OOMEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OOMEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OOMEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Len != 0 {
-		n += 1 + sovAgent(uint64(m.Len))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ReadStreamRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.Len != 0 {
-		n += 1 + sovAgent(uint64(m.Len))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.PCIPath) > 0 {
+		dAtA28 := make([]byte, len(m.PCIPath)*10)
+		var j27 int
+		for _, num := range m.PCIPath {
+			for num >= 1<<7 {
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			dAtA28[j27] = uint8(num)
+			j27++
+		}
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintAgent(dAtA, i, uint64(j27))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ReadStreamResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetMetricsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CloseStdinRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Metrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Metrics) > 0 {
+		i -= len(m.Metrics)
+		copy(dAtA[i:], m.Metrics)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Metrics)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TtyWinResizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VolumeStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.ExecId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.Row != 0 {
-		n += 1 + sovAgent(uint64(m.Row))
-	}
-	if m.Column != 0 {
-		n += 1 + sovAgent(uint64(m.Column))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.VolumeGuestPath) > 0 {
+		i -= len(m.VolumeGuestPath)
+		copy(dAtA[i:], m.VolumeGuestPath)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.VolumeGuestPath)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *KernelModule) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResizeVolumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResizeVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResizeVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Parameters) > 0 {
-		for _, s := range m.Parameters {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.Size_ != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.VolumeGuestPath) > 0 {
+		i -= len(m.VolumeGuestPath)
+		copy(dAtA[i:], m.VolumeGuestPath)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.VolumeGuestPath)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateSandboxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WatchableEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchableEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchableEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hostname)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Dns) > 0 {
-		for _, s := range m.Dns {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.Storages) > 0 {
-		for _, e := range m.Storages {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.Offset != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.SandboxPidns {
-		n += 2
+	if m.Size_ != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.SandboxId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.LinkTarget) > 0 {
+		i -= len(m.LinkTarget)
+		copy(dAtA[i:], m.LinkTarget)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.LinkTarget)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.GuestHookPath)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Gid != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Gid))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.KernelModules) > 0 {
-		for _, e := range m.KernelModules {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.Uid != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Uid))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Mode != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DestroySandboxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SyncWatchableMountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWatchableMountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWatchableMountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *Interfaces) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Removed[iNdEx])
+			copy(dAtA[i:], m.Removed[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Removed[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Interfaces) > 0 {
-		for _, e := range m.Interfaces {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.MountPoint) > 0 {
+		i -= len(m.MountPoint)
+		copy(dAtA[i:], m.MountPoint)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.MountPoint)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Routes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateInterfaceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Interface != nil {
-		l = m.Interface.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpdateRoutesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Routes != nil {
-		l = m.Routes.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *GetPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListInterfacesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListRoutesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Policy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Policy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ARPNeighbors) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteHooksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteHooksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteHooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ARPNeighbors) > 0 {
-		for _, e := range m.ARPNeighbors {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddARPNeighborsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Neighbors != nil {
-		l = m.Neighbors.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetDiagnosticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OnlineCPUMemRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetDiagnosticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDiagnosticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Wait {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NbCpus != 0 {
-		n += 1 + sovAgent(uint64(m.NbCpus))
+	if m.Offset != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.CpuOnly {
-		n += 2
+	if len(m.CoreDump) > 0 {
+		i -= len(m.CoreDump)
+		copy(dAtA[i:], m.CoreDump)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.CoreDump)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.MaxSize != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxSize))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.ContainerId) > 0 {
+		i -= len(m.ContainerId)
+		copy(dAtA[i:], m.ContainerId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ContainerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ReseedRandomDevRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DiagnosticsFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AgentDetails) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DiagnosticsFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiagnosticsFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.InitDaemon {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DeviceHandlers) > 0 {
-		for _, s := range m.DeviceHandlers {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.Size_ != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.StorageHandlers) > 0 {
-		for _, s := range m.StorageHandlers {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.SupportsSeccomp {
-		n += 2
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GuestDetailsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MemBlockSize {
-		n += 2
+func (m *Diagnostics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.MemHotplugProbe {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GuestDetailsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MemBlockSizeBytes != 0 {
-		n += 1 + sovAgent(uint64(m.MemBlockSizeBytes))
-	}
-	if m.AgentDetails != nil {
-		l = m.AgentDetails.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.SupportMemHotplugProbe {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *Diagnostics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MemHotplugByProbeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Diagnostics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MemHotplugProbeAddr) > 0 {
-		l = 0
-		for _, e := range m.MemHotplugProbeAddr {
-			l += sovAgent(uint64(e))
-		}
-		n += 1 + sovAgent(uint64(l)) + l
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Files) > 0 {
+		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Files[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SetGuestDateTimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetLogLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetLogLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetLogLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Sec != 0 {
-		n += 1 + sovAgent(uint64(m.Sec))
-	}
-	if m.Usec != 0 {
-		n += 1 + sovAgent(uint64(m.Usec))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FSGroup) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReadKernelLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadKernelLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadKernelLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovAgent(uint64(m.GroupId))
-	}
-	if m.GroupChangePolicy != 0 {
-		n += 1 + sovAgent(uint64(m.GroupChangePolicy))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Storage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *KernelLogRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *KernelLogRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KernelLogRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Driver)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if len(m.DriverOptions) > 0 {
-		for _, s := range m.DriverOptions {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
-		}
-	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Fstype)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Options) > 0 {
-		for _, s := range m.Options {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.TimestampUsec != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.TimestampUsec))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.MountPoint)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Sequence != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.FsGroup != nil {
-		l = m.FsGroup.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Facility != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Facility))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Level != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Device) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *KernelLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *KernelLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KernelLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.VmPath)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.ContainerPath)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Options) > 0 {
-		for _, s := range m.Options {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StringUser) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetGuestTimeSourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetGuestTimeSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetGuestTimeSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Uid)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Gid)
-	if l > 0 {
+	if len(m.NtpServers) > 0 {
+		for iNdEx := len(m.NtpServers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NtpServers[iNdEx])
+			copy(dAtA[i:], m.NtpServers[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.NtpServers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartTracingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartTracingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartTracingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopTracingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopTracingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopTracingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAgent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAgent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreateContainerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if len(m.AdditionalGids) > 0 {
-		for _, s := range m.AdditionalGids {
-			l = len(s)
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.StringUser != nil {
+		l = m.StringUser.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Devices) > 0 {
+		for _, e := range m.Devices {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.Storages) > 0 {
+		for _, e := range m.Storages {
+			l = e.Size()
 			n += 1 + l + sovAgent(uint64(l))
 		}
 	}
+	if m.OCI != nil {
+		l = m.OCI.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.SandboxPidns {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *CopyFileRequest) Size() (n int) {
+func (m *StartContainerRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Path)
+	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.FileSize != 0 {
-		n += 1 + sovAgent(uint64(m.FileSize))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.FileMode != 0 {
-		n += 1 + sovAgent(uint64(m.FileMode))
+	return n
+}
+
+func (m *RemoveContainerRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.DirMode != 0 {
-		n += 1 + sovAgent(uint64(m.DirMode))
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.Uid != 0 {
-		n += 1 + sovAgent(uint64(m.Uid))
+	if m.Timeout != 0 {
+		n += 1 + sovAgent(uint64(m.Timeout))
 	}
-	if m.Gid != 0 {
-		n += 1 + sovAgent(uint64(m.Gid))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.Offset != 0 {
-		n += 1 + sovAgent(uint64(m.Offset))
+	return n
+}
+
+func (m *ExecProcessRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.Data)
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
+	if m.StringUser != nil {
+		l = m.StringUser.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Process != nil {
+		l = m.Process.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GetOOMEventRequest) Size() (n int) {
+func (m *SignalProcessRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Signal != 0 {
+		n += 1 + sovAgent(uint64(m.Signal))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *OOMEvent) Size() (n int) {
+func (m *WaitProcessRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6970,24 +7905,24 @@ func (m *OOMEvent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AddSwapRequest) Size() (n int) {
+func (m *WaitProcessResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.PCIPath) > 0 {
-		l = 0
-		for _, e := range m.PCIPath {
-			l += sovAgent(uint64(e))
-		}
-		n += 1 + sovAgent(uint64(l)) + l
+	if m.Status != 0 {
+		n += 1 + sovAgent(uint64(m.Status))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -6995,25 +7930,33 @@ func (m *AddSwapRequest) Size() (n int) {
 	return n
 }
 
-func (m *GetMetricsRequest) Size() (n int) {
+func (m *UpdateContainerRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Metrics) Size() (n int) {
+func (m *StatsContainerRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Metrics)
+	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
@@ -7023,13 +7966,13 @@ func (m *Metrics) Size() (n int) {
 	return n
 }
 
-func (m *VolumeStatsRequest) Size() (n int) {
+func (m *PauseContainerRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.VolumeGuestPath)
+	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
@@ -7039,890 +7982,2750 @@ func (m *VolumeStatsRequest) Size() (n int) {
 	return n
 }
 
-func (m *ResizeVolumeRequest) Size() (n int) {
+func (m *ResumeContainerRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.VolumeGuestPath)
+	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.Size_ != 0 {
-		n += 1 + sovAgent(uint64(m.Size_))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func sovAgent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAgent(x uint64) (n int) {
-	return sovAgent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *CreateContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *CpuUsage) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForDevices := "[]*Device{"
-	for _, f := range this.Devices {
-		repeatedStringForDevices += strings.Replace(f.String(), "Device", "Device", 1) + ","
+	var l int
+	_ = l
+	if m.TotalUsage != 0 {
+		n += 1 + sovAgent(uint64(m.TotalUsage))
 	}
-	repeatedStringForDevices += "}"
-	repeatedStringForStorages := "[]*Storage{"
-	for _, f := range this.Storages {
-		repeatedStringForStorages += strings.Replace(f.String(), "Storage", "Storage", 1) + ","
+	if len(m.PercpuUsage) > 0 {
+		l = 0
+		for _, e := range m.PercpuUsage {
+			l += sovAgent(uint64(e))
+		}
+		n += 1 + sovAgent(uint64(l)) + l
 	}
-	repeatedStringForStorages += "}"
-	s := strings.Join([]string{`&CreateContainerRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
-		`StringUser:` + strings.Replace(this.StringUser.String(), "StringUser", "StringUser", 1) + `,`,
-		`Devices:` + repeatedStringForDevices + `,`,
-		`Storages:` + repeatedStringForStorages + `,`,
-		`OCI:` + strings.Replace(fmt.Sprintf("%v", this.OCI), "Spec", "Spec", 1) + `,`,
-		`SandboxPidns:` + fmt.Sprintf("%v", this.SandboxPidns) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StartContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.UsageInKernelmode != 0 {
+		n += 1 + sovAgent(uint64(m.UsageInKernelmode))
 	}
-	s := strings.Join([]string{`&StartContainerRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RemoveContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.UsageInUsermode != 0 {
+		n += 1 + sovAgent(uint64(m.UsageInUsermode))
 	}
-	s := strings.Join([]string{`&RemoveContainerRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ExecProcessRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	s := strings.Join([]string{`&ExecProcessRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
-		`StringUser:` + strings.Replace(this.StringUser.String(), "StringUser", "StringUser", 1) + `,`,
-		`Process:` + strings.Replace(fmt.Sprintf("%v", this.Process), "Process", "Process", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *SignalProcessRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ThrottlingData) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SignalProcessRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
-		`Signal:` + fmt.Sprintf("%v", this.Signal) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WaitProcessRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		n += 1 + sovAgent(uint64(m.Periods))
 	}
-	s := strings.Join([]string{`&WaitProcessRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WaitProcessResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.ThrottledPeriods != 0 {
+		n += 1 + sovAgent(uint64(m.ThrottledPeriods))
 	}
-	s := strings.Join([]string{`&WaitProcessResponse{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ThrottledTime != 0 {
+		n += 1 + sovAgent(uint64(m.ThrottledTime))
 	}
-	s := strings.Join([]string{`&UpdateContainerRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "LinuxResources", "LinuxResources", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StatsContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	s := strings.Join([]string{`&StatsContainerRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PauseContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CpuStats) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PauseContainerRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResumeContainerRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.CpuUsage != nil {
+		l = m.CpuUsage.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	s := strings.Join([]string{`&ResumeContainerRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CpuUsage) String() string {
-	if this == nil {
-		return "nil"
+	if m.ThrottlingData != nil {
+		l = m.ThrottlingData.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	s := strings.Join([]string{`&CpuUsage{`,
-		`TotalUsage:` + fmt.Sprintf("%v", this.TotalUsage) + `,`,
-		`PercpuUsage:` + fmt.Sprintf("%v", this.PercpuUsage) + `,`,
-		`UsageInKernelmode:` + fmt.Sprintf("%v", this.UsageInKernelmode) + `,`,
-		`UsageInUsermode:` + fmt.Sprintf("%v", this.UsageInUsermode) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ThrottlingData) String() string {
-	if this == nil {
-		return "nil"
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	s := strings.Join([]string{`&ThrottlingData{`,
-		`Periods:` + fmt.Sprintf("%v", this.Periods) + `,`,
-		`ThrottledPeriods:` + fmt.Sprintf("%v", this.ThrottledPeriods) + `,`,
-		`ThrottledTime:` + fmt.Sprintf("%v", this.ThrottledTime) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *CpuStats) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PidsStats) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&CpuStats{`,
-		`CpuUsage:` + strings.Replace(this.CpuUsage.String(), "CpuUsage", "CpuUsage", 1) + `,`,
-		`ThrottlingData:` + strings.Replace(this.ThrottlingData.String(), "ThrottlingData", "ThrottlingData", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PidsStats) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Current != 0 {
+		n += 1 + sovAgent(uint64(m.Current))
 	}
-	s := strings.Join([]string{`&PidsStats{`,
-		`Current:` + fmt.Sprintf("%v", this.Current) + `,`,
-		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MemoryData) String() string {
-	if this == nil {
-		return "nil"
+	if m.Limit != 0 {
+		n += 1 + sovAgent(uint64(m.Limit))
 	}
-	s := strings.Join([]string{`&MemoryData{`,
-		`Usage:` + fmt.Sprintf("%v", this.Usage) + `,`,
-		`MaxUsage:` + fmt.Sprintf("%v", this.MaxUsage) + `,`,
-		`Failcnt:` + fmt.Sprintf("%v", this.Failcnt) + `,`,
-		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MemoryStats) String() string {
-	if this == nil {
-		return "nil"
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	keysForStats := make([]string, 0, len(this.Stats))
-	for k, _ := range this.Stats {
-		keysForStats = append(keysForStats, k)
+	return n
+}
+
+func (m *MemoryData) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForStats)
-	mapStringForStats := "map[string]uint64{"
-	for _, k := range keysForStats {
-		mapStringForStats += fmt.Sprintf("%v: %v,", k, this.Stats[k])
+	var l int
+	_ = l
+	if m.Usage != 0 {
+		n += 1 + sovAgent(uint64(m.Usage))
 	}
-	mapStringForStats += "}"
-	s := strings.Join([]string{`&MemoryStats{`,
-		`Cache:` + fmt.Sprintf("%v", this.Cache) + `,`,
-		`Usage:` + strings.Replace(this.Usage.String(), "MemoryData", "MemoryData", 1) + `,`,
-		`SwapUsage:` + strings.Replace(this.SwapUsage.String(), "MemoryData", "MemoryData", 1) + `,`,
-		`KernelUsage:` + strings.Replace(this.KernelUsage.String(), "MemoryData", "MemoryData", 1) + `,`,
-		`UseHierarchy:` + fmt.Sprintf("%v", this.UseHierarchy) + `,`,
-		`Stats:` + mapStringForStats + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BlkioStatsEntry) String() string {
-	if this == nil {
-		return "nil"
+	if m.MaxUsage != 0 {
+		n += 1 + sovAgent(uint64(m.MaxUsage))
 	}
-	s := strings.Join([]string{`&BlkioStatsEntry{`,
-		`Major:` + fmt.Sprintf("%v", this.Major) + `,`,
-		`Minor:` + fmt.Sprintf("%v", this.Minor) + `,`,
-		`Op:` + fmt.Sprintf("%v", this.Op) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BlkioStats) String() string {
-	if this == nil {
-		return "nil"
+	if m.Failcnt != 0 {
+		n += 1 + sovAgent(uint64(m.Failcnt))
 	}
-	repeatedStringForIoServiceBytesRecursive := "[]*BlkioStatsEntry{"
-	for _, f := range this.IoServiceBytesRecursive {
-		repeatedStringForIoServiceBytesRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	if m.Limit != 0 {
+		n += 1 + sovAgent(uint64(m.Limit))
 	}
-	repeatedStringForIoServiceBytesRecursive += "}"
-	repeatedStringForIoServicedRecursive := "[]*BlkioStatsEntry{"
-	for _, f := range this.IoServicedRecursive {
-		repeatedStringForIoServicedRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	repeatedStringForIoServicedRecursive += "}"
-	repeatedStringForIoQueuedRecursive := "[]*BlkioStatsEntry{"
-	for _, f := range this.IoQueuedRecursive {
-		repeatedStringForIoQueuedRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	return n
+}
+
+func (m *MemoryStats) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForIoQueuedRecursive += "}"
-	repeatedStringForIoServiceTimeRecursive := "[]*BlkioStatsEntry{"
-	for _, f := range this.IoServiceTimeRecursive {
-		repeatedStringForIoServiceTimeRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	var l int
+	_ = l
+	if m.Cache != 0 {
+		n += 1 + sovAgent(uint64(m.Cache))
 	}
-	repeatedStringForIoServiceTimeRecursive += "}"
-	repeatedStringForIoWaitTimeRecursive := "[]*BlkioStatsEntry{"
-	for _, f := range this.IoWaitTimeRecursive {
-		repeatedStringForIoWaitTimeRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	repeatedStringForIoWaitTimeRecursive += "}"
-	repeatedStringForIoMergedRecursive := "[]*BlkioStatsEntry{"
-	for _, f := range this.IoMergedRecursive {
-		repeatedStringForIoMergedRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	if m.SwapUsage != nil {
+		l = m.SwapUsage.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	repeatedStringForIoMergedRecursive += "}"
-	repeatedStringForIoTimeRecursive := "[]*BlkioStatsEntry{"
-	for _, f := range this.IoTimeRecursive {
-		repeatedStringForIoTimeRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	if m.KernelUsage != nil {
+		l = m.KernelUsage.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	repeatedStringForIoTimeRecursive += "}"
-	repeatedStringForSectorsRecursive := "[]*BlkioStatsEntry{"
-	for _, f := range this.SectorsRecursive {
-		repeatedStringForSectorsRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	if m.UseHierarchy {
+		n += 2
 	}
-	repeatedStringForSectorsRecursive += "}"
-	s := strings.Join([]string{`&BlkioStats{`,
-		`IoServiceBytesRecursive:` + repeatedStringForIoServiceBytesRecursive + `,`,
-		`IoServicedRecursive:` + repeatedStringForIoServicedRecursive + `,`,
-		`IoQueuedRecursive:` + repeatedStringForIoQueuedRecursive + `,`,
-		`IoServiceTimeRecursive:` + repeatedStringForIoServiceTimeRecursive + `,`,
-		`IoWaitTimeRecursive:` + repeatedStringForIoWaitTimeRecursive + `,`,
-		`IoMergedRecursive:` + repeatedStringForIoMergedRecursive + `,`,
-		`IoTimeRecursive:` + repeatedStringForIoTimeRecursive + `,`,
-		`SectorsRecursive:` + repeatedStringForSectorsRecursive + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *HugetlbStats) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Stats) > 0 {
+		for k, v := range m.Stats {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + sovAgent(uint64(v))
+			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&HugetlbStats{`,
-		`Usage:` + fmt.Sprintf("%v", this.Usage) + `,`,
-		`MaxUsage:` + fmt.Sprintf("%v", this.MaxUsage) + `,`,
-		`Failcnt:` + fmt.Sprintf("%v", this.Failcnt) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (this *CgroupStats) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *BlkioStatsEntry) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForHugetlbStats := make([]string, 0, len(this.HugetlbStats))
-	for k, _ := range this.HugetlbStats {
-		keysForHugetlbStats = append(keysForHugetlbStats, k)
+	var l int
+	_ = l
+	if m.Major != 0 {
+		n += 1 + sovAgent(uint64(m.Major))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForHugetlbStats)
-	mapStringForHugetlbStats := "map[string]*HugetlbStats{"
-	for _, k := range keysForHugetlbStats {
-		mapStringForHugetlbStats += fmt.Sprintf("%v: %v,", k, this.HugetlbStats[k])
+	if m.Minor != 0 {
+		n += 1 + sovAgent(uint64(m.Minor))
 	}
-	mapStringForHugetlbStats += "}"
-	s := strings.Join([]string{`&CgroupStats{`,
-		`CpuStats:` + strings.Replace(this.CpuStats.String(), "CpuStats", "CpuStats", 1) + `,`,
-		`MemoryStats:` + strings.Replace(this.MemoryStats.String(), "MemoryStats", "MemoryStats", 1) + `,`,
-		`PidsStats:` + strings.Replace(this.PidsStats.String(), "PidsStats", "PidsStats", 1) + `,`,
-		`BlkioStats:` + strings.Replace(this.BlkioStats.String(), "BlkioStats", "BlkioStats", 1) + `,`,
-		`HugetlbStats:` + mapStringForHugetlbStats + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NetworkStats) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	s := strings.Join([]string{`&NetworkStats{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`RxBytes:` + fmt.Sprintf("%v", this.RxBytes) + `,`,
-		`RxPackets:` + fmt.Sprintf("%v", this.RxPackets) + `,`,
-		`RxErrors:` + fmt.Sprintf("%v", this.RxErrors) + `,`,
-		`RxDropped:` + fmt.Sprintf("%v", this.RxDropped) + `,`,
-		`TxBytes:` + fmt.Sprintf("%v", this.TxBytes) + `,`,
-		`TxPackets:` + fmt.Sprintf("%v", this.TxPackets) + `,`,
-		`TxErrors:` + fmt.Sprintf("%v", this.TxErrors) + `,`,
-		`TxDropped:` + fmt.Sprintf("%v", this.TxDropped) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StatsContainerResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Value != 0 {
+		n += 1 + sovAgent(uint64(m.Value))
 	}
-	repeatedStringForNetworkStats := "[]*NetworkStats{"
-	for _, f := range this.NetworkStats {
-		repeatedStringForNetworkStats += strings.Replace(f.String(), "NetworkStats", "NetworkStats", 1) + ","
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	repeatedStringForNetworkStats += "}"
-	s := strings.Join([]string{`&StatsContainerResponse{`,
-		`CgroupStats:` + strings.Replace(this.CgroupStats.String(), "CgroupStats", "CgroupStats", 1) + `,`,
-		`NetworkStats:` + repeatedStringForNetworkStats + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *WriteStreamRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *BlkioStats) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&WriteStreamRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *WriteStreamResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if len(m.IoServiceBytesRecursive) > 0 {
+		for _, e := range m.IoServiceBytesRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&WriteStreamResponse{`,
-		`Len:` + fmt.Sprintf("%v", this.Len) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReadStreamRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.IoServicedRecursive) > 0 {
+		for _, e := range m.IoServicedRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ReadStreamRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
-		`Len:` + fmt.Sprintf("%v", this.Len) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReadStreamResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.IoQueuedRecursive) > 0 {
+		for _, e := range m.IoQueuedRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ReadStreamResponse{`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CloseStdinRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.IoServiceTimeRecursive) > 0 {
+		for _, e := range m.IoServiceTimeRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&CloseStdinRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *TtyWinResizeRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.IoWaitTimeRecursive) > 0 {
+		for _, e := range m.IoWaitTimeRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&TtyWinResizeRequest{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
-		`Row:` + fmt.Sprintf("%v", this.Row) + `,`,
-		`Column:` + fmt.Sprintf("%v", this.Column) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KernelModule) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.IoMergedRecursive) > 0 {
+		for _, e := range m.IoMergedRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&KernelModule{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Parameters:` + fmt.Sprintf("%v", this.Parameters) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CreateSandboxRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.IoTimeRecursive) > 0 {
+		for _, e := range m.IoTimeRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	repeatedStringForStorages := "[]*Storage{"
-	for _, f := range this.Storages {
-		repeatedStringForStorages += strings.Replace(f.String(), "Storage", "Storage", 1) + ","
+	if len(m.SectorsRecursive) > 0 {
+		for _, e := range m.SectorsRecursive {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	repeatedStringForStorages += "}"
-	repeatedStringForKernelModules := "[]*KernelModule{"
-	for _, f := range this.KernelModules {
-		repeatedStringForKernelModules += strings.Replace(f.String(), "KernelModule", "KernelModule", 1) + ","
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	repeatedStringForKernelModules += "}"
-	s := strings.Join([]string{`&CreateSandboxRequest{`,
-		`Hostname:` + fmt.Sprintf("%v", this.Hostname) + `,`,
-		`Dns:` + fmt.Sprintf("%v", this.Dns) + `,`,
-		`Storages:` + repeatedStringForStorages + `,`,
-		`SandboxPidns:` + fmt.Sprintf("%v", this.SandboxPidns) + `,`,
-		`SandboxId:` + fmt.Sprintf("%v", this.SandboxId) + `,`,
-		`GuestHookPath:` + fmt.Sprintf("%v", this.GuestHookPath) + `,`,
-		`KernelModules:` + repeatedStringForKernelModules + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *DestroySandboxRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *HugetlbStats) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&DestroySandboxRequest{`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Interfaces) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Usage != 0 {
+		n += 1 + sovAgent(uint64(m.Usage))
 	}
-	repeatedStringForInterfaces := "[]*Interface{"
-	for _, f := range this.Interfaces {
-		repeatedStringForInterfaces += strings.Replace(fmt.Sprintf("%v", f), "Interface", "protocols.Interface", 1) + ","
+	if m.MaxUsage != 0 {
+		n += 1 + sovAgent(uint64(m.MaxUsage))
 	}
-	repeatedStringForInterfaces += "}"
-	s := strings.Join([]string{`&Interfaces{`,
-		`Interfaces:` + repeatedStringForInterfaces + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Routes) String() string {
-	if this == nil {
-		return "nil"
+	if m.Failcnt != 0 {
+		n += 1 + sovAgent(uint64(m.Failcnt))
 	}
-	repeatedStringForRoutes := "[]*Route{"
-	for _, f := range this.Routes {
-		repeatedStringForRoutes += strings.Replace(fmt.Sprintf("%v", f), "Route", "protocols.Route", 1) + ","
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	repeatedStringForRoutes += "}"
-	s := strings.Join([]string{`&Routes{`,
-		`Routes:` + repeatedStringForRoutes + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *UpdateInterfaceRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CgroupStats) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&UpdateInterfaceRequest{`,
-		`Interface:` + strings.Replace(fmt.Sprintf("%v", this.Interface), "Interface", "protocols.Interface", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateRoutesRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.CpuStats != nil {
+		l = m.CpuStats.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	s := strings.Join([]string{`&UpdateRoutesRequest{`,
-		`Routes:` + strings.Replace(this.Routes.String(), "Routes", "Routes", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ListInterfacesRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.MemoryStats != nil {
+		l = m.MemoryStats.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	s := strings.Join([]string{`&ListInterfacesRequest{`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ListRoutesRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.PidsStats != nil {
+		l = m.PidsStats.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	s := strings.Join([]string{`&ListRoutesRequest{`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ARPNeighbors) String() string {
-	if this == nil {
-		return "nil"
+	if m.BlkioStats != nil {
+		l = m.BlkioStats.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	repeatedStringForARPNeighbors := "[]*ARPNeighbor{"
-	for _, f := range this.ARPNeighbors {
-		repeatedStringForARPNeighbors += strings.Replace(fmt.Sprintf("%v", f), "ARPNeighbor", "protocols.ARPNeighbor", 1) + ","
+	if len(m.HugetlbStats) > 0 {
+		for k, v := range m.HugetlbStats {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovAgent(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+		}
 	}
-	repeatedStringForARPNeighbors += "}"
-	s := strings.Join([]string{`&ARPNeighbors{`,
-		`ARPNeighbors:` + repeatedStringForARPNeighbors + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddARPNeighborsRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	s := strings.Join([]string{`&AddARPNeighborsRequest{`,
-		`Neighbors:` + strings.Replace(this.Neighbors.String(), "ARPNeighbors", "ARPNeighbors", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *OnlineCPUMemRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NetworkStats) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&OnlineCPUMemRequest{`,
-		`Wait:` + fmt.Sprintf("%v", this.Wait) + `,`,
-		`NbCpus:` + fmt.Sprintf("%v", this.NbCpus) + `,`,
-		`CpuOnly:` + fmt.Sprintf("%v", this.CpuOnly) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReseedRandomDevRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	s := strings.Join([]string{`&ReseedRandomDevRequest{`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AgentDetails) String() string {
-	if this == nil {
-		return "nil"
+	if m.RxBytes != 0 {
+		n += 1 + sovAgent(uint64(m.RxBytes))
 	}
-	s := strings.Join([]string{`&AgentDetails{`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`InitDaemon:` + fmt.Sprintf("%v", this.InitDaemon) + `,`,
-		`DeviceHandlers:` + fmt.Sprintf("%v", this.DeviceHandlers) + `,`,
-		`StorageHandlers:` + fmt.Sprintf("%v", this.StorageHandlers) + `,`,
-		`SupportsSeccomp:` + fmt.Sprintf("%v", this.SupportsSeccomp) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GuestDetailsRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.RxPackets != 0 {
+		n += 1 + sovAgent(uint64(m.RxPackets))
 	}
-	s := strings.Join([]string{`&GuestDetailsRequest{`,
-		`MemBlockSize:` + fmt.Sprintf("%v", this.MemBlockSize) + `,`,
-		`MemHotplugProbe:` + fmt.Sprintf("%v", this.MemHotplugProbe) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GuestDetailsResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.RxErrors != 0 {
+		n += 1 + sovAgent(uint64(m.RxErrors))
 	}
-	s := strings.Join([]string{`&GuestDetailsResponse{`,
-		`MemBlockSizeBytes:` + fmt.Sprintf("%v", this.MemBlockSizeBytes) + `,`,
-		`AgentDetails:` + strings.Replace(this.AgentDetails.String(), "AgentDetails", "AgentDetails", 1) + `,`,
-		`SupportMemHotplugProbe:` + fmt.Sprintf("%v", this.SupportMemHotplugProbe) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MemHotplugByProbeRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.RxDropped != 0 {
+		n += 1 + sovAgent(uint64(m.RxDropped))
 	}
-	s := strings.Join([]string{`&MemHotplugByProbeRequest{`,
-		`MemHotplugProbeAddr:` + fmt.Sprintf("%v", this.MemHotplugProbeAddr) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SetGuestDateTimeRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.TxBytes != 0 {
+		n += 1 + sovAgent(uint64(m.TxBytes))
 	}
-	s := strings.Join([]string{`&SetGuestDateTimeRequest{`,
-		`Sec:` + fmt.Sprintf("%v", this.Sec) + `,`,
-		`Usec:` + fmt.Sprintf("%v", this.Usec) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *FSGroup) String() string {
-	if this == nil {
-		return "nil"
+	if m.TxPackets != 0 {
+		n += 1 + sovAgent(uint64(m.TxPackets))
 	}
-	s := strings.Join([]string{`&FSGroup{`,
-		`GroupId:` + fmt.Sprintf("%v", this.GroupId) + `,`,
-		`GroupChangePolicy:` + fmt.Sprintf("%v", this.GroupChangePolicy) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Storage) String() string {
-	if this == nil {
-		return "nil"
+	if m.TxErrors != 0 {
+		n += 1 + sovAgent(uint64(m.TxErrors))
 	}
-	s := strings.Join([]string{`&Storage{`,
-		`Driver:` + fmt.Sprintf("%v", this.Driver) + `,`,
-		`DriverOptions:` + fmt.Sprintf("%v", this.DriverOptions) + `,`,
-		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
-		`Fstype:` + fmt.Sprintf("%v", this.Fstype) + `,`,
-		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
-		`MountPoint:` + fmt.Sprintf("%v", this.MountPoint) + `,`,
-		`FsGroup:` + strings.Replace(this.FsGroup.String(), "FSGroup", "FSGroup", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Device) String() string {
-	if this == nil {
-		return "nil"
+	if m.TxDropped != 0 {
+		n += 1 + sovAgent(uint64(m.TxDropped))
 	}
-	s := strings.Join([]string{`&Device{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`VmPath:` + fmt.Sprintf("%v", this.VmPath) + `,`,
-		`ContainerPath:` + fmt.Sprintf("%v", this.ContainerPath) + `,`,
-		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StringUser) String() string {
-	if this == nil {
-		return "nil"
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	s := strings.Join([]string{`&StringUser{`,
-		`Uid:` + fmt.Sprintf("%v", this.Uid) + `,`,
-		`Gid:` + fmt.Sprintf("%v", this.Gid) + `,`,
-		`AdditionalGids:` + fmt.Sprintf("%v", this.AdditionalGids) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *CopyFileRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StatsContainerResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&CopyFileRequest{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`FileSize:` + fmt.Sprintf("%v", this.FileSize) + `,`,
-		`FileMode:` + fmt.Sprintf("%v", this.FileMode) + `,`,
-		`DirMode:` + fmt.Sprintf("%v", this.DirMode) + `,`,
-		`Uid:` + fmt.Sprintf("%v", this.Uid) + `,`,
-		`Gid:` + fmt.Sprintf("%v", this.Gid) + `,`,
-		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
-		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetOOMEventRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.CgroupStats != nil {
+		l = m.CgroupStats.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	s := strings.Join([]string{`&GetOOMEventRequest{`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *OOMEvent) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.NetworkStats) > 0 {
+		for _, e := range m.NetworkStats {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&OOMEvent{`,
-		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddSwapRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	s := strings.Join([]string{`&AddSwapRequest{`,
-		`PCIPath:` + fmt.Sprintf("%v", this.PCIPath) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GetMetricsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *WriteStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetMetricsRequest{`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Metrics) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	s := strings.Join([]string{`&Metrics{`,
-		`Metrics:` + fmt.Sprintf("%v", this.Metrics) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *VolumeStatsRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	s := strings.Join([]string{`&VolumeStatsRequest{`,
-		`VolumeGuestPath:` + fmt.Sprintf("%v", this.VolumeGuestPath) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResizeVolumeRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	s := strings.Join([]string{`&ResizeVolumeRequest{`,
-		`VolumeGuestPath:` + fmt.Sprintf("%v", this.VolumeGuestPath) + `,`,
-		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringAgent(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return n
 }
 
-type AgentServiceService interface {
-	CreateContainer(ctx context.Context, req *CreateContainerRequest) (*types.Empty, error)
-	StartContainer(ctx context.Context, req *StartContainerRequest) (*types.Empty, error)
-	RemoveContainer(ctx context.Context, req *RemoveContainerRequest) (*types.Empty, error)
-	ExecProcess(ctx context.Context, req *ExecProcessRequest) (*types.Empty, error)
-	SignalProcess(ctx context.Context, req *SignalProcessRequest) (*types.Empty, error)
+func (m *WriteStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Len != 0 {
+		n += 1 + sovAgent(uint64(m.Len))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Len != 0 {
+		n += 1 + sovAgent(uint64(m.Len))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CloseStdinRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OpenProcessIORequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Bidirectional {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProcessIOPorts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StdinPort != 0 {
+		n += 1 + sovAgent(uint64(m.StdinPort))
+	}
+	if m.StdoutPort != 0 {
+		n += 1 + sovAgent(uint64(m.StdoutPort))
+	}
+	if m.StderrPort != 0 {
+		n += 1 + sovAgent(uint64(m.StderrPort))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TtyWinResizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ExecId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Row != 0 {
+		n += 1 + sovAgent(uint64(m.Row))
+	}
+	if m.Column != 0 {
+		n += 1 + sovAgent(uint64(m.Column))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KernelModule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateSandboxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Dns) > 0 {
+		for _, s := range m.Dns {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.Storages) > 0 {
+		for _, e := range m.Storages {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.SandboxPidns {
+		n += 2
+	}
+	l = len(m.SandboxId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.GuestHookPath)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.KernelModules) > 0 {
+		for _, e := range m.KernelModules {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DestroySandboxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Interfaces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Interfaces) > 0 {
+		for _, e := range m.Interfaces {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Routes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateInterfaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interface != nil {
+		l = m.Interface.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Routes != nil {
+		l = m.Routes.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListInterfacesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ARPNeighbors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ARPNeighbors) > 0 {
+		for _, e := range m.ARPNeighbors {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddARPNeighborsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Neighbors != nil {
+		l = m.Neighbors.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetIPTablesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsIpv6 {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetIPTablesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetIPTablesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsIpv6 {
+		n += 2
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetIPTablesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OnlineCPUMemRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Wait {
+		n += 2
+	}
+	if m.NbCpus != 0 {
+		n += 1 + sovAgent(uint64(m.NbCpus))
+	}
+	if m.CpuOnly {
+		n += 2
+	}
+	if m.NbMemBlocks != 0 {
+		n += 1 + sovAgent(uint64(m.NbMemBlocks))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReseedRandomDevRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AgentDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.InitDaemon {
+		n += 2
+	}
+	if len(m.DeviceHandlers) > 0 {
+		for _, s := range m.DeviceHandlers {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.StorageHandlers) > 0 {
+		for _, s := range m.StorageHandlers {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.SupportsSeccomp {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GuestDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemBlockSize {
+		n += 2
+	}
+	if m.MemHotplugProbe {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GuestDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemBlockSizeBytes != 0 {
+		n += 1 + sovAgent(uint64(m.MemBlockSizeBytes))
+	}
+	if m.AgentDetails != nil {
+		l = m.AgentDetails.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.SupportMemHotplugProbe {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MemHotplugByProbeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MemHotplugProbeAddr) > 0 {
+		l = 0
+		for _, e := range m.MemHotplugProbeAddr {
+			l += sovAgent(uint64(e))
+		}
+		n += 1 + sovAgent(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetGuestDateTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sec != 0 {
+		n += 1 + sovAgent(uint64(m.Sec))
+	}
+	if m.Usec != 0 {
+		n += 1 + sovAgent(uint64(m.Usec))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FSGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovAgent(uint64(m.GroupId))
+	}
+	if m.GroupChangePolicy != 0 {
+		n += 1 + sovAgent(uint64(m.GroupChangePolicy))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Storage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Driver)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.DriverOptions) > 0 {
+		for _, s := range m.DriverOptions {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Fstype)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	l = len(m.MountPoint)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.FsGroup != nil {
+		l = m.FsGroup.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Encryption != nil {
+		l = m.Encryption.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StorageEncryption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Device) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.VmPath)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ContainerPath)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StringUser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Gid)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.AdditionalGids) > 0 {
+		for _, s := range m.AdditionalGids {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CopyFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.FileSize != 0 {
+		n += 1 + sovAgent(uint64(m.FileSize))
+	}
+	if m.FileMode != 0 {
+		n += 1 + sovAgent(uint64(m.FileMode))
+	}
+	if m.DirMode != 0 {
+		n += 1 + sovAgent(uint64(m.DirMode))
+	}
+	if m.Uid != 0 {
+		n += 1 + sovAgent(uint64(m.Uid))
+	}
+	if m.Gid != 0 {
+		n += 1 + sovAgent(uint64(m.Gid))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAgent(uint64(m.Offset))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOOMEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OOMEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PCIPath) > 0 {
+		l = 0
+		for _, e := range m.PCIPath {
+			l += sovAgent(uint64(e))
+		}
+		n += 1 + sovAgent(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetMetricsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Metrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Metrics)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VolumeStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VolumeGuestPath)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResizeVolumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VolumeGuestPath)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovAgent(uint64(m.Size_))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchableEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovAgent(uint64(m.Mode))
+	}
+	if m.Uid != 0 {
+		n += 1 + sovAgent(uint64(m.Uid))
+	}
+	if m.Gid != 0 {
+		n += 1 + sovAgent(uint64(m.Gid))
+	}
+	l = len(m.LinkTarget)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovAgent(uint64(m.Size_))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAgent(uint64(m.Offset))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncWatchableMountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MountPoint)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Policy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExecuteHooksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDiagnosticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContainerId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.MaxSize != 0 {
+		n += 1 + sovAgent(uint64(m.MaxSize))
+	}
+	l = len(m.CoreDump)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAgent(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiagnosticsFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovAgent(uint64(m.Size_))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Diagnostics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetLogLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadKernelLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KernelLogRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovAgent(uint64(m.Level))
+	}
+	if m.Facility != 0 {
+		n += 1 + sovAgent(uint64(m.Facility))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovAgent(uint64(m.Sequence))
+	}
+	if m.TimestampUsec != 0 {
+		n += 1 + sovAgent(uint64(m.TimestampUsec))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KernelLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetGuestTimeSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.NtpServers) > 0 {
+		for _, s := range m.NtpServers {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartTracingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StopTracingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAgent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAgent(x uint64) (n int) {
+	return sovAgent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *CreateContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDevices := "[]*Device{"
+	for _, f := range this.Devices {
+		repeatedStringForDevices += strings.Replace(f.String(), "Device", "Device", 1) + ","
+	}
+	repeatedStringForDevices += "}"
+	repeatedStringForStorages := "[]*Storage{"
+	for _, f := range this.Storages {
+		repeatedStringForStorages += strings.Replace(f.String(), "Storage", "Storage", 1) + ","
+	}
+	repeatedStringForStorages += "}"
+	s := strings.Join([]string{`&CreateContainerRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
+		`StringUser:` + strings.Replace(this.StringUser.String(), "StringUser", "StringUser", 1) + `,`,
+		`Devices:` + repeatedStringForDevices + `,`,
+		`Storages:` + repeatedStringForStorages + `,`,
+		`OCI:` + strings.Replace(fmt.Sprintf("%v", this.OCI), "Spec", "Spec", 1) + `,`,
+		`SandboxPidns:` + fmt.Sprintf("%v", this.SandboxPidns) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartContainerRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveContainerRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecProcessRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecProcessRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
+		`StringUser:` + strings.Replace(this.StringUser.String(), "StringUser", "StringUser", 1) + `,`,
+		`Process:` + strings.Replace(fmt.Sprintf("%v", this.Process), "Process", "Process", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignalProcessRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignalProcessRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
+		`Signal:` + fmt.Sprintf("%v", this.Signal) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WaitProcessRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WaitProcessRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WaitProcessResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WaitProcessResponse{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateContainerRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "LinuxResources", "LinuxResources", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatsContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StatsContainerRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseContainerRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResumeContainerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResumeContainerRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CpuUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CpuUsage{`,
+		`TotalUsage:` + fmt.Sprintf("%v", this.TotalUsage) + `,`,
+		`PercpuUsage:` + fmt.Sprintf("%v", this.PercpuUsage) + `,`,
+		`UsageInKernelmode:` + fmt.Sprintf("%v", this.UsageInKernelmode) + `,`,
+		`UsageInUsermode:` + fmt.Sprintf("%v", this.UsageInUsermode) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ThrottlingData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ThrottlingData{`,
+		`Periods:` + fmt.Sprintf("%v", this.Periods) + `,`,
+		`ThrottledPeriods:` + fmt.Sprintf("%v", this.ThrottledPeriods) + `,`,
+		`ThrottledTime:` + fmt.Sprintf("%v", this.ThrottledTime) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CpuStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CpuStats{`,
+		`CpuUsage:` + strings.Replace(this.CpuUsage.String(), "CpuUsage", "CpuUsage", 1) + `,`,
+		`ThrottlingData:` + strings.Replace(this.ThrottlingData.String(), "ThrottlingData", "ThrottlingData", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PidsStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PidsStats{`,
+		`Current:` + fmt.Sprintf("%v", this.Current) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemoryData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemoryData{`,
+		`Usage:` + fmt.Sprintf("%v", this.Usage) + `,`,
+		`MaxUsage:` + fmt.Sprintf("%v", this.MaxUsage) + `,`,
+		`Failcnt:` + fmt.Sprintf("%v", this.Failcnt) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemoryStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForStats := make([]string, 0, len(this.Stats))
+	for k, _ := range this.Stats {
+		keysForStats = append(keysForStats, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForStats)
+	mapStringForStats := "map[string]uint64{"
+	for _, k := range keysForStats {
+		mapStringForStats += fmt.Sprintf("%v: %v,", k, this.Stats[k])
+	}
+	mapStringForStats += "}"
+	s := strings.Join([]string{`&MemoryStats{`,
+		`Cache:` + fmt.Sprintf("%v", this.Cache) + `,`,
+		`Usage:` + strings.Replace(this.Usage.String(), "MemoryData", "MemoryData", 1) + `,`,
+		`SwapUsage:` + strings.Replace(this.SwapUsage.String(), "MemoryData", "MemoryData", 1) + `,`,
+		`KernelUsage:` + strings.Replace(this.KernelUsage.String(), "MemoryData", "MemoryData", 1) + `,`,
+		`UseHierarchy:` + fmt.Sprintf("%v", this.UseHierarchy) + `,`,
+		`Stats:` + mapStringForStats + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlkioStatsEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlkioStatsEntry{`,
+		`Major:` + fmt.Sprintf("%v", this.Major) + `,`,
+		`Minor:` + fmt.Sprintf("%v", this.Minor) + `,`,
+		`Op:` + fmt.Sprintf("%v", this.Op) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlkioStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForIoServiceBytesRecursive := "[]*BlkioStatsEntry{"
+	for _, f := range this.IoServiceBytesRecursive {
+		repeatedStringForIoServiceBytesRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	}
+	repeatedStringForIoServiceBytesRecursive += "}"
+	repeatedStringForIoServicedRecursive := "[]*BlkioStatsEntry{"
+	for _, f := range this.IoServicedRecursive {
+		repeatedStringForIoServicedRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	}
+	repeatedStringForIoServicedRecursive += "}"
+	repeatedStringForIoQueuedRecursive := "[]*BlkioStatsEntry{"
+	for _, f := range this.IoQueuedRecursive {
+		repeatedStringForIoQueuedRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	}
+	repeatedStringForIoQueuedRecursive += "}"
+	repeatedStringForIoServiceTimeRecursive := "[]*BlkioStatsEntry{"
+	for _, f := range this.IoServiceTimeRecursive {
+		repeatedStringForIoServiceTimeRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	}
+	repeatedStringForIoServiceTimeRecursive += "}"
+	repeatedStringForIoWaitTimeRecursive := "[]*BlkioStatsEntry{"
+	for _, f := range this.IoWaitTimeRecursive {
+		repeatedStringForIoWaitTimeRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	}
+	repeatedStringForIoWaitTimeRecursive += "}"
+	repeatedStringForIoMergedRecursive := "[]*BlkioStatsEntry{"
+	for _, f := range this.IoMergedRecursive {
+		repeatedStringForIoMergedRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	}
+	repeatedStringForIoMergedRecursive += "}"
+	repeatedStringForIoTimeRecursive := "[]*BlkioStatsEntry{"
+	for _, f := range this.IoTimeRecursive {
+		repeatedStringForIoTimeRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	}
+	repeatedStringForIoTimeRecursive += "}"
+	repeatedStringForSectorsRecursive := "[]*BlkioStatsEntry{"
+	for _, f := range this.SectorsRecursive {
+		repeatedStringForSectorsRecursive += strings.Replace(f.String(), "BlkioStatsEntry", "BlkioStatsEntry", 1) + ","
+	}
+	repeatedStringForSectorsRecursive += "}"
+	s := strings.Join([]string{`&BlkioStats{`,
+		`IoServiceBytesRecursive:` + repeatedStringForIoServiceBytesRecursive + `,`,
+		`IoServicedRecursive:` + repeatedStringForIoServicedRecursive + `,`,
+		`IoQueuedRecursive:` + repeatedStringForIoQueuedRecursive + `,`,
+		`IoServiceTimeRecursive:` + repeatedStringForIoServiceTimeRecursive + `,`,
+		`IoWaitTimeRecursive:` + repeatedStringForIoWaitTimeRecursive + `,`,
+		`IoMergedRecursive:` + repeatedStringForIoMergedRecursive + `,`,
+		`IoTimeRecursive:` + repeatedStringForIoTimeRecursive + `,`,
+		`SectorsRecursive:` + repeatedStringForSectorsRecursive + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HugetlbStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HugetlbStats{`,
+		`Usage:` + fmt.Sprintf("%v", this.Usage) + `,`,
+		`MaxUsage:` + fmt.Sprintf("%v", this.MaxUsage) + `,`,
+		`Failcnt:` + fmt.Sprintf("%v", this.Failcnt) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CgroupStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHugetlbStats := make([]string, 0, len(this.HugetlbStats))
+	for k, _ := range this.HugetlbStats {
+		keysForHugetlbStats = append(keysForHugetlbStats, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHugetlbStats)
+	mapStringForHugetlbStats := "map[string]*HugetlbStats{"
+	for _, k := range keysForHugetlbStats {
+		mapStringForHugetlbStats += fmt.Sprintf("%v: %v,", k, this.HugetlbStats[k])
+	}
+	mapStringForHugetlbStats += "}"
+	s := strings.Join([]string{`&CgroupStats{`,
+		`CpuStats:` + strings.Replace(this.CpuStats.String(), "CpuStats", "CpuStats", 1) + `,`,
+		`MemoryStats:` + strings.Replace(this.MemoryStats.String(), "MemoryStats", "MemoryStats", 1) + `,`,
+		`PidsStats:` + strings.Replace(this.PidsStats.String(), "PidsStats", "PidsStats", 1) + `,`,
+		`BlkioStats:` + strings.Replace(this.BlkioStats.String(), "BlkioStats", "BlkioStats", 1) + `,`,
+		`HugetlbStats:` + mapStringForHugetlbStats + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkStats{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`RxBytes:` + fmt.Sprintf("%v", this.RxBytes) + `,`,
+		`RxPackets:` + fmt.Sprintf("%v", this.RxPackets) + `,`,
+		`RxErrors:` + fmt.Sprintf("%v", this.RxErrors) + `,`,
+		`RxDropped:` + fmt.Sprintf("%v", this.RxDropped) + `,`,
+		`TxBytes:` + fmt.Sprintf("%v", this.TxBytes) + `,`,
+		`TxPackets:` + fmt.Sprintf("%v", this.TxPackets) + `,`,
+		`TxErrors:` + fmt.Sprintf("%v", this.TxErrors) + `,`,
+		`TxDropped:` + fmt.Sprintf("%v", this.TxDropped) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatsContainerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNetworkStats := "[]*NetworkStats{"
+	for _, f := range this.NetworkStats {
+		repeatedStringForNetworkStats += strings.Replace(f.String(), "NetworkStats", "NetworkStats", 1) + ","
+	}
+	repeatedStringForNetworkStats += "}"
+	s := strings.Join([]string{`&StatsContainerResponse{`,
+		`CgroupStats:` + strings.Replace(this.CgroupStats.String(), "CgroupStats", "CgroupStats", 1) + `,`,
+		`NetworkStats:` + repeatedStringForNetworkStats + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WriteStreamRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WriteStreamRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WriteStreamResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WriteStreamResponse{`,
+		`Len:` + fmt.Sprintf("%v", this.Len) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadStreamRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadStreamRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
+		`Len:` + fmt.Sprintf("%v", this.Len) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadStreamResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadStreamResponse{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloseStdinRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloseStdinRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OpenProcessIORequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OpenProcessIORequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
+		`Bidirectional:` + fmt.Sprintf("%v", this.Bidirectional) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProcessIOPorts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProcessIOPorts{`,
+		`StdinPort:` + fmt.Sprintf("%v", this.StdinPort) + `,`,
+		`StdoutPort:` + fmt.Sprintf("%v", this.StdoutPort) + `,`,
+		`StderrPort:` + fmt.Sprintf("%v", this.StderrPort) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TtyWinResizeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TtyWinResizeRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`ExecId:` + fmt.Sprintf("%v", this.ExecId) + `,`,
+		`Row:` + fmt.Sprintf("%v", this.Row) + `,`,
+		`Column:` + fmt.Sprintf("%v", this.Column) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KernelModule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KernelModule{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Parameters:` + fmt.Sprintf("%v", this.Parameters) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateSandboxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForStorages := "[]*Storage{"
+	for _, f := range this.Storages {
+		repeatedStringForStorages += strings.Replace(f.String(), "Storage", "Storage", 1) + ","
+	}
+	repeatedStringForStorages += "}"
+	repeatedStringForKernelModules := "[]*KernelModule{"
+	for _, f := range this.KernelModules {
+		repeatedStringForKernelModules += strings.Replace(f.String(), "KernelModule", "KernelModule", 1) + ","
+	}
+	repeatedStringForKernelModules += "}"
+	s := strings.Join([]string{`&CreateSandboxRequest{`,
+		`Hostname:` + fmt.Sprintf("%v", this.Hostname) + `,`,
+		`Dns:` + fmt.Sprintf("%v", this.Dns) + `,`,
+		`Storages:` + repeatedStringForStorages + `,`,
+		`SandboxPidns:` + fmt.Sprintf("%v", this.SandboxPidns) + `,`,
+		`SandboxId:` + fmt.Sprintf("%v", this.SandboxId) + `,`,
+		`GuestHookPath:` + fmt.Sprintf("%v", this.GuestHookPath) + `,`,
+		`KernelModules:` + repeatedStringForKernelModules + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DestroySandboxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DestroySandboxRequest{`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Interfaces) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForInterfaces := "[]*Interface{"
+	for _, f := range this.Interfaces {
+		repeatedStringForInterfaces += strings.Replace(fmt.Sprintf("%v", f), "Interface", "protocols.Interface", 1) + ","
+	}
+	repeatedStringForInterfaces += "}"
+	s := strings.Join([]string{`&Interfaces{`,
+		`Interfaces:` + repeatedStringForInterfaces + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Routes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRoutes := "[]*Route{"
+	for _, f := range this.Routes {
+		repeatedStringForRoutes += strings.Replace(fmt.Sprintf("%v", f), "Route", "protocols.Route", 1) + ","
+	}
+	repeatedStringForRoutes += "}"
+	s := strings.Join([]string{`&Routes{`,
+		`Routes:` + repeatedStringForRoutes + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateInterfaceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateInterfaceRequest{`,
+		`Interface:` + strings.Replace(fmt.Sprintf("%v", this.Interface), "Interface", "protocols.Interface", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateRoutesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateRoutesRequest{`,
+		`Routes:` + strings.Replace(this.Routes.String(), "Routes", "Routes", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListInterfacesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListInterfacesRequest{`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListRoutesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListRoutesRequest{`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ARPNeighbors) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForARPNeighbors := "[]*ARPNeighbor{"
+	for _, f := range this.ARPNeighbors {
+		repeatedStringForARPNeighbors += strings.Replace(fmt.Sprintf("%v", f), "ARPNeighbor", "protocols.ARPNeighbor", 1) + ","
+	}
+	repeatedStringForARPNeighbors += "}"
+	s := strings.Join([]string{`&ARPNeighbors{`,
+		`ARPNeighbors:` + repeatedStringForARPNeighbors + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddARPNeighborsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddARPNeighborsRequest{`,
+		`Neighbors:` + strings.Replace(this.Neighbors.String(), "ARPNeighbors", "ARPNeighbors", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetIPTablesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetIPTablesRequest{`,
+		`IsIpv6:` + fmt.Sprintf("%v", this.IsIpv6) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetIPTablesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetIPTablesResponse{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetIPTablesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetIPTablesRequest{`,
+		`IsIpv6:` + fmt.Sprintf("%v", this.IsIpv6) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetIPTablesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetIPTablesResponse{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OnlineCPUMemRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OnlineCPUMemRequest{`,
+		`Wait:` + fmt.Sprintf("%v", this.Wait) + `,`,
+		`NbCpus:` + fmt.Sprintf("%v", this.NbCpus) + `,`,
+		`CpuOnly:` + fmt.Sprintf("%v", this.CpuOnly) + `,`,
+		`NbMemBlocks:` + fmt.Sprintf("%v", this.NbMemBlocks) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReseedRandomDevRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReseedRandomDevRequest{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AgentDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AgentDetails{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`InitDaemon:` + fmt.Sprintf("%v", this.InitDaemon) + `,`,
+		`DeviceHandlers:` + fmt.Sprintf("%v", this.DeviceHandlers) + `,`,
+		`StorageHandlers:` + fmt.Sprintf("%v", this.StorageHandlers) + `,`,
+		`SupportsSeccomp:` + fmt.Sprintf("%v", this.SupportsSeccomp) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GuestDetailsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GuestDetailsRequest{`,
+		`MemBlockSize:` + fmt.Sprintf("%v", this.MemBlockSize) + `,`,
+		`MemHotplugProbe:` + fmt.Sprintf("%v", this.MemHotplugProbe) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GuestDetailsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GuestDetailsResponse{`,
+		`MemBlockSizeBytes:` + fmt.Sprintf("%v", this.MemBlockSizeBytes) + `,`,
+		`AgentDetails:` + strings.Replace(this.AgentDetails.String(), "AgentDetails", "AgentDetails", 1) + `,`,
+		`SupportMemHotplugProbe:` + fmt.Sprintf("%v", this.SupportMemHotplugProbe) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemHotplugByProbeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemHotplugByProbeRequest{`,
+		`MemHotplugProbeAddr:` + fmt.Sprintf("%v", this.MemHotplugProbeAddr) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetGuestDateTimeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetGuestDateTimeRequest{`,
+		`Sec:` + fmt.Sprintf("%v", this.Sec) + `,`,
+		`Usec:` + fmt.Sprintf("%v", this.Usec) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FSGroup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FSGroup{`,
+		`GroupId:` + fmt.Sprintf("%v", this.GroupId) + `,`,
+		`GroupChangePolicy:` + fmt.Sprintf("%v", this.GroupChangePolicy) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Storage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Storage{`,
+		`Driver:` + fmt.Sprintf("%v", this.Driver) + `,`,
+		`DriverOptions:` + fmt.Sprintf("%v", this.DriverOptions) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Fstype:` + fmt.Sprintf("%v", this.Fstype) + `,`,
+		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
+		`MountPoint:` + fmt.Sprintf("%v", this.MountPoint) + `,`,
+		`FsGroup:` + strings.Replace(this.FsGroup.String(), "FSGroup", "FSGroup", 1) + `,`,
+		`Encryption:` + strings.Replace(this.Encryption.String(), "StorageEncryption", "StorageEncryption", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StorageEncryption) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StorageEncryption{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Device) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Device{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`VmPath:` + fmt.Sprintf("%v", this.VmPath) + `,`,
+		`ContainerPath:` + fmt.Sprintf("%v", this.ContainerPath) + `,`,
+		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StringUser) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StringUser{`,
+		`Uid:` + fmt.Sprintf("%v", this.Uid) + `,`,
+		`Gid:` + fmt.Sprintf("%v", this.Gid) + `,`,
+		`AdditionalGids:` + fmt.Sprintf("%v", this.AdditionalGids) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CopyFileRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CopyFileRequest{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`FileSize:` + fmt.Sprintf("%v", this.FileSize) + `,`,
+		`FileMode:` + fmt.Sprintf("%v", this.FileMode) + `,`,
+		`DirMode:` + fmt.Sprintf("%v", this.DirMode) + `,`,
+		`Uid:` + fmt.Sprintf("%v", this.Uid) + `,`,
+		`Gid:` + fmt.Sprintf("%v", this.Gid) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetOOMEventRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetOOMEventRequest{`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OOMEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OOMEvent{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddSwapRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddSwapRequest{`,
+		`PCIPath:` + fmt.Sprintf("%v", this.PCIPath) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetMetricsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetMetricsRequest{`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Metrics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Metrics{`,
+		`Metrics:` + fmt.Sprintf("%v", this.Metrics) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VolumeStatsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VolumeStatsRequest{`,
+		`VolumeGuestPath:` + fmt.Sprintf("%v", this.VolumeGuestPath) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResizeVolumeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResizeVolumeRequest{`,
+		`VolumeGuestPath:` + fmt.Sprintf("%v", this.VolumeGuestPath) + `,`,
+		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchableEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchableEntry{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`Uid:` + fmt.Sprintf("%v", this.Uid) + `,`,
+		`Gid:` + fmt.Sprintf("%v", this.Gid) + `,`,
+		`LinkTarget:` + fmt.Sprintf("%v", this.LinkTarget) + `,`,
+		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWatchableMountRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEntries := "[]*WatchableEntry{"
+	for _, f := range this.Entries {
+		repeatedStringForEntries += strings.Replace(f.String(), "WatchableEntry", "WatchableEntry", 1) + ","
+	}
+	repeatedStringForEntries += "}"
+	s := strings.Join([]string{`&SyncWatchableMountRequest{`,
+		`MountPoint:` + fmt.Sprintf("%v", this.MountPoint) + `,`,
+		`Entries:` + repeatedStringForEntries + `,`,
+		`Removed:` + fmt.Sprintf("%v", this.Removed) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetPolicyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetPolicyRequest{`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetPolicyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetPolicyRequest{`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Policy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Policy{`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecuteHooksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHooks := "[]*Hook{"
+	for _, f := range this.Hooks {
+		repeatedStringForHooks += strings.Replace(fmt.Sprintf("%v", f), "Hook", "Hook", 1) + ","
+	}
+	repeatedStringForHooks += "}"
+	s := strings.Join([]string{`&ExecuteHooksRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`Hooks:` + repeatedStringForHooks + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDiagnosticsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDiagnosticsRequest{`,
+		`ContainerId:` + fmt.Sprintf("%v", this.ContainerId) + `,`,
+		`MaxSize:` + fmt.Sprintf("%v", this.MaxSize) + `,`,
+		`CoreDump:` + fmt.Sprintf("%v", this.CoreDump) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiagnosticsFile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DiagnosticsFile{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Truncated:` + fmt.Sprintf("%v", this.Truncated) + `,`,
+		`Size_:` + fmt.Sprintf("%v", this.Size_) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Diagnostics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFiles := "[]*DiagnosticsFile{"
+	for _, f := range this.Files {
+		repeatedStringForFiles += strings.Replace(f.String(), "DiagnosticsFile", "DiagnosticsFile", 1) + ","
+	}
+	repeatedStringForFiles += "}"
+	s := strings.Join([]string{`&Diagnostics{`,
+		`Files:` + repeatedStringForFiles + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetLogLevelRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetLogLevelRequest{`,
+		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReadKernelLogRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReadKernelLogRequest{`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KernelLogRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KernelLogRecord{`,
+		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
+		`Facility:` + fmt.Sprintf("%v", this.Facility) + `,`,
+		`Sequence:` + fmt.Sprintf("%v", this.Sequence) + `,`,
+		`TimestampUsec:` + fmt.Sprintf("%v", this.TimestampUsec) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KernelLog) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRecords := "[]*KernelLogRecord{"
+	for _, f := range this.Records {
+		repeatedStringForRecords += strings.Replace(f.String(), "KernelLogRecord", "KernelLogRecord", 1) + ","
+	}
+	repeatedStringForRecords += "}"
+	s := strings.Join([]string{`&KernelLog{`,
+		`Records:` + repeatedStringForRecords + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetGuestTimeSourceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetGuestTimeSourceRequest{`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`NtpServers:` + fmt.Sprintf("%v", this.NtpServers) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartTracingRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartTracingRequest{`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopTracingRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopTracingRequest{`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAgent(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+
+type AgentServiceService interface {
+	CreateContainer(ctx context.Context, req *CreateContainerRequest) (*types.Empty, error)
+	StartContainer(ctx context.Context, req *StartContainerRequest) (*types.Empty, error)
+	RemoveContainer(ctx context.Context, req *RemoveContainerRequest) (*types.Empty, error)
+	ExecProcess(ctx context.Context, req *ExecProcessRequest) (*types.Empty, error)
+	SignalProcess(ctx context.Context, req *SignalProcessRequest) (*types.Empty, error)
 	WaitProcess(ctx context.Context, req *WaitProcessRequest) (*WaitProcessResponse, error)
 	UpdateContainer(ctx context.Context, req *UpdateContainerRequest) (*types.Empty, error)
 	StatsContainer(ctx context.Context, req *StatsContainerRequest) (*StatsContainerResponse, error)
@@ -7933,12 +10736,17 @@ type AgentServiceService interface {
 	ReadStderr(ctx context.Context, req *ReadStreamRequest) (*ReadStreamResponse, error)
 	CloseStdin(ctx context.Context, req *CloseStdinRequest) (*types.Empty, error)
 	TtyWinResize(ctx context.Context, req *TtyWinResizeRequest) (*types.Empty, error)
+	OpenProcessIO(ctx context.Context, req *OpenProcessIORequest) (*ProcessIOPorts, error)
 	UpdateInterface(ctx context.Context, req *UpdateInterfaceRequest) (*protocols.Interface, error)
 	UpdateRoutes(ctx context.Context, req *UpdateRoutesRequest) (*Routes, error)
 	ListInterfaces(ctx context.Context, req *ListInterfacesRequest) (*Interfaces, error)
 	ListRoutes(ctx context.Context, req *ListRoutesRequest) (*Routes, error)
 	AddARPNeighbors(ctx context.Context, req *AddARPNeighborsRequest) (*types.Empty, error)
+	GetIPTables(ctx context.Context, req *GetIPTablesRequest) (*GetIPTablesResponse, error)
+	SetIPTables(ctx context.Context, req *SetIPTablesRequest) (*SetIPTablesResponse, error)
 	GetMetrics(ctx context.Context, req *GetMetricsRequest) (*Metrics, error)
+	StartTracing(ctx context.Context, req *StartTracingRequest) (*types.Empty, error)
+	StopTracing(ctx context.Context, req *StopTracingRequest) (*types.Empty, error)
 	CreateSandbox(ctx context.Context, req *CreateSandboxRequest) (*types.Empty, error)
 	DestroySandbox(ctx context.Context, req *DestroySandboxRequest) (*types.Empty, error)
 	OnlineCPUMem(ctx context.Context, req *OnlineCPUMemRequest) (*types.Empty, error)
@@ -7951,518 +10759,2997 @@ type AgentServiceService interface {
 	AddSwap(ctx context.Context, req *AddSwapRequest) (*types.Empty, error)
 	GetVolumeStats(ctx context.Context, req *VolumeStatsRequest) (*VolumeStatsResponse, error)
 	ResizeVolume(ctx context.Context, req *ResizeVolumeRequest) (*types.Empty, error)
+	SyncWatchableMount(ctx context.Context, req *SyncWatchableMountRequest) (*types.Empty, error)
+	SetPolicy(ctx context.Context, req *SetPolicyRequest) (*types.Empty, error)
+	GetPolicy(ctx context.Context, req *GetPolicyRequest) (*Policy, error)
+	ExecuteHooks(ctx context.Context, req *ExecuteHooksRequest) (*types.Empty, error)
+	GetDiagnostics(ctx context.Context, req *GetDiagnosticsRequest) (*Diagnostics, error)
+	SetLogLevel(ctx context.Context, req *SetLogLevelRequest) (*types.Empty, error)
+	ReadKernelLog(ctx context.Context, req *ReadKernelLogRequest) (*KernelLog, error)
+	SetGuestTimeSource(ctx context.Context, req *SetGuestTimeSourceRequest) (*types.Empty, error)
+}
+
+func RegisterAgentServiceService(srv *github_com_containerd_ttrpc.Server, svc AgentServiceService) {
+	srv.Register("grpc.AgentService", map[string]github_com_containerd_ttrpc.Method{
+		"CreateContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req CreateContainerRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.CreateContainer(ctx, &req)
+		},
+		"StartContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req StartContainerRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.StartContainer(ctx, &req)
+		},
+		"RemoveContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req RemoveContainerRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.RemoveContainer(ctx, &req)
+		},
+		"ExecProcess": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req ExecProcessRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.ExecProcess(ctx, &req)
+		},
+		"SignalProcess": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req SignalProcessRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.SignalProcess(ctx, &req)
+		},
+		"WaitProcess": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req WaitProcessRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.WaitProcess(ctx, &req)
+		},
+		"UpdateContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req UpdateContainerRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.UpdateContainer(ctx, &req)
+		},
+		"StatsContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req StatsContainerRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.StatsContainer(ctx, &req)
+		},
+		"PauseContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req PauseContainerRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.PauseContainer(ctx, &req)
+		},
+		"ResumeContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req ResumeContainerRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.ResumeContainer(ctx, &req)
+		},
+		"WriteStdin": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req WriteStreamRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.WriteStdin(ctx, &req)
+		},
+		"ReadStdout": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req ReadStreamRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.ReadStdout(ctx, &req)
+		},
+		"ReadStderr": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req ReadStreamRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.ReadStderr(ctx, &req)
+		},
+		"CloseStdin": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req CloseStdinRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.CloseStdin(ctx, &req)
+		},
+		"TtyWinResize": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req TtyWinResizeRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.TtyWinResize(ctx, &req)
+		},
+		"OpenProcessIO": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req OpenProcessIORequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.OpenProcessIO(ctx, &req)
+		},
+		"UpdateInterface": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req UpdateInterfaceRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.UpdateInterface(ctx, &req)
+		},
+		"UpdateRoutes": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req UpdateRoutesRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.UpdateRoutes(ctx, &req)
+		},
+		"ListInterfaces": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req ListInterfacesRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.ListInterfaces(ctx, &req)
+		},
+		"ListRoutes": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req ListRoutesRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.ListRoutes(ctx, &req)
+		},
+		"AddARPNeighbors": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req AddARPNeighborsRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.AddARPNeighbors(ctx, &req)
+		},
+		"GetIPTables": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req GetIPTablesRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.GetIPTables(ctx, &req)
+		},
+		"SetIPTables": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req SetIPTablesRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.SetIPTables(ctx, &req)
+		},
+		"GetMetrics": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req GetMetricsRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.GetMetrics(ctx, &req)
+		},
+		"StartTracing": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req StartTracingRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.StartTracing(ctx, &req)
+		},
+		"StopTracing": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req StopTracingRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.StopTracing(ctx, &req)
+		},
+		"CreateSandbox": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req CreateSandboxRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.CreateSandbox(ctx, &req)
+		},
+		"DestroySandbox": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req DestroySandboxRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.DestroySandbox(ctx, &req)
+		},
+		"OnlineCPUMem": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req OnlineCPUMemRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.OnlineCPUMem(ctx, &req)
+		},
+		"ReseedRandomDev": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req ReseedRandomDevRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.ReseedRandomDev(ctx, &req)
+		},
+		"GetGuestDetails": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req GuestDetailsRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.GetGuestDetails(ctx, &req)
+		},
+		"MemHotplugByProbe": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req MemHotplugByProbeRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.MemHotplugByProbe(ctx, &req)
+		},
+		"SetGuestDateTime": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req SetGuestDateTimeRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.SetGuestDateTime(ctx, &req)
+		},
+		"CopyFile": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req CopyFileRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.CopyFile(ctx, &req)
+		},
+		"GetOOMEvent": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req GetOOMEventRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.GetOOMEvent(ctx, &req)
+		},
+		"AddSwap": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req AddSwapRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.AddSwap(ctx, &req)
+		},
+		"GetVolumeStats": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req VolumeStatsRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.GetVolumeStats(ctx, &req)
+		},
+		"ResizeVolume": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req ResizeVolumeRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.ResizeVolume(ctx, &req)
+		},
+		"SyncWatchableMount": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req SyncWatchableMountRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.SyncWatchableMount(ctx, &req)
+		},
+		"SetPolicy": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req SetPolicyRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.SetPolicy(ctx, &req)
+		},
+		"GetPolicy": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req GetPolicyRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.GetPolicy(ctx, &req)
+		},
+		"ExecuteHooks": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req ExecuteHooksRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.ExecuteHooks(ctx, &req)
+		},
+		"GetDiagnostics": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req GetDiagnosticsRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.GetDiagnostics(ctx, &req)
+		},
+		"SetLogLevel": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req SetLogLevelRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.SetLogLevel(ctx, &req)
+		},
+		"ReadKernelLog": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req ReadKernelLogRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.ReadKernelLog(ctx, &req)
+		},
+		"SetGuestTimeSource": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req SetGuestTimeSourceRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.SetGuestTimeSource(ctx, &req)
+		},
+	})
+}
+
+type agentServiceClient struct {
+	client *github_com_containerd_ttrpc.Client
+}
+
+func NewAgentServiceClient(client *github_com_containerd_ttrpc.Client) AgentServiceService {
+	return &agentServiceClient{
+		client: client,
+	}
+}
+
+func (c *agentServiceClient) CreateContainer(ctx context.Context, req *CreateContainerRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "CreateContainer", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) StartContainer(ctx context.Context, req *StartContainerRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "StartContainer", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) RemoveContainer(ctx context.Context, req *RemoveContainerRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "RemoveContainer", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) ExecProcess(ctx context.Context, req *ExecProcessRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "ExecProcess", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) SignalProcess(ctx context.Context, req *SignalProcessRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "SignalProcess", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) WaitProcess(ctx context.Context, req *WaitProcessRequest) (*WaitProcessResponse, error) {
+	var resp WaitProcessResponse
+	if err := c.client.Call(ctx, "grpc.AgentService", "WaitProcess", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) UpdateContainer(ctx context.Context, req *UpdateContainerRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "UpdateContainer", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) StatsContainer(ctx context.Context, req *StatsContainerRequest) (*StatsContainerResponse, error) {
+	var resp StatsContainerResponse
+	if err := c.client.Call(ctx, "grpc.AgentService", "StatsContainer", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) PauseContainer(ctx context.Context, req *PauseContainerRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "PauseContainer", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) ResumeContainer(ctx context.Context, req *ResumeContainerRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "ResumeContainer", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) WriteStdin(ctx context.Context, req *WriteStreamRequest) (*WriteStreamResponse, error) {
+	var resp WriteStreamResponse
+	if err := c.client.Call(ctx, "grpc.AgentService", "WriteStdin", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) ReadStdout(ctx context.Context, req *ReadStreamRequest) (*ReadStreamResponse, error) {
+	var resp ReadStreamResponse
+	if err := c.client.Call(ctx, "grpc.AgentService", "ReadStdout", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) ReadStderr(ctx context.Context, req *ReadStreamRequest) (*ReadStreamResponse, error) {
+	var resp ReadStreamResponse
+	if err := c.client.Call(ctx, "grpc.AgentService", "ReadStderr", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) CloseStdin(ctx context.Context, req *CloseStdinRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "CloseStdin", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) TtyWinResize(ctx context.Context, req *TtyWinResizeRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "TtyWinResize", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) OpenProcessIO(ctx context.Context, req *OpenProcessIORequest) (*ProcessIOPorts, error) {
+	var resp ProcessIOPorts
+	if err := c.client.Call(ctx, "grpc.AgentService", "OpenProcessIO", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) UpdateInterface(ctx context.Context, req *UpdateInterfaceRequest) (*protocols.Interface, error) {
+	var resp protocols.Interface
+	if err := c.client.Call(ctx, "grpc.AgentService", "UpdateInterface", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) UpdateRoutes(ctx context.Context, req *UpdateRoutesRequest) (*Routes, error) {
+	var resp Routes
+	if err := c.client.Call(ctx, "grpc.AgentService", "UpdateRoutes", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) ListInterfaces(ctx context.Context, req *ListInterfacesRequest) (*Interfaces, error) {
+	var resp Interfaces
+	if err := c.client.Call(ctx, "grpc.AgentService", "ListInterfaces", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) ListRoutes(ctx context.Context, req *ListRoutesRequest) (*Routes, error) {
+	var resp Routes
+	if err := c.client.Call(ctx, "grpc.AgentService", "ListRoutes", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) AddARPNeighbors(ctx context.Context, req *AddARPNeighborsRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "AddARPNeighbors", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) GetIPTables(ctx context.Context, req *GetIPTablesRequest) (*GetIPTablesResponse, error) {
+	var resp GetIPTablesResponse
+	if err := c.client.Call(ctx, "grpc.AgentService", "GetIPTables", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) SetIPTables(ctx context.Context, req *SetIPTablesRequest) (*SetIPTablesResponse, error) {
+	var resp SetIPTablesResponse
+	if err := c.client.Call(ctx, "grpc.AgentService", "SetIPTables", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) GetMetrics(ctx context.Context, req *GetMetricsRequest) (*Metrics, error) {
+	var resp Metrics
+	if err := c.client.Call(ctx, "grpc.AgentService", "GetMetrics", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) StartTracing(ctx context.Context, req *StartTracingRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "StartTracing", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) StopTracing(ctx context.Context, req *StopTracingRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "StopTracing", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) CreateSandbox(ctx context.Context, req *CreateSandboxRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "CreateSandbox", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) DestroySandbox(ctx context.Context, req *DestroySandboxRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "DestroySandbox", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) OnlineCPUMem(ctx context.Context, req *OnlineCPUMemRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "OnlineCPUMem", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) ReseedRandomDev(ctx context.Context, req *ReseedRandomDevRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "ReseedRandomDev", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) GetGuestDetails(ctx context.Context, req *GuestDetailsRequest) (*GuestDetailsResponse, error) {
+	var resp GuestDetailsResponse
+	if err := c.client.Call(ctx, "grpc.AgentService", "GetGuestDetails", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) MemHotplugByProbe(ctx context.Context, req *MemHotplugByProbeRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "MemHotplugByProbe", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) SetGuestDateTime(ctx context.Context, req *SetGuestDateTimeRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "SetGuestDateTime", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) CopyFile(ctx context.Context, req *CopyFileRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "CopyFile", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) GetOOMEvent(ctx context.Context, req *GetOOMEventRequest) (*OOMEvent, error) {
+	var resp OOMEvent
+	if err := c.client.Call(ctx, "grpc.AgentService", "GetOOMEvent", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) AddSwap(ctx context.Context, req *AddSwapRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "AddSwap", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) GetVolumeStats(ctx context.Context, req *VolumeStatsRequest) (*VolumeStatsResponse, error) {
+	var resp VolumeStatsResponse
+	if err := c.client.Call(ctx, "grpc.AgentService", "GetVolumeStats", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) ResizeVolume(ctx context.Context, req *ResizeVolumeRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "ResizeVolume", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) SyncWatchableMount(ctx context.Context, req *SyncWatchableMountRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "SyncWatchableMount", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) SetPolicy(ctx context.Context, req *SetPolicyRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "SetPolicy", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) GetPolicy(ctx context.Context, req *GetPolicyRequest) (*Policy, error) {
+	var resp Policy
+	if err := c.client.Call(ctx, "grpc.AgentService", "GetPolicy", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) ExecuteHooks(ctx context.Context, req *ExecuteHooksRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "ExecuteHooks", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) GetDiagnostics(ctx context.Context, req *GetDiagnosticsRequest) (*Diagnostics, error) {
+	var resp Diagnostics
+	if err := c.client.Call(ctx, "grpc.AgentService", "GetDiagnostics", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) SetLogLevel(ctx context.Context, req *SetLogLevelRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "SetLogLevel", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) ReadKernelLog(ctx context.Context, req *ReadKernelLogRequest) (*KernelLog, error) {
+	var resp KernelLog
+	if err := c.client.Call(ctx, "grpc.AgentService", "ReadKernelLog", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *agentServiceClient) SetGuestTimeSource(ctx context.Context, req *SetGuestTimeSourceRequest) (*types.Empty, error) {
+	var resp types.Empty
+	if err := c.client.Call(ctx, "grpc.AgentService", "SetGuestTimeSource", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringUser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StringUser == nil {
+				m.StringUser = &StringUser{}
+			}
+			if err := m.StringUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, &Device{})
+			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storages = append(m.Storages, &Storage{})
+			if err := m.Storages[len(m.Storages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OCI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OCI == nil {
+				m.OCI = &Spec{}
+			}
+			if err := m.OCI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SandboxPidns", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SandboxPidns = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringUser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StringUser == nil {
+				m.StringUser = &StringUser{}
+			}
+			if err := m.StringUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Process == nil {
+				m.Process = &Process{}
+			}
+			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+			}
+			m.Signal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Signal |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *WaitProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WaitProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WaitProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func RegisterAgentServiceService(srv *github_com_containerd_ttrpc.Server, svc AgentServiceService) {
-	srv.Register("grpc.AgentService", map[string]github_com_containerd_ttrpc.Method{
-		"CreateContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req CreateContainerRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WaitProcessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-			return svc.CreateContainer(ctx, &req)
-		},
-		"StartContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req StartContainerRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			return svc.StartContainer(ctx, &req)
-		},
-		"RemoveContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req RemoveContainerRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			return svc.RemoveContainer(ctx, &req)
-		},
-		"ExecProcess": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req ExecProcessRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WaitProcessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WaitProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			return svc.ExecProcess(ctx, &req)
-		},
-		"SignalProcess": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req SignalProcessRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			return svc.SignalProcess(ctx, &req)
-		},
-		"WaitProcess": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req WaitProcessRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			return svc.WaitProcess(ctx, &req)
-		},
-		"UpdateContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req UpdateContainerRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			return svc.UpdateContainer(ctx, &req)
-		},
-		"StatsContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req StatsContainerRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			return svc.StatsContainer(ctx, &req)
-		},
-		"PauseContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req PauseContainerRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			return svc.PauseContainer(ctx, &req)
-		},
-		"ResumeContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req ResumeContainerRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			return svc.ResumeContainer(ctx, &req)
-		},
-		"WriteStdin": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req WriteStreamRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
 			}
-			return svc.WriteStdin(ctx, &req)
-		},
-		"ReadStdout": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req ReadStreamRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			return svc.ReadStdout(ctx, &req)
-		},
-		"ReadStderr": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req ReadStreamRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			return svc.ReadStderr(ctx, &req)
-		},
-		"CloseStdin": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req CloseStdinRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			return svc.CloseStdin(ctx, &req)
-		},
-		"TtyWinResize": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req TtyWinResizeRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			return svc.TtyWinResize(ctx, &req)
-		},
-		"UpdateInterface": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req UpdateInterfaceRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
 			}
-			return svc.UpdateInterface(ctx, &req)
-		},
-		"UpdateRoutes": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req UpdateRoutesRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			return svc.UpdateRoutes(ctx, &req)
-		},
-		"ListInterfaces": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req ListInterfacesRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			if m.Resources == nil {
+				m.Resources = &LinuxResources{}
 			}
-			return svc.ListInterfaces(ctx, &req)
-		},
-		"ListRoutes": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req ListRoutesRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			return svc.ListRoutes(ctx, &req)
-		},
-		"AddARPNeighbors": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req AddARPNeighborsRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			return svc.AddARPNeighbors(ctx, &req)
-		},
-		"GetMetrics": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req GetMetricsRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
 			}
-			return svc.GetMetrics(ctx, &req)
-		},
-		"CreateSandbox": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req CreateSandboxRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			return svc.CreateSandbox(ctx, &req)
-		},
-		"DestroySandbox": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req DestroySandboxRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatsContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-			return svc.DestroySandbox(ctx, &req)
-		},
-		"OnlineCPUMem": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req OnlineCPUMemRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			return svc.OnlineCPUMem(ctx, &req)
-		},
-		"ReseedRandomDev": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req ReseedRandomDevRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			return svc.ReseedRandomDev(ctx, &req)
-		},
-		"GetGuestDetails": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req GuestDetailsRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatsContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatsContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			return svc.GetGuestDetails(ctx, &req)
-		},
-		"MemHotplugByProbe": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req MemHotplugByProbeRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			return svc.MemHotplugByProbe(ctx, &req)
-		},
-		"SetGuestDateTime": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req SetGuestDateTimeRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			return svc.SetGuestDateTime(ctx, &req)
-		},
-		"CopyFile": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req CopyFileRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
 			}
-			return svc.CopyFile(ctx, &req)
-		},
-		"GetOOMEvent": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req GetOOMEventRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			return svc.GetOOMEvent(ctx, &req)
-		},
-		"AddSwap": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req AddSwapRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			return svc.AddSwap(ctx, &req)
-		},
-		"GetVolumeStats": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req VolumeStatsRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
 			}
-			return svc.GetVolumeStats(ctx, &req)
-		},
-		"ResizeVolume": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
-			var req ResizeVolumeRequest
-			if err := unmarshal(&req); err != nil {
-				return nil, err
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			return svc.ResizeVolume(ctx, &req)
-		},
-	})
-}
-
-type agentServiceClient struct {
-	client *github_com_containerd_ttrpc.Client
-}
-
-func NewAgentServiceClient(client *github_com_containerd_ttrpc.Client) AgentServiceService {
-	return &agentServiceClient{
-		client: client,
-	}
-}
-
-func (c *agentServiceClient) CreateContainer(ctx context.Context, req *CreateContainerRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "CreateContainer", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) StartContainer(ctx context.Context, req *StartContainerRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "StartContainer", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) RemoveContainer(ctx context.Context, req *RemoveContainerRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "RemoveContainer", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) ExecProcess(ctx context.Context, req *ExecProcessRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "ExecProcess", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) SignalProcess(ctx context.Context, req *SignalProcessRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "SignalProcess", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) WaitProcess(ctx context.Context, req *WaitProcessRequest) (*WaitProcessResponse, error) {
-	var resp WaitProcessResponse
-	if err := c.client.Call(ctx, "grpc.AgentService", "WaitProcess", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) UpdateContainer(ctx context.Context, req *UpdateContainerRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "UpdateContainer", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) StatsContainer(ctx context.Context, req *StatsContainerRequest) (*StatsContainerResponse, error) {
-	var resp StatsContainerResponse
-	if err := c.client.Call(ctx, "grpc.AgentService", "StatsContainer", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) PauseContainer(ctx context.Context, req *PauseContainerRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "PauseContainer", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) ResumeContainer(ctx context.Context, req *ResumeContainerRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "ResumeContainer", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) WriteStdin(ctx context.Context, req *WriteStreamRequest) (*WriteStreamResponse, error) {
-	var resp WriteStreamResponse
-	if err := c.client.Call(ctx, "grpc.AgentService", "WriteStdin", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) ReadStdout(ctx context.Context, req *ReadStreamRequest) (*ReadStreamResponse, error) {
-	var resp ReadStreamResponse
-	if err := c.client.Call(ctx, "grpc.AgentService", "ReadStdout", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) ReadStderr(ctx context.Context, req *ReadStreamRequest) (*ReadStreamResponse, error) {
-	var resp ReadStreamResponse
-	if err := c.client.Call(ctx, "grpc.AgentService", "ReadStderr", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) CloseStdin(ctx context.Context, req *CloseStdinRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "CloseStdin", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) TtyWinResize(ctx context.Context, req *TtyWinResizeRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "TtyWinResize", req, &resp); err != nil {
-		return nil, err
-	}
-	return &resp, nil
-}
-
-func (c *agentServiceClient) UpdateInterface(ctx context.Context, req *UpdateInterfaceRequest) (*protocols.Interface, error) {
-	var resp protocols.Interface
-	if err := c.client.Call(ctx, "grpc.AgentService", "UpdateInterface", req, &resp); err != nil {
-		return nil, err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return &resp, nil
-}
 
-func (c *agentServiceClient) UpdateRoutes(ctx context.Context, req *UpdateRoutesRequest) (*Routes, error) {
-	var resp Routes
-	if err := c.client.Call(ctx, "grpc.AgentService", "UpdateRoutes", req, &resp); err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return &resp, nil
+	return nil
 }
-
-func (c *agentServiceClient) ListInterfaces(ctx context.Context, req *ListInterfacesRequest) (*Interfaces, error) {
-	var resp Interfaces
-	if err := c.client.Call(ctx, "grpc.AgentService", "ListInterfaces", req, &resp); err != nil {
-		return nil, err
+func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return &resp, nil
-}
 
-func (c *agentServiceClient) ListRoutes(ctx context.Context, req *ListRoutesRequest) (*Routes, error) {
-	var resp Routes
-	if err := c.client.Call(ctx, "grpc.AgentService", "ListRoutes", req, &resp); err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return &resp, nil
+	return nil
 }
-
-func (c *agentServiceClient) AddARPNeighbors(ctx context.Context, req *AddARPNeighborsRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "AddARPNeighbors", req, &resp); err != nil {
-		return nil, err
+func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeContainerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return &resp, nil
-}
 
-func (c *agentServiceClient) GetMetrics(ctx context.Context, req *GetMetricsRequest) (*Metrics, error) {
-	var resp Metrics
-	if err := c.client.Call(ctx, "grpc.AgentService", "GetMetrics", req, &resp); err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return &resp, nil
+	return nil
 }
-
-func (c *agentServiceClient) CreateSandbox(ctx context.Context, req *CreateSandboxRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "CreateSandbox", req, &resp); err != nil {
-		return nil, err
+func (m *CpuUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CpuUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CpuUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUsage", wireType)
+			}
+			m.TotalUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalUsage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PercpuUsage = append(m.PercpuUsage, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAgent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAgent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PercpuUsage) == 0 {
+					m.PercpuUsage = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PercpuUsage = append(m.PercpuUsage, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PercpuUsage", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageInKernelmode", wireType)
+			}
+			m.UsageInKernelmode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsageInKernelmode |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageInUsermode", wireType)
+			}
+			m.UsageInUsermode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsageInUsermode |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return &resp, nil
-}
 
-func (c *agentServiceClient) DestroySandbox(ctx context.Context, req *DestroySandboxRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "DestroySandbox", req, &resp); err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return &resp, nil
+	return nil
 }
-
-func (c *agentServiceClient) OnlineCPUMem(ctx context.Context, req *OnlineCPUMemRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "OnlineCPUMem", req, &resp); err != nil {
-		return nil, err
+func (m *ThrottlingData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThrottlingData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThrottlingData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledPeriods", wireType)
+			}
+			m.ThrottledPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThrottledPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledTime", wireType)
+			}
+			m.ThrottledTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThrottledTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return &resp, nil
-}
 
-func (c *agentServiceClient) ReseedRandomDev(ctx context.Context, req *ReseedRandomDevRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "ReseedRandomDev", req, &resp); err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return &resp, nil
+	return nil
 }
-
-func (c *agentServiceClient) GetGuestDetails(ctx context.Context, req *GuestDetailsRequest) (*GuestDetailsResponse, error) {
-	var resp GuestDetailsResponse
-	if err := c.client.Call(ctx, "grpc.AgentService", "GetGuestDetails", req, &resp); err != nil {
-		return nil, err
+func (m *CpuStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CpuStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CpuStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CpuUsage == nil {
+				m.CpuUsage = &CpuUsage{}
+			}
+			if err := m.CpuUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottlingData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ThrottlingData == nil {
+				m.ThrottlingData = &ThrottlingData{}
+			}
+			if err := m.ThrottlingData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return &resp, nil
-}
 
-func (c *agentServiceClient) MemHotplugByProbe(ctx context.Context, req *MemHotplugByProbeRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "MemHotplugByProbe", req, &resp); err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return &resp, nil
+	return nil
 }
-
-func (c *agentServiceClient) SetGuestDateTime(ctx context.Context, req *SetGuestDateTimeRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "SetGuestDateTime", req, &resp); err != nil {
-		return nil, err
+func (m *PidsStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PidsStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PidsStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			m.Current = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Current |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return &resp, nil
-}
 
-func (c *agentServiceClient) CopyFile(ctx context.Context, req *CopyFileRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "CopyFile", req, &resp); err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return &resp, nil
+	return nil
 }
-
-func (c *agentServiceClient) GetOOMEvent(ctx context.Context, req *GetOOMEventRequest) (*OOMEvent, error) {
-	var resp OOMEvent
-	if err := c.client.Call(ctx, "grpc.AgentService", "GetOOMEvent", req, &resp); err != nil {
-		return nil, err
+func (m *MemoryData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoryData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoryData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			m.Usage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Usage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUsage", wireType)
+			}
+			m.MaxUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUsage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failcnt", wireType)
+			}
+			m.Failcnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failcnt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return &resp, nil
-}
 
-func (c *agentServiceClient) AddSwap(ctx context.Context, req *AddSwapRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "AddSwap", req, &resp); err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return &resp, nil
+	return nil
 }
-
-func (c *agentServiceClient) GetVolumeStats(ctx context.Context, req *VolumeStatsRequest) (*VolumeStatsResponse, error) {
-	var resp VolumeStatsResponse
-	if err := c.client.Call(ctx, "grpc.AgentService", "GetVolumeStats", req, &resp); err != nil {
-		return nil, err
+func (m *MemoryStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoryStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoryStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			m.Cache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cache |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &MemoryData{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwapUsage == nil {
+				m.SwapUsage = &MemoryData{}
+			}
+			if err := m.SwapUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KernelUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KernelUsage == nil {
+				m.KernelUsage = &MemoryData{}
+			}
+			if err := m.KernelUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseHierarchy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseHierarchy = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAgent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Stats[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return &resp, nil
-}
 
-func (c *agentServiceClient) ResizeVolume(ctx context.Context, req *ResizeVolumeRequest) (*types.Empty, error) {
-	var resp types.Empty
-	if err := c.client.Call(ctx, "grpc.AgentService", "ResizeVolume", req, &resp); err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return &resp, nil
+	return nil
 }
-func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *BlkioStatsEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8485,15 +13772,53 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlkioStatsEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlkioStatsEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Major", wireType)
+			}
+			m.Major = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Major |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minor", wireType)
+			}
+			m.Minor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8521,13 +13846,13 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.Op = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8537,27 +13862,65 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Value |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlkioStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlkioStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlkioStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StringUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IoServiceBytesRecursive", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8584,16 +13947,14 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StringUser == nil {
-				m.StringUser = &StringUser{}
-			}
-			if err := m.StringUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IoServiceBytesRecursive = append(m.IoServiceBytesRecursive, &BlkioStatsEntry{})
+			if err := m.IoServiceBytesRecursive[len(m.IoServiceBytesRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IoServicedRecursive", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8620,14 +13981,14 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Devices = append(m.Devices, &Device{})
-			if err := m.Devices[len(m.Devices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IoServicedRecursive = append(m.IoServicedRecursive, &BlkioStatsEntry{})
+			if err := m.IoServicedRecursive[len(m.IoServicedRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IoQueuedRecursive", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8654,14 +14015,14 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Storages = append(m.Storages, &Storage{})
-			if err := m.Storages[len(m.Storages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IoQueuedRecursive = append(m.IoQueuedRecursive, &BlkioStatsEntry{})
+			if err := m.IoQueuedRecursive[len(m.IoQueuedRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OCI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IoServiceTimeRecursive", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8688,18 +14049,16 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OCI == nil {
-				m.OCI = &Spec{}
-			}
-			if err := m.OCI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IoServiceTimeRecursive = append(m.IoServiceTimeRecursive, &BlkioStatsEntry{})
+			if err := m.IoServiceTimeRecursive[len(m.IoServiceTimeRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SandboxPidns", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoWaitTimeRecursive", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8709,68 +14068,31 @@ func (m *CreateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SandboxPidns = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.IoWaitTimeRecursive = append(m.IoWaitTimeRecursive, &BlkioStatsEntry{})
+			if err := m.IoWaitTimeRecursive[len(m.IoWaitTimeRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartContainerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IoMergedRecursive", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8780,80 +14102,31 @@ func (m *StartContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
+			m.IoMergedRecursive = append(m.IoMergedRecursive, &BlkioStatsEntry{})
+			if err := m.IoMergedRecursive[len(m.IoMergedRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveContainerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IoTimeRecursive", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8863,29 +14136,31 @@ func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.IoTimeRecursive = append(m.IoTimeRecursive, &BlkioStatsEntry{})
+			if err := m.IoTimeRecursive[len(m.IoTimeRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectorsRecursive", wireType)
 			}
-			m.Timeout = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8895,11 +14170,26 @@ func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SectorsRecursive = append(m.SectorsRecursive, &BlkioStatsEntry{})
+			if err := m.SectorsRecursive[len(m.SectorsRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -8922,7 +14212,7 @@ func (m *RemoveContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
+func (m *HugetlbStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8945,17 +14235,17 @@ func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecProcessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HugetlbStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HugetlbStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
-			var stringLen uint64
+			m.Usage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8965,29 +14255,16 @@ func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Usage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUsage", wireType)
 			}
-			var stringLen uint64
+			m.MaxUsage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8997,65 +14274,16 @@ func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxUsage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StringUser", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StringUser == nil {
-				m.StringUser = &StringUser{}
-			}
-			if err := m.StringUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failcnt", wireType)
 			}
-			var msglen int
+			m.Failcnt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9065,28 +14293,11 @@ func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Failcnt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Process == nil {
-				m.Process = &Process{}
-			}
-			if err := m.Process.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -9109,7 +14320,7 @@ func (m *ExecProcessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
+func (m *CgroupStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9132,17 +14343,17 @@ func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalProcessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CgroupStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CgroupStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuStats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9152,29 +14363,33 @@ func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			if m.CpuStats == nil {
+				m.CpuStats = &CpuStats{}
+			}
+			if err := m.CpuStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryStats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9184,29 +14399,33 @@ func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
+			if m.MemoryStats == nil {
+				m.MemoryStats = &MemoryStats{}
+			}
+			if err := m.MemoryStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidsStats", wireType)
 			}
-			m.Signal = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9216,67 +14435,33 @@ func (m *SignalProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Signal |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WaitProcessRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.PidsStats == nil {
+				m.PidsStats = &PidsStats{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.PidsStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WaitProcessRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WaitProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlkioStats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9286,29 +14471,33 @@ func (m *WaitProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			if m.BlkioStats == nil {
+				m.BlkioStats = &BlkioStats{}
+			}
+			if err := m.BlkioStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HugetlbStats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9318,23 +14507,120 @@ func (m *WaitProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
+			if m.HugetlbStats == nil {
+				m.HugetlbStats = make(map[string]*HugetlbStats)
+			}
+			var mapkey string
+			var mapvalue *HugetlbStats
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthAgent
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &HugetlbStats{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAgent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAgent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.HugetlbStats[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9358,7 +14644,7 @@ func (m *WaitProcessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WaitProcessResponse) Unmarshal(dAtA []byte) error {
+func (m *NetworkStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9381,17 +14667,17 @@ func (m *WaitProcessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WaitProcessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WaitProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9401,67 +14687,48 @@ func (m *WaitProcessResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxBytes", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateContainerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxPackets", wireType)
 			}
-			var stringLen uint64
+			m.RxPackets = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9471,29 +14738,54 @@ func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RxPackets |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxErrors", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
+			m.RxErrors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RxErrors |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxDropped", wireType)
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			m.RxDropped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RxDropped |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			m.TxBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9503,28 +14795,68 @@ func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TxBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPackets", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
+			m.TxPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxErrors", wireType)
 			}
-			if m.Resources == nil {
-				m.Resources = &LinuxResources{}
+			m.TxErrors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxErrors |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxDropped", wireType)
+			}
+			m.TxDropped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxDropped |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -9547,7 +14879,7 @@ func (m *UpdateContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatsContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *StatsContainerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9570,17 +14902,53 @@ func (m *StatsContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatsContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatsContainerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatsContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatsContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CgroupStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CgroupStats == nil {
+				m.CgroupStats = &CgroupStats{}
+			}
+			if err := m.CgroupStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkStats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9590,23 +14958,25 @@ func (m *StatsContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.NetworkStats = append(m.NetworkStats, &NetworkStats{})
+			if err := m.NetworkStats[len(m.NetworkStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9630,7 +15000,7 @@ func (m *StatsContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
+func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9653,10 +15023,10 @@ func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PauseContainerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WriteStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WriteStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9691,62 +15061,43 @@ func (m *PauseContainerRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAgent
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResumeContainerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResumeContainerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9756,23 +15107,25 @@ func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9796,7 +15149,7 @@ func (m *ResumeContainerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CpuUsage) Unmarshal(dAtA []byte) error {
+func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9819,131 +15172,17 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CpuUsage: wiretype end group for non-group")
+			return fmt.Errorf("proto: WriteStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CpuUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WriteStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUsage", wireType)
-			}
-			m.TotalUsage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalUsage |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAgent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.PercpuUsage = append(m.PercpuUsage, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAgent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAgent
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAgent
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.PercpuUsage) == 0 {
-					m.PercpuUsage = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAgent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PercpuUsage = append(m.PercpuUsage, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PercpuUsage", wireType)
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsageInKernelmode", wireType)
-			}
-			m.UsageInKernelmode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UsageInKernelmode |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsageInUsermode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Len", wireType)
 			}
-			m.UsageInUsermode = 0
+			m.Len = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -9953,7 +15192,7 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UsageInUsermode |= uint64(b&0x7F) << shift
+				m.Len |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9980,7 +15219,7 @@ func (m *CpuUsage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ThrottlingData) Unmarshal(dAtA []byte) error {
+func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10003,17 +15242,17 @@ func (m *ThrottlingData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ThrottlingData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ThrottlingData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			m.Periods = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10023,16 +15262,29 @@ func (m *ThrottlingData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Periods |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledPeriods", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
 			}
-			m.ThrottledPeriods = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10042,16 +15294,29 @@ func (m *ThrottlingData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ThrottledPeriods |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Len", wireType)
 			}
-			m.ThrottledTime = 0
+			m.Len = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10061,7 +15326,7 @@ func (m *ThrottlingData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ThrottledTime |= uint64(b&0x7F) << shift
+				m.Len |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10088,7 +15353,7 @@ func (m *ThrottlingData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CpuStats) Unmarshal(dAtA []byte) error {
+func (m *ReadStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10111,53 +15376,17 @@ func (m *CpuStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CpuStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CpuStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuUsage", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CpuUsage == nil {
-				m.CpuUsage = &CpuUsage{}
-			}
-			if err := m.CpuUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThrottlingData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10167,26 +15396,24 @@ func (m *CpuStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ThrottlingData == nil {
-				m.ThrottlingData = &ThrottlingData{}
-			}
-			if err := m.ThrottlingData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -10211,7 +15438,7 @@ func (m *CpuStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PidsStats) Unmarshal(dAtA []byte) error {
+func (m *CloseStdinRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10234,17 +15461,17 @@ func (m *PidsStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PidsStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloseStdinRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PidsStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloseStdinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			m.Current = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10254,16 +15481,29 @@ func (m *PidsStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Current |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10273,11 +15513,24 @@ func (m *PidsStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -10300,7 +15553,7 @@ func (m *PidsStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemoryData) Unmarshal(dAtA []byte) error {
+func (m *OpenProcessIORequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10323,17 +15576,17 @@ func (m *MemoryData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemoryData: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpenProcessIORequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoryData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpenProcessIORequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			m.Usage = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10343,16 +15596,29 @@ func (m *MemoryData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Usage |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUsage", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
 			}
-			m.MaxUsage = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10362,35 +15628,29 @@ func (m *MemoryData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxUsage |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failcnt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.Failcnt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Failcnt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidirectional", wireType)
 			}
-			m.Limit = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10400,11 +15660,12 @@ func (m *MemoryData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Bidirectional = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -10427,7 +15688,7 @@ func (m *MemoryData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemoryStats) Unmarshal(dAtA []byte) error {
+func (m *ProcessIOPorts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10450,17 +15711,17 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemoryStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessIOPorts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoryStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessIOPorts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StdinPort", wireType)
 			}
-			m.Cache = 0
+			m.StdinPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10470,16 +15731,16 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cache |= uint64(b&0x7F) << shift
+				m.StdinPort |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdoutPort", wireType)
 			}
-			var msglen int
+			m.StdoutPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10489,33 +15750,86 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StdoutPort |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StderrPort", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.StderrPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StderrPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TtyWinResizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Usage == nil {
-				m.Usage = &MemoryData{}
-			}
-			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TtyWinResizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TtyWinResizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapUsage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10525,33 +15839,29 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SwapUsage == nil {
-				m.SwapUsage = &MemoryData{}
-			}
-			if err := m.SwapUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KernelUsage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10561,33 +15871,29 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KernelUsage == nil {
-				m.KernelUsage = &MemoryData{}
-			}
-			if err := m.KernelUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExecId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseHierarchy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
 			}
-			var v int
+			m.Row = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10597,17 +15903,16 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Row |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseHierarchy = bool(v != 0)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
-			var msglen int
+			m.Column = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10617,105 +15922,11 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Column |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stats == nil {
-				m.Stats = make(map[string]uint64)
-			}
-			var mapkey string
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAgent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAgent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAgent
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthAgent
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAgent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAgent(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthAgent
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Stats[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -10738,7 +15949,7 @@ func (m *MemoryStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlkioStatsEntry) Unmarshal(dAtA []byte) error {
+func (m *KernelModule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10761,53 +15972,15 @@ func (m *BlkioStatsEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlkioStatsEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: KernelModule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlkioStatsEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KernelModule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Major", wireType)
-			}
-			m.Major = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Major |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minor", wireType)
-			}
-			m.Minor = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Minor |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10835,13 +16008,13 @@ func (m *BlkioStatsEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Op = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			m.Value = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10851,11 +16024,24 @@ func (m *BlkioStatsEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -10878,7 +16064,7 @@ func (m *BlkioStatsEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlkioStats) Unmarshal(dAtA []byte) error {
+func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10901,17 +16087,17 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlkioStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateSandboxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlkioStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoServiceBytesRecursive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10921,31 +16107,29 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IoServiceBytesRecursive = append(m.IoServiceBytesRecursive, &BlkioStatsEntry{})
-			if err := m.IoServiceBytesRecursive[len(m.IoServiceBytesRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoServicedRecursive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dns", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -10955,29 +16139,27 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IoServicedRecursive = append(m.IoServicedRecursive, &BlkioStatsEntry{})
-			if err := m.IoServicedRecursive[len(m.IoServicedRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Dns = append(m.Dns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoQueuedRecursive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11004,16 +16186,16 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IoQueuedRecursive = append(m.IoQueuedRecursive, &BlkioStatsEntry{})
-			if err := m.IoQueuedRecursive[len(m.IoQueuedRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Storages = append(m.Storages, &Storage{})
+			if err := m.Storages[len(m.Storages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoServiceTimeRecursive", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SandboxPidns", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11023,31 +16205,17 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IoServiceTimeRecursive = append(m.IoServiceTimeRecursive, &BlkioStatsEntry{})
-			if err := m.IoServiceTimeRecursive[len(m.IoServiceTimeRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.SandboxPidns = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoWaitTimeRecursive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SandboxId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11057,31 +16225,29 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IoWaitTimeRecursive = append(m.IoWaitTimeRecursive, &BlkioStatsEntry{})
-			if err := m.IoWaitTimeRecursive[len(m.IoWaitTimeRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SandboxId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoMergedRecursive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GuestHookPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11091,63 +16257,27 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IoMergedRecursive = append(m.IoMergedRecursive, &BlkioStatsEntry{})
-			if err := m.IoMergedRecursive[len(m.IoMergedRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GuestHookPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoTimeRecursive", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IoTimeRecursive = append(m.IoTimeRecursive, &BlkioStatsEntry{})
-			if err := m.IoTimeRecursive[len(m.IoTimeRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectorsRecursive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KernelModules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11174,8 +16304,8 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SectorsRecursive = append(m.SectorsRecursive, &BlkioStatsEntry{})
-			if err := m.SectorsRecursive[len(m.SectorsRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KernelModules = append(m.KernelModules, &KernelModule{})
+			if err := m.KernelModules[len(m.KernelModules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11201,7 +16331,7 @@ func (m *BlkioStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HugetlbStats) Unmarshal(dAtA []byte) error {
+func (m *DestroySandboxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11224,69 +16354,12 @@ func (m *HugetlbStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HugetlbStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: DestroySandboxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HugetlbStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DestroySandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
-			}
-			m.Usage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Usage |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUsage", wireType)
-			}
-			m.MaxUsage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxUsage |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failcnt", wireType)
-			}
-			m.Failcnt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Failcnt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -11309,7 +16382,7 @@ func (m *HugetlbStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CgroupStats) Unmarshal(dAtA []byte) error {
+func (m *Interfaces) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11330,53 +16403,17 @@ func (m *CgroupStats) Unmarshal(dAtA []byte) error {
 			}
 		}
 		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CgroupStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CgroupStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuStats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CpuStats == nil {
-				m.CpuStats = &CpuStats{}
-			}
-			if err := m.CpuStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Interfaces: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Interfaces: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoryStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interfaces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11403,52 +16440,65 @@ func (m *CgroupStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemoryStats == nil {
-				m.MemoryStats = &MemoryStats{}
-			}
-			if err := m.MemoryStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Interfaces = append(m.Interfaces, &protocols.Interface{})
+			if err := m.Interfaces[len(m.Interfaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PidsStats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PidsStats == nil {
-				m.PidsStats = &PidsStats{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Routes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-			if err := m.PidsStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Routes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Routes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlkioStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11475,141 +16525,97 @@ func (m *CgroupStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlkioStats == nil {
-				m.BlkioStats = &BlkioStats{}
-			}
-			if err := m.BlkioStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, &protocols.Route{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HugetlbStats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateInterfaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HugetlbStats == nil {
-				m.HugetlbStats = make(map[string]*HugetlbStats)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var mapkey string
-			var mapvalue *HugetlbStats
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAgent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAgent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAgent
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthAgent
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAgent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthAgent
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthAgent
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &HugetlbStats{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAgent(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthAgent
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateInterfaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateInterfaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interface", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.HugetlbStats[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Interface == nil {
+				m.Interface = &protocols.Interface{}
+			}
+			if err := m.Interface.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11633,7 +16639,7 @@ func (m *CgroupStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkStats) Unmarshal(dAtA []byte) error {
+func (m *UpdateRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11656,17 +16662,17 @@ func (m *NetworkStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -11676,176 +16682,130 @@ func (m *NetworkStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxBytes", wireType)
-			}
-			m.RxBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RxBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxPackets", wireType)
+			if m.Routes == nil {
+				m.Routes = &Routes{}
 			}
-			m.RxPackets = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RxPackets |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Routes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxErrors", wireType)
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.RxErrors = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RxErrors |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxDropped", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.RxDropped = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RxDropped |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListInterfacesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxPackets", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListInterfacesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListInterfacesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TxPackets = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxPackets |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxErrors", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TxErrors = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxErrors |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxDropped", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TxDropped = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxDropped |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -11868,7 +16828,7 @@ func (m *NetworkStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatsContainerResponse) Unmarshal(dAtA []byte) error {
+func (m *ARPNeighbors) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11891,15 +16851,15 @@ func (m *StatsContainerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatsContainerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ARPNeighbors: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatsContainerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ARPNeighbors: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CgroupStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ARPNeighbors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11926,16 +16886,65 @@ func (m *StatsContainerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CgroupStats == nil {
-				m.CgroupStats = &CgroupStats{}
-			}
-			if err := m.CgroupStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ARPNeighbors = append(m.ARPNeighbors, &protocols.ARPNeighbor{})
+			if err := m.ARPNeighbors[len(m.ARPNeighbors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddARPNeighborsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddARPNeighborsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddARPNeighborsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Neighbors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11962,8 +16971,10 @@ func (m *StatsContainerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkStats = append(m.NetworkStats, &NetworkStats{})
-			if err := m.NetworkStats[len(m.NetworkStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Neighbors == nil {
+				m.Neighbors = &ARPNeighbors{}
+			}
+			if err := m.Neighbors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11989,7 +17000,7 @@ func (m *StatsContainerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *GetIPTablesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12012,17 +17023,17 @@ func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WriteStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetIPTablesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WriteStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetIPTablesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsIpv6", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12032,29 +17043,68 @@ func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
+			m.IsIpv6 = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetIPTablesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetIPTablesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetIPTablesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12064,25 +17114,98 @@ func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetIPTablesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetIPTablesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetIPTablesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsIpv6", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsIpv6 = bool(v != 0)
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -12138,7 +17261,7 @@ func (m *WriteStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *SetIPTablesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12161,17 +17284,17 @@ func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WriteStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetIPTablesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WriteStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetIPTablesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Len", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.Len = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12181,11 +17304,26 @@ func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Len |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -12208,7 +17346,7 @@ func (m *WriteStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *OnlineCPUMemRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12231,17 +17369,17 @@ func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnlineCPUMemRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnlineCPUMemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12251,29 +17389,17 @@ func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Wait = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NbCpus", wireType)
 			}
-			var stringLen uint64
+			m.NbCpus = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12283,29 +17409,36 @@ func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NbCpus |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuOnly", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.CpuOnly = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Len", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NbMemBlocks", wireType)
 			}
-			m.Len = 0
+			m.NbMemBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12315,7 +17448,7 @@ func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Len |= uint32(b&0x7F) << shift
+				m.NbMemBlocks |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12342,7 +17475,7 @@ func (m *ReadStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *ReseedRandomDevRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12365,13 +17498,13 @@ func (m *ReadStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReseedRandomDevRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReseedRandomDevRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -12427,7 +17560,7 @@ func (m *ReadStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseStdinRequest) Unmarshal(dAtA []byte) error {
+func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12450,15 +17583,15 @@ func (m *CloseStdinRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseStdinRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AgentDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseStdinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AgentDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12486,13 +17619,13 @@ func (m *CloseStdinRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitDaemon", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12502,78 +17635,15 @@ func (m *CloseStdinRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TtyWinResizeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TtyWinResizeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TtyWinResizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.InitDaemon = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceHandlers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12601,11 +17671,11 @@ func (m *TtyWinResizeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.DeviceHandlers = append(m.DeviceHandlers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageHandlers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12633,32 +17703,13 @@ func (m *TtyWinResizeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecId = string(dAtA[iNdEx:postIndex])
+			m.StorageHandlers = append(m.StorageHandlers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
-			}
-			m.Row = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Row |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportsSeccomp", wireType)
 			}
-			m.Column = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12668,11 +17719,12 @@ func (m *TtyWinResizeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Column |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SupportsSeccomp = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -12695,7 +17747,7 @@ func (m *TtyWinResizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KernelModule) Unmarshal(dAtA []byte) error {
+func (m *GuestDetailsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12718,17 +17770,17 @@ func (m *KernelModule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KernelModule: wiretype end group for non-group")
+			return fmt.Errorf("proto: GuestDetailsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KernelModule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GuestDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemBlockSize", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12738,29 +17790,17 @@ func (m *KernelModule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.MemBlockSize = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemHotplugProbe", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12770,24 +17810,12 @@ func (m *KernelModule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.MemHotplugProbe = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -12810,7 +17838,7 @@ func (m *KernelModule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
+func (m *GuestDetailsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12833,17 +17861,17 @@ func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateSandboxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GuestDetailsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateSandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GuestDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemBlockSizeBytes", wireType)
 			}
-			var stringLen uint64
+			m.MemBlockSizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -12853,59 +17881,14 @@ func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MemBlockSizeBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hostname = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dns", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Dns = append(m.Dns, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentDetails", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12932,14 +17915,16 @@ func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Storages = append(m.Storages, &Storage{})
-			if err := m.Storages[len(m.Storages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AgentDetails == nil {
+				m.AgentDetails = &AgentDetails{}
+			}
+			if err := m.AgentDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SandboxPidns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportMemHotplugProbe", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12956,105 +17941,134 @@ func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.SandboxPidns = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SandboxId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.SupportMemHotplugProbe = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemHotplugByProbeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SandboxId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GuestHookPath", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemHotplugByProbeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemHotplugByProbeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.MemHotplugProbeAddr = append(m.MemHotplugProbeAddr, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthAgent
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GuestHookPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KernelModules", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAgent
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MemHotplugProbeAddr) == 0 {
+					m.MemHotplugProbeAddr = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAgent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MemHotplugProbeAddr = append(m.MemHotplugProbeAddr, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemHotplugProbeAddr", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KernelModules = append(m.KernelModules, &KernelModule{})
-			if err := m.KernelModules[len(m.KernelModules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -13077,7 +18091,7 @@ func (m *CreateSandboxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DestroySandboxRequest) Unmarshal(dAtA []byte) error {
+func (m *SetGuestDateTimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13100,12 +18114,50 @@ func (m *DestroySandboxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DestroySandboxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetGuestDateTimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DestroySandboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetGuestDateTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sec", wireType)
+			}
+			m.Sec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usec", wireType)
+			}
+			m.Usec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Usec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -13128,7 +18180,7 @@ func (m *DestroySandboxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Interfaces) Unmarshal(dAtA []byte) error {
+func (m *FSGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13151,17 +18203,17 @@ func (m *Interfaces) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Interfaces: wiretype end group for non-group")
+			return fmt.Errorf("proto: FSGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Interfaces: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FSGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interfaces", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -13171,26 +18223,30 @@ func (m *Interfaces) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupChangePolicy", wireType)
 			}
-			m.Interfaces = append(m.Interfaces, &protocols.Interface{})
-			if err := m.Interfaces[len(m.Interfaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GroupChangePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupChangePolicy |= protocols.FSGroupChangePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -13213,7 +18269,7 @@ func (m *Interfaces) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Routes) Unmarshal(dAtA []byte) error {
+func (m *Storage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13236,17 +18292,145 @@ func (m *Routes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Routes: wiretype end group for non-group")
+			return fmt.Errorf("proto: Storage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Routes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Storage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Driver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DriverOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DriverOptions = append(m.DriverOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fstype", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fstype = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -13256,80 +18440,59 @@ func (m *Routes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, &protocols.Route{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPoint", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAgent
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateInterfaceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateInterfaceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateInterfaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MountPoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interface", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FsGroup", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13356,67 +18519,16 @@ func (m *UpdateInterfaceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Interface == nil {
-				m.Interface = &protocols.Interface{}
+			if m.FsGroup == nil {
+				m.FsGroup = &FSGroup{}
 			}
-			if err := m.Interface.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FsGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateRoutesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRoutesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13443,115 +18555,13 @@ func (m *UpdateRoutesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Routes == nil {
-				m.Routes = &Routes{}
-			}
-			if err := m.Routes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListInterfacesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Encryption == nil {
+				m.Encryption = &StorageEncryption{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListInterfacesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListInterfacesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Encryption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListRoutesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListRoutesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -13574,7 +18584,7 @@ func (m *ListRoutesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ARPNeighbors) Unmarshal(dAtA []byte) error {
+func (m *StorageEncryption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13597,17 +18607,17 @@ func (m *ARPNeighbors) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ARPNeighbors: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageEncryption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ARPNeighbors: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageEncryption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ARPNeighbors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -13617,24 +18627,56 @@ func (m *ARPNeighbors) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ARPNeighbors = append(m.ARPNeighbors, &protocols.ARPNeighbor{})
-			if err := m.ARPNeighbors[len(m.ARPNeighbors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -13659,7 +18701,7 @@ func (m *ARPNeighbors) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddARPNeighborsRequest) Unmarshal(dAtA []byte) error {
+func (m *Device) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13682,17 +18724,17 @@ func (m *AddARPNeighborsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddARPNeighborsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Device: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddARPNeighborsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Neighbors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -13702,27 +18744,151 @@ func (m *AddARPNeighborsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Neighbors == nil {
-				m.Neighbors = &ARPNeighbors{}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if err := m.Neighbors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13746,7 +18912,7 @@ func (m *AddARPNeighborsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OnlineCPUMemRequest) Unmarshal(dAtA []byte) error {
+func (m *StringUser) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13769,17 +18935,17 @@ func (m *OnlineCPUMemRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OnlineCPUMemRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StringUser: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OnlineCPUMemRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StringUser: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -13789,36 +18955,29 @@ func (m *OnlineCPUMemRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Wait = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NbCpus", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.NbCpus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NbCpus |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuOnly", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -13828,68 +18987,29 @@ func (m *OnlineCPUMemRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CpuOnly = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReseedRandomDevRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReseedRandomDevRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReseedRandomDevRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			m.Gid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalGids", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -13899,25 +19019,23 @@ func (m *ReseedRandomDevRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.AdditionalGids = append(m.AdditionalGids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13941,7 +19059,7 @@ func (m *ReseedRandomDevRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AgentDetails) Unmarshal(dAtA []byte) error {
+func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13964,15 +19082,15 @@ func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AgentDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: CopyFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AgentDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CopyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14000,13 +19118,13 @@ func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitDaemon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
 			}
-			var v int
+			m.FileSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -14016,17 +19134,16 @@ func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.FileSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InitDaemon = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviceHandlers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileMode", wireType)
 			}
-			var stringLen uint64
+			m.FileMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -14036,29 +19153,16 @@ func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FileMode |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeviceHandlers = append(m.DeviceHandlers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageHandlers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirMode", wireType)
 			}
-			var stringLen uint64
+			m.DirMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -14068,29 +19172,54 @@ func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DirMode |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
+			m.Uid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uid |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
 			}
-			m.StorageHandlers = append(m.StorageHandlers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+			m.Gid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gid |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportsSeccomp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var v int
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -14100,12 +19229,45 @@ func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Offset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SupportsSeccomp = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -14128,7 +19290,7 @@ func (m *AgentDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GuestDetailsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetOOMEventRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14136,67 +19298,27 @@ func (m *GuestDetailsRequest) Unmarshal(dAtA []byte) error {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GuestDetailsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GuestDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemBlockSize", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.MemBlockSize = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemHotplugProbe", wireType)
+				return ErrIntOverflowAgent
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MemHotplugProbe = bool(v != 0)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetOOMEventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetOOMEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -14219,7 +19341,7 @@ func (m *GuestDetailsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GuestDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *OOMEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14242,36 +19364,17 @@ func (m *GuestDetailsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GuestDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OOMEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GuestDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OOMEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemBlockSizeBytes", wireType)
-			}
-			m.MemBlockSizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MemBlockSizeBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -14281,48 +19384,24 @@ func (m *GuestDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AgentDetails == nil {
-				m.AgentDetails = &AgentDetails{}
-			}
-			if err := m.AgentDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportMemHotplugProbe", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SupportMemHotplugProbe = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -14345,7 +19424,7 @@ func (m *GuestDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemHotplugByProbeRequest) Unmarshal(dAtA []byte) error {
+func (m *AddSwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14368,15 +19447,15 @@ func (m *MemHotplugByProbeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemHotplugByProbeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddSwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemHotplugByProbeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType == 0 {
-				var v uint64
+				var v uint32
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowAgent
@@ -14386,12 +19465,12 @@ func (m *MemHotplugByProbeRequest) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= uint64(b&0x7F) << shift
+					v |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.MemHotplugProbeAddr = append(m.MemHotplugProbeAddr, v)
+				m.PCIPath = append(m.PCIPath, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -14426,11 +19505,11 @@ func (m *MemHotplugByProbeRequest) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.MemHotplugProbeAddr) == 0 {
-					m.MemHotplugProbeAddr = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.PCIPath) == 0 {
+					m.PCIPath = make([]uint32, 0, elementCount)
 				}
 				for iNdEx < postIndex {
-					var v uint64
+					var v uint32
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowAgent
@@ -14440,15 +19519,15 @@ func (m *MemHotplugByProbeRequest) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= uint64(b&0x7F) << shift
+						v |= uint32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.MemHotplugProbeAddr = append(m.MemHotplugProbeAddr, v)
+					m.PCIPath = append(m.PCIPath, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemHotplugProbeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PCIPath", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -14472,7 +19551,141 @@ func (m *MemHotplugByProbeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetGuestDateTimeRequest) Unmarshal(dAtA []byte) error {
+func (m *GetMetricsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMetricsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metrics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metrics = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolumeStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14495,17 +19708,17 @@ func (m *SetGuestDateTimeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetGuestDateTimeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VolumeStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetGuestDateTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VolumeStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sec", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeGuestPath", wireType)
 			}
-			m.Sec = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -14515,30 +19728,24 @@ func (m *SetGuestDateTimeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sec |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usec", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.Usec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Usec |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeGuestPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -14561,7 +19768,7 @@ func (m *SetGuestDateTimeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FSGroup) Unmarshal(dAtA []byte) error {
+func (m *ResizeVolumeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14584,17 +19791,17 @@ func (m *FSGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FSGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResizeVolumeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FSGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResizeVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeGuestPath", wireType)
 			}
-			m.GroupId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -14604,16 +19811,29 @@ func (m *FSGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeGuestPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupChangePolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			m.GroupChangePolicy = 0
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -14623,7 +19843,7 @@ func (m *FSGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupChangePolicy |= protocols.FSGroupChangePolicy(b&0x7F) << shift
+				m.Size_ |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -14650,7 +19870,7 @@ func (m *FSGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Storage) Unmarshal(dAtA []byte) error {
+func (m *WatchableEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14673,15 +19893,15 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Storage: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchableEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Storage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchableEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14709,13 +19929,13 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Driver = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DriverOptions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var stringLen uint64
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -14725,27 +19945,52 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Mode |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
+			m.Uid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uid |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
 			}
-			m.DriverOptions = append(m.DriverOptions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+			m.Gid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gid |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkTarget", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14773,13 +20018,51 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.LinkTarget = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fstype", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -14789,27 +20072,80 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fstype = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWatchableMountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWatchableMountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWatchableMountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14837,13 +20173,13 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
+			m.MountPoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MountPoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -14853,29 +20189,31 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MountPoint = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, &WatchableEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FsGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -14885,27 +20223,23 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FsGroup == nil {
-				m.FsGroup = &FSGroup{}
-			}
-			if err := m.FsGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14929,7 +20263,7 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Device) Unmarshal(dAtA []byte) error {
+func (m *SetPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14952,15 +20286,15 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Device: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Device: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14988,43 +20322,113 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Policy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAgent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Policy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Policy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Policy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15052,11 +20456,62 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmPath = string(dAtA[iNdEx:postIndex])
+			m.Policy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteHooksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteHooksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteHooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15084,13 +20539,13 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerPath = string(dAtA[iNdEx:postIndex])
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -15100,23 +20555,25 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
+			m.Hooks = append(m.Hooks, &Hook{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15140,7 +20597,7 @@ func (m *Device) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StringUser) Unmarshal(dAtA []byte) error {
+func (m *GetDiagnosticsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15163,15 +20620,15 @@ func (m *StringUser) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StringUser: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDiagnosticsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StringUser: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDiagnosticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15199,13 +20656,13 @@ func (m *StringUser) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uid = string(dAtA[iNdEx:postIndex])
+			m.ContainerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
 			}
-			var stringLen uint64
+			m.MaxSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -15215,27 +20672,14 @@ func (m *StringUser) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Gid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalGids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreDump", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15263,8 +20707,27 @@ func (m *StringUser) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdditionalGids = append(m.AdditionalGids, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.CoreDump = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -15287,7 +20750,7 @@ func (m *StringUser) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
+func (m *DiagnosticsFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15310,15 +20773,15 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CopyFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiagnosticsFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CopyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiagnosticsFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15346,32 +20809,13 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
-			}
-			m.FileSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FileSize |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileMode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.FileMode = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -15381,54 +20825,31 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileMode |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DirMode", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.DirMode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DirMode |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Uid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Uid |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-		case 6:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
 			}
-			m.Gid = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -15438,35 +20859,17 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gid |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			m.Truncated = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
-			}
-			m.Offset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Offset |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			var byteLen int
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -15476,77 +20879,11 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Size_ |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetOOMEventRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetOOMEventRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetOOMEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -15569,7 +20906,7 @@ func (m *GetOOMEventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OOMEvent) Unmarshal(dAtA []byte) error {
+func (m *Diagnostics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15592,17 +20929,17 @@ func (m *OOMEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OOMEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: Diagnostics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OOMEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Diagnostics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -15612,23 +20949,25 @@ func (m *OOMEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(dAtA[iNdEx:postIndex])
+			m.Files = append(m.Files, &DiagnosticsFile{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15652,7 +20991,7 @@ func (m *OOMEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddSwapRequest) Unmarshal(dAtA []byte) error {
+func (m *SetLogLevelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15675,88 +21014,44 @@ func (m *AddSwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddSwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetLogLevelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetLogLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAgent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.PCIPath = append(m.PCIPath, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAgent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthAgent
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthAgent
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.PCIPath) == 0 {
-					m.PCIPath = make([]uint32, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAgent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PCIPath = append(m.PCIPath, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PCIPath", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -15779,7 +21074,7 @@ func (m *AddSwapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMetricsRequest) Unmarshal(dAtA []byte) error {
+func (m *ReadKernelLogRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15802,10 +21097,10 @@ func (m *GetMetricsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetricsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadKernelLogRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadKernelLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15830,7 +21125,7 @@ func (m *GetMetricsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metrics) Unmarshal(dAtA []byte) error {
+func (m *KernelLogRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15853,15 +21148,91 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
+			return fmt.Errorf("proto: KernelLogRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KernelLogRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Facility", wireType)
+			}
+			m.Facility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Facility |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampUsec", wireType)
+			}
+			m.TimestampUsec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampUsec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15889,7 +21260,7 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metrics = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15913,7 +21284,7 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VolumeStatsRequest) Unmarshal(dAtA []byte) error {
+func (m *KernelLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15936,17 +21307,17 @@ func (m *VolumeStatsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VolumeStatsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: KernelLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VolumeStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KernelLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeGuestPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -15956,23 +21327,25 @@ func (m *VolumeStatsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeGuestPath = string(dAtA[iNdEx:postIndex])
+			m.Records = append(m.Records, &KernelLogRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15996,7 +21369,7 @@ func (m *VolumeStatsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResizeVolumeRequest) Unmarshal(dAtA []byte) error {
+func (m *SetGuestTimeSourceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16019,15 +21392,15 @@ func (m *ResizeVolumeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResizeVolumeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetGuestTimeSourceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResizeVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetGuestTimeSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeGuestPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16055,13 +21428,13 @@ func (m *ResizeVolumeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeGuestPath = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NtpServers", wireType)
 			}
-			m.Size_ = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -16071,11 +21444,126 @@ func (m *ResizeVolumeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Size_ |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NtpServers = append(m.NtpServers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartTracingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartTracingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartTracingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopTracingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopTracingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopTracingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
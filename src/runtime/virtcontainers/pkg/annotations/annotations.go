@@ -105,6 +105,12 @@ const (
 	// DisableVhostNet is a sandbox annotation to specify if vhost-net is not available on the host.
 	DisableVhostNet = kataAnnotHypervisorPrefix + "disable_vhost_net"
 
+	// NetworkQueues is a sandbox annotation to specify the number of queue pairs of the network devices.
+	NetworkQueues = kataAnnotHypervisorPrefix + "network_queues"
+
+	// EnableNetRSS is a sandbox annotation to specify if receive side scaling is enabled on the network devices.
+	EnableNetRSS = kataAnnotHypervisorPrefix + "enable_net_rss"
+
 	// EnableVhostUserStore is a sandbox annotation to specify if vhost-user-blk/scsi is abailable on the host
 	EnableVhostUserStore = kataAnnotHypervisorPrefix + "enable_vhost_user_store"
 
@@ -159,6 +165,15 @@ const (
 	// VirtioMem is a sandbox annotation that is used to enable/disable virtio-mem.
 	VirtioMem = kataAnnotHypervisorPrefix + "enable_virtio_mem"
 
+	// EnableMemoryBalloon is a sandbox annotation that is used to add a memory balloon to the VM.
+	EnableMemoryBalloon = kataAnnotHypervisorPrefix + "enable_memory_balloon"
+
+	// MemoryShrinkHysteresisMB is a sandbox annotation that specifies the memory, in MiB, the VM must have in excess for it to be shrunk.
+	MemoryShrinkHysteresisMB = kataAnnotHypervisorPrefix + "memory_shrink_hysteresis_mb"
+
+	// EnableGuestNUMA is a sandbox annotation that is used to give the guest the NUMA topology of the host nodes the sandbox is bound to.
+	EnableGuestNUMA = kataAnnotHypervisorPrefix + "enable_guest_numa"
+
 	// MemPrealloc is a sandbox annotation that specifies the memory space used for nvdimm device by the hypervisor.
 	MemPrealloc = kataAnnotHypervisorPrefix + "enable_mem_prealloc"
 
@@ -181,9 +196,18 @@ const (
 	// Msize9p is a sandbox annotation to specify as the msize for 9p shares
 	Msize9p = kataAnnotHypervisorPrefix + "msize_9p"
 
+	// Cache9p is a sandbox annotation to specify the cache mode of the 9p shares
+	Cache9p = kataAnnotHypervisorPrefix + "cache_9p"
+
+	// Version9p is a sandbox annotation to specify the protocol version of the 9p shares
+	Version9p = kataAnnotHypervisorPrefix + "version_9p"
+
 	// SharedFs is a sandbox annotation to specify the shared file system type, either virtio-9p or virtio-fs.
 	SharedFS = kataAnnotHypervisorPrefix + "shared_fs"
 
+	// SharedFSReadOnly is a sandbox annotation to share the volumes read-only with the guest
+	SharedFSReadOnly = kataAnnotHypervisorPrefix + "shared_fs_readonly"
+
 	// VirtioFSDaemon is a sandbox annotations to specify virtio-fs vhost-user daemon path
 	VirtioFSDaemon = kataAnnotHypervisorPrefix + "virtio_fs_daemon"
 
@@ -244,6 +268,9 @@ const (
 	// SandboxCgroupOnly is a sandbox annotation that determines if kata processes are managed only in sandbox cgroup.
 	SandboxCgroupOnly = kataAnnotRuntimePrefix + "sandbox_cgroup_only"
 
+	// EnableVCPUsPinning is a sandbox annotation that determines if the vCPU threads are pinned to the exclusive CPUs of the sandbox.
+	EnableVCPUsPinning = kataAnnotRuntimePrefix + "enable_vcpus_pinning"
+
 	// EnablePprof is a sandbox annotation that determines if pprof enabled.
 	EnablePprof = kataAnnotRuntimePrefix + "enable_pprof"
 
@@ -286,6 +313,9 @@ const (
 	AgentContainerPipeSize       = kataAnnotAgentPrefix + ContainerPipeSizeOption
 	ContainerPipeSizeOption      = "container_pipe_size"
 	ContainerPipeSizeKernelParam = "agent." + ContainerPipeSizeOption
+
+	// AgentPolicy is a sandbox annotation to specify the base64 encoded policy restricting the agent API
+	AgentPolicy = kataAnnotAgentPrefix + "policy"
 )
 
 // Container resource related annotations
@@ -297,6 +327,36 @@ const (
 
 	// ContainerResourcesSwapInBytes is a container annotation to specify the Resources.Memory.Swap
 	ContainerResourcesSwapInBytes = kataAnnotContainerResourcePrefix + "swap_in_bytes"
+
+	// ContainerResourcesVolumeIOLimits is a container annotation to cap the I/Os of the block device
+	// volumes of the container. It is a JSON object mapping the destination of a volume in the container
+	// to its maximum number of operations and bytes per second, e.g.
+	//
+	//   io.katacontainers.container.resource.volume_io_limits: '{"/data": {"iops": 1000, "bps": 104857600}}'
+	//
+	ContainerResourcesVolumeIOLimits = kataAnnotContainerResourcePrefix + "volume_io_limits"
+
+	// ContainerResourcesImageVolumes is a container annotation to attach the raw image files bind mounted
+	// in the container as block devices, through loop devices the runtime manages, instead of sharing them
+	// with the guest. It is a JSON object mapping the destination of a volume in the container to the type
+	// of the filesystem of its image, e.g.
+	//
+	//   io.katacontainers.container.resource.image_volumes: '{"/data": "ext4"}'
+	//
+	ContainerResourcesImageVolumes = kataAnnotContainerResourcePrefix + "image_volumes"
+
+	// ContainerRootfsBlock is a container annotation to override the decision to attach the block devices
+	// of the EROFS image layers of the container rootfs rather than sharing them with the guest, which is
+	// otherwise taken from the block_rootfs_threshold_mb hypervisor option: "true" always attaches them,
+	// "false" never does.
+	ContainerRootfsBlock = kataAnnotContainerPrefix + "rootfs_block"
+
+	// ContainerGuestHooks is a container annotation to declare the hooks run in the guest before the
+	// container starts and after it stops, in the format of the OCI hooks, e.g.
+	//
+	//   io.katacontainers.container.guest_hooks: '{"prestart": [{"path": "/sbin/sysctl", "args": ["sysctl", "-w", "vm.max_map_count=262144"]}]}'
+	//
+	ContainerGuestHooks = kataAnnotContainerPrefix + "guest_hooks"
 )
 
 const (
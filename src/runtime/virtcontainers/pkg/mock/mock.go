@@ -176,6 +176,22 @@ func (p *HybridVSockTTRPCMockImp) AddARPNeighbors(ctx context.Context, req *pb.A
 	return emptyResp, nil
 }
 
+func (p *HybridVSockTTRPCMockImp) GetIPTables(ctx context.Context, req *pb.GetIPTablesRequest) (*pb.GetIPTablesResponse, error) {
+	return &pb.GetIPTablesResponse{}, nil
+}
+
+func (p *HybridVSockTTRPCMockImp) SetIPTables(ctx context.Context, req *pb.SetIPTablesRequest) (*pb.SetIPTablesResponse, error) {
+	return &pb.SetIPTablesResponse{}, nil
+}
+
+func (p *HybridVSockTTRPCMockImp) StartTracing(ctx context.Context, req *pb.StartTracingRequest) (*gpb.Empty, error) {
+	return emptyResp, nil
+}
+
+func (p *HybridVSockTTRPCMockImp) StopTracing(ctx context.Context, req *pb.StopTracingRequest) (*gpb.Empty, error) {
+	return emptyResp, nil
+}
+
 func (p *HybridVSockTTRPCMockImp) OnlineCPUMem(ctx context.Context, req *pb.OnlineCPUMemRequest) (*gpb.Empty, error) {
 	return emptyResp, nil
 }
@@ -239,3 +255,39 @@ func (p *HybridVSockTTRPCMockImp) GetVolumeStats(ctx context.Context, req *pb.Vo
 func (p *HybridVSockTTRPCMockImp) ResizeVolume(ctx context.Context, req *pb.ResizeVolumeRequest) (*gpb.Empty, error) {
 	return &gpb.Empty{}, nil
 }
+
+func (p *HybridVSockTTRPCMockImp) SyncWatchableMount(ctx context.Context, req *pb.SyncWatchableMountRequest) (*gpb.Empty, error) {
+	return &gpb.Empty{}, nil
+}
+
+func (p *HybridVSockTTRPCMockImp) SetPolicy(ctx context.Context, req *pb.SetPolicyRequest) (*gpb.Empty, error) {
+	return &gpb.Empty{}, nil
+}
+
+func (p *HybridVSockTTRPCMockImp) GetPolicy(ctx context.Context, req *pb.GetPolicyRequest) (*pb.Policy, error) {
+	return &pb.Policy{}, nil
+}
+
+func (p *HybridVSockTTRPCMockImp) ExecuteHooks(ctx context.Context, req *pb.ExecuteHooksRequest) (*gpb.Empty, error) {
+	return &gpb.Empty{}, nil
+}
+
+func (p *HybridVSockTTRPCMockImp) GetDiagnostics(ctx context.Context, req *pb.GetDiagnosticsRequest) (*pb.Diagnostics, error) {
+	return &pb.Diagnostics{}, nil
+}
+
+func (p *HybridVSockTTRPCMockImp) SetLogLevel(ctx context.Context, req *pb.SetLogLevelRequest) (*gpb.Empty, error) {
+	return &gpb.Empty{}, nil
+}
+
+func (p *HybridVSockTTRPCMockImp) ReadKernelLog(ctx context.Context, req *pb.ReadKernelLogRequest) (*pb.KernelLog, error) {
+	return &pb.KernelLog{}, nil
+}
+
+func (p *HybridVSockTTRPCMockImp) OpenProcessIO(ctx context.Context, req *pb.OpenProcessIORequest) (*pb.ProcessIOPorts, error) {
+	return &pb.ProcessIOPorts{}, nil
+}
+
+func (p *HybridVSockTTRPCMockImp) SetGuestTimeSource(ctx context.Context, req *pb.SetGuestTimeSourceRequest) (*gpb.Empty, error) {
+	return emptyResp, nil
+}
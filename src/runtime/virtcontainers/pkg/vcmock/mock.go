@@ -50,6 +50,15 @@ func (m *VCMock) CreateSandbox(ctx context.Context, sandboxConfig vc.SandboxConf
 	return nil, fmt.Errorf("%s: %s (%+v): sandboxConfig: %v", mockErrorPrefix, getSelf(), m, sandboxConfig)
 }
 
+// AdoptSandbox implements the VC function of the same name.
+func (m *VCMock) AdoptSandbox(ctx context.Context, sandboxID string) (vc.VCSandbox, error) {
+	if m.AdoptSandboxFunc != nil {
+		return m.AdoptSandboxFunc(ctx, sandboxID)
+	}
+
+	return nil, fmt.Errorf("%s: %s (%+v): sandboxID: %v", mockErrorPrefix, getSelf(), m, sandboxID)
+}
+
 func (m *VCMock) CleanupContainer(ctx context.Context, sandboxID, containerID string, force bool) error {
 	if m.CleanupContainerFunc != nil {
 		return m.CleanupContainerFunc(ctx, sandboxID, containerID, true)
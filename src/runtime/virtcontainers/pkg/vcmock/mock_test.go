@@ -171,6 +171,33 @@ func TestVCMockSetVMFactory(t *testing.T) {
 	assert.Equal(factoryTriggered, 1)
 }
 
+func TestVCMockAdoptSandbox(t *testing.T) {
+	assert := assert.New(t)
+
+	m := &VCMock{}
+	assert.Nil(m.AdoptSandboxFunc)
+
+	ctx := context.Background()
+	_, err := m.AdoptSandbox(ctx, testSandboxID)
+	assert.Error(err)
+	assert.True(IsMockError(err))
+
+	m.AdoptSandboxFunc = func(ctx context.Context, sandboxID string) (vc.VCSandbox, error) {
+		return &Sandbox{}, nil
+	}
+
+	sandbox, err := m.AdoptSandbox(ctx, testSandboxID)
+	assert.NoError(err)
+	assert.Equal(sandbox, &Sandbox{})
+
+	// reset
+	m.AdoptSandboxFunc = nil
+
+	_, err = m.AdoptSandbox(ctx, testSandboxID)
+	assert.Error(err)
+	assert.True(IsMockError(err))
+}
+
 func TestVCMockCleanupContainer(t *testing.T) {
 	assert := assert.New(t)
 
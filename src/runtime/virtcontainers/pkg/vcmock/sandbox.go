@@ -216,6 +216,30 @@ func (s *Sandbox) ListRoutes(ctx context.Context) ([]*pbTypes.Route, error) {
 	return nil, nil
 }
 
+// GetIPTables implements the VCSandbox function of the same name.
+func (s *Sandbox) GetIPTables(ctx context.Context, isIPv6 bool) ([]byte, error) {
+	if s.GetIPTablesFunc != nil {
+		return s.GetIPTablesFunc(isIPv6)
+	}
+	return nil, nil
+}
+
+// SetIPTables implements the VCSandbox function of the same name.
+func (s *Sandbox) SetIPTables(ctx context.Context, isIPv6 bool, data []byte) error {
+	if s.SetIPTablesFunc != nil {
+		return s.SetIPTablesFunc(isIPv6, data)
+	}
+	return nil
+}
+
+// SetAgentTracing implements the VCSandbox function of the same name.
+func (s *Sandbox) SetAgentTracing(ctx context.Context, enable bool) error {
+	if s.SetAgentTracingFunc != nil {
+		return s.SetAgentTracingFunc(enable)
+	}
+	return nil
+}
+
 func (s *Sandbox) GetOOMEvent(ctx context.Context) (string, error) {
 	return "", nil
 }
@@ -244,6 +268,35 @@ func (s *Sandbox) Stats(ctx context.Context) (vc.SandboxStats, error) {
 	return vc.SandboxStats{}, nil
 }
 
+// OverheadStats implements the VCSandbox function of the same name.
+func (s *Sandbox) OverheadStats() (vc.OverheadStats, error) {
+	if s.OverheadStatsFunc != nil {
+		return s.OverheadStatsFunc()
+	}
+	return vc.OverheadStats{}, nil
+}
+
+// GetAllocatedResources implements the VCSandbox function of the same name.
+func (s *Sandbox) GetAllocatedResources(ctx context.Context) (vc.AllocatedResources, error) {
+	if s.GetAllocatedResourcesFunc != nil {
+		return s.GetAllocatedResourcesFunc()
+	}
+	return vc.AllocatedResources{}, nil
+}
+
+// PauseForCheckpoint implements the VCSandbox function of the same name.
+func (s *Sandbox) PauseForCheckpoint(ctx context.Context) (*vc.CheckpointState, error) {
+	return &vc.CheckpointState{SandboxID: s.MockID}, nil
+}
+
+// SaveCheckpoint implements the VCSandbox function of the same name.
+func (s *Sandbox) SaveCheckpoint(ctx context.Context, dir string, state *vc.CheckpointState) error {
+	if s.CheckpointFunc != nil {
+		return s.CheckpointFunc(dir)
+	}
+	return nil
+}
+
 func (s *Sandbox) GetAgentURL() (string, error) {
 	if s.GetAgentURLFunc != nil {
 		return s.GetAgentURLFunc()
@@ -255,9 +308,49 @@ func (s *Sandbox) GetHypervisorPid() (int, error) {
 	return 0, nil
 }
 
+func (s *Sandbox) DebugState() vc.SandboxDebugState {
+	return vc.SandboxDebugState{}
+}
+
 func (s *Sandbox) GuestVolumeStats(ctx context.Context, path string) ([]byte, error) {
 	return nil, nil
 }
 func (s *Sandbox) ResizeGuestVolume(ctx context.Context, path string, size uint64) error {
 	return nil
 }
+
+func (s *Sandbox) GetAgentPolicyHash(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (s *Sandbox) CollectDiagnostics(ctx context.Context, containerID string, w io.Writer) error {
+	return nil
+}
+
+func (s *Sandbox) SetAgentLogLevel(ctx context.Context, level string) error {
+	return nil
+}
+
+func (s *Sandbox) ReadGuestKernelLog(ctx context.Context) ([]vc.GuestKernelLogRecord, error) {
+	return nil, nil
+}
+
+func (s *Sandbox) GrownVolumes() []vc.GrownVolume {
+	return nil
+}
+
+func (s *Sandbox) ResizeVolume(ctx context.Context, v vc.GrownVolume) error {
+	return nil
+}
+
+func (s *Sandbox) VolumeResized(v vc.GrownVolume) error {
+	return nil
+}
+
+func (s *Sandbox) GuestTimeSyncDue() (bool, error) {
+	return false, nil
+}
+
+func (s *Sandbox) SyncGuestTime(ctx context.Context) error {
+	return nil
+}
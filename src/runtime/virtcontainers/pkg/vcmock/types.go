@@ -29,45 +29,51 @@ type Sandbox struct {
 	MockNetNs       string
 
 	// functions for mocks
-	AnnotationsFunc          func(key string) (string, error)
-	SetAnnotationsFunc       func(annotations map[string]string) error
-	GetAnnotationsFunc       func() map[string]string
-	GetNetNsFunc             func() string
-	GetAllContainersFunc     func() []vc.VCContainer
-	GetContainerFunc         func(containerID string) vc.VCContainer
-	ReleaseFunc              func() error
-	StartFunc                func() error
-	StopFunc                 func(force bool) error
-	PauseFunc                func() error
-	ResumeFunc               func() error
-	DeleteFunc               func() error
-	CreateContainerFunc      func(conf vc.ContainerConfig) (vc.VCContainer, error)
-	DeleteContainerFunc      func(contID string) (vc.VCContainer, error)
-	StartContainerFunc       func(contID string) (vc.VCContainer, error)
-	StopContainerFunc        func(contID string, force bool) (vc.VCContainer, error)
-	KillContainerFunc        func(contID string, signal syscall.Signal, all bool) error
-	StatusContainerFunc      func(contID string) (vc.ContainerStatus, error)
-	StatsContainerFunc       func(contID string) (vc.ContainerStats, error)
-	PauseContainerFunc       func(contID string) error
-	ResumeContainerFunc      func(contID string) error
-	StatusFunc               func() vc.SandboxStatus
-	EnterContainerFunc       func(containerID string, cmd types.Cmd) (vc.VCContainer, *vc.Process, error)
-	MonitorFunc              func() (chan error, error)
-	UpdateContainerFunc      func(containerID string, resources specs.LinuxResources) error
-	WaitProcessFunc          func(containerID, processID string) (int32, error)
-	SignalProcessFunc        func(containerID, processID string, signal syscall.Signal, all bool) error
-	WinsizeProcessFunc       func(containerID, processID string, height, width uint32) error
-	IOStreamFunc             func(containerID, processID string) (io.WriteCloser, io.Reader, io.Reader, error)
-	AddDeviceFunc            func(info config.DeviceInfo) (api.Device, error)
-	AddInterfaceFunc         func(inf *pbTypes.Interface) (*pbTypes.Interface, error)
-	RemoveInterfaceFunc      func(inf *pbTypes.Interface) (*pbTypes.Interface, error)
-	ListInterfacesFunc       func() ([]*pbTypes.Interface, error)
-	UpdateRoutesFunc         func(routes []*pbTypes.Route) ([]*pbTypes.Route, error)
-	ListRoutesFunc           func() ([]*pbTypes.Route, error)
-	UpdateRuntimeMetricsFunc func() error
-	GetAgentMetricsFunc      func() (string, error)
-	StatsFunc                func() (vc.SandboxStats, error)
-	GetAgentURLFunc          func() (string, error)
+	AnnotationsFunc           func(key string) (string, error)
+	SetAnnotationsFunc        func(annotations map[string]string) error
+	GetAnnotationsFunc        func() map[string]string
+	GetNetNsFunc              func() string
+	GetAllContainersFunc      func() []vc.VCContainer
+	GetContainerFunc          func(containerID string) vc.VCContainer
+	ReleaseFunc               func() error
+	StartFunc                 func() error
+	StopFunc                  func(force bool) error
+	PauseFunc                 func() error
+	ResumeFunc                func() error
+	DeleteFunc                func() error
+	CreateContainerFunc       func(conf vc.ContainerConfig) (vc.VCContainer, error)
+	DeleteContainerFunc       func(contID string) (vc.VCContainer, error)
+	StartContainerFunc        func(contID string) (vc.VCContainer, error)
+	StopContainerFunc         func(contID string, force bool) (vc.VCContainer, error)
+	KillContainerFunc         func(contID string, signal syscall.Signal, all bool) error
+	StatusContainerFunc       func(contID string) (vc.ContainerStatus, error)
+	StatsContainerFunc        func(contID string) (vc.ContainerStats, error)
+	PauseContainerFunc        func(contID string) error
+	ResumeContainerFunc       func(contID string) error
+	StatusFunc                func() vc.SandboxStatus
+	EnterContainerFunc        func(containerID string, cmd types.Cmd) (vc.VCContainer, *vc.Process, error)
+	MonitorFunc               func() (chan error, error)
+	UpdateContainerFunc       func(containerID string, resources specs.LinuxResources) error
+	WaitProcessFunc           func(containerID, processID string) (int32, error)
+	SignalProcessFunc         func(containerID, processID string, signal syscall.Signal, all bool) error
+	WinsizeProcessFunc        func(containerID, processID string, height, width uint32) error
+	IOStreamFunc              func(containerID, processID string) (io.WriteCloser, io.Reader, io.Reader, error)
+	AddDeviceFunc             func(info config.DeviceInfo) (api.Device, error)
+	AddInterfaceFunc          func(inf *pbTypes.Interface) (*pbTypes.Interface, error)
+	RemoveInterfaceFunc       func(inf *pbTypes.Interface) (*pbTypes.Interface, error)
+	ListInterfacesFunc        func() ([]*pbTypes.Interface, error)
+	UpdateRoutesFunc          func(routes []*pbTypes.Route) ([]*pbTypes.Route, error)
+	ListRoutesFunc            func() ([]*pbTypes.Route, error)
+	GetIPTablesFunc           func(isIPv6 bool) ([]byte, error)
+	SetIPTablesFunc           func(isIPv6 bool, data []byte) error
+	SetAgentTracingFunc       func(enable bool) error
+	UpdateRuntimeMetricsFunc  func() error
+	GetAgentMetricsFunc       func() (string, error)
+	StatsFunc                 func() (vc.SandboxStats, error)
+	OverheadStatsFunc         func() (vc.OverheadStats, error)
+	GetAllocatedResourcesFunc func() (vc.AllocatedResources, error)
+	GetAgentURLFunc           func() (string, error)
+	CheckpointFunc            func(dir string) error
 }
 
 // Container is a fake Container type used for testing
@@ -89,5 +95,6 @@ type VCMock struct {
 	SetFactoryFunc func(ctx context.Context, factory vc.Factory)
 
 	CreateSandboxFunc    func(ctx context.Context, sandboxConfig vc.SandboxConfig) (vc.VCSandbox, error)
+	AdoptSandboxFunc     func(ctx context.Context, sandboxID string) (vc.VCSandbox, error)
 	CleanupContainerFunc func(ctx context.Context, sandboxID, containerID string, force bool) error
 }
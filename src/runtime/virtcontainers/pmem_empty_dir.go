@@ -0,0 +1,114 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
+)
+
+const (
+	// pmemEmptyDirImage is the name of the image backing a pmem emptyDir,
+	// in the host directory of the emptyDir.
+	pmemEmptyDirImage = ".kata-pmem-empty-dir.img"
+
+	pmemEmptyDirFsType = "ext4"
+)
+
+// usePmemEmptyDir returns true if the emptyDir mount, which would otherwise
+// be created on the guest filesystem, is backed by a virtio-pmem image.
+func (c *Container) usePmemEmptyDir(m Mount) bool {
+	return m.Type == KataLocalDevType && c.sandbox.config.HypervisorConfig.PmemEmptyDirSizeMB > 0
+}
+
+// buildPmemEmptyDirImage creates the image backing the emptyDir dir, unless
+// another container of the sandbox created it already. The image is sparse
+// and kept in the emptyDir, so that its space is accounted to the pod and
+// it is removed along with the emptyDir.
+func buildPmemEmptyDirImage(dir string, sizeMB uint64) (string, error) {
+	image := filepath.Join(dir, pmemEmptyDirImage)
+	if _, err := os.Stat(image); err == nil {
+		return image, nil
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	var st syscall.Stat_t
+	if err := syscall.Stat(dir, &st); err != nil {
+		return "", err
+	}
+
+	// The size of virtio-pmem devices is a multiple of 2 MiB
+	sizeMB = (sizeMB + 1) &^ 1
+
+	// DAX requires the block size of the filesystem to be the page size
+	tmp := image + ".tmp"
+	cmd := exec.Command("mkfs."+pmemEmptyDirFsType, "-q", "-F",
+		"-b", strconv.Itoa(os.Getpagesize()),
+		"-E", fmt.Sprintf("root_owner=%d:%d", st.Uid, st.Gid),
+		tmp, strconv.FormatUint(sizeMB, 10)+"M")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		os.Remove(tmp)
+		return "", fmt.Errorf("failed to build pmem emptyDir image %s: %v: %s", image, err, output)
+	}
+
+	// mkfs.ext4 can't set the mode of the root directory, which keeps the
+	// one of the emptyDir.
+	cmd = exec.Command("debugfs", "-w", "-R", fmt.Sprintf("sif / mode 0%o", st.Mode&(syscall.S_IFMT|07777)), tmp)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		os.Remove(tmp)
+		return "", fmt.Errorf("failed to set the mode of pmem emptyDir image %s: %v: %s", image, err, output)
+	}
+
+	if err := os.Rename(tmp, image); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+
+	return image, nil
+}
+
+// createPmemEmptyDir backs the emptyDir mount with a virtio-pmem device,
+// mounted with DAX by the agent, so that the files written to it are not
+// cached by both the host and the guest.
+func (c *Container) createPmemEmptyDir(m *Mount) error {
+	image, err := buildPmemEmptyDirImage(m.Source, c.sandbox.config.HypervisorConfig.PmemEmptyDirSizeMB)
+	if err != nil {
+		return err
+	}
+
+	b, err := c.sandbox.devManager.NewDevice(config.DeviceInfo{
+		HostPath:      image,
+		ContainerPath: m.Destination,
+		DevType:       "b",
+		VirtioPmem:    true,
+	})
+	if err != nil {
+		return fmt.Errorf("device manager failed to create pmem emptyDir device for %q: %v", image, err)
+	}
+
+	m.BlockDeviceID = b.DeviceID()
+	m.Type = pmemEmptyDirFsType
+	m.Options = nil
+
+	// The container bind mounts the filesystem the agent mounted, rather
+	// than a directory of the guest filesystem.
+	if spec := c.GetPatchedOCISpec(); spec != nil {
+		for i := range spec.Mounts {
+			if spec.Mounts[i].Destination == m.Destination {
+				spec.Mounts[i].Type = "bind"
+			}
+		}
+	}
+
+	return nil
+}
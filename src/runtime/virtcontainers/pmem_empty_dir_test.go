@@ -0,0 +1,75 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsePmemEmptyDir(t *testing.T) {
+	assert := assert.New(t)
+
+	c := &Container{
+		sandbox: &Sandbox{
+			config: &SandboxConfig{
+				HypervisorConfig: HypervisorConfig{
+					PmemEmptyDirSizeMB: 64,
+				},
+			},
+		},
+	}
+
+	assert.True(c.usePmemEmptyDir(Mount{Type: KataLocalDevType}))
+	assert.False(c.usePmemEmptyDir(Mount{Type: KataEphemeralDevType}))
+	assert.False(c.usePmemEmptyDir(Mount{Type: "bind"}))
+
+	c.sandbox.config.HypervisorConfig.PmemEmptyDirSizeMB = 0
+	assert.False(c.usePmemEmptyDir(Mount{Type: KataLocalDevType}))
+}
+
+func TestBuildPmemEmptyDirImage(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, cmd := range []string{"mkfs." + pmemEmptyDirFsType, "debugfs"} {
+		if _, err := exec.LookPath(cmd); err != nil {
+			t.Skip(cmd + " not found")
+		}
+	}
+
+	dir := filepath.Join(t.TempDir(), "scratch")
+	assert.NoError(os.Mkdir(dir, 0777))
+	assert.NoError(os.Chmod(dir, 0777))
+
+	image, err := buildPmemEmptyDirImage(dir, 15)
+	assert.NoError(err)
+	assert.Equal(filepath.Join(dir, pmemEmptyDirImage), image)
+
+	// Rounded up to a multiple of 2 MiB
+	st, err := os.Stat(image)
+	assert.NoError(err)
+	assert.Equal(int64(16<<20), st.Size())
+
+	output, err := exec.Command("debugfs", "-R", "stat /", image).CombinedOutput()
+	assert.NoError(err)
+	assert.Contains(string(output), "Mode:  0777")
+
+	// The image of the emptyDir is shared by the containers
+	assert.NoError(os.WriteFile(image, []byte("in use"), 0644))
+	again, err := buildPmemEmptyDirImage(dir, 15)
+	assert.NoError(err)
+	assert.Equal(image, again)
+	content, err := os.ReadFile(image)
+	assert.NoError(err)
+	assert.Equal("in use", string(content))
+
+	_, err = buildPmemEmptyDirImage(filepath.Join(dir, "missing"), 16)
+	assert.Error(err)
+}
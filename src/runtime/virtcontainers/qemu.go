@@ -74,11 +74,15 @@ type QemuState struct {
 	UUID    string
 	Bridges []types.Bridge
 	// HotpluggedCPUs is the list of CPUs that were hot-added
-	HotpluggedVCPUs      []hv.CPUDevice
-	HotpluggedMemory     int
-	VirtiofsDaemonPid    int
-	PCIeRootPort         int
-	HotplugVFIOOnRootBus bool
+	HotpluggedVCPUs  []hv.CPUDevice
+	HotpluggedMemory int
+	// BalloonedMemory is the hotplugged memory taken back by the balloon,
+	// not counted in HotpluggedMemory
+	BalloonedMemory          int
+	VirtiofsDaemonPid        int
+	VolumesVirtiofsDaemonPid int
+	PCIeRootPort             int
+	HotplugVFIOOnRootBus     bool
 }
 
 // qemu is an Hypervisor interface implementation for the Linux qemu hypervisor.
@@ -88,6 +92,10 @@ type qemu struct {
 
 	virtiofsDaemon VirtiofsDaemon
 
+	// volumesVirtiofsDaemon shares the volumes, when they are not shared
+	// by virtiofsDaemon
+	volumesVirtiofsDaemon VirtiofsDaemon
+
 	ctx context.Context
 
 	// fds is a list of file descriptors inherited by QEMU process
@@ -124,13 +132,18 @@ const (
 	qmpCapErrMsg  = "Failed to negotiate QMP Capabilities"
 	qmpExecCatCmd = "exec:cat"
 
-	scsiControllerID         = "scsi0"
+	scsiControllerPrefix     = "scsi"
 	rngID                    = "rng0"
+	balloonDeviceID          = "balloon0"
 	fallbackFileBackedMemDir = "/dev/shm"
 
 	qemuStopSandboxTimeoutSecs = 15
 
 	qomPathPrefix = "/machine/peripheral/"
+
+	// virtioPmemAlignment is the alignment of the size of virtio-pmem
+	// devices, for the guest to map them with huge pages.
+	virtioPmemAlignment = 2 << 20
 )
 
 // agnostic list of kernel parameters
@@ -194,7 +207,16 @@ func (q *qemu) Capabilities(ctx context.Context) types.Capabilities {
 	span, _ := katatrace.Trace(ctx, q.Logger(), "Capabilities", qemuTracingTags, map[string]string{"sandbox_id": q.id})
 	defer span.End()
 
-	return q.arch.capabilities()
+	caps := q.arch.capabilities()
+	// Without a shared filesystem, the files are copied to the guest
+	if q.config.SharedFS == config.NoSharedFS {
+		caps.UnsetFsSharingSupport()
+	}
+	// The vhost-user-fs devices block the migration of the VM
+	if q.config.SharedFS != config.VirtioFS && q.config.SharedFS != config.VirtioFSNydus {
+		caps.SetSnapshotSupport()
+	}
+	return caps
 }
 
 func (q *qemu) HypervisorConfig() HypervisorConfig {
@@ -295,6 +317,10 @@ func (q *qemu) setup(ctx context.Context, id string, hypervisorConfig *Hyperviso
 		q.arch.disableVhostNet()
 	}
 
+	if q.config.EnableNetRSS {
+		q.arch.enableNetRSS()
+	}
+
 	return nil
 }
 
@@ -325,6 +351,26 @@ func (q *qemu) memoryTopology() (govmmQemu.Memory, error) {
 	return q.arch.memoryTopology(memMb, hostMemMb, uint8(q.config.MemSlots)), nil
 }
 
+// numaNodes returns the guest NUMA nodes of the VM, but with VM templating,
+// which shares the memory file of the template VM.
+func (q *qemu) numaNodes() []govmmQemu.NUMANode {
+	if q.config.BootToBeTemplate || q.config.BootFromTemplate {
+		return nil
+	}
+
+	var nodes []govmmQemu.NUMANode
+	for _, node := range q.config.GuestNUMANodes {
+		nodes = append(nodes, govmmQemu.NUMANode{
+			CPUs:      node.VCPUs,
+			Size:      fmt.Sprintf("%dM", node.MemorySize),
+			HostNodes: strconv.FormatUint(uint64(node.HostNode), 10),
+			Distances: node.Distances,
+		})
+	}
+
+	return nodes
+}
+
 func (q *qemu) qmpSocketPath(id string) (string, error) {
 	return utils.BuildSocketPath(q.config.VMStorePath, id, qmpSocket)
 }
@@ -380,7 +426,20 @@ func (q *qemu) createQmpSocket() ([]govmmQemu.QMPSocket, error) {
 	}, nil
 }
 
-func (q *qemu) buildDevices(ctx context.Context, initrdPath string) ([]govmmQemu.Device, *govmmQemu.IOThread, error) {
+// scsiControllerID returns the id of the index-th virtio-scsi controller.
+func scsiControllerID(index int) string {
+	return fmt.Sprintf("%s%d", scsiControllerPrefix, index)
+}
+
+// scsiControllers returns the number of virtio-scsi controllers of the VM.
+func (q *qemu) scsiControllers() int {
+	if q.config.SCSIControllers == 0 {
+		return 1
+	}
+	return int(q.config.SCSIControllers)
+}
+
+func (q *qemu) buildDevices(ctx context.Context, initrdPath string) ([]govmmQemu.Device, []govmmQemu.IOThread, error) {
 	var devices []govmmQemu.Device
 
 	_, console, err := q.GetVMConsole(ctx, q.id)
@@ -416,12 +475,30 @@ func (q *qemu) buildDevices(ctx context.Context, initrdPath string) ([]govmmQemu
 		devices, _ = q.arch.appendPVPanicDevice(devices)
 	}
 
-	var ioThread *govmmQemu.IOThread
+	if q.memoryBalloon() {
+		devices, err = q.arch.appendBalloonDevice(devices)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var ioThreads []govmmQemu.IOThread
 	if q.config.BlockDeviceDriver == config.VirtioSCSI {
-		return q.arch.appendSCSIController(ctx, devices, q.config.EnableIOThreads)
+		// Each controller gets its own IO thread, so that the
+		// block devices spread across them are not serialized.
+		for i := 0; i < q.scsiControllers(); i++ {
+			var ioThread *govmmQemu.IOThread
+			devices, ioThread, err = q.arch.appendSCSIController(ctx, devices, scsiControllerID(i), q.config.SCSIQueueDepth, q.config.EnableIOThreads)
+			if err != nil {
+				return nil, nil, err
+			}
+			if ioThread != nil {
+				ioThreads = append(ioThreads, *ioThread)
+			}
+		}
 	}
 
-	return devices, ioThread, nil
+	return devices, ioThreads, nil
 }
 
 func (q *qemu) setupTemplate(knobs *govmmQemu.Knobs, memory *govmmQemu.Memory) govmmQemu.Incoming {
@@ -502,6 +579,10 @@ func (q *qemu) createVirtiofsDaemon(sharedPath string) (VirtiofsDaemon, error) {
 		socketPath: virtiofsdSocketPath,
 		extraArgs:  q.config.VirtioFSExtraArgs,
 		cache:      q.config.VirtioFSCache,
+		writeback:  q.config.VirtioFSWriteback,
+		sandbox:    q.config.VirtioFSSandbox,
+		seccomp:    q.config.VirtioFSSeccomp,
+		modcaps:    q.config.VirtioFSModcaps,
 	}, nil
 }
 
@@ -594,7 +675,7 @@ func (q *qemu) CreateVM(ctx context.Context, id string, network Network, hypervi
 		return err
 	}
 
-	devices, ioThread, err := q.buildDevices(ctx, initrdPath)
+	devices, ioThreads, err := q.buildDevices(ctx, initrdPath)
 	if err != nil {
 		return err
 	}
@@ -641,6 +722,7 @@ func (q *qemu) CreateVM(ctx context.Context, id string, network Network, hypervi
 		Machine:     machine,
 		SMP:         smp,
 		Memory:      memory,
+		NUMANodes:   q.numaNodes(),
 		Devices:     devices,
 		CPUModel:    cpuModel,
 		Kernel:      kernel,
@@ -660,9 +742,8 @@ func (q *qemu) CreateVM(ctx context.Context, id string, network Network, hypervi
 		return err
 	}
 
-	if ioThread != nil {
-		qemuConfig.IOThreads = []govmmQemu.IOThread{*ioThread}
-	}
+	qemuConfig.IOThreads = ioThreads
+
 	// Add RNG device to hypervisor
 	// Skip for s390x as CPACF is used
 	if machine.Type != QemuCCWVirtio {
@@ -686,13 +767,29 @@ func (q *qemu) CreateVM(ctx context.Context, id string, network Network, hypervi
 	q.qemuConfig = qemuConfig
 
 	q.virtiofsDaemon, err = q.createVirtiofsDaemon(hypervisorConfig.SharedPath)
-	return err
+	if err != nil {
+		return err
+	}
+
+	if useVolumesVirtiofsd(&q.config) {
+		sockPath, err := q.vhostFSVolumesSocketPath(q.id)
+		if err != nil {
+			return err
+		}
+		q.volumesVirtiofsDaemon = newVolumesVirtiofsd(&q.config, q.id, sockPath)
+	}
+
+	return nil
 }
 
 func (q *qemu) vhostFSSocketPath(id string) (string, error) {
 	return utils.BuildSocketPath(q.config.VMStorePath, id, vhostFSSocket)
 }
 
+func (q *qemu) vhostFSVolumesSocketPath(id string) (string, error) {
+	return utils.BuildSocketPath(q.config.VMStorePath, id, vhostFSVolumesSocket)
+}
+
 func (q *qemu) nydusdAPISocketPath(id string) (string, error) {
 	return utils.BuildSocketPath(q.config.VMStorePath, id, nydusdAPISock)
 }
@@ -706,10 +803,28 @@ func (q *qemu) setupVirtiofsDaemon(ctx context.Context) (err error) {
 	}
 	q.state.VirtiofsDaemonPid = pid
 
+	if q.volumesVirtiofsDaemon != nil {
+		pid, err = startVolumesVirtiofsd(ctx, q.volumesVirtiofsDaemon)
+		if err != nil {
+			if stopErr := q.stopVirtiofsDaemon(ctx); stopErr != nil {
+				q.Logger().WithError(stopErr).Warn("failed to stop virtiofsDaemon")
+			}
+			return err
+		}
+		q.state.VolumesVirtiofsDaemonPid = pid
+	}
+
 	return nil
 }
 
 func (q *qemu) stopVirtiofsDaemon(ctx context.Context) (err error) {
+	if q.state.VolumesVirtiofsDaemonPid != 0 && q.volumesVirtiofsDaemon != nil {
+		if err = q.volumesVirtiofsDaemon.Stop(ctx); err != nil {
+			return err
+		}
+		q.state.VolumesVirtiofsDaemonPid = 0
+	}
+
 	if q.state.VirtiofsDaemonPid == 0 {
 		q.Logger().Warn("The virtiofsd had stopped")
 		return nil
@@ -1163,7 +1278,7 @@ func (q *qemu) canDumpGuestMemory(dumpSavePath string) error {
 		}).Info("get avail space")
 
 	// get guest memory size
-	guestMemorySizeInBytes := (uint64(q.config.MemorySize) + uint64(q.state.HotpluggedMemory)) << utils.MibToBytesShift
+	guestMemorySizeInBytes := (uint64(q.config.MemorySize) + uint64(q.state.HotpluggedMemory) + uint64(q.state.BalloonedMemory)) << utils.MibToBytesShift
 	q.Logger().WithField("guestMemorySizeInBytes", guestMemorySizeInBytes).Info("get guest memory size")
 
 	// default we want ensure there are at least double of VM memory size free spaces available,
@@ -1265,6 +1380,14 @@ func (q *qemu) qmpShutdown() {
 }
 
 func (q *qemu) hotplugAddBlockDevice(ctx context.Context, drive *config.BlockDrive, op Operation, devID string) (err error) {
+	if drive.VirtioPmem {
+		return q.hotplugAddVirtioPmemDevice(ctx, drive, devID)
+	}
+
+	if drive.NVMe && (q.config.BlockDeviceDriver == config.Nvdimm || drive.Pmem) {
+		return fmt.Errorf("NVMe namespace %s cannot be used as a nvdimm device", drive.File)
+	}
+
 	// drive can be a pmem device, in which case it's used as backing file for a nvdimm device
 	if q.config.BlockDeviceDriver == config.Nvdimm || drive.Pmem {
 		var blocksize int64
@@ -1295,8 +1418,23 @@ func (q *qemu) hotplugAddBlockDevice(ctx context.Context, drive *config.BlockDri
 		return nil
 	}
 
-	if drive.Swap {
+	if drive.NVMe {
+		var bdf string
+		var namespace uint32
+		if bdf, namespace, err = config.ParseNVMeURI(drive.File); err != nil {
+			return err
+		}
+		err = q.qmpMonitorCh.qmp.ExecuteBlockdevAddNVMe(q.qmpMonitorCh.ctx, bdf, namespace, drive.ID, drive.ReadOnly)
+	} else if drive.ImageFile {
+		// The image may be shared by several sandboxes, let them share
+		// the host page cache too.
+		err = q.qmpMonitorCh.qmp.ExecuteBlockdevAddWithDriverCache(q.qmpMonitorCh.ctx, "file", drive.File, drive.ID, false, false, true)
+	} else if drive.Swap {
 		err = q.qmpMonitorCh.qmp.ExecuteBlockdevAddWithDriverCache(q.qmpMonitorCh.ctx, "file", drive.File, drive.ID, false, false, false)
+	} else if q.config.BlockDeviceDiscard && !drive.ReadOnly && q.config.BlockDeviceCacheSet {
+		err = q.qmpMonitorCh.qmp.ExecuteBlockdevAddWithCacheDiscard(q.qmpMonitorCh.ctx, drive.File, drive.ID, q.config.BlockDeviceCacheDirect, q.config.BlockDeviceCacheNoflush, drive.ReadOnly)
+	} else if q.config.BlockDeviceDiscard && !drive.ReadOnly {
+		err = q.qmpMonitorCh.qmp.ExecuteBlockdevAddWithDiscard(q.qmpMonitorCh.ctx, drive.File, drive.ID, drive.ReadOnly)
 	} else if q.config.BlockDeviceCacheSet {
 		err = q.qmpMonitorCh.qmp.ExecuteBlockdevAddWithCache(q.qmpMonitorCh.ctx, drive.File, drive.ID, q.config.BlockDeviceCacheDirect, q.config.BlockDeviceCacheNoflush, drive.ReadOnly)
 	} else {
@@ -1341,7 +1479,7 @@ func (q *qemu) hotplugAddBlockDevice(ctx context.Context, drive *config.BlockDri
 			return err
 		}
 
-		if err = q.qmpMonitorCh.qmp.ExecutePCIDeviceAdd(q.qmpMonitorCh.ctx, drive.ID, devID, driver, addr, bridge.ID, romFile, 0, true, defaultDisableModern); err != nil {
+		if err = q.qmpMonitorCh.qmp.ExecutePCIDeviceAdd(q.qmpMonitorCh.ctx, drive.ID, devID, driver, addr, bridge.ID, romFile, drive.NumQueues, true, defaultDisableModern); err != nil {
 			return err
 		}
 	case q.config.BlockDeviceDriver == config.VirtioBlockCCW:
@@ -1366,8 +1504,10 @@ func (q *qemu) hotplugAddBlockDevice(ctx context.Context, drive *config.BlockDri
 	case q.config.BlockDeviceDriver == config.VirtioSCSI:
 		driver := "scsi-hd"
 
-		// Bus exposed by the SCSI Controller
-		bus := scsiControllerID + ".0"
+		// Bus exposed by the SCSI Controller. The drives are spread
+		// across the controllers, their SCSI addresses being unique
+		// in the VM.
+		bus := scsiControllerID(drive.Index%q.scsiControllers()) + ".0"
 
 		// Get SCSI-id and LUN based on the order of attaching drives.
 		scsiID, lun, err := utils.GetSCSIIdLun(drive.Index)
@@ -1382,10 +1522,68 @@ func (q *qemu) hotplugAddBlockDevice(ctx context.Context, drive *config.BlockDri
 		return fmt.Errorf("Block device %s not recognized", q.config.BlockDeviceDriver)
 	}
 
+	if drive.IOLimits != nil {
+		// Each drive gets its own throttle group, named after it.
+		if err = q.qmpMonitorCh.qmp.ExecuteBlockSetIOThrottle(q.qmpMonitorCh.ctx, devID, drive.ID, drive.IOLimits.IOPS, drive.IOLimits.BPS); err != nil {
+			q.Logger().WithError(err).WithField("device", devID).Error("Failed to throttle block device")
+			if delErr := q.qmpMonitorCh.qmp.ExecuteDeviceDel(q.qmpMonitorCh.ctx, devID); delErr != nil {
+				q.Logger().WithError(delErr).WithField("device", devID).Error("Failed to remove block device")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
+// hotplugAddVirtioPmemDevice maps the file of drive into the guest as a
+// virtio-pmem device, which the guest accesses directly through DAX.
+func (q *qemu) hotplugAddVirtioPmemDevice(ctx context.Context, drive *config.BlockDrive, devID string) (err error) {
+	if q.qemuConfig.Machine.Type == QemuCCWVirtio {
+		return fmt.Errorf("virtio-pmem devices are not supported on %s machines", QemuCCWVirtio)
+	}
+
+	size, err := utils.GetBlockDeviceSize(drive.File)
+	if err != nil {
+		return err
+	}
+	if size == 0 || size%virtioPmemAlignment != 0 {
+		return fmt.Errorf("size of virtio-pmem backing file %s must be a non zero multiple of %d bytes", drive.File, virtioPmemAlignment)
+	}
+
+	addr, bridge, err := q.arch.addDeviceToBridge(ctx, drive.ID, types.PCI)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			q.arch.removeDeviceFromBridge(drive.ID)
+		}
+	}()
+
+	bridgeSlot, err := types.PciSlotFromInt(bridge.Addr)
+	if err != nil {
+		return err
+	}
+	devSlot, err := types.PciSlotFromString(addr)
+	if err != nil {
+		return err
+	}
+	drive.PCIPath, err = types.PciPathFromSlots(bridgeSlot, devSlot)
+	if err != nil {
+		return err
+	}
+
+	return q.qmpMonitorCh.qmp.ExecuteVirtioPmemDeviceAdd(q.qmpMonitorCh.ctx, devID, drive.File, int64(size), addr, bridge.ID, drive.ReadOnly)
+}
+
 func (q *qemu) hotplugAddVhostUserBlkDevice(ctx context.Context, vAttr *config.VhostUserDeviceAttrs, op Operation, devID string) (err error) {
+	// The vhost-user target, e.g. SPDK, accesses the guest memory directly.
+	if !q.qemuConfig.Knobs.MemShared {
+		return fmt.Errorf("Vhost-user-blk device %s requires shared guest memory, enable hugepages with vhost-user store or file backed memory", vAttr.SocketPath)
+	}
+
 	err = q.qmpMonitorCh.qmp.ExecuteCharDevUnixSocketAdd(q.qmpMonitorCh.ctx, vAttr.DevID, vAttr.SocketPath, false, false)
 	if err != nil {
 		return err
@@ -1473,6 +1671,12 @@ func (q *qemu) hotplugBlockDevice(ctx context.Context, drive *config.BlockDrive,
 	if op == AddDevice {
 		return q.hotplugAddBlockDevice(ctx, drive, op, devID)
 	}
+	if drive.VirtioPmem {
+		// QEMU does not support unplugging virtio-pmem devices, the
+		// device stays in the guest until the sandbox stops.
+		q.Logger().WithField("device", drive.File).Warn("virtio-pmem device cannot be hot removed")
+		return nil
+	}
 	if !drive.Swap && q.config.BlockDeviceDriver == config.VirtioBlock {
 		if err := q.arch.removeDeviceFromBridge(drive.ID); err != nil {
 			return err
@@ -1607,6 +1811,11 @@ func (q *qemu) hotplugVFIODevice(ctx context.Context, device *config.VFIODev, op
 			// In case MachineType is q35, a PCIe device is hotplugged on a PCIe Root Port.
 			switch machineType {
 			case QemuQ35:
+				// A NVMe controller on the root bus would be an integrated
+				// endpoint, which cannot be reset nor hot removed.
+				if device.Class == config.PCINVMeClass && q.state.PCIeRootPort <= 0 {
+					return fmt.Errorf("NVMe controller %s must be hot plugged on a PCIe Root Port, set the pcie_root_port parameter in the configuration for q35", device.BDF)
+				}
 				if device.IsPCIe && q.state.PCIeRootPort <= 0 {
 					q.Logger().WithField("dev-id", device.ID).Warn("VFIO device is a PCIe device. It's recommended to add the PCIe Root Port by setting the pcie_root_port parameter in the configuration for q35")
 					device.Bus = ""
@@ -1757,9 +1966,9 @@ func (q *qemu) hotplugNetDevice(ctx context.Context, endpoint Endpoint, op Opera
 		}
 		if machine.Type == QemuCCWVirtio {
 			devNoHotplug := fmt.Sprintf("fe.%x.%x", bridge.Addr, addr)
-			return q.qmpMonitorCh.qmp.ExecuteNetCCWDeviceAdd(q.qmpMonitorCh.ctx, tap.Name, devID, endpoint.HardwareAddr(), devNoHotplug, int(q.config.NumVCPUs))
+			return q.qmpMonitorCh.qmp.ExecuteNetCCWDeviceAdd(q.qmpMonitorCh.ctx, tap.Name, devID, endpoint.HardwareAddr(), devNoHotplug, int(q.config.NetQueues()), endpoint.Properties().Iface.MTU)
 		}
-		return q.qmpMonitorCh.qmp.ExecuteNetPCIDeviceAdd(q.qmpMonitorCh.ctx, tap.Name, devID, endpoint.HardwareAddr(), addr, bridge.ID, romFile, int(q.config.NumVCPUs), defaultDisableModern)
+		return q.qmpMonitorCh.qmp.ExecuteNetPCIDeviceAdd(q.qmpMonitorCh.ctx, tap.Name, devID, endpoint.HardwareAddr(), addr, bridge.ID, romFile, int(q.config.NetQueues()), endpoint.Properties().Iface.MTU, defaultDisableModern, q.config.EnableNetRSS)
 
 	}
 
@@ -1945,7 +2154,8 @@ func (q *qemu) hotplugMemory(memDev *MemoryDevice, op Operation) (int, error) {
 		return 0, err
 	}
 
-	currentMemory := int(q.config.MemorySize) + q.state.HotpluggedMemory
+	// The ballooned memory is still plugged
+	currentMemory := int(q.config.MemorySize) + q.state.HotpluggedMemory + q.state.BalloonedMemory
 
 	if memDev.SizeMB == 0 {
 		memLog.Debug("hotplug is not required")
@@ -1967,9 +2177,9 @@ func (q *qemu) hotplugMemory(memDev *MemoryDevice, op Operation) (int, error) {
 
 		// Don't exceed the maximum amount of memory
 		if currentMemory+memDev.SizeMB > int(maxMem) {
-			// Fixme: return a typed error
-			return 0, fmt.Errorf("Unable to hotplug %d MiB memory, the SB has %d MiB and the maximum amount is %d MiB",
-				memDev.SizeMB, currentMemory, maxMem)
+			return 0, types.NewError(types.ErrorResourceExhausted, types.SubsystemResources, false,
+				fmt.Errorf("Unable to hotplug %d MiB memory, the SB has %d MiB and the maximum amount is %d MiB",
+					memDev.SizeMB, currentMemory, maxMem))
 		}
 		memoryAdded, err := q.hotplugAddMemory(memDev)
 		if err != nil {
@@ -1982,6 +2192,29 @@ func (q *qemu) hotplugMemory(memDev *MemoryDevice, op Operation) (int, error) {
 
 }
 
+// memoryBalloon returns whether the VM has a memory balloon, to give back the
+// hotplugged memory.
+func (q *qemu) memoryBalloon() bool {
+	// virtio-mem unplugs the memory by itself, the balloon is only needed
+	// to give back the memory of the DIMMs, which cannot be unplugged.
+	return q.config.EnableMemoryBalloon && !q.config.VirtioMem && q.arch.supportGuestMemoryHotplug()
+}
+
+// resizeBalloon inflates or deflates the memory balloon for the VM memory to
+// be memoryMB, the rest of the plugged memory being ballooned.
+func (q *qemu) resizeBalloon(memoryMB uint32) error {
+	pluggedMemory := int(q.config.MemorySize) + q.state.HotpluggedMemory + q.state.BalloonedMemory
+
+	q.Logger().WithField("hotplug", "memory").Debugf("resize memory balloon to %dMB", pluggedMemory-int(memoryMB))
+	if err := q.qmpMonitorCh.qmp.ExecuteBalloon(q.qmpMonitorCh.ctx, uint64(memoryMB)<<utils.MibToBytesShift); err != nil {
+		return fmt.Errorf("failed to resize the memory balloon: %v", err)
+	}
+
+	q.state.HotpluggedMemory = int(memoryMB) - int(q.config.MemorySize)
+	q.state.BalloonedMemory = pluggedMemory - int(memoryMB)
+	return nil
+}
+
 func (q *qemu) hotplugAddMemory(memDev *MemoryDevice) (int, error) {
 	memoryDevices, err := q.qmpMonitorCh.qmp.ExecQueryMemoryDevices(q.qmpMonitorCh.ctx)
 	if err != nil {
@@ -2059,7 +2292,11 @@ func (q *qemu) AddDevice(ctx context.Context, devInfo interface{}, devType Devic
 			id := hex.EncodeToString(randBytes)
 
 			var sockPath string
-			sockPath, err = q.vhostFSSocketPath(q.id)
+			if v.MountTag == mountGuestVolumesTag {
+				sockPath, err = q.vhostFSVolumesSocketPath(q.id)
+			} else {
+				sockPath, err = q.vhostFSSocketPath(q.id)
+			}
 			if err != nil {
 				return err
 			}
@@ -2112,7 +2349,7 @@ func (q *qemu) GetVMConsole(ctx context.Context, id string) (string, string, err
 	return consoleProtoUnix, consoleURL, nil
 }
 
-func (q *qemu) SaveVM() error {
+func (q *qemu) SaveVM(statePath string) error {
 	q.Logger().Info("Save sandbox")
 
 	if err := q.qmpSetup(); err != nil {
@@ -2129,7 +2366,7 @@ func (q *qemu) SaveVM() error {
 		}
 	}
 
-	err := q.qmpMonitorCh.qmp.ExecSetMigrateArguments(q.qmpMonitorCh.ctx, fmt.Sprintf("%s>%s", qmpExecCatCmd, q.config.DevicesStatePath))
+	err := q.qmpMonitorCh.qmp.ExecSetMigrateArguments(q.qmpMonitorCh.ctx, fmt.Sprintf("%s>%s", qmpExecCatCmd, statePath))
 	if err != nil {
 		q.Logger().WithError(err).Error("exec migration")
 		return err
@@ -2181,8 +2418,9 @@ func (q *qemu) Disconnect(ctx context.Context) {
 // Memory unplug can be slow and it cannot be guaranteed.
 // Additionally, the unplug has not small granularly it has to be
 // the memory to remove has to be at least the size of one slot.
-// To return memory back we are resizing the VM memory balloon.
-// A longer term solution is evaluate solutions like virtio-mem
+// To return memory back we are resizing the virtio-mem device when
+// virtio-mem is enabled, or inflating the VM memory balloon, which is
+// deflated before hotplugging memory again.
 func (q *qemu) ResizeMemory(ctx context.Context, reqMemMB uint32, memoryBlockSizeMB uint32, probe bool) (uint32, MemoryDevice, error) {
 
 	currentMemory := q.config.MemorySize + uint32(q.state.HotpluggedMemory)
@@ -2204,6 +2442,21 @@ func (q *qemu) ResizeMemory(ctx context.Context, reqMemMB uint32, memoryBlockSiz
 
 	switch {
 	case currentMemory < reqMemMB:
+		// Take back the ballooned memory first
+		if q.state.BalloonedMemory > 0 {
+			memMB := reqMemMB
+			if maxMemMB := currentMemory + uint32(q.state.BalloonedMemory); memMB > maxMemMB {
+				memMB = maxMemMB
+			}
+			if err := q.resizeBalloon(memMB); err != nil {
+				return currentMemory, MemoryDevice{}, err
+			}
+			currentMemory = memMB
+			if currentMemory == reqMemMB {
+				break
+			}
+		}
+
 		//hotplug
 		addMemMB := reqMemMB - currentMemory
 		memHotplugMB, err := calcHotplugMemMiBSize(addMemMB, memoryBlockSizeMB)
@@ -2223,6 +2476,16 @@ func (q *qemu) ResizeMemory(ctx context.Context, reqMemMB uint32, memoryBlockSiz
 			return currentMemory, addMemDevice, fmt.Errorf("Could not get the memory added, got %+v", data)
 		}
 		currentMemory += uint32(memoryAdded)
+	case currentMemory > reqMemMB && q.memoryBalloon():
+		// The balloon only takes back the hotplugged memory
+		memMB := reqMemMB
+		if memMB < q.config.MemorySize {
+			memMB = q.config.MemorySize
+		}
+		if err := q.resizeBalloon(memMB); err != nil {
+			return currentMemory, MemoryDevice{}, err
+		}
+		currentMemory = memMB
 	case currentMemory > reqMemMB:
 		//hotunplug
 		addMemMB := currentMemory - reqMemMB
@@ -2416,6 +2679,25 @@ func calcHotplugMemMiBSize(mem uint32, memorySectionSizeMB uint32) (uint32, erro
 	return uint32(math.Ceil(float64(mem)/float64(memorySectionSizeMB))) * memorySectionSizeMB, nil
 }
 
+// ResizeBlockDevice resizes a hot plugged block device through QMP. The host
+// device or file must have been grown to size beforehand.
+func (q *qemu) ResizeBlockDevice(ctx context.Context, drive *config.BlockDrive, size uint64) error {
+	span, ctx := katatrace.Trace(ctx, q.Logger(), "ResizeBlockDevice", qemuTracingTags, map[string]string{"sandbox_id": q.id})
+	defer span.End()
+
+	if drive.Pmem {
+		return fmt.Errorf("Resizing a pmem device is not supported")
+	}
+
+	if err := q.qmpSetup(); err != nil {
+		return err
+	}
+
+	q.Logger().WithFields(logrus.Fields{"drive": drive.ID, "size": size}).Info("Resizing block device")
+
+	return q.qmpMonitorCh.qmp.ExecuteBlockResize(q.qmpMonitorCh.ctx, drive.ID, size)
+}
+
 func (q *qemu) ResizeVCPUs(ctx context.Context, reqVCPUs uint32) (currentVCPUs uint32, newVCPUs uint32, err error) {
 
 	currentVCPUs = q.config.NumVCPUs + uint32(len(q.state.HotpluggedVCPUs))
@@ -2480,6 +2762,9 @@ func (q *qemu) GetPids() []int {
 	if q.state.VirtiofsDaemonPid != 0 {
 		pids = append(pids, q.state.VirtiofsDaemonPid)
 	}
+	if q.state.VolumesVirtiofsDaemonPid != 0 {
+		pids = append(pids, q.state.VolumesVirtiofsDaemonPid)
+	}
 
 	return pids
 }
@@ -2488,6 +2773,33 @@ func (q *qemu) GetVirtioFsPid() *int {
 	return &q.state.VirtiofsDaemonPid
 }
 
+func (q *qemu) adoptVirtiofsDaemons(ctx context.Context) error {
+	if q.state.VirtiofsDaemonPid != 0 && q.virtiofsDaemon != nil {
+		if err := q.virtiofsDaemon.Adopt(ctx, q.state.VirtiofsDaemonPid, func() {
+			q.StopVM(ctx, false)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if q.state.VolumesVirtiofsDaemonPid != 0 && q.volumesVirtiofsDaemon != nil {
+		return q.volumesVirtiofsDaemon.Adopt(ctx, q.state.VolumesVirtiofsDaemonPid, volumesVirtiofsdQuit)
+	}
+
+	return nil
+}
+
+func (q *qemu) virtiofsdPids() []int {
+	var pids []int
+	if q.state.VirtiofsDaemonPid != 0 {
+		pids = append(pids, q.state.VirtiofsDaemonPid)
+	}
+	if q.state.VolumesVirtiofsDaemonPid != 0 {
+		pids = append(pids, q.state.VolumesVirtiofsDaemonPid)
+	}
+	return pids
+}
+
 type qemuGrpc struct {
 	ID             string
 	QmpChannelpath string
@@ -2555,9 +2867,11 @@ func (q *qemu) Save() (s hv.HypervisorState) {
 		s.Pid = pids[0]
 	}
 	s.VirtiofsDaemonPid = q.state.VirtiofsDaemonPid
+	s.VolumesVirtiofsDaemonPid = q.state.VolumesVirtiofsDaemonPid
 	s.Type = string(QemuHypervisor)
 	s.UUID = q.state.UUID
 	s.HotpluggedMemory = q.state.HotpluggedMemory
+	s.BalloonedMemory = q.state.BalloonedMemory
 	s.HotplugVFIOOnRootBus = q.state.HotplugVFIOOnRootBus
 	s.PCIeRootPort = q.state.PCIeRootPort
 
@@ -2581,8 +2895,10 @@ func (q *qemu) Save() (s hv.HypervisorState) {
 func (q *qemu) Load(s hv.HypervisorState) {
 	q.state.UUID = s.UUID
 	q.state.HotpluggedMemory = s.HotpluggedMemory
+	q.state.BalloonedMemory = s.BalloonedMemory
 	q.state.HotplugVFIOOnRootBus = s.HotplugVFIOOnRootBus
 	q.state.VirtiofsDaemonPid = s.VirtiofsDaemonPid
+	q.state.VolumesVirtiofsDaemonPid = s.VolumesVirtiofsDaemonPid
 	q.state.PCIeRootPort = s.PCIeRootPort
 
 	for _, bridge := range s.Bridges {
@@ -40,6 +40,9 @@ type qemuArch interface {
 	// disableVhostNet vhost will be disabled
 	disableVhostNet()
 
+	// enableNetRSS receive side scaling will be enabled on network devices
+	enableNetRSS()
+
 	// machine returns the machine type
 	machine() govmmQemu.Machine
 
@@ -78,7 +81,7 @@ type qemuArch interface {
 	appendNvdimmImage(devices []govmmQemu.Device, path string) ([]govmmQemu.Device, error)
 
 	// appendSCSIController appens a SCSI controller to devices
-	appendSCSIController(context context.Context, devices []govmmQemu.Device, enableIOThreads bool) ([]govmmQemu.Device, *govmmQemu.IOThread, error)
+	appendSCSIController(context context.Context, devices []govmmQemu.Device, id string, queueDepth uint32, enableIOThreads bool) ([]govmmQemu.Device, *govmmQemu.IOThread, error)
 
 	// appendBridges appends bridges to devices
 	appendBridges(devices []govmmQemu.Device) []govmmQemu.Device
@@ -146,6 +149,9 @@ type qemuArch interface {
 	// append pvpanic device
 	appendPVPanicDevice(devices []govmmQemu.Device) ([]govmmQemu.Device, error)
 
+	// append memory balloon device
+	appendBalloonDevice(devices []govmmQemu.Device) ([]govmmQemu.Device, error)
+
 	// append protection device.
 	// This implementation is architecture specific, some archs may need
 	// a firmware, returns a string containing the path to the firmware that should
@@ -167,6 +173,7 @@ type qemuArchBase struct {
 	protection    guestProtection //nolint:structcheck
 	nestedRun     bool
 	vhost         bool
+	netRSS        bool
 	disableNvdimm bool
 	dax           bool
 	legacySerial  bool
@@ -256,6 +263,10 @@ func (q *qemuArchBase) disableVhostNet() {
 	q.vhost = false
 }
 
+func (q *qemuArchBase) enableNetRSS() {
+	q.netRSS = true
+}
+
 func (q *qemuArchBase) machine() govmmQemu.Machine {
 	return q.qemuMachine
 }
@@ -433,10 +444,11 @@ func (q *qemuArchBase) appendBlockImage(ctx context.Context, devices []govmmQemu
 	return devices, nil
 }
 
-func genericSCSIController(enableIOThreads, nestedRun bool) (govmmQemu.SCSIController, *govmmQemu.IOThread) {
+func genericSCSIController(id string, queueDepth uint32, enableIOThreads, nestedRun bool) (govmmQemu.SCSIController, *govmmQemu.IOThread) {
 	scsiController := govmmQemu.SCSIController{
-		ID:            scsiControllerID,
+		ID:            id,
 		DisableModern: nestedRun,
+		VirtQueueSize: queueDepth,
 	}
 
 	var t *govmmQemu.IOThread
@@ -454,8 +466,8 @@ func genericSCSIController(enableIOThreads, nestedRun bool) (govmmQemu.SCSIContr
 	return scsiController, t
 }
 
-func (q *qemuArchBase) appendSCSIController(_ context.Context, devices []govmmQemu.Device, enableIOThreads bool) ([]govmmQemu.Device, *govmmQemu.IOThread, error) {
-	d, t := genericSCSIController(enableIOThreads, q.nestedRun)
+func (q *qemuArchBase) appendSCSIController(_ context.Context, devices []govmmQemu.Device, id string, queueDepth uint32, enableIOThreads bool) ([]govmmQemu.Device, *govmmQemu.IOThread, error) {
+	d, t := genericSCSIController(id, queueDepth, enableIOThreads, q.nestedRun)
 	devices = append(devices, d)
 	return devices, t, nil
 }
@@ -593,10 +605,34 @@ func genericNetwork(endpoint Endpoint, vhost, nestedRun bool, index int) (govmmQ
 			FDs:           netPair.VMFds,
 			VhostFDs:      netPair.VhostFds,
 		}
+	case *VdpaEndpoint:
+		d = govmmQemu.NetDevice{
+			Type:          govmmQemu.VHOSTVDPA,
+			Driver:        govmmQemu.VirtioNet,
+			ID:            fmt.Sprintf("network-%d", index),
+			IFName:        ep.Name(),
+			MACAddress:    ep.HardwareAddr(),
+			VhostDev:      ep.VhostDevPath,
+			DisableModern: nestedRun,
+		}
+	case *PasstEndpoint:
+		d = govmmQemu.NetDevice{
+			Type:          govmmQemu.STREAM,
+			Driver:        govmmQemu.VirtioNet,
+			ID:            fmt.Sprintf("network-%d", index),
+			IFName:        ep.Name(),
+			MACAddress:    ep.HardwareAddr(),
+			SocketPath:    ep.SocketPath,
+			DisableModern: nestedRun,
+		}
 	default:
 		return govmmQemu.NetDevice{}, fmt.Errorf("Unknown type for endpoint")
 	}
 
+	// Advertise the MTU of the host interface to the guest driver so that
+	// the tap device, the virtio-net device and the guest interface agree.
+	d.MTU = endpoint.Properties().Iface.MTU
+
 	return d, nil
 }
 
@@ -605,6 +641,8 @@ func (q *qemuArchBase) appendNetwork(_ context.Context, devices []govmmQemu.Devi
 	if err != nil {
 		return devices, fmt.Errorf("Failed to append network %v", err)
 	}
+	d.RSS = q.netRSS
+	d.HashReport = q.netRSS
 	q.networkIndex++
 	devices = append(devices, d)
 	return devices, nil
@@ -699,18 +737,18 @@ func (q *qemuArchBase) appendRNGDevice(_ context.Context, devices []govmmQemu.De
 
 func (q *qemuArchBase) handleImagePath(config HypervisorConfig) {
 	if config.ImagePath != "" {
-		kernelRootParams := commonVirtioblkKernelRootParams
+		rootParams := commonVirtioblkKernelRootParams
 		if !q.disableNvdimm {
 			q.qemuMachine.Options = strings.Join([]string{
 				q.qemuMachine.Options, qemuNvdimmOption,
 			}, ",")
 			if q.dax {
-				kernelRootParams = commonNvdimmKernelRootParams
+				rootParams = commonNvdimmKernelRootParams
 			} else {
-				kernelRootParams = commonNvdimmNoDAXKernelRootParams
+				rootParams = commonNvdimmNoDAXKernelRootParams
 			}
 		}
-		q.kernelParams = append(q.kernelParams, kernelRootParams...)
+		q.kernelParams = append(q.kernelParams, kernelRootParams(rootParams, config.RootfsType)...)
 		q.kernelParamsNonDebug = append(q.kernelParamsNonDebug, kernelParamsSystemdNonDebug...)
 		q.kernelParamsDebug = append(q.kernelParamsDebug, kernelParamsSystemdDebug...)
 	}
@@ -814,6 +852,17 @@ func (q *qemuArchBase) appendPVPanicDevice(devices []govmmQemu.Device) ([]govmmQ
 	return devices, nil
 }
 
+// appendBalloonDevice appends a memory balloon device, deflated by the guest
+// rather than being OOM killed
+func (q *qemuArchBase) appendBalloonDevice(devices []govmmQemu.Device) ([]govmmQemu.Device, error) {
+	devices = append(devices, govmmQemu.BalloonDevice{
+		ID:            balloonDeviceID,
+		DeflateOnOOM:  true,
+		DisableModern: q.nestedRun,
+	})
+	return devices, nil
+}
+
 func (q *qemuArchBase) getPFlash() ([]string, error) {
 	return q.PFlash, nil
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/persist/fs"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
 	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
 )
 
 const (
@@ -500,16 +501,25 @@ func TestQemuArchBaseAppendSCSIController(t *testing.T) {
 
 	expectedOut := []govmmQemu.Device{
 		govmmQemu.SCSIController{
-			ID: scsiControllerID,
+			ID: scsiControllerID(0),
 		},
 	}
 
-	devices, ioThread, err := qemuArchBase.appendSCSIController(context.Background(), devices, false)
+	devices, ioThread, err := qemuArchBase.appendSCSIController(context.Background(), devices, scsiControllerID(0), 0, false)
 	assert.Equal(expectedOut, devices)
 	assert.Nil(ioThread)
 	assert.NoError(err)
 
-	_, ioThread, err = qemuArchBase.appendSCSIController(context.Background(), devices, true)
+	expectedOut = append(expectedOut, govmmQemu.SCSIController{
+		ID:            scsiControllerID(1),
+		VirtQueueSize: 512,
+	})
+	devices, ioThread, err = qemuArchBase.appendSCSIController(context.Background(), devices, scsiControllerID(1), 512, false)
+	assert.Equal(expectedOut, devices)
+	assert.Nil(ioThread)
+	assert.NoError(err)
+
+	_, ioThread, err = qemuArchBase.appendSCSIController(context.Background(), devices, scsiControllerID(2), 0, true)
 	assert.NotNil(ioThread)
 	assert.NoError(err)
 }
@@ -544,6 +554,9 @@ func TestQemuArchBaseAppendNetwork(t *testing.T) {
 		EndpointType: MacvtapEndpointType,
 		EndpointProperties: NetworkInfo{
 			Iface: NetlinkIface{
+				LinkAttrs: netlink.LinkAttrs{
+					MTU: 1450,
+				},
 				Type: "macvtap",
 			},
 		},
@@ -567,6 +580,7 @@ func TestQemuArchBaseAppendNetwork(t *testing.T) {
 			ID:         fmt.Sprintf("network-%d", 1),
 			IFName:     macvtapEp.Name(),
 			MACAddress: macvtapEp.HardwareAddr(),
+			MTU:        1450,
 			DownScript: "no",
 			Script:     "no",
 			FDs:        macvtapEp.VMFds,
@@ -84,7 +84,7 @@ func newQemuArch(config HypervisorConfig) (qemuArch, error) {
 	}
 
 	if config.ImagePath != "" {
-		q.kernelParams = append(q.kernelParams, commonVirtioblkKernelRootParams...)
+		q.kernelParams = append(q.kernelParams, kernelRootParams(commonVirtioblkKernelRootParams, config.RootfsType)...)
 		q.kernelParamsNonDebug = append(q.kernelParamsNonDebug, kernelParamsSystemdNonDebug...)
 		q.kernelParamsDebug = append(q.kernelParamsDebug, kernelParamsSystemdDebug...)
 	}
@@ -204,6 +204,8 @@ func (q *qemuS390x) appendNetwork(ctx context.Context, devices []govmmQemu.Devic
 	if err != nil {
 		return devices, fmt.Errorf("Failed to append network %v", err)
 	}
+	d.RSS = q.netRSS
+	d.HashReport = q.netRSS
 	q.networkIndex++
 	addr, b, err := q.addDeviceToBridge(ctx, d.ID, types.CCW)
 	if err != nil {
@@ -257,8 +259,8 @@ func (q *qemuS390x) append9PVolume(ctx context.Context, devices []govmmQemu.Devi
 	return devices, nil
 }
 
-func (q *qemuS390x) appendSCSIController(ctx context.Context, devices []govmmQemu.Device, enableIOThreads bool) ([]govmmQemu.Device, *govmmQemu.IOThread, error) {
-	d, t := genericSCSIController(enableIOThreads, q.nestedRun)
+func (q *qemuS390x) appendSCSIController(ctx context.Context, devices []govmmQemu.Device, id string, queueDepth uint32, enableIOThreads bool) ([]govmmQemu.Device, *govmmQemu.IOThread, error) {
+	d, t := genericSCSIController(id, queueDepth, enableIOThreads, q.nestedRun)
 	addr, b, err := q.addDeviceToBridge(ctx, d.ID, types.CCW)
 	if err != nil {
 		return devices, nil, fmt.Errorf("Failed to append scsi-controller %v", err)
@@ -10,10 +10,14 @@ package virtcontainers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/kata-containers/kata-containers/src/runtime/pkg/govmm"
 	govmmQemu "github.com/kata-containers/kata-containers/src/runtime/pkg/govmm/qemu"
@@ -198,6 +202,138 @@ func TestQemuMemoryTopology(t *testing.T) {
 	assert.Exactly(memory, expectedOut)
 }
 
+func TestQemuMemoryBalloon(t *testing.T) {
+	assert := assert.New(t)
+
+	q := &qemu{
+		arch: &qemuArchBase{},
+		config: HypervisorConfig{
+			EnableMemoryBalloon: true,
+		},
+	}
+	assert.True(q.memoryBalloon())
+
+	devices, err := q.arch.appendBalloonDevice(nil)
+	assert.NoError(err)
+	assert.Equal([]govmmQemu.Device{
+		govmmQemu.BalloonDevice{ID: balloonDeviceID, DeflateOnOOM: true},
+	}, devices)
+
+	// virtio-mem gives back the memory by itself
+	q.config.VirtioMem = true
+	assert.False(q.memoryBalloon())
+
+	// The memory cannot be hotplugged in protected VMs
+	q.config.VirtioMem = false
+	q.arch = &qemuArchBase{protection: tdxProtection}
+	assert.False(q.memoryBalloon())
+}
+
+// startTestQMPServer serves QMP on a unix socket, recording the value of the
+// balloon commands it gets and failing them when failBalloon is set.
+func startTestQMPServer(t *testing.T, failBalloon *int32) (string, chan uint64) {
+	socket := filepath.Join(t.TempDir(), "qmp.sock")
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	balloons := make(chan uint64, 8)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		fmt.Fprintln(conn, `{"QMP": {"version": {"qemu": {"major": 6, "minor": 2, "micro": 0}, "package": ""}, "capabilities": []}}`)
+		decoder := json.NewDecoder(conn)
+		for {
+			var cmd struct {
+				Execute   string                 `json:"execute"`
+				Arguments map[string]interface{} `json:"arguments"`
+			}
+			if err := decoder.Decode(&cmd); err != nil {
+				return
+			}
+			if cmd.Execute == "balloon" {
+				if atomic.LoadInt32(failBalloon) != 0 {
+					fmt.Fprintln(conn, `{"error": {"class": "GenericError", "desc": "balloon failed"}}`)
+					continue
+				}
+				balloons <- uint64(cmd.Arguments["value"].(float64))
+			}
+			fmt.Fprintln(conn, `{"return": {}}`)
+		}
+	}()
+
+	return socket, balloons
+}
+
+func TestQemuResizeMemoryBalloon(t *testing.T) {
+	assert := assert.New(t)
+
+	var failBalloon int32
+	socket, balloons := startTestQMPServer(t, &failBalloon)
+
+	q := &qemu{
+		arch: &qemuArchBase{},
+		config: HypervisorConfig{
+			MemorySize:          2048,
+			EnableMemoryBalloon: true,
+		},
+		qmpMonitorCh: qmpChannel{
+			ctx:  context.Background(),
+			path: socket,
+		},
+	}
+	q.state.HotpluggedMemory = 1024
+	defer q.qmpShutdown()
+
+	nextBalloon := func() uint64 {
+		select {
+		case value := <-balloons:
+			return value
+		case <-time.After(5 * time.Second):
+			t.Fatal("no balloon command received")
+			return 0
+		}
+	}
+
+	// The balloon takes back the hotplugged memory not needed anymore
+	memory, _, err := q.ResizeMemory(context.Background(), 2560, 128, false)
+	assert.NoError(err)
+	assert.Equal(uint32(2560), memory)
+	assert.Equal(uint64(2560)<<utils.MibToBytesShift, nextBalloon())
+	assert.Equal(512, q.state.HotpluggedMemory)
+	assert.Equal(512, q.state.BalloonedMemory)
+
+	// but not the memory the VM booted with
+	memory, _, err = q.ResizeMemory(context.Background(), 1024, 128, false)
+	assert.NoError(err)
+	assert.Equal(uint32(2048), memory)
+	assert.Equal(uint64(2048)<<utils.MibToBytesShift, nextBalloon())
+	assert.Equal(0, q.state.HotpluggedMemory)
+	assert.Equal(1024, q.state.BalloonedMemory)
+
+	// The ballooned memory is given back before hotplugging memory
+	memory, _, err = q.ResizeMemory(context.Background(), 2560, 128, false)
+	assert.NoError(err)
+	assert.Equal(uint32(2560), memory)
+	assert.Equal(uint64(2560)<<utils.MibToBytesShift, nextBalloon())
+	assert.Equal(512, q.state.HotpluggedMemory)
+	assert.Equal(512, q.state.BalloonedMemory)
+
+	// The memory is left as is when the balloon cannot be resized
+	atomic.StoreInt32(&failBalloon, 1)
+	memory, _, err = q.ResizeMemory(context.Background(), 2048, 128, false)
+	assert.Error(err)
+	assert.Equal(uint32(2560), memory)
+	assert.Equal(512, q.state.HotpluggedMemory)
+	assert.Equal(512, q.state.BalloonedMemory)
+}
+
 func TestQemuKnobs(t *testing.T) {
 	assert := assert.New(t)
 
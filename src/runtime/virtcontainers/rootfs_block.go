@@ -0,0 +1,252 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/grpc"
+	vcAnnotations "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/annotations"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/utils"
+)
+
+const erofsLayerFsType = "erofs"
+
+// getMountSourceAndFsType returns the source and the filesystem type of the
+// filesystem mounted on a path.
+var getMountSourceAndFsType = func(mountPoint string) (string, string, error) {
+	source, fsType, _, err := utils.GetDevicePathAndFsTypeOptions(mountPoint)
+	return source, fsType, err
+}
+
+// erofsLayer is an image layer of the container rootfs the snapshotter
+// mounted on the host from an EROFS blob, as the erofs snapshotter does.
+type erofsLayer struct {
+	// source is the loop device the blob is attached to, or the blob
+	// itself for the file backed mounts.
+	source   string
+	loopFile bool
+	size     uint64
+}
+
+// getErofsLayer returns the EROFS filesystem mounted on a layer directory,
+// or nil when the layer is not one.
+func getErofsLayer(layer string) (*erofsLayer, error) {
+	source, fsType, err := getMountSourceAndFsType(layer)
+	if err != nil || fsType != erofsLayerFsType {
+		return nil, nil
+	}
+
+	st, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := utils.GetBlockDeviceSize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &erofsLayer{
+		source:   source,
+		loopFile: st.Mode().IsRegular(),
+		size:     size,
+	}, nil
+}
+
+// useErofsLayers tells if the rootfs of the container is built in the guest
+// from its image layers, attached as read-only block devices, rather than
+// shared with the guest as a whole. This is the case for the overlay rootfs
+// whose layers are all EROFS filesystems mounted by the snapshotter, when
+// the size of the layers exceeds the configured threshold, unless the
+// container annotations decide otherwise. It returns the layers to attach.
+// Rootfs backed by a devmapper device are attached as a whole, whatever
+// their size, by hotplugDrive.
+func (c *Container) useErofsLayers() ([]*erofsLayer, error) {
+	hypervisorConfig := &c.sandbox.config.HypervisorConfig
+	if hypervisorConfig.SharedFS == config.NoSharedFS || c.rootFs.Type != typeOverlayFS {
+		return nil, nil
+	}
+
+	threshold := hypervisorConfig.BlockRootfsThresholdMB << utils.MibToBytesShift
+
+	forced := false
+	if value, ok := c.config.Annotations[vcAnnotations.ContainerRootfsBlock]; ok {
+		use, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid container configuration Annotations %s %v", vcAnnotations.ContainerRootfsBlock, err)
+		}
+		if !use {
+			return nil, nil
+		}
+		forced = true
+	} else if threshold == 0 {
+		return nil, nil
+	}
+
+	lowers, upper, work := overlayLayers(c.rootFs.Options)
+	// The upper and work directories are shared together
+	if len(lowers) == 0 || upper == "" || filepath.Dir(upper) != filepath.Dir(work) {
+		return nil, nil
+	}
+
+	var layers []*erofsLayer
+	var size uint64
+	for _, lower := range lowers {
+		layer, err := getErofsLayer(lower)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the block device of layer %s: %v", lower, err)
+		}
+		if layer == nil {
+			c.Logger().WithField("layer", lower).Info("Rootfs layer is not backed by a block device, sharing the rootfs")
+			return nil, nil
+		}
+		layers = append(layers, layer)
+		size += layer.size
+	}
+
+	use := forced || size > threshold
+	c.Logger().WithFields(logrus.Fields{
+		"layers":      len(layers),
+		"layers-size": size,
+		"threshold":   threshold,
+		"forced":      forced,
+		"use-block":   use,
+	}).Info("Rootfs block device fallback decision")
+
+	if !use {
+		return nil, nil
+	}
+	return layers, nil
+}
+
+// plugErofsLayers attaches the block devices of the EROFS layers of the
+// container rootfs to the VM, read-only, for the agent to build the rootfs
+// overlay from them and from the snapshot directory of the container,
+// which stays shared with the guest. The same layers are attached once to
+// the VM, whatever the number of containers running them. The devices are
+// recorded as they are attached, for removeDrive to release them if the
+// creation of the container fails.
+func (c *Container) plugErofsLayers(ctx context.Context, layers []*erofsLayer) error {
+	for _, layer := range layers {
+		var devID string
+		var err error
+		if layer.loopFile {
+			devID, err = c.plugBlockDevice(ctx, layer.source, "", true)
+		} else {
+			devID, err = c.plugLayerDevice(ctx, layer.source)
+		}
+		if devID != "" {
+			c.state.LayerDeviceIDs = append(c.state.LayerDeviceIDs, devID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	c.Logger().WithField("layers", len(layers)).Info("Attached EROFS rootfs layers as block devices")
+
+	return nil
+}
+
+// plugLayerDevice attaches a block device holding a layer to the VM,
+// read-only, and returns its ID.
+func (c *Container) plugLayerDevice(ctx context.Context, devicePath string) (string, error) {
+	var stat unix.Stat_t
+	if err := unix.Stat(devicePath, &stat); err != nil {
+		return "", fmt.Errorf("stat %q failed: %v", devicePath, err)
+	}
+
+	b, err := c.sandbox.devManager.NewDevice(config.DeviceInfo{
+		HostPath: devicePath,
+		DevType:  "b",
+		Major:    int64(unix.Major(uint64(stat.Rdev))),
+		Minor:    int64(unix.Minor(uint64(stat.Rdev))),
+		ReadOnly: true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("device manager failed to create layer device for %q: %v", devicePath, err)
+	}
+
+	return b.DeviceID(), c.sandbox.devManager.AttachDevice(ctx, b.DeviceID(), c.sandbox)
+}
+
+// shareRootFilesystemWithErofsLayers shares the snapshot directory of the
+// container, holding the upper and work directories of its rootfs, with
+// the guest, and returns the storage of the rootfs overlay the agent builds
+// from it and from the EROFS layers, which it mounts first. The writes of
+// the container thus go to its snapshot on the host.
+func (f *FilesystemShare) shareRootFilesystemWithErofsLayers(ctx context.Context, c *Container) (*SharedFile, error) {
+	_, upper, work := overlayLayers(c.rootFs.Options)
+
+	var deps []*grpc.Storage
+	var lowers []string
+	for _, devID := range c.state.LayerDeviceIDs {
+		layer, _, err := f.blockDeviceStorage(devID)
+		if err != nil {
+			return nil, err
+		}
+		// The layers are mounted once for the sandbox
+		layer.MountPoint = filepath.Join(kataGuestSandboxStorageDir(), layersDir, devID)
+		layer.Fstype = erofsLayerFsType
+		layer.Options = []string{"ro"}
+
+		deps = append(deps, layer)
+		lowers = append(lowers, layer.MountPoint)
+	}
+
+	rootfsGuestPath := filepath.Join(kataGuestSharedDir(), c.id, c.rootfsSuffix)
+	containerShareDir := filepath.Join(getMountPath(f.sandbox.ID()), c.id)
+
+	// mkdir rootfs, guest at /run/kata-containers/shared/containers/<cid>/rootfs
+	if err := os.MkdirAll(filepath.Join(containerShareDir, c.rootfsSuffix), DirMode); err != nil {
+		return nil, err
+	}
+
+	// bindmount the snapshot dir holding the upper and work dirs
+	// to guest /run/kata-containers/shared/containers/<cid>/snapshotdir
+	if err := bindMount(ctx, filepath.Dir(upper), filepath.Join(containerShareDir, snapshotDir), false, "slave"); err != nil {
+		return nil, err
+	}
+
+	rootfs := &grpc.Storage{
+		Driver:     kataOverlayDevType,
+		Source:     typeOverlayFS,
+		Fstype:     typeOverlayFS,
+		MountPoint: rootfsGuestPath,
+		Options: []string{
+			fmt.Sprintf("%s=%s", lowerDir, strings.Join(lowers, ":")),
+			fmt.Sprintf("%s=%s", upperDir, filepath.Join(kataGuestSharedDir(), c.id, snapshotDir, filepath.Base(upper))),
+			fmt.Sprintf("%s=%s", workDir, filepath.Join(kataGuestSharedDir(), c.id, snapshotDir, filepath.Base(work))),
+			"index=off",
+		},
+	}
+
+	return &SharedFile{
+		storage:   rootfs,
+		guestPath: rootfsGuestPath,
+		deps:      deps,
+	}, nil
+}
+
+// erofsLayersContainerCleanup unmounts the snapshot directory shared for a
+// rootfs built from EROFS layers.
+func erofsLayersContainerCleanup(ctx context.Context, sharedDir string, c *Container) error {
+	if err := bindUnmountContainerSnapshotDir(ctx, sharedDir, c.id); err != nil {
+		return err
+	}
+	return syscall.Rmdir(filepath.Join(sharedDir, c.id, c.rootfsSuffix))
+}
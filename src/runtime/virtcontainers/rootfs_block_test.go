@@ -0,0 +1,104 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
+	vcAnnotations "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/annotations"
+)
+
+func TestUseErofsLayers(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	blobs := map[string]string{}
+	for _, layer := range []string{"1", "2"} {
+		blob := filepath.Join(dir, layer, "layer.erofs")
+		assert.NoError(os.MkdirAll(filepath.Dir(blob), DirMode))
+		assert.NoError(os.WriteFile(blob, make([]byte, 1<<20), 0644))
+		blobs[filepath.Join(dir, layer, "fs")] = blob
+	}
+	plainLayer := filepath.Join(dir, "3", "fs")
+
+	savedGetMountSourceAndFsType := getMountSourceAndFsType
+	defer func() {
+		getMountSourceAndFsType = savedGetMountSourceAndFsType
+	}()
+	getMountSourceAndFsType = func(mountPoint string) (string, string, error) {
+		if blob, ok := blobs[mountPoint]; ok {
+			return blob, erofsLayerFsType, nil
+		}
+		return "", "", fmt.Errorf("Mount %s not found", mountPoint)
+	}
+
+	newContainer := func(sharedFS string, thresholdMB uint64, annotations map[string]string, lowers string) *Container {
+		return &Container{
+			id: "container",
+			sandbox: &Sandbox{
+				config: &SandboxConfig{
+					HypervisorConfig: HypervisorConfig{
+						SharedFS:               sharedFS,
+						BlockRootfsThresholdMB: thresholdMB,
+					},
+				},
+			},
+			config: &ContainerConfig{
+				Annotations: annotations,
+			},
+			rootFs: RootFs{
+				Type: typeOverlayFS,
+				Options: []string{
+					"lowerdir=" + lowers,
+					"upperdir=" + filepath.Join(dir, "4", "fs"),
+					"workdir=" + filepath.Join(dir, "4", "work"),
+				},
+			},
+		}
+	}
+
+	erofsLowers := filepath.Join(dir, "2", "fs") + ":" + filepath.Join(dir, "1", "fs")
+
+	for _, d := range []struct {
+		name        string
+		sharedFS    string
+		thresholdMB uint64
+		annotations map[string]string
+		lowers      string
+		use         bool
+		err         bool
+	}{
+		{"disabled", config.VirtioFS, 0, nil, erofsLowers, false, false},
+		{"below threshold", config.VirtioFS, 4, nil, erofsLowers, false, false},
+		{"above threshold", config.VirtioFS, 1, nil, erofsLowers, true, false},
+		{"forced", config.VirtioFS, 0, map[string]string{vcAnnotations.ContainerRootfsBlock: "true"}, erofsLowers, true, false},
+		{"disabled above threshold", config.VirtioFS, 1, map[string]string{vcAnnotations.ContainerRootfsBlock: "false"}, erofsLowers, false, false},
+		{"invalid annotation", config.VirtioFS, 1, map[string]string{vcAnnotations.ContainerRootfsBlock: "sometimes"}, erofsLowers, false, true},
+		{"layer not on a block device", config.VirtioFS, 1, nil, erofsLowers + ":" + plainLayer, false, false},
+		{"no shared fs", config.NoSharedFS, 1, nil, erofsLowers, false, false},
+	} {
+		layers, err := newContainer(d.sharedFS, d.thresholdMB, d.annotations, d.lowers).useErofsLayers()
+		if d.err {
+			assert.Error(err, d.name)
+			continue
+		}
+		assert.NoError(err, d.name)
+		if !d.use {
+			assert.Nil(layers, d.name)
+			continue
+		}
+		assert.Equal([]*erofsLayer{
+			{source: blobs[filepath.Join(dir, "2", "fs")], loopFile: true, size: 1 << 20},
+			{source: blobs[filepath.Join(dir, "1", "fs")], loopFile: true, size: 1 << 20},
+		}, layers, d.name)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	sectorSize = 512
+
+	// MBR partition table layout
+	mbrSignatureOffset  = 510
+	mbrPartition1Offset = 446
+	mbrPartitionLBA     = 8
+
+	// Filesystem superblock magics, relative to the start of the partition
+	ext4MagicOffset  = 1024 + 0x38
+	ext4Magic        = 0xEF53
+	erofsMagicOffset = 1024
+	erofsMagic       = 0xE0F5E1E2
+	xfsMagicOffset   = 0
+	xfsMagic         = "XFSB"
+)
+
+// detectRootfsType returns the filesystem type of a guest image, reading the
+// superblock of its first partition, or of the image itself when it has no
+// partition table. An empty string is returned if the type is unknown.
+func detectRootfsType(imagePath string) (string, error) {
+	f, err := os.Open(imagePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	mbr := make([]byte, sectorSize)
+	if _, err := io.ReadFull(f, mbr); err != nil {
+		return "", fmt.Errorf("failed to read image %s: %v", imagePath, err)
+	}
+
+	if rootfsType, err := detectFsType(f, 0); rootfsType != "" || err != nil {
+		return rootfsType, err
+	}
+
+	if mbr[mbrSignatureOffset] != 0x55 || mbr[mbrSignatureOffset+1] != 0xAA {
+		return "", nil
+	}
+
+	lba := binary.LittleEndian.Uint32(mbr[mbrPartition1Offset+mbrPartitionLBA:])
+	if lba == 0 {
+		return "", nil
+	}
+
+	return detectFsType(f, int64(lba)*sectorSize)
+}
+
+// detectFsType looks for a known filesystem superblock at the given offset.
+func detectFsType(r io.ReaderAt, offset int64) (string, error) {
+	buf := make([]byte, 4)
+
+	read := func(off int64, size int) ([]byte, error) {
+		n, err := r.ReadAt(buf[:size], offset+off)
+		if err == io.EOF && n < size {
+			return nil, nil
+		}
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		return buf[:size], nil
+	}
+
+	magic, err := read(erofsMagicOffset, 4)
+	if err != nil {
+		return "", err
+	}
+	if magic != nil && binary.LittleEndian.Uint32(magic) == erofsMagic {
+		return RootfsTypeEROFS, nil
+	}
+
+	magic, err = read(ext4MagicOffset, 2)
+	if err != nil {
+		return "", err
+	}
+	if magic != nil && binary.LittleEndian.Uint16(magic) == ext4Magic {
+		return RootfsTypeExt4, nil
+	}
+
+	magic, err = read(xfsMagicOffset, 4)
+	if err != nil {
+		return "", err
+	}
+	if magic != nil && bytes.Equal(magic, []byte(xfsMagic)) {
+		return RootfsTypeXFS, nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestImage(t *testing.T, partitioned bool, setMagic func(fs []byte)) string {
+	fs := make([]byte, 4096)
+	setMagic(fs)
+
+	image := fs
+	if partitioned {
+		const startLBA = 2048
+		image = make([]byte, startLBA*sectorSize+len(fs))
+		image[mbrSignatureOffset] = 0x55
+		image[mbrSignatureOffset+1] = 0xAA
+		binary.LittleEndian.PutUint32(image[mbrPartition1Offset+mbrPartitionLBA:], startLBA)
+		copy(image[startLBA*sectorSize:], fs)
+	}
+
+	path := filepath.Join(t.TempDir(), "kata-containers.img")
+	err := os.WriteFile(path, image, 0600)
+	assert.NoError(t, err)
+
+	return path
+}
+
+func TestDetectRootfsType(t *testing.T) {
+	assert := assert.New(t)
+
+	erofs := func(fs []byte) { binary.LittleEndian.PutUint32(fs[erofsMagicOffset:], erofsMagic) }
+	ext4 := func(fs []byte) { binary.LittleEndian.PutUint16(fs[ext4MagicOffset:], ext4Magic) }
+	xfs := func(fs []byte) { copy(fs[xfsMagicOffset:], xfsMagic) }
+	unknown := func(fs []byte) {}
+
+	for _, d := range []struct {
+		setMagic    func(fs []byte)
+		partitioned bool
+		expected    string
+	}{
+		{erofs, false, RootfsTypeEROFS},
+		{erofs, true, RootfsTypeEROFS},
+		{ext4, false, RootfsTypeExt4},
+		{ext4, true, RootfsTypeExt4},
+		{xfs, true, RootfsTypeXFS},
+		{unknown, false, ""},
+		{unknown, true, ""},
+	} {
+		rootfsType, err := detectRootfsType(writeTestImage(t, d.partitioned, d.setMagic))
+		assert.NoError(err)
+		assert.Equal(d.expected, rootfsType)
+	}
+
+	_, err := detectRootfsType(filepath.Join(t.TempDir(), "nonexistent"))
+	assert.Error(err)
+
+	// Too small to hold a partition table
+	path := filepath.Join(t.TempDir(), "empty.img")
+	assert.NoError(os.WriteFile(path, nil, 0600))
+	_, err = detectRootfsType(path)
+	assert.Error(err)
+}
@@ -0,0 +1,412 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/grpc"
+)
+
+// layerImagesDir holds the block device images built from the image layers
+// of the container rootfs when no file system is shared with the guest. As
+// the layers are never modified once committed, the images are kept for
+// the next containers running them, in this sandbox or another one, and
+// removed by pruneLayerImages once unused for layerImagesMaxAge.
+var layerImagesDir = "/var/lib/kata-containers/layer-images"
+
+// rootfsImagesDir holds the scratch disks of the containers. It must not be
+// on a tmpfs, the containers writing to them.
+var rootfsImagesDir = "/var/lib/kata-containers/rootfs-images"
+
+const (
+	blockLayerFsType = "ext4"
+
+	// Room left in a layer image for the filesystem metadata, on top of
+	// a quarter of the size of the layer.
+	layerImageExtraSpace = 64 << 20
+
+	// Free space left in the scratch disk for the container to write to.
+	scratchDiskExtraSpace = 1 << 30
+
+	// layerImagesMaxAge is how long a layer image is kept once no
+	// container is created from it.
+	layerImagesMaxAge = 7 * 24 * time.Hour
+
+	// ext4BytesPerInode is the default inode ratio of mkfs.ext4.
+	ext4BytesPerInode = 16384
+
+	// scratchDir is the guest directory of a container the scratch disk,
+	// holding the upper and work directories of its rootfs, is mounted on.
+	scratchDir = "scratch"
+)
+
+// useBlockLayers tells if the rootfs of the container is built in the guest
+// as an overlay of its image layers, attached as read-only block devices,
+// and of a writable scratch disk. This is the case for the overlay rootfs
+// when no file system is shared with the guest.
+func (c *Container) useBlockLayers() bool {
+	if c.sandbox.config.HypervisorConfig.SharedFS != config.NoSharedFS || c.rootFs.Type != typeOverlayFS {
+		return false
+	}
+
+	lowers, upper, work := overlayLayers(c.rootFs.Options)
+	// The upper and work directories are copied together
+	return len(lowers) > 0 && upper != "" && filepath.Dir(upper) == filepath.Dir(work)
+}
+
+// layerImageUsage returns the size of the regular files of a layer and its
+// number of entries, for the layer image to hold as many inodes.
+func layerImageUsage(path string) (size uint64, inodes uint64, err error) {
+	err = filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		inodes++
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += uint64(info.Size())
+		return nil
+	})
+
+	return size, inodes, err
+}
+
+// buildLayerImage returns the image of a layer, creating it when missing.
+// The image is filled from the layer directory, keeping the whiteouts and
+// the overlay extended attributes of its files, and is built aside first
+// as other sandboxes may be building the same image.
+func buildLayerImage(layer string) (string, error) {
+	key, err := layerKey(layer)
+	if err != nil {
+		return "", err
+	}
+
+	image := filepath.Join(layerImagesDir, key+".img")
+	err = withLayerImagesLock(syscall.LOCK_SH, func() error {
+		return buildLayerImageLocked(layer, key, image)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return image, nil
+}
+
+// buildLayerImageLocked builds the image of a layer when missing, and marks
+// it used otherwise, for pruneLayerImages to keep it.
+func buildLayerImageLocked(layer, key, image string) error {
+	now := time.Now()
+	if err := os.Chtimes(image, now, now); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	size, inodes, err := layerImageUsage(layer)
+	if err != nil {
+		return fmt.Errorf("failed to get the size of layer %s: %v", layer, err)
+	}
+	imageSize := size + size/4 + layerImageExtraSpace
+
+	tmp, err := os.CreateTemp(layerImagesDir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp.Close()
+
+	// The image is only read, it needs no journal
+	cmd := exec.Command("mkfs."+blockLayerFsType, "-q", "-F", "-O", "^has_journal",
+		"-N", strconv.FormatUint(inodes+inodes/4+16, 10),
+		"-d", layer, tmp.Name(), strconv.FormatUint(imageSize>>10, 10))
+	if output, err := cmd.CombinedOutput(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to build layer image %s: %v: %s", image, err, output)
+	}
+
+	if err := os.Rename(tmp.Name(), image); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return nil
+}
+
+// withLayerImagesLock runs f holding a lock on the layer images directory:
+// a shared one to use or build images, an exclusive one to remove them.
+func withLayerImagesLock(how int, f func() error) error {
+	if err := os.MkdirAll(layerImagesDir, DirMode); err != nil {
+		return err
+	}
+
+	dir, err := os.Open(layerImagesDir)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+
+	if err := syscall.Flock(int(dir.Fd()), how); err != nil {
+		return err
+	}
+	defer syscall.Flock(int(dir.Fd()), syscall.LOCK_UN)
+
+	return f()
+}
+
+// loopBackingFiles returns the image files attached to the loop devices of
+// the node.
+func loopBackingFiles() map[string]bool {
+	files := make(map[string]bool)
+
+	paths, _ := filepath.Glob("/sys/block/loop*/loop/backing_file")
+	for _, path := range paths {
+		if data, err := os.ReadFile(path); err == nil {
+			files[strings.TrimSpace(string(data))] = true
+		}
+	}
+
+	return files
+}
+
+// pruneLayerImages removes the layer images no container was created from
+// for layerImagesMaxAge, along with the ones left half-built, unless they
+// are still attached to a VM. Removing an attached image would only make
+// the next container running the layer build it again, the loop device
+// keeping the file of the VM. The images are not pruned while sandboxes
+// use or build them, the next pruning removing them.
+func pruneLayerImages() error {
+	if _, err := os.Stat(layerImagesDir); os.IsNotExist(err) {
+		return nil
+	}
+
+	err := withLayerImagesLock(syscall.LOCK_EX|syscall.LOCK_NB, func() error {
+		entries, err := os.ReadDir(layerImagesDir)
+		if err != nil {
+			return err
+		}
+
+		attached := loopBackingFiles()
+		for _, e := range entries {
+			path := filepath.Join(layerImagesDir, e.Name())
+			if !e.Type().IsRegular() || attached[path] {
+				continue
+			}
+			info, err := e.Info()
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return err
+			}
+			if time.Since(info.ModTime()) < layerImagesMaxAge {
+				continue
+			}
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			virtLog.WithField("layer-image", path).Info("Removed unused layer image")
+		}
+
+		return nil
+	})
+	if err == syscall.EWOULDBLOCK {
+		return nil
+	}
+
+	return err
+}
+
+func (c *Container) scratchImagePath() string {
+	return filepath.Join(rootfsImagesDir, c.sandboxID+"-"+c.id+"-scratch.img")
+}
+
+// buildScratchImage creates the scratch disk of the container, holding a
+// copy of its snapshot directory, that is the upper directory, which may
+// have been written to before the container is created, and the work one.
+func buildScratchImage(snapshot, image string) error {
+	size, inodes, err := layerImageUsage(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to get the size of snapshot %s: %v", snapshot, err)
+	}
+	imageSize := size + scratchDiskExtraSpace
+
+	if err := os.MkdirAll(filepath.Dir(image), DirMode); err != nil {
+		return err
+	}
+
+	// The files the container creates get the inodes of the default ext4
+	// inode ratio, on top of the ones of the files of the snapshot.
+	inodes += inodes/4 + imageSize/ext4BytesPerInode
+	cmd := exec.Command("mkfs."+blockLayerFsType, "-q", "-F", "-N", strconv.FormatUint(inodes, 10),
+		"-d", snapshot, image, strconv.FormatUint(imageSize>>10, 10))
+	if output, err := cmd.CombinedOutput(); err != nil {
+		os.Remove(image)
+		return fmt.Errorf("failed to build scratch image %s: %v: %s", image, err, output)
+	}
+
+	return nil
+}
+
+// plugBlockDevice attaches an image file to the VM through a loop device,
+// and returns the ID of its block device.
+func (c *Container) plugBlockDevice(ctx context.Context, image, guestPath string, readOnly bool) (string, error) {
+	b, err := c.sandbox.devManager.NewDevice(config.DeviceInfo{
+		HostPath:      image,
+		ContainerPath: guestPath,
+		DevType:       "b",
+		LoopFile:      true,
+		ReadOnly:      readOnly,
+		Ublk:          c.sandbox.ublkConfig(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("device manager failed to create rootfs device for %q: %v", image, err)
+	}
+
+	return b.DeviceID(), c.sandbox.devManager.AttachDevice(ctx, b.DeviceID(), c.sandbox)
+}
+
+// plugBlockLayers attaches the images of the layers of the container rootfs
+// to the VM, read-only, along with a scratch disk for the container to
+// write to, for the agent to build the rootfs overlay from them. The same
+// layers are attached once to the VM, whatever the number of containers
+// running them. The devices are recorded as they are attached, for
+// removeDrive to release them if the creation of the container fails.
+func (c *Container) plugBlockLayers(ctx context.Context) error {
+	lowers, upper, _ := overlayLayers(c.rootFs.Options)
+
+	for _, lower := range lowers {
+		image, err := buildLayerImage(lower)
+		if err != nil {
+			return err
+		}
+
+		devID, err := c.plugBlockDevice(ctx, image, "", true)
+		if devID != "" {
+			c.state.LayerDeviceIDs = append(c.state.LayerDeviceIDs, devID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	image := c.scratchImagePath()
+	if err := buildScratchImage(filepath.Dir(upper), image); err != nil {
+		return err
+	}
+
+	devID, err := c.plugBlockDevice(ctx, image, filepath.Join(kataGuestSharedDir(), c.id, scratchDir), false)
+	if devID != "" {
+		c.state.ScratchDeviceID = devID
+	}
+	if err != nil {
+		return err
+	}
+
+	c.Logger().WithFields(logrus.Fields{
+		"layers":  len(lowers),
+		"scratch": image,
+	}).Info("Attached rootfs layers as block devices")
+
+	return nil
+}
+
+// unplugBlockLayers detaches the layers and the scratch disk of the
+// container rootfs, removes the scratch disk, and prunes the layer images
+// no longer used.
+func (c *Container) unplugBlockLayers(ctx context.Context) error {
+	for len(c.state.LayerDeviceIDs) > 0 {
+		if err := c.removeBlockDevice(ctx, c.state.LayerDeviceIDs[0]); err != nil {
+			return err
+		}
+		c.state.LayerDeviceIDs = c.state.LayerDeviceIDs[1:]
+	}
+	c.state.LayerDeviceIDs = nil
+
+	if c.state.ScratchDeviceID != "" {
+		if err := c.removeBlockDevice(ctx, c.state.ScratchDeviceID); err != nil {
+			return err
+		}
+		c.state.ScratchDeviceID = ""
+	}
+
+	if err := os.Remove(c.scratchImagePath()); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := pruneLayerImages(); err != nil {
+		c.Logger().WithError(err).Warn("Could not prune layer images")
+	}
+
+	return nil
+}
+
+// shareRootFilesystemWithBlockLayers returns the storage of the rootfs
+// overlay the agent builds from the layers and the scratch disk of the
+// container, which it mounts first.
+func (f *FilesystemShare) shareRootFilesystemWithBlockLayers(c *Container) (*SharedFile, error) {
+	_, upper, work := overlayLayers(c.rootFs.Options)
+
+	var deps []*grpc.Storage
+	var lowers []string
+	for _, devID := range c.state.LayerDeviceIDs {
+		layer, _, err := f.blockDeviceStorage(devID)
+		if err != nil {
+			return nil, err
+		}
+		// The layers are mounted once for the sandbox
+		layer.MountPoint = filepath.Join(kataGuestSandboxStorageDir(), layersDir, devID)
+		layer.Fstype = blockLayerFsType
+		layer.Options = []string{"ro"}
+
+		deps = append(deps, layer)
+		lowers = append(lowers, layer.MountPoint)
+	}
+
+	scratch, _, err := f.blockDeviceStorage(c.state.ScratchDeviceID)
+	if err != nil {
+		return nil, err
+	}
+	scratch.MountPoint = filepath.Join(kataGuestSharedDir(), c.id, scratchDir)
+	scratch.Fstype = blockLayerFsType
+	deps = append(deps, scratch)
+
+	rootfsGuestPath := filepath.Join(kataGuestSharedDir(), c.id, c.rootfsSuffix)
+	rootfs := &grpc.Storage{
+		Driver:     kataOverlayDevType,
+		Source:     typeOverlayFS,
+		Fstype:     typeOverlayFS,
+		MountPoint: rootfsGuestPath,
+		Options: []string{
+			fmt.Sprintf("%s=%s", lowerDir, strings.Join(lowers, ":")),
+			fmt.Sprintf("%s=%s", upperDir, filepath.Join(scratch.MountPoint, filepath.Base(upper))),
+			fmt.Sprintf("%s=%s", workDir, filepath.Join(scratch.MountPoint, filepath.Base(work))),
+			"index=off",
+		},
+	}
+
+	return &SharedFile{
+		storage:   rootfs,
+		guestPath: rootfsGuestPath,
+		deps:      deps,
+	}, nil
+}
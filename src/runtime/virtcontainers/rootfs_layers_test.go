@@ -0,0 +1,110 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
+)
+
+func TestUseBlockLayers(t *testing.T) {
+	assert := assert.New(t)
+
+	newContainer := func(sharedFS string, rootFs RootFs) *Container {
+		return &Container{
+			sandbox: &Sandbox{
+				config: &SandboxConfig{
+					HypervisorConfig: HypervisorConfig{
+						SharedFS: sharedFS,
+					},
+				},
+			},
+			rootFs: rootFs,
+		}
+	}
+
+	overlay := RootFs{
+		Type: typeOverlayFS,
+		Options: []string{
+			"lowerdir=/snapshots/2/fs:/snapshots/1/fs",
+			"upperdir=/snapshots/3/fs",
+			"workdir=/snapshots/3/work",
+		},
+	}
+
+	assert.True(newContainer(config.NoSharedFS, overlay).useBlockLayers())
+	assert.False(newContainer(config.VirtioFS, overlay).useBlockLayers())
+	assert.False(newContainer(config.NoSharedFS, RootFs{Type: "ext4"}).useBlockLayers())
+	assert.False(newContainer(config.NoSharedFS, RootFs{
+		Type:    typeOverlayFS,
+		Options: []string{"lowerdir=/snapshots/1/fs"},
+	}).useBlockLayers())
+	assert.False(newContainer(config.NoSharedFS, RootFs{
+		Type: typeOverlayFS,
+		Options: []string{
+			"lowerdir=/snapshots/1/fs",
+			"upperdir=/upper/fs",
+			"workdir=/work/work",
+		},
+	}).useBlockLayers())
+}
+
+func TestLayerImageUsage(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	assert.NoError(os.MkdirAll(filepath.Join(dir, "usr", "bin"), DirMode))
+	assert.NoError(os.WriteFile(filepath.Join(dir, "usr", "bin", "sh"), make([]byte, 4000), 0755))
+	assert.NoError(os.Symlink("sh", filepath.Join(dir, "usr", "bin", "bash")))
+
+	size, inodes, err := layerImageUsage(dir)
+	assert.NoError(err)
+	assert.Equal(uint64(4000), size)
+	// the layer, usr, bin, sh and bash
+	assert.Equal(uint64(5), inodes)
+
+	_, _, err = layerImageUsage(filepath.Join(dir, "missing"))
+	assert.Error(err)
+}
+
+func TestPruneLayerImages(t *testing.T) {
+	assert := assert.New(t)
+
+	savedLayerImagesDir := layerImagesDir
+	defer func() { layerImagesDir = savedLayerImagesDir }()
+	layerImagesDir = filepath.Join(t.TempDir(), "layer-images")
+
+	// nothing to prune before the first image is built
+	assert.NoError(pruneLayerImages())
+
+	assert.NoError(os.MkdirAll(layerImagesDir, DirMode))
+	old := time.Now().Add(-layerImagesMaxAge - time.Hour)
+	for _, name := range []string{"unused.img", "stale.img.1234.tmp", "used.img"} {
+		path := filepath.Join(layerImagesDir, name)
+		assert.NoError(os.WriteFile(path, nil, 0600))
+		assert.NoError(os.Chtimes(path, old, old))
+	}
+	assert.NoError(os.WriteFile(filepath.Join(layerImagesDir, "recent.img"), nil, 0600))
+
+	// a container created from the image marks it used
+	assert.NoError(buildLayerImageLocked(t.TempDir(), "used", filepath.Join(layerImagesDir, "used.img")))
+
+	assert.NoError(pruneLayerImages())
+
+	entries, err := os.ReadDir(layerImagesDir)
+	assert.NoError(err)
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	assert.Equal([]string{"recent.img", "used.img"}, names)
+}
@@ -10,6 +10,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"math"
@@ -17,8 +18,12 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
+	"time"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
@@ -99,7 +104,17 @@ type SandboxStatus struct {
 // SandboxStats describes a sandbox's stats
 type SandboxStats struct {
 	CgroupStats CgroupStats
-	Cpus        int
+	// NetworkStats holds the host side statistics of the sandbox
+	// network interfaces.
+	NetworkStats []*NetworkStats
+	Cpus         int
+}
+
+// AllocatedResources describes the resources currently allocated to the VM of
+// a sandbox, including the hotplugged ones.
+type AllocatedResources struct {
+	VCPUs    uint32
+	MemoryMB uint32
 }
 
 type SandboxResourceSizing struct {
@@ -164,7 +179,61 @@ type SandboxConfig struct {
 	// SandboxCgroupOnly enables cgroup only at podlevel in the host
 	SandboxCgroupOnly bool
 
+	// EnableVCPUsPinning pins the vCPU threads hotplugged for the containers
+	// to the exclusive CPUs of the sandbox, one each.
+	EnableVCPUsPinning bool
+
 	DisableGuestSeccomp bool
+
+	// RequireGuestSeccomp fails the creation of the containers whose
+	// seccomp profile is not applied in the guest.
+	RequireGuestSeccomp bool
+
+	// GuestTimeSyncInterval is the interval at which the guest clock is set
+	// to the host one, besides after the host resumes from suspend.
+	GuestTimeSyncInterval time.Duration
+
+	// GuestTimeSource is the time source the guest clock is kept in sync
+	// with, GuestNTPServers being the servers of the "ntp" one.
+	GuestTimeSource string
+	GuestNTPServers []string
+
+	// EnableGuestHooks allows the containers to declare hooks run in the
+	// guest through their annotations.
+	EnableGuestHooks bool
+
+	// ConsoleCaptureSize is the size in KiB of the last output of the guest
+	// console captured when the sandbox fails to be created or the agent
+	// stops responding. 0 disables the capture.
+	ConsoleCaptureSize uint32
+
+	// EnableAudit logs an audit record for each device hotplug, resource
+	// resize and mount performed on the sandbox, also sent to the Linux
+	// audit subsystem if LinuxAudit is set.
+	EnableAudit bool
+	LinuxAudit  bool
+
+	// SlowOperationThreshold is the duration in milliseconds after which
+	// the agent requests, hypervisor operations and mounts are reported as
+	// slow. 0 disables the watchdog.
+	SlowOperationThreshold uint32
+
+	// LogRateLimit is the maximum number of lines per second of each log
+	// source of the sandbox, such as the guest console, forwarded to the
+	// shim log. 0 disables the rate limiting.
+	LogRateLimit uint32
+
+	// LogRotateSize is the size in KiB at which the files of the sandbox
+	// store the logs are forwarded to are rotated. 0 disables the files.
+	LogRotateSize uint32
+
+	// ShimCPUWeight, ShimMilliCPUs and ShimMemoryMB constrain the CPU
+	// weight, the CPU time and the memory of the shim, including its
+	// forwarding of the logs of the sandbox, in its own resource controller
+	// in the one of the VMM. 0 leaves them unconstrained.
+	ShimCPUWeight uint32
+	ShimMilliCPUs uint32
+	ShimMemoryMB  uint32
 }
 
 // valid checks that the sandbox configuration is valid.
@@ -199,15 +268,25 @@ type Sandbox struct {
 
 	swapDevices []*config.BlockDrive
 	volumes     []types.Volume
+	layerCache  *layerCache
 
 	monitor         *monitor
 	config          *SandboxConfig
 	annotationsLock *sync.RWMutex
 	wg              *sync.WaitGroup
 	cw              *consoleWatcher
+	nw              *netnsWatcher
+
+	lifecycleEventHandler LifecycleEventHandler
+	// hypervisorExited is set once the HypervisorExitedEvent of the VM
+	// is emitted.
+	hypervisorExited int32
 
-	sandboxController  resCtrl.ResourceController
-	overheadController resCtrl.ResourceController
+	sandboxController   resCtrl.ResourceController
+	overheadController  resCtrl.ResourceController
+	vmmController       resCtrl.ResourceController
+	virtiofsdController resCtrl.ResourceController
+	shimController      resCtrl.ResourceController
 
 	containers map[string]*Container
 
@@ -223,9 +302,20 @@ type Sandbox struct {
 	shmSize       uint64
 	swapDeviceNum uint
 
+	// lastTimeSync is the last time the guest clock was set, when the host
+	// was suspended for hostSuspendTime since it booted. timeSyncLock
+	// protects both, the guest clock being set without the sandbox lock.
+	lastTimeSync    time.Time
+	hostSuspendTime time.Duration
+	timeSyncLock    sync.Mutex
+
 	sharePidNs        bool
 	seccompSupported  bool
 	disableVMShutdown bool
+
+	// vcpusPinned tells if the vCPU threads are pinned to the exclusive
+	// CPUs of the sandbox.
+	vcpusPinned bool
 }
 
 // ID returns the sandbox identifier string.
@@ -543,7 +633,7 @@ func newSandbox(ctx context.Context, sandboxConfig SandboxConfig, factory Factor
 		config:          &sandboxConfig,
 		volumes:         sandboxConfig.Volumes,
 		containers:      map[string]*Container{},
-		state:           types.SandboxState{BlockIndexMap: make(map[int]struct{})},
+		state:           types.SandboxState{BlockIndexMap: bootBlockIndexes(sandboxConfig)},
 		annotationsLock: &sync.RWMutex{},
 		wg:              &sync.WaitGroup{},
 		shmSize:         sandboxConfig.ShmSize,
@@ -553,8 +643,13 @@ func newSandbox(ctx context.Context, sandboxConfig SandboxConfig, factory Factor
 		swapDeviceNum:   0,
 		swapSizeBytes:   0,
 		swapDevices:     []*config.BlockDrive{},
+
+		lifecycleEventHandler: getLifecycleEventHandler(ctx),
 	}
 
+	setSlowOperationThreshold(sandboxConfig.SlowOperationThreshold)
+	setLogForwarding(sandboxConfig.LogRateLimit, sandboxConfig.LogRotateSize)
+
 	fsShare, err := NewFilesystemShare(s)
 	if err != nil {
 		return nil, err
@@ -588,6 +683,15 @@ func newSandbox(ctx context.Context, sandboxConfig SandboxConfig, factory Factor
 		return nil, err
 	}
 
+	// The guest NUMA topology follows the placement of the sandbox, it is
+	// kept once the VM is created.
+	if sandboxConfig.HypervisorConfig.EnableGuestNUMA && len(sandboxConfig.HypervisorConfig.GuestNUMANodes) == 0 {
+		if sandboxConfig.HypervisorConfig.GuestNUMANodes, err = s.guestNUMANodes(); err != nil {
+			return nil, err
+		}
+		s.Logger().WithField("nodes", len(sandboxConfig.HypervisorConfig.GuestNUMANodes)).Info("guest NUMA topology")
+	}
+
 	// Ignore the error. Restore can fail for a new sandbox
 	if err := s.Restore(); err != nil {
 		s.Logger().WithError(err).Debug("restore sandbox failed")
@@ -715,7 +819,12 @@ func (s *Sandbox) createResourceController() error {
 		s.state.OverheadCgroupPath = s.overheadController.ID()
 	}
 
-	return nil
+	vmmController := s.sandboxController
+	if s.overheadController != nil {
+		vmmController = s.overheadController
+	}
+
+	return s.createHelperResourceControllers(vmmController)
 }
 
 // storeSandbox stores a sandbox config.
@@ -812,6 +921,10 @@ func (s *Sandbox) Delete(ctx context.Context) error {
 		s.Logger().WithError(err).Error("failed to cleanup share files")
 	}
 
+	if err := deviceManager.ReleaseSandboxSharedDevices(s.id); err != nil {
+		s.Logger().WithError(err).Error("failed to release shared devices")
+	}
+
 	return s.store.Destroy(s.id)
 }
 
@@ -821,6 +934,11 @@ func (s *Sandbox) createNetwork(ctx context.Context) error {
 		return nil
 	}
 
+	if s.config.NetworkConfig.HostNetwork && s.config.NetworkConfig.HostNetworkMode != HostNetworkUser {
+		s.Logger().WithField("host-network-mode", s.config.NetworkConfig.HostNetworkMode).Info("Host networking, skipping network namespace scan")
+		return nil
+	}
+
 	span, ctx := katatrace.Trace(ctx, s.Logger(), "createNetwork", sandboxTracingTags, map[string]string{"sandbox_id": s.id})
 	defer span.End()
 	katatrace.AddTags(span, "network", s.network, "NetworkConfig", s.config.NetworkConfig)
@@ -832,9 +950,11 @@ func (s *Sandbox) createNetwork(ctx context.Context) error {
 	}
 
 	// Add all the networking endpoints.
+	start := time.Now()
 	if _, err := s.network.AddEndpoints(ctx, s, nil, false); err != nil {
 		return err
 	}
+	observeBootPhase(bootPhaseNetnsScan, start)
 
 	return nil
 }
@@ -930,6 +1050,12 @@ func (s *Sandbox) AddInterface(ctx context.Context, inf *pbTypes.Interface) (*pb
 		return nil, err
 	}
 
+	// Add the static neighbor entries set on the interface, if any.
+	// The newly added endpoint is last.
+	if err = s.agent.addARPNeighbors(ctx, generateVCNeighbors(endpoints[len(endpoints)-1])); err != nil {
+		return nil, err
+	}
+
 	// Update the sandbox storage
 	if err = s.Save(); err != nil {
 		return nil, err
@@ -972,6 +1098,112 @@ func (s *Sandbox) ListRoutes(ctx context.Context) ([]*pbTypes.Route, error) {
 	return s.agent.listRoutes(ctx)
 }
 
+// GetIPTables returns the iptables, or ip6tables if isIPv6 is set, rules of
+// the guest in the iptables-save format.
+func (s *Sandbox) GetIPTables(ctx context.Context, isIPv6 bool) ([]byte, error) {
+	return s.agent.getIPTables(ctx, isIPv6)
+}
+
+// SetIPTables replaces the iptables, or ip6tables if isIPv6 is set, rules of
+// the guest by the ones in the iptables-save format.
+func (s *Sandbox) SetIPTables(ctx context.Context, isIPv6 bool, data []byte) error {
+	return s.agent.setIPTables(ctx, isIPv6, data)
+}
+
+// SetAgentTracing starts the tracing of the agent if enable is set, or stops
+// it. The agent spans are sent to the trace forwarder of the host, which has
+// to be running.
+func (s *Sandbox) SetAgentTracing(ctx context.Context, enable bool) error {
+	return s.agent.setAgentTracing(ctx, enable)
+}
+
+// netnsWatcher hot removes the endpoints whose interface is deleted from the
+// sandbox network namespace, e.g. when a secondary network is removed with a
+// CNI DEL while the sandbox is running.
+type netnsWatcher struct {
+	done chan struct{}
+	// Serializes the endpoint removals with the watcher being stopped.
+	sync.Mutex
+	stopped bool
+}
+
+// startNetnsWatcher starts watching the sandbox network namespace, unless
+// the sandbox runs without one or shares the host network.
+func (s *Sandbox) startNetnsWatcher() error {
+	if s.config.NetworkConfig.DisableNewNetwork || s.config.NetworkConfig.HostNetwork ||
+		s.network.NetworkID() == "" || s.nw != nil {
+		return nil
+	}
+
+	nw := &netnsWatcher{
+		done: make(chan struct{}),
+	}
+
+	// The watcher outlives the request starting the sandbox, hence the
+	// sandbox context is used.
+	if err := watchDeletedLinks(s.network.NetworkID(), nw.done, func(name string) {
+		nw.Lock()
+		defer nw.Unlock()
+
+		if nw.stopped {
+			return
+		}
+		s.removeDeletedInterface(s.ctx, name)
+	}); err != nil {
+		return err
+	}
+
+	s.nw = nw
+
+	return nil
+}
+
+// stopNetnsWatcher stops the network namespace watcher once any ongoing
+// endpoint removal has completed.
+func (s *Sandbox) stopNetnsWatcher() {
+	if s.nw == nil {
+		return
+	}
+
+	close(s.nw.done)
+
+	s.nw.Lock()
+	s.nw.stopped = true
+	s.nw.Unlock()
+
+	s.nw = nil
+}
+
+// removeDeletedInterface hot removes the endpoint of the interface deleted
+// from the sandbox network namespace. The guest drops the routes going
+// through the interface along with the NIC.
+func (s *Sandbox) removeDeletedInterface(ctx context.Context, name string) {
+	for _, endpoint := range s.network.Endpoints() {
+		if endpoint.Name() != name {
+			continue
+		}
+
+		logger := s.Logger().WithFields(logrus.Fields{
+			"interface":     name,
+			"endpoint-type": endpoint.Type(),
+		})
+		logger.Info("Interface deleted from the network namespace, hot detaching endpoint")
+
+		// Cold plugged endpoints may not be removable from the VM, in
+		// which case they are kept until the sandbox is stopped.
+		if err := s.network.RemoveEndpoints(ctx, s, []Endpoint{endpoint}, true); err != nil {
+			logger.WithError(err).Warn("Could not hot detach endpoint")
+			return
+		}
+
+		if err := s.Save(); err != nil {
+			logger.WithError(err).Warn("Could not save sandbox state")
+		}
+
+		return
+	}
+}
+
 const (
 	// unix socket type of console
 	consoleProtoUnix = "unix"
@@ -980,10 +1212,31 @@ const (
 	consoleProtoPty = "pty"
 )
 
+const (
+	consoleCaptureExt = ".log"
+
+	// consoleCaptureRetention is how long the captured guest console
+	// outputs are kept.
+	consoleCaptureRetention = 7 * 24 * time.Hour
+)
+
+// consoleCaptureDir is where the captured guest console outputs are written,
+// out of the sandbox stores, which are removed when the sandbox creation
+// fails.
+var consoleCaptureDir = "/var/lib/kata-containers/console"
+
+// ConsoleCapturePath returns the path of the captured guest console output
+// of the sandbox.
+func ConsoleCapturePath(sandboxID string) string {
+	return filepath.Join(consoleCaptureDir, sandboxID+consoleCaptureExt)
+}
+
 // console watcher is designed to monitor guest console output.
 type consoleWatcher struct {
 	conn       net.Conn
 	ptyConsole *os.File
+	capture    *consoleCapture
+	forwarder  *LogForwarder
 	proto      string
 	consoleURL string
 }
@@ -999,9 +1252,51 @@ func newConsoleWatcher(ctx context.Context, s *Sandbox) (*consoleWatcher, error)
 		return nil, err
 	}
 
+	if s.guestConsoleCaptured() {
+		cw.capture = &consoleCapture{max: int(s.config.ConsoleCaptureSize) * 1024}
+	}
+
+	cw.forwarder = newSandboxLogForwarder(logSourceConsole, filepath.Join(s.store.RunStoragePath(), s.id, forwardedLogDir))
+
 	return &cw, nil
 }
 
+// consoleCapture keeps the last lines of the guest console output, up to max
+// bytes.
+type consoleCapture struct {
+	lines []string
+	size  int
+	max   int
+	sync.Mutex
+}
+
+func (c *consoleCapture) add(line string) {
+	c.Lock()
+	defer c.Unlock()
+
+	if len(line)+1 > c.max {
+		line = line[len(line)+1-c.max:]
+	}
+
+	c.lines = append(c.lines, line)
+	c.size += len(line) + 1
+
+	for c.size > c.max {
+		c.size -= len(c.lines[0]) + 1
+		c.lines = c.lines[1:]
+	}
+}
+
+func (c *consoleCapture) String() string {
+	c.Lock()
+	defer c.Unlock()
+
+	if len(c.lines) == 0 {
+		return ""
+	}
+	return strings.Join(c.lines, "\n") + "\n"
+}
+
 // start the console watcher
 func (cw *consoleWatcher) start(s *Sandbox) (err error) {
 	if cw.consoleWatched() {
@@ -1027,6 +1322,14 @@ func (cw *consoleWatcher) start(s *Sandbox) (err error) {
 
 	go func() {
 		for scanner.Scan() {
+			if cw.capture != nil {
+				cw.capture.add(scanner.Text())
+			}
+
+			if !cw.forwarder.Forward(scanner.Text()) {
+				continue
+			}
+
 			s.Logger().WithFields(logrus.Fields{
 				"console-protocol": cw.proto,
 				"console-url":      cw.consoleURL,
@@ -1065,6 +1368,69 @@ func (cw *consoleWatcher) stop() {
 		cw.ptyConsole.Close()
 		cw.ptyConsole = nil
 	}
+
+	if cw.forwarder != nil {
+		cw.forwarder.Close()
+	}
+}
+
+// captureGuestConsole logs the last output of the guest console, and writes
+// it to the console capture directory, for the cause of the failure to come
+// with the messages of the guest kernel and init.
+func (s *Sandbox) captureGuestConsole(cause error) {
+	if s.cw == nil || s.cw.capture == nil {
+		return
+	}
+
+	output := s.cw.capture.String()
+	if output == "" {
+		return
+	}
+
+	s.Logger().WithError(cause).WithField("console", output).Error("captured the guest console output on failure")
+
+	path := ConsoleCapturePath(s.id)
+	if err := os.MkdirAll(consoleCaptureDir, 0700); err != nil {
+		s.Logger().WithError(err).Warn("failed to create the guest console capture directory")
+		return
+	}
+	if err := os.WriteFile(path, []byte(output), 0600); err != nil {
+		s.Logger().WithError(err).Warn("failed to write the captured guest console output")
+		return
+	}
+
+	if err := utils.RemoveExpiredFiles(consoleCaptureDir, consoleCaptureExt, path, consoleCaptureRetention); err != nil {
+		s.Logger().WithError(err).Warn("failed to remove the expired guest console outputs")
+	}
+}
+
+// guestConsoleCaptured returns if the last output of the guest console is
+// captured, Cloud Hypervisor and Firecracker only having a guest console in
+// debug mode.
+func (s *Sandbox) guestConsoleCaptured() bool {
+	if s.config.ConsoleCaptureSize == 0 {
+		return false
+	}
+
+	switch s.config.HypervisorType {
+	case ClhHypervisor, FirecrackerHypervisor:
+		return s.config.HypervisorConfig.Debug
+	}
+
+	return true
+}
+
+// ublkConfig returns the configuration of the ublk devices image files are
+// attached through, or nil when they are attached through loop devices.
+func (s *Sandbox) ublkConfig() *config.UblkConfig {
+	if s.config.HypervisorConfig.FileBackedBlockDevice != config.UblkBlockDevice {
+		return nil
+	}
+
+	return &config.UblkConfig{
+		Queues:     s.config.HypervisorConfig.UblkQueues,
+		QueueDepth: s.config.HypervisorConfig.UblkQueueDepth,
+	}
 }
 
 func (s *Sandbox) addSwap(ctx context.Context, swapID string, size int64) (*config.BlockDrive, error) {
@@ -1183,7 +1549,7 @@ func (s *Sandbox) startVM(ctx context.Context) (err error) {
 
 	s.Logger().Info("Starting VM")
 
-	if s.config.HypervisorConfig.Debug {
+	if s.config.HypervisorConfig.Debug || s.guestConsoleCaptured() {
 		// create console watcher
 		consoleWatcher, err := newConsoleWatcher(ctx, s)
 		if err != nil {
@@ -1199,6 +1565,7 @@ func (s *Sandbox) startVM(ctx context.Context) (err error) {
 	}()
 
 	if err := s.network.Run(ctx, func() error {
+		start := time.Now()
 		if s.factory != nil {
 			vm, err := s.factory.GetVM(ctx, VMConfig{
 				HypervisorType:   s.config.HypervisorType,
@@ -1206,13 +1573,24 @@ func (s *Sandbox) startVM(ctx context.Context) (err error) {
 				AgentConfig:      s.config.AgentConfig,
 			})
 			if err != nil {
-				return err
+				return types.NewError(types.ErrorInternal, types.SubsystemHypervisor, false, err)
 			}
 
-			return vm.assignSandbox(s)
+			if err := vm.assignSandbox(s); err != nil {
+				return err
+			}
+			observeBootPhase(bootPhaseFactoryFetch, start)
+			return nil
 		}
 
-		return s.hypervisor.StartVM(ctx, VmStartTimeout)
+		done := watchOperation(slowOperationHypervisor, "StartVM", nil)
+		err := s.hypervisor.StartVM(ctx, VmStartTimeout)
+		done()
+		if err != nil {
+			return types.NewError(types.ErrorInternal, types.SubsystemHypervisor, false, err)
+		}
+		observeBootPhase(bootPhaseHypervisorExec, start)
+		return nil
 	}); err != nil {
 		return err
 	}
@@ -1220,13 +1598,29 @@ func (s *Sandbox) startVM(ctx context.Context) (err error) {
 	// In case of vm factory, network interfaces are hotplugged
 	// after vm is started.
 	if s.factory != nil {
+		start := time.Now()
 		if _, err := s.network.AddEndpoints(ctx, s, nil, true); err != nil {
-			return err
+			return types.NewError(types.ErrorInternal, types.SubsystemNetwork, false, err)
 		}
+		observeBootPhase(bootPhaseNetnsScan, start)
 	}
 
 	s.Logger().Info("VM started")
 
+	hypervisorStarted := map[string]string{}
+	if pid, err := s.GetHypervisorPid(); err == nil {
+		hypervisorStarted["pid"] = strconv.Itoa(pid)
+	}
+	atomic.StoreInt32(&s.hypervisorExited, 0)
+	s.emitLifecycleEvent(HypervisorStartedEvent, hypervisorStarted)
+
+	// the VMs of the factory set their clock when assigned to the sandbox
+	s.guestTimeSynced(time.Now())
+
+	if err := s.constrainHelpers(); err != nil {
+		return types.NewError(types.ErrorInternal, types.SubsystemResources, false, err)
+	}
+
 	if s.cw != nil {
 		s.Logger().Debug("console watcher starts")
 		if err := s.cw.start(s); err != nil {
@@ -1239,12 +1633,16 @@ func (s *Sandbox) startVM(ctx context.Context) (err error) {
 	// we want to guarantee that it is manageable.
 	// For that we need to ask the agent to start the
 	// sandbox inside the VM.
+	agentStart := time.Now()
 	if err := s.agent.startSandbox(ctx, s); err != nil {
 		return err
 	}
+	observeBootPhase(bootPhaseAgentReady, agentStart)
 
 	s.Logger().Info("Agent started in the sandbox")
 
+	s.emitLifecycleEvent(AgentConnectedEvent, nil)
+
 	defer func() {
 		if err != nil {
 			if e := s.agent.stopSandbox(ctx, s); e != nil {
@@ -1253,6 +1651,14 @@ func (s *Sandbox) startVM(ctx context.Context) (err error) {
 		}
 	}()
 
+	if err = s.setGuestTimeSource(ctx); err != nil {
+		return err
+	}
+
+	if err := s.startNetnsWatcher(); err != nil {
+		s.Logger().WithError(err).Warn("Could not watch the network namespace, deleted interfaces will not be hot removed")
+	}
+
 	return nil
 }
 
@@ -1268,7 +1674,18 @@ func (s *Sandbox) stopVM(ctx context.Context) error {
 
 	s.Logger().Info("Stopping VM")
 
-	return s.hypervisor.StopVM(ctx, s.disableVMShutdown)
+	done := watchOperation(slowOperationHypervisor, "StopVM", nil)
+	err := s.hypervisor.StopVM(ctx, s.disableVMShutdown)
+	done()
+	if err != nil {
+		return err
+	}
+
+	s.emitHypervisorExited(nil)
+
+	s.releaseLayerCache()
+
+	return nil
 }
 
 func (s *Sandbox) addContainer(c *Container) error {
@@ -1438,6 +1855,12 @@ func (s *Sandbox) DeleteContainer(ctx context.Context, containerID string) (VCCo
 		}
 	}
 
+	// Give the memory of the container back to the host, the container
+	// being deleted whether the VM could be shrunk or not.
+	if err := s.shrinkMemory(ctx); err != nil {
+		s.Logger().WithError(err).WithField("container", containerID).Warn("Could not shrink the sandbox memory")
+	}
+
 	// update the sandbox resource controller
 	if err = s.resourceControllerUpdate(ctx); err != nil {
 		return nil, err
@@ -1548,9 +1971,31 @@ func (s *Sandbox) Stats(ctx context.Context) (SandboxStats, error) {
 	}
 	stats.Cpus = len(tids.vcpus)
 
+	if s.network.NetworkID() != "" {
+		netStats, err := s.network.Stats(ctx)
+		if err != nil {
+			s.Logger().WithError(err).Warn("Could not get network stats")
+		}
+		stats.NetworkStats = netStats
+	}
+
 	return stats, nil
 }
 
+// GetAllocatedResources returns the resources currently allocated to the VM
+// of the sandbox.
+func (s *Sandbox) GetAllocatedResources(ctx context.Context) (AllocatedResources, error) {
+	tids, err := s.hypervisor.GetThreadIDs(ctx)
+	if err != nil {
+		return AllocatedResources{}, err
+	}
+
+	return AllocatedResources{
+		VCPUs:    uint32(len(tids.vcpus)),
+		MemoryMB: s.hypervisor.HypervisorConfig().MemorySize + uint32(s.hypervisor.Save().HotpluggedMemory),
+	}, nil
+}
+
 // PauseContainer pauses a running container.
 func (s *Sandbox) PauseContainer(ctx context.Context, containerID string) error {
 	// Fetch the container.
@@ -1682,6 +2127,9 @@ func (s *Sandbox) Stop(ctx context.Context, force bool) error {
 		}
 	}
 
+	// The endpoints are removed along with the network below.
+	s.stopNetnsWatcher()
+
 	if err := s.stopVM(ctx); err != nil && !force {
 		return err
 	}
@@ -1729,6 +2177,22 @@ func (s *Sandbox) setSandboxState(state types.StateString) error {
 
 const maxBlockIndex = 65535
 
+// bootBlockIndexes returns the block indexes used by the drives the VM boots
+// with. When QEMU boots from an image attached as a virtio-blk device, the
+// guest sees it as /dev/vda, so that name must not be predicted for the block
+// devices of the containers.
+func bootBlockIndexes(sandboxConfig SandboxConfig) map[int]struct{} {
+	indexes := make(map[int]struct{})
+
+	hconf := sandboxConfig.HypervisorConfig
+	if sandboxConfig.HypervisorType == QemuHypervisor && hconf.ImagePath != "" && hconf.InitrdPath == "" &&
+		hconf.DisableImageNvdimm && hconf.BlockDeviceDriver == config.VirtioBlock {
+		indexes[0] = struct{}{}
+	}
+
+	return indexes
+}
+
 // getAndSetSandboxBlockIndex retrieves an unused sandbox block index from
 // the BlockIndexMap and marks it as used. This index is used to maintain the
 // index at which a block device is assigned to a container in the sandbox.
@@ -1765,9 +2229,29 @@ func (s *Sandbox) unsetSandboxBlockIndex(index int) error {
 
 // HotplugAddDevice is used for add a device to sandbox
 // Sandbox implement DeviceReceiver interface from device/api/interface.go
-func (s *Sandbox) HotplugAddDevice(ctx context.Context, device api.Device, devType config.DeviceType) error {
+func (s *Sandbox) HotplugAddDevice(ctx context.Context, device api.Device, devType config.DeviceType) (err error) {
 	span, ctx := katatrace.Trace(ctx, s.Logger(), "HotplugAddDevice", sandboxTracingTags, map[string]string{"sandbox_id": s.id})
 	defer span.End()
+	defer watchOperation(slowOperationHypervisor, "HotplugAddDevice", map[string]string{"device": device.DeviceID(), "type": string(devType)})()
+
+	defer func() {
+		if devType == config.DeviceGeneric {
+			return
+		}
+
+		attributes := map[string]string{
+			"device":    device.DeviceID(),
+			"type":      string(devType),
+			"host_path": device.GetHostPath(),
+		}
+		if err == nil {
+			s.emitLifecycleEvent(DeviceHotpluggedEvent, attributes)
+		}
+		s.audit(AuditDeviceHotplug, "", device.DeviceID(), map[string]string{
+			"type":      string(devType),
+			"host_path": device.GetHostPath(),
+		}, err)
+	}()
 
 	if s.sandboxController != nil {
 		if err := s.sandboxController.AddDevice(device.GetHostPath()); err != nil {
@@ -1819,8 +2303,17 @@ func (s *Sandbox) HotplugAddDevice(ctx context.Context, device api.Device, devTy
 
 // HotplugRemoveDevice is used for removing a device from sandbox
 // Sandbox implement DeviceReceiver interface from device/api/interface.go
-func (s *Sandbox) HotplugRemoveDevice(ctx context.Context, device api.Device, devType config.DeviceType) error {
+func (s *Sandbox) HotplugRemoveDevice(ctx context.Context, device api.Device, devType config.DeviceType) (err error) {
+	defer watchOperation(slowOperationHypervisor, "HotplugRemoveDevice", map[string]string{"device": device.DeviceID(), "type": string(devType)})()
+
 	defer func() {
+		if devType != config.DeviceGeneric {
+			s.audit(AuditDeviceHotunplug, "", device.DeviceID(), map[string]string{
+				"type":      string(devType),
+				"host_path": device.GetHostPath(),
+			}, err)
+		}
+
 		if s.sandboxController != nil {
 			if err := s.sandboxController.RemoveDevice(device.GetHostPath()); err != nil {
 				s.Logger().WithError(err).WithField("device", device).
@@ -1983,9 +2476,18 @@ func (s *Sandbox) updateResources(ctx context.Context) error {
 
 	// Update VCPUs
 	s.Logger().WithField("cpus-sandbox", sandboxVCPUs).Debugf("Request to hypervisor to update vCPUs")
+	done := watchOperation(slowOperationHypervisor, "ResizeVCPUs", sandboxVCPUs)
 	oldCPUs, newCPUs, err := s.hypervisor.ResizeVCPUs(ctx, sandboxVCPUs)
+	done()
+	if err != nil || oldCPUs != newCPUs {
+		s.audit(AuditCPUResize, "", "vcpus", map[string]string{
+			"requested": strconv.FormatUint(uint64(sandboxVCPUs), 10),
+			"old":       strconv.FormatUint(uint64(oldCPUs), 10),
+			"new":       strconv.FormatUint(uint64(newCPUs), 10),
+		}, err)
+	}
 	if err != nil {
-		return err
+		return types.NewError(types.ErrorInternal, types.SubsystemResources, false, err)
 	}
 
 	s.Logger().Debugf("Request to hypervisor to update oldCPUs/newCPUs: %d/%d", oldCPUs, newCPUs)
@@ -1993,7 +2495,7 @@ func (s *Sandbox) updateResources(ctx context.Context) error {
 	if oldCPUs < newCPUs {
 		vcpusAdded := newCPUs - oldCPUs
 		s.Logger().Debugf("Request to onlineCPUMem with %d CPUs", vcpusAdded)
-		if err := s.agent.onlineCPUMem(ctx, vcpusAdded, true); err != nil {
+		if err := s.agent.onlineCPUMem(ctx, vcpusAdded, true, 0); err != nil {
 			return err
 		}
 	}
@@ -2001,16 +2503,53 @@ func (s *Sandbox) updateResources(ctx context.Context) error {
 
 	// Update Memory
 	s.Logger().WithField("memory-sandbox-size-byte", sandboxMemoryByte).Debugf("Request to hypervisor to update memory")
-	newMemoryMB := uint32(sandboxMemoryByte >> utils.MibToBytesShift)
+	return s.updateMemory(ctx, uint32(sandboxMemoryByte>>utils.MibToBytesShift))
+}
+
+// shrinkMemory gives the memory the sandbox does not need anymore back to
+// the host, leaving the vCPUs and a VM which needs more memory untouched.
+func (s *Sandbox) shrinkMemory(ctx context.Context) error {
+	if s.config.StaticResourceMgmt {
+		return nil
+	}
+
+	sandboxMemoryByte, _, _ := s.calculateSandboxMemory()
+	newMemoryMB := uint32(sandboxMemoryByte>>utils.MibToBytesShift) + s.hypervisor.HypervisorConfig().MemorySize
+	oldMemory := s.hypervisor.HypervisorConfig().MemorySize + uint32(s.hypervisor.Save().HotpluggedMemory)
+	if newMemoryMB >= oldMemory {
+		return nil
+	}
+
+	return s.updateMemory(ctx, newMemoryMB)
+}
+
+// updateMemory resizes the VM memory for its containers to get newMemoryMB,
+// and has the agent online the memory hot added.
+func (s *Sandbox) updateMemory(ctx context.Context, newMemoryMB uint32) error {
+	oldMemory := s.hypervisor.HypervisorConfig().MemorySize + uint32(s.hypervisor.Save().HotpluggedMemory)
+	newMemoryMB = s.memoryShrinkHysteresis(oldMemory, newMemoryMB)
+	done := watchOperation(slowOperationHypervisor, "ResizeMemory", newMemoryMB)
 	newMemory, updatedMemoryDevice, err := s.hypervisor.ResizeMemory(ctx, newMemoryMB, s.state.GuestMemoryBlockSizeMB, s.state.GuestMemoryHotplugProbe)
+	done()
+	if (err != nil && err != noGuestMemHotplugErr) || (err == nil && newMemory != oldMemory) {
+		s.audit(AuditMemoryResize, "", "memory", map[string]string{
+			"requested_mb": strconv.FormatUint(uint64(newMemoryMB), 10),
+			"old_mb":       strconv.FormatUint(uint64(oldMemory), 10),
+			"new_mb":       strconv.FormatUint(uint64(newMemory), 10),
+		}, err)
+	}
 	if err != nil {
 		if err == noGuestMemHotplugErr {
 			s.Logger().Warnf("%s, memory specifications cannot be guaranteed", err)
 		} else {
-			return err
+			return types.NewError(types.ErrorInternal, types.SubsystemResources, false, err)
 		}
 	}
 	s.Logger().Debugf("Sandbox memory size: %d MB", newMemory)
+	if newMemory < oldMemory {
+		// no memory was hot added for the agent to online
+		return nil
+	}
 	if s.state.GuestMemoryHotplugProbe && updatedMemoryDevice.Addr != 0 {
 		// notify the guest kernel about memory hot-add event, before onlining them
 		s.Logger().Debugf("notify guest kernel memory hot-add event via probe interface, memory device located at 0x%x", updatedMemoryDevice.Addr)
@@ -2018,13 +2557,40 @@ func (s *Sandbox) updateResources(ctx context.Context) error {
 			return err
 		}
 	}
-	if err := s.agent.onlineCPUMem(ctx, 0, false); err != nil {
+	if err := s.agent.onlineCPUMem(ctx, 0, false, s.hotpluggedMemoryBlocks(newMemory)); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// memoryShrinkHysteresis returns the memory the VM should be resized to from
+// oldMemoryMB for its containers to get newMemoryMB, the VM not being shrunk
+// as long as it does not have more than the shrink hysteresis in excess.
+func (s *Sandbox) memoryShrinkHysteresis(oldMemoryMB, newMemoryMB uint32) uint32 {
+	if newMemoryMB < oldMemoryMB && oldMemoryMB-newMemoryMB <= s.config.HypervisorConfig.MemoryShrinkHysteresisMB {
+		s.Logger().WithFields(logrus.Fields{
+			"old-memory-mb": oldMemoryMB,
+			"new-memory-mb": newMemoryMB,
+		}).Debug("memory shrink within hysteresis, keeping the VM memory")
+		return oldMemoryMB
+	}
+
+	return newMemoryMB
+}
+
+// hotpluggedMemoryBlocks returns the number of memory blocks hot added to
+// the guest, for the agent to acknowledge when they are online, or 0 when
+// it cannot be known.
+func (s *Sandbox) hotpluggedMemoryBlocks(memoryMB uint32) uint32 {
+	blockSizeMB := s.state.GuestMemoryBlockSizeMB
+	if blockSizeMB == 0 || memoryMB <= s.config.HypervisorConfig.MemorySize {
+		return 0
+	}
+
+	return (memoryMB - s.config.HypervisorConfig.MemorySize) / blockSizeMB
+}
+
 func (s *Sandbox) calculateSandboxMemory() (uint64, bool, int64) {
 	memorySandbox := uint64(0)
 	needPodSwap := false
@@ -2112,6 +2678,12 @@ func (s *Sandbox) GetHypervisorType() string {
 	return string(s.config.HypervisorType)
 }
 
+// GetSandboxID is used for getting the ID of the sandbox.
+// Sandbox implement DeviceReceiver interface from device/api/interface.go
+func (s *Sandbox) GetSandboxID() string {
+	return s.id
+}
+
 // resourceControllerUpdate updates the sandbox cpuset resource controller
 // (Linux cgroup) subsystem.
 // Also, if the sandbox has an overhead controller, it updates the hypervisor
@@ -2128,6 +2700,11 @@ func (s *Sandbox) resourceControllerUpdate(ctx context.Context) error {
 		return err
 	}
 
+	// virtiofsd daemons may have been started along with the volumes
+	if err := s.constrainHelpers(); err != nil {
+		return err
+	}
+
 	if s.overheadController != nil {
 		// If we have an overhead controller, new vCPU threads would start there,
 		// as being children of the VMM PID.
@@ -2137,7 +2714,9 @@ func (s *Sandbox) resourceControllerUpdate(ctx context.Context) error {
 		}
 	}
 
-	return nil
+	// The vCPUs may have been hotplugged, or the exclusive CPUs of the
+	// sandbox changed.
+	return s.checkVCPUsPinning(ctx)
 }
 
 // resourceControllerDelete will move the running processes in the sandbox resource
@@ -2154,6 +2733,10 @@ func (s *Sandbox) resourceControllerDelete() error {
 		return err
 	}
 
+	if err := s.deleteHelperResourceControllers(); err != nil {
+		return err
+	}
+
 	resCtrlParent := sandboxController.Parent()
 	if err := sandboxController.MoveTo(resCtrlParent); err != nil {
 		return err
@@ -2207,6 +2790,12 @@ func (s *Sandbox) setupResourceController() error {
 		vmmController = s.overheadController
 	}
 
+	// On cgroup v2, the processes of the VMM controller live in its child,
+	// as it holds the controllers of the helper processes.
+	if s.vmmController != nil {
+		vmmController = s.vmmController
+	}
+
 	// By adding the runtime process to either the sandbox or overhead controller, we are making
 	// sure that any child process of the runtime (i.e. *all* processes serving a Kata pod)
 	// will initially live in this controller. Depending on the sandbox_cgroup settings, we will
@@ -2254,6 +2843,23 @@ func (s *Sandbox) GetAgentURL() (string, error) {
 	return s.agent.getAgentURL()
 }
 
+// GetAgentPolicyHash returns the SHA-256 hash of the active policy of the
+// agent API, or an empty string when the API is not restricted.
+func (s *Sandbox) GetAgentPolicyHash(ctx context.Context) (string, error) {
+	policy, err := s.agent.getPolicy(ctx)
+	if err != nil || policy == "" {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(policy))), nil
+}
+
+// SetAgentLogLevel changes the log level of the agent, until the sandbox is
+// restarted.
+func (s *Sandbox) SetAgentLogLevel(ctx context.Context, level string) error {
+	return s.agent.setLogLevel(ctx, level)
+}
+
 // GuestVolumeStats return the filesystem stat of a given volume in the guest.
 func (s *Sandbox) GuestVolumeStats(ctx context.Context, volumePath string) ([]byte, error) {
 	guestMountPath, err := s.guestMountPath(volumePath)
@@ -2264,31 +2870,168 @@ func (s *Sandbox) GuestVolumeStats(ctx context.Context, volumePath string) ([]by
 }
 
 // ResizeGuestVolume resizes a volume in the guest.
-func (s *Sandbox) ResizeGuestVolume(ctx context.Context, volumePath string, size uint64) error {
-	// TODO: https://github.com/kata-containers/kata-containers/issues/3694.
-	guestMountPath, err := s.guestMountPath(volumePath)
+func (s *Sandbox) ResizeGuestVolume(ctx context.Context, volumePath string, size uint64) (err error) {
+	defer func() {
+		s.audit(AuditVolumeResize, "", volumePath, map[string]string{
+			"size": strconv.FormatUint(size, 10),
+		}, err)
+	}()
+
+	m, err := s.volumeMount(volumePath)
 	if err != nil {
 		return err
 	}
-	return s.agent.resizeGuestVolume(ctx, guestMountPath, size)
+
+	if err := s.resizeVolumeDevice(ctx, m, size); err != nil {
+		return err
+	}
+
+	return s.agent.resizeGuestVolume(ctx, m.GuestDeviceMount, size)
+}
+
+// resizeVolumeDevice propagates the new size of the device backing a volume
+// to the VM, so that the guest can grow the filesystem of the volume.
+func (s *Sandbox) resizeVolumeDevice(ctx context.Context, m *Mount, size uint64) error {
+	if m.BlockDeviceID == "" {
+		return nil
+	}
+
+	device := s.devManager.GetDeviceByID(m.BlockDeviceID)
+	if device == nil {
+		return fmt.Errorf("Failed to find device by id (id=%s)", m.BlockDeviceID)
+	}
+
+	switch device.DeviceType() {
+	case config.DeviceBlock:
+		drive, ok := device.GetDeviceInfo().(*config.BlockDrive)
+		if !ok || drive == nil {
+			return fmt.Errorf("malformed block drive")
+		}
+		defer watchOperation(slowOperationHypervisor, "ResizeBlockDevice", map[string]interface{}{"drive": drive.ID, "size": size})()
+		return s.hypervisor.ResizeBlockDevice(ctx, drive, size)
+	case config.VhostUserBlk:
+		// The vhost-user target notifies the guest of the new size.
+		return nil
+	default:
+		return fmt.Errorf("Resizing %s devices is not supported", device.DeviceType())
+	}
+}
+
+// volumeDrive returns the block drive attached for the device of a volume,
+// and the size of the device on the host. The drive is nil if the device
+// cannot be resized by the runtime.
+func (s *Sandbox) volumeDrive(devID string) (*config.BlockDrive, uint64, error) {
+	device := s.devManager.GetDeviceByID(devID)
+	if device == nil {
+		return nil, 0, fmt.Errorf("Failed to find device by id (id=%s)", devID)
+	}
+
+	if device.DeviceType() != config.DeviceBlock {
+		return nil, 0, nil
+	}
+
+	drive, ok := device.GetDeviceInfo().(*config.BlockDrive)
+	if !ok || drive == nil {
+		return nil, 0, fmt.Errorf("malformed block drive")
+	}
+
+	// NVMe namespaces are not host block devices, and the size of
+	// virtio-pmem devices is fixed.
+	if drive.NVMe || drive.VirtioPmem {
+		return nil, 0, nil
+	}
+
+	size, err := utils.GetBlockDeviceSize(drive.File)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return drive, size, nil
+}
+
+// GrownVolume is a block device backing the rootfs or a volume of a
+// container, which grew on the host.
+type GrownVolume struct {
+	drive *config.BlockDrive
+
+	ContainerID string
+	// Destination is the mount point of the volume, empty for the rootfs
+	Destination string
+	Size        uint64
+
+	guestPath string
+}
+
+// GrownVolumes returns the block devices backing the rootfs and the volumes
+// of the containers which grew on the host. The growth is propagated to the
+// guest by ResizeVolume, which does not need the sandbox to be locked, then
+// recorded by VolumeResized.
+func (s *Sandbox) GrownVolumes() []GrownVolume {
+	if s.state.State != types.StateRunning {
+		return nil
+	}
+
+	var grown []GrownVolume
+	for _, c := range s.containers {
+		drive, err := c.grownDrive()
+		if err != nil {
+			c.Logger().WithError(err).Warn("failed to check rootfs block device size")
+		} else if drive != nil {
+			grown = append(grown, *drive)
+		}
+		grown = append(grown, c.grownVolumes()...)
+	}
+
+	return grown
+}
+
+// ResizeVolume propagates to the guest the growth of a block device, and
+// grows its filesystem, for the container to see the new capacity without
+// restarting.
+func (s *Sandbox) ResizeVolume(ctx context.Context, v GrownVolume) error {
+	if err := s.hypervisor.ResizeBlockDevice(ctx, v.drive, v.Size); err != nil {
+		return err
+	}
+
+	return s.agent.resizeGuestVolume(ctx, v.guestPath, v.Size)
+}
+
+// VolumeResized records the new size of a grown block device, once
+// ResizeVolume propagated it to the guest.
+func (s *Sandbox) VolumeResized(v GrownVolume) error {
+	c, ok := s.containers[v.ContainerID]
+	if !ok {
+		// the container was deleted meanwhile
+		return nil
+	}
+
+	return c.volumeResized(v)
 }
 
 func (s *Sandbox) guestMountPath(volumePath string) (string, error) {
+	m, err := s.volumeMount(volumePath)
+	if err != nil {
+		return "", err
+	}
+	return m.GuestDeviceMount, nil
+}
+
+func (s *Sandbox) volumeMount(volumePath string) (*Mount, error) {
 	// verify the device even exists
 	if _, err := os.Stat(volumePath); err != nil {
 		s.Logger().WithError(err).WithField("volume", volumePath).Error("Cannot get stats for volume that doesn't exist")
-		return "", err
+		return nil, err
 	}
 
 	// verify that we have a mount in this sandbox who's source maps to this
 	for _, c := range s.containers {
-		for _, m := range c.mounts {
-			if volumePath == m.Source {
-				return m.GuestDeviceMount, nil
+		for i := range c.mounts {
+			if volumePath == c.mounts[i].Source {
+				return &c.mounts[i], nil
 			}
 		}
 	}
-	return "", fmt.Errorf("mount %s not found in sandbox", volumePath)
+	return nil, fmt.Errorf("mount %s not found in sandbox", volumePath)
 }
 
 // getSandboxCPUSet returns the union of each of the sandbox's containers' CPU sets'
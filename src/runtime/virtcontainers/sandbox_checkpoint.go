@@ -0,0 +1,104 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	persistapi "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/persist/api"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
+)
+
+const (
+	// CheckpointVMStateFile is the file of a checkpoint holding the
+	// snapshot of the VM, its memory and device state.
+	CheckpointVMStateFile = "vm-state"
+
+	// CheckpointSandboxStateFile is the file of a checkpoint holding the
+	// state of the sandbox and of its containers when the VM was saved.
+	CheckpointSandboxStateFile = "sandbox-state.json"
+)
+
+// CheckpointState is the state of a sandbox saved along with the snapshot of
+// its VM.
+type CheckpointState struct {
+	Time           time.Time                            `json:"time"`
+	Containers     map[string]persistapi.ContainerState `json:"containers"`
+	SandboxID      string                               `json:"sandbox_id"`
+	HypervisorType HypervisorType                       `json:"hypervisor_type"`
+	Sandbox        persistapi.SandboxState              `json:"sandbox"`
+}
+
+// PauseForCheckpoint pauses the VM of the sandbox and returns the state of
+// the sandbox describing it, for SaveCheckpoint to save them. The snapshot of
+// the VM can be inspected but not restored, its guest state not matching the
+// one of a new sandbox.
+func (s *Sandbox) PauseForCheckpoint(ctx context.Context) (*CheckpointState, error) {
+	if s.state.State != types.StateRunning {
+		return nil, fmt.Errorf("sandbox %s is not running", s.id)
+	}
+
+	caps := s.hypervisor.Capabilities(ctx)
+	if !caps.IsSnapshotSupported() {
+		return nil, fmt.Errorf("the %s hypervisor cannot save a snapshot of the VM with this configuration", s.config.HypervisorType)
+	}
+
+	done := watchOperation(slowOperationHypervisor, "PauseVM", nil)
+	err := s.hypervisor.PauseVM(ctx)
+	done()
+	if err != nil {
+		return nil, err
+	}
+
+	ss, cs := s.dumpPersistState()
+	return &CheckpointState{
+		Time:           time.Now(),
+		Containers:     cs,
+		SandboxID:      s.id,
+		HypervisorType: s.config.HypervisorType,
+		Sandbox:        ss,
+	}, nil
+}
+
+// SaveCheckpoint saves to dir a snapshot of the VM paused by
+// PauseForCheckpoint, and the state of the sandbox describing it, then
+// resumes the VM. It does not need the sandbox to be locked, saving the
+// memory of the VM possibly being slow.
+func (s *Sandbox) SaveCheckpoint(ctx context.Context, dir string, state *CheckpointState) (err error) {
+	defer func() {
+		if resumeErr := s.hypervisor.ResumeVM(ctx); resumeErr != nil {
+			s.Logger().WithError(resumeErr).Error("failed to resume the VM after the checkpoint")
+			if err == nil {
+				err = resumeErr
+			}
+		}
+	}()
+
+	if err := os.MkdirAll(dir, DirMode); err != nil {
+		return err
+	}
+
+	done := watchOperation(slowOperationHypervisor, "SaveVM", dir)
+	err = s.hypervisor.SaveVM(filepath.Join(dir, CheckpointVMStateFile))
+	done()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	s.Logger().WithField("checkpoint", dir).Info("VM saved")
+
+	return os.WriteFile(filepath.Join(dir, CheckpointSandboxStateFile), data, 0600)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type snapshotMockHypervisor struct {
+	mockHypervisor
+	saved  string
+	paused bool
+}
+
+func (m *snapshotMockHypervisor) Capabilities(ctx context.Context) types.Capabilities {
+	caps := m.mockHypervisor.Capabilities(ctx)
+	caps.SetSnapshotSupport()
+	return caps
+}
+
+func (m *snapshotMockHypervisor) PauseVM(ctx context.Context) error {
+	m.paused = true
+	return nil
+}
+
+func (m *snapshotMockHypervisor) SaveVM(statePath string) error {
+	m.saved = statePath
+	return os.WriteFile(statePath, []byte("state"), 0600)
+}
+
+func (m *snapshotMockHypervisor) ResumeVM(ctx context.Context) error {
+	m.paused = false
+	return nil
+}
+
+func TestSandboxCheckpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	s, err := testCreateSandbox(t, testSandboxID, MockHypervisor, newHypervisorConfig(nil, nil), NetworkConfig{}, nil, nil)
+	assert.NoError(err)
+	defer cleanUp()
+
+	dir := filepath.Join(t.TempDir(), "checkpoint")
+
+	// the sandbox is not running
+	_, err = s.PauseForCheckpoint(context.Background())
+	assert.Error(err)
+
+	s.state.State = types.StateRunning
+
+	// the hypervisor does not support snapshots
+	_, err = s.PauseForCheckpoint(context.Background())
+	assert.Error(err)
+
+	h := &snapshotMockHypervisor{}
+	s.hypervisor = h
+
+	state, err := s.PauseForCheckpoint(context.Background())
+	assert.NoError(err)
+	assert.True(h.paused)
+
+	assert.NoError(s.SaveCheckpoint(context.Background(), dir, state))
+	assert.Equal(filepath.Join(dir, CheckpointVMStateFile), h.saved)
+	assert.False(h.paused)
+
+	data, err := os.ReadFile(filepath.Join(dir, CheckpointSandboxStateFile))
+	assert.NoError(err)
+
+	var saved CheckpointState
+	assert.NoError(json.Unmarshal(data, &saved))
+	assert.Equal(testSandboxID, saved.SandboxID)
+	assert.Equal(MockHypervisor, saved.HypervisorType)
+	assert.Equal(string(types.StateRunning), saved.Sandbox.State)
+}
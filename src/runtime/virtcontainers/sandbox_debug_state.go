@@ -0,0 +1,80 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"sort"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
+)
+
+// SandboxDebugState is a snapshot of the sandbox, for debugging stuck pods.
+type SandboxDebugState struct {
+	ID             string                `json:"id"`
+	State          types.StateString     `json:"state"`
+	HypervisorPIDs []int                 `json:"hypervisor_pids"`
+	Containers     []ContainerDebugState `json:"containers"`
+	Devices        []DeviceDebugState    `json:"devices"`
+}
+
+// ContainerDebugState is a snapshot of a container of the sandbox.
+type ContainerDebugState struct {
+	ID    string            `json:"id"`
+	State types.StateString `json:"state"`
+	PID   int               `json:"pid"`
+	// IDs of the devices of the container
+	Devices []string `json:"devices"`
+}
+
+// DeviceDebugState is a snapshot of a device of the sandbox.
+type DeviceDebugState struct {
+	ID          string            `json:"id"`
+	Type        config.DeviceType `json:"type"`
+	HostPath    string            `json:"host_path"`
+	AttachCount uint              `json:"attach_count"`
+}
+
+// DebugState returns a snapshot of the sandbox, its containers and devices,
+// sorted by ID.
+func (s *Sandbox) DebugState() SandboxDebugState {
+	state := SandboxDebugState{
+		ID:             s.id,
+		State:          s.state.State,
+		HypervisorPIDs: s.hypervisor.GetPids(),
+	}
+
+	for _, c := range s.containers {
+		cs := ContainerDebugState{
+			ID:    c.id,
+			State: c.state.State,
+			PID:   c.process.Pid,
+		}
+		for _, dev := range c.devices {
+			cs.Devices = append(cs.Devices, dev.ID)
+		}
+		state.Containers = append(state.Containers, cs)
+	}
+	sort.Slice(state.Containers, func(i, j int) bool {
+		return state.Containers[i].ID < state.Containers[j].ID
+	})
+
+	if s.devManager != nil {
+		for _, dev := range s.devManager.GetAllDevices() {
+			state.Devices = append(state.Devices, DeviceDebugState{
+				ID:          dev.DeviceID(),
+				Type:        dev.DeviceType(),
+				HostPath:    dev.GetHostPath(),
+				AttachCount: dev.GetAttachCount(),
+			})
+		}
+	}
+	sort.Slice(state.Devices, func(i, j int) bool {
+		return state.Devices[i].ID < state.Devices[j].ID
+	})
+
+	return state
+}
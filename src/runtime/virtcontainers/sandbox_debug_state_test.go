@@ -0,0 +1,49 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
+)
+
+func TestSandboxDebugState(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &Sandbox{
+		id:         testSandboxID,
+		hypervisor: &mockHypervisor{mockPid: 42},
+		state:      types.SandboxState{State: types.StateRunning},
+		containers: map[string]*Container{
+			"b": {
+				id:      "b",
+				state:   types.ContainerState{State: types.StateStopped},
+				devices: []ContainerDevice{{ID: "dev1"}},
+			},
+			"a": {
+				id:      "a",
+				state:   types.ContainerState{State: types.StateRunning},
+				process: Process{Pid: 7},
+			},
+		},
+	}
+
+	state := s.DebugState()
+	assert.Equal(testSandboxID, state.ID)
+	assert.Equal(types.StateRunning, state.State)
+	assert.Equal([]int{42}, state.HypervisorPIDs)
+	assert.Empty(state.Devices)
+
+	assert.Len(state.Containers, 2)
+	assert.Equal("a", state.Containers[0].ID)
+	assert.Equal(7, state.Containers[0].PID)
+	assert.Equal("b", state.Containers[1].ID)
+	assert.Equal(types.StateStopped, state.Containers[1].State)
+	assert.Equal([]string{"dev1"}, state.Containers[1].Devices)
+}
@@ -7,8 +7,15 @@ package virtcontainers
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
 
+	v1 "github.com/containerd/cgroups/stats/v1"
 	mutils "github.com/kata-containers/kata-containers/src/runtime/pkg/utils"
+	kataclient "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/client"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/grpc"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/procfs"
@@ -64,6 +71,15 @@ var (
 		Help:      "Open FDs for hypervisor.",
 	})
 
+	// sandbox network interfaces, as seen from the host
+	podNetdev = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespaceKatashim,
+		Name:      "pod_netdev",
+		Help:      "Kata pod network devices statistics.",
+	},
+		[]string{"interface", "item"},
+	)
+
 	// agent
 	agentRPCDurationsHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Namespace: namespaceKatashim,
@@ -74,6 +90,30 @@ var (
 		[]string{"action"},
 	)
 
+	agentReconnections = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespaceKatashim,
+		Name:      "agent_reconnections_total",
+		Help:      "Number of times the shim reconnected to the agent after losing the connection.",
+	})
+
+	agentHealthCheckLatency = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespaceKatashim,
+		Name:      "agent_health_check_latency_milliseconds",
+		Help:      "Round-trip latency of the last agent health check.",
+	})
+
+	agentHealthCheckFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespaceKatashim,
+		Name:      "agent_health_check_failures_total",
+		Help:      "Number of failed agent health checks.",
+	})
+
+	agentHealthy = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespaceKatashim,
+		Name:      "agent_healthy",
+		Help:      "Whether the agent passed its health checks (1) or not (0).",
+	})
+
 	// virtiofsd
 	virtiofsdThreads = prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: namespaceVirtiofsd,
@@ -110,8 +150,84 @@ var (
 		Name:      "fds",
 		Help:      "Open FDs for virtiofsd.",
 	})
+
+	virtiofsdSchedWait = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespaceVirtiofsd,
+		Name:      "sched_wait_seconds_total",
+		Help:      "Time the virtiofsd threads waited for a CPU, adding to the latency of the requests.",
+	})
+
+	virtiofsdCgroup = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespaceVirtiofsd,
+		Name:      "cgroup",
+		Help:      "Resource usage of the virtiofsd daemons, when limited.",
+	},
+		[]string{"item"},
+	)
+
+	virtiofsdUnexpectedQuits = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespaceVirtiofsd,
+		Name:      "unexpected_quits_total",
+		Help:      "Number of times virtiofsd quit while it was not stopped.",
+	})
+
+	// boot
+	bootPhaseDurationsHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespaceKatashim,
+		Name:      "boot_phase_durations_histogram_milliseconds",
+		Help:      "Duration of the phases of the boot of the sandbox and of the start of its containers.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 14),
+	},
+		[]string{"phase"},
+	)
+
+	// watchdog
+	slowOperations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespaceKatashim,
+		Name:      "slow_operations_total",
+		Help:      "Number of agent requests, hypervisor operations and mounts that lasted longer than the threshold.",
+	},
+		[]string{"kind", "operation"},
+	)
+
+	// log forwarding
+	droppedLogLines = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespaceKatashim,
+		Name:      "log_dropped_lines_total",
+		Help:      "Number of lines of the logs of the sandbox not forwarded to the shim log, as exceeding the rate limit.",
+	},
+		[]string{"source"},
+	)
 )
 
+// The phases of the boot of the sandbox and of the start of its containers,
+// whose durations are observed when they succeed.
+const (
+	// scan of the network namespace, and hotplug of its endpoints for the
+	// VMs of the factory
+	bootPhaseNetnsScan = "netns-scan"
+
+	// fetch of a VM from the factory
+	bootPhaseFactoryFetch = "factory-fetch"
+
+	// start of the hypervisor, until the VM is booted
+	bootPhaseHypervisorExec = "hypervisor-exec"
+
+	// start of the sandbox by the agent
+	bootPhaseAgentReady = "agent-ready"
+
+	// share of the rootfs of a container with the VM
+	bootPhaseRootfsMount = "rootfs-mount"
+
+	// start of a container by the agent
+	bootPhaseContainerStart = "container-start"
+)
+
+// observeBootPhase observes the duration of the boot phase started at start.
+func observeBootPhase(phase string, start time.Time) {
+	bootPhaseDurationsHistogram.WithLabelValues(phase).Observe(float64(time.Since(start)) / float64(time.Millisecond))
+}
+
 func RegisterMetrics() {
 	// hypervisor
 	prometheus.MustRegister(hypervisorThreads)
@@ -120,14 +236,29 @@ func RegisterMetrics() {
 	prometheus.MustRegister(hypervisorNetdev)
 	prometheus.MustRegister(hypervisorIOStat)
 	prometheus.MustRegister(hypervisorOpenFDs)
+	prometheus.MustRegister(podNetdev)
 	// agent
 	prometheus.MustRegister(agentRPCDurationsHistogram)
+	prometheus.MustRegister(agentReconnections)
+	prometheus.MustRegister(agentHealthCheckLatency)
+	prometheus.MustRegister(agentHealthCheckFailures)
+	prometheus.MustRegister(agentHealthy)
+	kataclient.RegisterMetrics()
 	// virtiofsd
 	prometheus.MustRegister(virtiofsdThreads)
 	prometheus.MustRegister(virtiofsdProcStatus)
 	prometheus.MustRegister(virtiofsdProcStat)
 	prometheus.MustRegister(virtiofsdIOStat)
 	prometheus.MustRegister(virtiofsdOpenFDs)
+	prometheus.MustRegister(virtiofsdSchedWait)
+	prometheus.MustRegister(virtiofsdCgroup)
+	prometheus.MustRegister(virtiofsdUnexpectedQuits)
+	// boot
+	prometheus.MustRegister(bootPhaseDurationsHistogram)
+	// watchdog
+	prometheus.MustRegister(slowOperations)
+	// log forwarding
+	prometheus.MustRegister(droppedLogLines)
 }
 
 // UpdateRuntimeMetrics update shim/hypervisor's metrics
@@ -173,6 +304,15 @@ func (s *Sandbox) UpdateRuntimeMetrics() error {
 		mutils.SetGaugeVecProcIO(hypervisorIOStat, ioStat)
 	}
 
+	// pod network device statistics
+	if s.network.NetworkID() != "" {
+		if netStats, err := s.network.Stats(context.Background()); err == nil {
+			for _, v := range netStats {
+				setGaugeVecNetworkStats(podNetdev, v)
+			}
+		}
+	}
+
 	// virtiofs metrics
 	err = s.UpdateVirtiofsdMetrics()
 	if err != nil {
@@ -215,9 +355,88 @@ func (s *Sandbox) UpdateVirtiofsdMetrics() error {
 		mutils.SetGaugeVecProcIO(virtiofsdIOStat, ioStat)
 	}
 
+	// scheduling delay of the worker threads
+	if wait, err := procWaitingNanoseconds(*vfsPid); err == nil {
+		addVirtiofsdSchedWait(wait)
+	}
+
+	// resource controller of all the daemons
+	if s.virtiofsdController != nil {
+		if stats, err := s.virtiofsdController.Stat(); err == nil {
+			setGaugeVecVirtiofsdCgroup(virtiofsdCgroup, stats)
+		}
+	}
+
 	return nil
 }
 
+// virtiofsdSchedWaitLast is the scheduling delay of the virtiofsd threads
+// read last, the counter being increased by the delay read since.
+var virtiofsdSchedWaitLast struct {
+	sync.Mutex
+	ns uint64
+}
+
+// addVirtiofsdSchedWait adds to the counter the scheduling delay of the
+// virtiofsd threads since it was read last. The delay of the threads which
+// exited meanwhile is lost, the delay read is then lower than the previous
+// one, and counted from there.
+func addVirtiofsdSchedWait(wait uint64) {
+	virtiofsdSchedWaitLast.Lock()
+	defer virtiofsdSchedWaitLast.Unlock()
+
+	if wait > virtiofsdSchedWaitLast.ns {
+		virtiofsdSchedWait.Add(float64(wait-virtiofsdSchedWaitLast.ns) / 1e9)
+	}
+	virtiofsdSchedWaitLast.ns = wait
+}
+
+// procWaitingNanoseconds returns the time all the threads of a process
+// spent runnable, waiting for a CPU.
+func procWaitingNanoseconds(pid int) (uint64, error) {
+	entries, err := os.ReadDir(fmt.Sprintf("/proc/%d/task", pid))
+	if err != nil {
+		return 0, err
+	}
+
+	var wait uint64
+	for _, entry := range entries {
+		tid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		thread, err := procfs.NewProc(tid)
+		if err != nil {
+			// the thread exited
+			continue
+		}
+
+		schedstat, err := thread.Schedstat()
+		if err != nil {
+			continue
+		}
+
+		wait += schedstat.WaitingNanoseconds
+	}
+
+	return wait, nil
+}
+
+// setGaugeVecVirtiofsdCgroup set gauge for the virtiofsd cgroup statistics
+func setGaugeVecVirtiofsdCgroup(gv *prometheus.GaugeVec, stats *v1.Metrics) {
+	if stats.Memory != nil && stats.Memory.Usage != nil {
+		gv.WithLabelValues("memory_usage_bytes").Set(float64(stats.Memory.Usage.Usage))
+		gv.WithLabelValues("memory_limit_bytes").Set(float64(stats.Memory.Usage.Limit))
+		gv.WithLabelValues("memory_failcnt").Set(float64(stats.Memory.Usage.Failcnt))
+	}
+
+	if stats.CPU != nil && stats.CPU.Throttling != nil {
+		gv.WithLabelValues("cpu_throttled_periods").Set(float64(stats.CPU.Throttling.ThrottledPeriods))
+		gv.WithLabelValues("cpu_throttled_seconds").Set(float64(stats.CPU.Throttling.ThrottledTime) / 1e9)
+	}
+}
+
 func (s *Sandbox) GetAgentMetrics(ctx context.Context) (string, error) {
 	r, err := s.agent.getAgentMetrics(ctx, &grpc.GetMetricsRequest{})
 	if err != nil {
@@ -225,3 +444,16 @@ func (s *Sandbox) GetAgentMetrics(ctx context.Context) (string, error) {
 	}
 	return r.Metrics, nil
 }
+
+// setGaugeVecNetworkStats set gauge for NetworkStats
+func setGaugeVecNetworkStats(gv *prometheus.GaugeVec, v *NetworkStats) {
+	gv.WithLabelValues(v.Name, "recv_bytes").Set(float64(v.RxBytes))
+	gv.WithLabelValues(v.Name, "recv_packets").Set(float64(v.RxPackets))
+	gv.WithLabelValues(v.Name, "recv_errs").Set(float64(v.RxErrors))
+	gv.WithLabelValues(v.Name, "recv_drop").Set(float64(v.RxDropped))
+
+	gv.WithLabelValues(v.Name, "sent_bytes").Set(float64(v.TxBytes))
+	gv.WithLabelValues(v.Name, "sent_packets").Set(float64(v.TxPackets))
+	gv.WithLabelValues(v.Name, "sent_errs").Set(float64(v.TxErrors))
+	gv.WithLabelValues(v.Name, "sent_drop").Set(float64(v.TxDropped))
+}
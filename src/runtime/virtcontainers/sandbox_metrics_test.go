@@ -0,0 +1,100 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	v1 "github.com/containerd/cgroups/stats/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcWaitingNanoseconds(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := procWaitingNanoseconds(os.Getpid())
+	assert.NoError(err)
+
+	_, err = procWaitingNanoseconds(-1)
+	assert.Error(err)
+}
+
+func TestAddVirtiofsdSchedWait(t *testing.T) {
+	assert := assert.New(t)
+
+	value := func() float64 {
+		m := &dto.Metric{}
+		assert.NoError(virtiofsdSchedWait.Write(m))
+		return m.GetCounter().GetValue()
+	}
+
+	start := value()
+	addVirtiofsdSchedWait(virtiofsdSchedWaitLast.ns + 2e9)
+	assert.Equal(start+2, value())
+
+	// The delay of the threads which exited is lost, the counter does not
+	// decrease but increases from the delay read
+	addVirtiofsdSchedWait(virtiofsdSchedWaitLast.ns - 1e9)
+	assert.Equal(start+2, value())
+	addVirtiofsdSchedWait(virtiofsdSchedWaitLast.ns + 1e9)
+	assert.Equal(start+3, value())
+}
+
+func TestSetGaugeVecVirtiofsdCgroup(t *testing.T) {
+	assert := assert.New(t)
+
+	gv := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "test"}, []string{"item"})
+
+	value := func(item string) float64 {
+		m := &dto.Metric{}
+		assert.NoError(gv.WithLabelValues(item).Write(m))
+		return m.GetGauge().GetValue()
+	}
+
+	setGaugeVecVirtiofsdCgroup(gv, &v1.Metrics{})
+	assert.Equal(float64(0), value("memory_usage_bytes"))
+
+	setGaugeVecVirtiofsdCgroup(gv, &v1.Metrics{
+		Memory: &v1.MemoryStat{
+			Usage: &v1.MemoryEntry{
+				Usage: 64 << 20,
+				Limit: 256 << 20,
+			},
+		},
+		CPU: &v1.CPUStat{
+			Throttling: &v1.Throttle{
+				ThrottledPeriods: 3,
+				ThrottledTime:    1500000000,
+			},
+		},
+	})
+	assert.Equal(float64(64<<20), value("memory_usage_bytes"))
+	assert.Equal(float64(256<<20), value("memory_limit_bytes"))
+	assert.Equal(float64(3), value("cpu_throttled_periods"))
+	assert.Equal(float64(1.5), value("cpu_throttled_seconds"))
+}
+
+func TestObserveBootPhase(t *testing.T) {
+	assert := assert.New(t)
+
+	histogram := func(phase string) *dto.Histogram {
+		m := &dto.Metric{}
+		assert.NoError(bootPhaseDurationsHistogram.WithLabelValues(phase).(prometheus.Metric).Write(m))
+		return m.GetHistogram()
+	}
+
+	count := histogram(bootPhaseAgentReady).GetSampleCount()
+
+	observeBootPhase(bootPhaseAgentReady, time.Now().Add(-50*time.Millisecond))
+
+	h := histogram(bootPhaseAgentReady)
+	assert.Equal(count+1, h.GetSampleCount())
+	assert.True(h.GetSampleSum() >= 50)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/procfs"
+)
+
+// userHZ is the clock ticks per second the CPU times of /proc/<pid>/stat are
+// counted in, as assumed by procfs.
+const userHZ = 100
+
+// statGuestTimeField is the index of guest_time in the fields of
+// /proc/<pid>/stat following the command.
+const statGuestTimeField = 40
+
+// ProcessUsage is the host resource usage of processes.
+type ProcessUsage struct {
+	UserNanoseconds   uint64
+	SystemNanoseconds uint64
+	// GuestNanoseconds is the time spent running the vCPUs of a guest,
+	// included in UserNanoseconds.
+	GuestNanoseconds uint64
+	RSSBytes         uint64
+	Threads          uint64
+	FDs              uint64
+}
+
+// Add adds the usage of other processes.
+func (u *ProcessUsage) Add(other ProcessUsage) {
+	u.UserNanoseconds += other.UserNanoseconds
+	u.SystemNanoseconds += other.SystemNanoseconds
+	u.GuestNanoseconds += other.GuestNanoseconds
+	u.RSSBytes += other.RSSBytes
+	u.Threads += other.Threads
+	u.FDs += other.FDs
+}
+
+// ProcessUsageOf returns the host resource usage of a process.
+func ProcessUsageOf(proc procfs.Proc) (ProcessUsage, error) {
+	stat, err := proc.Stat()
+	if err != nil {
+		return ProcessUsage{}, err
+	}
+
+	fds, err := proc.FileDescriptorsLen()
+	if err != nil {
+		return ProcessUsage{}, err
+	}
+
+	guestTime, err := processGuestTime(proc.PID)
+	if err != nil {
+		return ProcessUsage{}, err
+	}
+
+	return ProcessUsage{
+		UserNanoseconds:   uint64(stat.UTime) * uint64(time.Second) / userHZ,
+		SystemNanoseconds: uint64(stat.STime) * uint64(time.Second) / userHZ,
+		GuestNanoseconds:  guestTime * uint64(time.Second) / userHZ,
+		RSSBytes:          uint64(stat.ResidentMemory()),
+		Threads:           uint64(stat.NumThreads),
+		FDs:               uint64(fds),
+	}, nil
+}
+
+// processGuestTime returns the clock ticks a process spent running the vCPUs
+// of a guest, which procfs does not parse.
+func processGuestTime(pid int) (uint64, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, err
+	}
+
+	// the command may contain spaces and parentheses
+	stat := string(data)
+	fields := strings.Fields(stat[strings.LastIndex(stat, ")")+1:])
+	if len(fields) <= statGuestTimeField {
+		return 0, fmt.Errorf("no guest time in the stat of process %d", pid)
+	}
+
+	return strconv.ParseUint(fields[statGuestTimeField], 10, 64)
+}
+
+// OverheadStats is the host resource usage of the processes running the
+// sandbox, on top of the one of its guest.
+type OverheadStats struct {
+	Hypervisor ProcessUsage
+	Virtiofsd  ProcessUsage
+}
+
+// OverheadStats returns the host resource usage of the hypervisor and of the
+// virtiofsd daemons of the sandbox.
+func (s *Sandbox) OverheadStats() (OverheadStats, error) {
+	var stats OverheadStats
+
+	virtiofsd := make(map[int]bool)
+	if getter, ok := s.hypervisor.(virtiofsdPidsGetter); ok {
+		for _, pid := range getter.virtiofsdPids() {
+			virtiofsd[pid] = true
+		}
+	}
+
+	// the pids of the hypervisor include the ones of the virtiofsd daemons
+	// for some hypervisors
+	for _, pid := range s.hypervisor.GetPids() {
+		if pid == 0 || virtiofsd[pid] {
+			continue
+		}
+
+		usage, err := processUsage(pid)
+		if err != nil {
+			return OverheadStats{}, err
+		}
+		stats.Hypervisor.Add(usage)
+	}
+
+	for pid := range virtiofsd {
+		usage, err := processUsage(pid)
+		if err != nil {
+			return OverheadStats{}, err
+		}
+		stats.Virtiofsd.Add(usage)
+	}
+
+	return stats, nil
+}
+
+func processUsage(pid int) (ProcessUsage, error) {
+	proc, err := procfs.NewProc(pid)
+	if err != nil {
+		return ProcessUsage{}, err
+	}
+
+	return ProcessUsageOf(proc)
+}
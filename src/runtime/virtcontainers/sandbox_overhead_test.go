@@ -0,0 +1,74 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"os"
+	"testing"
+
+	"github.com/prometheus/procfs"
+	"github.com/stretchr/testify/assert"
+)
+
+type virtiofsdMockHypervisor struct {
+	mockHypervisor
+	virtiofsd []int
+}
+
+func (m *virtiofsdMockHypervisor) GetPids() []int {
+	return append([]int{m.mockPid}, m.virtiofsd...)
+}
+
+func (m *virtiofsdMockHypervisor) virtiofsdPids() []int {
+	return m.virtiofsd
+}
+
+func TestProcessUsageOf(t *testing.T) {
+	assert := assert.New(t)
+
+	proc, err := procfs.Self()
+	assert.NoError(err)
+
+	usage, err := ProcessUsageOf(proc)
+	assert.NoError(err)
+	assert.NotZero(usage.RSSBytes)
+	assert.NotZero(usage.Threads)
+	assert.NotZero(usage.FDs)
+	assert.Zero(usage.GuestNanoseconds)
+
+	total := usage
+	total.Add(usage)
+	assert.Equal(2*usage.RSSBytes, total.RSSBytes)
+	assert.Equal(2*usage.UserNanoseconds, total.UserNanoseconds)
+	assert.Equal(2*usage.Threads, total.Threads)
+	assert.Equal(2*usage.FDs, total.FDs)
+}
+
+func TestSandboxOverheadStats(t *testing.T) {
+	assert := assert.New(t)
+
+	// no hypervisor process
+	s := &Sandbox{
+		hypervisor: &mockHypervisor{},
+	}
+	stats, err := s.OverheadStats()
+	assert.NoError(err)
+	assert.Equal(OverheadStats{}, stats)
+
+	// the virtiofsd daemon is not accounted to the hypervisor
+	s.hypervisor = &virtiofsdMockHypervisor{
+		virtiofsd: []int{os.Getpid()},
+	}
+	stats, err = s.OverheadStats()
+	assert.NoError(err)
+	assert.Zero(stats.Hypervisor.RSSBytes)
+	assert.NotZero(stats.Virtiofsd.RSSBytes)
+
+	// the hypervisor process is gone
+	s.hypervisor = &mockHypervisor{mockPid: 1 << 30}
+	_, err = s.OverheadStats()
+	assert.Error(err)
+}
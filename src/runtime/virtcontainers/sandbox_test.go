@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	ktu "github.com/kata-containers/kata-containers/src/runtime/pkg/katatestutils"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/api"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/drivers"
 	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/manager"
@@ -193,6 +194,41 @@ func TestCalculateSandboxMemHandlesNegativeLimits(t *testing.T) {
 	assert.Equal(t, swap, int64(0))
 }
 
+func TestHotpluggedMemoryBlocks(t *testing.T) {
+	assert := assert.New(t)
+
+	sandbox := &Sandbox{}
+	sandbox.config = &SandboxConfig{
+		HypervisorConfig: HypervisorConfig{
+			MemorySize: 2048,
+		},
+	}
+
+	// The block size is unknown
+	assert.Equal(uint32(0), sandbox.hotpluggedMemoryBlocks(3072))
+
+	sandbox.state.GuestMemoryBlockSizeMB = 128
+	assert.Equal(uint32(8), sandbox.hotpluggedMemoryBlocks(3072))
+	assert.Equal(uint32(0), sandbox.hotpluggedMemoryBlocks(2048))
+	assert.Equal(uint32(0), sandbox.hotpluggedMemoryBlocks(1024))
+}
+
+func TestMemoryShrinkHysteresis(t *testing.T) {
+	assert := assert.New(t)
+
+	sandbox := &Sandbox{}
+	sandbox.config = &SandboxConfig{}
+
+	assert.Equal(uint32(2048), sandbox.memoryShrinkHysteresis(2048, 2048))
+	assert.Equal(uint32(3072), sandbox.memoryShrinkHysteresis(2048, 3072))
+	assert.Equal(uint32(2047), sandbox.memoryShrinkHysteresis(2048, 2047))
+
+	sandbox.config.HypervisorConfig.MemoryShrinkHysteresisMB = 512
+	assert.Equal(uint32(3072), sandbox.memoryShrinkHysteresis(2048, 3072))
+	assert.Equal(uint32(2048), sandbox.memoryShrinkHysteresis(2048, 1536))
+	assert.Equal(uint32(1535), sandbox.memoryShrinkHysteresis(2048, 1535))
+}
+
 func TestCreateSandboxEmptyID(t *testing.T) {
 	hConfig := newHypervisorConfig(nil, nil)
 	_, err := testCreateSandbox(t, "", MockHypervisor, hConfig, NetworkConfig{}, nil, nil)
@@ -1135,6 +1171,33 @@ func TestContainerProcessIOStream(t *testing.T) {
 	assert.Nil(t, err, "Winsize process failed: %v", err)
 }
 
+func TestBootBlockIndexes(t *testing.T) {
+	assert := assert.New(t)
+
+	sandboxConfig := SandboxConfig{
+		HypervisorType: QemuHypervisor,
+		HypervisorConfig: HypervisorConfig{
+			ImagePath:         "/usr/share/kata-containers/kata-containers.img",
+			BlockDeviceDriver: config.VirtioBlock,
+		},
+	}
+
+	// The image is attached as a NVDIMM device
+	assert.Empty(bootBlockIndexes(sandboxConfig))
+
+	// The image is the first virtio-blk device
+	sandboxConfig.HypervisorConfig.DisableImageNvdimm = true
+	assert.Equal(map[int]struct{}{0: {}}, bootBlockIndexes(sandboxConfig))
+
+	// Container block devices are not named after their index
+	sandboxConfig.HypervisorConfig.BlockDeviceDriver = config.VirtioSCSI
+	assert.Empty(bootBlockIndexes(sandboxConfig))
+
+	sandboxConfig.HypervisorConfig.BlockDeviceDriver = config.VirtioBlock
+	sandboxConfig.HypervisorType = FirecrackerHypervisor
+	assert.Empty(bootBlockIndexes(sandboxConfig))
+}
+
 func TestAttachBlockDevice(t *testing.T) {
 	hypervisor := &mockHypervisor{}
 
@@ -1670,3 +1733,167 @@ func TestSandboxHugepageLimit(t *testing.T) {
 	err = s.updateResources(context.Background())
 	assert.NoError(t, err)
 }
+
+func TestSandboxRemoveDeletedInterface(t *testing.T) {
+	assert := assert.New(t)
+
+	ep := &VhostUserEndpoint{
+		IfaceName:    "net1",
+		HardAddr:     "02:00:ca:fe:00:01",
+		EndpointType: VhostUserEndpointType,
+	}
+
+	s := &Sandbox{
+		ctx:        context.Background(),
+		id:         "testSandboxRemoveDeletedInterface",
+		config:     &SandboxConfig{},
+		hypervisor: &mockHypervisor{},
+		network:    &LinuxNetwork{eps: []Endpoint{ep}},
+	}
+
+	// No network namespace to watch
+	assert.NoError(s.startNetnsWatcher())
+	assert.Nil(s.nw)
+	s.stopNetnsWatcher()
+
+	// Interfaces without an endpoint are ignored
+	s.removeDeletedInterface(context.Background(), "eth0")
+	assert.Equal([]Endpoint{ep}, s.network.Endpoints())
+
+	// Endpoints which cannot be hot detached are kept
+	s.removeDeletedInterface(context.Background(), "net1")
+	assert.Equal([]Endpoint{ep}, s.network.Endpoints())
+}
+
+func TestSandboxResizeGuestVolume(t *testing.T) {
+	assert := assert.New(t)
+
+	dm := manager.NewDeviceManager(config.VirtioBlock, false, "", nil)
+	device, err := dm.NewDevice(config.DeviceInfo{
+		HostPath:      "/dev/hda",
+		ContainerPath: "/dev/hda",
+		DevType:       "b",
+	})
+	assert.NoError(err)
+	assert.NoError(device.Attach(context.Background(), &api.MockDeviceReceiver{}))
+
+	volumePath := filepath.Join(t.TempDir(), "volume")
+	assert.NoError(os.WriteFile(volumePath, nil, 0600))
+
+	container := &Container{
+		mounts: []Mount{
+			{
+				Source:           volumePath,
+				Destination:      "/data",
+				BlockDeviceID:    device.DeviceID(),
+				GuestDeviceMount: "/run/kata-containers/sandbox/storage/volume",
+			},
+		},
+	}
+
+	s := &Sandbox{
+		id:         "testSandboxResizeGuestVolume",
+		devManager: dm,
+		hypervisor: &mockHypervisor{},
+		agent:      &mockAgent{},
+		containers: map[string]*Container{"100": container},
+	}
+
+	assert.NoError(s.ResizeGuestVolume(context.Background(), volumePath, 1<<30))
+
+	// Unknown volume
+	assert.Error(s.ResizeGuestVolume(context.Background(), filepath.Join(t.TempDir(), "nonexistent"), 1<<30))
+
+	// Unknown device
+	container.mounts[0].BlockDeviceID = "nonexistent"
+	assert.Error(s.ResizeGuestVolume(context.Background(), volumePath, 1<<30))
+}
+
+func TestSandboxGetAllocatedResources(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &Sandbox{
+		id:         "testSandboxGetAllocatedResources",
+		hypervisor: &mockHypervisor{},
+	}
+
+	resources, err := s.GetAllocatedResources(context.Background())
+	assert.NoError(err)
+	assert.Equal(AllocatedResources{VCPUs: 1}, resources)
+}
+
+func TestConsoleCapture(t *testing.T) {
+	assert := assert.New(t)
+
+	c := &consoleCapture{max: 16}
+	assert.Equal("", c.String())
+
+	c.add("boot")
+	c.add("init")
+	assert.Equal("boot\ninit\n", c.String())
+
+	// the oldest lines are dropped
+	c.add("panic")
+	c.add("end")
+	assert.Equal("init\npanic\nend\n", c.String())
+
+	// a line longer than the capture is truncated
+	c.add("Kernel panic - not syncing")
+	assert.Equal("c - not syncing\n", c.String())
+}
+
+func TestCaptureGuestConsole(t *testing.T) {
+	assert := assert.New(t)
+
+	savedDir := consoleCaptureDir
+	consoleCaptureDir = filepath.Join(t.TempDir(), "console")
+	defer func() { consoleCaptureDir = savedDir }()
+
+	s := &Sandbox{
+		id: "testCaptureGuestConsole",
+	}
+
+	path := ConsoleCapturePath(s.id)
+
+	// no console watcher
+	s.captureGuestConsole(fmt.Errorf("agent did not respond"))
+	assert.NoFileExists(path)
+
+	s.cw = &consoleWatcher{capture: &consoleCapture{max: 1024}}
+	s.captureGuestConsole(fmt.Errorf("agent did not respond"))
+	assert.NoFileExists(path)
+
+	s.cw.capture.add("Kernel panic - not syncing: Attempted to kill init!")
+	s.captureGuestConsole(fmt.Errorf("agent did not respond"))
+
+	content, err := os.ReadFile(path)
+	assert.NoError(err)
+	assert.Equal("Kernel panic - not syncing: Attempted to kill init!\n", string(content))
+}
+
+func TestGuestConsoleCaptured(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, d := range []struct {
+		hypervisor HypervisorType
+		size       uint32
+		debug      bool
+		captured   bool
+	}{
+		{QemuHypervisor, 0, true, false},
+		{QemuHypervisor, 64, false, true},
+		{ClhHypervisor, 64, false, false},
+		{ClhHypervisor, 64, true, true},
+		{FirecrackerHypervisor, 64, false, false},
+		{FirecrackerHypervisor, 64, true, true},
+	} {
+		s := &Sandbox{
+			config: &SandboxConfig{
+				HypervisorType:     d.hypervisor,
+				HypervisorConfig:   HypervisorConfig{Debug: d.debug},
+				ConsoleCaptureSize: d.size,
+			},
+		}
+		assert.Equal(d.captured, s.guestConsoleCaptured(), "%+v", d)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"fmt"
+)
+
+const (
+	// virtio9pTransport is the transport of the 9p shares, whatever the
+	// bus of the virtio-9p devices.
+	virtio9pTransport = "virtio"
+
+	default9pCache   = "mmap"
+	default9pVersion = "9p2000.L"
+)
+
+// Supported9pCacheModes are the cache modes of the 9p shares.
+var Supported9pCacheModes = []string{"none", "loose", "fscache", "mmap"}
+
+// Supported9pVersions are the protocol versions of the 9p shares.
+var Supported9pVersions = []string{"9p2000.L", "9p2000.u"}
+
+// sharedDir9pOptions returns the mount options of the 9p shares. The small
+// default msize of the guest kernel cripples the throughput of the large
+// reads and writes, the msize is always given.
+func sharedDir9pOptions(conf *HypervisorConfig) []string {
+	msize := conf.Msize9p
+	if msize == 0 {
+		msize = defaultMsize9p
+	}
+
+	cache := conf.Cache9p
+	if cache == "" {
+		cache = default9pCache
+	}
+
+	version := conf.Version9p
+	if version == "" {
+		version = default9pVersion
+	}
+
+	return []string{
+		fmt.Sprintf("trans=%s,version=%s,cache=%s", virtio9pTransport, version, cache),
+		"nodev",
+		fmt.Sprintf("msize=%d", msize),
+	}
+}
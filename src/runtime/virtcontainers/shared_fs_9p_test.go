@@ -0,0 +1,34 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharedDir9pOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	conf := &HypervisorConfig{}
+	assert.Equal([]string{
+		"trans=virtio,version=9p2000.L,cache=mmap",
+		"nodev",
+		"msize=512000",
+	}, sharedDir9pOptions(conf))
+
+	conf = &HypervisorConfig{
+		Msize9p:   8192,
+		Cache9p:   "loose",
+		Version9p: "9p2000.u",
+	}
+	assert.Equal([]string{
+		"trans=virtio,version=9p2000.u,cache=loose",
+		"nodev",
+		"msize=8192",
+	}, sharedDir9pOptions(conf))
+}
@@ -99,7 +99,8 @@ func (endpoint *TapEndpoint) HotAttach(ctx context.Context, h Hypervisor) error
 	span, ctx := tapTrace(ctx, "HotAttach", endpoint)
 	defer span.End()
 
-	if err := tapNetwork(endpoint, h.HypervisorConfig().NumVCPUs, h.HypervisorConfig().DisableVhostNet); err != nil {
+	hConfig := h.HypervisorConfig()
+	if err := tapNetwork(endpoint, hConfig.NetQueues(), hConfig.DisableVhostNet); err != nil {
 		networkLogger().WithError(err).Error("Error bridging tap ep")
 		return err
 	}
@@ -154,20 +155,20 @@ func createTapNetworkEndpoint(idx int, ifName string) (*TapEndpoint, error) {
 	return endpoint, nil
 }
 
-func tapNetwork(endpoint *TapEndpoint, numCPUs uint32, disableVhostNet bool) error {
+func tapNetwork(endpoint *TapEndpoint, queues uint32, disableVhostNet bool) error {
 	netHandle, err := netlink.NewHandle()
 	if err != nil {
 		return err
 	}
 	defer netHandle.Close()
 
-	tapLink, fds, err := createLink(netHandle, endpoint.TapInterface.TAPIface.Name, &netlink.Tuntap{}, int(numCPUs))
+	tapLink, fds, err := createLink(netHandle, endpoint.TapInterface.TAPIface.Name, &netlink.Tuntap{}, int(queues))
 	if err != nil {
 		return fmt.Errorf("Could not create TAP interface: %s", err)
 	}
 	endpoint.TapInterface.VMFds = fds
 	if !disableVhostNet {
-		vhostFds, err := createVhostFds(int(numCPUs))
+		vhostFds, err := createVhostFds(int(queues))
 		if err != nil {
 			return fmt.Errorf("Could not setup vhost fds %s : %s", endpoint.TapInterface.Name, err)
 		}
@@ -110,7 +110,8 @@ func (endpoint *TuntapEndpoint) HotAttach(ctx context.Context, h Hypervisor) err
 	span, ctx := tuntapTrace(ctx, "HotAttach", endpoint)
 	defer span.End()
 
-	if err := tuntapNetwork(endpoint, h.HypervisorConfig().NumVCPUs, h.HypervisorConfig().DisableVhostNet); err != nil {
+	hConfig := h.HypervisorConfig()
+	if err := tuntapNetwork(endpoint, hConfig.NetQueues(), hConfig.DisableVhostNet); err != nil {
 		networkLogger().WithError(err).Error("Error bridging tun/tap ep")
 		return err
 	}
@@ -171,14 +172,14 @@ func createTuntapNetworkEndpoint(idx int, ifName string, hwName net.HardwareAddr
 	return endpoint, nil
 }
 
-func tuntapNetwork(endpoint *TuntapEndpoint, numCPUs uint32, disableVhostNet bool) error {
+func tuntapNetwork(endpoint *TuntapEndpoint, queues uint32, disableVhostNet bool) error {
 	netHandle, err := netlink.NewHandle()
 	if err != nil {
 		return err
 	}
 	defer netHandle.Close()
 
-	tapLink, _, err := createLink(netHandle, endpoint.TuntapInterface.TAPIface.Name, &netlink.Tuntap{}, int(numCPUs))
+	tapLink, _, err := createLink(netHandle, endpoint.TuntapInterface.TAPIface.Name, &netlink.Tuntap{}, int(queues))
 	if err != nil {
 		return fmt.Errorf("Could not create TAP interface: %s", err)
 	}
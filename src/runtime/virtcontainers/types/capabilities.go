@@ -10,6 +10,7 @@ const (
 	blockDeviceHotplugSupport
 	multiQueueSupport
 	fsSharingSupported
+	snapshotSupport
 )
 
 // Capabilities describe a virtcontainers hypervisor capabilities
@@ -57,3 +58,19 @@ func (caps *Capabilities) IsFsSharingSupported() bool {
 func (caps *Capabilities) SetFsSharingSupport() {
 	caps.flags |= fsSharingSupported
 }
+
+// UnsetFsSharingSupport sets the host filesystem sharing capability to false.
+func (caps *Capabilities) UnsetFsSharingSupport() {
+	caps.flags &^= fsSharingSupported
+}
+
+// IsSnapshotSupported tells if an hypervisor supports saving a snapshot of
+// the VM.
+func (caps *Capabilities) IsSnapshotSupported() bool {
+	return caps.flags&snapshotSupport != 0
+}
+
+// SetSnapshotSupport sets the VM snapshot capability to true.
+func (caps *Capabilities) SetSnapshotSupport() {
+	caps.flags |= snapshotSupport
+}
@@ -33,6 +33,8 @@ func TestFsSharingCapability(t *testing.T) {
 	assert.False(t, caps.IsFsSharingSupported())
 	caps.SetFsSharingSupport()
 	assert.True(t, caps.IsFsSharingSupported())
+	caps.UnsetFsSharingSupport()
+	assert.False(t, caps.IsFsSharingSupported())
 }
 
 func TestMultiQueueCapability(t *testing.T) {
@@ -43,3 +45,11 @@ func TestMultiQueueCapability(t *testing.T) {
 	caps.SetMultiQueueSupport()
 	assert.True(caps.IsMultiQueueSupported())
 }
+
+func TestSnapshotCapability(t *testing.T) {
+	var caps Capabilities
+
+	assert.False(t, caps.IsSnapshotSupported())
+	caps.SetSnapshotSupport()
+	assert.True(t, caps.IsSnapshotSupported())
+}
@@ -14,6 +14,15 @@ type ContainerState struct {
 	// File system of the rootfs incase it is block device
 	Fstype string `json:"fstype"`
 
+	// Size of the rootfs block device the last time it was propagated
+	// to the guest
+	BlockDeviceSize uint64 `json:"blockDeviceSize,omitempty"`
+
+	// Block devices of the image layers and of the scratch disk of the
+	// rootfs, when it is built in the guest
+	LayerDeviceIDs  []string `json:"layerDeviceIDs,omitempty"`
+	ScratchDeviceID string   `json:"scratchDeviceID,omitempty"`
+
 	// CgroupPath is the cgroup hierarchy where sandbox's processes
 	// including the hypervisor are placed.
 	CgroupPath string `json:"cgroupPath,omitempty"`
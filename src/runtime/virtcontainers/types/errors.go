@@ -6,6 +6,7 @@
 package types
 
 import (
+	"context"
 	"errors"
 )
 
@@ -18,3 +19,90 @@ var (
 	ErrNoSuchContainer   = errors.New("Container does not exist")
 	ErrInvalidConfigType = errors.New("Invalid config type")
 )
+
+// ErrorCategory classifies a failure of the runtime by what the caller can
+// do about it.
+type ErrorCategory string
+
+const (
+	// ErrorInvalidArgument is a request which is invalid.
+	ErrorInvalidArgument ErrorCategory = "invalid-argument"
+
+	// ErrorNotFound is a request for an object which does not exist.
+	ErrorNotFound ErrorCategory = "not-found"
+
+	// ErrorFailedPrecondition is a request the sandbox is not in a state
+	// to serve.
+	ErrorFailedPrecondition ErrorCategory = "failed-precondition"
+
+	// ErrorResourceExhausted is a request the host or the VM lacks the
+	// resources for.
+	ErrorResourceExhausted ErrorCategory = "resource-exhausted"
+
+	// ErrorUnavailable is a component of the sandbox, such as the agent,
+	// which cannot be reached.
+	ErrorUnavailable ErrorCategory = "unavailable"
+
+	// ErrorTimeout is an operation which did not complete in time.
+	ErrorTimeout ErrorCategory = "timeout"
+
+	// ErrorInternal is a failure of the runtime or of the components of
+	// the sandbox.
+	ErrorInternal ErrorCategory = "internal"
+)
+
+// The subsystems of the runtime the errors come from.
+const (
+	SubsystemAgent      = "agent"
+	SubsystemHypervisor = "hypervisor"
+	SubsystemNetwork    = "network"
+	SubsystemStorage    = "storage"
+	SubsystemResources  = "resources"
+)
+
+// Error is a failure of the runtime classified by category and by the
+// subsystem it comes from, for the callers of the runtime to report the
+// cause of the failure and to tell whether the request may be retried.
+type Error struct {
+	Err       error
+	Category  ErrorCategory
+	Subsystem string
+
+	// Retriable is set when the request may succeed if sent again as is.
+	Retriable bool
+}
+
+// NewError classifies err, returning nil when err is nil. An error which is
+// already classified is returned as is, as the classification closest to
+// the failure is the most accurate. An error from an expired context is
+// classified as a retriable timeout.
+func NewError(category ErrorCategory, subsystem string, retriable bool, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		category = ErrorTimeout
+		retriable = true
+	}
+
+	return &Error{
+		Err:       err,
+		Category:  category,
+		Subsystem: subsystem,
+		Retriable: retriable,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewError(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(NewError(ErrorInternal, SubsystemHypervisor, false, nil))
+
+	cause := errors.New("qemu exited")
+	err := NewError(ErrorInternal, SubsystemHypervisor, false, cause)
+	assert.Equal("qemu exited", err.Error())
+	assert.True(errors.Is(err, cause))
+
+	var e *Error
+	assert.True(errors.As(err, &e))
+	assert.Equal(ErrorInternal, e.Category)
+	assert.Equal(SubsystemHypervisor, e.Subsystem)
+	assert.False(e.Retriable)
+
+	// the classification closest to the failure is kept
+	wrapped := NewError(ErrorInternal, SubsystemStorage, false, fmt.Errorf("hotplug drive: %w", err))
+	assert.True(errors.As(wrapped, &e))
+	assert.Equal(SubsystemHypervisor, e.Subsystem)
+	assert.Equal("hotplug drive: qemu exited", wrapped.Error())
+
+	// an expired context is a retriable timeout
+	err = NewError(ErrorUnavailable, SubsystemAgent, false, fmt.Errorf("check: %w", context.DeadlineExceeded))
+	assert.True(errors.As(err, &e))
+	assert.Equal(ErrorTimeout, e.Category)
+	assert.Equal(SubsystemAgent, e.Subsystem)
+	assert.True(e.Retriable)
+}
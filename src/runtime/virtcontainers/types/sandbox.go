@@ -60,6 +60,19 @@ type SandboxState struct {
 	// cgroup.
 	OverheadCgroupPath string `json:"overheadCgroupPath,omitempty"`
 
+	// VirtiofsdCgroupPath is the path to the optional cgroup of the
+	// virtiofsd daemons, in the sandbox or overhead cgroup.
+	VirtiofsdCgroupPath string `json:"virtiofsdCgroupPath,omitempty"`
+
+	// ShimCgroupPath is the path to the optional cgroup of the shim, in
+	// the sandbox or overhead cgroup.
+	ShimCgroupPath string `json:"shimCgroupPath,omitempty"`
+
+	// VMMCgroupPath is the path to the cgroup of the VMM, in the sandbox or
+	// overhead cgroup, when it holds the cgroups of the helper processes on
+	// cgroup v2.
+	VMMCgroupPath string `json:"vmmCgroupPath,omitempty"`
+
 	// PersistVersion indicates current storage api version.
 	// It's also known as ABI version of kata-runtime.
 	// Note: it won't be written to disk
@@ -152,3 +152,54 @@ func IsAPVFIOMediatedDevice(sysfsdev string) bool {
 	}
 	return false
 }
+
+// GetBlockDeviceSize returns the size in bytes of a block device, or of a
+// regular file used as the backing file of a block device.
+func GetBlockDeviceSize(path string) (uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	st, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	// regular files do not support syscall BLKGETSIZE64
+	if st.Mode().IsRegular() {
+		return uint64(st.Size()), nil
+	}
+
+	var size uint64
+	if err := ioctlFunc(file.Fd(), unix.BLKGETSIZE64, uintptr(unsafe.Pointer(&size))); err != nil {
+		return 0, fmt.Errorf("failed to get size of %s: %v", path, err)
+	}
+
+	return size, nil
+}
+
+// PidfdOpen returns a file descriptor referring to the process pid, which
+// does not need to be a child of the caller.
+func PidfdOpen(pid int) (*os.File, error) {
+	fd, err := unix.PidfdOpen(pid, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open pidfd of process %d: %v", pid, err)
+	}
+
+	return os.NewFile(uintptr(fd), fmt.Sprintf("pidfd:%d", pid)), nil
+}
+
+// PidfdWait waits for the exit of the process the pidfd refers to. Unlike
+// wait(2), it works for the processes which are not children of the caller,
+// such as the ones left by a previous shim, but does not reap them.
+func PidfdWait(pidfd *os.File) error {
+	fds := []unix.PollFd{{Fd: int32(pidfd.Fd()), Events: unix.POLLIN}}
+	for {
+		_, err := unix.Poll(fds, -1)
+		if err != unix.EINTR {
+			return err
+		}
+	}
+}
@@ -8,9 +8,12 @@ package utils
 import (
 	"bytes"
 	"errors"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -79,3 +82,43 @@ func TestIsAPVFIOMediatedDeviceTrue(t *testing.T) {
 	isAPMdev := IsAPVFIOMediatedDevice("/sys/devices/vfio_ap/matrix/a297db4a-f4c2-11e6-90f6-d3b88d6c9525")
 	assert.True(isAPMdev)
 }
+
+func TestGetBlockDeviceSize(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "disk.img")
+	assert.NoError(os.WriteFile(path, make([]byte, 8192), 0600))
+
+	size, err := GetBlockDeviceSize(path)
+	assert.NoError(err)
+	assert.Equal(uint64(8192), size)
+
+	_, err = GetBlockDeviceSize(filepath.Join(t.TempDir(), "nonexistent"))
+	assert.Error(err)
+}
+
+func TestPidfdWait(t *testing.T) {
+	assert := assert.New(t)
+
+	cmd := exec.Command("sleep", "0.1")
+	assert.NoError(cmd.Start())
+	defer cmd.Wait()
+
+	pidfd, err := PidfdOpen(cmd.Process.Pid)
+	if err != nil {
+		t.Skipf("pidfd is not supported: %v", err)
+	}
+	defer pidfd.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- PidfdWait(pidfd)
+	}()
+
+	select {
+	case err := <-done:
+		assert.NoError(err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the process")
+	}
+}
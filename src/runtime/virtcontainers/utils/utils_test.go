@@ -15,6 +15,7 @@ import (
 	"strings"
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -580,3 +581,27 @@ func TestRevertBytes(t *testing.T) {
 	num := RevertBytes(testNum)
 	assert.Equal(expectedNum, num)
 }
+
+func TestRemoveExpiredFiles(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	old := time.Now().Add(-2 * time.Hour)
+	for _, name := range []string{"expired.json", "kept.json", "recent.json", "other.log"} {
+		path := filepath.Join(dir, name)
+		assert.NoError(os.WriteFile(path, nil, 0600))
+		if name != "recent.json" {
+			assert.NoError(os.Chtimes(path, old, old))
+		}
+	}
+
+	assert.NoError(RemoveExpiredFiles(dir, ".json", filepath.Join(dir, "kept.json"), time.Hour))
+
+	var names []string
+	entries, err := os.ReadDir(dir)
+	assert.NoError(err)
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.Equal([]string{"kept.json", "other.log", "recent.json"}, names)
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/cpuset"
+)
+
+// vcpusPinning returns the host CPU each vCPU thread pinned is pinned to,
+// given the vCPU threads of the VM and the exclusive CPUs of the sandbox, or
+// nil if they cannot be pinned. The vCPUs hotplugged for the containers, the
+// last ones, are pinned one to one, the default vCPUs being left to the
+// shared CPUs. When the guest has NUMA nodes, given along with the CPUs of
+// their host nodes, the last vCPUs of each node are pinned to the exclusive
+// CPUs of its host node.
+func vcpusPinning(vcpus map[int]int, cpus cpuset.CPUSet, nodes []GuestNUMANode, nodesCPUs []cpuset.CPUSet) map[int]int {
+	if cpus.Size() == 0 || len(vcpus) < cpus.Size() {
+		return nil
+	}
+
+	// pinLast pins the last vCPUs of ids to the CPUs
+	pinning := make(map[int]int, cpus.Size())
+	pinLast := func(ids []int, cpus []int) bool {
+		if len(ids) < len(cpus) {
+			return false
+		}
+
+		sort.Ints(ids)
+		ids = ids[len(ids)-len(cpus):]
+		for i, cpu := range cpus {
+			pinning[vcpus[ids[i]]] = cpu
+		}
+
+		return true
+	}
+
+	if len(nodes) == 0 {
+		ids := make([]int, 0, len(vcpus))
+		for id := range vcpus {
+			ids = append(ids, id)
+		}
+
+		pinLast(ids, cpus.ToSlice())
+
+		return pinning
+	}
+
+	for i, node := range nodes {
+		var ids []int
+		for _, id := range node.VCPUs {
+			if _, ok := vcpus[int(id)]; ok {
+				ids = append(ids, int(id))
+			}
+		}
+
+		if !pinLast(ids, cpus.Intersection(nodesCPUs[i]).ToSlice()) {
+			return nil
+		}
+	}
+
+	// Some of the exclusive CPUs are not on the host nodes of the guest
+	if len(pinning) != cpus.Size() {
+		return nil
+	}
+
+	return pinning
+}
+
+// exclusiveCPU tells if the CPUs of a container are exclusive, the static
+// policy of the kubelet CPU manager giving them to the containers with an
+// integer CPU limit, as many as the limit. The other containers run on the
+// shared CPUs.
+func exclusiveCPU(cpu *specs.LinuxCPU, cpus cpuset.CPUSet) bool {
+	if cpu.Quota == nil || cpu.Period == nil || *cpu.Quota <= 0 || *cpu.Period == 0 {
+		return false
+	}
+
+	quota, period := uint64(*cpu.Quota), *cpu.Period
+	return quota%period == 0 && uint64(cpus.Size()) == quota/period
+}
+
+// sandboxExclusiveCPUs returns the exclusive CPUs of the containers of the
+// sandbox, and the shared ones the others run on.
+func (s *Sandbox) sandboxExclusiveCPUs() (exclusive, shared cpuset.CPUSet, err error) {
+	exclusive = cpuset.NewCPUSet()
+	shared = cpuset.NewCPUSet()
+
+	for _, ctr := range s.config.Containers {
+		cpu := ctr.Resources.CPU
+		if cpu == nil || cpu.Cpus == "" {
+			continue
+		}
+
+		cpus, err := cpuset.Parse(cpu.Cpus)
+		if err != nil {
+			return exclusive, shared, fmt.Errorf("unable to parse CPUset.cpus for container %s: %v", ctr.ID, err)
+		}
+
+		if exclusiveCPU(cpu, cpus) {
+			exclusive = exclusive.Union(cpus)
+		} else {
+			shared = shared.Union(cpus)
+		}
+	}
+
+	return exclusive, shared.Difference(exclusive), nil
+}
+
+// setThreadAffinity restricts the thread tid to the CPUs cpus.
+func setThreadAffinity(tid int, cpus []int) error {
+	var mask unix.CPUSet
+	for _, cpu := range cpus {
+		mask.Set(cpu)
+	}
+
+	return schedSetaffinity(tid, &mask)
+}
+
+// resetThreadAffinity gives the thread tid the CPU affinity of the runtime,
+// which the VMM and its threads inherited before they were pinned: the
+// cpuset of their cgroup still restricts them.
+func resetThreadAffinity(tid int) error {
+	var mask unix.CPUSet
+	if err := unix.SchedGetaffinity(0, &mask); err != nil {
+		return fmt.Errorf("failed to get the CPU affinity of the runtime: %v", err)
+	}
+
+	return schedSetaffinity(tid, &mask)
+}
+
+func schedSetaffinity(tid int, mask *unix.CPUSet) error {
+	if err := unix.SchedSetaffinity(tid, mask); err != nil {
+		return fmt.Errorf("failed to set the CPU affinity of thread %d: %v", tid, err)
+	}
+
+	return nil
+}
+
+// checkVCPUsPinning pins the vCPU threads of the VM hotplugged for the
+// containers to the exclusive host CPUs of the sandbox, one each, when
+// enable_vcpus_pinning is set, the other vCPU threads being restricted to
+// the shared CPUs of the sandbox. The exclusive CPUs are the ones the static
+// policy of the kubelet CPU manager assigns to the containers, and they are
+// only pinned once there are as many vCPUs hotplugged, on each guest NUMA
+// node if any: the pinning is reset when the containers are removed, until
+// they match again.
+func (s *Sandbox) checkVCPUsPinning(ctx context.Context) error {
+	if s.config == nil || !s.config.EnableVCPUsPinning {
+		return nil
+	}
+
+	exclusive, shared, err := s.sandboxExclusiveCPUs()
+	if err != nil {
+		return err
+	}
+
+	tids, err := s.hypervisor.GetThreadIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get thread ids from hypervisor: %v", err)
+	}
+
+	nodes := s.config.HypervisorConfig.GuestNUMANodes
+	var nodesCPUs []cpuset.CPUSet
+	for _, node := range nodes {
+		host, err := readHostNUMANode(int(node.HostNode))
+		if err != nil {
+			return err
+		}
+		nodesCPUs = append(nodesCPUs, host.cpus)
+	}
+
+	pinning := vcpusPinning(tids.vcpus, exclusive, nodes, nodesCPUs)
+	if pinning == nil {
+		if !s.vcpusPinned {
+			return nil
+		}
+
+		s.Logger().WithFields(logrus.Fields{
+			"vcpus": len(tids.vcpus),
+			"cpus":  exclusive.String(),
+		}).Info("resetting the pinning of the vCPU threads")
+
+		for _, tid := range tids.vcpus {
+			if err := resetThreadAffinity(tid); err != nil {
+				return err
+			}
+		}
+		s.vcpusPinned = false
+
+		return nil
+	}
+
+	for _, tid := range tids.vcpus {
+		if cpu, ok := pinning[tid]; ok {
+			err = setThreadAffinity(tid, []int{cpu})
+		} else if shared.Size() > 0 {
+			err = setThreadAffinity(tid, shared.ToSlice())
+		} else {
+			err = resetThreadAffinity(tid)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	s.vcpusPinned = true
+
+	s.Logger().WithFields(logrus.Fields{
+		"cpus":   exclusive.String(),
+		"shared": shared.String(),
+	}).Debug("pinned the vCPU threads")
+
+	return nil
+}
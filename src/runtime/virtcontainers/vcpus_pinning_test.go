@@ -0,0 +1,87 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/cpuset"
+)
+
+func TestVCPUsPinning(t *testing.T) {
+	assert := assert.New(t)
+
+	vcpus := map[int]int{0: 1000, 1: 1001, 2: 1002}
+
+	assert.Nil(vcpusPinning(nil, cpuset.NewCPUSet(4), nil, nil))
+	assert.Nil(vcpusPinning(vcpus, cpuset.NewCPUSet(), nil, nil))
+	assert.Nil(vcpusPinning(vcpus, cpuset.NewCPUSet(1, 2, 4, 5), nil, nil))
+
+	assert.Equal(map[int]int{1000: 2, 1001: 4, 1002: 7}, vcpusPinning(vcpus, cpuset.NewCPUSet(7, 2, 4), nil, nil))
+
+	// The default vCPU is left to the shared CPUs
+	assert.Equal(map[int]int{1001: 4, 1002: 5}, vcpusPinning(vcpus, cpuset.NewCPUSet(4, 5), nil, nil))
+}
+
+func TestVCPUsPinningNUMA(t *testing.T) {
+	assert := assert.New(t)
+
+	vcpus := map[int]int{0: 1000, 1: 1001, 2: 1002, 3: 1003}
+	nodes := []GuestNUMANode{
+		{HostNode: 0, VCPUs: []uint32{0, 2, 4}},
+		{HostNode: 1, VCPUs: []uint32{1, 3, 5}},
+	}
+	nodesCPUs := []cpuset.CPUSet{
+		cpuset.NewCPUSet(0, 1, 2, 3),
+		cpuset.NewCPUSet(4, 5, 6, 7),
+	}
+
+	assert.Equal(map[int]int{1000: 1, 1002: 2, 1001: 5, 1003: 6},
+		vcpusPinning(vcpus, cpuset.NewCPUSet(1, 2, 5, 6), nodes, nodesCPUs))
+
+	assert.Equal(map[int]int{1002: 2, 1003: 6},
+		vcpusPinning(vcpus, cpuset.NewCPUSet(2, 6), nodes, nodesCPUs))
+
+	// The vCPUs of a node cannot be pinned to the CPUs of its host node.
+	assert.Nil(vcpusPinning(vcpus, cpuset.NewCPUSet(1, 2, 3, 5), nodes, nodesCPUs))
+}
+
+func TestSandboxExclusiveCPUs(t *testing.T) {
+	assert := assert.New(t)
+
+	quota := func(q int64) *int64 { return &q }
+	period := func(p uint64) *uint64 { return &p }
+
+	s := &Sandbox{
+		config: &SandboxConfig{
+			Containers: []ContainerConfig{
+				// The pause container runs on the shared CPUs
+				{ID: "sandbox", Resources: specs.LinuxResources{CPU: &specs.LinuxCPU{Cpus: "0-3,6-7"}}},
+				{ID: "guaranteed", Resources: specs.LinuxResources{CPU: &specs.LinuxCPU{
+					Cpus: "4-5", Quota: quota(200000), Period: period(100000)}}},
+				{ID: "burstable", Resources: specs.LinuxResources{CPU: &specs.LinuxCPU{
+					Cpus: "0-3,6-7", Quota: quota(150000), Period: period(100000)}}},
+				{ID: "unconstrained"},
+			},
+		},
+	}
+
+	exclusive, shared, err := s.sandboxExclusiveCPUs()
+	assert.NoError(err)
+	assert.Equal("4-5", exclusive.String())
+	assert.Equal("0-3,6-7", shared.String())
+}
+
+func TestCheckVCPUsPinningDisabled(t *testing.T) {
+	s := &Sandbox{config: &SandboxConfig{}}
+
+	// Neither the resource controller nor the hypervisor are needed when the
+	// pinning is disabled.
+	assert.NoError(t, s.checkVCPUsPinning(nil))
+}
@@ -0,0 +1,180 @@
+//go:build linux
+// +build linux
+
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	persistapi "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/persist/api"
+	vcTypes "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/types"
+	"github.com/safchain/ethtool"
+)
+
+var vdpaTrace = getNetworkTrace(VdpaEndpointType)
+
+// VdpaEndpoint represents a network interface backed by a vhost-vdpa device.
+// The data path is offloaded to the NIC while the guest keeps using the
+// regular virtio-net driver.
+type VdpaEndpoint struct {
+	// Path to the vhost-vdpa character device on the host system
+	VhostDevPath       string
+	IfaceName          string
+	HardAddr           string
+	EndpointProperties NetworkInfo
+	EndpointType       EndpointType
+	PCIPath            vcTypes.PciPath
+}
+
+// Properties returns the properties of the interface.
+func (endpoint *VdpaEndpoint) Properties() NetworkInfo {
+	return endpoint.EndpointProperties
+}
+
+// Name returns name of the interface.
+func (endpoint *VdpaEndpoint) Name() string {
+	return endpoint.IfaceName
+}
+
+// HardwareAddr returns the mac address of the vDPA network interface.
+func (endpoint *VdpaEndpoint) HardwareAddr() string {
+	return endpoint.HardAddr
+}
+
+// Type indentifies the endpoint as a vDPA endpoint.
+func (endpoint *VdpaEndpoint) Type() EndpointType {
+	return endpoint.EndpointType
+}
+
+// SetProperties sets the properties of the endpoint.
+func (endpoint *VdpaEndpoint) SetProperties(properties NetworkInfo) {
+	endpoint.EndpointProperties = properties
+}
+
+// PciPath returns the PCI path of the endpoint.
+func (endpoint *VdpaEndpoint) PciPath() vcTypes.PciPath {
+	return endpoint.PCIPath
+}
+
+// SetPciPath sets the PCI path of the endpoint.
+func (endpoint *VdpaEndpoint) SetPciPath(pciPath vcTypes.PciPath) {
+	endpoint.PCIPath = pciPath
+}
+
+// NetworkPair returns the network pair of the endpoint.
+func (endpoint *VdpaEndpoint) NetworkPair() *NetworkInterfacePair {
+	return nil
+}
+
+// Attach for vDPA endpoint adds the vhost-vdpa device to the hypervisor.
+func (endpoint *VdpaEndpoint) Attach(ctx context.Context, s *Sandbox) error {
+	span, ctx := vdpaTrace(ctx, "Attach", endpoint)
+	defer span.End()
+
+	return s.hypervisor.AddDevice(ctx, endpoint, NetDev)
+}
+
+// Detach for vDPA endpoint
+func (endpoint *VdpaEndpoint) Detach(ctx context.Context, netNsCreated bool, netNsPath string) error {
+	return nil
+}
+
+// HotAttach for vDPA endpoint not supported yet
+func (endpoint *VdpaEndpoint) HotAttach(ctx context.Context, h Hypervisor) error {
+	return fmt.Errorf("VdpaEndpoint does not support Hot attach")
+}
+
+// HotDetach for vDPA endpoint not supported yet
+func (endpoint *VdpaEndpoint) HotDetach(ctx context.Context, h Hypervisor, netNsCreated bool, netNsPath string) error {
+	return fmt.Errorf("VdpaEndpoint does not support Hot detach")
+}
+
+// Create a vDPA endpoint
+func createVdpaEndpoint(netInfo NetworkInfo, vhostDevPath string) (*VdpaEndpoint, error) {
+	vdpaEndpoint := &VdpaEndpoint{
+		VhostDevPath: vhostDevPath,
+		HardAddr:     netInfo.Iface.HardwareAddr.String(),
+		IfaceName:    netInfo.Iface.Name,
+		EndpointType: VdpaEndpointType,
+	}
+	return vdpaEndpoint, nil
+}
+
+// vdpaVhostDevPath checks if a physical interface has a vDPA device bound
+// to the vhost-vdpa driver, and if it does it returns the path to the
+// vhost-vdpa character device.
+func vdpaVhostDevPath(ifaceName string) (string, error) {
+	ethHandle, err := ethtool.NewEthtool()
+	if err != nil {
+		return "", err
+	}
+	defer ethHandle.Close()
+
+	bdf, err := ethHandle.BusInfo(ifaceName)
+	if err != nil {
+		return "", err
+	}
+
+	return findVdpaVhostDevPath(bdf)
+}
+
+// findVdpaVhostDevPath looks for a vhost-vdpa device created on top of the
+// PCI device identified by bdf, i.e. /sys/bus/pci/devices/$bdf/vdpaX/vhost-vdpa-Y.
+func findVdpaVhostDevPath(bdf string) (string, error) {
+	matches, err := filepath.Glob(filepath.Join(sysPCIDevicesPath, bdf, "vdpa*", "vhost-vdpa-*"))
+	if err != nil {
+		return "", err
+	}
+
+	if len(matches) == 0 {
+		return "", nil
+	}
+
+	return filepath.Join("/dev", filepath.Base(matches[0])), nil
+}
+
+func (endpoint *VdpaEndpoint) save() persistapi.NetworkEndpoint {
+	return persistapi.NetworkEndpoint{
+		Type: string(endpoint.Type()),
+		Vdpa: &persistapi.VdpaEndpoint{
+			VhostDevPath: endpoint.VhostDevPath,
+			IfaceName:    endpoint.IfaceName,
+			PCIPath:      endpoint.PCIPath,
+		},
+	}
+}
+
+func (endpoint *VdpaEndpoint) load(s persistapi.NetworkEndpoint) {
+	endpoint.EndpointType = VdpaEndpointType
+
+	if s.Vdpa != nil {
+		endpoint.VhostDevPath = s.Vdpa.VhostDevPath
+		endpoint.IfaceName = s.Vdpa.IfaceName
+		endpoint.PCIPath = s.Vdpa.PCIPath
+	}
+}
+
+// unsupported
+func (endpoint *VdpaEndpoint) GetRxRateLimiter() bool {
+	return false
+}
+
+func (endpoint *VdpaEndpoint) SetRxRateLimiter() error {
+	return fmt.Errorf("rx rate limiter is unsupported for vdpa endpoint")
+}
+
+// unsupported
+func (endpoint *VdpaEndpoint) GetTxRateLimiter() bool {
+	return false
+}
+
+func (endpoint *VdpaEndpoint) SetTxRateLimiter() error {
+	return fmt.Errorf("tx rate limiter is unsupported for vdpa endpoint")
+}
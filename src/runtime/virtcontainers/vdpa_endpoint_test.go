@@ -0,0 +1,96 @@
+//go:build linux
+// +build linux
+
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+)
+
+func TestFindVdpaVhostDevPath(t *testing.T) {
+	assert := assert.New(t)
+
+	savedSysPCIDevicesPath := sysPCIDevicesPath
+	defer func() {
+		sysPCIDevicesPath = savedSysPCIDevicesPath
+	}()
+	sysPCIDevicesPath = t.TempDir()
+
+	bdf := "0000:3b:00.2"
+	path, err := findVdpaVhostDevPath(bdf)
+	assert.NoError(err)
+	assert.Empty(path)
+
+	err = os.MkdirAll(filepath.Join(sysPCIDevicesPath, bdf, "vdpa0", "vhost-vdpa-0"), 0755)
+	assert.NoError(err)
+
+	path, err = findVdpaVhostDevPath(bdf)
+	assert.NoError(err)
+	assert.Equal("/dev/vhost-vdpa-0", path)
+}
+
+func TestCreateVdpaEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	macAddr := net.HardwareAddr{0x02, 0x00, 0xCA, 0xFE, 0x00, 0x04}
+	netInfo := NetworkInfo{
+		Iface: NetlinkIface{
+			LinkAttrs: netlink.LinkAttrs{
+				Name:         "eth0",
+				HardwareAddr: macAddr,
+			},
+		},
+	}
+
+	endpoint, err := createVdpaEndpoint(netInfo, "/dev/vhost-vdpa-0")
+	assert.NoError(err)
+	assert.Equal(VdpaEndpointType, endpoint.Type())
+	assert.Equal("eth0", endpoint.Name())
+	assert.Equal(macAddr.String(), endpoint.HardwareAddr())
+
+	loaded := &VdpaEndpoint{}
+	loaded.load(endpoint.save())
+	assert.Equal(VdpaEndpointType, loaded.Type())
+	assert.Equal("/dev/vhost-vdpa-0", loaded.VhostDevPath)
+	assert.Equal("eth0", loaded.IfaceName)
+}
+
+func TestVdpaEndpointAttach(t *testing.T) {
+	assert := assert.New(t)
+	v := &VdpaEndpoint{
+		VhostDevPath: "/dev/vhost-vdpa-0",
+		HardAddr:     "mac-addr",
+	}
+
+	s := &Sandbox{
+		hypervisor: &mockHypervisor{},
+	}
+
+	err := v.Attach(context.Background(), s)
+	assert.NoError(err)
+}
+
+func TestVdpaEndpoint_HotAttach(t *testing.T) {
+	assert := assert.New(t)
+	v := &VdpaEndpoint{
+		VhostDevPath: "/dev/vhost-vdpa-0",
+		HardAddr:     "mac-addr",
+	}
+
+	h := &mockHypervisor{}
+
+	err := v.HotAttach(context.Background(), h)
+	assert.Error(err)
+}
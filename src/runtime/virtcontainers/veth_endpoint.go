@@ -145,10 +145,6 @@ func (endpoint *VethEndpoint) HotAttach(ctx context.Context, h Hypervisor) error
 
 // HotDetach for the veth endpoint uses hot pull device
 func (endpoint *VethEndpoint) HotDetach(ctx context.Context, h Hypervisor, netNsCreated bool, netNsPath string) error {
-	if !netNsCreated {
-		return nil
-	}
-
 	span, ctx := vethTrace(ctx, "HotDetach", endpoint)
 	defer span.End()
 
@@ -12,7 +12,10 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 
 	"github.com/kata-containers/kata-containers/src/runtime/pkg/katautils/katatrace"
@@ -39,6 +42,8 @@ var (
 type VirtiofsDaemon interface {
 	// Start virtiofs daemon, return pid of virtiofs daemon process
 	Start(context.Context, onQuitFunc) (pid int, err error)
+	// Adopt a virtiofs daemon process started by a previous shim
+	Adopt(ctx context.Context, pid int, onQuit onQuitFunc) error
 	// Stop virtiofs daemon process
 	Stop(context.Context) error
 	// Add a submount rafs to the virtiofs mountpoint
@@ -56,6 +61,13 @@ type MountOption struct {
 // Helper function to execute when virtiofsd quit
 type onQuitFunc func()
 
+// virtiofsDaemonsAdopter is implemented by the hypervisors starting virtiofs
+// daemons, for a shim adopting a sandbox to watch the daemons of the shim it
+// upgraded.
+type virtiofsDaemonsAdopter interface {
+	adoptVirtiofsDaemons(ctx context.Context) error
+}
+
 type virtiofsd struct {
 	// Neded by tracing
 	ctx context.Context
@@ -65,12 +77,26 @@ type virtiofsd struct {
 	socketPath string
 	// cache size for virtiofsd
 	cache string
+	// writeback enables the writeback cache
+	writeback bool
 	// sourcePath path that daemon will help to share
 	sourcePath string
 	// extraArgs list of extra args to append to virtiofsd command
 	extraArgs []string
+	// sandbox mode of the daemon: namespace, chroot or none
+	sandbox string
+	// seccomp action of the daemon: kill, log, trap or none
+	seccomp string
+	// modcaps capabilities added to (+cap) or removed from (-cap) the daemon
+	modcaps []string
+	// readonly rejects the writes to the shared directory
+	readonly bool
 	// PID process ID of virtiosd process
 	PID int
+
+	// mu protects restarts and stopping
+	mu       sync.Mutex
+	stopping bool
 }
 
 // Open socket on behalf of virtiofsd
@@ -141,6 +167,7 @@ func (v *virtiofsd) Start(ctx context.Context, onQuit onQuitFunc) (int, error) {
 	go func() {
 		cmd.Process.Wait()
 		v.Logger().Info("virtiofsd quits")
+		v.checkUnexpectedQuit()
 		if onQuit != nil {
 			onQuit()
 		}
@@ -151,7 +178,61 @@ func (v *virtiofsd) Start(ctx context.Context, onQuit onQuitFunc) (int, error) {
 	return cmd.Process.Pid, nil
 }
 
+// Adopt watches the daemon process left by the shim which was upgraded, to
+// handle it quitting as if this shim had started it.
+func (v *virtiofsd) Adopt(ctx context.Context, pid int, onQuit onQuitFunc) error {
+	v.PID = pid
+
+	return watchDaemon(pid, func() {
+		v.Logger().Info("virtiofsd quits")
+		v.checkUnexpectedQuit()
+		if onQuit != nil {
+			onQuit()
+		}
+	})
+}
+
+// watchDaemon calls quit when the process pid, which is not a child of the
+// shim, quits. Its exit status is collected by its new parent, init.
+func watchDaemon(pid int, quit func()) error {
+	pidfd, err := utils.PidfdOpen(pid)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer pidfd.Close()
+		if err := utils.PidfdWait(pidfd); err != nil {
+			hvLogger.WithError(err).WithField("pid", pid).Warn("failed to wait for the daemon")
+			return
+		}
+		quit()
+	}()
+
+	return nil
+}
+
+// checkUnexpectedQuit reports the daemon quitting while it was not stopped.
+// It is not restarted: the guest cannot resume the FUSE session of the
+// previous daemon, which the mounts of the shared directory keep using.
+func (v *virtiofsd) checkUnexpectedQuit() {
+	v.mu.Lock()
+	stopping := v.stopping
+	v.mu.Unlock()
+
+	if stopping {
+		return
+	}
+
+	virtiofsdUnexpectedQuits.Inc()
+	v.Logger().WithField("socket", v.socketPath).Error("virtiofsd quit unexpectedly")
+}
+
 func (v *virtiofsd) Stop(ctx context.Context) error {
+	v.mu.Lock()
+	v.stopping = true
+	v.mu.Unlock()
+
 	if err := v.kill(ctx); err != nil {
 		v.Logger().WithError(err).WithField("pid", v.PID).Warn("kill virtiofsd failed")
 		return nil
@@ -190,6 +271,16 @@ func (v *virtiofsd) args(FdSocketNumber uint) ([]string, error) {
 		"-f",
 	}
 
+	if v.writeback {
+		args = append(args, "-o", "writeback")
+	}
+
+	sandboxingArgs, err := v.sandboxingArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, sandboxingArgs...)
+
 	if len(v.extraArgs) != 0 {
 		args = append(args, v.extraArgs...)
 	}
@@ -197,6 +288,106 @@ func (v *virtiofsd) args(FdSocketNumber uint) ([]string, error) {
 	return args, nil
 }
 
+// virtiofsdVersion is the version of a virtiofsd daemon, either the Rust
+// implementation or the C one shipped with QEMU, which has the QEMU version.
+type virtiofsdVersion struct {
+	rust  bool
+	major int
+	minor int
+}
+
+func (ver virtiofsdVersion) atLeast(major, minor int) bool {
+	return ver.major > major || (ver.major == major && ver.minor >= minor)
+}
+
+func (ver virtiofsdVersion) String() string {
+	if ver.rust {
+		return fmt.Sprintf("virtiofsd %d.%d", ver.major, ver.minor)
+	}
+	return fmt.Sprintf("QEMU virtiofsd %d.%d", ver.major, ver.minor)
+}
+
+// The Rust daemon prints "virtiofsd 1.4.0", the C one "virtiofsd version 6.2.0 (...)"
+var virtiofsdVersionRegex = regexp.MustCompile(`^virtiofsd (version )?v?(\d+)\.(\d+)`)
+
+// getVirtiofsdVersion is overridden by the tests
+var getVirtiofsdVersion = func(path string) (virtiofsdVersion, error) {
+	output, err := exec.Command(path, "--version").CombinedOutput()
+	if err != nil {
+		return virtiofsdVersion{}, fmt.Errorf("failed to get the version of %s: %v: %s", path, err, output)
+	}
+
+	line := strings.SplitN(strings.TrimSpace(string(output)), "\n", 2)[0]
+	match := virtiofsdVersionRegex.FindStringSubmatch(line)
+	if match == nil {
+		return virtiofsdVersion{}, fmt.Errorf("unknown version of %s: %q", path, line)
+	}
+
+	ver := virtiofsdVersion{rust: match[1] == ""}
+	ver.major, _ = strconv.Atoi(match[2])
+	ver.minor, _ = strconv.Atoi(match[3])
+
+	return ver, nil
+}
+
+// sandboxingArgs returns the arguments confining the daemon, in the syntax
+// of its implementation, or an error if its version does not support them.
+func (v *virtiofsd) sandboxingArgs() ([]string, error) {
+	if v.sandbox == "" && v.seccomp == "" && len(v.modcaps) == 0 && !v.readonly {
+		return nil, nil
+	}
+
+	ver, err := getVirtiofsdVersion(v.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var args []string
+	modcaps := strings.Join(v.modcaps, ":")
+
+	if ver.rust {
+		if v.sandbox != "" {
+			args = append(args, "--sandbox="+v.sandbox)
+		}
+		if v.seccomp != "" {
+			args = append(args, "--seccomp="+v.seccomp)
+		}
+		if modcaps != "" {
+			args = append(args, "--modcaps="+modcaps)
+		}
+		if v.readonly {
+			args = append(args, "--readonly")
+		}
+		return args, nil
+	}
+
+	if v.readonly {
+		return nil, fmt.Errorf("%s does not support read-only sharing", ver)
+	}
+
+	if v.sandbox != "" {
+		// The C daemon can't run without a sandbox
+		if v.sandbox == "none" || !ver.atLeast(5, 2) {
+			return nil, fmt.Errorf("%s does not support sandbox mode %q", ver, v.sandbox)
+		}
+		args = append(args, "-o", "sandbox="+v.sandbox)
+	}
+
+	// The C daemon always kills itself on a seccomp violation
+	if v.seccomp != "" && v.seccomp != "kill" {
+		return nil, fmt.Errorf("%s does not support seccomp action %q", ver, v.seccomp)
+	}
+
+	if modcaps != "" {
+		if !ver.atLeast(5, 1) {
+			return nil, fmt.Errorf("%s does not support modcaps", ver)
+		}
+		args = append(args, "-o", "modcaps="+modcaps)
+	}
+
+	return args, nil
+}
+
 func (v *virtiofsd) valid() error {
 	if v.path == "" {
 		return errVirtiofsdDaemonPathEmpty
@@ -245,6 +436,10 @@ func (v *virtiofsdMock) Start(ctx context.Context, onQuit onQuitFunc) (int, erro
 	return 9999999, nil
 }
 
+func (v *virtiofsdMock) Adopt(ctx context.Context, pid int, onQuit onQuitFunc) error {
+	return nil
+}
+
 func (v *virtiofsdMock) Mount(opt MountOption) error {
 	return errUnimplemented
 }
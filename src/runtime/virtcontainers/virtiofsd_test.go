@@ -7,10 +7,16 @@ package virtcontainers
 
 import (
 	"context"
+	"os"
+	"os/exec"
 	"path"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/utils"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -70,6 +76,88 @@ func TestVirtiofsdStart(t *testing.T) {
 	}
 }
 
+func virtiofsdUnexpectedQuitsCount(t *testing.T) float64 {
+	var m dto.Metric
+	assert.NoError(t, virtiofsdUnexpectedQuits.Write(&m))
+	return m.GetCounter().GetValue()
+}
+
+func TestVirtiofsdUnexpectedQuit(t *testing.T) {
+	assert := assert.New(t)
+
+	truePath, err := exec.LookPath("true")
+	if err != nil {
+		t.Skip("true not found")
+	}
+
+	savedStartCmd := utils.StartCmd
+	defer func() {
+		utils.StartCmd = savedStartCmd
+	}()
+	utils.StartCmd = func(c *exec.Cmd) error {
+		return c.Start()
+	}
+
+	// The daemon quits at once, without being restarted
+	v := &virtiofsd{
+		path:       truePath,
+		socketPath: path.Join(t.TempDir(), "socket.s"),
+		sourcePath: t.TempDir(),
+		cache:      "none",
+	}
+
+	quits := virtiofsdUnexpectedQuitsCount(t)
+	quit := make(chan struct{})
+	_, err = v.Start(context.Background(), func() {
+		close(quit)
+	})
+	assert.NoError(err)
+
+	select {
+	case <-quit:
+	case <-time.After(10 * time.Second):
+		t.Fatal("onQuit was not called")
+	}
+	assert.Equal(quits+1, virtiofsdUnexpectedQuitsCount(t))
+
+	// A stopped daemon quits as expected
+	assert.NoError(v.Stop(context.Background()))
+	v.checkUnexpectedQuit()
+	assert.Equal(quits+1, virtiofsdUnexpectedQuitsCount(t))
+}
+
+func TestVirtiofsdAdopt(t *testing.T) {
+	assert := assert.New(t)
+
+	// A process this test starts stands for the daemon left by a previous
+	// shim, the watch does not rely on being its parent
+	cmd := exec.Command("sleep", "0.1")
+	assert.NoError(cmd.Start())
+	defer cmd.Wait()
+
+	v := &virtiofsd{
+		socketPath: path.Join(t.TempDir(), "socket.s"),
+	}
+
+	quits := virtiofsdUnexpectedQuitsCount(t)
+	quit := make(chan struct{})
+	err := v.Adopt(context.Background(), cmd.Process.Pid, func() {
+		close(quit)
+	})
+	if err != nil {
+		t.Skipf("pidfd is not supported: %v", err)
+	}
+	assert.Equal(cmd.Process.Pid, v.PID)
+
+	select {
+	case <-quit:
+	case <-time.After(10 * time.Second):
+		t.Fatal("onQuit was not called")
+	}
+	// The daemons of the other tests may quit meanwhile
+	assert.GreaterOrEqual(virtiofsdUnexpectedQuitsCount(t), quits+1)
+}
+
 func TestVirtiofsdArgs(t *testing.T) {
 	assert := assert.New(t)
 
@@ -88,6 +176,93 @@ func TestVirtiofsdArgs(t *testing.T) {
 	args, err = v.args(456)
 	assert.NoError(err)
 	assert.Equal(expected, strings.Join(args, " "))
+
+	v.writeback = true
+	expected = "--syslog -o cache=none -o no_posix_lock -o source=/run/kata-shared/foo --fd=456 -f -o writeback"
+	args, err = v.args(456)
+	assert.NoError(err)
+	assert.Equal(expected, strings.Join(args, " "))
+}
+
+func TestVirtiofsdSandboxingArgs(t *testing.T) {
+	assert := assert.New(t)
+
+	savedGetVirtiofsdVersion := getVirtiofsdVersion
+	defer func() {
+		getVirtiofsdVersion = savedGetVirtiofsdVersion
+	}()
+
+	var version virtiofsdVersion
+	getVirtiofsdVersion = func(path string) (virtiofsdVersion, error) {
+		return version, nil
+	}
+
+	v := &virtiofsd{
+		sandbox: "none",
+		seccomp: "log",
+		modcaps: []string{"+sys_admin", "-fsetid"},
+	}
+
+	version = virtiofsdVersion{rust: true, major: 1, minor: 4}
+	args, err := v.sandboxingArgs()
+	assert.NoError(err)
+	assert.Equal("--sandbox=none --seccomp=log --modcaps=+sys_admin:-fsetid", strings.Join(args, " "))
+
+	// The C daemon can't disable its sandbox nor its seccomp filter
+	version = virtiofsdVersion{major: 6, minor: 2}
+	_, err = v.sandboxingArgs()
+	assert.Error(err)
+
+	v.sandbox = "chroot"
+	_, err = v.sandboxingArgs()
+	assert.Error(err)
+
+	v.seccomp = "kill"
+	args, err = v.sandboxingArgs()
+	assert.NoError(err)
+	assert.Equal("-o sandbox=chroot -o modcaps=+sys_admin:-fsetid", strings.Join(args, " "))
+
+	version = virtiofsdVersion{major: 5, minor: 1}
+	_, err = v.sandboxingArgs()
+	assert.Error(err)
+
+	v.sandbox = ""
+	args, err = v.sandboxingArgs()
+	assert.NoError(err)
+	assert.Equal("-o modcaps=+sys_admin:-fsetid", strings.Join(args, " "))
+
+	// Only the Rust daemon can share read-only
+	v.readonly = true
+	_, err = v.sandboxingArgs()
+	assert.Error(err)
+
+	version = virtiofsdVersion{rust: true, major: 1, minor: 4}
+	args, err = v.sandboxingArgs()
+	assert.NoError(err)
+	assert.Equal("--seccomp=kill --modcaps=+sys_admin:-fsetid --readonly", strings.Join(args, " "))
+}
+
+func TestGetVirtiofsdVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	daemon := path.Join(t.TempDir(), "virtiofsd")
+
+	for output, expected := range map[string]virtiofsdVersion{
+		"virtiofsd 1.4.0": {rust: true, major: 1, minor: 4},
+		"virtiofsd version 6.2.0 (v6.2.0)\nusing FUSE kernel": {major: 6, minor: 2},
+	} {
+		err := os.WriteFile(daemon, []byte("#!/bin/sh\nprintf '"+output+"\\n'\n"), 0755)
+		assert.NoError(err)
+
+		version, err := getVirtiofsdVersion(daemon)
+		assert.NoError(err)
+		assert.Equal(expected, version)
+	}
+
+	err := os.WriteFile(daemon, []byte("#!/bin/sh\necho foo\n"), 0755)
+	assert.NoError(err)
+	_, err = getVirtiofsdVersion(daemon)
+	assert.Error(err)
 }
 
 func TestValid(t *testing.T) {
@@ -131,3 +306,47 @@ func TestValid(t *testing.T) {
 	err = v.valid()
 	assert.Equal(errVirtiofsdSourceNotAvailable, err)
 }
+
+func TestShareByVolumesVirtiofsd(t *testing.T) {
+	assert := assert.New(t)
+
+	conf := &HypervisorConfig{
+		SharedFS:                 config.VirtioFS,
+		VirtioFSCache:            "always",
+		VirtioFSVolumesDaemon:    true,
+		VirtioFSVolumesCache:     typeVirtioFSNoCache,
+		VirtioFSVolumesWriteback: true,
+	}
+
+	// nolint: govet
+	tests := []struct {
+		options    []string
+		useVolumes bool
+		wantErr    bool
+	}{
+		{nil, true, false},
+		{[]string{"rbind", virtioFSCacheMountOption + "none"}, true, false},
+		{[]string{virtioFSCacheMountOption + "always"}, false, false},
+		{[]string{virtioFSCacheMountOption + "always", virtioFSWritebackMountOption + "false"}, false, false},
+		{[]string{virtioFSCacheMountOption + "none", virtioFSWritebackMountOption + "false"}, false, true},
+		{[]string{virtioFSCacheMountOption + "auto"}, false, true},
+		{[]string{virtioFSCacheMountOption + "never"}, false, true},
+		{[]string{virtioFSWritebackMountOption + "maybe"}, false, true},
+	}
+
+	for i, tt := range tests {
+		useVolumes, err := shareByVolumesVirtiofsd(conf, &Mount{Destination: "/data", Options: tt.options})
+		if tt.wantErr {
+			assert.Error(err, "test %d", i)
+			continue
+		}
+		assert.NoError(err, "test %d", i)
+		assert.Equal(tt.useVolumes, useVolumes, "test %d", i)
+	}
+
+	// The options only choose between the virtio-fs daemons.
+	conf.SharedFS = config.Virtio9P
+	useVolumes, err := shareByVolumesVirtiofsd(conf, &Mount{Options: []string{virtioFSCacheMountOption + "auto"}})
+	assert.NoError(err)
+	assert.False(useVolumes)
+}
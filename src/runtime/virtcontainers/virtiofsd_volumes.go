@@ -0,0 +1,149 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/device/config"
+)
+
+// vhostFSVolumesSocket is the vhost-user socket of the virtiofsd sharing the
+// volumes, in the VM store directory.
+const vhostFSVolumesSocket = "vhost-fs-volumes.sock"
+
+const (
+	// virtioFSCacheMountOption is the mount option choosing the cache mode
+	// (none, auto or always) of the virtiofsd sharing a mount.
+	virtioFSCacheMountOption = "io.katacontainers.virtio-fs.cache="
+
+	// virtioFSWritebackMountOption is the mount option choosing whether the
+	// virtiofsd sharing a mount has the writeback cache enabled.
+	virtioFSWritebackMountOption = "io.katacontainers.virtio-fs.writeback="
+)
+
+// virtioFSMountPolicy is the virtio-fs cache policy requested for a mount.
+type virtioFSMountPolicy struct {
+	cache        string
+	writeback    bool
+	hasCache     bool
+	hasWriteback bool
+}
+
+// matches returns true if a virtiofsd with the given cache mode and
+// writeback setting can share the mount.
+func (p virtioFSMountPolicy) matches(cache string, writeback bool) bool {
+	return (!p.hasCache || p.cache == cache) && (!p.hasWriteback || p.writeback == writeback)
+}
+
+// parseVirtioFSMountPolicy returns the virtio-fs cache policy set in the
+// options of a mount.
+func parseVirtioFSMountPolicy(options []string) (virtioFSMountPolicy, error) {
+	var p virtioFSMountPolicy
+
+	for _, o := range options {
+		switch {
+		case strings.HasPrefix(o, virtioFSCacheMountOption):
+			p.cache = strings.TrimPrefix(o, virtioFSCacheMountOption)
+			switch p.cache {
+			case typeVirtioFSNoCache, "auto", "always":
+			default:
+				return p, fmt.Errorf("invalid virtio-fs cache mode %q in mount option %q", p.cache, o)
+			}
+			p.hasCache = true
+		case strings.HasPrefix(o, virtioFSWritebackMountOption):
+			writeback, err := strconv.ParseBool(strings.TrimPrefix(o, virtioFSWritebackMountOption))
+			if err != nil {
+				return p, fmt.Errorf("invalid virtio-fs writeback setting in mount option %q: %v", o, err)
+			}
+			p.writeback = writeback
+			p.hasWriteback = true
+		}
+	}
+
+	return p, nil
+}
+
+// isVirtioFSMountOption returns true for the mount options only read by the
+// runtime to choose the virtiofsd sharing a mount, which the guest must not
+// see.
+func isVirtioFSMountOption(option string) bool {
+	return strings.HasPrefix(option, virtioFSCacheMountOption) || strings.HasPrefix(option, virtioFSWritebackMountOption)
+}
+
+// shareByVolumesVirtiofsd returns true if a mount is shared by the virtiofsd
+// sharing the volumes rather than by the one sharing the root filesystems.
+// The mount can ask for a cache policy through its options, in which case it
+// is shared by the daemon running with that policy, the volumes one first:
+// the cache mode and writeback settings of the two daemons are the policies
+// the mounts of a sandbox can choose from.
+func shareByVolumesVirtiofsd(conf *HypervisorConfig, m *Mount) (bool, error) {
+	useVolumes := useVolumesVirtiofsd(conf)
+	if conf.SharedFS != config.VirtioFS {
+		return useVolumes, nil
+	}
+
+	p, err := parseVirtioFSMountPolicy(m.Options)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case useVolumes && p.matches(volumesVirtioFSCache(conf), conf.VirtioFSVolumesWriteback):
+		return true, nil
+	case p.matches(conf.VirtioFSCache, conf.VirtioFSWriteback):
+		return false, nil
+	}
+
+	return false, fmt.Errorf("no virtiofsd runs with the virtio-fs cache policy asked for mount %s", m.Destination)
+}
+
+// useVolumesVirtiofsd returns true if the volumes of the containers are shared
+// by another virtiofsd than their root filesystems, so that a workload
+// crashing or stalling the daemon through a volume doesn't break the I/O on
+// the root filesystems.
+func useVolumesVirtiofsd(conf *HypervisorConfig) bool {
+	return conf.VirtioFSVolumesDaemon && conf.SharedFS == config.VirtioFS
+}
+
+// volumesVirtioFSCache returns the cache mode of the virtiofsd sharing the
+// volumes, which defaults to the one of the root filesystems.
+func volumesVirtioFSCache(conf *HypervisorConfig) string {
+	if conf.VirtioFSVolumesCache == "" {
+		return conf.VirtioFSCache
+	}
+	return conf.VirtioFSVolumesCache
+}
+
+// newVolumesVirtiofsd returns the virtiofsd sharing the volumes of the sandbox.
+func newVolumesVirtiofsd(conf *HypervisorConfig, id, socketPath string) *virtiofsd {
+	return &virtiofsd{
+		path:       conf.VirtioFSDaemon,
+		sourcePath: GetVolumesSharePath(id),
+		socketPath: socketPath,
+		extraArgs:  conf.VirtioFSExtraArgs,
+		cache:      volumesVirtioFSCache(conf),
+		writeback:  conf.VirtioFSVolumesWriteback,
+		sandbox:    conf.VirtioFSSandbox,
+		seccomp:    conf.VirtioFSSeccomp,
+		modcaps:    conf.VirtioFSModcaps,
+		readonly:   conf.SharedFSReadOnly,
+	}
+}
+
+// startVolumesVirtiofsd starts the virtiofsd sharing the volumes. Unlike the
+// one sharing the root filesystems, the VM is not stopped when it quits: the
+// I/O on the volumes fails, but the containers keep running.
+func startVolumesVirtiofsd(ctx context.Context, daemon VirtiofsDaemon) (int, error) {
+	return daemon.Start(ctx, volumesVirtiofsdQuit)
+}
+
+func volumesVirtiofsdQuit() {
+	hvLogger.WithField("subsystem", "virtiofsd").Warn("virtiofsd sharing the volumes quits")
+}
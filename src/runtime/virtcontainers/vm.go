@@ -226,7 +226,7 @@ func (v *VM) Pause(ctx context.Context) error {
 // Save saves a VM to persistent disk.
 func (v *VM) Save() error {
 	v.logger().Info("Save vm")
-	return v.hypervisor.SaveVM()
+	return v.hypervisor.SaveVM(v.hypervisor.HypervisorConfig().DevicesStatePath)
 }
 
 // Resume resumes a paused VM.
@@ -293,7 +293,7 @@ func (v *VM) AddMemory(ctx context.Context, numMB uint32) error {
 // OnlineCPUMemory puts the hotplugged CPU and memory online.
 func (v *VM) OnlineCPUMemory(ctx context.Context) error {
 	v.logger().Infof("online CPU %d and memory", v.cpuDelta)
-	err := v.agent.onlineCPUMem(ctx, v.cpuDelta, false)
+	err := v.agent.onlineCPUMem(ctx, v.cpuDelta, false, 0)
 	if err == nil {
 		v.cpuDelta = 0
 	}
@@ -0,0 +1,403 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/grpc"
+)
+
+const (
+	// watchableSyncInterval is the interval at which watchable mounts are
+	// rescanned, in case some of their changes were not notified.
+	watchableSyncInterval = 10 * time.Second
+
+	// watchableSyncDelay is the delay between the notification of a change
+	// and the sync of a watchable mount, so that the changes of an update,
+	// e.g. of a Kubernetes ConfigMap, are synced at once.
+	watchableSyncDelay = 100 * time.Millisecond
+)
+
+// watchableEntry is the state of a file system entry of a watchable mount,
+// as last synced to the guest.
+type watchableEntry struct {
+	mode       fs.FileMode
+	uid        uint32
+	gid        uint32
+	size       int64
+	modTime    time.Time
+	linkTarget string
+}
+
+func (e watchableEntry) kind() int {
+	switch {
+	case e.mode.IsDir():
+		return 0
+	case e.mode.IsRegular():
+		return 1
+	default:
+		return 2
+	}
+}
+
+// watchableMount mirrors a watchable mount of the host, e.g. a Kubernetes
+// ConfigMap or Secret, in a guest directory supporting inotify, as virtio-fs
+// and 9p don't notify the guest of the changes made on the host. Changes are
+// detected on the host with inotify, and streamed to the agent in chunks, so
+// that the number and size of the files of the mount are not limited.
+type watchableMount struct {
+	agent      agent
+	source     string
+	mountPoint string
+
+	entries map[string]watchableEntry
+	watcher *fsnotify.Watcher
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newWatchableMount(a agent, source, mountPoint string) *watchableMount {
+	return &watchableMount{
+		agent:      a,
+		source:     source,
+		mountPoint: mountPoint,
+		entries:    make(map[string]watchableEntry),
+		stopCh:     make(chan struct{}),
+	}
+}
+
+func (w *watchableMount) Logger() *logrus.Entry {
+	return virtLog.WithFields(logrus.Fields{
+		"subsystem":   "watchable-mount",
+		"source":      w.source,
+		"mount-point": w.mountPoint,
+	})
+}
+
+func newWatchableEntry(info fs.FileInfo) watchableEntry {
+	entry := watchableEntry{
+		mode: info.Mode(),
+	}
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		entry.uid = st.Uid
+		entry.gid = st.Gid
+	}
+	if entry.mode.IsRegular() {
+		entry.size = info.Size()
+		entry.modTime = info.ModTime()
+	}
+	return entry
+}
+
+// scanWatchableMount returns the entries of a watchable mount, indexed by
+// their path relative to the mount. A mount of a single file, which is a
+// symbolic link for Kubernetes ConfigMaps and Secrets, is followed.
+func scanWatchableMount(source string) (map[string]watchableEntry, error) {
+	entries := make(map[string]watchableEntry)
+
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		if !info.Mode().IsRegular() {
+			return nil, fmt.Errorf("watchable mount %s is not a regular file or directory", source)
+		}
+		entries[""] = newWatchableEntry(info)
+		return entries, nil
+	}
+
+	err = filepath.WalkDir(source, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			rel = ""
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		entry := newWatchableEntry(info)
+		switch {
+		case info.Mode()&fs.ModeSymlink != 0:
+			if entry.linkTarget, err = os.Readlink(path); err != nil {
+				return err
+			}
+		case !info.IsDir() && !info.Mode().IsRegular():
+			// Sockets, devices and pipes are not mirrored
+			return nil
+		}
+
+		entries[rel] = entry
+		return nil
+	})
+
+	return entries, err
+}
+
+// start syncs the content of the mount to the guest, and keeps syncing its
+// changes until the mount is stopped.
+func (w *watchableMount) start(ctx context.Context) (err error) {
+	if w.watcher, err = fsnotify.NewWatcher(); err != nil {
+		return err
+	}
+
+	if err = w.sync(ctx); err != nil {
+		w.watcher.Close()
+		return err
+	}
+
+	w.wg.Add(1)
+	go w.run(ctx)
+
+	return nil
+}
+
+func (w *watchableMount) run(ctx context.Context) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(watchableSyncInterval)
+	defer ticker.Stop()
+
+	var delay <-chan time.Time
+
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		case _, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if delay == nil {
+				delay = time.After(watchableSyncDelay)
+			}
+			continue
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			w.Logger().WithError(err).Warn("watchable mount watcher error")
+			continue
+		case <-delay:
+			delay = nil
+		case <-ticker.C:
+		}
+
+		if err := w.sync(ctx); err != nil {
+			w.Logger().WithError(err).Warn("failed to sync watchable mount")
+		}
+	}
+}
+
+// stop stops syncing the changes of the mount.
+func (w *watchableMount) stop() {
+	close(w.stopCh)
+	w.wg.Wait()
+	w.watcher.Close()
+}
+
+// watch watches the directories of the mount, or the directory of a single
+// file mount, whose file is replaced rather than updated by Kubernetes.
+func (w *watchableMount) watch(entries map[string]watchableEntry) {
+	if root, ok := entries[""]; ok && !root.mode.IsDir() {
+		w.watcher.Add(filepath.Dir(w.source))
+		return
+	}
+
+	for rel, entry := range entries {
+		if !entry.mode.IsDir() {
+			continue
+		}
+		if err := w.watcher.Add(filepath.Join(w.source, rel)); err != nil {
+			w.Logger().WithError(err).WithField("path", rel).Debug("failed to watch directory")
+		}
+	}
+}
+
+// sync sends the changes of the mount since its last sync to the guest.
+// Directories are created first, then regular files, then symbolic links,
+// and removed entries are removed last, so that the guest sees the atomic
+// updates of Kubernetes ConfigMaps and Secrets in the same order.
+func (w *watchableMount) sync(ctx context.Context) error {
+	entries, err := scanWatchableMount(w.source)
+	if err != nil {
+		return err
+	}
+
+	w.watch(entries)
+
+	var updated, removed []string
+	for rel, entry := range entries {
+		if old, ok := w.entries[rel]; !ok || old != entry {
+			updated = append(updated, rel)
+		}
+	}
+	for rel := range w.entries {
+		if _, ok := entries[rel]; !ok {
+			removed = append(removed, rel)
+		}
+	}
+
+	if len(updated) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	sort.Slice(updated, func(i, j int) bool {
+		ki, kj := entries[updated[i]].kind(), entries[updated[j]].kind()
+		if ki != kj {
+			return ki < kj
+		}
+		return updated[i] < updated[j]
+	})
+	// Remove the entries of a directory before the directory itself
+	sort.Sort(sort.Reverse(sort.StringSlice(removed)))
+
+	w.Logger().WithFields(logrus.Fields{
+		"updated": len(updated),
+		"removed": len(removed),
+	}).Debug("Syncing watchable mount")
+
+	s := watchableSyncer{
+		ctx:   ctx,
+		agent: w.agent,
+		req:   &grpc.SyncWatchableMountRequest{MountPoint: w.mountPoint},
+	}
+
+	for _, rel := range updated {
+		if err := s.add(filepath.Join(w.source, rel), rel, entries[rel]); err != nil {
+			return err
+		}
+	}
+	s.req.Removed = removed
+
+	if err := s.flush(); err != nil {
+		return err
+	}
+
+	w.entries = entries
+
+	return nil
+}
+
+// watchableSyncer batches the entries of a sync in requests of at most
+// grpcMaxDataSize bytes of file data.
+type watchableSyncer struct {
+	ctx      context.Context
+	agent    agent
+	req      *grpc.SyncWatchableMountRequest
+	dataSize int64
+}
+
+func (s *watchableSyncer) flush() error {
+	if len(s.req.Entries) == 0 && len(s.req.Removed) == 0 {
+		return nil
+	}
+
+	if err := s.agent.syncWatchableMount(s.ctx, s.req); err != nil {
+		return fmt.Errorf("could not sync watchable mount %s: %v", s.req.MountPoint, err)
+	}
+
+	s.req = &grpc.SyncWatchableMountRequest{MountPoint: s.req.MountPoint}
+	s.dataSize = 0
+
+	return nil
+}
+
+func (s *watchableSyncer) append(entry *grpc.WatchableEntry) error {
+	size := int64(len(entry.Data))
+	if s.dataSize > 0 && s.dataSize+size > grpcMaxDataSize {
+		if err := s.flush(); err != nil {
+			return err
+		}
+	}
+
+	s.req.Entries = append(s.req.Entries, entry)
+	s.dataSize += size
+
+	return nil
+}
+
+func (s *watchableSyncer) add(path, rel string, entry watchableEntry) error {
+	newEntry := func() *grpc.WatchableEntry {
+		return &grpc.WatchableEntry{
+			Path:       rel,
+			Mode:       uint32(entry.mode.Perm()) | fileModeType(entry.mode),
+			Uid:        entry.uid,
+			Gid:        entry.gid,
+			LinkTarget: entry.linkTarget,
+			Size_:      uint64(entry.size),
+		}
+	}
+
+	if !entry.mode.IsRegular() || entry.size == 0 {
+		return s.append(newEntry())
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	for offset := int64(0); offset < entry.size; {
+		chunk := entry.size - offset
+		if chunk > grpcMaxDataSize {
+			chunk = grpcMaxDataSize
+		}
+
+		e := newEntry()
+		e.Offset = uint64(offset)
+		e.Data = make([]byte, chunk)
+		// The file changed since it was scanned, it is synced again by the
+		// next sync.
+		if _, err := io.ReadFull(f, e.Data); err != nil {
+			return fmt.Errorf("could not read %s: %v", path, err)
+		}
+
+		if err := s.append(e); err != nil {
+			return err
+		}
+		offset += chunk
+	}
+
+	return nil
+}
+
+// fileModeType returns the st_mode file type bits of a file mode.
+func fileModeType(mode fs.FileMode) uint32 {
+	switch {
+	case mode.IsDir():
+		return syscall.S_IFDIR
+	case mode&fs.ModeSymlink != 0:
+		return syscall.S_IFLNK
+	default:
+		return syscall.S_IFREG
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/grpc"
+)
+
+type syncRecorderAgent struct {
+	mockAgent
+	reqs []*grpc.SyncWatchableMountRequest
+}
+
+func (a *syncRecorderAgent) syncWatchableMount(ctx context.Context, req *grpc.SyncWatchableMountRequest) error {
+	a.reqs = append(a.reqs, req)
+	return nil
+}
+
+func (a *syncRecorderAgent) entries() []*grpc.WatchableEntry {
+	var entries []*grpc.WatchableEntry
+	for _, req := range a.reqs {
+		entries = append(entries, req.Entries...)
+	}
+	return entries
+}
+
+func TestWatchableMountSync(t *testing.T) {
+	assert := assert.New(t)
+
+	orgGrpcMaxDataSize := grpcMaxDataSize
+	grpcMaxDataSize = 4
+	defer func() {
+		grpcMaxDataSize = orgGrpcMaxDataSize
+	}()
+
+	// Layout of a Kubernetes ConfigMap volume
+	source := t.TempDir()
+	assert.NoError(os.Mkdir(filepath.Join(source, "..v1"), 0755))
+	assert.NoError(os.WriteFile(filepath.Join(source, "..v1", "config"), []byte("0123456789"), 0644))
+	assert.NoError(os.Symlink("..v1", filepath.Join(source, "..data")))
+	assert.NoError(os.Symlink("..data/config", filepath.Join(source, "config")))
+
+	a := &syncRecorderAgent{}
+	w := newWatchableMount(a, source, "/run/kata-containers/shared/containers/watchable/config")
+
+	var err error
+	w.watcher, err = fsnotify.NewWatcher()
+	assert.NoError(err)
+	defer w.watcher.Close()
+
+	assert.NoError(w.sync(context.Background()))
+
+	// Directories first, then files by chunks, then symlinks
+	var paths []string
+	var data []byte
+	for _, e := range a.entries() {
+		if len(paths) == 0 || paths[len(paths)-1] != e.Path {
+			paths = append(paths, e.Path)
+		}
+		if e.Path == "..v1/config" {
+			assert.Equal(uint32(syscall.S_IFREG|0644), e.Mode)
+			assert.Equal(uint64(10), e.Size_)
+			assert.Equal(uint64(len(data)), e.Offset)
+			data = append(data, e.Data...)
+		}
+	}
+	assert.Equal([]string{"", "..v1", "..v1/config", "..data", "config"}, paths)
+	assert.Equal([]byte("0123456789"), data)
+
+	for _, req := range a.reqs {
+		size := 0
+		for _, e := range req.Entries {
+			size += len(e.Data)
+		}
+		assert.True(int64(size) <= grpcMaxDataSize)
+		assert.Equal(w.mountPoint, req.MountPoint)
+	}
+
+	// Nothing changed
+	a.reqs = nil
+	assert.NoError(w.sync(context.Background()))
+	assert.Empty(a.reqs)
+
+	// Atomic update of the ConfigMap
+	assert.NoError(os.Mkdir(filepath.Join(source, "..v2"), 0755))
+	assert.NoError(os.WriteFile(filepath.Join(source, "..v2", "config"), []byte("abc"), 0644))
+	assert.NoError(os.Symlink("..v2", filepath.Join(source, "..data_tmp")))
+	assert.NoError(os.Rename(filepath.Join(source, "..data_tmp"), filepath.Join(source, "..data")))
+	assert.NoError(os.RemoveAll(filepath.Join(source, "..v1")))
+
+	assert.NoError(w.sync(context.Background()))
+
+	paths = nil
+	for _, e := range a.entries() {
+		paths = append(paths, e.Path)
+		if e.Path == "..data" {
+			assert.Equal("..v2", e.LinkTarget)
+			assert.Equal(uint32(syscall.S_IFLNK), e.Mode&syscall.S_IFMT)
+		}
+	}
+	assert.Equal([]string{"..v2", "..v2/config", "..data"}, paths)
+	assert.Equal([]string{"..v1/config", "..v1"}, a.reqs[len(a.reqs)-1].Removed)
+}
+
+func TestWatchableMountSyncFile(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	assert.NoError(os.WriteFile(filepath.Join(dir, "token"), []byte("secret"), 0600))
+	source := filepath.Join(dir, "link")
+	assert.NoError(os.Symlink("token", source))
+
+	entries, err := scanWatchableMount(source)
+	assert.NoError(err)
+	assert.Len(entries, 1)
+	assert.True(entries[""].mode.IsRegular())
+	assert.Equal(int64(6), entries[""].size)
+
+	a := &syncRecorderAgent{}
+	w := newWatchableMount(a, source, "/run/kata-containers/shared/containers/watchable/token")
+	assert.NoError(w.start(context.Background()))
+	w.stop()
+
+	assert.Len(a.reqs, 1)
+	assert.Len(a.reqs[0].Entries, 1)
+	assert.Equal("", a.reqs[0].Entries[0].Path)
+	assert.Equal([]byte("secret"), a.reqs[0].Entries[0].Data)
+
+	_, err = scanWatchableMount(filepath.Join(dir, "missing"))
+	assert.Error(err)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// The kinds of the operations watched for slowness.
+const (
+	slowOperationAgent      = "agent"
+	slowOperationHypervisor = "hypervisor"
+	slowOperationMount      = "mount"
+)
+
+// slowOperationThreshold is the duration, in nanoseconds, after which the
+// watched operations are reported as slow. The watchdog is disabled when it
+// is zero.
+var slowOperationThreshold int64
+
+// setSlowOperationThreshold sets the threshold of the watchdog of the slow
+// operations, in milliseconds, disabling it when zero.
+func setSlowOperationThreshold(threshold uint32) {
+	atomic.StoreInt64(&slowOperationThreshold, int64(time.Duration(threshold)*time.Millisecond))
+}
+
+// watchOperation watches an operation of the runtime, and returns the
+// function to call once it completes. When the operation lasts longer than
+// the threshold, it is counted and logged at that point, whether it ever
+// completes or not, then logged again with its duration when it completes.
+// The arguments of the operation, if any, which are a fmt.Stringer are only
+// formatted when it is logged, and not logged when empty.
+func watchOperation(kind, name string, args interface{}) func() {
+	threshold := time.Duration(atomic.LoadInt64(&slowOperationThreshold))
+	if threshold <= 0 {
+		return func() {}
+	}
+
+	start := time.Now()
+	logger := func() *logrus.Entry {
+		fields := logrus.Fields{
+			"subsystem": "watchdog",
+			"kind":      kind,
+			"operation": name,
+			"threshold": threshold,
+		}
+		if stringer, ok := args.(fmt.Stringer); ok {
+			if arguments := stringer.String(); arguments != "" {
+				fields["arguments"] = arguments
+			}
+		} else if args != nil {
+			fields["arguments"] = args
+		}
+		return virtLog.WithFields(fields)
+	}
+
+	timer := time.AfterFunc(threshold, func() {
+		slowOperations.WithLabelValues(kind, name).Inc()
+		logger().Warn("operation is taking longer than the threshold")
+	})
+
+	return func() {
+		if timer.Stop() {
+			return
+		}
+		logger().WithField("duration", time.Since(start)).Warn("slow operation completed")
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2022 Kata Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package virtcontainers
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/agent/protocols/grpc"
+	"github.com/kata-containers/kata-containers/src/runtime/virtcontainers/pkg/mock"
+)
+
+type watchdogHook struct {
+	sync.Mutex
+	entries []*logrus.Entry
+}
+
+func (h *watchdogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *watchdogHook) Fire(entry *logrus.Entry) error {
+	if entry.Data["subsystem"] == "watchdog" {
+		h.Lock()
+		h.entries = append(h.entries, entry)
+		h.Unlock()
+	}
+	return nil
+}
+
+func (h *watchdogHook) messages() []string {
+	h.Lock()
+	defer h.Unlock()
+
+	var messages []string
+	for _, entry := range h.entries {
+		messages = append(messages, entry.Message)
+	}
+	return messages
+}
+
+type testStringer string
+
+func (s testStringer) String() string {
+	return "formatted " + string(s)
+}
+
+func slowOperationsCount(t *testing.T, kind, name string) float64 {
+	var m dto.Metric
+	assert.NoError(t, slowOperations.WithLabelValues(kind, name).Write(&m))
+	return m.GetCounter().GetValue()
+}
+
+func TestWatchOperation(t *testing.T) {
+	assert := assert.New(t)
+
+	hook := &watchdogHook{}
+	logger := virtLog.Logger
+	hooks := logger.ReplaceHooks(logrus.LevelHooks{})
+	defer logger.ReplaceHooks(hooks)
+	logger.AddHook(hook)
+
+	level := logger.GetLevel()
+	defer logger.SetLevel(level)
+	logger.SetLevel(logrus.WarnLevel)
+
+	defer setSlowOperationThreshold(0)
+
+	// disabled
+	count := slowOperationsCount(t, slowOperationHypervisor, "StopVM")
+	done := watchOperation(slowOperationHypervisor, "StopVM", nil)
+	time.Sleep(10 * time.Millisecond)
+	done()
+	assert.Empty(hook.messages())
+	assert.Equal(count, slowOperationsCount(t, slowOperationHypervisor, "StopVM"))
+
+	// fast operation
+	setSlowOperationThreshold(1000)
+	done = watchOperation(slowOperationMount, "remount", "/foo")
+	done()
+	assert.Empty(hook.messages())
+
+	// slow operation, logged when exceeding the threshold then when
+	// completed
+	setSlowOperationThreshold(1)
+	count = slowOperationsCount(t, slowOperationHypervisor, "SaveVM")
+	done = watchOperation(slowOperationHypervisor, "SaveVM", testStringer("state"))
+	assert.Eventually(func() bool {
+		return len(hook.messages()) == 1
+	}, time.Second, time.Millisecond)
+	assert.Equal([]string{"operation is taking longer than the threshold"}, hook.messages())
+	assert.Equal(count+1, slowOperationsCount(t, slowOperationHypervisor, "SaveVM"))
+
+	done()
+	assert.Equal([]string{
+		"operation is taking longer than the threshold",
+		"slow operation completed",
+	}, hook.messages())
+
+	for _, entry := range hook.entries {
+		assert.Equal(slowOperationHypervisor, entry.Data["kind"])
+		assert.Equal("SaveVM", entry.Data["operation"])
+		assert.Equal("formatted state", entry.Data["arguments"])
+		assert.Equal(time.Millisecond, entry.Data["threshold"])
+	}
+	assert.Contains(hook.entries[1].Data, "duration")
+}
+
+func TestWatchOperationAgentRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	hook := &watchdogHook{}
+	logger := virtLog.Logger
+	hooks := logger.ReplaceHooks(logrus.LevelHooks{})
+	defer logger.ReplaceHooks(hooks)
+	logger.AddHook(hook)
+
+	level := logger.GetLevel()
+	defer logger.SetLevel(level)
+	logger.SetLevel(logrus.WarnLevel)
+
+	url, err := mock.GenerateKataMockHybridVSock()
+	assert.NoError(err)
+	defer mock.RemoveKataMockHybridVSock(url)
+
+	hybridVSockTTRPCMock := mock.HybridVSockTTRPCMock{}
+	assert.NoError(hybridVSockTTRPCMock.Start(url))
+	defer hybridVSockTTRPCMock.Stop()
+
+	k := &kataAgent{
+		ctx: context.Background(),
+		state: KataAgentState{
+			URL: url,
+		},
+		keepConn: true,
+	}
+
+	// every request is slow
+	atomic.StoreInt64(&slowOperationThreshold, 1)
+	defer setSlowOperationThreshold(0)
+
+	_, err = k.sendReq(context.Background(), &pb.WriteStreamRequest{Data: []byte("secret")})
+	assert.NoError(err)
+
+	assert.Eventually(func() bool {
+		return len(hook.messages()) == 2
+	}, time.Second, time.Millisecond)
+	for _, entry := range hook.entries {
+		assert.Equal(grpcWriteStreamRequest, entry.Data["operation"])
+		assert.NotContains(entry.Data, "arguments")
+	}
+}